@@ -69,3 +69,23 @@ func eval(binaryOp stmt.BinaryOP, left, right float64) float64 {
 		return 0
 	}
 }
+
+// compare compares two values based on compare operator, returns if matches
+func compare(binaryOp stmt.BinaryOP, left, right float64) bool {
+	switch binaryOp {
+	case stmt.EQUAL:
+		return left == right
+	case stmt.NOTEQUAL:
+		return left != right
+	case stmt.LESS:
+		return left < right
+	case stmt.LESSEQUAL:
+		return left <= right
+	case stmt.GREATER:
+		return left > right
+	case stmt.GREATEREQUAL:
+		return left >= right
+	default:
+		return false
+	}
+}
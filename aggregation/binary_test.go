@@ -38,6 +38,19 @@ func TestBinary_eval(t *testing.T) {
 	assert.Equal(t, float64(0), eval(stmt.OR, 4, 8))
 }
 
+func TestBinary_compare(t *testing.T) {
+	assert.True(t, compare(stmt.EQUAL, 4, 4))
+	assert.True(t, compare(stmt.NOTEQUAL, 4, 6))
+	assert.True(t, compare(stmt.LESS, 4, 6))
+	assert.True(t, compare(stmt.LESSEQUAL, 4, 4))
+	assert.True(t, compare(stmt.GREATER, 6, 4))
+	assert.True(t, compare(stmt.GREATEREQUAL, 6, 6))
+	assert.False(t, compare(stmt.GREATER, 4, 6))
+
+	// wrong compare operator
+	assert.False(t, compare(stmt.ADD, 4, 8))
+}
+
 func TestBinary_Eval_Single(t *testing.T) {
 	left := collections.NewFloatArray(10)
 	left.SetValue(0, 1.1)
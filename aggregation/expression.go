@@ -41,6 +41,8 @@ type Expression interface {
 	Eval(timeSeries series.GroupedIterator)
 	// ResultSet returns the eval result, returns field name(alias) => series data.
 	ResultSet() map[string]*collections.FloatArray
+	// Filter evaluates the having condition after Eval, returns if keeps the grouped time series.
+	Filter(condition stmt.Expr) bool
 	// Reset resets the Expression context for reusing.
 	Reset()
 }
@@ -52,17 +54,30 @@ type expression struct {
 	timeRange   timeutil.TimeRange
 	selectItems []stmt.Expr
 
+	resultStart int // start slot of result in time range
+	resultCount int // num. of points of result
+
 	fieldStore map[field.Name]fields.Field
 	resultSet  map[string]*collections.FloatArray // field => series
 }
 
 // NewExpression creates an Expression instance.
 func NewExpression(timeRange timeutil.TimeRange, interval int64, selectItems []stmt.Expr) Expression {
+	return NewExpressionWithResultRange(timeRange, timeRange, interval, selectItems)
+}
+
+// NewExpressionWithResultRange creates an Expression instance which evaluates the data in time range,
+// and trims the result to result time range, time range is widened for timeshift function.
+func NewExpressionWithResultRange(timeRange, resultTimeRange timeutil.TimeRange,
+	interval int64, selectItems []stmt.Expr,
+) Expression {
 	return &expression{
 		pointCount:  timeutil.CalPointCount(timeRange.Start, timeRange.End, interval) + 1,
 		interval:    interval,
 		timeRange:   timeRange,
 		selectItems: selectItems,
+		resultStart: int((resultTimeRange.Start - timeRange.Start) / interval),
+		resultCount: timeutil.CalPointCount(resultTimeRange.Start, resultTimeRange.End, interval) + 1,
 		fieldStore:  make(map[field.Name]fields.Field),
 		resultSet:   make(map[string]*collections.FloatArray),
 	}
@@ -84,9 +99,9 @@ func (e *expression) Eval(timeSeries series.GroupedIterator) {
 		values := e.eval(nil, selectItem)
 		if len(values) != 0 {
 			if item, ok := selectItem.(*stmt.SelectItem); ok && len(item.Alias) > 0 {
-				e.resultSet[item.Alias] = values[0]
+				e.resultSet[item.Alias] = e.trim(values[0])
 			} else {
-				e.resultSet[item.Rewrite()] = values[0]
+				e.resultSet[item.Rewrite()] = e.trim(values[0])
 			}
 		}
 	}
@@ -97,6 +112,110 @@ func (e *expression) ResultSet() map[string]*collections.FloatArray {
 	return e.resultSet
 }
 
+// Filter evaluates the having condition after Eval, returns if keeps the grouped time series.
+// Each side of compare expression is aggregated into one value over the whole query time range,
+// based on the function type of call expr or the default function type of field.
+func (e *expression) Filter(condition stmt.Expr) bool {
+	switch ex := condition.(type) {
+	case nil:
+		return true
+	case *stmt.ParenExpr:
+		return e.Filter(ex.Expr)
+	case *stmt.BinaryExpr:
+		switch ex.Operator {
+		case stmt.AND:
+			return e.Filter(ex.Left) && e.Filter(ex.Right)
+		case stmt.OR:
+			return e.Filter(ex.Left) || e.Filter(ex.Right)
+		}
+		left, ok := e.reduce(ex.Left)
+		if !ok {
+			return false
+		}
+		right, ok := e.reduce(ex.Right)
+		if !ok {
+			return false
+		}
+		return compare(ex.Operator, left, right)
+	default:
+		return false
+	}
+}
+
+// reduce evaluates the expr and aggregates the series data into one value.
+func (e *expression) reduce(expr stmt.Expr) (float64, bool) {
+	switch ex := expr.(type) {
+	case *stmt.NumberLiteral:
+		return ex.Val, true
+	case *stmt.ParenExpr:
+		return e.reduce(ex.Expr)
+	case *stmt.BinaryExpr:
+		if ex.Operator != stmt.ADD && ex.Operator != stmt.SUB && ex.Operator != stmt.DIV && ex.Operator != stmt.MUL {
+			return 0, false
+		}
+		left, ok := e.reduce(ex.Left)
+		if !ok {
+			return 0, false
+		}
+		right, ok := e.reduce(ex.Right)
+		if !ok {
+			return 0, false
+		}
+		return eval(ex.Operator, left, right), true
+	case *stmt.CallExpr:
+		funcType := ex.FuncType
+		if funcType == function.Count {
+			// count of each down sampling point need sum
+			funcType = function.Sum
+		}
+		return e.aggregate(e.eval(nil, ex), funcType)
+	case *stmt.FieldExpr:
+		// find select item by alias
+		for _, selectItem := range e.selectItems {
+			if item, ok := selectItem.(*stmt.SelectItem); ok && item.Alias == ex.Name {
+				return e.reduce(item.Expr)
+			}
+		}
+		fieldValues, ok := e.fieldStore[field.Name(ex.Name)]
+		if !ok {
+			return 0, false
+		}
+		return e.aggregate(fieldValues.GetDefaultValues(), fieldValues.Type().GetOrderByFunc())
+	default:
+		return 0, false
+	}
+}
+
+// aggregate aggregates the values into one value by function type.
+func (e *expression) aggregate(values []*collections.FloatArray, funcType function.FuncType) (float64, bool) {
+	if len(values) != 1 || values[0] == nil {
+		return 0, false
+	}
+	result := e.trim(values[0])
+	if result.IsEmpty() {
+		return 0, false
+	}
+	return aggregate(result.NewIterator()).getValue(funcType), true
+}
+
+// trim trims the values to result time range if time range is widened.
+func (e *expression) trim(values *collections.FloatArray) *collections.FloatArray {
+	if values == nil || (e.resultStart == 0 && e.resultCount == e.pointCount) {
+		return values
+	}
+	result := collections.NewFloatArray(e.resultCount)
+	itr := values.NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		pos := idx - e.resultStart
+		if pos >= 0 && pos < e.resultCount {
+			result.SetValue(pos, val)
+		}
+	}
+	result.SetSingle(values.IsSingle())
+	return result
+}
+
 // prepare the field store.
 func (e *expression) prepare(timeSeries series.GroupedIterator) {
 	if timeSeries == nil {
@@ -121,6 +240,8 @@ func (e *expression) eval(parentFunc *stmt.CallExpr, expr stmt.Expr) []*collecti
 		switch ex.FuncType {
 		case function.Quantile:
 			return e.quantile(ex)
+		case function.Percentile:
+			return e.percentile(ex)
 		default:
 			return e.funcCall(ex)
 		}
@@ -182,8 +303,31 @@ func (e *expression) quantile(expr *stmt.CallExpr) []*collections.FloatArray {
 	return []*collections.FloatArray{array}
 }
 
+// percentile calculates the percentile of raw sample values, e.g. percentile(field, 99).
+func (e *expression) percentile(expr *stmt.CallExpr) []*collections.FloatArray {
+	if len(expr.Params) != 2 {
+		return nil
+	}
+	p, ok := expr.Params[1].(*stmt.NumberLiteral)
+	if !ok {
+		return nil
+	}
+	samples := e.eval(expr, expr.Params[0])
+	if len(samples) == 0 {
+		return nil
+	}
+	array, err := function.PercentileCall(p.Val, samples...)
+	if err != nil || array == nil {
+		return nil
+	}
+	return []*collections.FloatArray{array}
+}
+
 // funcCall calls the function
 func (e *expression) funcCall(expr *stmt.CallExpr) []*collections.FloatArray {
+	if function.IsTransform(expr.FuncType) {
+		return e.transformCall(expr)
+	}
 	var params []*collections.FloatArray
 	for _, param := range expr.Params {
 		paramValues := e.eval(expr, param)
@@ -207,6 +351,58 @@ func (e *expression) funcCall(expr *stmt.CallExpr) []*collections.FloatArray {
 	return []*collections.FloatArray{result}
 }
 
+// transformCall evaluates the function which transforms the time series of first param,
+// e.g. derivative(f), moving_average(f, 5), clamp_min(f, 0), timeshift(f, 1d).
+func (e *expression) transformCall(expr *stmt.CallExpr) []*collections.FloatArray {
+	if len(expr.Params) == 0 {
+		return nil
+	}
+	values := e.eval(nil, expr.Params[0])
+	if len(values) != 1 {
+		return nil
+	}
+	var result *collections.FloatArray
+	switch expr.FuncType {
+	case function.Increase:
+		result = function.IncreaseCall(values[0])
+	case function.Derivative:
+		result = function.DerivativeCall(e.interval, values[0])
+	case function.Delta:
+		result = function.DeltaCall(values[0])
+	case function.Abs:
+		result = function.AbsCall(values[0])
+	case function.MovingAverage, function.ClampMin, function.ClampMax:
+		if len(expr.Params) != 2 {
+			return nil
+		}
+		arg, ok := expr.Params[1].(*stmt.NumberLiteral)
+		if !ok {
+			return nil
+		}
+		switch expr.FuncType {
+		case function.MovingAverage:
+			result = function.MovingAverageCall(values[0], int(arg.Val))
+		case function.ClampMin:
+			result = function.ClampMinCall(values[0], arg.Val)
+		default:
+			result = function.ClampMaxCall(values[0], arg.Val)
+		}
+	case function.TimeShift:
+		if len(expr.Params) != 2 {
+			return nil
+		}
+		duration, ok := expr.Params[1].(*stmt.DurationLiteral)
+		if !ok {
+			return nil
+		}
+		result = function.TimeShiftCall(e.interval, values[0], duration.Val)
+	}
+	if result == nil {
+		return nil
+	}
+	return []*collections.FloatArray{result}
+}
+
 // binaryEval evaluates binary operator
 func (e *expression) binaryEval(expr *stmt.BinaryExpr) []*collections.FloatArray {
 	binaryOP := expr.Operator
@@ -233,3 +429,47 @@ func (e *expression) Reset() {
 	}
 	e.resultSet = make(map[string]*collections.FloatArray)
 }
+
+// EvalJoinedExpr evaluates the math expression(binary/paren/number/field) with the field values,
+// which are joined from multiple metrics by group by tag values and time slot.
+// Returns nil if expression cannot be evaluated.
+func EvalJoinedExpr(expr stmt.Expr, pointCount int, fieldValues map[string]*collections.FloatArray) *collections.FloatArray {
+	switch ex := expr.(type) {
+	case *stmt.SelectItem:
+		return EvalJoinedExpr(ex.Expr, pointCount, fieldValues)
+	case *stmt.ParenExpr:
+		return EvalJoinedExpr(ex.Expr, pointCount, fieldValues)
+	case *stmt.FieldExpr:
+		return fieldValues[ex.Name]
+	case *stmt.NumberLiteral:
+		values := collections.NewFloatArray(pointCount)
+		for i := 0; i < pointCount; i++ {
+			values.SetValue(i, ex.Val)
+		}
+		values.SetSingle(true)
+		return values
+	case *stmt.BinaryExpr:
+		if ex.Operator != stmt.ADD && ex.Operator != stmt.SUB && ex.Operator != stmt.DIV && ex.Operator != stmt.MUL {
+			return nil
+		}
+		left := EvalJoinedExpr(ex.Left, pointCount, fieldValues)
+		if left == nil {
+			return nil
+		}
+		right := EvalJoinedExpr(ex.Right, pointCount, fieldValues)
+		if right == nil {
+			return nil
+		}
+		// only evaluates the time slot which both sides have value(inner join)
+		result := collections.NewFloatArray(pointCount)
+		result.SetSingle(left.IsSingle() && right.IsSingle())
+		for i := 0; i < pointCount; i++ {
+			if left.HasValue(i) && right.HasValue(i) {
+				result.SetValue(i, eval(ex.Operator, left.GetValue(i), right.GetValue(i)))
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/field"
@@ -340,6 +341,148 @@ func TestExpression_FuncCall_Rate(t *testing.T) {
 	assert.Equal(t, 50.0/60, value.GetValue(50-10))
 }
 
+func TestExpression_FuncCall_Percentile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aggSpec := NewAggregatorSpec("f1", field.LastField)
+	aggSpec.AddFunctionType(function.Percentile)
+	agg := NewFieldAggregator(aggSpec, familyTime, 0, 59)
+	for i := 1; i <= 100; i++ {
+		agg.AggregateBySlot(50, float64(i))
+	}
+	agg.AggregateBySlot(51, 10)
+	// marshal/unmarshal like storage => broker
+	_, fieldIt := agg.ResultSet()
+	data, err := fieldIt.MarshalBinary()
+	assert.NoError(t, err)
+
+	series1 := series.NewMockIterator(ctrl)
+	series1.EXPECT().FieldType().Return(field.LastField)
+	series1.EXPECT().FieldName().Return(field.Name("f1"))
+	series1.EXPECT().HasNext().Return(true)
+	series1.EXPECT().Next().Return(familyTime, series.NewFieldIterator(data))
+	series1.EXPECT().HasNext().Return(false)
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+
+	q, _ := sql.Parse("select percentile(f1, 99), percentile(f1, 50) as p50 from cpu")
+	query := q.(*stmt.Query)
+	exp := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + timeutil.OneHour*2,
+	}, timeutil.OneMinute, query.SelectItems)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(series1),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	exp.Eval(timeSeries)
+	resultSet := exp.ResultSet()
+	assert.Equal(t, 2, len(resultSet))
+
+	value := resultSet["percentile(f1,99.00)"]
+	assert.Equal(t, 2, value.Size())
+	assert.InDelta(t, 99.01, value.GetValue(50-10), 0.000001)
+	assert.Equal(t, 10.0, value.GetValue(51-10))
+	value = resultSet["p50"]
+	assert.Equal(t, 50.5, value.GetValue(50-10))
+
+	// invalid params
+	e := exp.(*expression)
+	for _, selectItem := range []stmt.Expr{
+		&stmt.CallExpr{FuncType: function.Percentile, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f1"}}},
+		&stmt.CallExpr{FuncType: function.Percentile, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f1"}, &stmt.FieldExpr{Name: "f1"}}},
+		&stmt.CallExpr{FuncType: function.Percentile, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f2"}, &stmt.NumberLiteral{Val: 99}}},
+		&stmt.CallExpr{FuncType: function.Percentile, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f1"}, &stmt.NumberLiteral{Val: 199}}},
+	} {
+		assert.Nil(t, e.eval(nil, selectItem))
+	}
+}
+
+func TestExpression_FuncCall_Transform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+	q, _ := sql.Parse("select derivative(f1), increase(f1), delta(f1), moving_average(f1, 2), " +
+		"abs(f1), clamp_min(f1, 10), clamp_max(f1, 10), timeshift(f1, 2m) as shift from cpu")
+	query := q.(*stmt.Query)
+	exp := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + timeutil.OneHour*2,
+	}, timeutil.OneMinute, query.SelectItems)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(mockTimeSeries(ctrl, familyTime, "f1", field.SumField, field.Sum)),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	exp.Eval(timeSeries)
+	resultSet := exp.ResultSet()
+	assert.Len(t, resultSet, 8)
+	// values: 50.0 at pos 40
+	assert.Equal(t, 0, resultSet["derivative(f1)"].Size())
+	assert.Equal(t, 0, resultSet["increase(f1)"].Size())
+	assert.Equal(t, 0, resultSet["delta(f1)"].Size())
+	assert.Equal(t, 50.0, resultSet["moving_average(f1,2.00)"].GetValue(40))
+	assert.Equal(t, 50.0, resultSet["abs(f1)"].GetValue(40))
+	assert.Equal(t, 50.0, resultSet["clamp_min(f1,10.00)"].GetValue(40))
+	assert.Equal(t, 10.0, resultSet["clamp_max(f1,10.00)"].GetValue(40))
+	assert.Equal(t, 50.0, resultSet["shift"].GetValue(42))
+	assert.False(t, resultSet["shift"].HasValue(40))
+
+	// invalid params
+	e := exp.(*expression)
+	for _, selectItem := range []stmt.Expr{
+		&stmt.CallExpr{FuncType: function.Abs},
+		&stmt.CallExpr{FuncType: function.Abs, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f2"}}},
+		&stmt.CallExpr{FuncType: function.ClampMin, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f1"}}},
+		&stmt.CallExpr{FuncType: function.ClampMin, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f1"}, &stmt.FieldExpr{Name: "f1"}}},
+		&stmt.CallExpr{FuncType: function.MovingAverage, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f1"}, &stmt.NumberLiteral{}}},
+		&stmt.CallExpr{FuncType: function.TimeShift, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f1"}}},
+		&stmt.CallExpr{FuncType: function.TimeShift, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f1"}, &stmt.NumberLiteral{}}},
+	} {
+		assert.Nil(t, e.eval(nil, selectItem))
+	}
+}
+
+func TestExpression_TimeShift_WidenedTimeRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	q, _ := sql.Parse("select f1, timeshift(f1, 1d) as shift from cpu")
+	query := q.(*stmt.Query)
+	// query 1h data, time range is widened by timeshift duration(1d) which is longer than query time range
+	exp := NewExpressionWithResultRange(timeutil.TimeRange{
+		Start: now,
+		End:   now + timeutil.OneDay + timeutil.OneHour,
+	}, timeutil.TimeRange{
+		Start: now + timeutil.OneDay,
+		End:   now + timeutil.OneDay + timeutil.OneHour,
+	}, timeutil.OneMinute, query.SelectItems)
+	timeSeries := series.NewMockGroupedIterator(ctrl)
+	gomock.InOrder(
+		timeSeries.EXPECT().HasNext().Return(true),
+		timeSeries.EXPECT().Next().Return(mockTimeSeries(ctrl, familyTime, "f1", field.SumField, field.Sum)),
+		timeSeries.EXPECT().HasNext().Return(false),
+	)
+	exp.Eval(timeSeries)
+	resultSet := exp.ResultSet()
+	assert.Len(t, resultSet, 2)
+	// values: 50.0 at pos 40 of widened time range, out of result time range
+	assert.Equal(t, 61, resultSet["f1"].Capacity())
+	assert.True(t, resultSet["f1"].IsEmpty())
+	// shifted into result time range
+	assert.Equal(t, 61, resultSet["shift"].Capacity())
+	assert.Equal(t, 1, resultSet["shift"].Size())
+	assert.Equal(t, 50.0, resultSet["shift"].GetValue(40))
+	// having is evaluated in result time range, f1 has no data
+	assert.False(t, exp.Filter(&stmt.BinaryExpr{
+		Left:     &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f1"}}},
+		Operator: stmt.GREATER,
+		Right:    &stmt.NumberLiteral{Val: 0},
+	}))
+}
+
 func TestExpression_NotSupport_Expr(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -366,3 +509,104 @@ func TestExpression_NotSupport_Expr(t *testing.T) {
 	resultSet = expression.ResultSet()
 	assert.Equal(t, 0, len(resultSet))
 }
+
+func TestExpression_Filter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cases := []struct {
+		sql  string
+		keep bool
+	}{
+		{sql: "select sum(f1) from cpu group by host", keep: true},
+		{sql: "select sum(f1) from cpu group by host having sum(f1) > 10", keep: true},
+		{sql: "select sum(f1) from cpu group by host having sum(f1) < 10", keep: false},
+		{sql: "select sum(f1) from cpu group by host having sum(f1) >= 50 and f1 = 50", keep: true},
+		{sql: "select sum(f1) from cpu group by host having (sum(f1) != 50 or f1 <= 50)", keep: true},
+		{sql: "select sum(f1) as s from cpu group by host having s > 40", keep: true},
+		{sql: "select sum(f1) from cpu group by host having (sum(f1)+1)/2 = 25.5", keep: true},
+		{sql: "select sum(f1) from cpu group by host having sum(f1)-avg(f1) > 0", keep: false},
+		{sql: "select sum(f1) from cpu group by host having f2 > 0", keep: false},
+		{sql: "select sum(f1) from cpu group by host having 1 > sum(f2)", keep: false},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.sql, func(t *testing.T) {
+			q, err := sql.Parse(tt.sql)
+			assert.NoError(t, err)
+			query := q.(*stmt.Query)
+			timeSeries := series.NewMockGroupedIterator(ctrl)
+			gomock.InOrder(
+				timeSeries.EXPECT().HasNext().Return(true),
+				timeSeries.EXPECT().Next().Return(mockTimeSeries(ctrl, familyTime, "f1", field.SumField, field.Sum)),
+				timeSeries.EXPECT().HasNext().Return(false),
+			)
+			expression := NewExpression(timeutil.TimeRange{
+				Start: now,
+				End:   now + timeutil.OneHour*2,
+			}, timeutil.OneMinute, query.SelectItems)
+			expression.Eval(timeSeries)
+			assert.Equal(t, tt.keep, expression.Filter(query.Having))
+		})
+	}
+
+	// not support expr
+	expression := NewExpression(timeutil.TimeRange{
+		Start: now,
+		End:   now + timeutil.OneHour*2,
+	}, timeutil.OneMinute, nil)
+	assert.False(t, expression.Filter(&stmt.EqualsExpr{}))
+	assert.False(t, expression.Filter(&stmt.BinaryExpr{
+		Left:     &stmt.NumberLiteral{Val: 1},
+		Operator: stmt.GREATER,
+		Right:    &stmt.BinaryExpr{Left: &stmt.NumberLiteral{Val: 1}, Operator: stmt.AND, Right: &stmt.NumberLiteral{Val: 1}},
+	}))
+	assert.False(t, expression.Filter(&stmt.BinaryExpr{
+		Left:     &stmt.NumberLiteral{Val: 1},
+		Operator: stmt.UNKNOWN,
+		Right:    &stmt.NumberLiteral{Val: 1},
+	}))
+}
+
+func TestEvalJoinedExpr(t *testing.T) {
+	errorValues := collections.NewFloatArray(3)
+	errorValues.SetValue(0, 1)
+	errorValues.SetValue(1, 2)
+	requests := collections.NewFloatArray(3)
+	requests.SetValue(0, 10)
+	requests.SetValue(2, 20)
+	fieldValues := map[string]*collections.FloatArray{"e": errorValues, "r": requests}
+
+	// (e/r)*100
+	result := EvalJoinedExpr(&stmt.SelectItem{Expr: &stmt.BinaryExpr{
+		Left: &stmt.ParenExpr{Expr: &stmt.BinaryExpr{
+			Left:     &stmt.FieldExpr{Name: "e"},
+			Operator: stmt.DIV,
+			Right:    &stmt.FieldExpr{Name: "r"},
+		}},
+		Operator: stmt.MUL,
+		Right:    &stmt.NumberLiteral{Val: 100},
+	}}, 3, fieldValues)
+	assert.Equal(t, 1, result.Size())
+	assert.Equal(t, 10.0, result.GetValue(0))
+	assert.False(t, result.IsSingle())
+
+	// field not exist
+	assert.Nil(t, EvalJoinedExpr(&stmt.BinaryExpr{
+		Left:     &stmt.FieldExpr{Name: "e"},
+		Operator: stmt.ADD,
+		Right:    &stmt.FieldExpr{Name: "x"},
+	}, 3, fieldValues))
+	assert.Nil(t, EvalJoinedExpr(&stmt.BinaryExpr{
+		Left:     &stmt.FieldExpr{Name: "x"},
+		Operator: stmt.ADD,
+		Right:    &stmt.FieldExpr{Name: "e"},
+	}, 3, fieldValues))
+	// not support
+	assert.Nil(t, EvalJoinedExpr(&stmt.BinaryExpr{
+		Left:     &stmt.FieldExpr{Name: "e"},
+		Operator: stmt.AND,
+		Right:    &stmt.FieldExpr{Name: "r"},
+	}, 3, fieldValues))
+	assert.Nil(t, EvalJoinedExpr(&stmt.CallExpr{FuncType: function.Sum}, 3, fieldValues))
+}
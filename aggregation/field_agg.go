@@ -40,10 +40,13 @@ type FieldAggregator interface {
 }
 
 // fieldAggregator implements field aggregator interface, aggregator field series based on aggregator spec.
+// if it needs raw sample values(e.g. percentile function), keeps the values as multi sample layers,
+// the first sample layer is in place of field.Sample agg type, others are appended after all agg types.
 type fieldAggregator struct {
 	aggTypes         []field.AggType
 	segmentStartTime int64
 	start, end       int
+	numOfAggTypes    int
 
 	fieldSeriesList []*collections.FloatArray
 }
@@ -64,6 +67,7 @@ func NewFieldAggregator(aggSpec AggregatorSpec, segmentStartTime int64, start, e
 		segmentStartTime: segmentStartTime,
 		start:            start,
 		end:              end,
+		numOfAggTypes:    len(aggTypes),
 		fieldSeriesList:  make([]*collections.FloatArray, len(aggTypes)),
 	}
 	return agg
@@ -78,21 +82,39 @@ func (a *fieldAggregator) ResultSet() (startTime int64, it series.FieldIterator)
 func (a *fieldAggregator) Aggregate(it series.FieldIterator) {
 	for it.HasNext() {
 		pIt := it.Next()
+		sample := pIt.AggType() == field.Sample
 		for pIt.HasNext() {
 			slot, value := pIt.Next()
-			a.AggregateBySlot(slot, value)
+			if sample {
+				// raw sample values only merge into sample layers
+				a.addSample(slot, value)
+			} else {
+				a.aggregateBySlot(slot, value, false)
+			}
 		}
 	}
 }
 
 // AggregateBySlot aggregates the field series into current aggregator
 func (a *fieldAggregator) AggregateBySlot(slot int, value float64) {
+	a.aggregateBySlot(slot, value, true)
+}
+
+// aggregateBySlot aggregates the value into current aggregator, adds value into sample layers if withSample is true.
+func (a *fieldAggregator) aggregateBySlot(slot int, value float64, withSample bool) {
 	// drop inf value
 	if math.IsInf(value, 1) {
 		return
 	}
 	pos := slot - a.start
-	for idx, aggType := range a.aggTypes {
+	for idx := 0; idx < a.numOfAggTypes; idx++ {
+		aggType := a.aggTypes[idx]
+		if aggType == field.Sample {
+			if withSample {
+				a.addSample(slot, value)
+			}
+			continue
+		}
 		values := a.fieldSeriesList[idx]
 		if values == nil {
 			values = collections.NewFloatArray(a.end - a.start + 1)
@@ -109,6 +131,52 @@ func (a *fieldAggregator) AggregateBySlot(slot int, value float64) {
 	}
 }
 
+// addSample adds the raw value into the first sample layer which has no value at the slot,
+// if all sample layers have value, appends a new sample layer.
+func (a *fieldAggregator) addSample(slot int, value float64) {
+	if math.IsInf(value, 1) {
+		return
+	}
+	pos := slot - a.start
+	sampleIdx := -1
+	for idx := 0; idx < a.numOfAggTypes; idx++ {
+		if a.aggTypes[idx] == field.Sample {
+			sampleIdx = idx
+			break
+		}
+	}
+	if sampleIdx < 0 {
+		// not need sample values
+		return
+	}
+	if a.setSample(sampleIdx, pos, value) {
+		return
+	}
+	for idx := a.numOfAggTypes; idx < len(a.fieldSeriesList); idx++ {
+		if a.setSample(idx, pos, value) {
+			return
+		}
+	}
+	values := collections.NewFloatArray(a.end - a.start + 1)
+	values.SetValue(pos, value)
+	a.aggTypes = append(a.aggTypes, field.Sample)
+	a.fieldSeriesList = append(a.fieldSeriesList, values)
+}
+
+// setSample sets the value into sample layer at the index, returns false if the layer has value at the pos.
+func (a *fieldAggregator) setSample(idx, pos int, value float64) bool {
+	values := a.fieldSeriesList[idx]
+	if values == nil {
+		values = collections.NewFloatArray(a.end - a.start + 1)
+		a.fieldSeriesList[idx] = values
+	}
+	if values.HasValue(pos) {
+		return false
+	}
+	values.SetValue(pos, value)
+	return true
+}
+
 // reset aggregator context for reusing.
 func (a *fieldAggregator) reset() {
 	for idx := range a.fieldSeriesList {
@@ -47,6 +47,7 @@ func TestFieldAggregator_Aggregate(t *testing.T) {
 	it.EXPECT().HasNext().Return(false)
 	pIt := series.NewMockPrimitiveIterator(ctrl)
 	it.EXPECT().Next().Return(pIt)
+	pIt.EXPECT().AggType().Return(field.Sum)
 	pIt.EXPECT().HasNext().Return(true)
 	pIt.EXPECT().Next().Return(20, 10.0)
 	pIt.EXPECT().HasNext().Return(false)
@@ -58,3 +59,62 @@ func TestFieldAggregator_Aggregate(t *testing.T) {
 
 	agg.reset()
 }
+
+func TestFieldAggregator_Sample(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	aggSpec := NewAggregatorSpec("f", field.LastField)
+	aggSpec.AddFunctionType(function.Percentile)
+	aggSpec.AddFunctionType(function.Max)
+
+	agg := NewFieldAggregator(aggSpec, 1, 10, 20)
+	agg.AggregateBySlot(11, math.Inf(1))
+	agg.AggregateBySlot(11, 1.0)
+	agg.AggregateBySlot(11, 3.0)
+	agg.AggregateBySlot(12, 2.0)
+
+	it := series.NewMockFieldIterator(ctrl)
+	pIt := series.NewMockPrimitiveIterator(ctrl)
+	gomock.InOrder(
+		it.EXPECT().HasNext().Return(true),
+		it.EXPECT().Next().Return(pIt),
+		pIt.EXPECT().AggType().Return(field.Sample),
+		pIt.EXPECT().HasNext().Return(true),
+		pIt.EXPECT().Next().Return(11, 5.0),
+		pIt.EXPECT().HasNext().Return(false),
+		it.EXPECT().HasNext().Return(true),
+		it.EXPECT().Next().Return(pIt),
+		pIt.EXPECT().AggType().Return(field.Max),
+		pIt.EXPECT().HasNext().Return(true),
+		pIt.EXPECT().Next().Return(12, 10.0),
+		pIt.EXPECT().HasNext().Return(false),
+		it.EXPECT().HasNext().Return(false),
+	)
+	agg.Aggregate(it)
+
+	_, rs := agg.ResultSet()
+	maxValues := make(map[int]float64)
+	samples := make(map[int][]float64)
+	for rs.HasNext() {
+		primitiveIt := rs.Next()
+		aggType := primitiveIt.AggType()
+		for primitiveIt.HasNext() {
+			slot, value := primitiveIt.Next()
+			switch aggType {
+			case field.Max:
+				maxValues[slot] = value
+			case field.Sample:
+				samples[slot] = append(samples[slot], value)
+			}
+		}
+	}
+	assert.Equal(t, map[int]float64{11: 3.0, 12: 10.0}, maxValues)
+	assert.Equal(t, map[int][]float64{11: {1.0, 3.0, 5.0}, 12: {2.0}}, samples)
+
+	agg.reset()
+	_, rs = agg.ResultSet()
+	for rs.HasNext() {
+		assert.False(t, rs.Next().HasNext())
+	}
+}
@@ -47,7 +47,8 @@ type dynamicField struct {
 	interval  int64
 	capacity  int
 
-	fields map[field.AggType]*collections.FloatArray
+	fields  map[field.AggType]*collections.FloatArray
+	samples []*collections.FloatArray // raw sample values, one layer holds at most one value for each time slot
 }
 
 // NewDynamicField creates a dynamic field series.
@@ -81,6 +82,13 @@ func (f *dynamicField) SetValue(fieldSeries series.Iterator) {
 		for it.HasNext() {
 			pIt := it.Next()
 			aggType := pIt.AggType()
+			if aggType == field.Sample {
+				for pIt.HasNext() {
+					slot, val := pIt.Next()
+					f.addSample(int(((int64(slot)*f.interval+startTime)-f.startTime)/f.interval), val)
+				}
+				continue
+			}
 			fieldValues, ok = f.fields[aggType]
 			if !ok {
 				fieldValues = collections.NewFloatArray(f.capacity)
@@ -110,6 +118,22 @@ func (f *dynamicField) Reset() {
 	for _, pField := range f.fields {
 		pField.Reset()
 	}
+	for _, sample := range f.samples {
+		sample.Reset()
+	}
+}
+
+// addSample adds the raw value into the first sample layer which has no value at the idx.
+func (f *dynamicField) addSample(idx int, val float64) {
+	for _, sample := range f.samples {
+		if !sample.HasValue(idx) {
+			sample.SetValue(idx, val)
+			return
+		}
+	}
+	sample := collections.NewFloatArray(f.capacity)
+	sample.SetValue(idx, val)
+	f.samples = append(f.samples, sample)
 }
 
 // getFieldValues returns the values by field name and agg type.
@@ -118,6 +142,10 @@ func (f *dynamicField) getFieldValues(aggTypes []field.AggType) (result []*colle
 		return
 	}
 	for _, aggType := range aggTypes {
+		if aggType == field.Sample {
+			result = append(result, f.samples...)
+			continue
+		}
 		if pField, ok := f.fields[aggType]; ok {
 			result = append(result, pField)
 		}
@@ -64,6 +64,46 @@ func TestDynamicField_UnknownType(t *testing.T) {
 	assert.Nil(t, values)
 }
 
+func TestDynamicField_Sample(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fIt := series.NewMockIterator(ctrl)
+	it := series.NewMockFieldIterator(ctrl)
+	primitiveIt := series.NewMockPrimitiveIterator(ctrl)
+	fIt.EXPECT().HasNext().Return(true)
+	fIt.EXPECT().Next().Return(int64(10), it)
+	fIt.EXPECT().HasNext().Return(false)
+	it.EXPECT().HasNext().Return(true).Times(2)
+	it.EXPECT().Next().Return(primitiveIt).Times(2)
+	it.EXPECT().HasNext().Return(false)
+	primitiveIt.EXPECT().AggType().Return(field.Sample).Times(2)
+	gomock.InOrder(
+		primitiveIt.EXPECT().HasNext().Return(true),
+		primitiveIt.EXPECT().Next().Return(4, 1.1),
+		primitiveIt.EXPECT().HasNext().Return(true),
+		primitiveIt.EXPECT().Next().Return(5, 2.1),
+		primitiveIt.EXPECT().HasNext().Return(false),
+		primitiveIt.EXPECT().HasNext().Return(true),
+		primitiveIt.EXPECT().Next().Return(4, 3.1),
+		primitiveIt.EXPECT().HasNext().Return(false),
+	)
+
+	f := NewDynamicField(field.LastField, 10, 10, 10)
+	f.SetValue(fIt)
+	values := f.GetValues(function.Percentile)
+	assert.Len(t, values, 2)
+	assert.Equal(t, 1.1, values[0].GetValue(4))
+	assert.Equal(t, 2.1, values[0].GetValue(5))
+	assert.Equal(t, 3.1, values[1].GetValue(4))
+	assert.False(t, values[1].HasValue(5))
+	assert.Empty(t, f.GetDefaultValues())
+
+	f.Reset()
+	values = f.GetValues(function.Percentile)
+	assert.Equal(t, 0, values[0].Size())
+}
+
 // mockSingleIterator returns mock an iterator of single field
 func mockSingleIterator(ctrl *gomock.Controller) series.Iterator {
 	fIt := series.NewMockIterator(ctrl)
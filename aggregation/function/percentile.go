@@ -0,0 +1,67 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+// PercentileCall calculates the percentile of raw sample values for each time slot,
+// samples are stored as multi layers, one layer holds at most one value for each time slot.
+// 0 < p <= 100, using linear interpolation between the closest ranks.
+func PercentileCall(p float64, samples ...*collections.FloatArray) (*collections.FloatArray, error) {
+	if p <= 0 || p > 100 {
+		return nil, fmt.Errorf("PercentileCall with illegal value: %f", p)
+	}
+	if len(samples) == 0 {
+		return nil, nil
+	}
+	capacity := samples[0].Capacity()
+	result := collections.NewFloatArray(capacity)
+	values := make([]float64, 0, len(samples))
+	for slot := 0; slot < capacity; slot++ {
+		values = values[:0]
+		for _, layer := range samples {
+			if layer != nil && layer.HasValue(slot) {
+				values = append(values, layer.GetValue(slot))
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+		result.SetValue(slot, percentile(p, values))
+	}
+	return result, nil
+}
+
+// percentile returns the percentile of values, values will be sorted.
+func percentile(p float64, values []float64) float64 {
+	sort.Float64s(values)
+	rank := p / 100 * float64(len(values)-1)
+	lower := math.Floor(rank)
+	upper := math.Ceil(rank)
+	lowerValue := values[int(lower)]
+	if lower == upper {
+		return lowerValue
+	}
+	return lowerValue + (values[int(upper)]-lowerValue)*(rank-lower)
+}
@@ -0,0 +1,63 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+)
+
+func TestPercentileCall(t *testing.T) {
+	result, err := PercentileCall(0)
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	result, err = PercentileCall(101)
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	result, err = PercentileCall(99)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	var samples []*collections.FloatArray
+	for i := 1; i <= 10; i++ {
+		layer := collections.NewFloatArray(4)
+		layer.SetValue(0, float64(11-i))
+		if i <= 2 {
+			layer.SetValue(1, float64(i))
+		}
+		samples = append(samples, layer)
+	}
+	samples = append(samples, nil)
+	result, err = PercentileCall(50, samples...)
+	assert.NoError(t, err)
+	assert.Equal(t, 5.5, result.GetValue(0))
+	assert.Equal(t, 1.5, result.GetValue(1))
+	assert.False(t, result.HasValue(2))
+
+	result, err = PercentileCall(100, samples...)
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, result.GetValue(0))
+	assert.Equal(t, 2.0, result.GetValue(1))
+
+	result, err = PercentileCall(90, samples...)
+	assert.NoError(t, err)
+	assert.InDelta(t, 9.1, result.GetValue(0), 0.000001)
+}
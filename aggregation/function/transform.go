@@ -0,0 +1,151 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"math"
+
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// DeltaCall returns the difference between each point and its previous point.
+func DeltaCall(values *collections.FloatArray) *collections.FloatArray {
+	return diff(values, func(_ int, prev, cur float64) float64 {
+		return cur - prev
+	})
+}
+
+// IncreaseCall returns the increase between each point and its previous point,
+// if current value less than previous value, treats it as counter reset.
+func IncreaseCall(values *collections.FloatArray) *collections.FloatArray {
+	return diff(values, func(_ int, prev, cur float64) float64 {
+		if cur < prev {
+			// counter reset
+			return cur
+		}
+		return cur - prev
+	})
+}
+
+// DerivativeCall returns the per-second rate of change between each point and its previous point.
+func DerivativeCall(interval int64, values *collections.FloatArray) *collections.FloatArray {
+	seconds := float64(interval) / float64(timeutil.OneSecond)
+	if seconds <= 0 {
+		return nil
+	}
+	return diff(values, func(distance int, prev, cur float64) float64 {
+		return (cur - prev) / (float64(distance) * seconds)
+	})
+}
+
+// MovingAverageCall returns the average of the last n points(includes current point) for each point.
+func MovingAverageCall(values *collections.FloatArray, n int) *collections.FloatArray {
+	if values == nil || n <= 0 {
+		return nil
+	}
+	result := collections.NewFloatArray(values.Capacity())
+	itr := values.NewIterator()
+	for itr.HasNext() {
+		idx, _ := itr.Next()
+		sum := 0.0
+		count := 0
+		for i := idx; i > idx-n && i >= 0; i-- {
+			if values.HasValue(i) {
+				sum += values.GetValue(i)
+				count++
+			}
+		}
+		result.SetValue(idx, sum/float64(count))
+	}
+	return result
+}
+
+// AbsCall returns the absolute value of each point.
+func AbsCall(values *collections.FloatArray) *collections.FloatArray {
+	return apply(values, math.Abs)
+}
+
+// ClampMinCall returns the value of each point clamped to have a lower limit of min.
+func ClampMinCall(values *collections.FloatArray, min float64) *collections.FloatArray {
+	return apply(values, func(v float64) float64 {
+		return math.Max(v, min)
+	})
+}
+
+// ClampMaxCall returns the value of each point clamped to have an upper limit of max.
+func ClampMaxCall(values *collections.FloatArray, max float64) *collections.FloatArray {
+	return apply(values, func(v float64) float64 {
+		return math.Min(v, max)
+	})
+}
+
+// TimeShiftCall shifts the points forward by duration(backward if duration is negative),
+// points out of range are dropped, shift points count = duration / interval.
+func TimeShiftCall(interval int64, values *collections.FloatArray, duration int64) *collections.FloatArray {
+	if values == nil || interval <= 0 {
+		return nil
+	}
+	shift := int(duration / interval)
+	capacity := values.Capacity()
+	result := collections.NewFloatArray(capacity)
+	itr := values.NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		pos := idx + shift
+		if pos >= 0 && pos < capacity {
+			result.SetValue(pos, val)
+		}
+	}
+	return result
+}
+
+// diff calculates the value between each point and its previous point by fn,
+// distance is the count of slots between two points.
+func diff(values *collections.FloatArray, fn func(distance int, prev, cur float64) float64) *collections.FloatArray {
+	if values == nil {
+		return nil
+	}
+	result := collections.NewFloatArray(values.Capacity())
+	itr := values.NewIterator()
+	prevIdx := -1
+	prev := 0.0
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		if prevIdx >= 0 {
+			result.SetValue(idx, fn(idx-prevIdx, prev, val))
+		}
+		prevIdx = idx
+		prev = val
+	}
+	return result
+}
+
+// apply calculates the value of each point by fn.
+func apply(values *collections.FloatArray, fn func(v float64) float64) *collections.FloatArray {
+	if values == nil {
+		return nil
+	}
+	result := collections.NewFloatArray(values.Capacity())
+	itr := values.NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		result.SetValue(idx, fn(val))
+	}
+	return result
+}
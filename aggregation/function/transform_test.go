@@ -0,0 +1,89 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func newValues(values map[int]float64) *collections.FloatArray {
+	array := collections.NewFloatArray(10)
+	for idx, val := range values {
+		array.SetValue(idx, val)
+	}
+	return array
+}
+
+func assertValues(t *testing.T, expect map[int]float64, array *collections.FloatArray) {
+	actual := make(map[int]float64)
+	itr := array.NewIterator()
+	for itr.HasNext() {
+		idx, val := itr.Next()
+		actual[idx] = val
+	}
+	assert.Equal(t, expect, actual)
+}
+
+func TestDeltaCall(t *testing.T) {
+	assert.Nil(t, DeltaCall(nil))
+	assertValues(t, map[int]float64{2: 2, 5: -3}, DeltaCall(newValues(map[int]float64{1: 1, 2: 3, 5: 0})))
+}
+
+func TestIncreaseCall(t *testing.T) {
+	assert.Nil(t, IncreaseCall(nil))
+	assertValues(t, map[int]float64{2: 2, 5: 1, 6: 4}, IncreaseCall(newValues(map[int]float64{1: 1, 2: 3, 5: 1, 6: 5})))
+}
+
+func TestDerivativeCall(t *testing.T) {
+	assert.Nil(t, DerivativeCall(0, nil))
+	assert.Nil(t, DerivativeCall(10*timeutil.OneSecond, nil))
+	assertValues(t, map[int]float64{2: 0.2, 4: -0.15},
+		DerivativeCall(10*timeutil.OneSecond, newValues(map[int]float64{1: 1, 2: 3, 4: 0})))
+}
+
+func TestMovingAverageCall(t *testing.T) {
+	assert.Nil(t, MovingAverageCall(nil, 2))
+	assert.Nil(t, MovingAverageCall(newValues(nil), 0))
+	assertValues(t, map[int]float64{0: 1, 1: 2, 2: 4, 4: 5},
+		MovingAverageCall(newValues(map[int]float64{0: 1, 1: 3, 2: 5, 4: 5}), 2))
+}
+
+func TestAbsCall(t *testing.T) {
+	assert.Nil(t, AbsCall(nil))
+	assertValues(t, map[int]float64{0: 1, 1: 3}, AbsCall(newValues(map[int]float64{0: -1, 1: 3})))
+}
+
+func TestClampCall(t *testing.T) {
+	assert.Nil(t, ClampMinCall(nil, 0))
+	assert.Nil(t, ClampMaxCall(nil, 0))
+	assertValues(t, map[int]float64{0: 0, 1: 3}, ClampMinCall(newValues(map[int]float64{0: -1, 1: 3}), 0))
+	assertValues(t, map[int]float64{0: -1, 1: 2}, ClampMaxCall(newValues(map[int]float64{0: -1, 1: 3}), 2))
+}
+
+func TestTimeShiftCall(t *testing.T) {
+	assert.Nil(t, TimeShiftCall(timeutil.OneMinute, nil, timeutil.OneHour))
+	assert.Nil(t, TimeShiftCall(0, newValues(nil), timeutil.OneHour))
+	values := newValues(map[int]float64{0: 1, 1: 2, 8: 3})
+	assertValues(t, map[int]float64{2: 1, 3: 2}, TimeShiftCall(timeutil.OneMinute, values, 2*timeutil.OneMinute))
+	assertValues(t, map[int]float64{0: 2, 7: 3}, TimeShiftCall(timeutil.OneMinute, values, -timeutil.OneMinute))
+}
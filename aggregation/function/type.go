@@ -32,6 +32,15 @@ const (
 	Quantile
 	Stddev
 	Rate
+	Percentile
+	Increase
+	Derivative
+	Delta
+	MovingAverage
+	Abs
+	ClampMin
+	ClampMax
+	TimeShift
 )
 
 // String return the function's name
@@ -57,6 +66,24 @@ func (t FuncType) String() string {
 		return "stddev"
 	case Rate:
 		return "rate"
+	case Percentile:
+		return "percentile"
+	case Increase:
+		return "increase"
+	case Derivative:
+		return "derivative"
+	case Delta:
+		return "delta"
+	case MovingAverage:
+		return "moving_average"
+	case Abs:
+		return "abs"
+	case ClampMin:
+		return "clamp_min"
+	case ClampMax:
+		return "clamp_max"
+	case TimeShift:
+		return "timeshift"
 	default:
 		return "unknown"
 	}
@@ -66,3 +93,14 @@ func (t FuncType) String() string {
 func IsSupportOrderBy(t FuncType) bool {
 	return t == Sum || t == Min || t == Max || t == Count || t == Avg || t == Last || t == First || t == Stddev
 }
+
+// IsTransform checks if function transforms the time series which evaluated by first param,
+// other params are number/duration arguments.
+func IsTransform(t FuncType) bool {
+	switch t {
+	case Increase, Derivative, Delta, MovingAverage, Abs, ClampMin, ClampMax, TimeShift:
+		return true
+	default:
+		return false
+	}
+}
@@ -34,6 +34,15 @@ func TestFuncTypeString(t *testing.T) {
 	assert.Equal(t, "quantile", Quantile.String())
 	assert.Equal(t, "stddev", Stddev.String())
 	assert.Equal(t, "rate", Rate.String())
+	assert.Equal(t, "percentile", Percentile.String())
+	assert.Equal(t, "increase", Increase.String())
+	assert.Equal(t, "derivative", Derivative.String())
+	assert.Equal(t, "delta", Delta.String())
+	assert.Equal(t, "moving_average", MovingAverage.String())
+	assert.Equal(t, "abs", Abs.String())
+	assert.Equal(t, "clamp_min", ClampMin.String())
+	assert.Equal(t, "clamp_max", ClampMax.String())
+	assert.Equal(t, "timeshift", TimeShift.String())
 	assert.Equal(t, "unknown", Unknown.String())
 }
 
@@ -42,3 +51,11 @@ func TestIsSupportOrderBy(t *testing.T) {
 	assert.False(t, IsSupportOrderBy(Quantile))
 	assert.False(t, IsSupportOrderBy(Unknown))
 }
+
+func TestIsTransform(t *testing.T) {
+	for _, f := range []FuncType{Increase, Derivative, Delta, MovingAverage, Abs, ClampMin, ClampMax, TimeShift} {
+		assert.True(t, IsTransform(f))
+	}
+	assert.False(t, IsTransform(Sum))
+	assert.False(t, IsTransform(Rate))
+}
@@ -30,6 +30,29 @@ type aggResult struct {
 	min, max, sum, last, first, avg, stddev float64
 }
 
+// getValue returns the value of aggregation based on function type.
+func (r *aggResult) getValue(funcType function.FuncType) float64 {
+	switch funcType {
+	case function.Count:
+		return float64(r.count)
+	case function.Sum:
+		return r.sum
+	case function.Max:
+		return r.max
+	case function.Min:
+		return r.min
+	case function.First:
+		return r.first
+	case function.Last:
+		return r.last
+	case function.Avg:
+		return r.avg
+	case function.Stddev:
+		return r.stddev
+	}
+	return 0.0
+}
+
 // OrderByRow represents row for order by, implements Row interface.
 type OrderByRow struct {
 	tags   string
@@ -48,8 +71,8 @@ func NewOrderByRow(tags string, fields map[string]*collections.FloatArray) Row {
 	}
 }
 
-// aggregate returns the value by given function type for this series data.
-func (r *OrderByRow) aggregate(it *collections.FloatArrayIterator) *aggResult {
+// aggregate aggregates the series data, returns the result of all aggregation functions.
+func aggregate(it *collections.FloatArrayIterator) *aggResult {
 	var count int
 	var min, max, sum, last, first, avg, stddev float64
 	// stddev
@@ -112,29 +135,10 @@ func (r *OrderByRow) GetValue(fieldName string, funcType function.FuncType) floa
 		if field == nil {
 			return 0.0
 		}
-		val = r.aggregate(field.NewIterator())
+		val = aggregate(field.NewIterator())
 		r.points[fieldName] = val
 	}
-	// return val base function type
-	switch funcType {
-	case function.Count:
-		return float64(val.count)
-	case function.Sum:
-		return val.sum
-	case function.Max:
-		return val.max
-	case function.Min:
-		return val.min
-	case function.First:
-		return val.first
-	case function.Last:
-		return val.last
-	case function.Avg:
-		return val.avg
-	case function.Stddev:
-		return val.stddev
-	}
-	return 0.0
+	return val.getValue(funcType)
 }
 
 // ResultSet returns the resutl set of series(tags/fields).
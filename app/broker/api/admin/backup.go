@@ -0,0 +1,367 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/archive"
+	"github.com/lindb/lindb/pkg/encoding"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// for testing
+var (
+	doRequestFn = (&http.Client{Transport: httppkg.ClientTransport()}).Do
+)
+
+var (
+	BackupPath         = "/database/backup"
+	RestorePath        = "/database/restore"
+	RestorePlanPath    = "/database/restore/plan"
+	RestoreNodePath    = "/database/restore/node"
+	storageBackupPath  = constants.APIVersion1CliPath + "/database/backup"
+	storageRestorePath = constants.APIVersion1CliPath + "/database/restore"
+)
+
+// BackupAPI represents database snapshot backup/restore admin rest api.
+type BackupAPI struct {
+	deps   *depspkg.HTTPDeps
+	logger *logger.Logger
+}
+
+// NewBackupAPI creates database backup api instance.
+func NewBackupAPI(deps *depspkg.HTTPDeps) *BackupAPI {
+	return &BackupAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "BackupAPI"),
+	}
+}
+
+// Register adds database backup/restore admin url route.
+func (api *BackupAPI) Register(route gin.IRoutes) {
+	route.GET(BackupPath, api.Backup)
+	route.POST(RestorePlanPath, api.Plan)
+	route.PUT(RestoreNodePath, api.RestoreNode)
+	route.POST(RestorePath, api.Restore)
+}
+
+// Backup takes a point-in-time snapshot of database from all storage nodes which host the shards,
+// then streams them as tar archive(nodes/{node id}/...), the manifest is the last entry of archive,
+// so the archive without manifest is incomplete.
+func (api *BackupAPI) Backup(c *gin.Context) {
+	var param struct {
+		Database string `form:"db" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	manifest, nodes, err := api.prepareBackup(param.Database)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	c.Header("Content-Type", "application/x-tar")
+	c.Status(http.StatusOK)
+	tw := tar.NewWriter(c.Writer)
+	for _, node := range nodes {
+		backupNode, err0 := api.backupNode(c.Request.Context(), tw, param.Database, node)
+		if err0 != nil {
+			api.logger.Error("backup database from storage node failure",
+				logger.String("database", param.Database), logger.Any("node", node.ID), logger.Error(err0))
+			return
+		}
+		manifest.Nodes = append(manifest.Nodes, backupNode)
+	}
+	if err = archive.WriteBytes(tw, models.BackupManifestFile, encoding.JSONMarshal(manifest)); err == nil {
+		err = tw.Close()
+	}
+	if err != nil {
+		api.logger.Error("write backup manifest failure",
+			logger.String("database", param.Database), logger.Error(err))
+		return
+	}
+	api.logger.Info("backup database successfully",
+		logger.String("database", param.Database), logger.Int("nodes", len(nodes)))
+}
+
+// Plan creates the plan of restoring backup into storage cluster, database cannot exist.
+func (api *BackupAPI) Plan(c *gin.Context) {
+	var param struct {
+		Storage  string                 `json:"storage"`
+		Manifest *models.BackupManifest `json:"manifest" binding:"required"`
+	}
+	if err := c.ShouldBind(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if param.Manifest.Version != models.BackupVersion || param.Manifest.Database == nil {
+		httppkg.Error(c, fmt.Errorf("backup manifest is invalid, version: %d", param.Manifest.Version))
+		return
+	}
+	if err := api.checkDatabaseNotExist(param.Manifest.Database.Name); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	storageName := param.Storage
+	if storageName == "" {
+		storageName = param.Manifest.Database.Storage
+	}
+	storage, ok := api.deps.StateMgr.GetStorage(storageName)
+	if !ok {
+		httppkg.Error(c, fmt.Errorf("storage %s not found", storageName))
+		return
+	}
+	liveNodes := make([]models.NodeID, 0, len(storage.LiveNodes))
+	for nodeID := range storage.LiveNodes {
+		liveNodes = append(liveNodes, nodeID)
+	}
+	plan, err := models.NewRestorePlan(param.Manifest, storageName, liveNodes)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, plan)
+}
+
+// RestoreNode forwards the snapshot of source node(tar archive) to target storage node.
+func (api *BackupAPI) RestoreNode(c *gin.Context) {
+	var param struct {
+		Database string        `form:"db" binding:"required"`
+		Storage  string        `form:"storage" binding:"required"`
+		NodeID   models.NodeID `form:"node"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	storage, ok := api.deps.StateMgr.GetStorage(param.Storage)
+	if !ok {
+		httppkg.Error(c, fmt.Errorf("storage %s not found", param.Storage))
+		return
+	}
+	node, ok := storage.LiveNodes[param.NodeID]
+	if !ok {
+		httppkg.Error(c, fmt.Errorf("storage node %d not alive", param.NodeID))
+		return
+	}
+	resp, err := api.doRequest(c.Request.Context(), http.MethodPost,
+		node.HTTPAddress()+storageRestorePath+"?db="+url.QueryEscape(param.Database), c.Request.Body)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	_ = resp.Body.Close()
+	api.logger.Info("restore database snapshot into storage node successfully",
+		logger.String("database", param.Database), logger.Any("node", param.NodeID))
+	httppkg.OK(c, "ok")
+}
+
+// Restore commits the restore plan after all snapshots restored, saves the rebuilt shard assignment
+// then the database config, master assigns the shards to storage nodes which load the restored data.
+func (api *BackupAPI) Restore(c *gin.Context) {
+	plan := &models.RestorePlan{}
+	if err := c.ShouldBind(plan); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if plan.Database == nil || plan.ShardAssignment == nil || plan.Database.Name != plan.ShardAssignment.Name {
+		httppkg.Error(c, fmt.Errorf("restore plan is invalid"))
+		return
+	}
+	if err := api.checkDatabaseNotExist(plan.Database.Name); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	ctx, cancel := api.deps.WithTimeout()
+	defer cancel()
+	// save shard assignment first, because master builds shard assignment if not exist when database config changed.
+	if err := api.deps.Repo.Put(ctx, constants.GetDatabaseAssignPath(plan.Database.Name),
+		encoding.JSONMarshal(plan.ShardAssignment)); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if err := api.deps.Repo.Put(ctx, constants.GetDatabaseConfigPath(plan.Database.Name),
+		encoding.JSONMarshal(plan.Database)); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	api.logger.Info("restore database successfully", logger.String("database", plan.Database.Name))
+	httppkg.OK(c, "ok")
+}
+
+// prepareBackup builds the backup manifest and picks the live storage nodes which host the shards of database.
+func (api *BackupAPI) prepareBackup(database string) (*models.BackupManifest, []models.StatefulNode, error) {
+	ctx, cancel := api.deps.WithTimeout()
+	defer cancel()
+	cfg := &models.Database{}
+	if err := api.getFromRepo(ctx, constants.GetDatabaseConfigPath(database), cfg); err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			err = constants.ErrDatabaseNotFound
+		}
+		return nil, nil, err
+	}
+	shardAssignment := &models.ShardAssignment{}
+	if err := api.getFromRepo(ctx, constants.GetDatabaseAssignPath(database), shardAssignment); err != nil {
+		return nil, nil, err
+	}
+	storage, ok := api.deps.StateMgr.GetStorage(cfg.Storage)
+	if !ok {
+		return nil, nil, fmt.Errorf("storage %s not found", cfg.Storage)
+	}
+	nodeIDs := make(map[models.NodeID]struct{})
+	for shardID, replica := range shardAssignment.Shards {
+		alive := false
+		for _, nodeID := range replica.Replicas {
+			if _, ok := storage.LiveNodes[nodeID]; ok {
+				nodeIDs[nodeID] = struct{}{}
+				alive = true
+			}
+		}
+		if !alive {
+			return nil, nil, fmt.Errorf("shard %d hasn't any alive replica", shardID)
+		}
+	}
+	nodes := make([]models.StatefulNode, 0, len(nodeIDs))
+	for nodeID := range nodeIDs {
+		nodes = append(nodes, storage.LiveNodes[nodeID])
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	return &models.BackupManifest{
+		Version:         models.BackupVersion,
+		Database:        cfg,
+		ShardAssignment: shardAssignment,
+		CreateTime:      timeutil.Now(),
+	}, nodes, nil
+}
+
+// backupNode copies the snapshot of storage node into archive, then verifies it by node manifest.
+func (api *BackupAPI) backupNode(
+	ctx context.Context,
+	tw *tar.Writer,
+	database string,
+	node models.StatefulNode,
+) (*models.BackupNode, error) {
+	resp, err := api.doRequest(ctx, http.MethodGet,
+		node.HTTPAddress()+storageBackupPath+"?db="+url.QueryEscape(database), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	var (
+		backupNode *models.BackupNode
+		files      []archive.Entry
+	)
+	prefix := models.BackupNodeDir(node.ID)
+	tr := tar.NewReader(resp.Body)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Name == models.BackupNodeManifestFile {
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			backupNode = &models.BackupNode{}
+			if err := encoding.JSONUnmarshal(data, backupNode); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		entry, err := archive.Copy(tw, archive.Join(prefix, hdr.Name), hdr.Size, tr)
+		if err != nil {
+			return nil, err
+		}
+		entry.Name = hdr.Name
+		files = append(files, *entry)
+	}
+	if backupNode == nil {
+		return nil, fmt.Errorf("node manifest not found in snapshot of node %d", node.ID)
+	}
+	if err := archive.Verify(backupNode.Files, files); err != nil {
+		return nil, err
+	}
+	return backupNode, nil
+}
+
+// doRequest sends the request to storage node, returns error if response status isn't ok.
+func (api *BackupAPI) doRequest(ctx context.Context, method, target string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(constants.InternalAuthorizationHeader, httppkg.InternalAuthorization())
+	resp, err := doRequestFn(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("request storage node failure, url: %s, status: %d, err: %s",
+			target, resp.StatusCode, string(msg))
+	}
+	return resp, nil
+}
+
+// checkDatabaseNotExist returns error if database config exist.
+func (api *BackupAPI) checkDatabaseNotExist(database string) error {
+	ctx, cancel := api.deps.WithTimeout()
+	defer cancel()
+	_, err := api.deps.Repo.Get(ctx, constants.GetDatabaseConfigPath(database))
+	switch {
+	case err == nil:
+		return fmt.Errorf("database %s already exist", database)
+	case errors.Is(err, state.ErrNotExist):
+		return nil
+	default:
+		return err
+	}
+}
+
+// getFromRepo gets the value by key from repo, then unmarshal it.
+func (api *BackupAPI) getFromRepo(ctx context.Context, key string, v interface{}) error {
+	data, err := api.deps.Repo.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	return encoding.JSONUnmarshal(data, v)
+}
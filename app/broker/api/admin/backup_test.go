@@ -0,0 +1,501 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator/broker"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/archive"
+	"github.com/lindb/lindb/pkg/encoding"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func newBackupTestAPI(ctrl *gomock.Controller) (*gin.Engine, *state.MockRepository, *broker.MockStateManager) {
+	r := gin.New()
+	repo := state.NewMockRepository(ctrl)
+	stateMgr := broker.NewMockStateManager(ctrl)
+	api := NewBackupAPI(&deps.HTTPDeps{
+		Ctx:      context.Background(),
+		Repo:     repo,
+		StateMgr: stateMgr,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)}}},
+	})
+	api.Register(r)
+	return r, repo, stateMgr
+}
+
+// newStorageNode starts a mock storage node which serves database backup/restore api.
+func newStorageNode(t *testing.T, nodeID models.NodeID, snapshot func() []byte) models.StatefulNode {
+	httppkg.SetClientInternalAuthorization(func() string {
+		return "internal-token"
+	})
+	t.Cleanup(func() {
+		httppkg.SetClientInternalAuthorization(nil)
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(constants.InternalAuthorizationHeader) != "internal-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case storageBackupPath:
+			data := snapshot()
+			if data == nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_, _ = w.Write(data)
+		case storageRestorePath:
+			if r.URL.Query().Get("db") != "test" {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	assert.NoError(t, err)
+	p, err := strconv.Atoi(port)
+	assert.NoError(t, err)
+	return models.StatefulNode{
+		StatelessNode: models.StatelessNode{HostIP: host, HTTPPort: uint16(p)},
+		ID:            nodeID,
+	}
+}
+
+func newNodeSnapshot(t *testing.T, nodeID models.NodeID, withManifest bool, checksum string) []byte {
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	entry, err := archive.Copy(tw, "test/OPTIONS", 7, bytes.NewBufferString("options"))
+	assert.NoError(t, err)
+	if checksum != "" {
+		entry.Checksum = checksum
+	}
+	if withManifest {
+		assert.NoError(t, archive.WriteBytes(tw, models.BackupNodeManifestFile,
+			encoding.JSONMarshal(&models.BackupNode{NodeID: nodeID, Files: []archive.Entry{*entry}})))
+	}
+	assert.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func readBackupManifest(body []byte) *models.BackupManifest {
+	tr := tar.NewReader(bytes.NewReader(body))
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			return nil
+		}
+		if hdr.Name == models.BackupManifestFile {
+			buf := &bytes.Buffer{}
+			_, _ = buf.ReadFrom(tr)
+			manifest := &models.BackupManifest{}
+			_ = encoding.JSONUnmarshal(buf.Bytes(), manifest)
+			return manifest
+		}
+	}
+}
+
+func TestBackupAPI_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, repo, stateMgr := newBackupTestAPI(ctrl)
+	var snapshot []byte
+	node1 := newStorageNode(t, 1, func() []byte { return snapshot })
+	cfg := encoding.JSONMarshal(&models.Database{Name: "test", Storage: "cluster"})
+	assignment := encoding.JSONMarshal(&models.ShardAssignment{
+		Name:   "test",
+		Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2}}},
+	})
+	storage := &models.StorageState{LiveNodes: map[models.NodeID]models.StatefulNode{1: node1}}
+
+	cases := []struct {
+		name    string
+		path    string
+		prepare func()
+		assert  func(manifest *models.BackupManifest)
+		code    int
+	}{
+		{
+			name: "param invalid",
+			path: BackupPath,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "database not found",
+			path: BackupPath + "?db=test",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "get shard assignment failure",
+			path: BackupPath + "?db=test",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(cfg, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseAssignPath("test")).Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "storage not found",
+			path: BackupPath + "?db=test",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(cfg, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseAssignPath("test")).Return(assignment, nil)
+				stateMgr.EXPECT().GetStorage("cluster").Return(nil, false)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "shard hasn't alive replica",
+			path: BackupPath + "?db=test",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(cfg, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseAssignPath("test")).Return(assignment, nil)
+				stateMgr.EXPECT().GetStorage("cluster").Return(&models.StorageState{}, true)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "backup storage node failure",
+			path: BackupPath + "?db=test",
+			prepare: func() {
+				snapshot = nil
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(cfg, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseAssignPath("test")).Return(assignment, nil)
+				stateMgr.EXPECT().GetStorage("cluster").Return(storage, true)
+			},
+			assert: func(manifest *models.BackupManifest) {
+				assert.Nil(t, manifest)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "node manifest not found",
+			path: BackupPath + "?db=test",
+			prepare: func() {
+				snapshot = newNodeSnapshot(t, 1, false, "")
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(cfg, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseAssignPath("test")).Return(assignment, nil)
+				stateMgr.EXPECT().GetStorage("cluster").Return(storage, true)
+			},
+			assert: func(manifest *models.BackupManifest) {
+				assert.Nil(t, manifest)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "checksum not match",
+			path: BackupPath + "?db=test",
+			prepare: func() {
+				snapshot = newNodeSnapshot(t, 1, true, "abc")
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(cfg, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseAssignPath("test")).Return(assignment, nil)
+				stateMgr.EXPECT().GetStorage("cluster").Return(storage, true)
+			},
+			assert: func(manifest *models.BackupManifest) {
+				assert.Nil(t, manifest)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "backup successfully",
+			path: BackupPath + "?db=test",
+			prepare: func() {
+				snapshot = newNodeSnapshot(t, 1, true, "")
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(cfg, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseAssignPath("test")).Return(assignment, nil)
+				stateMgr.EXPECT().GetStorage("cluster").Return(storage, true)
+			},
+			assert: func(manifest *models.BackupManifest) {
+				assert.NotNil(t, manifest)
+				assert.Equal(t, models.BackupVersion, manifest.Version)
+				assert.Equal(t, "test", manifest.Database.Name)
+				assert.Len(t, manifest.Nodes, 1)
+				assert.Equal(t, "test/OPTIONS", manifest.Nodes[0].Files[0].Name)
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodGet, tt.path, "")
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.assert != nil {
+				tt.assert(readBackupManifest(resp.Body.Bytes()))
+			}
+		})
+	}
+}
+
+func TestBackupAPI_Plan(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, repo, stateMgr := newBackupTestAPI(ctrl)
+	manifest := &models.BackupManifest{
+		Version:  models.BackupVersion,
+		Database: &models.Database{Name: "test", Storage: "source"},
+		ShardAssignment: &models.ShardAssignment{
+			Name:   "test",
+			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1}}},
+		},
+		Nodes: []*models.BackupNode{{NodeID: 1}},
+	}
+	body := string(encoding.JSONMarshal(map[string]interface{}{"storage": "target", "manifest": manifest}))
+	cases := []struct {
+		name    string
+		body    string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "param invalid",
+			body: "{}",
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "manifest version invalid",
+			body: `{"manifest":{"version":100}}`,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "database exist",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return([]byte("{}"), nil)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "get database failure",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "storage not found",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+				stateMgr.EXPECT().GetStorage("target").Return(nil, false)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "not enough live nodes",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+				stateMgr.EXPECT().GetStorage("target").Return(&models.StorageState{}, true)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "plan successfully",
+			body: string(encoding.JSONMarshal(map[string]interface{}{"manifest": manifest})),
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+				stateMgr.EXPECT().GetStorage("source").Return(&models.StorageState{
+					LiveNodes: map[models.NodeID]models.StatefulNode{10: {ID: 10}},
+				}, true)
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPost, RestorePlanPath, tt.body)
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
+
+func TestBackupAPI_RestoreNode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, _, stateMgr := newBackupTestAPI(ctrl)
+	node := newStorageNode(t, 10, nil)
+	storage := &models.StorageState{LiveNodes: map[models.NodeID]models.StatefulNode{10: node}}
+	cases := []struct {
+		name    string
+		path    string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "param invalid",
+			path: RestoreNodePath,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "storage not found",
+			path: RestoreNodePath + "?db=test&storage=cluster&node=10",
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("cluster").Return(nil, false)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "node not alive",
+			path: RestoreNodePath + "?db=test&storage=cluster&node=1",
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("cluster").Return(storage, true)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "restore storage node failure",
+			path: RestoreNodePath + "?db=other&storage=cluster&node=10",
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("cluster").Return(storage, true)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "restore storage node successfully",
+			path: RestoreNodePath + "?db=test&storage=cluster&node=10",
+			prepare: func() {
+				stateMgr.EXPECT().GetStorage("cluster").Return(storage, true)
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPut, tt.path, "snapshot")
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
+
+func TestBackupAPI_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, repo, _ := newBackupTestAPI(ctrl)
+	body := string(encoding.JSONMarshal(&models.RestorePlan{
+		Database:        &models.Database{Name: "test"},
+		ShardAssignment: &models.ShardAssignment{Name: "test"},
+	}))
+	cases := []struct {
+		name    string
+		body    string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "param invalid",
+			body: "abc",
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "plan invalid",
+			body: "{}",
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "database exist",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return([]byte("{}"), nil)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "save shard assignment failure",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), constants.GetDatabaseAssignPath("test"), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "save database config failure",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), constants.GetDatabaseAssignPath("test"), gomock.Any()).Return(nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetDatabaseConfigPath("test"), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "restore successfully",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+				gomock.InOrder(
+					repo.EXPECT().Put(gomock.Any(), constants.GetDatabaseAssignPath("test"), gomock.Any()).Return(nil),
+					repo.EXPECT().Put(gomock.Any(), constants.GetDatabaseConfigPath("test"), gomock.Any()).Return(nil),
+				)
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPost, RestorePath, tt.body)
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/query/continuous"
+)
+
+var (
+	ContinuousQueryPath = "/continuous-query"
+)
+
+// ContinuousQueryAPI represents continuous query admin rest api,
+// the definitions are stored in repo, executed by the scheduler of master broker.
+type ContinuousQueryAPI struct {
+	deps   *depspkg.HTTPDeps
+	logger *logger.Logger
+}
+
+// NewContinuousQueryAPI creates continuous query api instance.
+func NewContinuousQueryAPI(deps *depspkg.HTTPDeps) *ContinuousQueryAPI {
+	return &ContinuousQueryAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "ContinuousQueryAPI"),
+	}
+}
+
+// Register adds continuous query admin url route.
+func (cq *ContinuousQueryAPI) Register(route gin.IRoutes) {
+	route.POST(ContinuousQueryPath, cq.Save)
+	route.GET(ContinuousQueryPath, cq.Get)
+	route.DELETE(ContinuousQueryPath, cq.Delete)
+}
+
+// Save creates or updates the continuous query definition, the execution state is kept if updating.
+func (cq *ContinuousQueryAPI) Save(c *gin.Context) {
+	query := &models.ContinuousQuery{}
+	err := c.ShouldBind(query)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	if err = query.Validate(); err != nil {
+		http.Error(c, err)
+		return
+	}
+	if _, err = continuous.ParseQuery(query.SQL); err != nil {
+		http.Error(c, err)
+		return
+	}
+	ctx, cancel := cq.deps.WithTimeout()
+	defer cancel()
+	for _, databaseName := range []string{query.Database, query.GetTargetDatabase()} {
+		if err = cq.checkDatabase(ctx, databaseName); err != nil {
+			http.Error(c, err)
+			return
+		}
+	}
+	if err = cq.deps.Repo.Put(ctx, constants.GetContinuousQueryPath(query.Name), encoding.JSONMarshal(query)); err != nil {
+		http.Error(c, err)
+		return
+	}
+	cq.logger.Info("save continuous query successfully",
+		logger.String("name", query.Name), logger.String("sql", query.SQL))
+	http.NoContent(c)
+}
+
+// Get returns the continuous query with execution state by name, returns all continuous queries if name not given.
+func (cq *ContinuousQueryAPI) Get(c *gin.Context) {
+	var param struct {
+		Name string `form:"name"`
+	}
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	ctx, cancel := cq.deps.WithTimeout()
+	defer cancel()
+	if param.Name != "" {
+		data, err := cq.deps.Repo.Get(ctx, constants.GetContinuousQueryPath(param.Name))
+		if err != nil {
+			if errors.Is(err, state.ErrNotExist) {
+				http.NotFound(c)
+				return
+			}
+			http.Error(c, err)
+			return
+		}
+		query := &models.ContinuousQuery{}
+		if err := encoding.JSONUnmarshal(data, query); err != nil {
+			http.Error(c, err)
+			return
+		}
+		http.OK(c, &models.ContinuousQueryInfo{ContinuousQuery: query, State: cq.getState(ctx, param.Name)})
+		return
+	}
+	kvs, err := cq.deps.Repo.List(ctx, constants.ContinuousQueryPath)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	queries := make([]*models.ContinuousQueryInfo, 0, len(kvs))
+	for _, kv := range kvs {
+		query := &models.ContinuousQuery{}
+		if err := encoding.JSONUnmarshal(kv.Value, query); err != nil {
+			cq.logger.Warn("unmarshal continuous query failure", logger.String("key", kv.Key), logger.Error(err))
+			continue
+		}
+		queries = append(queries, &models.ContinuousQueryInfo{ContinuousQuery: query, State: cq.getState(ctx, query.Name)})
+	}
+	http.OK(c, queries)
+}
+
+// Delete deletes the continuous query definition with execution state by name.
+func (cq *ContinuousQueryAPI) Delete(c *gin.Context) {
+	var param struct {
+		Name string `form:"name" binding:"required"`
+	}
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	ctx, cancel := cq.deps.WithTimeout()
+	defer cancel()
+	if err = cq.deps.Repo.Delete(ctx, constants.GetContinuousQueryPath(param.Name)); err != nil {
+		http.Error(c, err)
+		return
+	}
+	if err = cq.deps.Repo.Delete(ctx, constants.GetContinuousQueryStatePath(param.Name)); err != nil {
+		http.Error(c, err)
+		return
+	}
+	cq.logger.Info("delete continuous query successfully", logger.String("name", param.Name))
+	http.NoContent(c)
+}
+
+// checkDatabase checks if database exist.
+func (cq *ContinuousQueryAPI) checkDatabase(ctx context.Context, databaseName string) error {
+	if _, err := cq.deps.Repo.Get(ctx, constants.GetDatabaseConfigPath(databaseName)); err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			return constants.ErrDatabaseNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// getState returns the execution state of continuous query, returns nil if not executed.
+func (cq *ContinuousQueryAPI) getState(ctx context.Context, name string) *models.ContinuousQueryState {
+	data, err := cq.deps.Repo.Get(ctx, constants.GetContinuousQueryStatePath(name))
+	if err != nil {
+		return nil
+	}
+	cqState := &models.ContinuousQueryState{}
+	if err := encoding.JSONUnmarshal(data, cqState); err != nil {
+		return nil
+	}
+	return cqState
+}
@@ -0,0 +1,261 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func newContinuousQueryAPI(ctrl *gomock.Controller) (*gin.Engine, *state.MockRepository) {
+	r := gin.New()
+	repo := state.NewMockRepository(ctrl)
+	api := NewContinuousQueryAPI(&deps.HTTPDeps{
+		Ctx:  context.Background(),
+		Repo: repo,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)}}},
+	})
+	api.Register(r)
+	return r, repo
+}
+
+func TestContinuousQueryAPI_Save(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, repo := newContinuousQueryAPI(ctrl)
+	body := `{"name":"cq","database":"db","sql":"select sum(f) as f from cpu group by service",` +
+		`"targetDatabase":"target","targetMetric":"cpu_by_service","interval":"1m"}`
+	cases := []struct {
+		name    string
+		body    string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "bind param failure",
+			body: "abc",
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "param invalid",
+			body: `{"name":"cq"}`,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "sql invalid",
+			body: `{"name":"cq","database":"db","sql":"show databases","targetMetric":"m","interval":"1m"}`,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "source database not found",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("db")).Return(nil, state.ErrNotExist)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "get target database failure",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("db")).Return([]byte("{}"), nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("target")).Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "save failure",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("{}"), nil).Times(2)
+				repo.EXPECT().Put(gomock.Any(), constants.GetContinuousQueryPath("cq"), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "save successfully",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("{}"), nil).Times(2)
+				repo.EXPECT().Put(gomock.Any(), constants.GetContinuousQueryPath("cq"), gomock.Any()).Return(nil)
+			},
+			code: http.StatusNoContent,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPost, ContinuousQueryPath, tt.body)
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
+
+func TestContinuousQueryAPI_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, repo := newContinuousQueryAPI(ctrl)
+	cq := encoding.JSONMarshal(&models.ContinuousQuery{Name: "cq"})
+	cases := []struct {
+		name    string
+		reqBody string
+		prepare func()
+		code    int
+	}{
+		{
+			name:    "get by name, not found",
+			reqBody: "?name=cq",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetContinuousQueryPath("cq")).Return(nil, state.ErrNotExist)
+			},
+			code: http.StatusNotFound,
+		},
+		{
+			name:    "get by name failure",
+			reqBody: "?name=cq",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetContinuousQueryPath("cq")).Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name:    "get by name, unmarshal failure",
+			reqBody: "?name=cq",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetContinuousQueryPath("cq")).Return([]byte("abc"), nil)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name:    "get by name successfully",
+			reqBody: "?name=cq",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetContinuousQueryPath("cq")).Return(cq, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetContinuousQueryStatePath("cq")).
+					Return(encoding.JSONMarshal(&models.ContinuousQueryState{Name: "cq", Watermark: 10}), nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "list failure",
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.ContinuousQueryPath).Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "list successfully",
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.ContinuousQueryPath).Return([]state.KeyValue{
+					{Key: "cq", Value: cq},
+					{Key: "cq2", Value: encoding.JSONMarshal(&models.ContinuousQuery{Name: "cq2"})},
+					{Key: "cq3", Value: []byte("abc")},
+				}, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetContinuousQueryStatePath("cq")).Return([]byte("abc"), nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetContinuousQueryStatePath("cq2")).Return(nil, state.ErrNotExist)
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodGet, ContinuousQueryPath+tt.reqBody, "")
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
+
+func TestContinuousQueryAPI_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, repo := newContinuousQueryAPI(ctrl)
+	cases := []struct {
+		name    string
+		reqBody string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "name not given",
+			code: http.StatusInternalServerError,
+		},
+		{
+			name:    "delete definition failure",
+			reqBody: "?name=cq",
+			prepare: func() {
+				repo.EXPECT().Delete(gomock.Any(), constants.GetContinuousQueryPath("cq")).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name:    "delete state failure",
+			reqBody: "?name=cq",
+			prepare: func() {
+				repo.EXPECT().Delete(gomock.Any(), constants.GetContinuousQueryPath("cq")).Return(nil)
+				repo.EXPECT().Delete(gomock.Any(), constants.GetContinuousQueryStatePath("cq")).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name:    "delete successfully",
+			reqBody: "?name=cq",
+			prepare: func() {
+				repo.EXPECT().Delete(gomock.Any(), constants.GetContinuousQueryPath("cq")).Return(nil)
+				repo.EXPECT().Delete(gomock.Any(), constants.GetContinuousQueryStatePath("cq")).Return(nil)
+			},
+			code: http.StatusNoContent,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodDelete, ContinuousQueryPath+tt.reqBody, "")
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
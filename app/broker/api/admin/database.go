@@ -18,6 +18,8 @@
 package admin
 
 import (
+	"io"
+
 	"github.com/gin-gonic/gin"
 
 	depspkg "github.com/lindb/lindb/app/broker/deps"
@@ -29,7 +31,8 @@ import (
 )
 
 var (
-	DatabasePath = "/database"
+	DatabasePath       = "/database"
+	DatabaseOptionPath = "/database/option"
 )
 
 // DatabaseAPI represents database admin rest api
@@ -49,6 +52,7 @@ func NewDatabaseAPI(deps *depspkg.HTTPDeps) *DatabaseAPI {
 // Register adds database admin url route.
 func (d *DatabaseAPI) Register(route gin.IRoutes) {
 	route.GET(DatabasePath, d.GetByName)
+	route.PUT(DatabaseOptionPath, d.AlterOption)
 }
 
 // GetByName gets a database config by the name.
@@ -69,6 +73,44 @@ func (d *DatabaseAPI) GetByName(c *gin.Context) {
 	http.OK(c, database)
 }
 
+// AlterOption alters the option of database by the name, request body is the option json,
+// the fields in request body override the current option, others keep unchanged.
+func (d *DatabaseAPI) AlterOption(c *gin.Context) {
+	var param struct {
+		DatabaseName string `form:"name" binding:"required"`
+	}
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	optionJSON, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	database, err := d.getByName(param.DatabaseName)
+	if err != nil {
+		http.NotFound(c)
+		return
+	}
+	newDatabase, err := database.AlterOption(optionJSON)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	d.logger.Info("alter database option",
+		logger.String("name", param.DatabaseName), logger.String("option", string(optionJSON)))
+
+	ctx, cancel := d.deps.WithTimeout()
+	defer cancel()
+	if err := d.deps.Repo.Put(ctx, constants.GetDatabaseConfigPath(param.DatabaseName), encoding.JSONMarshal(newDatabase)); err != nil {
+		http.Error(c, err)
+		return
+	}
+	http.OK(c, newDatabase)
+}
+
 func (d *DatabaseAPI) getByName(name string) (*models.Database, error) {
 	ctx, cancel := d.deps.WithTimeout()
 	defer cancel()
@@ -19,6 +19,7 @@ package admin
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"testing"
@@ -71,3 +72,45 @@ func TestDatabaseAPI_GetByName(t *testing.T) {
 	reps = mock.DoRequest(t, r, http.MethodGet, DatabasePath+"?name=xxx", "")
 	assert.Equal(t, http.StatusOK, reps.Code)
 }
+
+func TestDatabaseAPI_AlterOption(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := gin.New()
+	repo := state.NewMockRepository(ctrl)
+	api := NewDatabaseAPI(&deps.HTTPDeps{
+		Ctx:  context.Background(),
+		Repo: repo,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)}}},
+	})
+	api.Register(r)
+	cfg := `{"name":"xxx","option":{"intervals":[{"interval":"10s"}]}}`
+
+	// name empty
+	reps := mock.DoRequest(t, r, http.MethodPut, DatabaseOptionPath, `{"ahead":"2h"}`)
+	assert.Equal(t, http.StatusInternalServerError, reps.Code)
+
+	// get error
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, io.ErrClosedPipe)
+	reps = mock.DoRequest(t, r, http.MethodPut, DatabaseOptionPath+"?name=xxx", `{"ahead":"2h"}`)
+	assert.Equal(t, http.StatusNotFound, reps.Code)
+
+	// option validation failure
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(cfg), nil)
+	reps = mock.DoRequest(t, r, http.MethodPut, DatabaseOptionPath+"?name=xxx", `{"ahead":"2x"}`)
+	assert.Equal(t, http.StatusInternalServerError, reps.Code)
+
+	// persist failure
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(cfg), nil)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	reps = mock.DoRequest(t, r, http.MethodPut, DatabaseOptionPath+"?name=xxx", `{"ahead":"2h"}`)
+	assert.Equal(t, http.StatusInternalServerError, reps.Code)
+
+	// alter ok
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(cfg), nil)
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	reps = mock.DoRequest(t, r, http.MethodPut, DatabaseOptionPath+"?name=xxx", `{"ahead":"2h"}`)
+	assert.Equal(t, http.StatusOK, reps.Code)
+}
@@ -0,0 +1,168 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+var (
+	RebalancePath = "/database/rebalance"
+)
+
+// RebalanceAPI represents shard replica rebalance admin rest api,
+// submits rebalance job which executed by master, and tracks the progress of job.
+type RebalanceAPI struct {
+	deps   *depspkg.HTTPDeps
+	logger *logger.Logger
+}
+
+// NewRebalanceAPI creates rebalance api instance.
+func NewRebalanceAPI(deps *depspkg.HTTPDeps) *RebalanceAPI {
+	return &RebalanceAPI{
+		deps:   deps,
+		logger: logger.GetLogger("Broker", "RebalanceAPI"),
+	}
+}
+
+// Register adds rebalance admin url route.
+func (r *RebalanceAPI) Register(route gin.IRoutes) {
+	route.POST(RebalancePath, r.Submit)
+	route.GET(RebalancePath, r.Get)
+}
+
+// Submit submits a rebalance job of database, master plans the shard moves if moves not given.
+func (r *RebalanceAPI) Submit(c *gin.Context) {
+	var param struct {
+		Database string             `json:"database" binding:"required"`
+		Moves    []models.ShardMove `json:"moves"`
+	}
+	err := c.ShouldBind(&param)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	for idx := range param.Moves {
+		move := &param.Moves[idx]
+		if move.From == move.To {
+			http.Error(c, fmt.Errorf("source node and target node of shard %d are same", move.ShardID))
+			return
+		}
+		move.State = models.RebalancePending
+	}
+	ctx, cancel := r.deps.WithTimeout()
+	defer cancel()
+	if _, err = r.deps.Repo.Get(ctx, constants.GetDatabaseConfigPath(param.Database)); err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			err = constants.ErrDatabaseNotFound
+		}
+		http.Error(c, err)
+		return
+	}
+	job, err := r.getJob(ctx, param.Database)
+	if err != nil && !errors.Is(err, state.ErrNotExist) {
+		http.Error(c, err)
+		return
+	}
+	if job != nil && !job.State.IsTerminal() {
+		http.Error(c, constants.ErrRebalanceJobRunning)
+		return
+	}
+	now := timeutil.Now()
+	job = &models.RebalanceJob{
+		Database:   param.Database,
+		State:      models.RebalancePending,
+		Moves:      param.Moves,
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	if err := r.deps.Repo.Put(ctx, constants.GetRebalanceJobPath(param.Database), encoding.JSONMarshal(job)); err != nil {
+		http.Error(c, err)
+		return
+	}
+	r.logger.Info("submit rebalance job successfully", logger.String("database", param.Database))
+	http.OK(c, job)
+}
+
+// Get returns the rebalance job of database, returns all rebalance jobs if database not given.
+func (r *RebalanceAPI) Get(c *gin.Context) {
+	var param struct {
+		Database string `form:"db"`
+	}
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	ctx, cancel := r.deps.WithTimeout()
+	defer cancel()
+	if param.Database != "" {
+		job, err := r.getJob(ctx, param.Database)
+		if err != nil {
+			if errors.Is(err, state.ErrNotExist) {
+				http.NotFound(c)
+				return
+			}
+			http.Error(c, err)
+			return
+		}
+		http.OK(c, job)
+		return
+	}
+	kvs, err := r.deps.Repo.List(ctx, constants.RebalanceJobPath)
+	if err != nil {
+		http.Error(c, err)
+		return
+	}
+	jobs := make([]*models.RebalanceJob, 0, len(kvs))
+	for _, kv := range kvs {
+		job := &models.RebalanceJob{}
+		if err := encoding.JSONUnmarshal(kv.Value, job); err != nil {
+			r.logger.Warn("unmarshal rebalance job failure", logger.String("key", kv.Key), logger.Error(err))
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	http.OK(c, jobs)
+}
+
+// getJob returns the rebalance job of database from repo.
+func (r *RebalanceAPI) getJob(ctx context.Context, databaseName string) (*models.RebalanceJob, error) {
+	data, err := r.deps.Repo.Get(ctx, constants.GetRebalanceJobPath(databaseName))
+	if err != nil {
+		return nil, err
+	}
+	job := &models.RebalanceJob{}
+	if err := encoding.JSONUnmarshal(data, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
@@ -0,0 +1,215 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func TestRebalanceAPI_Submit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := gin.New()
+	repo := state.NewMockRepository(ctrl)
+	api := NewRebalanceAPI(&deps.HTTPDeps{
+		Ctx:  context.Background(),
+		Repo: repo,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)}}},
+	})
+	api.Register(r)
+
+	body := `{"database":"test"}`
+	cases := []struct {
+		name    string
+		body    string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "param invalid",
+			body: "{}",
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "same source and target node",
+			body: `{"database":"test","moves":[{"shardId":1,"from":1,"to":1}]}`,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "database not found",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return(nil, state.ErrNotExist)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "get job failure",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return([]byte("{}"), nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetRebalanceJobPath("test")).Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "job is running",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return([]byte("{}"), nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetRebalanceJobPath("test")).
+					Return(encoding.JSONMarshal(&models.RebalanceJob{State: models.RebalanceCatchingUp}), nil)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "save job failure",
+			body: body,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return([]byte("{}"), nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetRebalanceJobPath("test")).Return(nil, state.ErrNotExist)
+				repo.EXPECT().Put(gomock.Any(), constants.GetRebalanceJobPath("test"), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "submit job successfully",
+			body: `{"database":"test","moves":[{"shardId":1,"from":1,"to":2}]}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetDatabaseConfigPath("test")).Return([]byte("{}"), nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetRebalanceJobPath("test")).
+					Return(encoding.JSONMarshal(&models.RebalanceJob{State: models.RebalanceDone}), nil)
+				repo.EXPECT().Put(gomock.Any(), constants.GetRebalanceJobPath("test"), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						job := &models.RebalanceJob{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, job))
+						assert.Equal(t, models.RebalancePending, job.State)
+						assert.Equal(t, []models.ShardMove{{ShardID: 1, From: 1, To: 2, State: models.RebalancePending}}, job.Moves)
+						return nil
+					})
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPost, RebalancePath, tt.body)
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
+
+func TestRebalanceAPI_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := gin.New()
+	repo := state.NewMockRepository(ctrl)
+	api := NewRebalanceAPI(&deps.HTTPDeps{
+		Ctx:  context.Background(),
+		Repo: repo,
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)}}},
+	})
+	api.Register(r)
+
+	cases := []struct {
+		name    string
+		path    string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "job not found",
+			path: RebalancePath + "?db=test",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetRebalanceJobPath("test")).Return(nil, state.ErrNotExist)
+			},
+			code: http.StatusNotFound,
+		},
+		{
+			name: "get job failure",
+			path: RebalancePath + "?db=test",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetRebalanceJobPath("test")).Return([]byte("abc"), nil)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "get job successfully",
+			path: RebalancePath + "?db=test",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetRebalanceJobPath("test")).
+					Return(encoding.JSONMarshal(&models.RebalanceJob{State: models.RebalanceDone}), nil)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "list jobs failure",
+			path: RebalancePath,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.RebalanceJobPath).Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "list jobs successfully",
+			path: RebalancePath,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.RebalanceJobPath).Return([]state.KeyValue{
+					{Key: "1", Value: []byte("abc")},
+					{Key: "2", Value: encoding.JSONMarshal(&models.RebalanceJob{State: models.RebalanceDone})},
+				}, nil)
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodGet, tt.path, "")
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// DeleteCommand executes delete series statement, sends delete request to all replicas of database,
+// all replica nodes must be alive, else deleted series will be resurrected by replica without tombstone.
+func DeleteCommand(_ context.Context, deps *depspkg.HTTPDeps,
+	param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	if strings.TrimSpace(param.Database) == "" {
+		return nil, constants.ErrDatabaseNameRequired
+	}
+	deleteStmt := stmt.(*stmtpkg.Delete)
+	databaseCfg, ok := deps.StateMgr.GetDatabaseCfg(param.Database)
+	if !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	storage, ok := deps.StateMgr.GetStorage(databaseCfg.Storage)
+	if !ok {
+		return nil, constants.ErrNoStorageCluster
+	}
+	shardAssignment, ok := storage.ShardAssignments[param.Database]
+	if !ok || len(shardAssignment.Shards) == 0 {
+		return nil, constants.ErrShardNotFound
+	}
+	nodeShards := make(map[models.NodeID][]models.ShardID)
+	for shardID, replica := range shardAssignment.Shards {
+		for _, nodeID := range replica.Replicas {
+			if _, alive := storage.LiveNodes[nodeID]; !alive {
+				return nil, fmt.Errorf("replica node[%d] of shard[%d] isn't alive", nodeID, shardID)
+			}
+			nodeShards[nodeID] = append(nodeShards[nodeID], shardID)
+		}
+	}
+
+	var (
+		wait    sync.WaitGroup
+		mutex   sync.Mutex
+		errs    error
+		results = &models.DeleteResult{}
+	)
+	for nodeID, shardIDs := range nodeShards {
+		node := storage.LiveNodes[nodeID]
+		sort.Slice(shardIDs, func(i, j int) bool { return shardIDs[i] < shardIDs[j] })
+		req := &models.DeleteParam{
+			Database:  param.Database,
+			ShardIDs:  shardIDs,
+			Statement: deleteStmt,
+		}
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			rs, err := deleteSeries(&node, req)
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				log.Error("delete series from storage node failure",
+					logger.String("node", node.Indicator()), logger.Error(err))
+				errs = multierror.Append(errs, err)
+				return
+			}
+			results.Merge(rs)
+		}()
+	}
+	wait.Wait()
+	if errs != nil {
+		return nil, errs
+	}
+	log.Info("delete series successfully", logger.String("database", param.Database),
+		logger.String("metric", deleteStmt.MetricName), logger.Any("series", results.NumOfSeries()),
+		logger.Any("metricDropped", results.MetricDropped))
+	rs := fmt.Sprintf("Delete series ok, %d series deleted", results.NumOfSeries())
+	return &rs, nil
+}
+
+// deleteSeries sends delete series request to storage node.
+func deleteSeries(node *models.StatefulNode, param *models.DeleteParam) (*models.DeleteResult, error) {
+	rs := &models.DeleteResult{}
+	resp, err := NewRestyFn().R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader(constants.InternalAuthorizationHeader, httppkg.InternalAuthorization()).
+		SetBody(param).
+		SetResult(rs).
+		Put(node.HTTPAddress() + constants.APIVersion1CliPath + "/database/delete")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("delete series failure, node: %s, err: %s", node.Indicator(), resp.String())
+	}
+	return rs, nil
+}
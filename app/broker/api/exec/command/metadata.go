@@ -23,8 +23,6 @@ import (
 	"strconv"
 	"strings"
 
-	resty "github.com/go-resty/resty/v2"
-
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/broker"
@@ -33,6 +31,7 @@ import (
 	"github.com/lindb/lindb/internal/client"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/state"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
@@ -41,7 +40,7 @@ import (
 // for testing
 var (
 	// NewRestyFn represents new resty client.
-	NewRestyFn           = resty.New
+	NewRestyFn           = httppkg.NewRestyClient
 	NewStateMachineCliFn = client.NewStateMachineCli
 )
 
@@ -26,6 +26,7 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	brokerQuery "github.com/lindb/lindb/query/broker"
 	"github.com/lindb/lindb/series/field"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
 )
@@ -48,6 +49,9 @@ func MetricMetadataCommand(ctx context.Context, deps *depspkg.HTTPDeps,
 func suggest(ctx context.Context, deps *depspkg.HTTPDeps,
 	param *models.ExecuteParam, request *stmtpkg.MetricMetadata) (interface{}, error) {
 	metaDataQuery := deps.QueryFactory.NewMetadataQuery(ctx, param.Database, request)
+	if request.Type == stmtpkg.MetricCardinality || request.Type == stmtpkg.TagCardinality {
+		return cardinality(metaDataQuery, request)
+	}
 	values, err := metaDataQuery.WaitResponse()
 	if err != nil {
 		return nil, err
@@ -107,3 +111,30 @@ func suggest(ctx context.Context, deps *depspkg.HTTPDeps,
 		}, nil
 	}
 }
+
+// cardinality executes metric/tag cardinality query, returns top n metrics/tag keys order by count desc,
+// tag cardinality is marked as approximate value.
+func cardinality(metaDataQuery brokerQuery.MetaDataQuery, request *stmtpkg.MetricMetadata) (interface{}, error) {
+	values, err := metaDataQuery.WaitCardinality()
+	if err != nil {
+		return nil, err
+	}
+	approximate := request.Type == stmtpkg.TagCardinality
+	result := make([]models.Cardinality, 0, len(values))
+	for name, count := range values {
+		result = append(result, models.Cardinality{Name: name, Count: count, Approximate: approximate})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count == result[j].Count {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].Count > result[j].Count
+	})
+	if request.Limit > 0 && len(result) > request.Limit {
+		result = result[:request.Limit]
+	}
+	return &models.Metadata{
+		Type:   request.Type.String(),
+		Values: result,
+	}, nil
+}
@@ -41,8 +41,10 @@ func QueryCommand(ctx context.Context, deps *depspkg.HTTPDeps,
 		Start: time.Now().UnixNano(),
 	}
 
-	// track request
-	reqID := brokerquery.GetRequestManager().NewRequest(req)
+	// track request, request can be canceled by kill query statement
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	reqID := brokerquery.GetRequestManager().NewRequest(req, cancel)
 	defer brokerquery.GetRequestManager().CompleteRequest(reqID)
 
 	metricQuery := deps.QueryFactory.NewMetricQuery(context.WithValue(ctx, constants.ContextKeySQL, req),
@@ -19,9 +19,13 @@ package command
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"sort"
 	"sync"
 
+	"go.uber.org/atomic"
+
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
@@ -30,7 +34,10 @@ import (
 )
 
 // RequestCommand executes requests/request related statement.
-func RequestCommand(_ context.Context, deps *depspkg.HTTPDeps, _ *models.ExecuteParam, _ stmtpkg.Statement) (interface{}, error) {
+func RequestCommand(_ context.Context, deps *depspkg.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	if requestStmt, ok := stmt.(*stmtpkg.Request); ok && requestStmt.Kill {
+		return killQuery(deps, requestStmt.RequestID)
+	}
 	liveNodes := deps.StateMgr.GetLiveNodes()
 	var nodes []models.Node
 	for idx := range liveNodes {
@@ -77,3 +84,39 @@ func RequestCommand(_ context.Context, deps *depspkg.HTTPDeps, _ *models.Execute
 	})
 	return rs, nil
 }
+
+// killQuery kills the query by given request id, because the query may be executed by any alive broker,
+// sends cancel request to all alive brokers.
+func killQuery(deps *depspkg.HTTPDeps, requestID string) (interface{}, error) {
+	liveNodes := deps.StateMgr.GetLiveNodes()
+	var (
+		wait   sync.WaitGroup
+		killed atomic.Bool
+	)
+	wait.Add(len(liveNodes))
+	for idx := range liveNodes {
+		node := &liveNodes[idx]
+		go func() {
+			defer wait.Done()
+			address := node.HTTPAddress()
+			resp, err := NewRestyFn().R().
+				SetQueryParam("requestId", requestID).
+				Delete(address + constants.APIVersion1CliPath + "/state/request")
+			if err != nil {
+				log.Error("kill query from alive node", logger.String("url", address), logger.Error(err))
+				return
+			}
+			if resp.StatusCode() == http.StatusNoContent {
+				killed.Store(true)
+			}
+		}()
+	}
+	wait.Wait()
+
+	if !killed.Load() {
+		return nil, fmt.Errorf("%w: request %s", constants.ErrNotFound, requestID)
+	}
+	log.Info("Kill query", logger.String("requestID", requestID))
+	rs := "Kill query ok"
+	return &rs, nil
+}
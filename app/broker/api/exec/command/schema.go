@@ -40,6 +40,8 @@ func SchemaCommand(ctx context.Context, deps *depspkg.HTTPDeps, _ *models.Execut
 		return saveDataBase(ctx, deps, schemaStmt)
 	case stmtpkg.DropDatabaseSchemaType:
 		return dropDatabase(ctx, deps, schemaStmt)
+	case stmtpkg.AlterDatabaseSchemaType:
+		return alterDatabase(ctx, deps, schemaStmt)
 	case stmtpkg.DatabaseNameSchemaType:
 		dbs, err := listDataBases(ctx, deps)
 		if err != nil {
@@ -69,6 +71,31 @@ func dropDatabase(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Sch
 	return &rs, nil
 }
 
+// alterDatabase alters the option of database config,
+// master will sync the new option to storage nodes after config changed.
+func alterDatabase(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.Schema) (interface{}, error) {
+	databaseName := stmt.Name
+	data, err := deps.Repo.Get(ctx, constants.GetDatabaseConfigPath(databaseName))
+	if err != nil {
+		return nil, err
+	}
+	database := &models.Database{}
+	if err = encoding.JSONUnmarshal(data, database); err != nil {
+		return nil, err
+	}
+	newDatabase, err := database.AlterOption([]byte(stmt.Value))
+	if err != nil {
+		return nil, err
+	}
+	log.Info("alter database",
+		logger.String("name", databaseName), logger.String("option", stmt.Value))
+	if err := deps.Repo.Put(ctx, constants.GetDatabaseConfigPath(databaseName), encoding.JSONMarshal(newDatabase)); err != nil {
+		return nil, err
+	}
+	rs := fmt.Sprintf("Alter database[%s] ok", databaseName)
+	return &rs, nil
+}
+
 // listDataBases returns database list in cluster.
 func listDataBases(ctx context.Context, deps *depspkg.HTTPDeps) (interface{}, error) {
 	data, err := deps.Repo.List(ctx, constants.DatabaseConfigPath)
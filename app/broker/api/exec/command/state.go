@@ -20,6 +20,7 @@ package command
 import (
 	"context"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -32,7 +33,7 @@ import (
 
 // StateCommand executes the state query.
 func StateCommand(_ context.Context, deps *depspkg.HTTPDeps,
-	_ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	param *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
 	stateStmt := stmt.(*stmtpkg.State)
 	switch stateStmt.Type {
 	case stmtpkg.Master:
@@ -65,11 +66,77 @@ func StateCommand(_ context.Context, deps *depspkg.HTTPDeps,
 			return fetchMetricData(nodes, stateStmt.MetricNames)
 		}
 		return nil, nil
+	case stmtpkg.SeriesQuota:
+		return getSeriesQuota(deps, param, stateStmt)
 	default:
 		return nil, nil
 	}
 }
 
+// getSeriesQuota returns the series quota usages of database from each live storage node.
+func getSeriesQuota(deps *depspkg.HTTPDeps, param *models.ExecuteParam, stmt *stmtpkg.State) (interface{}, error) {
+	database := strings.TrimSpace(stmt.Database)
+	if database == "" && param != nil {
+		database = strings.TrimSpace(param.Database)
+	}
+	if database == "" {
+		return nil, constants.ErrDatabaseNameRequired
+	}
+	databaseCfg, ok := deps.StateMgr.GetDatabaseCfg(database)
+	if !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	storage, ok := deps.StateMgr.GetStorage(databaseCfg.Storage)
+	if !ok {
+		return nil, constants.ErrNoStorageCluster
+	}
+	liveNodes := storage.LiveNodes
+	var nodes []models.Node
+	for id := range liveNodes {
+		n := liveNodes[id]
+		nodes = append(nodes, &n)
+	}
+	return fetchSeriesQuota(nodes, database, stmt.Limit)
+}
+
+// fetchSeriesQuota fetches the series quota usages of database from each live nodes.
+func fetchSeriesQuota(nodes []models.Node, database string, limit int) (interface{}, error) {
+	size := len(nodes)
+	if size == 0 {
+		return nil, nil
+	}
+	result := make([][]models.ShardSeriesQuota, size)
+	var wait sync.WaitGroup
+	wait.Add(size)
+	for idx := range nodes {
+		i := idx
+		go func() {
+			defer wait.Done()
+			node := nodes[i]
+			address := node.HTTPAddress()
+			var state []models.ShardSeriesQuota
+			_, err := NewRestyFn().R().SetQueryParams(map[string]string{
+				"db":    database,
+				"limit": strconv.Itoa(limit),
+			}).
+				SetHeader("Accept", "application/json").
+				SetResult(&state).
+				Get(address + constants.APIVersion1CliPath + "/state/tsdb/series-quota")
+			if err != nil {
+				log.Error("get series quota from storage node", logger.String("url", address), logger.Error(err))
+				return
+			}
+			result[i] = state
+		}()
+	}
+	wait.Wait()
+	rs := make(map[string][]models.ShardSeriesQuota)
+	for idx := range nodes {
+		rs[nodes[idx].Indicator()] = result[idx]
+	}
+	return rs, nil
+}
+
 // getReplicaState returns wal replica state.
 func getReplicaState(deps *depspkg.HTTPDeps, stmt *stmtpkg.State) (interface{}, error) {
 	if storage, ok := deps.StateMgr.GetStorage(stmt.StorageName); ok {
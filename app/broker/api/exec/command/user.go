@@ -0,0 +1,231 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+// for testing
+var (
+	newUserFn  = models.NewUser
+	newTokenFn = models.NewToken
+)
+
+// userCommandFn represents user command function define.
+type userCommandFn = func(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error)
+
+// userCommands registers all user/privilege related commands.
+var userCommands = map[stmtpkg.UserOpType]userCommandFn{
+	stmtpkg.UserOpShow:        listUsers,
+	stmtpkg.UserOpCreate:      createUser,
+	stmtpkg.UserOpDrop:        dropUser,
+	stmtpkg.UserOpCreateToken: createToken,
+	stmtpkg.UserOpGrant:       grantRole,
+	stmtpkg.UserOpRevoke:      revokeRole,
+}
+
+// UserCommand executes lin query language for user/privilege related.
+func UserCommand(ctx context.Context, deps *depspkg.HTTPDeps, _ *models.ExecuteParam, stmt stmtpkg.Statement) (interface{}, error) {
+	userStmt := stmt.(*stmtpkg.User)
+	if commandFn, ok := userCommands[userStmt.Type]; ok {
+		return commandFn(ctx, deps, userStmt)
+	}
+	return nil, nil
+}
+
+// listUsers lists all users(without password), includes built-in admin user.
+func listUsers(ctx context.Context, deps *depspkg.HTTPDeps, _ *stmtpkg.User) (interface{}, error) {
+	data, err := deps.Repo.List(ctx, constants.UserPath)
+	if err != nil {
+		return nil, err
+	}
+	var users models.Users
+	if admin, ok := deps.UserStore.GetUser(deps.BrokerCfg.BrokerBase.Auth.Admin.UserName); ok {
+		users = append(users, admin.Desensitize())
+	}
+	for _, val := range data {
+		user := &models.User{}
+		if err := encoding.JSONUnmarshal(val.Value, user); err != nil {
+			log.Warn("unmarshal data error",
+				logger.String("data", string(val.Value)))
+			continue
+		}
+		users = append(users, user.Desensitize())
+	}
+	return users, nil
+}
+
+// createUser creates human user with password.
+func createUser(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	if err := checkNotBuiltin(deps, stmt.Name); err != nil {
+		return nil, err
+	}
+	user, err := newUserFn(stmt.Name, stmt.Password)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("Creating user", logger.String("name", stmt.Name))
+	ok, err := deps.Repo.PutWithTX(ctx, constants.GetUserPath(user.Name), encoding.JSONMarshal(user), func(_ []byte) error {
+		return fmt.Errorf("user: %s already exists", user.Name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("create user failure")
+	}
+	deps.UserStore.Invalidate()
+	rs := "Create user ok"
+	return &rs, nil
+}
+
+// dropUser drops human user or api token.
+func dropUser(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	if err := checkNotBuiltin(deps, stmt.Name); err != nil {
+		return nil, err
+	}
+	if _, _, err := getUser(ctx, deps, stmt.Name); err != nil {
+		return nil, err
+	}
+	log.Info("Dropping user", logger.String("name", stmt.Name))
+	if err := deps.Repo.Delete(ctx, constants.GetUserPath(stmt.Name)); err != nil {
+		return nil, err
+	}
+	deps.UserStore.Invalidate()
+	rs := "Drop user ok"
+	return &rs, nil
+}
+
+// createToken creates api token for ingestion agent, returns the token(only shown once).
+// If token already exists, rotates the secret of token, keeps the granted roles.
+func createToken(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	if err := checkNotBuiltin(deps, stmt.Name); err != nil {
+		return nil, err
+	}
+	user, token, err := newTokenFn(stmt.Name)
+	if err != nil {
+		return nil, err
+	}
+	old, oldData, err := getUser(ctx, deps, stmt.Name)
+	switch {
+	case err == nil && old.Type != models.UserTypeToken:
+		return nil, fmt.Errorf("user: %s already exists, which is not api token", stmt.Name)
+	case err == nil:
+		user.Privileges = old.Privileges
+	case !errors.Is(err, constants.ErrNotFound):
+		return nil, err
+	}
+	log.Info("Creating api token", logger.String("name", stmt.Name))
+	if err := putUser(ctx, deps, user, oldData); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+// grantRole grants role on database to user.
+func grantRole(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	return updatePrivilege(ctx, deps, stmt, func(user *models.User, role models.Role) bool {
+		return user.Grant(stmt.Database, role)
+	}, "Grant role ok")
+}
+
+// revokeRole revokes role on database from user.
+func revokeRole(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User) (interface{}, error) {
+	return updatePrivilege(ctx, deps, stmt, func(user *models.User, role models.Role) bool {
+		return user.Revoke(stmt.Database, role)
+	}, "Revoke role ok")
+}
+
+// updatePrivilege updates the privileges of user, stores it if changed.
+func updatePrivilege(ctx context.Context, deps *depspkg.HTTPDeps, stmt *stmtpkg.User,
+	update func(user *models.User, role models.Role) bool, msg string,
+) (interface{}, error) {
+	if err := checkNotBuiltin(deps, stmt.Name); err != nil {
+		return nil, err
+	}
+	role, err := models.ParseRole(stmt.Role)
+	if err != nil {
+		return nil, err
+	}
+	user, oldData, err := getUser(ctx, deps, stmt.Name)
+	if err != nil {
+		return nil, err
+	}
+	if update(user, role) {
+		log.Info("Updating privileges of user", logger.String("name", stmt.Name),
+			logger.Any("privileges", user.Privileges))
+		if err := putUser(ctx, deps, user, oldData); err != nil {
+			return nil, err
+		}
+	}
+	return &msg, nil
+}
+
+// getUser returns the user and raw data from repo, returns constants.ErrNotFound if not exist.
+func getUser(ctx context.Context, deps *depspkg.HTTPDeps, name string) (*models.User, []byte, error) {
+	data, err := deps.Repo.Get(ctx, constants.GetUserPath(name))
+	if errors.Is(err, state.ErrNotExist) {
+		return nil, nil, fmt.Errorf("user: %s %w", name, constants.ErrNotFound)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	user := &models.User{}
+	if err := encoding.JSONUnmarshal(data, user); err != nil {
+		return nil, nil, err
+	}
+	return user, data, nil
+}
+
+// putUser stores the user if it's not modified concurrently(old data not changed).
+func putUser(ctx context.Context, deps *depspkg.HTTPDeps, user *models.User, oldData []byte) error {
+	ok, err := deps.Repo.PutWithTX(ctx, constants.GetUserPath(user.Name), encoding.JSONMarshal(user), func(data []byte) error {
+		if !bytes.Equal(data, oldData) {
+			return fmt.Errorf("user: %s is modified concurrently, please retry", user.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("store user: %s failure, please retry", user.Name)
+	}
+	deps.UserStore.Invalidate()
+	return nil
+}
+
+// checkNotBuiltin checks if the user isn't built-in admin user, which cannot be modified.
+func checkNotBuiltin(deps *depspkg.HTTPDeps, name string) error {
+	if deps.UserStore.IsBuiltin(name) {
+		return fmt.Errorf("built-in user: %s cannot be modified", name)
+	}
+	return nil
+}
@@ -28,6 +28,7 @@ import (
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/models"
 	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/logger"
 	sqlpkg "github.com/lindb/lindb/sql"
 	stmtpkg "github.com/lindb/lindb/sql/stmt"
@@ -57,6 +58,8 @@ var (
 		stmtpkg.MetricMetadataStatement: command.MetricMetadataCommand,
 		stmtpkg.QueryStatement:          command.QueryCommand,
 		stmtpkg.RequestStatement:        command.RequestCommand,
+		stmtpkg.DeleteStatement:         command.DeleteCommand,
+		stmtpkg.UserStatement:           command.UserCommand,
 	}
 )
 
@@ -127,6 +130,11 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	if err != nil {
 		return err
 	}
+	if database, role, required := requiredRole(&param, stmt); required {
+		if err := middleware.Authorize(c, database, role); err != nil {
+			return err
+		}
+	}
 
 	if commandFn, ok := commands[stmt.StatementType()]; ok {
 		result, err := commandFn(ctx, e.deps, &param, stmt)
@@ -142,3 +150,32 @@ func (e *ExecuteAPI) execute(c *gin.Context) error {
 	}
 	return errors.New("can't parse lin query language")
 }
+
+// requiredRole returns the role on database which is required by statement.
+// 1. query data/metadata of database requires read role;
+// 2. delete data of database requires write role;
+// 3. drop/alter database requires admin role on database;
+// 4. show database names requires nothing(authenticated);
+// 5. others(cluster/storage/user management etc.) require admin role on all databases.
+func requiredRole(param *models.ExecuteParam, stmt stmtpkg.Statement) (database string, role models.Role, required bool) {
+	switch s := stmt.(type) {
+	case *stmtpkg.Query, *stmtpkg.MetricMetadata:
+		return param.Database, models.RoleRead, true
+	case *stmtpkg.Delete:
+		return param.Database, models.RoleWrite, true
+	case *stmtpkg.State:
+		if s.Type == stmtpkg.SeriesQuota && s.Database != "" {
+			return s.Database, models.RoleRead, true
+		}
+	case *stmtpkg.Schema:
+		switch s.Type {
+		case stmtpkg.DatabaseNameSchemaType:
+			return "", "", false
+		case stmtpkg.DropDatabaseSchemaType:
+			return s.Value, models.RoleAdmin, true
+		case stmtpkg.AlterDatabaseSchemaType:
+			return s.Name, models.RoleAdmin, true
+		}
+	}
+	return models.AllDatabases, models.RoleAdmin, true
+}
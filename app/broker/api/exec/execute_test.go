@@ -35,8 +35,10 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/app/broker/api/exec/command"
+	"github.com/lindb/lindb/app/broker/auth"
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
 	masterpkg "github.com/lindb/lindb/coordinator/master"
@@ -47,6 +49,8 @@ import (
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/state"
@@ -69,9 +73,22 @@ func TestExecuteAPI_Execute(t *testing.T) {
 	master.EXPECT().GetStateManager().Return(masterStateMgr).AnyTimes()
 	queryFactory := brokerQuery.NewMockFactory(ctrl)
 	stateMgr := broker.NewMockStateManager(ctrl)
+	userStore := auth.NewMockUserStore(ctrl)
+	userStore.EXPECT().IsBuiltin(gomock.Any()).DoAndReturn(func(name string) bool {
+		return name == "admin"
+	}).AnyTimes()
+	userStore.EXPECT().Invalidate().AnyTimes()
+	bob, err := models.NewUser("bob", "pwd")
+	assert.NoError(t, err)
+	bobData := encoding.JSONMarshal(bob)
+	agent, _, err := models.NewToken("agent")
+	assert.NoError(t, err)
+	agent.Grant("db", models.RoleWrite)
+	agentData := encoding.JSONMarshal(agent)
 	opt := &option.DatabaseOption{}
 	api := NewExecuteAPI(&deps.HTTPDeps{
 		Ctx:          context.Background(),
+		UserStore:    userStore,
 		Repo:         repo,
 		RepoFactory:  repoFct,
 		Master:       master,
@@ -91,6 +108,8 @@ func TestExecuteAPI_Execute(t *testing.T) {
 	cfg += `\"leaseTTL\":10,\"endpoints\":[\"http://localhost:2379\"]}}`
 	databaseCfg := `{\"name\":\"test\",\"storage\":\"cluster-test\",\"numOfShard\":12,`
 	databaseCfg += `\"replicaFactor\":3,\"option\":{\"intervals\":[{\"interval\":\"10s\"}]}}`
+	databaseCfg1 := `{"name":"test","storage":"cluster-test","numOfShard":12,`
+	databaseCfg1 += `"replicaFactor":3,"option":{"intervals":[{"interval":"10s"}]}}`
 	r := gin.New()
 	api.Register(r)
 
@@ -329,6 +348,48 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name:    "show cardinality failure",
+			reqBody: `{"sql":"show cardinality","db":"db"}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitCardinality().Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show cardinality successfully",
+			reqBody: `{"sql":"show cardinality limit 2","db":"db"}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitCardinality().Return(map[string]uint64{"a": 1, "b": 10, "c": 10}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.JSONEq(t,
+					`{"type":"metricCardinality","values":[{"name":"b","count":10},{"name":"c","count":10}]}`,
+					resp.Body.String())
+			},
+		},
+		{
+			name:    "show tag cardinality successfully",
+			reqBody: `{"sql":"show tag cardinality from cpu","db":"db"}`,
+			prepare: func() {
+				metricQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), gomock.Any(), gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitCardinality().Return(map[string]uint64{"host": 10}, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Equal(t,
+					`{"type":"tagCardinality","values":[{"name":"host","count":10,"approximate":true}]}`,
+					resp.Body.String())
+			},
+		},
 		{
 			name:    "show fields failure",
 			reqBody: `{"sql":"show fields from cp","db":"db"}`,
@@ -634,6 +695,70 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{
+			name:    "show series quota without database",
+			reqBody: `{"sql":"show series quota"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show series quota, database not found",
+			reqBody: `{"db":"test","sql":"show series quota"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show series quota, storage not found",
+			reqBody: `{"sql":"show series quota where database=test"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(nil, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show series quota, alive node empty",
+			reqBody: `{"db":"test","sql":"show series quota"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(models.NewStorageState("s"), true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, resp.Code)
+			},
+		},
+		{
+			name:    "show series quota successfully",
+			reqBody: `{"db":"test","sql":"show series quota limit 10"}`,
+			prepare: func() {
+				svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					_, _ = w.Write([]byte(`[{"shardId":1,"usages":[{"scope":"database","name":"test","usage":10}]}]`))
+				}))
+				u, err := url.Parse(svr.URL)
+				assert.NoError(t, err)
+				p, err := strconv.Atoi(u.Port())
+				assert.NoError(t, err)
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(&models.StorageState{
+					LiveNodes: map[models.NodeID]models.StatefulNode{1: {
+						StatelessNode: models.StatelessNode{
+							HostIP:   u.Hostname(),
+							HTTPPort: uint16(p),
+						},
+						ID: 1,
+					}}}, true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
 		{
 			name:    "show broker metric, no alive node",
 			reqBody: `{"sql":"show broker metric where metric in (a,b)"}`,
@@ -1025,6 +1150,53 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{name: "alter database, get cfg failure",
+			reqBody: `{"sql":"alter database test with {\"ahead\":\"2h\"}"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{name: "alter database, unmarshal cfg failure",
+			reqBody: `{"sql":"alter database test with {\"ahead\":\"2h\"}"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("abc"), nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{name: "alter database, option validation failure",
+			reqBody: `{"sql":"alter database test with {\"ahead\":\"2h\"}"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(`{"name":"test"}`), nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{name: "alter database, persist failure",
+			reqBody: `{"sql":"alter database test with {\"ahead\":\"2h\"}"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(databaseCfg1), nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{name: "alter database successfully",
+			reqBody: `{"sql":"alter database test with {\"ahead\":\"2h\"}"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte(databaseCfg1), nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
 		{name: "show all requests, but no alive broker",
 			reqBody: `{"sql":"show requests"}`,
 			prepare: func() {
@@ -1073,6 +1245,416 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				assert.Equal(t, http.StatusOK, resp.Code)
 			},
 		},
+		{name: "kill query, but no alive broker",
+			reqBody: `{"sql":"kill query 'req-1'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetLiveNodes().Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{name: "kill query, but request not found",
+			reqBody: `{"sql":"kill query 'req-1'"}`,
+			prepare: func() {
+				svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusNotFound)
+				}))
+				u, err := url.Parse(svr.URL)
+				assert.NoError(t, err)
+				p, err := strconv.Atoi(u.Port())
+				assert.NoError(t, err)
+				stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{{
+					HostIP:   "127.0.0.1",
+					HTTPPort: uint16(p),
+				}, {
+					HostIP:   "127.0.0.1",
+					HTTPPort: 1,
+				}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{name: "kill query successfully",
+			reqBody: `{"sql":"kill query 'req-1'"}`,
+			prepare: func() {
+				svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, http.MethodDelete, r.Method)
+					assert.Equal(t, "req-1", r.URL.Query().Get("requestId"))
+					w.WriteHeader(http.StatusNoContent)
+				}))
+				u, err := url.Parse(svr.URL)
+				assert.NoError(t, err)
+				p, err := strconv.Atoi(u.Port())
+				assert.NoError(t, err)
+				stateMgr.EXPECT().GetLiveNodes().Return([]models.StatelessNode{{
+					HostIP:   "127.0.0.1",
+					HTTPPort: uint16(p),
+				}})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "delete series without database",
+			reqBody: `{"sql":"delete from cpu where host='a'"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "delete series, database not found",
+			reqBody: `{"db":"test","sql":"delete from cpu where host='a'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{}, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "delete series, storage not found",
+			reqBody: `{"db":"test","sql":"delete from cpu where host='a'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(nil, false)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "delete series, shard not found",
+			reqBody: `{"db":"test","sql":"delete from cpu where host='a'"}`,
+			prepare: func() {
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(models.NewStorageState("s"), true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "delete series, replica node not alive",
+			reqBody: `{"db":"test","sql":"delete from cpu where host='a'"}`,
+			prepare: func() {
+				storage := models.NewStorageState("s")
+				storage.ShardAssignments["test"] = &models.ShardAssignment{
+					Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1}}},
+				}
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(storage, true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "delete series failure",
+			reqBody: `{"db":"test","sql":"delete from cpu where host='a'"}`,
+			prepare: func() {
+				backend = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				}))
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(newDeleteStorageState(t, backend.URL), true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "delete series successfully",
+			reqBody: `{"db":"test","sql":"delete from cpu where host='a'"}`,
+			prepare: func() {
+				httppkg.SetClientInternalAuthorization(func() string {
+					return "internal-token"
+				})
+				backend = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "internal-token", r.Header.Get(constants.InternalAuthorizationHeader))
+					w.Header().Add("content-type", "application/json")
+					_, _ = w.Write([]byte(`{"shards":{"1":10,"2":5}}`))
+				}))
+				stateMgr.EXPECT().GetDatabaseCfg("test").Return(models.Database{Storage: "s"}, true)
+				stateMgr.EXPECT().GetStorage("s").Return(newDeleteStorageState(t, backend.URL), true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Contains(t, resp.Body.String(), "15 series deleted")
+			},
+		},
+		{
+			name:    "show users failure",
+			reqBody: `{"sql":"show users"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.UserPath).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "show users successfully",
+			reqBody: `{"sql":"show users"}`,
+			prepare: func() {
+				repo.EXPECT().List(gomock.Any(), constants.UserPath).Return([]state.KeyValue{
+					{Key: constants.GetUserPath("bob"), Value: bobData},
+					{Key: constants.GetUserPath("bad"), Value: []byte("abc")},
+				}, nil)
+				userStore.EXPECT().GetUser(gomock.Any()).Return(&models.User{Name: "admin", Password: "pwd"}, true)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Contains(t, resp.Body.String(), "bob")
+				assert.NotContains(t, resp.Body.String(), "password")
+			},
+		},
+		{
+			name:    "create built-in user",
+			reqBody: `{"sql":"create user admin with password 'pwd'"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create user, password empty",
+			reqBody: `{"sql":"create user bob with password ''"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create user, user exists",
+			reqBody: `{"sql":"create user bob with password 'pwd'"}`,
+			prepare: func() {
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetUserPath("bob"), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ []byte, check func(oldVal []byte) error) (bool, error) {
+						return false, check(bobData)
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Contains(t, resp.Body.String(), "already exists")
+			},
+		},
+		{
+			name:    "create user, put failure",
+			reqBody: `{"sql":"create user bob with password 'pwd'"}`,
+			prepare: func() {
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetUserPath("bob"), gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create user successfully",
+			reqBody: `{"sql":"create user bob with password 'pwd'"}`,
+			prepare: func() {
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetUserPath("bob"), gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "drop built-in user",
+			reqBody: `{"sql":"drop user admin"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop user, user not found",
+			reqBody: `{"sql":"drop user bob"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("bob")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Contains(t, resp.Body.String(), "not found")
+			},
+		},
+		{
+			name:    "drop user, delete failure",
+			reqBody: `{"sql":"drop user bob"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("bob")).Return(bobData, nil)
+				repo.EXPECT().Delete(gomock.Any(), constants.GetUserPath("bob")).Return(fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "drop user successfully",
+			reqBody: `{"sql":"drop user bob"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("bob")).Return(bobData, nil)
+				repo.EXPECT().Delete(gomock.Any(), constants.GetUserPath("bob")).Return(nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "create built-in token",
+			reqBody: `{"sql":"create token admin"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create token, name invalid",
+			reqBody: `{"sql":"create token 'a:b'"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create token, get user failure",
+			reqBody: `{"sql":"create token agent"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("agent")).Return(nil, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create token, unmarshal user failure",
+			reqBody: `{"sql":"create token agent"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("agent")).Return([]byte("abc"), nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "create token, human user exists",
+			reqBody: `{"sql":"create token bob"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("bob")).Return(bobData, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				assert.Contains(t, resp.Body.String(), "not api token")
+			},
+		},
+		{
+			name:    "create token successfully",
+			reqBody: `{"sql":"create token agent"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("agent")).Return(nil, state.ErrNotExist)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetUserPath("agent"), gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+				assert.Contains(t, resp.Body.String(), "agent:")
+			},
+		},
+		{
+			name:    "rotate token, keep privileges",
+			reqBody: `{"sql":"create token agent"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("agent")).Return(agentData, nil)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetUserPath("agent"), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, val []byte, check func(oldVal []byte) error) (bool, error) {
+						user := &models.User{}
+						assert.NoError(t, encoding.JSONUnmarshal(val, user))
+						assert.True(t, user.HasRole("db", models.RoleWrite))
+						assert.NotEqual(t, agent.Password, user.Password)
+						return true, check(agentData)
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "rotate token, modified concurrently",
+			reqBody: `{"sql":"create token agent"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("agent")).Return(agentData, nil)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetUserPath("agent"), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ []byte, check func(oldVal []byte) error) (bool, error) {
+						return false, check(bobData)
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant role to built-in user",
+			reqBody: `{"sql":"grant read on db to admin"}`,
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant role, user not found",
+			reqBody: `{"sql":"grant read on db to bob"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("bob")).Return(nil, state.ErrNotExist)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant role, put failure",
+			reqBody: `{"sql":"grant read on db to bob"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("bob")).Return(bobData, nil)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetUserPath("bob"), gomock.Any(), gomock.Any()).
+					Return(false, fmt.Errorf("err"))
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+			},
+		},
+		{
+			name:    "grant role successfully",
+			reqBody: `{"sql":"grant read on * to bob"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("bob")).Return(bobData, nil)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetUserPath("bob"), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, val []byte, _ func(oldVal []byte) error) (bool, error) {
+						user := &models.User{}
+						assert.NoError(t, encoding.JSONUnmarshal(val, user))
+						assert.True(t, user.HasRole("db", models.RoleRead))
+						return true, nil
+					})
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "revoke role not granted",
+			reqBody: `{"sql":"revoke write on db from bob"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("bob")).Return(bobData, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
+		{
+			name:    "revoke role successfully",
+			reqBody: `{"sql":"revoke write on db from agent"}`,
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.GetUserPath("agent")).Return(agentData, nil)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.GetUserPath("agent"), gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			assert: func(resp *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, resp.Code)
+			},
+		},
 	}
 
 	for _, tt := range cases {
@@ -1082,6 +1664,7 @@ func TestExecuteAPI_Execute(t *testing.T) {
 				sqlParseFn = sql.Parse
 				command.NewRestyFn = resty.New
 				command.NewStateMachineCliFn = client.NewStateMachineCli
+				httppkg.SetClientInternalAuthorization(nil)
 			}()
 			command.NewRestyFn = func() *resty.Client {
 				c := resty.New()
@@ -1102,3 +1685,93 @@ func TestExecuteAPI_Execute(t *testing.T) {
 		})
 	}
 }
+
+func newDeleteStorageState(t *testing.T, address string) *models.StorageState {
+	u, err := url.Parse(address)
+	assert.NoError(t, err)
+	p, err := strconv.Atoi(u.Port())
+	assert.NoError(t, err)
+	storage := models.NewStorageState("s")
+	storage.ShardAssignments["test"] = &models.ShardAssignment{
+		Shards: map[models.ShardID]*models.Replica{
+			1: {Replicas: []models.NodeID{1, 2}},
+			2: {Replicas: []models.NodeID{1}},
+		},
+	}
+	for _, nodeID := range []models.NodeID{1, 2} {
+		storage.LiveNodes[nodeID] = models.StatefulNode{
+			StatelessNode: models.StatelessNode{HostIP: "127.0.0.1", HTTPPort: uint16(p)},
+			ID:            nodeID,
+		}
+	}
+	return storage
+}
+
+func TestExecuteAPI_Privilege(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user := &models.User{Name: "bob", Type: models.UserTypeUser, Password: "pwd"}
+	user.Grant("db", models.RoleRead)
+	token, err := middleware.CreateToken(user, time.Hour)
+	assert.NoError(t, err)
+	users := middleware.NewMockUserProvider(ctrl)
+	users.EXPECT().GetUser("bob").Return(user, true).AnyTimes()
+
+	api := NewExecuteAPI(&deps.HTTPDeps{
+		Ctx: context.Background(),
+		BrokerCfg: &config.Broker{BrokerBase: config.BrokerBase{
+			HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+		}},
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			2,
+			time.Second*5,
+			metrics.NewLimitStatistics("exec_privilege", linmetric.BrokerRegistry),
+		),
+	})
+	r := gin.New()
+	r.Use(middleware.NewAuthentication(users).Validate())
+	api.Register(r)
+
+	cases := []struct {
+		name     string
+		sql      string
+		database string
+		role     models.Role
+		required bool
+	}{
+		{name: "query data", sql: "select f from cpu", database: "db", role: models.RoleRead, required: true},
+		{name: "query metadata", sql: "show metrics", database: "db", role: models.RoleRead, required: true},
+		{name: "delete data", sql: "delete from cpu where host='a'", database: "db", role: models.RoleWrite, required: true},
+		{name: "series quota of database", sql: "show series quota where database=db2", database: "db2", role: models.RoleRead, required: true},
+		{name: "show databases", sql: "show databases"},
+		{name: "drop database", sql: "drop database db2", database: "db2", role: models.RoleAdmin, required: true},
+		{name: "alter database", sql: `alter database db2 with {"ttl":"1d"}`, database: "db2", role: models.RoleAdmin, required: true},
+		{name: "show schemas", sql: "show schemas", database: models.AllDatabases, role: models.RoleAdmin, required: true},
+		{name: "show alive", sql: "show broker alive", database: models.AllDatabases, role: models.RoleAdmin, required: true},
+		{name: "create user", sql: "create user a with password b", database: models.AllDatabases, role: models.RoleAdmin, required: true},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			stmt, err := sql.Parse(tt.sql)
+			assert.NoError(t, err)
+			database, role, required := requiredRole(&models.ExecuteParam{Database: "db"}, stmt)
+			assert.Equal(t, tt.required, required)
+			assert.Equal(t, tt.database, database)
+			assert.Equal(t, tt.role, role)
+
+			if !required || user.HasRole(database, role) {
+				return
+			}
+			req := httptest.NewRequest(http.MethodPut, ExecutePath,
+				strings.NewReader(string(encoding.JSONMarshal(&models.ExecuteParam{Database: "db", SQL: tt.sql}))))
+			req.Header.Set("Authorization", "Bearer "+token)
+			req.Header.Set("Content-Type", "application/json")
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+			assert.Equal(t, http.StatusForbidden, resp.Code)
+		})
+	}
+}
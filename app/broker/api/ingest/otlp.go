@@ -0,0 +1,149 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gogo/protobuf/jsonpb"
+
+	commonconstants "github.com/lindb/common/constants"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	ingestCommon "github.com/lindb/lindb/ingestion/common"
+	"github.com/lindb/lindb/ingestion/otlp"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
+)
+
+var (
+	// OTLPMetricsPath represents OTLP/HTTP metrics receiver router path,
+	// OTLP exporter endpoint is http://broker:port/api/v1/otlp.
+	OTLPMetricsPath = "/otlp/v1/metrics"
+)
+
+// errMissingDatabase represents database not found in query param or header.
+var errMissingDatabase = errors.New("database not found in query param or header: db")
+
+// OTLPWrite represents OpenTelemetry OTLP/HTTP metrics receiver api.
+type OTLPWrite struct {
+	deps *depspkg.HTTPDeps
+}
+
+// NewOTLPWrite creates an OTLP/HTTP metrics receiver api instance.
+func NewOTLPWrite(deps *depspkg.HTTPDeps) *OTLPWrite {
+	return &OTLPWrite{
+		deps: deps,
+	}
+}
+
+// Register adds OTLP metrics receiver url route.
+func (w *OTLPWrite) Register(route gin.IRoutes) {
+	route.POST(OTLPMetricsPath, w.Write)
+}
+
+// Write processes OTLP/HTTP metrics data with ingest limit.
+//
+// @BasePath /api/v1
+// @Summary OpenTelemetry OTLP/HTTP metrics receiver
+// @Schemes
+// @Description receive OTLP metrics data(protobuf or json encoding), resource/scope/data point attributes
+// @Description are mapped to tags, then write data via database channel.
+// @Description database/namespace can be passed by query param or header(db/ns).
+// @Tags Write
+// @Accept application/x-protobuf
+// @Accept application/json
+// @Param db query string true "database name"
+// @Param ns query string false "namespace, default value: default-ns"
+// @Param string body string ture "metric data"
+// @Produce application/x-protobuf
+// @Produce application/json
+// @Success 200 {string} string "export metrics service response"
+// @Failure 500 {string} string "internal error"
+// @Router /otlp/v1/metrics [post]
+func (w *OTLPWrite) Write(c *gin.Context) {
+	if err := w.deps.IngestLimiter.Do(func() error {
+		return w.write(c)
+	}); err != nil {
+		httppkg.Error(c, err)
+	}
+}
+
+// write decodes OTLP metrics data, writes converted rows to database's write channel, then responses export result.
+func (w *OTLPWrite) write(c *gin.Context) error {
+	database, namespace := getOTLPParam(c)
+	if database == "" {
+		return errMissingDatabase
+	}
+	if err := middleware.Authorize(c, database, models.RoleWrite); err != nil {
+		return err
+	}
+	enrichedTags, err := ingestCommon.ExtractEnrichTags(c.Request)
+	if err != nil {
+		return err
+	}
+	exportReq, err := otlp.DecodeRequest(c.Request)
+	if err != nil {
+		return err
+	}
+	rows, rejected := otlp.ParseRequest(exportReq, enrichedTags, namespace)
+	if rows.Len() > 0 {
+		ctx, cancel := context.WithTimeout(context.Background(),
+			w.deps.BrokerCfg.BrokerBase.Ingestion.IngestTimeout.Duration())
+		defer cancel()
+		if err := w.deps.CM.Write(ctx, database, rows); err != nil {
+			return err
+		}
+	}
+	resp := otlp.NewExportResponse(rejected)
+	if otlp.IsJSON(c.Request) {
+		data, err := (&jsonpb.Marshaler{}).MarshalToString(resp)
+		if err != nil {
+			return err
+		}
+		c.Data(http.StatusOK, constants.ContentTypeJSON, []byte(data))
+		return nil
+	}
+	data, err := resp.Marshal()
+	if err != nil {
+		return err
+	}
+	c.Data(http.StatusOK, constants.ContentTypeXProto, data)
+	return nil
+}
+
+// getOTLPParam returns database/namespace from query param, if not exist, try to get them from header.
+func getOTLPParam(c *gin.Context) (database, namespace string) {
+	database = c.Query(otlp.DatabaseKey)
+	if database == "" {
+		database = c.GetHeader(otlp.DatabaseKey)
+	}
+	namespace = c.Query(otlp.NamespaceKey)
+	if namespace == "" {
+		namespace = c.GetHeader(otlp.NamespaceKey)
+	}
+	if namespace == "" {
+		namespace = commonconstants.DefaultNamespace
+	}
+	return database, namespace
+}
@@ -0,0 +1,119 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ingest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-http-utils/headers"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/ltoml"
+	protoOTLPV1 "github.com/lindb/lindb/proto/gen/v1/otlp"
+	"github.com/lindb/lindb/replica"
+)
+
+func TestOTLPWrite_Write(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cm := replica.NewMockChannelManager(ctrl)
+	api := NewOTLPWrite(&deps.HTTPDeps{
+		BrokerCfg: &config.Broker{
+			BrokerBase: config.BrokerBase{
+				Ingestion: config.Ingestion{
+					IngestTimeout: ltoml.Duration(time.Second * 2),
+				},
+			},
+		},
+		CM: cm,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("otlp_write_test", linmetric.BrokerRegistry)),
+	})
+	r := gin.New()
+	api.Register(r)
+
+	exportReq := &protoOTLPV1.ExportMetricsServiceRequest{
+		ResourceMetrics: []*protoOTLPV1.ResourceMetrics{{
+			ScopeMetrics: []*protoOTLPV1.ScopeMetrics{{
+				Metrics: []*protoOTLPV1.Metric{{
+					Name: "memory",
+					Data: &protoOTLPV1.Metric_Gauge{Gauge: &protoOTLPV1.Gauge{
+						DataPoints: []*protoOTLPV1.NumberDataPoint{
+							{Value: &protoOTLPV1.NumberDataPoint_AsDouble{AsDouble: 1}},
+							{},
+						},
+					}},
+				}},
+			}},
+		}},
+	}
+	data, err := exportReq.Marshal()
+	assert.NoError(t, err)
+	body := string(data)
+	header := make(http.Header)
+	header.Set(headers.ContentType, constants.ContentTypeXProto)
+
+	// missing db param
+	resp := mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath, body, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// enrich_tag bad format
+	resp = mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath+"?db=test&enrich_tag=a", body, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// decode err
+	resp = mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath+"?db=test", "bad-data", header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// write error
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).Return(io.ErrClosedPipe)
+	resp = mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath+"?db=test", body, header)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// write successfully, database from header
+	cm.EXPECT().Write(gomock.Any(), "test2", gomock.Any()).Return(nil)
+	header.Set("db", "test2")
+	header.Set("ns", "ns2")
+	resp = mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath, body, header)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	exportResp := &protoOTLPV1.ExportMetricsServiceResponse{}
+	assert.NoError(t, exportResp.Unmarshal(resp.Body.Bytes()))
+	assert.Equal(t, int64(1), exportResp.PartialSuccess.RejectedDataPoints)
+
+	// json encoding, all data points rejected
+	header = make(http.Header)
+	header.Set(headers.ContentType, constants.ContentTypeJSON)
+	resp = mock.DoRequest(t, r, http.MethodPost, OTLPMetricsPath+"?db=test",
+		`{"resourceMetrics":[{"scopeMetrics":[{"metrics":[{"name":"summary"}]}]}]}`, header)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, constants.ContentTypeJSON, resp.Header().Get(headers.ContentType))
+	assert.Contains(t, resp.Body.String(), `"rejectedDataPoints":"1"`)
+}
@@ -35,7 +35,9 @@ import (
 	"github.com/lindb/lindb/ingestion/proto"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/series/metric"
 )
 
@@ -119,6 +121,9 @@ func (w *Write) write(c *gin.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	if err = middleware.Authorize(c, param.Database, models.RoleWrite); err != nil {
+		return err
+	}
 	ctx, cancel := context.WithTimeout(context.Background(),
 		w.deps.BrokerCfg.BrokerBase.Ingestion.IngestTimeout.Duration())
 	defer cancel()
@@ -39,6 +39,8 @@ import (
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/mock"
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/replica"
@@ -225,3 +227,46 @@ func TestWrite_Proto(t *testing.T) {
 	resp = mock.DoRequest(t, r, http.MethodPost, WritePath+"?db=test&ns=ns4&enrich_tag=a=b", string(data), header)
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 }
+
+func TestWrite_Permission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	agent, token, err := models.NewToken("agent")
+	assert.NoError(t, err)
+	agent.Grant("db", models.RoleWrite)
+	users := middleware.NewMockUserProvider(ctrl)
+	users.EXPECT().GetUser("agent").Return(agent, true).AnyTimes()
+
+	cm := replica.NewMockChannelManager(ctrl)
+	api := NewWrite(&deps.HTTPDeps{
+		BrokerCfg: &config.Broker{
+			BrokerBase: config.BrokerBase{
+				Ingestion: config.Ingestion{
+					IngestTimeout: ltoml.Duration(time.Second * 2),
+				},
+			},
+		},
+		CM: cm,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("write_permission_test", linmetric.BrokerRegistry)),
+	})
+	r := gin.New()
+	r.Use(middleware.NewAuthentication(users).Validate())
+	api.Register(r)
+
+	header := make(http.Header)
+	header.Set(headers.ContentType, constants.ContentTypeInflux)
+	header.Set(headers.Authorization, "Token "+token)
+
+	// without write role on database
+	resp := mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=db2", "cpu f1=1", header)
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+
+	cm.EXPECT().Write(gomock.Any(), "db", gomock.Any()).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPut, WritePath+"?db=db", "cpu f1=1", header)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+}
@@ -18,8 +18,11 @@
 package api
 
 import (
+	"errors"
+
 	"github.com/gin-gonic/gin"
 
+	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/http/middleware"
@@ -31,17 +34,19 @@ var (
 	LoginPath     = "/login"
 )
 
+var errLoginFailure = errors.New("username or password invalid")
+
 // LoginAPI represents login param
 type LoginAPI struct {
-	user config.User
+	deps *depspkg.HTTPDeps
 
 	logger *logger.Logger
 }
 
 // NewLoginAPI creates login api instance
-func NewLoginAPI(user config.User) *LoginAPI {
+func NewLoginAPI(deps *depspkg.HTTPDeps) *LoginAPI {
 	return &LoginAPI{
-		user:   user,
+		deps:   deps,
 		logger: logger.GetLogger("Broker", "LoginAPI"),
 	}
 }
@@ -51,32 +56,27 @@ func (l *LoginAPI) Register(route gin.IRoutes) {
 	route.PUT(LoginPath, l.Login)
 }
 
-// Login responses unique token
+// Login responses unique token(jwt), which is valid within token ttl.
 // empty use name or password will responses error msg
-// invalid use name or password will responses error msg
+// invalid use name or password will responses error msg,
+// api token of ingestion agent cannot login.
 func (l *LoginAPI) Login(c *gin.Context) {
 	user := config.User{}
 	err := c.ShouldBind(&user)
 	if err != nil {
 		l.logger.Error("cannot get user info from request")
-		http.OK(c, "")
-		return
-	}
-	// user name is error
-	if l.user.UserName != user.UserName {
-		l.logger.Error("username is invalid")
-		http.OK(c, "")
+		http.Unauthorized(c, errLoginFailure)
 		return
 	}
-	// password is error
-	if l.user.Password != user.Password {
-		l.logger.Error("password is invalid")
-		http.OK(c, "")
+	loginUser, ok := l.deps.UserStore.Login(user.UserName, user.Password)
+	if !ok {
+		l.logger.Error("username or password is invalid", logger.String("user", user.UserName))
+		http.Unauthorized(c, errLoginFailure)
 		return
 	}
-	token, err := createTokenFn(user)
+	token, err := createTokenFn(loginUser, l.deps.BrokerCfg.BrokerBase.Auth.TokenTTL.Duration())
 	if err != nil {
-		http.OK(c, "")
+		http.Error(c, err)
 		return
 	}
 	http.OK(c, token)
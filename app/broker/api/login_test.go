@@ -21,60 +21,90 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/app/broker/auth"
+	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/internal/mock"
-	httppkg "github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
 )
 
 func TestLogin(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
-		createTokenFn = httppkg.CreateToken
+		createTokenFn = middleware.CreateToken
 		ctrl.Finish()
 	}()
 
-	user := config.User{UserName: "admin", Password: "admin123"}
-	api := NewLoginAPI(user)
+	user := &models.User{Name: "admin", Type: models.UserTypeUser}
+	store := auth.NewMockUserStore(ctrl)
+	api := NewLoginAPI(&depspkg.HTTPDeps{
+		UserStore: store,
+		BrokerCfg: &config.Broker{BrokerBase: *config.NewDefaultBrokerBase()},
+	})
 	r := gin.New()
 	api.Register(r)
 
-	resp := mock.DoRequest(t, r, http.MethodPut, LoginPath, "")
-	assert.Equal(t, http.StatusOK, resp.Code)
-
-	// create success
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, "")
-	assert.Equal(t, http.StatusOK, resp.Code)
-
-	// user failure error password
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "admin", "password": "admin1234"}`)
-	assert.Equal(t, http.StatusOK, resp.Code)
-
-	// user failure error user name
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "123", "password": "admin1234"}`)
-	assert.Equal(t, http.StatusOK, resp.Code)
-
-	// user failure error password
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "123", "password": "admin12dd34"}`)
-	assert.Equal(t, http.StatusOK, resp.Code)
-
-	// user login failure  password is empty
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "123"}`)
-	assert.Equal(t, http.StatusOK, resp.Code)
-
-	// token create fail
-	createTokenFn = func(user config.User) (string, error) {
-		return "", fmt.Errorf("err")
+	cases := []struct {
+		name    string
+		reqBody string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "bind param failure",
+			code: http.StatusUnauthorized,
+		},
+		{
+			name:    "password is empty",
+			reqBody: `{"username": "123"}`,
+			code:    http.StatusUnauthorized,
+		},
+		{
+			name:    "username or password invalid",
+			reqBody: `{"username": "admin", "password": "admin1234"}`,
+			prepare: func() {
+				store.EXPECT().Login("admin", "admin1234").Return(nil, false)
+			},
+			code: http.StatusUnauthorized,
+		},
+		{
+			name:    "create token failure",
+			reqBody: `{"username": "admin", "password": "admin123"}`,
+			prepare: func() {
+				store.EXPECT().Login("admin", "admin123").Return(user, true)
+				createTokenFn = func(_ *models.User, _ time.Duration) (string, error) {
+					return "", fmt.Errorf("err")
+				}
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name:    "login successfully",
+			reqBody: `{"username": "admin", "password": "admin123"}`,
+			prepare: func() {
+				store.EXPECT().Login("admin", "admin123").Return(user, true)
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				createTokenFn = middleware.CreateToken
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPut, LoginPath, tt.reqBody)
+			assert.Equal(t, tt.code, resp.Code)
+		})
 	}
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "admin", "password": "admin123"}`)
-	assert.Equal(t, http.StatusOK, resp.Code)
-
-	// token create ok
-	createTokenFn = httppkg.CreateToken
-	resp = mock.DoRequest(t, r, http.MethodPut, LoginPath, `{"username": "admin", "password": "admin123"}`)
-	assert.Equal(t, http.StatusOK, resp.Code)
 }
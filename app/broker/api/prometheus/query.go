@@ -0,0 +1,337 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	commonconstants "github.com/lindb/common/constants"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/ingestion/prometheus"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/promql"
+)
+
+var (
+	// QueryPath represents PromQL instant query http api router path.
+	QueryPath = "/query"
+	// QueryRangePath represents PromQL range query http api router path.
+	QueryRangePath = "/query_range"
+)
+
+// defaultLookback represents the max time range to find the latest sample for instant query.
+const defaultLookback = 5 * time.Minute
+
+const (
+	statusSuccess      = "success"
+	statusError        = "error"
+	errorTypeBadData   = "bad_data"
+	errorTypeExecution = "execution"
+	errorTypeForbidden = "forbidden"
+	resultTypeVector   = "vector"
+	resultTypeMatrix   = "matrix"
+)
+
+// queryParam represents the param of PromQL query.
+type queryParam struct {
+	Database  string `form:"db" binding:"required"`
+	Namespace string `form:"ns"`
+	Query     string `form:"query" binding:"required"`
+	Time      string `form:"time"`
+	Start     string `form:"start"`
+	End       string `form:"end"`
+	Step      string `form:"step"`
+}
+
+// queryResponse represents the response of PromQL query, compatible with prometheus http api.
+type queryResponse struct {
+	Status    string     `json:"status"`
+	Data      *queryData `json:"data,omitempty"`
+	ErrorType string     `json:"errorType,omitempty"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// queryData represents the result data of PromQL query.
+type queryData struct {
+	ResultType string          `json:"resultType"`
+	Result     []*sampleSeries `json:"result"`
+}
+
+// sampleSeries represents the series of vector(value)/matrix(values) result.
+type sampleSeries struct {
+	Metric map[string]string `json:"metric"`
+	Value  *samplePoint      `json:"value,omitempty"`
+	Values []*samplePoint    `json:"values,omitempty"`
+}
+
+// samplePoint represents the sample with timestamp(ms), which encodes as [<unix seconds>, "<value>"].
+type samplePoint struct {
+	Timestamp int64
+	Value     float64
+}
+
+// MarshalJSON returns json data of sample point.
+func (p *samplePoint) MarshalJSON() ([]byte, error) {
+	return encoding.JSONMarshal([]interface{}{
+		float64(p.Timestamp) / 1000,
+		strconv.FormatFloat(p.Value, 'f', -1, 64),
+	}), nil
+}
+
+// QueryAPI represents PromQL compatible query api.
+type QueryAPI struct {
+	deps *depspkg.HTTPDeps
+}
+
+// NewQueryAPI creates a PromQL compatible query api instance.
+func NewQueryAPI(deps *depspkg.HTTPDeps) *QueryAPI {
+	return &QueryAPI{
+		deps: deps,
+	}
+}
+
+// Register adds PromQL query url route.
+func (api *QueryAPI) Register(route gin.IRoutes) {
+	route.GET(QueryPath, api.Query)
+	route.POST(QueryPath, api.Query)
+	route.GET(QueryRangePath, api.QueryRange)
+	route.POST(QueryRangePath, api.QueryRange)
+}
+
+// Query evaluates PromQL instant query with rate limit.
+//
+// @BasePath /api/v1
+// @Summary PromQL instant query
+// @Schemes
+// @Description evaluate PromQL expression at a single point in time, returns prometheus vector result.
+// @Tags Query
+// @Param db query string true "database name"
+// @Param ns query string false "namespace, default value: default-ns"
+// @Param query query string true "PromQL expression"
+// @Param time query string false "evaluation timestamp(rfc3339 or unix seconds), default value: now"
+// @Produce json
+// @Success 200 {object} object
+// @Failure 400 {object} object
+// @Failure 422 {object} object
+// @Router /query [get]
+func (api *QueryAPI) Query(c *gin.Context) {
+	param, ok := bindQueryParam(c)
+	if !ok {
+		return
+	}
+	evalTime := timeutil.Now()
+	if param.Time != "" {
+		var err error
+		if evalTime, err = parseTime(param.Time); err != nil {
+			responseError(c, http.StatusBadRequest, errorTypeBadData, err)
+			return
+		}
+	}
+	timeRange := timeutil.TimeRange{Start: evalTime - defaultLookback.Milliseconds(), End: evalTime}
+	api.execute(c, param, timeRange, 0, func(points []*samplePoint) *sampleSeries {
+		// returns latest sample with evaluation timestamp
+		return &sampleSeries{Value: &samplePoint{Timestamp: evalTime, Value: points[len(points)-1].Value}}
+	}, resultTypeVector)
+}
+
+// QueryRange evaluates PromQL range query with rate limit.
+//
+// @BasePath /api/v1
+// @Summary PromQL range query
+// @Schemes
+// @Description evaluate PromQL expression over a range of time, returns prometheus matrix result.
+// @Tags Query
+// @Param db query string true "database name"
+// @Param ns query string false "namespace, default value: default-ns"
+// @Param query query string true "PromQL expression"
+// @Param start query string true "start timestamp(rfc3339 or unix seconds)"
+// @Param end query string true "end timestamp(rfc3339 or unix seconds)"
+// @Param step query string true "query resolution step(duration or float seconds)"
+// @Produce json
+// @Success 200 {object} object
+// @Failure 400 {object} object
+// @Failure 422 {object} object
+// @Router /query_range [get]
+func (api *QueryAPI) QueryRange(c *gin.Context) {
+	param, ok := bindQueryParam(c)
+	if !ok {
+		return
+	}
+	start, err := parseTime(param.Start)
+	if err != nil {
+		responseError(c, http.StatusBadRequest, errorTypeBadData, fmt.Errorf("invalid parameter 'start': %w", err))
+		return
+	}
+	end, err := parseTime(param.End)
+	if err != nil {
+		responseError(c, http.StatusBadRequest, errorTypeBadData, fmt.Errorf("invalid parameter 'end': %w", err))
+		return
+	}
+	if end < start {
+		responseError(c, http.StatusBadRequest, errorTypeBadData, fmt.Errorf("end timestamp must not be before start time"))
+		return
+	}
+	step, err := parseStep(param.Step)
+	if err != nil {
+		responseError(c, http.StatusBadRequest, errorTypeBadData, fmt.Errorf("invalid parameter 'step': %w", err))
+		return
+	}
+	api.execute(c, param, timeutil.TimeRange{Start: start, End: end}, timeutil.Interval(step.Milliseconds()),
+		func(points []*samplePoint) *sampleSeries {
+			return &sampleSeries{Values: points}
+		}, resultTypeMatrix)
+}
+
+// execute translates PromQL into LinDB query, then executes it via query engine with rate limit.
+func (api *QueryAPI) execute(c *gin.Context, param *queryParam,
+	timeRange timeutil.TimeRange, interval timeutil.Interval,
+	buildSeries func(points []*samplePoint) *sampleSeries, resultType string,
+) {
+	expr, err := promql.Parse(param.Query)
+	if err != nil {
+		responseError(c, http.StatusBadRequest, errorTypeBadData, err)
+		return
+	}
+	query, err := promql.Translate(expr, prometheus.ValueField)
+	if err != nil {
+		responseError(c, http.StatusBadRequest, errorTypeBadData, err)
+		return
+	}
+	query.Stmt.Namespace = param.Namespace
+	query.Stmt.TimeRange = timeRange
+	query.Stmt.Interval = interval
+
+	var resultSet *models.ResultSet
+	if err := api.deps.QueryLimiter.Do(func() error {
+		ctx, cancel := api.deps.WithTimeout()
+		defer cancel()
+		resultSet, err = api.query(ctx, param.Database, query)
+		return err
+	}); err != nil {
+		responseError(c, http.StatusUnprocessableEntity, errorTypeExecution, err)
+		return
+	}
+	_, keepName := expr.(*promql.VectorSelector)
+	data := &queryData{ResultType: resultType, Result: []*sampleSeries{}}
+	if resultSet != nil {
+		for _, series := range resultSet.Series {
+			points := toSamplePoints(series.Fields[prometheus.ValueField])
+			if len(points) == 0 {
+				continue
+			}
+			result := buildSeries(points)
+			result.Metric = make(map[string]string)
+			for key, value := range series.Tags {
+				result.Metric[key] = value
+			}
+			if keepName {
+				// prometheus drops metric name after function/aggregation
+				result.Metric[prometheus.MetricNameLabel] = query.Stmt.MetricName
+			}
+			data.Result = append(data.Result, result)
+		}
+	}
+	c.JSON(http.StatusOK, &queryResponse{Status: statusSuccess, Data: data})
+}
+
+// query executes the translated query, group by all tag keys of metric if it returns each series.
+func (api *QueryAPI) query(ctx context.Context, database string, query *promql.Query) (*models.ResultSet, error) {
+	if query.GroupByAllTags {
+		tagKeys, err := findTagKeys(ctx, api.deps, database, query.Stmt.Namespace, query.Stmt.MetricName)
+		if err != nil {
+			return nil, err
+		}
+		query.Stmt.GroupBy = tagKeys
+	}
+	return api.deps.QueryFactory.NewMetricQuery(ctx, api.deps.Node, database, query.Stmt).WaitResponse()
+}
+
+// bindQueryParam binds PromQL query param, responses bad data error if failure.
+func bindQueryParam(c *gin.Context) (*queryParam, bool) {
+	param := &queryParam{}
+	// bind from url query and post form
+	if err := c.ShouldBindWith(param, binding.Form); err != nil {
+		responseError(c, http.StatusBadRequest, errorTypeBadData, err)
+		return nil, false
+	}
+	if err := middleware.Authorize(c, param.Database, models.RoleRead); err != nil {
+		responseError(c, http.StatusForbidden, errorTypeForbidden, err)
+		return nil, false
+	}
+	if param.Namespace == "" {
+		param.Namespace = commonconstants.DefaultNamespace
+	}
+	return param, true
+}
+
+// responseError responses error with prometheus http api format.
+func responseError(c *gin.Context, httpCode int, errorType string, err error) {
+	_ = c.Error(err)
+	c.JSON(httpCode, &queryResponse{Status: statusError, ErrorType: errorType, Error: err.Error()})
+}
+
+// toSamplePoints converts points of field into sample points sorted by timestamp, skips NaN value.
+func toSamplePoints(points map[int64]float64) []*samplePoint {
+	result := make([]*samplePoint, 0, len(points))
+	for timestamp, value := range points {
+		if math.IsNaN(value) {
+			continue
+		}
+		result = append(result, &samplePoint{Timestamp: timestamp, Value: value})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Timestamp < result[j].Timestamp
+	})
+	return result
+}
+
+// parseTime parses timestamp(rfc3339 or unix seconds with decimal), returns timestamp in milliseconds.
+func parseTime(s string) (int64, error) {
+	if seconds, err := strconv.ParseFloat(s, 64); err == nil {
+		return int64(math.Round(seconds * 1000)), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse %q to a valid timestamp", s)
+	}
+	return t.UnixMilli(), nil
+}
+
+// parseStep parses step(float seconds or PromQL duration).
+func parseStep(s string) (time.Duration, error) {
+	if seconds, err := strconv.ParseFloat(s, 64); err == nil {
+		if seconds <= 0 {
+			return 0, fmt.Errorf("zero or negative query resolution step widths are not accepted")
+		}
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+	return promql.ParseDuration(s)
+}
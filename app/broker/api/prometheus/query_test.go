@@ -0,0 +1,223 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	brokerQuery "github.com/lindb/lindb/query/broker"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestQueryAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	httpDeps, _, queryFactory := newTestDeps(ctrl)
+	api := NewQueryAPI(httpDeps)
+	r := gin.New()
+	api.Register(r)
+
+	mockTagKeys := func(tagKeys []string, err error) {
+		metadataQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+		queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+		metadataQuery.EXPECT().WaitResponse().Return(tagKeys, err)
+	}
+	mockQuery := func(check func(q *stmtpkg.Query), rs *models.ResultSet, err error) {
+		metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+		queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), "test", gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ models.Node, _ string, q *stmtpkg.Query) brokerQuery.MetricQuery {
+				if check != nil {
+					check(q)
+				}
+				return metricQuery
+			})
+		metricQuery.EXPECT().WaitResponse().Return(rs, err)
+	}
+	resultSet := &models.ResultSet{Series: []*models.Series{
+		{Tags: map[string]string{"host": "h1"}, Fields: map[string]map[int64]float64{
+			"value": {2000: 2, 1000: 1.5},
+		}},
+		{Tags: map[string]string{"host": "h2"}},
+	}}
+
+	cases := []struct {
+		name    string
+		path    string
+		params  url.Values
+		prepare func()
+		code    int
+		body    string
+	}{
+		{
+			name:   "missing param",
+			path:   QueryPath,
+			params: url.Values{"query": {"cpu"}},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "bad time",
+			path:   QueryPath,
+			params: url.Values{"db": {"test"}, "query": {"cpu"}, "time": {"abc"}},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "parse promql failure",
+			path:   QueryPath,
+			params: url.Values{"db": {"test"}, "query": {"cpu +"}},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "translate promql failure",
+			path:   QueryPath,
+			params: url.Values{"db": {"test"}, "query": {"cpu[5m]"}},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:    "find tag keys failure",
+			path:    QueryPath,
+			params:  url.Values{"db": {"test"}, "query": {"cpu"}},
+			prepare: func() { mockTagKeys(nil, fmt.Errorf("err")) },
+			code:    http.StatusUnprocessableEntity,
+		},
+		{
+			name:   "query failure",
+			path:   QueryPath,
+			params: url.Values{"db": {"test"}, "query": {"sum(cpu)"}},
+			prepare: func() {
+				mockQuery(nil, nil, fmt.Errorf("err"))
+			},
+			code: http.StatusUnprocessableEntity,
+		},
+		{
+			name:   "instant query",
+			path:   QueryPath,
+			params: url.Values{"db": {"test"}, "ns": {"ns"}, "query": {"cpu"}, "time": {"2022-01-01T00:00:10Z"}},
+			prepare: func() {
+				mockTagKeys([]string{"host"}, nil)
+				mockQuery(func(q *stmtpkg.Query) {
+					assert.Equal(t, "ns", q.Namespace)
+					assert.Equal(t, []string{"host"}, q.GroupBy)
+					assert.Equal(t, int64(1640995210000), q.TimeRange.End)
+					assert.Equal(t, int64(1640995210000-300000), q.TimeRange.Start)
+				}, resultSet, nil)
+			},
+			code: http.StatusOK,
+			body: `{"status":"success","data":{"resultType":"vector","result":[` +
+				`{"metric":{"__name__":"cpu","host":"h1"},"value":[1640995210,"2"]}]}}`,
+		},
+		{
+			name:   "bad start",
+			path:   QueryRangePath,
+			params: url.Values{"db": {"test"}, "query": {"cpu"}, "start": {"abc"}, "end": {"2"}, "step": {"1"}},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "bad end",
+			path:   QueryRangePath,
+			params: url.Values{"db": {"test"}, "query": {"cpu"}, "start": {"1"}, "end": {"abc"}, "step": {"1"}},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "end before start",
+			path:   QueryRangePath,
+			params: url.Values{"db": {"test"}, "query": {"cpu"}, "start": {"2"}, "end": {"1"}, "step": {"1"}},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "bad step",
+			path:   QueryRangePath,
+			params: url.Values{"db": {"test"}, "query": {"cpu"}, "start": {"1"}, "end": {"2"}, "step": {"-1"}},
+			code:   http.StatusBadRequest,
+		},
+		{
+			name:   "range query",
+			path:   QueryRangePath,
+			params: url.Values{"db": {"test"}, "query": {"sum by (host) (rate(cpu[1m]))"}, "start": {"1"}, "end": {"2.5"}, "step": {"1s"}},
+			prepare: func() {
+				mockQuery(func(q *stmtpkg.Query) {
+					assert.Equal(t, []string{"host"}, q.GroupBy)
+					assert.Equal(t, int64(1000), q.TimeRange.Start)
+					assert.Equal(t, int64(2500), q.TimeRange.End)
+					assert.Equal(t, int64(1000), q.Interval.Int64())
+				}, resultSet, nil)
+			},
+			code: http.StatusOK,
+			body: `{"status":"success","data":{"resultType":"matrix","result":[` +
+				`{"metric":{"host":"h1"},"values":[[1,"1.5"],[2,"2"]]}]}}`,
+		},
+		{
+			name:   "range query with empty result",
+			path:   QueryRangePath,
+			params: url.Values{"db": {"test"}, "query": {"max(cpu)"}, "start": {"1"}, "end": {"2"}, "step": {"0.5"}},
+			prepare: func() {
+				mockQuery(nil, nil, nil)
+			},
+			code: http.StatusOK,
+			body: `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodGet, tt.path+"?"+tt.params.Encode(), "", make(http.Header))
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.code != http.StatusOK {
+				rs := &queryResponse{}
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), rs))
+				assert.Equal(t, statusError, rs.Status)
+				return
+			}
+			assert.Equal(t, tt.body, resp.Body.String())
+		})
+	}
+}
+
+func TestQueryAPI_parse(t *testing.T) {
+	ts, err := parseTime("1641000010.123")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1641000010123), ts)
+	ts, err = parseTime("2022-01-01T00:00:10.5Z")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1640995210500), ts)
+
+	step, err := parseStep("1.5")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1500), step.Milliseconds())
+	step, err = parseStep("1m")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(60000), step.Milliseconds())
+	_, err = parseStep("0")
+	assert.Error(t, err)
+	_, err = parseStep("abc")
+	assert.Error(t, err)
+}
@@ -0,0 +1,455 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/snappy"
+
+	commonconstants "github.com/lindb/common/constants"
+
+	depspkg "github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/constants"
+	ingestCommon "github.com/lindb/lindb/ingestion/common"
+	"github.com/lindb/lindb/ingestion/prometheus"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/pkg/timeutil"
+	protoPrometheusV1 "github.com/lindb/lindb/proto/gen/v1/prometheus"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/sql/promql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+var (
+	// RemoteWritePath represents prometheus remote write http api router path.
+	RemoteWritePath = "/prom/write"
+	// RemoteReadPath represents prometheus remote read http api router path.
+	RemoteReadPath = "/prom/read"
+)
+
+// errMissingMetricName represents remote read query without __name__ equal matcher.
+var errMissingMetricName = errors.New("prometheus remote read requires __name__ equal matcher")
+
+// RemoteAPI represents prometheus remote write/read api.
+type RemoteAPI struct {
+	deps *depspkg.HTTPDeps
+}
+
+// NewRemoteAPI creates a prometheus remote write/read api instance.
+func NewRemoteAPI(deps *depspkg.HTTPDeps) *RemoteAPI {
+	return &RemoteAPI{
+		deps: deps,
+	}
+}
+
+// Register adds prometheus remote write/read url route.
+func (r *RemoteAPI) Register(route gin.IRoutes) {
+	route.POST(RemoteWritePath, r.Write)
+	route.PUT(RemoteWritePath, r.Write)
+	route.POST(RemoteReadPath, r.Read)
+}
+
+// Write processes prometheus remote write data with ingest limit.
+//
+// @BasePath /api/v1
+// @Summary prometheus remote write
+// @Schemes
+// @Description receive prometheus remote write data(snappy compressed protobuf),
+// @Description labels are mapped to tags, __name__ is mapped to metric name,
+// @Description then write data via database channel.
+// @Tags Write
+// @Accept application/x-protobuf
+// @Param db query string true "database name"
+// @Param ns query string false "namespace, default value: default-ns"
+// @Param string body string ture "metric data"
+// @Produce plain
+// @Success 204 {string} string ""
+// @Failure 500 {string} string "internal error"
+// @Router /prom/write [post]
+func (r *RemoteAPI) Write(c *gin.Context) {
+	if err := r.deps.IngestLimiter.Do(func() error {
+		return r.write(c)
+	}); err != nil {
+		httppkg.Error(c, err)
+	} else {
+		httppkg.NoContent(c)
+	}
+}
+
+// write parses prometheus remote write data, then writes parsed data to database's write channel.
+func (r *RemoteAPI) write(c *gin.Context) error {
+	param, err := bindParam(c)
+	if err != nil {
+		return err
+	}
+	if err = middleware.Authorize(c, param.Database, models.RoleWrite); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(),
+		r.deps.BrokerCfg.BrokerBase.Ingestion.IngestTimeout.Duration())
+	defer cancel()
+
+	enrichedTags, err := ingestCommon.ExtractEnrichTags(c.Request)
+	if err != nil {
+		return err
+	}
+	rows, err := prometheus.Parse(c.Request, enrichedTags, param.Namespace)
+	if err != nil {
+		return err
+	}
+	return r.deps.CM.Write(ctx, param.Database, rows)
+}
+
+// Read processes prometheus remote read request with query limit.
+//
+// @BasePath /api/v1
+// @Summary prometheus remote read
+// @Schemes
+// @Description receive prometheus remote read request(snappy compressed protobuf),
+// @Description query the data via query engine, only support sampled response type.
+// @Tags Query
+// @Accept application/x-protobuf
+// @Param db query string true "database name"
+// @Param ns query string false "namespace, default value: default-ns"
+// @Param string body string ture "read request"
+// @Produce application/x-protobuf
+// @Success 200 {string} string "snappy compressed read response"
+// @Failure 500 {string} string "internal error"
+// @Router /prom/read [post]
+func (r *RemoteAPI) Read(c *gin.Context) {
+	if err := r.deps.QueryLimiter.Do(func() error {
+		return r.read(c)
+	}); err != nil {
+		httppkg.Error(c, err)
+	}
+}
+
+// read executes all queries of prometheus remote read request, then returns sampled response.
+func (r *RemoteAPI) read(c *gin.Context) error {
+	param, err := bindParam(c)
+	if err != nil {
+		return err
+	}
+	if err = middleware.Authorize(c, param.Database, models.RoleRead); err != nil {
+		return err
+	}
+	compressed, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return err
+	}
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return fmt.Errorf("corrupted snappy data: %w", err)
+	}
+	var readReq protoPrometheusV1.ReadRequest
+	if err := readReq.Unmarshal(data); err != nil {
+		return err
+	}
+	ctx, cancel := r.deps.WithTimeout()
+	defer cancel()
+
+	readResp := &protoPrometheusV1.ReadResponse{}
+	for _, query := range readReq.Queries {
+		result, err := r.query(ctx, param.Database, param.Namespace, query)
+		if err != nil {
+			return err
+		}
+		readResp.Results = append(readResp.Results, result)
+	}
+	data, err = readResp.Marshal()
+	if err != nil {
+		return err
+	}
+	c.Header("Content-Encoding", "snappy")
+	c.Data(http.StatusOK, "application/x-protobuf", snappy.Encode(nil, data))
+	return nil
+}
+
+// query executes prometheus query via query engine, group by all tag keys of metric.
+func (r *RemoteAPI) query(ctx context.Context, database, namespace string,
+	query *protoPrometheusV1.Query) (*protoPrometheusV1.QueryResult, error) {
+	metricName, condition, err := buildCondition(query.Matchers)
+	if err != nil {
+		return nil, err
+	}
+	target, err := findReadTarget(ctx, r.deps, database, namespace, metricName)
+	if err != nil {
+		return nil, err
+	}
+	if len(target.fields) == 0 {
+		// metric not exist
+		return &protoPrometheusV1.QueryResult{}, nil
+	}
+	tagKeys, err := findTagKeys(ctx, r.deps, database, namespace, target.metricName)
+	if err != nil {
+		return nil, err
+	}
+	selectItems := make([]stmtpkg.Expr, len(target.fields))
+	for idx, fieldName := range target.fields {
+		selectItems[idx] = &stmtpkg.SelectItem{Expr: &stmtpkg.FieldExpr{Name: fieldName}}
+	}
+	resultSet, err := r.deps.QueryFactory.NewMetricQuery(ctx, r.deps.Node, database, &stmtpkg.Query{
+		Namespace:   namespace,
+		MetricName:  target.metricName,
+		SelectItems: selectItems,
+		Condition:   condition,
+		TimeRange:   timeutil.TimeRange{Start: query.StartTimestampMs, End: query.EndTimestampMs},
+		GroupBy:     tagKeys,
+	}).WaitResponse()
+	if err != nil {
+		return nil, err
+	}
+	return toQueryResult(metricName, target, resultSet), nil
+}
+
+// readTarget represents the metric/fields which stores the data of prometheus metric.
+type readTarget struct {
+	metricName string
+	fields     []string
+	// suffix is the histogram suffix(_bucket/_sum/_count) if it reads histogram of metric.
+	suffix string
+}
+
+// findReadTarget returns the metric/fields which stores the data of prometheus metric,
+// 1. all simple fields of metric, "value" field is returned as metric name, others as metric_field;
+// 2. if metric name is xxx_bucket/xxx_sum/xxx_count, returns the histogram fields of metric xxx.
+func findReadTarget(ctx context.Context, deps *depspkg.HTTPDeps, database, namespace, metricName string) (*readTarget, error) {
+	fields, err := findFields(ctx, deps, database, namespace, metricName)
+	if err != nil {
+		return nil, err
+	}
+	target := &readTarget{metricName: metricName}
+	for _, f := range fields {
+		if f.Type != field.HistogramField {
+			target.fields = append(target.fields, f.Name.String())
+		}
+	}
+	if len(target.fields) > 0 {
+		return target, nil
+	}
+	baseName, suffix := prometheus.SplitHistogramName(metricName)
+	if suffix == "" {
+		return target, nil
+	}
+	fields, err = findFields(ctx, deps, database, namespace, baseName)
+	if err != nil {
+		return nil, err
+	}
+	target = &readTarget{metricName: baseName, suffix: suffix}
+	for _, f := range fields {
+		switch {
+		case suffix == prometheus.BucketSuffix && f.Type == field.HistogramField,
+			suffix == prometheus.SumSuffix && f.Name == metric.HistogramSumField,
+			suffix == prometheus.CountSuffix && f.Name == metric.HistogramCountField:
+			target.fields = append(target.fields, f.Name.String())
+		}
+	}
+	return target, nil
+}
+
+// findFields returns all fields of metric.
+func findFields(ctx context.Context, deps *depspkg.HTTPDeps, database, namespace, metricName string) (field.Metas, error) {
+	values, err := deps.QueryFactory.NewMetadataQuery(ctx, database, &stmtpkg.MetricMetadata{
+		Namespace:  namespace,
+		MetricName: metricName,
+		Type:       stmtpkg.Field,
+		Limit:      constants.MaxSuggestions,
+	}).WaitResponse()
+	if err != nil {
+		return nil, err
+	}
+	// merge fields of all storage nodes
+	fieldNames := make(map[field.Name]struct{})
+	var result field.Metas
+	for _, value := range values {
+		var fields field.Metas
+		if err := encoding.JSONUnmarshal([]byte(value), &fields); err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			if _, ok := fieldNames[f.Name]; ok {
+				continue
+			}
+			fieldNames[f.Name] = struct{}{}
+			result = append(result, f)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// findTagKeys returns all tag keys of metric, which are used to group by for returning each series.
+func findTagKeys(ctx context.Context, deps *depspkg.HTTPDeps, database, namespace, metricName string) ([]string, error) {
+	tagKeys, err := deps.QueryFactory.NewMetadataQuery(ctx, database, &stmtpkg.MetricMetadata{
+		Namespace:  namespace,
+		MetricName: metricName,
+		Type:       stmtpkg.TagKey,
+		Limit:      constants.MaxSuggestions,
+	}).WaitResponse()
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(tagKeys)
+	return tagKeys, nil
+}
+
+// remoteParam represents the url query param of prometheus remote write/read.
+type remoteParam struct {
+	Database  string `form:"db" binding:"required"`
+	Namespace string `form:"ns"`
+}
+
+// bindParam binds database/namespace from url query.
+func bindParam(c *gin.Context) (*remoteParam, error) {
+	param := &remoteParam{}
+	if err := c.ShouldBindQuery(param); err != nil {
+		return nil, err
+	}
+	if param.Namespace == "" {
+		param.Namespace = commonconstants.DefaultNamespace
+	}
+	return param, nil
+}
+
+// buildCondition returns metric name and tag filter condition based on label matchers.
+func buildCondition(matchers []*protoPrometheusV1.LabelMatcher) (metricName string, condition stmtpkg.Expr, err error) {
+	var labelMatchers []*promql.LabelMatcher
+	for _, matcher := range matchers {
+		if matcher.Name == prometheus.MetricNameLabel {
+			if matcher.Type != protoPrometheusV1.LabelMatcher_EQ {
+				return "", nil, errMissingMetricName
+			}
+			metricName = matcher.Value
+			continue
+		}
+		labelMatchers = append(labelMatchers, &promql.LabelMatcher{
+			Type:  promql.MatchType(matcher.Type),
+			Name:  matcher.Name,
+			Value: matcher.Value,
+		})
+	}
+	if metricName == "" {
+		return "", nil, errMissingMetricName
+	}
+	condition, err = promql.ConditionOf(labelMatchers)
+	if err != nil {
+		return "", nil, err
+	}
+	return metricName, condition, nil
+}
+
+// toQueryResult converts query result set to prometheus time series.
+func toQueryResult(metricName string, target *readTarget, resultSet *models.ResultSet) *protoPrometheusV1.QueryResult {
+	result := &protoPrometheusV1.QueryResult{}
+	if resultSet == nil {
+		return result
+	}
+	for _, series := range resultSet.Series {
+		if target.suffix == prometheus.BucketSuffix {
+			result.Timeseries = append(result.Timeseries, toBucketSeries(metricName, series)...)
+			continue
+		}
+		for _, fieldName := range target.fields {
+			name := metricName
+			if target.suffix == "" && fieldName != prometheus.ValueField {
+				name = metricName + "_" + fieldName
+			}
+			if ts := toTimeSeries(name, series.Tags, series.Fields[fieldName]); ts != nil {
+				result.Timeseries = append(result.Timeseries, ts)
+			}
+		}
+	}
+	return result
+}
+
+// toBucketSeries converts histogram bucket fields to cumulative xxx_bucket series with le label.
+func toBucketSeries(metricName string, series *models.Series) (result []*protoPrometheusV1.TimeSeries) {
+	var upperBounds []float64
+	buckets := make(map[float64]map[int64]float64)
+	for fieldName, points := range series.Fields {
+		upperBound, err := metric.UpperBound(fieldName)
+		if err != nil {
+			continue
+		}
+		upperBounds = append(upperBounds, upperBound)
+		buckets[upperBound] = points
+	}
+	sort.Float64s(upperBounds)
+	// stored bucket is count of (previous bound, bound], prometheus bucket is count of (-Inf, bound]
+	cumulative := make(map[int64]float64)
+	for _, upperBound := range upperBounds {
+		points := make(map[int64]float64)
+		for timestamp, value := range buckets[upperBound] {
+			cumulative[timestamp] += value
+		}
+		for timestamp, value := range cumulative {
+			points[timestamp] = value
+		}
+		tags := make(map[string]string, len(series.Tags)+1)
+		for key, value := range series.Tags {
+			tags[key] = value
+		}
+		tags[prometheus.BucketLabel] = strconv.FormatFloat(upperBound, 'f', -1, 64)
+		if ts := toTimeSeries(metricName, tags, points); ts != nil {
+			result = append(result, ts)
+		}
+	}
+	return result
+}
+
+// toTimeSeries converts points of series to prometheus time series, returns nil if no points.
+func toTimeSeries(name string, tags map[string]string, points map[int64]float64) *protoPrometheusV1.TimeSeries {
+	if len(points) == 0 {
+		return nil
+	}
+	ts := &protoPrometheusV1.TimeSeries{
+		Labels: []*protoPrometheusV1.Label{{Name: prometheus.MetricNameLabel, Value: name}},
+	}
+	for key, value := range tags {
+		ts.Labels = append(ts.Labels, &protoPrometheusV1.Label{Name: key, Value: value})
+	}
+	// prometheus requires labels sorted by name
+	sort.Slice(ts.Labels, func(i, j int) bool {
+		return ts.Labels[i].Name < ts.Labels[j].Name
+	})
+	timestamps := make([]int64, 0, len(points))
+	for timestamp := range points {
+		timestamps = append(timestamps, timestamp)
+	}
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i] < timestamps[j]
+	})
+	for _, timestamp := range timestamps {
+		ts.Samples = append(ts.Samples, &protoPrometheusV1.Sample{Value: points[timestamp], Timestamp: timestamp})
+	}
+	return ts
+}
@@ -0,0 +1,441 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/app/broker/deps"
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/ingestion/prometheus"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/ltoml"
+	protoPrometheusV1 "github.com/lindb/lindb/proto/gen/v1/prometheus"
+	brokerQuery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func newTestDeps(ctrl *gomock.Controller) (*deps.HTTPDeps, *replica.MockChannelManager, *brokerQuery.MockFactory) {
+	cm := replica.NewMockChannelManager(ctrl)
+	queryFactory := brokerQuery.NewMockFactory(ctrl)
+	return &deps.HTTPDeps{
+		Ctx: context.Background(),
+		BrokerCfg: &config.Broker{
+			BrokerBase: config.BrokerBase{
+				HTTP: config.HTTP{ReadTimeout: ltoml.Duration(time.Second * 10)},
+				Ingestion: config.Ingestion{
+					IngestTimeout: ltoml.Duration(time.Second * 2),
+				},
+			},
+		},
+		CM:           cm,
+		QueryFactory: queryFactory,
+		IngestLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("prometheus_write_test", linmetric.BrokerRegistry)),
+		QueryLimiter: concurrent.NewLimiter(
+			context.TODO(),
+			32,
+			time.Second,
+			metrics.NewLimitStatistics("prometheus_read_test", linmetric.BrokerRegistry)),
+	}, cm, queryFactory
+}
+
+func newTestRemoteAPI(ctrl *gomock.Controller) (*gin.Engine, *replica.MockChannelManager, *brokerQuery.MockFactory) {
+	httpDeps, cm, queryFactory := newTestDeps(ctrl)
+	api := NewRemoteAPI(httpDeps)
+	r := gin.New()
+	api.Register(r)
+	return r, cm, queryFactory
+}
+
+func TestRemoteAPI_Write(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, cm, _ := newTestRemoteAPI(ctrl)
+	writeReq := &protoPrometheusV1.WriteRequest{
+		Timeseries: []*protoPrometheusV1.TimeSeries{{
+			Labels: []*protoPrometheusV1.Label{
+				{Name: prometheus.MetricNameLabel, Value: "cpu"},
+				{Name: "host", Value: "h1"},
+			},
+			Samples: []*protoPrometheusV1.Sample{{Value: 1, Timestamp: time.Now().UnixMilli()}},
+		}},
+	}
+	data, err := writeReq.Marshal()
+	assert.NoError(t, err)
+	body := string(snappy.Encode(nil, data))
+
+	// missing db param
+	resp := mock.DoRequest(t, r, http.MethodPost, RemoteWritePath, body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// enrich_tag bad format
+	resp = mock.DoRequest(t, r, http.MethodPost, RemoteWritePath+"?db=test&enrich_tag=a", body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// parse err
+	resp = mock.DoRequest(t, r, http.MethodPost, RemoteWritePath+"?db=test", "error")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// write err
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).Return(fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodPost, RemoteWritePath+"?db=test", body)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// write ok
+	cm.EXPECT().Write(gomock.Any(), "test", gomock.Any()).Return(nil)
+	resp = mock.DoRequest(t, r, http.MethodPut, RemoteWritePath+"?db=test&ns=ns&enrich_tag=a=b", body)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+}
+
+func TestRemoteAPI_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r, _, queryFactory := newTestRemoteAPI(ctrl)
+	makeBody := func(matchers ...*protoPrometheusV1.LabelMatcher) string {
+		readReq := &protoPrometheusV1.ReadRequest{Queries: []*protoPrometheusV1.Query{{
+			StartTimestampMs: 1000,
+			EndTimestampMs:   2000,
+			Matchers:         matchers,
+		}}}
+		data, err := readReq.Marshal()
+		assert.NoError(t, err)
+		return string(snappy.Encode(nil, data))
+	}
+	nameMatcher := &protoPrometheusV1.LabelMatcher{Name: prometheus.MetricNameLabel, Value: "cpu"}
+	body := makeBody(nameMatcher)
+	expectMetadata := func(values ...string) {
+		metadataQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+		queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+		metadataQuery.EXPECT().WaitResponse().Return(values, nil)
+	}
+	fieldsOf := func(metas ...field.Meta) string {
+		return string(encoding.JSONMarshal(field.Metas(metas)))
+	}
+	valueField := field.Meta{Name: prometheus.ValueField, Type: field.LastField}
+	histogramFields := fieldsOf(
+		field.Meta{Name: "__bucket_0.5", Type: field.HistogramField},
+		field.Meta{Name: "__bucket_+Inf", Type: field.HistogramField},
+		field.Meta{Name: "__bucket_0.1", Type: field.HistogramField},
+		field.Meta{Name: metric.HistogramSumField, Type: field.SumField},
+		field.Meta{Name: metric.HistogramCountField, Type: field.SumField},
+	)
+
+	cases := []struct {
+		name    string
+		path    string
+		reqBody string
+		prepare func()
+		assert  func(code int, readResp *protoPrometheusV1.ReadResponse)
+	}{
+		{
+			name:    "missing db param",
+			path:    RemoteReadPath,
+			reqBody: body,
+		},
+		{
+			name:    "bad snappy data",
+			path:    RemoteReadPath + "?db=test",
+			reqBody: "bad-data",
+		},
+		{
+			name:    "bad proto data",
+			path:    RemoteReadPath + "?db=test",
+			reqBody: string(snappy.Encode(nil, []byte("bad-data"))),
+		},
+		{
+			name:    "missing metric name",
+			path:    RemoteReadPath + "?db=test",
+			reqBody: makeBody(&protoPrometheusV1.LabelMatcher{Name: "host", Value: "h1"}),
+		},
+		{
+			name:    "metadata query failure",
+			path:    RemoteReadPath + "?db=test",
+			reqBody: body,
+			prepare: func() {
+				metadataQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name:    "bad field metadata",
+			path:    RemoteReadPath + "?db=test",
+			reqBody: body,
+			prepare: func() {
+				expectMetadata("bad-json")
+			},
+		},
+		{
+			name:    "tag keys query failure",
+			path:    RemoteReadPath + "?db=test",
+			reqBody: body,
+			prepare: func() {
+				expectMetadata(fieldsOf(valueField))
+				metadataQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name:    "histogram base metric query failure",
+			path:    RemoteReadPath + "?db=test",
+			reqBody: makeBody(&protoPrometheusV1.LabelMatcher{Name: prometheus.MetricNameLabel, Value: "latency_sum"}),
+			prepare: func() {
+				expectMetadata()
+				metadataQuery := brokerQuery.NewMockMetaDataQuery(ctrl)
+				queryFactory.EXPECT().NewMetadataQuery(gomock.Any(), "test", gomock.Any()).Return(metadataQuery)
+				metadataQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name:    "metric not exist",
+			path:    RemoteReadPath + "?db=test",
+			reqBody: body,
+			prepare: func() {
+				expectMetadata()
+			},
+			assert: func(code int, readResp *protoPrometheusV1.ReadResponse) {
+				assert.Equal(t, http.StatusOK, code)
+				assert.Len(t, readResp.Results, 1)
+				assert.Empty(t, readResp.Results[0].Timeseries)
+			},
+		},
+		{
+			name:    "metric query failure",
+			path:    RemoteReadPath + "?db=test",
+			reqBody: body,
+			prepare: func() {
+				expectMetadata(fieldsOf(valueField))
+				expectMetadata("host")
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), "test", gomock.Any()).Return(metricQuery)
+				metricQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name:    "read successfully",
+			path:    RemoteReadPath + "?db=test&ns=ns",
+			reqBody: body,
+			prepare: func() {
+				expectMetadata(fieldsOf(valueField, field.Meta{Name: "max", Type: field.MaxField}),
+					fieldsOf(valueField))
+				expectMetadata("host")
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), "test", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ models.Node, _ string, q *stmtpkg.Query) brokerQuery.MetricQuery {
+						assert.Len(t, q.SelectItems, 2)
+						assert.Equal(t, "ns", q.Namespace)
+						assert.Equal(t, "cpu", q.MetricName)
+						assert.Equal(t, []string{"host"}, q.GroupBy)
+						assert.Equal(t, int64(1000), q.TimeRange.Start)
+						assert.Equal(t, int64(2000), q.TimeRange.End)
+						return metricQuery
+					})
+				metricQuery.EXPECT().WaitResponse().Return(&models.ResultSet{Series: []*models.Series{
+					{Tags: map[string]string{"host": "h1"}, Fields: map[string]map[int64]float64{
+						prometheus.ValueField: {2000: 2, 1000: 1},
+						"max":                 {1000: 5},
+					}},
+					{Tags: map[string]string{"host": "h2"}},
+				}}, nil)
+			},
+			assert: func(code int, readResp *protoPrometheusV1.ReadResponse) {
+				assert.Equal(t, http.StatusOK, code)
+				assert.Len(t, readResp.Results, 1)
+				assert.Len(t, readResp.Results[0].Timeseries, 2)
+				ts := readResp.Results[0].Timeseries[0]
+				assert.Equal(t, []*protoPrometheusV1.Label{
+					{Name: prometheus.MetricNameLabel, Value: "cpu_max"},
+					{Name: "host", Value: "h1"},
+				}, ts.Labels)
+				assert.Equal(t, []*protoPrometheusV1.Sample{{Value: 5, Timestamp: 1000}}, ts.Samples)
+				ts = readResp.Results[0].Timeseries[1]
+				assert.Equal(t, []*protoPrometheusV1.Label{
+					{Name: prometheus.MetricNameLabel, Value: "cpu"},
+					{Name: "host", Value: "h1"},
+				}, ts.Labels)
+				assert.Equal(t, []*protoPrometheusV1.Sample{
+					{Value: 1, Timestamp: 1000},
+					{Value: 2, Timestamp: 2000},
+				}, ts.Samples)
+			},
+		},
+		{
+			name:    "read histogram sum",
+			path:    RemoteReadPath + "?db=test",
+			reqBody: makeBody(&protoPrometheusV1.LabelMatcher{Name: prometheus.MetricNameLabel, Value: "latency_sum"}),
+			prepare: func() {
+				expectMetadata()
+				expectMetadata(histogramFields)
+				expectMetadata("host")
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), "test", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ models.Node, _ string, q *stmtpkg.Query) brokerQuery.MetricQuery {
+						assert.Equal(t, "latency", q.MetricName)
+						assert.Equal(t, []stmtpkg.Expr{&stmtpkg.SelectItem{
+							Expr: &stmtpkg.FieldExpr{Name: metric.HistogramSumField.String()},
+						}}, q.SelectItems)
+						return metricQuery
+					})
+				metricQuery.EXPECT().WaitResponse().Return(&models.ResultSet{Series: []*models.Series{
+					{Tags: map[string]string{"host": "h1"}, Fields: map[string]map[int64]float64{
+						metric.HistogramSumField.String(): {1000: 10},
+					}},
+				}}, nil)
+			},
+			assert: func(code int, readResp *protoPrometheusV1.ReadResponse) {
+				assert.Equal(t, http.StatusOK, code)
+				assert.Len(t, readResp.Results[0].Timeseries, 1)
+				ts := readResp.Results[0].Timeseries[0]
+				assert.Equal(t, []*protoPrometheusV1.Label{
+					{Name: prometheus.MetricNameLabel, Value: "latency_sum"},
+					{Name: "host", Value: "h1"},
+				}, ts.Labels)
+				assert.Equal(t, []*protoPrometheusV1.Sample{{Value: 10, Timestamp: 1000}}, ts.Samples)
+			},
+		},
+		{
+			name:    "read histogram bucket",
+			path:    RemoteReadPath + "?db=test",
+			reqBody: makeBody(&protoPrometheusV1.LabelMatcher{Name: prometheus.MetricNameLabel, Value: "latency_bucket"}),
+			prepare: func() {
+				expectMetadata()
+				expectMetadata(histogramFields)
+				expectMetadata("host")
+				metricQuery := brokerQuery.NewMockMetricQuery(ctrl)
+				queryFactory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), "test", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ models.Node, _ string, q *stmtpkg.Query) brokerQuery.MetricQuery {
+						assert.Equal(t, "latency", q.MetricName)
+						assert.Len(t, q.SelectItems, 3)
+						return metricQuery
+					})
+				metricQuery.EXPECT().WaitResponse().Return(&models.ResultSet{Series: []*models.Series{
+					{Tags: map[string]string{"host": "h1"}, Fields: map[string]map[int64]float64{
+						"__bucket_0.1":  {1000: 1},
+						"__bucket_0.5":  {1000: 2},
+						"__bucket_+Inf": {1000: 3},
+					}},
+				}}, nil)
+			},
+			assert: func(code int, readResp *protoPrometheusV1.ReadResponse) {
+				assert.Equal(t, http.StatusOK, code)
+				series := readResp.Results[0].Timeseries
+				assert.Len(t, series, 3)
+				// buckets are cumulative
+				for idx, expect := range []struct {
+					le    string
+					value float64
+				}{{"0.1", 1}, {"0.5", 3}, {"+Inf", 6}} {
+					assert.Equal(t, []*protoPrometheusV1.Label{
+						{Name: prometheus.MetricNameLabel, Value: "latency_bucket"},
+						{Name: "host", Value: "h1"},
+						{Name: prometheus.BucketLabel, Value: expect.le},
+					}, series[idx].Labels)
+					assert.Equal(t, []*protoPrometheusV1.Sample{{Value: expect.value, Timestamp: 1000}}, series[idx].Samples)
+				}
+			},
+		},
+		{
+			name:    "not histogram metric",
+			path:    RemoteReadPath + "?db=test",
+			reqBody: makeBody(&protoPrometheusV1.LabelMatcher{Name: prometheus.MetricNameLabel, Value: "latency_count"}),
+			prepare: func() {
+				expectMetadata()
+				expectMetadata()
+			},
+			assert: func(code int, readResp *protoPrometheusV1.ReadResponse) {
+				assert.Equal(t, http.StatusOK, code)
+				assert.Empty(t, readResp.Results[0].Timeseries)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPost, tt.path, tt.reqBody)
+			if tt.assert == nil {
+				assert.Equal(t, http.StatusInternalServerError, resp.Code)
+				return
+			}
+			assert.Equal(t, "snappy", resp.Header().Get("Content-Encoding"))
+			data, err := snappy.Decode(nil, resp.Body.Bytes())
+			assert.NoError(t, err)
+			readResp := &protoPrometheusV1.ReadResponse{}
+			assert.NoError(t, readResp.Unmarshal(data))
+			tt.assert(resp.Code, readResp)
+		})
+	}
+}
+
+func TestRemoteAPI_buildCondition(t *testing.T) {
+	metricName, condition, err := buildCondition([]*protoPrometheusV1.LabelMatcher{
+		{Name: prometheus.MetricNameLabel, Value: "cpu"},
+		{Type: protoPrometheusV1.LabelMatcher_EQ, Name: "host", Value: "h1"},
+		{Type: protoPrometheusV1.LabelMatcher_NEQ, Name: "ip", Value: "1.1.1.1"},
+		{Type: protoPrometheusV1.LabelMatcher_RE, Name: "zone", Value: "sh.*"},
+		{Type: protoPrometheusV1.LabelMatcher_NRE, Name: "app", Value: "test.*"},
+		{Type: protoPrometheusV1.LabelMatcher_EQ, Name: "empty", Value: ""},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "cpu", metricName)
+	assert.Equal(t, &stmtpkg.BinaryExpr{
+		Left: &stmtpkg.BinaryExpr{
+			Left: &stmtpkg.BinaryExpr{
+				Left:     &stmtpkg.EqualsExpr{Key: "host", Value: "h1"},
+				Operator: stmtpkg.AND,
+				Right:    &stmtpkg.NotExpr{Expr: &stmtpkg.EqualsExpr{Key: "ip", Value: "1.1.1.1"}},
+			},
+			Operator: stmtpkg.AND,
+			Right:    &stmtpkg.RegexExpr{Key: "zone", Regexp: "sh.*"},
+		},
+		Operator: stmtpkg.AND,
+		Right:    &stmtpkg.NotExpr{Expr: &stmtpkg.RegexExpr{Key: "app", Regexp: "test.*"}},
+	}, condition)
+
+	_, _, err = buildCondition([]*protoPrometheusV1.LabelMatcher{
+		{Type: protoPrometheusV1.LabelMatcher_RE, Name: prometheus.MetricNameLabel, Value: "cpu.*"},
+	})
+	assert.Equal(t, errMissingMetricName, err)
+	_, _, err = buildCondition([]*protoPrometheusV1.LabelMatcher{
+		{Name: prometheus.MetricNameLabel, Value: "cpu"},
+		{Type: protoPrometheusV1.LabelMatcher_Type(10), Name: "host", Value: "h1"},
+	})
+	assert.Error(t, err)
+}
@@ -56,7 +56,7 @@ func (p *ReverseProxy) Proxy(c *gin.Context) {
 		return
 	}
 	director := func(req *http.Request) {
-		req.URL.Scheme = "http"
+		req.URL.Scheme = httppkg.ClientScheme()
 		req.URL.Host = param.Target
 		req.URL.Path = param.Path
 		req.URL.RawQuery = c.Request.URL.RawQuery
@@ -74,7 +74,7 @@ type transport struct {
 
 // RoundTrip removes cors http header.
 func (t transport) RoundTrip(r *http.Request) (*http.Response, error) {
-	resp, err := http.DefaultTransport.RoundTrip(r)
+	resp, err := httppkg.ClientTransport().RoundTrip(r)
 	// if target server enable cors, maybe add duplicate Access-Control-Allow-Origin header.
 	resp.Header.Del("Access-Control-Allow-Origin")
 	return resp, err
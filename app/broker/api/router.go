@@ -23,42 +23,64 @@ import (
 	"github.com/lindb/lindb/app/broker/api/admin"
 	"github.com/lindb/lindb/app/broker/api/exec"
 	"github.com/lindb/lindb/app/broker/api/ingest"
+	"github.com/lindb/lindb/app/broker/api/prometheus"
 	"github.com/lindb/lindb/app/broker/api/state"
 	depspkg "github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/monitoring"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
 )
 
 // API represents broker http api.
 type API struct {
+	authEnabled    bool
+	authentication middleware.Authentication
+	login          *LoginAPI
+
 	execute *exec.ExecuteAPI
 
 	database           *admin.DatabaseAPI
 	flusher            *admin.DatabaseFlusherAPI
 	storage            *admin.StorageClusterAPI
+	rebalance          *admin.RebalanceAPI
+	backup             *admin.BackupAPI
+	continuousQuery    *admin.ContinuousQueryAPI
 	brokerStateMachine *state.BrokerStateMachineAPI
 	request            *state.RequestAPI
 	metricExplore      *monitoring.ExploreAPI
 	log                *monitoring.LoggerAPI
 	config             *monitoring.ConfigAPI
 	write              *ingest.Write
+	otlp               *ingest.OTLPWrite
+	prometheus         *prometheus.RemoteAPI
+	promQL             *prometheus.QueryAPI
 	proxy              *ReverseProxy
 }
 
 // NewAPI creates broker http api.
 func NewAPI(deps *depspkg.HTTPDeps) *API {
 	return &API{
+		authEnabled:        deps.BrokerCfg.BrokerBase.Auth.Enabled,
+		authentication:     middleware.NewAuthentication(deps.UserStore),
+		login:              NewLoginAPI(deps),
 		execute:            exec.NewExecuteAPI(deps),
 		database:           admin.NewDatabaseAPI(deps),
 		flusher:            admin.NewDatabaseFlusherAPI(deps),
 		storage:            admin.NewStorageClusterAPI(deps),
+		rebalance:          admin.NewRebalanceAPI(deps),
+		backup:             admin.NewBackupAPI(deps),
+		continuousQuery:    admin.NewContinuousQueryAPI(deps),
 		brokerStateMachine: state.NewBrokerStateMachineAPI(deps),
 		request:            state.NewRequestAPI(),
 		metricExplore:      monitoring.NewExploreAPI(deps.GlobalKeyValues, linmetric.BrokerRegistry),
 		log:                monitoring.NewLoggerAPI(deps.BrokerCfg.Logging.Dir),
 		config:             monitoring.NewConfigAPI(deps.Node, deps.BrokerCfg),
 		write:              ingest.NewWrite(deps),
+		otlp:               ingest.NewOTLPWrite(deps),
+		prometheus:         prometheus.NewRemoteAPI(deps),
+		promQL:             prometheus.NewQueryAPI(deps),
 		proxy:              NewReverseProxy(),
 	}
 }
@@ -66,24 +88,45 @@ func NewAPI(deps *depspkg.HTTPDeps) *API {
 // RegisterRouter registers http api router.
 func (api *API) RegisterRouter(router *gin.RouterGroup) {
 	v1 := router.Group(constants.APIVersion1)
+	// login(without authentication)
+	api.login.Register(v1)
+
+	// user must be authenticated if auth enabled,
+	// the role on database is checked by api(write/query data) or admin router group.
+	authorized := v1.Group("")
+	if api.authEnabled {
+		authorized.Use(api.authentication.Validate())
+	}
+	// cluster/database management, state and monitoring api require admin role on all databases.
+	admin := authorized.Group("", middleware.RequireRole(models.RoleAdmin))
+
 	// execute lin query language statement
-	api.execute.Register(v1)
+	api.execute.Register(authorized)
+	// execute PromQL query
+	api.promQL.Register(authorized)
 
-	api.database.Register(v1)
-	api.flusher.Register(v1)
-	api.storage.Register(v1)
+	api.database.Register(admin)
+	api.flusher.Register(admin)
+	api.storage.Register(admin)
+	api.rebalance.Register(admin)
+	api.backup.Register(admin)
+	api.continuousQuery.Register(admin)
 
 	// state
-	api.brokerStateMachine.Register(v1)
-	api.request.Register(v1)
+	api.brokerStateMachine.Register(admin)
+	api.request.Register(admin)
 
 	// write metric data
-	api.write.Register(v1)
+	api.write.Register(authorized)
+	// prometheus remote write/read
+	api.prometheus.Register(authorized)
+	// OpenTelemetry OTLP/HTTP metrics receiver
+	api.otlp.Register(authorized)
 
 	// monitoring
-	api.metricExplore.Register(v1)
-	api.log.Register(v1)
-	api.config.Register(v1)
+	api.metricExplore.Register(admin)
+	api.log.Register(admin)
+	api.config.Register(admin)
 
-	api.proxy.Register(v1)
+	api.proxy.Register(admin)
 }
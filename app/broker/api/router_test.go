@@ -18,16 +18,84 @@
 package api
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/app/broker/api/admin"
+	"github.com/lindb/lindb/app/broker/api/exec"
+	"github.com/lindb/lindb/app/broker/auth"
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
 )
 
 func TestNewRouter(t *testing.T) {
 	r := NewAPI(&deps.HTTPDeps{BrokerCfg: &config.Broker{}})
 	r.RegisterRouter(gin.New().Group(constants.APIRoot))
 }
+
+func TestNewRouter_Auth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user := &models.User{Name: "bob", Type: models.UserTypeUser, Password: "pwd"}
+	token, err := middleware.CreateToken(user, time.Hour)
+	assert.NoError(t, err)
+	store := auth.NewMockUserStore(ctrl)
+	store.EXPECT().GetUser("bob").Return(user, true).AnyTimes()
+	store.EXPECT().Login(gomock.Any(), gomock.Any()).Return(nil, false).AnyTimes()
+
+	cfg := &config.Broker{BrokerBase: *config.NewDefaultBrokerBase()}
+	cfg.BrokerBase.Auth.Enabled = true
+	r := NewAPI(&deps.HTTPDeps{BrokerCfg: cfg, UserStore: store})
+	router := gin.New()
+	r.RegisterRouter(router.Group(constants.APIRoot))
+
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		code   int
+	}{
+		{
+			name:   "login without authentication",
+			method: http.MethodPut,
+			path:   LoginPath,
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "execute without token",
+			method: http.MethodGet,
+			path:   exec.ExecutePath,
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:   "admin api without admin role",
+			method: http.MethodGet,
+			path:   admin.ContinuousQueryPath,
+			token:  "Bearer " + token,
+			code:   http.StatusForbidden,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, constants.APIVersion1CliPath+tt.path, http.NoBody)
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+			resp := httptest.NewRecorder()
+			router.ServeHTTP(resp, req)
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
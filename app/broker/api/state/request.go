@@ -26,6 +26,7 @@ import (
 
 var (
 	RequestsPath = "/state/requests"
+	RequestPath  = "/state/request"
 )
 
 // RequestAPI represents request state related api.
@@ -40,6 +41,23 @@ func NewRequestAPI() *RequestAPI {
 // Register adds request state url route.
 func (api *RequestAPI) Register(route gin.IRoutes) {
 	route.GET(RequestsPath, api.GetAllAliveRequests)
+	route.DELETE(RequestPath, api.CancelRequest)
+}
+
+// CancelRequest cancels the alive request by given request id.
+func (api *RequestAPI) CancelRequest(c *gin.Context) {
+	var param struct {
+		RequestID string `form:"requestId" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		http.Error(c, err)
+		return
+	}
+	if !brokerquery.GetRequestManager().CancelRequest(param.RequestID) {
+		http.NotFound(c)
+		return
+	}
+	http.NoContent(c)
 }
 
 // GetAllAliveRequests returns all alive request.
@@ -18,6 +18,7 @@
 package state
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
@@ -25,6 +26,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	brokerquery "github.com/lindb/lindb/query/broker"
 )
 
 func TestRequestAPI(t *testing.T) {
@@ -34,4 +37,16 @@ func TestRequestAPI(t *testing.T) {
 
 	resp := mock.DoRequest(t, r, http.MethodGet, RequestsPath, "")
 	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = mock.DoRequest(t, r, http.MethodDelete, RequestPath, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	resp = mock.DoRequest(t, r, http.MethodDelete, RequestPath+"?requestId=not-exist", "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	requestID := brokerquery.GetRequestManager().NewRequest(&models.Request{}, cancel)
+	defer brokerquery.GetRequestManager().CompleteRequest(requestID)
+	resp = mock.DoRequest(t, r, http.MethodDelete, RequestPath+"?requestId="+requestID, "")
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
 }
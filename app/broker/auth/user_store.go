@@ -0,0 +1,203 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+//go:generate mockgen -source=./user_store.go -destination=./user_store_mock.go -package=auth
+
+// for testing
+var (
+	// refreshInterval represents how often the cached users are reloaded from repo,
+	// users modified by other broker are visible after this interval.
+	refreshInterval = 5 * time.Second
+	// retryInterval represents how long to back off after reloading users failure(such as repo unavailable),
+	// the last loaded users are served during back off.
+	retryInterval = time.Second
+)
+
+// UserStore represents the store of user account(human user/api token), caches users from repo.
+type UserStore interface {
+	// GetUser returns user account by name, includes built-in admin user.
+	GetUser(name string) (*models.User, bool)
+	// Login returns the user if name and password match, api token cannot login.
+	Login(name, password string) (*models.User, bool)
+	// IsBuiltin checks if the user is built-in admin user(config file), which cannot be modified.
+	IsBuiltin(name string) bool
+	// Invalidate invalidates cached users, reloads from repo when next accessing.
+	Invalidate()
+}
+
+// userStore implements UserStore interface.
+type userStore struct {
+	ctx     context.Context
+	repo    state.Repository
+	timeout time.Duration
+
+	admin     config.User
+	adminUser *models.User
+
+	users       map[string]*models.User // last loaded users, nil if never loaded successfully
+	loadedAt    time.Time
+	retryAt     time.Time
+	loading     chan struct{} // closed when the in-flight loading completed, nil if no loading
+	invalidated bool          // accessing waits for the loading after users invalidated
+	generation  int64         // increased when users invalidated
+	mutex       sync.Mutex
+
+	logger *logger.Logger
+}
+
+// NewUserStore creates a user store.
+func NewUserStore(ctx context.Context, repo state.Repository, cfg config.Auth, timeout time.Duration) UserStore {
+	password := sha256.Sum256([]byte(cfg.Admin.Password))
+	return &userStore{
+		ctx:     ctx,
+		repo:    repo,
+		timeout: timeout,
+		admin:   cfg.Admin,
+		adminUser: &models.User{
+			Name:       cfg.Admin.UserName,
+			Type:       models.UserTypeUser,
+			Password:   hex.EncodeToString(password[:]),
+			Privileges: []models.Privilege{{Database: models.AllDatabases, Role: models.RoleAdmin}},
+		},
+		logger: logger.GetLogger("Broker", "UserStore"),
+	}
+}
+
+// GetUser returns user account by name, includes built-in admin user.
+func (s *userStore) GetUser(name string) (*models.User, bool) {
+	if s.IsBuiltin(name) {
+		return s.adminUser, true
+	}
+	user, ok := s.getUsers()[name]
+	return user, ok
+}
+
+// Login returns the user if name and password match, api token cannot login.
+func (s *userStore) Login(name, password string) (*models.User, bool) {
+	if s.IsBuiltin(name) {
+		return s.adminUser, subtle.ConstantTimeCompare([]byte(password), []byte(s.admin.Password)) == 1
+	}
+	user, ok := s.GetUser(name)
+	if !ok || !user.CheckPassword(password) {
+		return nil, false
+	}
+	return user, true
+}
+
+// IsBuiltin checks if the user is built-in admin user(config file), which cannot be modified.
+func (s *userStore) IsBuiltin(name string) bool {
+	return name != "" && name == s.admin.UserName
+}
+
+// Invalidate invalidates cached users, reloads from repo when next accessing.
+func (s *userStore) Invalidate() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.loadedAt = time.Time{}
+	s.retryAt = time.Time{}
+	s.invalidated = true
+	s.generation++
+}
+
+// getUsers returns the last loaded users, starts loading users from repo if cache expired,
+// only one loading runs at the same time and runs without holding lock, so the last loaded users are served
+// while loading, except that users never loaded or invalidated, which waits for the in-flight loading.
+func (s *userStore) getUsers() map[string]*models.User {
+	s.mutex.Lock()
+	now := time.Now()
+	loading := s.loading
+	if loading == nil && now.Sub(s.loadedAt) >= refreshInterval && !now.Before(s.retryAt) {
+		loading = make(chan struct{})
+		s.loading = loading
+		go s.load(loading, s.generation)
+	}
+	users := s.users
+	wait := loading != nil && (users == nil || s.invalidated)
+	s.mutex.Unlock()
+
+	if !wait {
+		return users
+	}
+	<-loading
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.users
+}
+
+// load loads users from repo, keeps old users and backs off if load failure.
+func (s *userStore) load(done chan struct{}, generation int64) {
+	defer close(done)
+
+	startAt := time.Now()
+	users, err := s.list()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.loading = nil
+	if err != nil {
+		s.logger.Warn("load users from repo failure", logger.Error(err))
+		s.retryAt = time.Now().Add(retryInterval)
+		return
+	}
+	s.users = users
+	if generation == s.generation {
+		s.loadedAt = startAt
+		s.invalidated = false
+	}
+	// else users invalidated while loading, reload when next accessing
+}
+
+// list lists all users from repo.
+func (s *userStore) list() (map[string]*models.User, error) {
+	ctx, cancel := context.WithTimeout(s.ctx, s.timeout)
+	defer cancel()
+	kvs, err := s.repo.List(ctx, constants.UserPath)
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[string]*models.User, len(kvs))
+	for _, kv := range kvs {
+		user := &models.User{}
+		if err := encoding.JSONUnmarshal(kv.Value, user); err != nil {
+			s.logger.Warn("unmarshal user failure", logger.String("key", kv.Key), logger.Error(err))
+			continue
+		}
+		users[user.Name] = user
+	}
+	return users, nil
+}
@@ -0,0 +1,192 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func TestUserStore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		retryInterval = time.Second
+		ctrl.Finish()
+	}()
+	// reload immediately after load failure
+	retryInterval = 0
+
+	repo := state.NewMockRepository(ctrl)
+	store := NewUserStore(context.TODO(), repo, config.Auth{
+		Admin: config.User{UserName: "admin", Password: "admin123"},
+	}, time.Second)
+
+	// built-in admin user
+	assert.True(t, store.IsBuiltin("admin"))
+	assert.False(t, store.IsBuiltin("bob"))
+	admin, ok := store.GetUser("admin")
+	assert.True(t, ok)
+	assert.True(t, admin.HasRole("db", models.RoleAdmin))
+	_, ok = store.Login("admin", "admin123")
+	assert.True(t, ok)
+	_, ok = store.Login("admin", "admin")
+	assert.False(t, ok)
+
+	bob, err := models.NewUser("bob", "pwd")
+	assert.NoError(t, err)
+	agent, _, err := models.NewToken("agent")
+	assert.NoError(t, err)
+	kvs := []state.KeyValue{
+		{Key: constants.GetUserPath("bob"), Value: encoding.JSONMarshal(bob)},
+		{Key: constants.GetUserPath("agent"), Value: encoding.JSONMarshal(agent)},
+		{Key: constants.GetUserPath("bad"), Value: []byte("abc")},
+	}
+	// load failure
+	repo.EXPECT().List(gomock.Any(), constants.UserPath).Return(nil, fmt.Errorf("err"))
+	_, ok = store.GetUser("bob")
+	assert.False(t, ok)
+	// load users, cached within refresh interval
+	repo.EXPECT().List(gomock.Any(), constants.UserPath).Return(kvs, nil)
+	user, ok := store.GetUser("bob")
+	assert.True(t, ok)
+	assert.Equal(t, bob.Password, user.Password)
+	_, ok = store.GetUser("bad")
+	assert.False(t, ok)
+	_, ok = store.Login("bob", "pwd")
+	assert.True(t, ok)
+	_, ok = store.Login("bob", "pwd1")
+	assert.False(t, ok)
+	_, ok = store.Login("agent", "")
+	assert.False(t, ok)
+
+	// reload after invalidated, keep old users if reload failure
+	store.Invalidate()
+	repo.EXPECT().List(gomock.Any(), constants.UserPath).Return(nil, fmt.Errorf("err"))
+	_, ok = store.GetUser("agent")
+	assert.True(t, ok)
+	repo.EXPECT().List(gomock.Any(), constants.UserPath).Return(kvs[:1], nil)
+	_, ok = store.GetUser("agent")
+	assert.False(t, ok)
+}
+
+func TestUserStore_Refresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		refreshInterval = 5 * time.Second
+		retryInterval = time.Second
+		ctrl.Finish()
+	}()
+	refreshInterval = time.Hour
+	retryInterval = time.Hour
+
+	repo := state.NewMockRepository(ctrl)
+	s := NewUserStore(context.TODO(), repo, config.Auth{}, time.Second)
+	store := s.(*userStore)
+	bob, err := models.NewUser("bob", "pwd")
+	assert.NoError(t, err)
+	alice, err := models.NewUser("alice", "pwd")
+	assert.NoError(t, err)
+
+	repo.EXPECT().List(gomock.Any(), constants.UserPath).
+		Return([]state.KeyValue{{Key: constants.GetUserPath("bob"), Value: encoding.JSONMarshal(bob)}}, nil)
+	_, ok := store.GetUser("bob")
+	assert.True(t, ok)
+
+	// cache expired, serves last loaded users while refreshing, only one refreshing runs
+	expire := func() {
+		store.mutex.Lock()
+		store.loadedAt = time.Time{}
+		store.mutex.Unlock()
+	}
+	expire()
+	listing := make(chan struct{})
+	release := make(chan struct{})
+	repo.EXPECT().List(gomock.Any(), constants.UserPath).
+		DoAndReturn(func(_ context.Context, _ string) ([]state.KeyValue, error) {
+			close(listing)
+			<-release
+			return []state.KeyValue{{Key: constants.GetUserPath("alice"), Value: encoding.JSONMarshal(alice)}}, nil
+		})
+	_, ok = store.GetUser("bob")
+	assert.True(t, ok)
+	<-listing
+	for i := 0; i < 10; i++ {
+		_, ok = store.GetUser("bob")
+		assert.True(t, ok)
+	}
+	close(release)
+	assert.Eventually(t, func() bool {
+		_, ok := store.GetUser("alice")
+		return ok
+	}, time.Second, 10*time.Millisecond)
+
+	// refresh failure, serves last loaded users and backs off(no refreshing within retry interval)
+	expire()
+	repo.EXPECT().List(gomock.Any(), constants.UserPath).Return(nil, fmt.Errorf("err"))
+	_, ok = store.GetUser("alice")
+	assert.True(t, ok)
+	assert.Eventually(t, func() bool {
+		store.mutex.Lock()
+		defer store.mutex.Unlock()
+		return !store.retryAt.IsZero()
+	}, time.Second, 10*time.Millisecond)
+	for i := 0; i < 10; i++ {
+		_, ok = store.GetUser("alice")
+		assert.True(t, ok)
+	}
+
+	// waits for reloading after invalidated
+	store.Invalidate()
+	repo.EXPECT().List(gomock.Any(), constants.UserPath).
+		Return([]state.KeyValue{{Key: constants.GetUserPath("bob"), Value: encoding.JSONMarshal(bob)}}, nil)
+	_, ok = store.GetUser("bob")
+	assert.True(t, ok)
+	_, ok = store.GetUser("alice")
+	assert.False(t, ok)
+}
+
+func TestUserStore_Load_Invalidated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	store := NewUserStore(context.TODO(), repo, config.Auth{}, time.Second).(*userStore)
+	repo.EXPECT().List(gomock.Any(), constants.UserPath).
+		DoAndReturn(func(_ context.Context, _ string) ([]state.KeyValue, error) {
+			// invalidated while loading
+			store.Invalidate()
+			return nil, nil
+		})
+	done := make(chan struct{})
+	store.load(done, store.generation)
+	<-done
+	assert.NotNil(t, store.users)
+	assert.True(t, store.invalidated)
+	assert.True(t, store.loadedAt.IsZero())
+}
@@ -20,6 +20,7 @@ package deps
 import (
 	"context"
 
+	"github.com/lindb/lindb/app/broker/auth"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
@@ -47,6 +48,7 @@ type HTTPDeps struct {
 	QueryLimiter  *concurrent.Limiter
 
 	QueryFactory brokerQuery.Factory
+	UserStore    auth.UserStore
 
 	GlobalKeyValues tag.Tags
 }
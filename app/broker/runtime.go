@@ -26,14 +26,17 @@ import (
 	"time"
 
 	"go.uber.org/atomic"
+	"google.golang.org/grpc"
 
 	"github.com/lindb/lindb/app/broker/api"
+	"github.com/lindb/lindb/app/broker/auth"
 	"github.com/lindb/lindb/app/broker/deps"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator"
 	"github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/coordinator/discovery"
+	"github.com/lindb/lindb/ingestion/otlp"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/internal/monitoring"
@@ -42,17 +45,23 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/hostutil"
 	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/pkg/tlsutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/query"
 	brokerQuery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/query/continuous"
 	"github.com/lindb/lindb/replica"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/tag"
 )
 
+// internalTokenTTL represents the ttl of token used by internal request between nodes.
+const internalTokenTTL = time.Minute
+
 // just for testing
 var (
 	getHostIP              = hostutil.GetHostIP
@@ -67,6 +76,10 @@ var (
 	newTaskManager         = brokerQuery.NewTaskManager
 	newMasterController    = coordinator.NewMasterController
 	newNativeProtoPusher   = monitoring.NewNativeProtoPusher
+	newCQScheduler         = continuous.NewScheduler
+	newTLSLoader           = tlsutil.NewLoader
+	newUserStore           = auth.NewUserStore
+	loadInternalSecret     = state.LoadOrCreateInternalSecret
 	serveGRPCFn            = serveGRPC
 )
 
@@ -74,6 +87,9 @@ var (
 type srv struct {
 	channelManager replica.ChannelManager
 	taskManager    brokerQuery.TaskManager
+	queryFactory   brokerQuery.Factory
+	ingestLimiter  *concurrent.Limiter
+	userStore      auth.UserStore
 }
 
 // factory represents all factories for broker
@@ -103,6 +119,7 @@ type runtime struct {
 	registry            discovery.Registry
 	stateMachineFactory discovery.StateMachineFactory
 	stateMgr            broker.StateManager
+	cqScheduler         continuous.Scheduler
 
 	grpcServer rpc.GRPCServer
 	rpcHandler *rpcHandler
@@ -162,9 +179,14 @@ func (r *runtime) Run() error {
 		HostName:   hostName,
 		GRPCPort:   r.config.BrokerBase.GRPC.Port,
 		HTTPPort:   r.config.BrokerBase.HTTP.Port,
+		HTTPS:      r.config.BrokerBase.HTTP.TLS.Enabled,
 		OnlineTime: timeutil.Now(),
 		Version:    config.Version,
 	}
+	if err = r.initClientTLS(); err != nil {
+		r.state = server.Failed
+		return err
+	}
 
 	// start state repository
 	err = r.startStateRepo()
@@ -173,6 +195,10 @@ func (r *runtime) Run() error {
 		r.state = server.Failed
 		return err
 	}
+	if err = r.initClientInternalAuthorization(); err != nil {
+		r.state = server.Failed
+		return err
+	}
 	r.globalKeyValues = tag.Tags{
 		{Key: []byte("node"), Value: []byte(r.node.Indicator())},
 		{Key: []byte("role"), Value: []byte(constants.BrokerRole)},
@@ -253,6 +279,10 @@ func (r *runtime) Run() error {
 
 	// start http server
 	r.startHTTPServer()
+	// start continuous query scheduler, only executes continuous queries when current node is master
+	r.cqScheduler = newCQScheduler(r.ctx, r.node, r.repo, r.master,
+		r.srv.queryFactory, r.srv.channelManager, r.config.Query.Timeout.Duration())
+	r.cqScheduler.Start()
 
 	if r.enableSystemMonitor {
 		// start system collector
@@ -280,6 +310,11 @@ func (r *runtime) Stop() {
 		r.log.Info("stopped native metric pusher successfully")
 	}
 
+	if r.cqScheduler != nil {
+		r.cqScheduler.Stop()
+		r.log.Info("stopped continuous query scheduler successfully")
+	}
+
 	if r.httpServer != nil {
 		r.log.Info("stopping http server...")
 		if err := r.httpServer.Close(r.ctx); err != nil {
@@ -345,39 +380,89 @@ func (r *runtime) Stop() {
 	r.log.Info("stopped broker server successfully")
 }
 
+// initClientAuthorization sets the authorization of http client which calls the api of other brokers,
+// uses the token of built-in admin user, all brokers of cluster share the same built-in admin user.
+func (r *runtime) initClientAuthorization(userStore auth.UserStore) {
+	admin, _ := userStore.GetUser(r.config.BrokerBase.Auth.Admin.UserName)
+	httppkg.SetClientAuthorization(func() string {
+		token, err := middleware.CreateToken(admin, internalTokenTTL)
+		if err != nil {
+			r.log.Warn("create token for internal request failure", logger.Error(err))
+			return ""
+		}
+		return "Bearer " + token
+	})
+}
+
+// initClientInternalAuthorization sets the authorization of http client which calls the internal api of storage nodes,
+// uses the token signed by cluster internal secret, which is synced into storage state repo by master.
+func (r *runtime) initClientInternalAuthorization() error {
+	secret, err := loadInternalSecret(r.ctx, r.repo)
+	if err != nil {
+		return fmt.Errorf("load internal secret error:%s", err)
+	}
+	httppkg.SetClientInternalAuthorization(func() string {
+		token, err := middleware.CreateInternalToken(secret, internalTokenTTL)
+		if err != nil {
+			r.log.Warn("create internal token failure", logger.Error(err))
+			return ""
+		}
+		return token
+	})
+	return nil
+}
+
+// initClientTLS sets the tls config of grpc/http client which connects other nodes if tls enabled.
+func (r *runtime) initClientTLS() error {
+	if grpcTLS := r.config.BrokerBase.GRPC.TLS; grpcTLS.Enabled {
+		loader, err := newTLSLoader(grpcTLS)
+		if err != nil {
+			return fmt.Errorf("load grpc tls config error:%s", err)
+		}
+		rpc.GetBrokerClientConnFactory().SetTLSConfig(loader.ClientConfig())
+	}
+	if httpTLS := r.config.BrokerBase.HTTP.TLS; httpTLS.Enabled {
+		loader, err := newTLSLoader(httpTLS)
+		if err != nil {
+			return fmt.Errorf("load http tls config error:%s", err)
+		}
+		httppkg.SetClientTLSConfig(loader.ClientConfig())
+	}
+	return nil
+}
+
 // startHTTPServer starts http server for api rpcHandler
 func (r *runtime) startHTTPServer() {
 	r.log.Info("starting HTTP server")
 	r.httpServer = httppkg.NewServer(r.config.BrokerBase.HTTP, true, linmetric.BrokerRegistry)
-	// TODO login api is not registered
+	userStore := r.srv.userStore
+	if r.config.BrokerBase.Auth.Enabled {
+		r.initClientAuthorization(userStore)
+	}
 	httpAPI := api.NewAPI(&deps.HTTPDeps{
-		Ctx:         r.ctx,
-		Node:        r.node,
-		BrokerCfg:   r.config,
-		Master:      r.master,
-		Repo:        r.repo,
-		RepoFactory: r.repoFactory,
-		StateMgr:    r.stateMgr,
-		CM:          r.srv.channelManager,
-		IngestLimiter: concurrent.NewLimiter(
-			r.ctx,
-			r.config.BrokerBase.Ingestion.MaxConcurrency,
-			r.config.BrokerBase.Ingestion.IngestTimeout.Duration(),
-			metrics.NewLimitStatistics("ingestion", linmetric.BrokerRegistry),
-		),
+		Ctx:           r.ctx,
+		Node:          r.node,
+		BrokerCfg:     r.config,
+		Master:        r.master,
+		Repo:          r.repo,
+		RepoFactory:   r.repoFactory,
+		StateMgr:      r.stateMgr,
+		CM:            r.srv.channelManager,
+		IngestLimiter: r.srv.ingestLimiter,
 		QueryLimiter: concurrent.NewLimiter(
 			r.ctx,
 			r.config.Query.QueryConcurrency,
 			r.config.Query.Timeout.Duration(),
 			metrics.NewLimitStatistics("query", linmetric.BrokerRegistry),
 		),
-		QueryFactory: brokerQuery.NewQueryFactory(
-			r.stateMgr,
-			r.srv.taskManager,
-		),
+		QueryFactory:    r.srv.queryFactory,
+		UserStore:       userStore,
 		GlobalKeyValues: r.globalKeyValues,
 	})
 	httpAPI.RegisterRouter(r.httpServer.GetAPIRouter())
+	// prometheus metric exposition doesn't need authentication, so that scraping works without cluster state.
+	prometheusAPI := monitoring.NewPrometheusAPI(r.globalKeyValues, linmetric.BrokerRegistry)
+	prometheusAPI.Register(r.httpServer.GetRootRouter())
 	go func() {
 		if err := r.httpServer.Run(); err != http.ErrServerClosed {
 			panic(fmt.Sprintf("start http server with error: %s", err))
@@ -418,6 +503,16 @@ func (r *runtime) buildServiceDependency() {
 	s := srv{
 		channelManager: cm,
 		taskManager:    taskManager,
+		queryFactory:   brokerQuery.NewQueryFactory(r.stateMgr, taskManager),
+		// ingestion limiter shared by http/grpc ingestion api
+		ingestLimiter: concurrent.NewLimiter(
+			r.ctx,
+			r.config.BrokerBase.Ingestion.MaxConcurrency,
+			r.config.BrokerBase.Ingestion.IngestTimeout.Duration(),
+			metrics.NewLimitStatistics("ingestion", linmetric.BrokerRegistry),
+		),
+		// user store shared by http api/grpc ingestion authentication
+		userStore: newUserStore(r.ctx, r.repo, r.config.BrokerBase.Auth, r.config.Coordinator.Timeout.Duration()),
 	}
 	r.srv = s
 }
@@ -444,6 +539,16 @@ func (r *runtime) startGRPCServer() {
 	}
 
 	protoCommonV1.RegisterTaskServiceServer(r.grpcServer.GetServer(), r.rpcHandler.handler)
+	// OpenTelemetry OTLP/gRPC metrics receiver
+	var otlpInterceptor grpc.UnaryServerInterceptor
+	if r.config.BrokerBase.Auth.Enabled {
+		otlpInterceptor = otlp.NewAuthInterceptor(middleware.NewAuthentication(r.srv.userStore))
+	}
+	otlp.RegisterMetricsServiceServer(r.grpcServer.GetServer(), otlp.NewMetricsService(
+		r.srv.channelManager,
+		r.srv.ingestLimiter,
+		r.config.BrokerBase.Ingestion.IngestTimeout.Duration(),
+	), otlpInterceptor)
 
 	go serveGRPCFn(r.grpcServer)
 }
@@ -20,7 +20,10 @@ package broker
 import (
 	"context"
 	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -28,12 +31,14 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/app/broker/auth"
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/coordinator"
 	brokerpkg "github.com/lindb/lindb/coordinator/broker"
 	"github.com/lindb/lindb/coordinator/discovery"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
 	"github.com/lindb/lindb/internal/monitoring"
 	"github.com/lindb/lindb/internal/server"
 	"github.com/lindb/lindb/models"
@@ -109,6 +114,16 @@ func TestBrokerRuntime_Run(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "load internal secret failure",
+			prepare: func() {
+				repoFct.EXPECT().CreateBrokerRepo(gomock.Any()).Return(repo, nil)
+				loadInternalSecret = func(_ context.Context, _ state.Repository) ([]byte, error) {
+					return nil, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
 		{
 			name: "registry alive node failure",
 			prepare: func() {
@@ -207,6 +222,7 @@ func TestBrokerRuntime_Run(t *testing.T) {
 				newMasterController = coordinator.NewMasterController
 				newRegistry = discovery.NewRegistry
 				serveGRPCFn = serveGRPC
+				loadInternalSecret = state.LoadOrCreateInternalSecret
 
 				newNativeProtoPusher = monitoring.NewNativeProtoPusher
 				newStateMachineFactory = brokerpkg.NewStateMachineFactory
@@ -377,6 +393,9 @@ func resetNewDepsMock() {
 	}
 	serveGRPCFn = func(grpc rpc.GRPCServer) {
 	}
+	loadInternalSecret = func(_ context.Context, _ state.Repository) ([]byte, error) {
+		return []byte("secret"), nil
+	}
 	newStateMachineFactory = func(ctx context.Context, discoveryFactory discovery.Factory,
 		stateMgr brokerpkg.StateManager) discovery.StateMachineFactory {
 		return nil
@@ -386,3 +405,77 @@ func resetNewDepsMock() {
 		return nil
 	}
 }
+
+func TestBrokerRuntime_initClientTLS(t *testing.T) {
+	defer func() {
+		rpc.GetBrokerClientConnFactory().SetTLSConfig(nil)
+		http.SetClientTLSConfig(nil)
+	}()
+	files := mock.GenerateTLSFiles(t, t.TempDir())
+	tlsCfg := config.TLS{Enabled: true, CertFile: files.CertFile, KeyFile: files.KeyFile, CAFile: files.CAFile}
+	cases := []struct {
+		name    string
+		grpcTLS config.TLS
+		httpTLS config.TLS
+		wantErr bool
+	}{
+		{
+			name: "tls disabled",
+		},
+		{
+			name:    "load grpc tls failure",
+			grpcTLS: config.TLS{Enabled: true},
+			wantErr: true,
+		},
+		{
+			name:    "load http tls failure",
+			grpcTLS: tlsCfg,
+			httpTLS: config.TLS{Enabled: true},
+			wantErr: true,
+		},
+		{
+			name:    "init client tls successfully",
+			grpcTLS: tlsCfg,
+			httpTLS: tlsCfg,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			brokerCfg := cfg
+			brokerCfg.BrokerBase.GRPC.TLS = tt.grpcTLS
+			brokerCfg.BrokerBase.HTTP.TLS = tt.httpTLS
+			r := &runtime{config: &brokerCfg}
+			err := r.initClientTLS()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+	assert.Equal(t, "https", http.ClientScheme())
+}
+
+func TestBrokerRuntime_initClientAuthorization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		http.SetClientAuthorization(nil)
+		ctrl.Finish()
+	}()
+	svr := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+	}))
+	defer svr.Close()
+
+	userStore := auth.NewMockUserStore(ctrl)
+	userStore.EXPECT().GetUser("admin").Return(&models.User{Name: "admin", Type: models.UserTypeUser}, true)
+	brokerCfg := cfg
+	brokerCfg.BrokerBase.Auth.Admin = config.User{UserName: "admin", Password: "admin123"}
+	r := &runtime{config: &brokerCfg, log: logger.GetLogger("Broker", "Test")}
+	r.initClientAuthorization(userStore)
+
+	resp, err := http.NewRestyClient().R().Get(svr.URL)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(resp.String(), "Bearer "))
+}
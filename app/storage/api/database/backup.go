@@ -0,0 +1,244 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package database
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/archive"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/fileutil"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// for testing
+var (
+	mkTempDirFn = os.MkdirTemp
+	renameFn    = os.Rename
+)
+
+// NOTE: database backup/restore apis are internal apis of cluster(invoked by broker/storage node) without authentication,
+// storage http port must only be reachable on the internal network.
+var (
+	// BackupPath is the path of creating snapshot backup of database on storage node.
+	BackupPath = "/database/backup"
+	// RestorePath is the path of restoring database from snapshot backup on storage node.
+	RestorePath = "/database/restore"
+)
+
+// BackupAPI represents database snapshot backup/restore rest api of storage node.
+type BackupAPI struct {
+	nodeID models.NodeID
+	engine tsdb.Engine
+	walMgr replica.WriteAheadLogManager
+	logger *logger.Logger
+}
+
+// NewBackupAPI creates database backup api instance.
+func NewBackupAPI(nodeID models.NodeID, engine tsdb.Engine, walMgr replica.WriteAheadLogManager) *BackupAPI {
+	return &BackupAPI{
+		nodeID: nodeID,
+		engine: engine,
+		walMgr: walMgr,
+		logger: logger.GetLogger("Storage", "BackupAPI"),
+	}
+}
+
+// Register adds database backup url route,
+// route should be protected by internal authentication(request signed by cluster internal secret).
+func (api *BackupAPI) Register(route gin.IRoutes) {
+	route.GET(BackupPath, api.Backup)
+	route.POST(RestorePath, api.Restore)
+}
+
+// Backup takes a snapshot of database on current node, then streams the snapshot as tar archive,
+// the node manifest(replica wal position/file checksums) is the last entry of archive.
+func (api *BackupAPI) Backup(c *gin.Context) {
+	var param struct {
+		Database string `form:"db" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	db, ok := api.engine.GetDatabase(param.Database)
+	if !ok {
+		httppkg.Error(c, constants.ErrDatabaseNotFound)
+		return
+	}
+	dir, err := api.createTempDir()
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	defer api.removeTempDir(dir)
+
+	// record replica wal position before flushing memory data
+	replicaState := api.walMgr.GetReplicaState(param.Database)
+	if err = db.Checkpoint(dir); err != nil {
+		api.logger.Error("checkpoint database failure",
+			logger.String("database", param.Database), logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	c.Header("Content-Type", "application/x-tar")
+	c.Status(http.StatusOK)
+	tw := tar.NewWriter(c.Writer)
+	// if write fail, node manifest will be missing in archive, so receiver can find it.
+	files, err := archive.WriteDir(tw, dir, "")
+	if err == nil {
+		err = archive.WriteBytes(tw, models.BackupNodeManifestFile, encoding.JSONMarshal(&models.BackupNode{
+			NodeID:       api.nodeID,
+			ReplicaState: replicaState,
+			Files:        files,
+		}))
+	}
+	if err == nil {
+		err = tw.Close()
+	}
+	if err != nil {
+		api.logger.Error("write database snapshot failure",
+			logger.String("database", param.Database), logger.Error(err))
+		return
+	}
+	api.logger.Info("backup database successfully",
+		logger.String("database", param.Database), logger.Int("files", len(files)))
+}
+
+// Restore restores database snapshot from tar archive into current node,
+// database cannot exist in current node, the snapshot is verified by the node manifest in archive.
+func (api *BackupAPI) Restore(c *gin.Context) {
+	var param struct {
+		Database string `form:"db" binding:"required"`
+	}
+	if err := c.ShouldBindQuery(&param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	if err := validateDatabaseName(param.Database); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	target := filepath.Join(config.GlobalStorageConfig().TSDB.Dir, param.Database)
+	if _, ok := api.engine.GetDatabase(param.Database); ok || fileutil.Exist(target) {
+		httppkg.Error(c, fmt.Errorf("database %s already exist", param.Database))
+		return
+	}
+	dir, err := api.createTempDir()
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	defer api.removeTempDir(dir)
+
+	node, err := api.extract(param.Database, dir, c.Request.Body)
+	if err != nil {
+		api.logger.Error("extract database snapshot failure",
+			logger.String("database", param.Database), logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	if err := renameFn(filepath.Join(dir, param.Database), target); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	api.logger.Info("restore database successfully",
+		logger.String("database", param.Database), logger.Any("sourceNode", node.NodeID),
+		logger.Int("files", len(node.Files)))
+	httppkg.OK(c, "ok")
+}
+
+// validateDatabaseName checks if database's name is a valid dir name under tsdb dir,
+// avoids the path of restoring escapes tsdb dir.
+func validateDatabaseName(name string) error {
+	if name == "" || name == "." || strings.Contains(name, "..") || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid database name: %s", name)
+	}
+	return nil
+}
+
+// extract extracts database snapshot from archive into dir, then verifies it by node manifest.
+func (api *BackupAPI) extract(database, dir string, r io.Reader) (*models.BackupNode, error) {
+	var (
+		node  *models.BackupNode
+		files []archive.Entry
+	)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Name == models.BackupNodeManifestFile {
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			node = &models.BackupNode{}
+			if err := encoding.JSONUnmarshal(data, node); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !strings.HasPrefix(hdr.Name, database+"/") {
+			return nil, fmt.Errorf("file %s not belong to database %s", hdr.Name, database)
+		}
+		entry, err := archive.Extract(dir, hdr.Name, tr)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, *entry)
+	}
+	if node == nil {
+		return nil, fmt.Errorf("node manifest not found in database snapshot")
+	}
+	if err := archive.Verify(node.Files, files); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// createTempDir creates a temp dir under the parent of storage dir for snapshot,
+// which is on the same file system as storage dir, but not be loaded as database by engine.
+func (api *BackupAPI) createTempDir() (string, error) {
+	return mkTempDirFn(filepath.Dir(filepath.Clean(config.GlobalStorageConfig().TSDB.Dir)), "backup-")
+}
+
+// removeTempDir removes the temp dir of snapshot.
+func (api *BackupAPI) removeTempDir(dir string) {
+	if err := fileutil.RemoveDir(dir); err != nil {
+		api.logger.Warn("remove snapshot temp dir failure", logger.String("dir", dir), logger.Error(err))
+	}
+}
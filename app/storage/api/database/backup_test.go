@@ -0,0 +1,316 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package database
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/archive"
+	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestBackupAPI_Backup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		mkTempDirFn = os.MkdirTemp
+		config.SetGlobalStorageConfig(config.NewDefaultStorageBase())
+		ctrl.Finish()
+	}()
+	cfg := config.NewDefaultStorageBase()
+	cfg.TSDB.Dir = filepath.Join(t.TempDir(), "data")
+	config.SetGlobalStorageConfig(cfg)
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	walMgr := replica.NewMockWriteAheadLogManager(ctrl)
+	api := NewBackupAPI(1, engine, walMgr)
+	r := gin.New()
+	api.Register(r)
+
+	cases := []struct {
+		name    string
+		reqBody string
+		path    string
+		prepare func()
+		assert  func(body []byte)
+		code    int
+	}{
+		{
+			name: "param invalid",
+			path: BackupPath,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "database not found",
+			path: BackupPath + "?db=test",
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(nil, false)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "create temp dir failure",
+			path: BackupPath + "?db=test",
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				mkTempDirFn = func(dir, pattern string) (string, error) {
+					return "", fmt.Errorf("err")
+				}
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "checkpoint failure",
+			path: BackupPath + "?db=test",
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				walMgr.EXPECT().GetReplicaState("test").Return(nil)
+				db.EXPECT().Checkpoint(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "backup successfully",
+			path: BackupPath + "?db=test",
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				walMgr.EXPECT().GetReplicaState("test").Return([]models.FamilyLogReplicaState{{ShardID: 1, Append: 10}})
+				db.EXPECT().Checkpoint(gomock.Any()).DoAndReturn(func(dir string) error {
+					writeFile(t, dir, "test/OPTIONS", "options")
+					writeFile(t, dir, "test/shard/1/index/CURRENT", "current")
+					return nil
+				})
+			},
+			assert: func(body []byte) {
+				entries := readArchive(t, body)
+				assert.Equal(t, []string{"test/OPTIONS", "test/shard/1/index/CURRENT", models.BackupNodeManifestFile},
+					entries)
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				mkTempDirFn = os.MkdirTemp
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodGet, tt.path, tt.reqBody)
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.assert != nil {
+				tt.assert(resp.Body.Bytes())
+			}
+		})
+	}
+}
+
+func TestBackupAPI_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		mkTempDirFn = os.MkdirTemp
+		renameFn = os.Rename
+		config.SetGlobalStorageConfig(config.NewDefaultStorageBase())
+		ctrl.Finish()
+	}()
+	cfg := config.NewDefaultStorageBase()
+	cfg.TSDB.Dir = filepath.Join(t.TempDir(), "data")
+	config.SetGlobalStorageConfig(cfg)
+
+	engine := tsdb.NewMockEngine(ctrl)
+	engine.EXPECT().GetDatabase(gomock.Any()).Return(nil, false).AnyTimes()
+	api := NewBackupAPI(1, engine, nil)
+	r := gin.New()
+	api.Register(r)
+
+	snapshot := func(name, content string, withManifest bool, checksum string) string {
+		buf := &bytes.Buffer{}
+		tw := tar.NewWriter(buf)
+		entry, err := archive.Copy(tw, name, int64(len(content)), bytes.NewBufferString(content))
+		assert.NoError(t, err)
+		if checksum != "" {
+			entry.Checksum = checksum
+		}
+		if withManifest {
+			assert.NoError(t, archive.WriteBytes(tw, models.BackupNodeManifestFile,
+				[]byte(fmt.Sprintf(`{"nodeId":1,"files":[{"name":"%s","size":%d,"checksum":"%s"}]}`,
+					entry.Name, entry.Size, entry.Checksum))))
+		}
+		assert.NoError(t, tw.Close())
+		return buf.String()
+	}
+
+	failOnRename := func() {
+		renameFn = func(_, _ string) error {
+			t.Fatal("invalid database name cannot be restored")
+			return nil
+		}
+	}
+
+	cases := []struct {
+		name    string
+		path    string
+		body    string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "param invalid",
+			path: RestorePath,
+			code: http.StatusInternalServerError,
+		},
+		{
+			name:    "database name escapes tsdb dir",
+			path:    RestorePath + "?db=" + url.QueryEscape("../escape"),
+			body:    snapshot("../escape/OPTIONS", "options", true, ""),
+			prepare: failOnRename,
+			code:    http.StatusInternalServerError,
+		},
+		{
+			name:    "database name with path separator",
+			path:    RestorePath + "?db=" + url.QueryEscape("a/b"),
+			body:    snapshot("a/b/OPTIONS", "options", true, ""),
+			prepare: failOnRename,
+			code:    http.StatusInternalServerError,
+		},
+		{
+			name:    "database name with windows path separator",
+			path:    RestorePath + "?db=" + url.QueryEscape(`a\b`),
+			prepare: failOnRename,
+			code:    http.StatusInternalServerError,
+		},
+		{
+			name:    "database name is current dir",
+			path:    RestorePath + "?db=.",
+			prepare: failOnRename,
+			code:    http.StatusInternalServerError,
+		},
+		{
+			name: "database already exist",
+			path: RestorePath + "?db=exist",
+			prepare: func() {
+				assert.NoError(t, os.MkdirAll(filepath.Join(cfg.TSDB.Dir, "exist"), os.ModePerm))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "create temp dir failure",
+			path: RestorePath + "?db=test",
+			prepare: func() {
+				mkTempDirFn = func(dir, pattern string) (string, error) {
+					return "", fmt.Errorf("err")
+				}
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "archive invalid",
+			path: RestorePath + "?db=test",
+			body: "abc",
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "file not belong to database",
+			path: RestorePath + "?db=test",
+			body: snapshot("other/OPTIONS", "options", true, ""),
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "node manifest not found",
+			path: RestorePath + "?db=test",
+			body: snapshot("test/OPTIONS", "options", false, ""),
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "checksum not match",
+			path: RestorePath + "?db=test",
+			body: snapshot("test/OPTIONS", "options", true, "abc"),
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "rename failure",
+			path: RestorePath + "?db=test",
+			body: snapshot("test/OPTIONS", "options", true, ""),
+			prepare: func() {
+				renameFn = func(oldpath, newpath string) error {
+					return fmt.Errorf("err")
+				}
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "restore successfully",
+			path: RestorePath + "?db=test",
+			body: snapshot("test/OPTIONS", "options", true, ""),
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				mkTempDirFn = os.MkdirTemp
+				renameFn = os.Rename
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPost, tt.path, tt.body)
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+	data, err := os.ReadFile(filepath.Join(cfg.TSDB.Dir, "test", "OPTIONS"))
+	assert.NoError(t, err)
+	assert.Equal(t, "options", string(data))
+	assert.NoDirExists(t, filepath.Join(filepath.Dir(cfg.TSDB.Dir), "escape"))
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func readArchive(t *testing.T, body []byte) (names []string) {
+	tr := tar.NewReader(bytes.NewReader(body))
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, hdr.Name)
+	}
+	return names
+}
@@ -0,0 +1,206 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package database
+
+import (
+	"errors"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/query/operator"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// for testing
+var (
+	newTagValuesLookupFn = operator.NewTagValuesLookup
+	newSeriesFilteringFn = operator.NewSeriesFiltering
+	newMetricAllSeriesFn = operator.NewMetricAllSeries
+)
+
+var (
+	// DeletePath is the path of deleting series on storage node.
+	DeletePath = "/database/delete"
+)
+
+// DeleteAPI represents delete series rest api of storage node.
+type DeleteAPI struct {
+	engine tsdb.Engine
+	logger *logger.Logger
+}
+
+// NewDeleteAPI creates delete series api instance.
+func NewDeleteAPI(engine tsdb.Engine) *DeleteAPI {
+	return &DeleteAPI{
+		engine: engine,
+		logger: logger.GetLogger("Storage", "DeleteAPI"),
+	}
+}
+
+// Register adds delete series url route,
+// route should be protected by internal authentication(request signed by cluster internal secret).
+func (api *DeleteAPI) Register(route gin.IRoutes) {
+	route.PUT(DeletePath, api.Delete)
+}
+
+// Delete deletes the series which match tag filter in time range for given shards,
+// drops the metadata of metric if metric hasn't any series after deleting the whole history.
+func (api *DeleteAPI) Delete(c *gin.Context) {
+	param := &models.DeleteParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	rs, err := api.delete(param)
+	if err != nil {
+		api.logger.Error("delete series failure",
+			logger.String("database", param.Database), logger.String("metric", param.Statement.MetricName),
+			logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	api.logger.Info("delete series successfully",
+		logger.String("database", param.Database), logger.String("metric", param.Statement.MetricName),
+		logger.Any("series", rs.NumOfSeries()), logger.Any("metricDropped", rs.MetricDropped))
+	httppkg.OK(c, rs)
+}
+
+// delete deletes the series of metric for each shard.
+func (api *DeleteAPI) delete(param *models.DeleteParam) (*models.DeleteResult, error) {
+	db, ok := api.engine.GetDatabase(param.Database)
+	if !ok {
+		return nil, constants.ErrDatabaseNotFound
+	}
+	deleteStmt := param.Statement
+	rs := &models.DeleteResult{Shards: make(map[models.ShardID]uint64)}
+	metricID, err := db.Metadata().MetadataDatabase().GetMetricID(deleteStmt.Namespace, deleteStmt.MetricName)
+	if err != nil {
+		if errors.Is(err, constants.ErrNotFound) {
+			return rs, nil
+		}
+		return nil, err
+	}
+	shards := make([]tsdb.Shard, 0, len(param.ShardIDs))
+	for _, shardID := range param.ShardIDs {
+		shard, ok := db.GetShard(shardID)
+		if !ok {
+			return nil, constants.ErrShardNotFound
+		}
+		shards = append(shards, shard)
+	}
+	storageCtx := &flow.StorageExecuteContext{
+		Query: &stmt.Query{
+			Namespace:  deleteStmt.Namespace,
+			MetricName: deleteStmt.MetricName,
+			Condition:  deleteStmt.Condition,
+			TimeRange:  deleteStmt.TimeRange,
+		},
+		MetricID: metricID,
+		TagKeys:  make(map[string]tag.KeyID),
+	}
+	if deleteStmt.Condition != nil {
+		if err := newTagValuesLookupFn(storageCtx, db).Execute(); err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				// tag key/value not found, no series matched
+				return rs, nil
+			}
+			return nil, err
+		}
+	}
+	for idx, shard := range shards {
+		seriesIDs, err := api.findSeriesIDs(storageCtx, shard)
+		if err != nil {
+			return nil, err
+		}
+		if !seriesIDs.IsEmpty() {
+			if err := shard.Delete(metricID, seriesIDs, deleteStmt.TimeRange); err != nil {
+				return nil, err
+			}
+		}
+		rs.Shards[param.ShardIDs[idx]] = seriesIDs.GetCardinality()
+	}
+	if !deleteStmt.IsWholeHistory() {
+		return rs, nil
+	}
+	empty, err := api.isMetricEmpty(shards, metricID, deleteStmt)
+	if err != nil {
+		return nil, err
+	}
+	if empty {
+		// drop index of metric before metadata, because it needs tag keys of metric
+		for _, shard := range shards {
+			if err := shard.IndexDatabase().DropMetric(deleteStmt.Namespace, deleteStmt.MetricName, metricID); err != nil {
+				return nil, err
+			}
+		}
+		if err := db.Metadata().MetadataDatabase().DropMetric(deleteStmt.Namespace, deleteStmt.MetricName); err != nil {
+			return nil, err
+		}
+		rs.MetricDropped = true
+	}
+	return rs, nil
+}
+
+// findSeriesIDs finds the series ids which match tag filter from shard.
+func (api *DeleteAPI) findSeriesIDs(storageCtx *flow.StorageExecuteContext, shard tsdb.Shard) (*roaring.Bitmap, error) {
+	shardCtx := flow.NewShardExecuteContext(storageCtx)
+	var op operator.Operator
+	if storageCtx.Query.Condition == nil {
+		op = newMetricAllSeriesFn(shardCtx, shard)
+	} else {
+		op = newSeriesFilteringFn(shardCtx, shard)
+	}
+	if err := op.Execute(); err != nil && !errors.Is(err, constants.ErrNotFound) {
+		return nil, err
+	}
+	return shardCtx.SeriesIDsAfterFiltering, nil
+}
+
+// isMetricEmpty checks if all series of metric deleted in the whole history for all shards.
+func (api *DeleteAPI) isMetricEmpty(shards []tsdb.Shard, metricID metric.ID, deleteStmt *stmt.Delete) (bool, error) {
+	timeRange := timeutil.TimeRange{Start: 0, End: deleteStmt.TimeRange.End}
+	for _, shard := range shards {
+		seriesIDs, err := shard.IndexDatabase().GetSeriesIDsForMetric(deleteStmt.Namespace, deleteStmt.MetricName)
+		if err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				continue
+			}
+			return false, err
+		}
+		deletedSeriesIDs := shard.Tombstones().DeletedSeriesIDs(metricID, timeRange)
+		if deletedSeriesIDs == nil {
+			return false, nil
+		}
+		seriesIDs.AndNot(deletedSeriesIDs)
+		if !seriesIDs.IsEmpty() {
+			return false, nil
+		}
+	}
+	return true, nil
+}
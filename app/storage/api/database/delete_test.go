@@ -0,0 +1,310 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package database
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/query/operator"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+func TestDeleteAPI_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newTagValuesLookupFn = operator.NewTagValuesLookup
+		newSeriesFilteringFn = operator.NewSeriesFiltering
+		newMetricAllSeriesFn = operator.NewMetricAllSeries
+		ctrl.Finish()
+	}()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	tombstones := tsdb.NewMockTombstoneStore(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	op := operator.NewMockOperator(ctrl)
+	db.EXPECT().Metadata().Return(metadata).AnyTimes()
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	shard.EXPECT().Tombstones().Return(tombstones).AnyTimes()
+	newTagValuesLookupFn = func(_ *flow.StorageExecuteContext, _ tsdb.Database) operator.Operator {
+		return op
+	}
+	newSeriesFilteringFn = func(executeCtx *flow.ShardExecuteContext, _ tsdb.Shard) operator.Operator {
+		executeCtx.SeriesIDsAfterFiltering.Add(1)
+		return op
+	}
+	newMetricAllSeriesFn = func(executeCtx *flow.ShardExecuteContext, _ tsdb.Shard) operator.Operator {
+		executeCtx.SeriesIDsAfterFiltering.Or(roaring.BitmapOf(0, 1, 2))
+		return op
+	}
+
+	api := NewDeleteAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	condition := &stmt.EqualsExpr{Key: "host", Value: "1.1.1.1"}
+	body := func(condition stmt.Expr, start int64) string {
+		return string(encoding.JSONMarshal(&models.DeleteParam{
+			Database: "test",
+			ShardIDs: []models.ShardID{1},
+			Statement: &stmt.Delete{
+				Namespace:  "ns",
+				MetricName: "cpu",
+				Condition:  condition,
+				TimeRange:  timeutil.TimeRange{Start: start, End: 100},
+			},
+		}))
+	}
+	cases := []struct {
+		name    string
+		body    string
+		prepare func()
+		assert  func(rs *models.DeleteResult)
+		code    int
+	}{
+		{
+			name: "param invalid",
+			body: "{}",
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "database not found",
+			body: body(condition, 10),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(nil, false)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "get metric id failure",
+			body: body(condition, 10),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.EmptyMetricID, fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "metric not found",
+			body: body(condition, 10),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.EmptyMetricID, constants.ErrMetricIDNotFound)
+			},
+			assert: func(rs *models.DeleteResult) {
+				assert.Zero(t, rs.NumOfSeries())
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "shard not found",
+			body: body(condition, 10),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(nil, false)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "lookup tag values failure",
+			body: body(condition, 10),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				op.EXPECT().Execute().Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "tag value not found",
+			body: body(condition, 10),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				op.EXPECT().Execute().Return(constants.ErrNotFound)
+			},
+			assert: func(rs *models.DeleteResult) {
+				assert.Zero(t, rs.NumOfSeries())
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "filter series failure",
+			body: body(condition, 10),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				op.EXPECT().Execute().Return(nil)
+				op.EXPECT().Execute().Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "delete shard data failure",
+			body: body(condition, 10),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				op.EXPECT().Execute().Return(nil).Times(2)
+				shard.EXPECT().Delete(metric.ID(10), roaring.BitmapOf(1), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "delete series in time range",
+			body: body(condition, 10),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				op.EXPECT().Execute().Return(nil).Times(2)
+				shard.EXPECT().Delete(metric.ID(10), roaring.BitmapOf(1),
+					timeutil.TimeRange{Start: 10, End: 100}).Return(nil)
+			},
+			assert: func(rs *models.DeleteResult) {
+				assert.Equal(t, map[models.ShardID]uint64{1: 1}, rs.Shards)
+				assert.False(t, rs.MetricDropped)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "get series ids for metric failure",
+			body: body(condition, 0),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				op.EXPECT().Execute().Return(nil).Times(2)
+				shard.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "metric still has series",
+			body: body(condition, 0),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				op.EXPECT().Execute().Return(nil).Times(2)
+				shard.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(roaring.BitmapOf(1, 2), nil)
+				tombstones.EXPECT().DeletedSeriesIDs(metric.ID(10),
+					timeutil.TimeRange{Start: 0, End: 100}).Return(roaring.BitmapOf(1))
+			},
+			assert: func(rs *models.DeleteResult) {
+				assert.False(t, rs.MetricDropped)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "drop index of metric failure",
+			body: body(nil, 0),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				op.EXPECT().Execute().Return(nil)
+				shard.EXPECT().Delete(metric.ID(10), roaring.BitmapOf(0, 1, 2), gomock.Any()).Return(nil)
+				indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(roaring.BitmapOf(1, 2), nil)
+				tombstones.EXPECT().DeletedSeriesIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(0, 1, 2))
+				indexDB.EXPECT().DropMetric("ns", "cpu", metric.ID(10)).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "drop metric failure",
+			body: body(nil, 0),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				op.EXPECT().Execute().Return(nil)
+				shard.EXPECT().Delete(metric.ID(10), roaring.BitmapOf(0, 1, 2), gomock.Any()).Return(nil)
+				indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(roaring.BitmapOf(1, 2), nil)
+				tombstones.EXPECT().DeletedSeriesIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(0, 1, 2))
+				indexDB.EXPECT().DropMetric("ns", "cpu", metric.ID(10)).Return(nil)
+				metadataDB.EXPECT().DropMetric("ns", "cpu").Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "delete all series and drop metric",
+			body: body(nil, 0),
+			prepare: func() {
+				engine.EXPECT().GetDatabase("test").Return(db, true)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				op.EXPECT().Execute().Return(nil)
+				shard.EXPECT().Delete(metric.ID(10), roaring.BitmapOf(0, 1, 2), gomock.Any()).Return(nil)
+				indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(roaring.BitmapOf(1, 2), nil)
+				tombstones.EXPECT().DeletedSeriesIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(0, 1, 2))
+				indexDB.EXPECT().DropMetric("ns", "cpu", metric.ID(10)).Return(nil)
+				metadataDB.EXPECT().DropMetric("ns", "cpu").Return(nil)
+			},
+			assert: func(rs *models.DeleteResult) {
+				assert.Equal(t, uint64(3), rs.NumOfSeries())
+				assert.True(t, rs.MetricDropped)
+			},
+			code: http.StatusOK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPut, DeletePath, tt.body)
+			assert.Equal(t, tt.code, resp.Code)
+			if tt.assert != nil {
+				rs := &models.DeleteResult{}
+				assert.NoError(t, encoding.JSONUnmarshal(resp.Body.Bytes(), rs))
+				tt.assert(rs)
+			}
+		})
+	}
+}
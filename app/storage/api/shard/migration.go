@@ -0,0 +1,170 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package shard
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/tsdb"
+)
+
+// for testing
+var (
+	newRestyFn         = httppkg.NewRestyClient
+	newShardExporterFn = tsdb.NewShardExporter
+)
+
+var (
+	// MigratePath is the path of migrating shard data from current node to target node.
+	MigratePath = "/shard/migrate"
+	// ImportPath is the path of importing a batch of shard data exported by source node.
+	ImportPath = "/shard/import"
+)
+
+// ImportParam represents the param of importing a batch of shard data.
+type ImportParam struct {
+	Database string                 `json:"database" binding:"required"`
+	ShardID  models.ShardID         `json:"shardId"`
+	Option   *option.DatabaseOption `json:"option" binding:"required"`
+	// Begin represents the first batch of migration, shard cannot exist in target node.
+	Begin bool `json:"begin"`
+
+	tsdb.ExportBatch
+}
+
+// MigrationAPI represents shard data migration rest api.
+type MigrationAPI struct {
+	engine tsdb.Engine
+	logger *logger.Logger
+}
+
+// NewMigrationAPI creates shard data migration api instance.
+func NewMigrationAPI(engine tsdb.Engine) *MigrationAPI {
+	return &MigrationAPI{
+		engine: engine,
+		logger: logger.GetLogger("Storage", "MigrationAPI"),
+	}
+}
+
+// Register adds shard migration url route,
+// routes should be protected by internal authentication(request signed by cluster internal secret).
+func (api *MigrationAPI) Register(route gin.IRoutes) {
+	route.PUT(MigratePath, api.Migrate)
+	route.POST(ImportPath, api.Import)
+}
+
+// Migrate exports all data of shard, then imports them into target node batch by batch.
+func (api *MigrationAPI) Migrate(c *gin.Context) {
+	param := &models.ShardMigration{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	shard, ok := api.engine.GetShard(param.Database, param.ShardID)
+	if !ok {
+		httppkg.Error(c, constants.ErrShardNotFound)
+		return
+	}
+	api.logger.Info("start migrating shard data",
+		logger.String("database", param.Database), logger.Any("shardID", param.ShardID),
+		logger.String("target", param.Target))
+	begin := true
+	batches := 0
+	err := newShardExporterFn(shard).Export(c.Request.Context(), func(batch *tsdb.ExportBatch) error {
+		err := api.importBatch(param, begin, batch)
+		begin = false
+		batches++
+		return err
+	})
+	if err == nil && begin {
+		// shard hasn't any data, creates shard in target node
+		err = api.importBatch(param, begin, &tsdb.ExportBatch{})
+	}
+	if err != nil {
+		api.logger.Error("migrate shard data failure",
+			logger.String("database", param.Database), logger.Any("shardID", param.ShardID),
+			logger.String("target", param.Target), logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	api.logger.Info("migrate shard data successfully",
+		logger.String("database", param.Database), logger.Any("shardID", param.ShardID),
+		logger.String("target", param.Target), logger.Int("batches", batches))
+	httppkg.OK(c, "ok")
+}
+
+// Import imports a batch of shard data which migrating from shard leader.
+func (api *MigrationAPI) Import(c *gin.Context) {
+	param := &ImportParam{}
+	if err := c.ShouldBind(param); err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	shard, ok := api.engine.GetShard(param.Database, param.ShardID)
+	if param.Begin {
+		if ok {
+			httppkg.Error(c, fmt.Errorf("shard %d of database %s already exist", param.ShardID, param.Database))
+			return
+		}
+		if err := api.engine.CreateShards(param.Database, param.Option, param.ShardID); err != nil {
+			httppkg.Error(c, err)
+			return
+		}
+		shard, ok = api.engine.GetShard(param.Database, param.ShardID)
+	}
+	if !ok {
+		httppkg.Error(c, constants.ErrShardNotFound)
+		return
+	}
+	if param.FamilyTime > 0 {
+		if err := tsdb.ImportBatch(shard, &param.ExportBatch); err != nil {
+			httppkg.Error(c, err)
+			return
+		}
+	}
+	httppkg.OK(c, "ok")
+}
+
+// importBatch sends a batch of shard data to target node.
+func (api *MigrationAPI) importBatch(migration *models.ShardMigration, begin bool, batch *tsdb.ExportBatch) error {
+	resp, err := newRestyFn().R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader(constants.InternalAuthorizationHeader, httppkg.InternalAuthorization()).
+		SetBody(&ImportParam{
+			Database:    migration.Database,
+			ShardID:     migration.ShardID,
+			Option:      migration.Option,
+			Begin:       begin,
+			ExportBatch: *batch,
+		}).
+		Post(migration.Target + constants.APIVersion1CliPath + ImportPath)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("import shard data failure, target: %s, err: %s", migration.Target, resp.String())
+	}
+	return nil
+}
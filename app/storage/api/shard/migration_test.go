@@ -0,0 +1,271 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package shard
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/tsdb"
+)
+
+func TestMigrationAPI_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	api := NewMigrationAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	newParam := func(begin bool, familyTime int64) string {
+		return string(encoding.JSONMarshal(&ImportParam{
+			Database:    "test",
+			ShardID:     1,
+			Option:      &option.DatabaseOption{},
+			Begin:       begin,
+			ExportBatch: tsdb.ExportBatch{FamilyTime: familyTime},
+		}))
+	}
+	cases := []struct {
+		name    string
+		body    string
+		prepare func()
+		code    int
+	}{
+		{
+			name: "param invalid",
+			body: "abc",
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "shard already exist when begin",
+			body: newParam(true, 0),
+			prepare: func() {
+				engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "create shard failure",
+			body: newParam(true, 0),
+			prepare: func() {
+				engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+				engine.EXPECT().CreateShards("test", gomock.Any(), models.ShardID(1)).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "create shard successfully",
+			body: newParam(true, 0),
+			prepare: func() {
+				engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+				engine.EXPECT().CreateShards("test", gomock.Any(), models.ShardID(1)).Return(nil)
+				engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true)
+			},
+			code: http.StatusOK,
+		},
+		{
+			name: "shard not found",
+			body: newParam(false, 10),
+			prepare: func() {
+				engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "import batch failure",
+			body: newParam(false, 10),
+			prepare: func() {
+				engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true)
+				shard.EXPECT().GetOrCrateDataFamily(int64(10)).Return(nil, fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPost, ImportPath, tt.body)
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
+
+func TestMigrationAPI_Migrate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newShardExporterFn = tsdb.NewShardExporter
+		httppkg.SetClientInternalAuthorization(nil)
+		ctrl.Finish()
+	}()
+	secret := []byte("secret")
+	httppkg.SetClientInternalAuthorization(func() string {
+		token, _ := middleware.CreateInternalToken(secret, time.Minute)
+		return token
+	})
+
+	engine := tsdb.NewMockEngine(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	exporter := tsdb.NewMockShardExporter(ctrl)
+	newShardExporterFn = func(_ tsdb.Shard) tsdb.ShardExporter {
+		return exporter
+	}
+	api := NewMigrationAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// target node
+	var begins []bool
+	targetFailure := false
+	target := gin.New()
+	target.Use(middleware.ValidateInternal(func() []byte { return secret }))
+	target.POST(constants.APIVersion1CliPath+ImportPath, func(c *gin.Context) {
+		param := &ImportParam{}
+		assert.NoError(t, c.ShouldBind(param))
+		begins = append(begins, param.Begin)
+		if targetFailure {
+			c.JSON(http.StatusInternalServerError, "err")
+			return
+		}
+		c.JSON(http.StatusOK, "ok")
+	})
+	ts := httptest.NewServer(target)
+	defer ts.Close()
+
+	body := string(encoding.JSONMarshal(&models.ShardMigration{
+		Database: "test",
+		ShardID:  1,
+		Option:   &option.DatabaseOption{},
+		Target:   ts.URL,
+	}))
+	exportBatches := func(batches ...*tsdb.ExportBatch) {
+		exporter.EXPECT().Export(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, fn func(batch *tsdb.ExportBatch) error) error {
+				for _, batch := range batches {
+					if err := fn(batch); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+	}
+	cases := []struct {
+		name    string
+		body    string
+		prepare func()
+		code    int
+		begins  []bool
+	}{
+		{
+			name: "param invalid",
+			body: "abc",
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "shard not found",
+			body: body,
+			prepare: func() {
+				engine.EXPECT().GetShard("test", models.ShardID(1)).Return(nil, false)
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "export failure",
+			body: body,
+			prepare: func() {
+				engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true)
+				exporter.EXPECT().Export(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			code: http.StatusInternalServerError,
+		},
+		{
+			name: "import failure",
+			body: body,
+			prepare: func() {
+				targetFailure = true
+				engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true)
+				exportBatches(&tsdb.ExportBatch{FamilyTime: 10}, &tsdb.ExportBatch{FamilyTime: 20})
+			},
+			code:   http.StatusInternalServerError,
+			begins: []bool{true},
+		},
+		{
+			name: "migrate empty shard",
+			body: body,
+			prepare: func() {
+				engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true)
+				exportBatches()
+			},
+			code:   http.StatusOK,
+			begins: []bool{true},
+		},
+		{
+			name: "migrate successfully",
+			body: body,
+			prepare: func() {
+				engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true)
+				exportBatches(&tsdb.ExportBatch{FamilyTime: 10}, &tsdb.ExportBatch{FamilyTime: 20})
+			},
+			code:   http.StatusOK,
+			begins: []bool{true, false},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			begins = nil
+			targetFailure = false
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			resp := mock.DoRequest(t, r, http.MethodPut, MigratePath, tt.body)
+			assert.Equal(t, tt.code, resp.Code)
+			assert.Equal(t, tt.begins, begins)
+		})
+	}
+	// target cannot connect
+	engine.EXPECT().GetShard("test", models.ShardID(1)).Return(shard, true)
+	exportBatches()
+	resp := mock.DoRequest(t, r, http.MethodPut, MigratePath, string(encoding.JSONMarshal(&models.ShardMigration{
+		Database: "test",
+		Option:   &option.DatabaseOption{},
+		Target:   "http://127.0.0.1:0",
+		ShardID:  1,
+	})))
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+}
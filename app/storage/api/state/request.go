@@ -20,6 +20,7 @@ package state
 import (
 	"github.com/gin-gonic/gin"
 
+	"github.com/lindb/lindb/models"
 	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/query"
 )
@@ -54,12 +55,17 @@ func (r *RequestAPI) GetRequestState(c *gin.Context) {
 		httppkg.Error(c, err)
 		return
 	}
-	pipeline := query.GetPipelineManager().GetPipeline(param.RequestID)
-	if pipeline == nil {
+	pipelines := query.GetPipelineManager().GetPipelines(param.RequestID)
+	if len(pipelines) == 0 {
 		httppkg.NotFound(c)
 		return
 	}
-	httppkg.OK(c, pipeline.Stats())
+	// request may execute multi pipelines(such as cross metric query), returns stats of all pipelines
+	var stats []*models.StageStats
+	for _, pipeline := range pipelines {
+		stats = append(stats, pipeline.Stats()...)
+	}
+	httppkg.OK(c, stats)
 }
 
 // GetAllAliveRequests returns all alive requests.
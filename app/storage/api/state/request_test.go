@@ -53,8 +53,11 @@ func TestRequestAPI(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, resp.Code)
 	})
 	t.Run("found reuqest", func(t *testing.T) {
-		query.GetPipelineManager().AddPipeline("id", pipeline)
-		pipeline.EXPECT().Stats().Return(nil)
+		query.GetPipelineManager().AddPipeline("id", "task-1", pipeline)
+		query.GetPipelineManager().AddPipeline("id", "task-2", pipeline)
+		defer query.GetPipelineManager().RemovePipeline("id", "task-1")
+		defer query.GetPipelineManager().RemovePipeline("id", "task-2")
+		pipeline.EXPECT().Stats().Return(nil).Times(2)
 		resp := mock.DoRequest(t, r, http.MethodGet, RequestPath+"?requestId=id", "")
 		assert.Equal(t, http.StatusOK, resp.Code)
 	})
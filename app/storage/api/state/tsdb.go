@@ -28,16 +28,19 @@ import (
 
 var (
 	MemoryDatabase = "/state/tsdb/memory"
+	SeriesQuota    = "/state/tsdb/series-quota"
 )
 
 // TSDBAPI represents tsdb internal state rest api.
 type TSDBAPI struct {
+	engine tsdb.Engine
 	logger *logger.Logger
 }
 
 // NewTSDBAPI creates a tsdb state api instance.
-func NewTSDBAPI() *TSDBAPI {
+func NewTSDBAPI(engine tsdb.Engine) *TSDBAPI {
 	return &TSDBAPI{
+		engine: engine,
 		logger: logger.GetLogger("Storage", "TSDBAPI"),
 	}
 }
@@ -45,6 +48,7 @@ func NewTSDBAPI() *TSDBAPI {
 // Register adds the route for tsdb state api.
 func (db *TSDBAPI) Register(route gin.IRoutes) {
 	route.GET(MemoryDatabase, db.GetMemoryDatabaseState)
+	route.GET(SeriesQuota, db.GetSeriesQuotaUsage)
 }
 
 // GetMemoryDatabaseState returns memory database
@@ -65,3 +69,27 @@ func (db *TSDBAPI) GetMemoryDatabaseState(c *gin.Context) {
 	})
 	httppkg.OK(c, rs)
 }
+
+// GetSeriesQuotaUsage returns the series quota usages of each shard for database.
+func (db *TSDBAPI) GetSeriesQuotaUsage(c *gin.Context) {
+	var param struct {
+		DB    string `form:"db" binding:"required"`
+		Limit int    `form:"limit"`
+	}
+	err := c.ShouldBindQuery(&param)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	database, ok := db.engine.GetDatabase(param.DB)
+	if !ok {
+		httppkg.NotFound(c)
+		return
+	}
+	rs, err := database.GetSeriesQuotaUsage(param.Limit)
+	if err != nil {
+		httppkg.Error(c, err)
+		return
+	}
+	httppkg.OK(c, rs)
+}
@@ -18,6 +18,7 @@
 package state
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -46,7 +47,7 @@ func TestTSDBAPI_GetMemoryDatabaseState(t *testing.T) {
 	db.EXPECT().Name().Return("test")
 	tsdb.GetFamilyManager().AddFamily(f)
 
-	api := NewTSDBAPI()
+	api := NewTSDBAPI(nil)
 	r := gin.New()
 	api.Register(r)
 
@@ -57,3 +58,31 @@ func TestTSDBAPI_GetMemoryDatabaseState(t *testing.T) {
 	resp = mock.DoRequest(t, r, http.MethodGet, MemoryDatabase+"?db=test", "")
 	assert.Equal(t, http.StatusOK, resp.Code)
 }
+
+func TestTSDBAPI_GetSeriesQuotaUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	engine := tsdb.NewMockEngine(ctrl)
+	db := tsdb.NewMockDatabase(ctrl)
+	api := NewTSDBAPI(engine)
+	r := gin.New()
+	api.Register(r)
+
+	// case 1: params invalid
+	resp := mock.DoRequest(t, r, http.MethodGet, SeriesQuota, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 2: database not found
+	engine.EXPECT().GetDatabase("test").Return(nil, false)
+	resp = mock.DoRequest(t, r, http.MethodGet, SeriesQuota+"?db=test", "")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+	// case 3: get usage failure
+	engine.EXPECT().GetDatabase("test").Return(db, true).AnyTimes()
+	db.EXPECT().GetSeriesQuotaUsage(10).Return(nil, fmt.Errorf("err"))
+	resp = mock.DoRequest(t, r, http.MethodGet, SeriesQuota+"?db=test&limit=10", "")
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	// case 4: get usage ok
+	db.EXPECT().GetSeriesQuotaUsage(0).Return([]models.ShardSeriesQuota{{ShardID: 1}}, nil)
+	resp = mock.DoRequest(t, r, http.MethodGet, SeriesQuota+"?db=test", "")
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
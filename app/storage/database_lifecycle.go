@@ -24,6 +24,8 @@ import (
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/replica"
@@ -45,9 +47,10 @@ type databaseLifecycle struct {
 	ctx    context.Context
 	cancel context.CancelFunc
 
-	walMgr replica.WriteAheadLogManager
-	engine tsdb.Engine
-	repo   state.Repository
+	currentNodeID models.NodeID
+	walMgr        replica.WriteAheadLogManager
+	engine        tsdb.Engine
+	repo          state.Repository
 
 	logger *logger.Logger
 }
@@ -55,18 +58,20 @@ type databaseLifecycle struct {
 // NewDatabaseLifecycle creates a DatabaseLifecycle instance.
 func NewDatabaseLifecycle(
 	ctx context.Context,
+	currentNodeID models.NodeID,
 	repo state.Repository,
 	walMgr replica.WriteAheadLogManager,
 	engine tsdb.Engine,
 ) DatabaseLifecycle {
 	c, cancel := context.WithCancel(ctx)
 	return &databaseLifecycle{
-		ctx:    c,
-		cancel: cancel,
-		repo:   repo,
-		walMgr: walMgr,
-		engine: engine,
-		logger: logger.GetLogger("Lifecycle", "Database"),
+		ctx:           c,
+		cancel:        cancel,
+		currentNodeID: currentNodeID,
+		repo:          repo,
+		walMgr:        walMgr,
+		engine:        engine,
+		logger:        logger.GetLogger("Lifecycle", "Database"),
 	}
 }
 
@@ -111,6 +116,8 @@ func (l *databaseLifecycle) ttlTask() {
 			case <-ticker.C:
 				// try drop databases
 				l.tryDropDatabases()
+				// try drop shards which moved to other nodes
+				l.tryDropShards()
 				// do data ttl
 				l.engine.TTL()
 				// do data compaction
@@ -147,3 +154,52 @@ func (l *databaseLifecycle) tryDropDatabases() {
 	l.engine.DropDatabases(activeDatabases)
 	l.walMgr.DropDatabases(activeDatabases)
 }
+
+// tryDropShards tries drop shard's resource(data/write ahead log) which isn't assigned to current node,
+// keeps the shards which are assigned to current node or are migrating to current node.
+func (l *databaseLifecycle) tryDropShards() {
+	activeShards := make(map[string]map[models.ShardID]struct{})
+	getShards := func(databaseName string) map[models.ShardID]struct{} {
+		shards, ok := activeShards[databaseName]
+		if !ok {
+			shards = make(map[models.ShardID]struct{})
+			activeShards[databaseName] = shards
+		}
+		return shards
+	}
+	if err := l.repo.WalkEntry(l.ctx, constants.ShardAssignmentPath, func(_, value []byte) {
+		assignment := &models.DatabaseAssignment{}
+		if err := encoding.JSONUnmarshal(value, assignment); err != nil || assignment.ShardAssignment == nil {
+			l.logger.Warn("unmarshal database assignment failure, ignore it", logger.Error(err))
+			return
+		}
+		shards := getShards(assignment.ShardAssignment.Name)
+		for shardID, replica := range assignment.ShardAssignment.Shards {
+			if replica.Contain(l.currentNodeID) {
+				shards[shardID] = struct{}{}
+			}
+		}
+	}); err != nil {
+		l.logger.Error("list database assignment failure", logger.Error(err))
+		return
+	}
+	if err := l.repo.WalkEntry(l.ctx, constants.ShardMigrationPath, func(key, value []byte) {
+		move := &models.ShardMove{}
+		if err := encoding.JSONUnmarshal(value, move); err != nil {
+			l.logger.Warn("unmarshal shard migration failure, ignore it", logger.Error(err))
+			return
+		}
+		if move.To == l.currentNodeID {
+			// key: /database/migration/{database}/{shard}
+			databaseName := path.Base(path.Dir(string(key)))
+			getShards(databaseName)[move.ShardID] = struct{}{}
+		}
+	}); err != nil {
+		l.logger.Error("list shard migration failure", logger.Error(err))
+		return
+	}
+	for databaseName, shards := range activeShards {
+		l.walMgr.DropShards(databaseName, shards)
+		l.engine.DropShards(databaseName, shards)
+	}
+}
@@ -28,6 +28,8 @@ import (
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/replica"
@@ -45,7 +47,7 @@ func TestNewDatabaseLifecycle(t *testing.T) {
 	engine := tsdb.NewMockEngine(ctrl)
 	engine.EXPECT().Close().MaxTimes(2)
 
-	dbLifecycle := NewDatabaseLifecycle(context.TODO(), repo, walMgr, engine)
+	dbLifecycle := NewDatabaseLifecycle(context.TODO(), 1, repo, walMgr, engine)
 
 	var wait sync.WaitGroup
 	wait.Add(1)
@@ -60,7 +62,7 @@ func TestNewDatabaseLifecycle(t *testing.T) {
 	ch <- struct{}{}
 	wait.Wait()
 
-	dbLifecycle = NewDatabaseLifecycle(context.TODO(), repo, walMgr, engine)
+	dbLifecycle = NewDatabaseLifecycle(context.TODO(), 1, repo, walMgr, engine)
 
 	walMgr.EXPECT().Close().Return(fmt.Errorf("err"))
 	dbLifecycle.Shutdown()
@@ -85,7 +87,7 @@ func TestDatabaseLifecycle_ttlTask(t *testing.T) {
 	engine := tsdb.NewMockEngine(ctrl)
 	engine.EXPECT().Close()
 
-	dbLifecycle := NewDatabaseLifecycle(context.TODO(), repo, walMgr, engine)
+	dbLifecycle := NewDatabaseLifecycle(context.TODO(), 1, repo, walMgr, engine)
 	ch := make(chan struct{})
 	go func() {
 		time.Sleep(100 * time.Millisecond)
@@ -150,7 +152,7 @@ func TestDatabaseLifecycle_dropDatabases(t *testing.T) {
 	for _, tt := range cases {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			dbLifecycle := NewDatabaseLifecycle(context.TODO(), repo, walMgr, engine)
+			dbLifecycle := NewDatabaseLifecycle(context.TODO(), 1, repo, walMgr, engine)
 			dbLifecycle1 := dbLifecycle.(*databaseLifecycle)
 			if tt.prepare != nil {
 				tt.prepare()
@@ -159,3 +161,77 @@ func TestDatabaseLifecycle_dropDatabases(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabaseLifecycle_dropShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	walMgr := replica.NewMockWriteAheadLogManager(ctrl)
+	engine := tsdb.NewMockEngine(ctrl)
+
+	cases := []struct {
+		name    string
+		prepare func()
+	}{
+		{
+			name: "list database assignment err",
+			prepare: func() {
+				repo.EXPECT().WalkEntry(gomock.Any(), constants.ShardAssignmentPath, gomock.Any()).Return(fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "list shard migration err",
+			prepare: func() {
+				repo.EXPECT().WalkEntry(gomock.Any(), constants.ShardAssignmentPath, gomock.Any()).Return(nil)
+				repo.EXPECT().WalkEntry(gomock.Any(), constants.ShardMigrationPath, gomock.Any()).Return(fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "drop shards",
+			prepare: func() {
+				repo.EXPECT().WalkEntry(gomock.Any(), constants.ShardAssignmentPath, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, prefix string, fn func([]byte, []byte)) error {
+						fn([]byte(constants.GetDatabaseAssignPath("test")), []byte("abc"))
+						fn([]byte(constants.GetDatabaseAssignPath("test")), encoding.JSONMarshal(&models.DatabaseAssignment{
+							ShardAssignment: &models.ShardAssignment{
+								Name: "test",
+								Shards: map[models.ShardID]*models.Replica{
+									1: {Replicas: []models.NodeID{1, 2}},
+									2: {Replicas: []models.NodeID{2, 3}},
+									3: {Replicas: []models.NodeID{2, 3}},
+								},
+							},
+						}))
+						return nil
+					})
+				repo.EXPECT().WalkEntry(gomock.Any(), constants.ShardMigrationPath, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, prefix string, fn func([]byte, []byte)) error {
+						fn([]byte(constants.GetShardMigrationPath("test", 2)), []byte("abc"))
+						fn([]byte(constants.GetShardMigrationPath("test", 2)),
+							encoding.JSONMarshal(&models.ShardMove{ShardID: 2, From: 2, To: 1}))
+						fn([]byte(constants.GetShardMigrationPath("test", 3)),
+							encoding.JSONMarshal(&models.ShardMove{ShardID: 3, From: 2, To: 4}))
+						return nil
+					})
+				activeShards := map[models.ShardID]struct{}{1: {}, 2: {}}
+				gomock.InOrder(
+					walMgr.EXPECT().DropShards("test", activeShards),
+					engine.EXPECT().DropShards("test", activeShards),
+				)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			dbLifecycle := NewDatabaseLifecycle(context.TODO(), 1, repo, walMgr, engine)
+			dbLifecycle1 := dbLifecycle.(*databaseLifecycle)
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			dbLifecycle1.tryDropShards()
+		})
+	}
+}
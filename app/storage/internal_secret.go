@@ -0,0 +1,87 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+// internalTokenTTL represents the ttl of token used by internal request between nodes.
+const internalTokenTTL = time.Minute
+
+// internalSecret represents the cluster internal secret which is synced into storage state repo by master,
+// used for validating/signing the token of internal api between nodes.
+type internalSecret struct {
+	ctx    context.Context
+	repo   state.Repository
+	secret []byte
+	mutex  sync.RWMutex
+}
+
+// newInternalSecret creates the cluster internal secret.
+func newInternalSecret(ctx context.Context) *internalSecret {
+	return &internalSecret{ctx: ctx}
+}
+
+// setRepo sets the storage state repo which the secret is loaded from.
+func (s *internalSecret) setRepo(repo state.Repository) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.repo = repo
+}
+
+// Get returns the secret, loads it from state repo if not loaded(the secret never changes once created),
+// returns nil if state repo not started or master hasn't synced the secret.
+func (s *internalSecret) Get() []byte {
+	s.mutex.RLock()
+	secret, repo := s.secret, s.repo
+	s.mutex.RUnlock()
+
+	if secret != nil || repo == nil {
+		return secret
+	}
+	// load secret without lock, avoid blocking other requests
+	secret, err := repo.Get(s.ctx, constants.InternalSecretPath)
+	if err != nil {
+		return nil
+	}
+	s.mutex.Lock()
+	s.secret = secret
+	s.mutex.Unlock()
+	return secret
+}
+
+// Token returns the token of internal api signed by the secret, empty if secret not available.
+func (s *internalSecret) Token() string {
+	secret := s.Get()
+	if secret == nil {
+		return ""
+	}
+	token, err := middleware.CreateInternalToken(secret, internalTokenTTL)
+	if err != nil {
+		return ""
+	}
+	return token
+}
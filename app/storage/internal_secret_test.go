@@ -0,0 +1,51 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func TestInternalSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secret := newInternalSecret(context.TODO())
+	// state repo not started
+	assert.Nil(t, secret.Get())
+	assert.Empty(t, secret.Token())
+
+	repo := state.NewMockRepository(ctrl)
+	secret.setRepo(repo)
+	// secret not synced by master
+	repo.EXPECT().Get(gomock.Any(), constants.InternalSecretPath).Return(nil, fmt.Errorf("err"))
+	assert.Nil(t, secret.Get())
+	// load secret once
+	repo.EXPECT().Get(gomock.Any(), constants.InternalSecretPath).Return([]byte("secret"), nil)
+	assert.Equal(t, []byte("secret"), secret.Get())
+	assert.Equal(t, []byte("secret"), secret.Get())
+	assert.NotEmpty(t, secret.Token())
+}
@@ -92,6 +92,14 @@ func (r *ReplicaHandler) Replica(server protoReplicaV1.ReplicaService_ReplicaSer
 		r.logger.Error("get replica state err", logger.Error(err))
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
+	wal := r.walMgr.GetOrCreateLog(replicaState.Database)
+	// reject replica stream from stale leader
+	if err := wal.CheckEpoch(replicaState.ShardID, replicaState.Epoch); err != nil {
+		r.logger.Warn("reject replica stream with stale leader epoch",
+			logger.String("replica", replicaState.String()),
+			logger.Int64("epoch", replicaState.Epoch))
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
 
 	p, err := r.getOrCreatePartition(
 		replicaState.Database,
@@ -120,6 +128,10 @@ func (r *ReplicaHandler) Replica(server protoReplicaV1.ReplicaService_ReplicaSer
 		}
 
 		resp := &protoReplicaV1.ReplicaResponse{}
+		// leader may be changed when stream is alive, reject replica if epoch is stale
+		if err := wal.CheckEpoch(replicaState.ShardID, replicaState.Epoch); err != nil {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
 		r.logger.Debug("receive write ahead log replica log",
 			logger.Any("from", replicaState.Leader), logger.Int64("index", req.ReplicaIndex))
 		// write replica wal log
@@ -25,9 +25,12 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
 	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
 	"github.com/lindb/lindb/replica"
 )
@@ -50,8 +53,14 @@ func TestReplicaHandler_Replica(t *testing.T) {
 	replicaServer.EXPECT().Context().Return(ctx).AnyTimes()
 	wal := replica.NewMockWriteAheadLog(ctrl)
 	walMgr.EXPECT().GetOrCreateLog(gomock.Any()).Return(wal).AnyTimes()
-	wal.EXPECT().GetOrCreatePartition(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	// case 4: stale leader epoch
+	wal.EXPECT().CheckEpoch(models.ShardID(1), int64(0)).Return(constants.ErrStaleLeaderEpoch)
 	err := r.Replica(replicaServer)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	wal.EXPECT().CheckEpoch(models.ShardID(1), int64(0)).Return(nil).Times(8)
+	wal.EXPECT().GetOrCreatePartition(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	err = r.Replica(replicaServer)
 	assert.Error(t, err)
 
 	// case 6: build replica replica err
@@ -86,4 +95,11 @@ func TestReplicaHandler_Replica(t *testing.T) {
 	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
 	err = r.Replica(replicaServer)
 	assert.NoError(t, err)
+
+	// case 10: leader changed when stream is alive
+	wal.EXPECT().CheckEpoch(models.ShardID(1), int64(0)).Return(nil)
+	replicaServer.EXPECT().Recv().Return(&protoReplicaV1.ReplicaRequest{}, nil)
+	wal.EXPECT().CheckEpoch(models.ShardID(1), int64(0)).Return(constants.ErrStaleLeaderEpoch)
+	err = r.Replica(replicaServer)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
 }
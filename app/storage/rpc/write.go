@@ -60,6 +60,15 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 	if len(familyState.Shard.Replica.Replicas) == 0 {
 		return status.Error(codes.InvalidArgument, "replicas cannot be empty")
 	}
+	wal := r.walMgr.GetOrCreateLog(familyState.Database)
+	// reject write stream from stale leader
+	if err := wal.CheckEpoch(familyState.Shard.ID, familyState.Shard.Epoch); err != nil {
+		r.logger.Warn("reject write stream with stale leader epoch",
+			logger.String("database", familyState.Database),
+			logger.Any("shardID", familyState.Shard.ID),
+			logger.Int64("epoch", familyState.Shard.Epoch))
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
 
 	p, err := r.getOrCreatePartition(
 		familyState.Database,
@@ -76,6 +85,8 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 		return status.Error(codes.Internal, err.Error())
 	}
 
+	// sequence of series quota rejections which reported to broker
+	var quotaSeq int64
 	// handle write request from stream
 	for {
 		req, err := server.Recv()
@@ -88,12 +99,23 @@ func (r *WriteHandler) Write(server protoWriteV1.WriteService_WriteServer) error
 		}
 
 		resp := &protoWriteV1.WriteResponse{}
+		// leader may be changed when stream is alive, reject write if epoch is stale
+		if err := wal.CheckEpoch(familyState.Shard.ID, familyState.Shard.Epoch); err != nil {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
 		// write wal log
 		err = p.WriteLog(req.Record)
 
 		if err != nil {
 			resp.Err = err.Error()
 		}
+		// series are created asynchronously after write acked, report series quota rejections of shard to broker,
+		// broker rejects the writes of these scopes.
+		quotaErrs, seq := p.SeriesQuotaErrors(quotaSeq)
+		quotaSeq = seq
+		if len(quotaErrs) > 0 {
+			resp.SeriesQuotaErrors = encoding.JSONMarshal(quotaErrs)
+		}
 
 		if err := server.Send(resp); err != nil {
 			return status.Error(codes.Internal, err.Error())
@@ -26,9 +26,13 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
 	protoWriteV1 "github.com/lindb/lindb/proto/gen/v1/write"
 	"github.com/lindb/lindb/replica"
 )
@@ -68,6 +72,12 @@ func TestWriteHandler_Write(t *testing.T) {
 	replicaServer.EXPECT().Context().Return(ctx).AnyTimes()
 	wal := replica.NewMockWriteAheadLog(ctrl)
 	walMgr.EXPECT().GetOrCreateLog(gomock.Any()).Return(wal).AnyTimes()
+	// case 4: stale leader epoch
+	wal.EXPECT().CheckEpoch(models.ShardID(1), int64(0)).Return(constants.ErrStaleLeaderEpoch)
+	err = r.Write(replicaServer)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	// case 5: create partition err
+	wal.EXPECT().CheckEpoch(models.ShardID(1), int64(0)).Return(nil).Times(11)
 	wal.EXPECT().GetOrCreatePartition(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
 	err = r.Write(replicaServer)
 	assert.Error(t, err)
@@ -91,14 +101,41 @@ func TestWriteHandler_Write(t *testing.T) {
 	// case 9: write wal err
 	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
 	p.EXPECT().WriteLog(gomock.Any()).Return(fmt.Errorf("err"))
+	p.EXPECT().SeriesQuotaErrors(int64(0)).Return(nil, int64(0))
 	replicaServer.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
 	err = r.Write(replicaServer)
 	assert.Error(t, err)
 	// case 10: write wal ok
 	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
 	p.EXPECT().WriteLog(gomock.Any()).Return(nil)
+	p.EXPECT().SeriesQuotaErrors(int64(0)).Return(nil, int64(0))
 	replicaServer.EXPECT().Send(gomock.Any()).Return(nil)
 	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
 	err = r.Write(replicaServer)
 	assert.NoError(t, err)
+	// case 11: report series quota rejections
+	quotaErrs := []models.SeriesQuotaError{{Scope: models.MetricQuotaScope, Namespace: "ns", Name: "cpu", Quota: 1, Usage: 1}}
+	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil).Times(2)
+	p.EXPECT().WriteLog(gomock.Any()).Return(nil).Times(2)
+	p.EXPECT().SeriesQuotaErrors(int64(0)).Return(quotaErrs, int64(1))
+	p.EXPECT().SeriesQuotaErrors(int64(1)).Return(nil, int64(1))
+	replicaServer.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoWriteV1.WriteResponse) error {
+		var errs []models.SeriesQuotaError
+		assert.NoError(t, encoding.JSONUnmarshal(resp.SeriesQuotaErrors, &errs))
+		assert.Equal(t, quotaErrs, errs)
+		return nil
+	})
+	replicaServer.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *protoWriteV1.WriteResponse) error {
+		assert.Empty(t, resp.SeriesQuotaErrors)
+		return nil
+	})
+	replicaServer.EXPECT().Recv().Return(nil, io.EOF)
+	err = r.Write(replicaServer)
+	assert.NoError(t, err)
+	// case 12: leader changed when stream is alive
+	wal.EXPECT().CheckEpoch(models.ShardID(1), int64(0)).Return(nil)
+	replicaServer.EXPECT().Recv().Return(&protoWriteV1.WriteRequest{}, nil)
+	wal.EXPECT().CheckEpoch(models.ShardID(1), int64(0)).Return(constants.ErrStaleLeaderEpoch)
+	err = r.Write(replicaServer)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
 }
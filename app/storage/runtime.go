@@ -26,6 +26,8 @@ import (
 	"strconv"
 	"time"
 
+	databaseapi "github.com/lindb/lindb/app/storage/api/database"
+	shardapi "github.com/lindb/lindb/app/storage/api/shard"
 	stateapi "github.com/lindb/lindb/app/storage/api/state"
 	rpchandler "github.com/lindb/lindb/app/storage/rpc"
 	"github.com/lindb/lindb/config"
@@ -43,9 +45,11 @@ import (
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/hostutil"
 	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/pkg/tlsutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
 	protoWriteV1 "github.com/lindb/lindb/proto/gen/v1/write"
@@ -54,6 +58,7 @@ import (
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/tag"
 	"github.com/lindb/lindb/tsdb"
+	"github.com/lindb/lindb/tsdb/tiering"
 )
 
 // factory represents all factories for storage
@@ -74,6 +79,7 @@ var (
 	hostName               = os.Hostname
 	newStateMachineFactory = storage.NewStateMachineFactory
 	newDatabaseLifecycleFn = NewDatabaseLifecycle
+	newTLSLoader           = tlsutil.NewLoader
 )
 
 // runtime represents storage runtime dependency
@@ -106,6 +112,7 @@ type runtime struct {
 	queryPool       concurrent.Pool
 	pusher          monitoring.NativePusher
 	globalKeyValues tag.Tags
+	internalSecret  *internalSecret
 
 	log *logger.Logger
 }
@@ -125,9 +132,10 @@ func NewStorageRuntime(version string, cfg *config.Storage) server.Service {
 			cfg.Query.QueryConcurrency,
 			cfg.Query.IdleTimeout.Duration(),
 			metrics.NewConcurrentStatistics("storage-query", linmetric.StorageRegistry)),
-		delayInit:   time.Second,
-		initializer: bootstrap.NewClusterInitializer(cfg.StorageBase.BrokerEndpoint),
-		log:         logger.GetLogger("Storage", "Runtime"),
+		delayInit:      time.Second,
+		initializer:    bootstrap.NewClusterInitializer(cfg.StorageBase.BrokerEndpoint),
+		internalSecret: newInternalSecret(ctx),
+		log:            logger.GetLogger("Storage", "Runtime"),
 	}
 }
 
@@ -148,6 +156,14 @@ func (r *runtime) Run() error {
 		return fmt.Errorf("failed to get server ip address, error: %s", err)
 	}
 
+	// init cold storage backend for tiering old segments
+	backend, err := tiering.NewBackend(&config.GlobalStorageConfig().TSDB.Tiering)
+	if err != nil {
+		r.state = server.Failed
+		return err
+	}
+	tiering.InitBackend(backend)
+
 	opt := kv.StoreOptions{
 		Dir: config.GlobalStorageConfig().TSDB.Dir,
 	}
@@ -175,10 +191,17 @@ func (r *runtime) Run() error {
 			GRPCPort:   r.config.StorageBase.GRPC.Port,
 			HostName:   hostName,
 			HTTPPort:   r.config.StorageBase.HTTP.Port,
+			HTTPS:      r.config.StorageBase.HTTP.TLS.Enabled,
 			OnlineTime: timeutil.Now(),
 			Version:    config.Version,
 		},
 	}
+	if err = r.initClientTLS(); err != nil {
+		r.state = server.Failed
+		return err
+	}
+	// sign the request of internal api(such as shard migration) with cluster internal secret
+	httppkg.SetClientInternalAuthorization(r.internalSecret.Token)
 	r.globalKeyValues = tag.Tags{
 		{Key: []byte("node"), Value: []byte(r.node.Indicator())},
 		{Key: []byte("role"), Value: []byte(constants.StorageRole)},
@@ -213,7 +236,7 @@ func (r *runtime) Run() error {
 		return err
 	}
 
-	r.dbLifecycle = newDatabaseLifecycleFn(r.ctx, r.repo, r.walMgr, r.engine)
+	r.dbLifecycle = newDatabaseLifecycleFn(r.ctx, r.node.ID, r.repo, r.walMgr, r.engine)
 	r.dbLifecycle.Startup()
 
 	// Use Leader election mechanism to ensure the uniqueness of stateful node id
@@ -314,6 +337,7 @@ func (r *runtime) startStateRepo() error {
 		return fmt.Errorf("start storage state repository error:%s", err)
 	}
 	r.repo = repo
+	r.internalSecret.setRepo(repo)
 	r.log.Info("start storage state repository successfully")
 	return nil
 }
@@ -373,6 +397,25 @@ func (r *runtime) Stop() {
 	r.state = server.Terminated
 }
 
+// initClientTLS sets the tls config of grpc/http client which connects other nodes if tls enabled.
+func (r *runtime) initClientTLS() error {
+	if grpcTLS := r.config.StorageBase.GRPC.TLS; grpcTLS.Enabled {
+		loader, err := newTLSLoader(grpcTLS)
+		if err != nil {
+			return fmt.Errorf("load grpc tls config error:%s", err)
+		}
+		rpc.GetStorageClientConnFactory().SetTLSConfig(loader.ClientConfig())
+	}
+	if httpTLS := r.config.StorageBase.HTTP.TLS; httpTLS.Enabled {
+		loader, err := newTLSLoader(httpTLS)
+		if err != nil {
+			return fmt.Errorf("load http tls config error:%s", err)
+		}
+		httppkg.SetClientTLSConfig(loader.ClientConfig())
+	}
+	return nil
+}
+
 // startHTTPServer starts http server for api rpcHandler
 func (r *runtime) startHTTPServer() {
 	if r.config.StorageBase.HTTP.Port <= 0 {
@@ -386,7 +429,7 @@ func (r *runtime) startHTTPServer() {
 	exploreAPI.Register(v1)
 	replicaAPI := stateapi.NewReplicaAPI(r.walMgr)
 	replicaAPI.Register(v1)
-	tsdbStateAPI := stateapi.NewTSDBAPI()
+	tsdbStateAPI := stateapi.NewTSDBAPI(r.engine)
 	tsdbStateAPI.Register(v1)
 	stateMachineAPI := stateapi.NewStorageStateMachineAPI(r.stateMgr)
 	stateMachineAPI.Register(v1)
@@ -396,6 +439,16 @@ func (r *runtime) startHTTPServer() {
 	configAPI.Register(v1)
 	requestAPI := stateapi.NewRequestAPI()
 	requestAPI.Register(v1)
+	// internal api only accepts the request signed by cluster internal secret(broker/master/storage)
+	internalV1 := v1.Group("", middleware.ValidateInternal(r.internalSecret.Get))
+	migrationAPI := shardapi.NewMigrationAPI(r.engine)
+	migrationAPI.Register(internalV1)
+	backupAPI := databaseapi.NewBackupAPI(r.node.ID, r.engine, r.walMgr)
+	backupAPI.Register(internalV1)
+	deleteAPI := databaseapi.NewDeleteAPI(r.engine)
+	deleteAPI.Register(internalV1)
+	prometheusAPI := monitoring.NewPrometheusAPI(r.globalKeyValues, linmetric.StorageRegistry)
+	prometheusAPI.Register(r.httpServer.GetRootRouter())
 
 	go func() {
 		if err := r.httpServer.Run(); err != http.ErrServerClosed {
@@ -20,6 +20,7 @@ package storage
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"testing"
@@ -35,9 +36,12 @@ import (
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/hostutil"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/state"
 	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/tsdb"
 )
 
@@ -70,7 +74,7 @@ func TestStorageRun(t *testing.T) {
 	dbLifecycle := NewMockDatabaseLifecycle(ctrl)
 	dbLifecycle.EXPECT().Startup()
 	dbLifecycle.EXPECT().Shutdown()
-	newDatabaseLifecycleFn = func(ctx context.Context, repo state.Repository,
+	newDatabaseLifecycleFn = func(ctx context.Context, currentNodeID models.NodeID, repo state.Repository,
 		walMgr replica.WriteAheadLogManager, engine tsdb.Engine) DatabaseLifecycle {
 		return dbLifecycle
 	}
@@ -98,6 +102,31 @@ func TestStorageRun(t *testing.T) {
 	assert.Equal(t, *runtime.node, nodeInfo)
 	assert.Equal(t, "storage", storage.Name())
 
+	// internal api requires the token signed by cluster internal secret
+	backupURL := "http://localhost:8888/api/v1/database/backup?db=test"
+	resp, err := httppkg.NewRestyClient().R().Get(backupURL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	resp, err = httppkg.NewRestyClient().R().Put("http://localhost:8888/api/v1/database/delete")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	resp, err = httppkg.NewRestyClient().R().Put("http://localhost:8888/api/v1/shard/migrate")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	resp, err = httppkg.NewRestyClient().R().Post("http://localhost:8888/api/v1/shard/import")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	token, err := middleware.CreateInternalToken([]byte("secret"), time.Minute)
+	assert.NoError(t, err)
+	resp, err = httppkg.NewRestyClient().R().SetHeader(constants.InternalAuthorizationHeader, token).Get(backupURL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode())
+	err = runtime.repo.Put(context.TODO(), constants.InternalSecretPath, []byte("secret"))
+	assert.NoError(t, err)
+	resp, err = httppkg.NewRestyClient().R().SetHeader(constants.InternalAuthorizationHeader, token).Get(backupURL)
+	assert.NoError(t, err)
+	assert.NotEqual(t, http.StatusUnauthorized, resp.StatusCode())
+
 	storage.Stop()
 	assert.Equal(t, server.Terminated, storage.State())
 	time.Sleep(500 * time.Millisecond)
@@ -117,7 +146,7 @@ func TestStorageRun_GetHost_Err(t *testing.T) {
 	dbLifecycle := NewMockDatabaseLifecycle(ctrl)
 	dbLifecycle.EXPECT().Startup()
 	dbLifecycle.EXPECT().Shutdown()
-	newDatabaseLifecycleFn = func(ctx context.Context, repo state.Repository,
+	newDatabaseLifecycleFn = func(ctx context.Context, currentNodeID models.NodeID, repo state.Repository,
 		walMgr replica.WriteAheadLogManager, engine tsdb.Engine) DatabaseLifecycle {
 		return dbLifecycle
 	}
@@ -164,6 +193,14 @@ func TestStorageRun_Err(t *testing.T) {
 	err := storage.Run()
 	assert.Error(t, err)
 
+	// unknown tiering backend
+	cfg.StorageBase.Indicator = 4
+	cfg.StorageBase.TSDB.Tiering.Backend = "unknown"
+	storage = NewStorageRuntime("test-version", &cfg)
+	err = storage.Run()
+	assert.Error(t, err)
+	cfg.StorageBase.TSDB.Tiering.Backend = ""
+
 	cfg.StorageBase.GRPC.Port = 8886
 	cfg.StorageBase.Indicator = 4
 	storage = NewStorageRuntime("test-version", &cfg)
@@ -185,3 +222,54 @@ func TestStorageRun_Err(t *testing.T) {
 	s.Stop()
 	assert.Error(t, err)
 }
+
+func TestStorageRuntime_initClientTLS(t *testing.T) {
+	defer func() {
+		rpc.GetStorageClientConnFactory().SetTLSConfig(nil)
+		httppkg.SetClientTLSConfig(nil)
+	}()
+	files := mock.GenerateTLSFiles(t, t.TempDir())
+	tlsCfg := config.TLS{Enabled: true, CertFile: files.CertFile, KeyFile: files.KeyFile, CAFile: files.CAFile}
+	cases := []struct {
+		name    string
+		grpcTLS config.TLS
+		httpTLS config.TLS
+		wantErr bool
+	}{
+		{
+			name: "tls disabled",
+		},
+		{
+			name:    "load grpc tls failure",
+			grpcTLS: config.TLS{Enabled: true},
+			wantErr: true,
+		},
+		{
+			name:    "load http tls failure",
+			grpcTLS: tlsCfg,
+			httpTLS: config.TLS{Enabled: true},
+			wantErr: true,
+		},
+		{
+			name:    "init client tls successfully",
+			grpcTLS: tlsCfg,
+			httpTLS: tlsCfg,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			storageCfg := cfg
+			storageCfg.StorageBase.GRPC.TLS = tt.grpcTLS
+			storageCfg.StorageBase.HTTP.TLS = tt.httpTLS
+			r := &runtime{config: &storageCfg}
+			err := r.initClientTLS()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+	assert.Equal(t, "https", httppkg.ClientScheme())
+}
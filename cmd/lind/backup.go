@@ -0,0 +1,334 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/gzip"
+	"github.com/spf13/cobra"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/client"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/archive"
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+const defaultBrokerEndpoint = "http://localhost:9000"
+
+var (
+	brokerEndpoint string
+	backupDatabase string
+	backupOutput   string
+	restoreInput   string
+	restoreStorage string
+)
+
+// newBackupCmd returns a new backup-cmd.
+func newBackupCmd() *cobra.Command {
+	backupCmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Take a consistent snapshot of database into directory or tarball(.tar/.tar.gz/.tgz)",
+		RunE:  backup,
+	}
+	backupCmd.Flags().StringVar(&brokerEndpoint, "broker", defaultBrokerEndpoint, "broker http endpoint")
+	backupCmd.Flags().StringVar(&backupDatabase, "database", "", "database name")
+	backupCmd.Flags().StringVar(&backupOutput, "output", "", "output directory or tarball(.tar/.tar.gz/.tgz)")
+	_ = backupCmd.MarkFlagRequired("database")
+	_ = backupCmd.MarkFlagRequired("output")
+	return backupCmd
+}
+
+// newRestoreCmd returns a new restore-cmd.
+func newRestoreCmd() *cobra.Command {
+	restoreCmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore database from snapshot directory or tarball into storage cluster",
+		RunE:  restore,
+	}
+	restoreCmd.Flags().StringVar(&brokerEndpoint, "broker", defaultBrokerEndpoint, "broker http endpoint")
+	restoreCmd.Flags().StringVar(&restoreInput, "input", "", "input directory or tarball(.tar/.tar.gz/.tgz)")
+	restoreCmd.Flags().StringVar(&restoreStorage, "storage", "",
+		"target storage cluster, default is the storage of backup database")
+	_ = restoreCmd.MarkFlagRequired("input")
+	return restoreCmd
+}
+
+// backup takes a snapshot of database via broker, then saves it into directory or tarball.
+func backup(_ *cobra.Command, _ []string) error {
+	cli := client.NewBackupCli(brokerEndpoint + constants.APIVersion1CliPath)
+	if !isTarball(backupOutput) {
+		if err := os.MkdirAll(backupOutput, os.ModePerm); err != nil {
+			return err
+		}
+		r, w := io.Pipe()
+		go func() {
+			_ = w.CloseWithError(cli.Backup(backupDatabase, w))
+		}()
+		manifest, err := readBackup(r, backupOutput)
+		_ = r.CloseWithError(err)
+		if err != nil {
+			return err
+		}
+		printBackup(manifest, backupOutput)
+		return nil
+	}
+	// write into temp file, rename it after backup archive verified.
+	tmp := backupOutput + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+	}()
+	var (
+		out io.Writer = f
+		gz  *gzip.Writer
+	)
+	if isGzip(backupOutput) {
+		gz = gzip.NewWriter(f)
+		out = gz
+	}
+	r, w := io.Pipe()
+	go func() {
+		_ = w.CloseWithError(cli.Backup(backupDatabase, w))
+	}()
+	tee := io.TeeReader(r, out)
+	manifest, err := readBackup(tee, "")
+	if err == nil {
+		// copy the end of archive
+		_, err = io.Copy(io.Discard, tee)
+	}
+	_ = r.CloseWithError(err)
+	if err != nil {
+		return err
+	}
+	if gz != nil {
+		if err := gz.Close(); err != nil {
+			return err
+		}
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, backupOutput); err != nil {
+		return err
+	}
+	printBackup(manifest, backupOutput)
+	return nil
+}
+
+// restore verifies the snapshot, then restores it into storage cluster via broker:
+// 1. create restore plan(source node => target node)
+// 2. upload the snapshot of each source node into target node
+// 3. commit the plan, rebuild shard assignment/database config.
+func restore(_ *cobra.Command, _ []string) error {
+	dir := restoreInput
+	var (
+		manifest *models.BackupManifest
+		err      error
+	)
+	if isTarball(restoreInput) {
+		dir, err = os.MkdirTemp(filepath.Dir(filepath.Clean(restoreInput)), "restore-")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = os.RemoveAll(dir)
+		}()
+		manifest, err = extractTarball(restoreInput, dir)
+	} else {
+		manifest, err = verifyBackupDir(dir)
+	}
+	if err != nil {
+		return err
+	}
+	cli := client.NewBackupCli(brokerEndpoint + constants.APIVersion1CliPath)
+	plan, err := cli.Plan(restoreStorage, manifest)
+	if err != nil {
+		return err
+	}
+	database := plan.Database.Name
+	for _, node := range manifest.Nodes {
+		target := plan.NodeMapping[node.NodeID]
+		r, w := io.Pipe()
+		go func(node *models.BackupNode) {
+			_ = w.CloseWithError(writeNodeSnapshot(w, filepath.Join(dir, models.BackupNodeDir(node.NodeID)), node))
+		}(node)
+		err = cli.RestoreNode(database, plan.Database.Storage, target, r)
+		_ = r.Close()
+		if err != nil {
+			return fmt.Errorf("restore snapshot of node %d into node %d failure: %w", node.NodeID, target, err)
+		}
+		fmt.Printf("restore snapshot of node %d into node %d successfully\n",
+			node.NodeID, target)
+	}
+	if err := cli.Restore(plan); err != nil {
+		return err
+	}
+	fmt.Printf("restore database %s into storage %s successfully\n",
+		database, plan.Database.Storage)
+	return nil
+}
+
+// readBackup reads the backup archive, extracts files into dir if dir not empty,
+// then verifies files by the manifest which is the last entry of archive.
+func readBackup(r io.Reader, dir string) (*models.BackupManifest, error) {
+	var (
+		manifest *models.BackupManifest
+		files    []archive.Entry
+	)
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Name == models.BackupManifestFile {
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			manifest = &models.BackupManifest{}
+			if err := encoding.JSONUnmarshal(data, manifest); err != nil {
+				return nil, err
+			}
+			if dir != "" {
+				if err := os.WriteFile(filepath.Join(dir, models.BackupManifestFile), data, 0o644); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		var entry *archive.Entry
+		if dir != "" {
+			entry, err = archive.Extract(dir, hdr.Name, tr)
+		} else {
+			entry, err = archive.Sum(hdr.Name, tr)
+		}
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, *entry)
+	}
+	if manifest == nil {
+		return nil, fmt.Errorf("backup manifest not found, backup archive is incomplete")
+	}
+	if err := archive.Verify(manifest.Files(), files); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// extractTarball extracts the backup tarball into dir.
+func extractTarball(path, dir string) (*models.BackupManifest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	var r io.Reader = f
+	if isGzip(path) {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = gz.Close()
+		}()
+		r = gz
+	}
+	return readBackup(r, dir)
+}
+
+// verifyBackupDir reads the manifest of backup directory, then verifies all files.
+func verifyBackupDir(dir string) (*models.BackupManifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, models.BackupManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	manifest := &models.BackupManifest{}
+	if err := encoding.JSONUnmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	expect := manifest.Files()
+	files := make([]archive.Entry, 0, len(expect))
+	for _, file := range expect {
+		entry, err := archive.Checksum(dir, file.Name)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, *entry)
+	}
+	if err := archive.Verify(expect, files); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// writeNodeSnapshot writes the snapshot of storage node as tar archive, the node manifest is the last entry.
+func writeNodeSnapshot(w io.Writer, dir string, node *models.BackupNode) error {
+	tw := tar.NewWriter(w)
+	for _, file := range node.Files {
+		path, err := archive.SafeJoin(dir, file.Name)
+		if err != nil {
+			return err
+		}
+		if _, err := archive.WriteFile(tw, file.Name, path); err != nil {
+			return err
+		}
+	}
+	if err := archive.WriteBytes(tw, models.BackupNodeManifestFile, encoding.JSONMarshal(node)); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
+// printBackup prints the summary of backup.
+func printBackup(manifest *models.BackupManifest, output string) {
+	files := manifest.Files()
+	var size int64
+	for _, file := range files {
+		size += file.Size
+	}
+	fmt.Printf("backup database %s successfully, nodes: %d, files: %d, size: %d bytes, output: %s\n",
+		manifest.Database.Name, len(manifest.Nodes), len(files), size, output)
+}
+
+// isTarball returns if the path is tarball.
+func isTarball(path string) bool {
+	return strings.HasSuffix(path, ".tar") || isGzip(path)
+}
+
+// isGzip returns if the path is gzip compressed tarball.
+func isGzip(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
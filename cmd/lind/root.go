@@ -50,5 +50,7 @@ func init() {
 		newStorageCmd(),
 		newBrokerCmd(),
 		newStandaloneCmd(),
+		newBackupCmd(),
+		newRestoreCmd(),
 	)
 }
@@ -19,7 +19,9 @@ package config
 
 import (
 	"fmt"
+	"path/filepath"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/lindb/lindb/pkg/ltoml"
@@ -31,6 +33,7 @@ type HTTP struct {
 	IdleTimeout  ltoml.Duration `toml:"idle-timeout"`
 	WriteTimeout ltoml.Duration `toml:"write-timeout"`
 	ReadTimeout  ltoml.Duration `toml:"read-timeout"`
+	TLS          TLS            `toml:"tls"`
 }
 
 func (h *HTTP) TOML() string {
@@ -94,11 +97,35 @@ password = "%s"`,
 		u.Password)
 }
 
+// Auth represents authentication/authorization config for broker http api.
+type Auth struct {
+	Enabled  bool           `toml:"enabled"`
+	TokenTTL ltoml.Duration `toml:"token-ttl"`
+	Admin    User           `toml:"admin"`
+}
+
+func (a *Auth) TOML() string {
+	return fmt.Sprintf(`
+## enable authentication/authorization of http api,
+## users and api tokens are managed by lin query language(CREATE USER/GRANT/REVOKE etc.).
+## Default: %v
+enabled = %v
+## how long the token of user login is valid.
+## Default: %s
+token-ttl = "%s"`,
+		a.Enabled,
+		a.Enabled,
+		a.TokenTTL.Duration().String(),
+		a.TokenTTL.Duration().String(),
+	)
+}
+
 // Write represents config for write replication in broker.
 type Write struct {
 	BatchTimeout   ltoml.Duration `toml:"batch-timeout"`
 	BatchBlockSize ltoml.Size     `toml:"batch-block-size"`
 	GCTaskInterval ltoml.Duration `toml:"gc-task-interval"`
+	Spool          WriteSpool     `toml:"spool"`
 }
 
 func (rc *Write) TOML() string {
@@ -122,12 +149,49 @@ gc-task-interval = "%s"`,
 	)
 }
 
+// WriteSpool represents config for durable write spool, which buffers write data on disk
+// when storage leaders are unavailable.
+type WriteSpool struct {
+	Enabled bool           `toml:"enabled"`
+	Dir     string         `toml:"dir"`
+	MaxSize ltoml.Size     `toml:"max-size"`
+	MaxAge  ltoml.Duration `toml:"max-age"`
+}
+
+func (ws *WriteSpool) TOML() string {
+	return fmt.Sprintf(`
+## Whether buffer write data on disk before sending to storage,
+## so that buffered data isn't lost after broker restart.
+## Default: %v
+enabled = %v
+## The directory stores the spooled write data.
+## Default: %s
+dir = "%s"
+## The max size of spooled data for each write family,
+## write request will wait until data is sent if exceeds it.
+## Default: %s
+max-size = "%s"
+## Spooled data which is older than max age will be dropped.
+## Default: %s
+max-age = "%s"`,
+		ws.Enabled,
+		ws.Enabled,
+		strings.ReplaceAll(ws.Dir, "\\", "\\\\"),
+		strings.ReplaceAll(ws.Dir, "\\", "\\\\"),
+		ws.MaxSize.String(),
+		ws.MaxSize.String(),
+		ws.MaxAge.String(),
+		ws.MaxAge.String(),
+	)
+}
+
 // BrokerBase represents a broker configuration
 type BrokerBase struct {
 	HTTP      HTTP      `toml:"http"`
 	Ingestion Ingestion `toml:"ingestion"`
 	Write     Write     `toml:"write"`
 	GRPC      GRPC      `toml:"grpc"`
+	Auth      Auth      `toml:"auth"`
 }
 
 // TOML returns broker's base configuration string as toml format.
@@ -139,18 +203,38 @@ func (bb *BrokerBase) TOML() string {
 ## Controls how HTTP Server are configured.
 [broker.http]%s
 
+## TLS configuration of HTTP Server.
+[broker.http.tls]%s
+
 ## Ingestion configuration for broker handle ingest request.
 [broker.ingestion]%s
 
 ## Write configuration for writing replication block.
 [broker.write]%s
 
+## Durable write spool configuration.
+[broker.write.spool]%s
+
 ## Controls how GRPC Server are configured.
-[broker.grpc]%s`,
+[broker.grpc]%s
+
+## TLS configuration of GRPC Server/Client.
+[broker.grpc.tls]%s
+
+## Authentication/Authorization configuration of HTTP API.
+[broker.auth]%s
+
+## Built-in admin user, which has admin role on all databases.
+[broker.auth.admin]%s`,
 		bb.HTTP.TOML(),
+		bb.HTTP.TLS.TOML(),
 		bb.Ingestion.TOML(),
 		bb.Write.TOML(),
+		bb.Write.Spool.TOML(),
 		bb.GRPC.TOML(),
+		bb.GRPC.TLS.TOML(),
+		bb.Auth.TOML(),
+		bb.Auth.Admin.TOML(),
 	)
 }
 
@@ -161,6 +245,7 @@ func NewDefaultBrokerBase() *BrokerBase {
 			IdleTimeout:  ltoml.Duration(time.Minute * 2),
 			ReadTimeout:  ltoml.Duration(time.Second * 5),
 			WriteTimeout: ltoml.Duration(time.Second * 5),
+			TLS:          TLS{ClientAuth: ClientAuthNone},
 		},
 		Ingestion: Ingestion{
 			MaxConcurrency: runtime.GOMAXPROCS(-1) * 2,
@@ -170,11 +255,24 @@ func NewDefaultBrokerBase() *BrokerBase {
 			BatchTimeout:   ltoml.Duration(time.Second * 2),
 			BatchBlockSize: ltoml.Size(256 * 1024),
 			GCTaskInterval: ltoml.Duration(time.Minute),
+			Spool: WriteSpool{
+				Dir:     filepath.Join(defaultParentDir, "broker", "spool"),
+				MaxSize: ltoml.Size(512 * 1024 * 1024),
+				MaxAge:  ltoml.Duration(time.Hour * 24),
+			},
 		},
 		GRPC: GRPC{
 			Port:                 9001,
 			MaxConcurrentStreams: runtime.GOMAXPROCS(-1) * 20,
 			ConnectTimeout:       ltoml.Duration(time.Second * 3),
+			TLS:                  TLS{ClientAuth: ClientAuthNone},
+		},
+		Auth: Auth{
+			TokenTTL: ltoml.Duration(time.Hour * 24),
+			Admin: User{
+				UserName: "admin",
+				Password: "admin123",
+			},
 		},
 	}
 }
@@ -243,6 +341,16 @@ func checkBrokerBaseCfg(brokerBaseCfg *BrokerBase) error {
 	if brokerBaseCfg.HTTP.IdleTimeout <= 0 {
 		brokerBaseCfg.HTTP.IdleTimeout = defaultBrokerCfg.HTTP.IdleTimeout
 	}
+	if err := brokerBaseCfg.HTTP.TLS.Validate(); err != nil {
+		return err
+	}
+	// auth check
+	if brokerBaseCfg.Auth.TokenTTL <= 0 {
+		brokerBaseCfg.Auth.TokenTTL = defaultBrokerCfg.Auth.TokenTTL
+	}
+	if brokerBaseCfg.Auth.Enabled && (brokerBaseCfg.Auth.Admin.UserName == "" || brokerBaseCfg.Auth.Admin.Password == "") {
+		return fmt.Errorf("admin user cannot be empty when auth enabled")
+	}
 
 	// ingestion
 	if brokerBaseCfg.Ingestion.IngestTimeout <= 0 {
@@ -261,6 +369,15 @@ func checkBrokerBaseCfg(brokerBaseCfg *BrokerBase) error {
 	if brokerBaseCfg.Write.GCTaskInterval <= 0 {
 		brokerBaseCfg.Write.GCTaskInterval = defaultBrokerCfg.Write.GCTaskInterval
 	}
+	if brokerBaseCfg.Write.Spool.Enabled && brokerBaseCfg.Write.Spool.Dir == "" {
+		return fmt.Errorf("write spool dir cannot be empty when spool enabled")
+	}
+	if brokerBaseCfg.Write.Spool.MaxSize <= 0 {
+		brokerBaseCfg.Write.Spool.MaxSize = defaultBrokerCfg.Write.Spool.MaxSize
+	}
+	if brokerBaseCfg.Write.Spool.MaxAge <= 0 {
+		brokerBaseCfg.Write.Spool.MaxAge = defaultBrokerCfg.Write.Spool.MaxAge
+	}
 
 	return nil
 }
@@ -111,6 +111,29 @@ func Test_checkBrokerBaseCfg(t *testing.T) {
 	assert.NotZero(t, brokerCfg3.HTTP.IdleTimeout)
 	assert.NotZero(t, brokerCfg3.HTTP.WriteTimeout)
 	assert.NotZero(t, brokerCfg3.Ingestion.IngestTimeout)
+	assert.NotZero(t, brokerCfg3.Auth.TokenTTL)
+	assert.NotZero(t, brokerCfg3.Write.Spool.MaxSize)
+	assert.NotZero(t, brokerCfg3.Write.Spool.MaxAge)
+
+	// auth admin user failure
+	brokerCfg4 := &BrokerBase{
+		GRPC: GRPC{Port: 2379},
+		HTTP: HTTP{Port: 9000},
+		Auth: Auth{Enabled: true},
+	}
+	assert.Error(t, checkBrokerBaseCfg(brokerCfg4))
+	brokerCfg4.Auth.Admin = User{UserName: "admin", Password: "admin123"}
+	assert.NoError(t, checkBrokerBaseCfg(brokerCfg4))
+
+	// write spool dir failure
+	brokerCfg5 := &BrokerBase{
+		GRPC:  GRPC{Port: 2379},
+		HTTP:  HTTP{Port: 9000},
+		Write: Write{Spool: WriteSpool{Enabled: true}},
+	}
+	assert.Error(t, checkBrokerBaseCfg(brokerCfg5))
+	brokerCfg5.Write.Spool.Dir = "spool"
+	assert.NoError(t, checkBrokerBaseCfg(brokerCfg5))
 }
 
 func Test_checkStorageBaseCfg(t *testing.T) {
@@ -149,6 +172,14 @@ func Test_checkStorageBaseCfg(t *testing.T) {
 	assert.NotZero(t, storageCfg4.TSDB.FlushConcurrency)
 	assert.NotZero(t, storageCfg4.TSDB.MaxSeriesIDsNumber)
 	assert.NotZero(t, storageCfg4.TSDB.MaxTagKeysNumber)
+
+	// tiering dir empty
+	storageCfg5 := &StorageBase{
+		Indicator: 1,
+		GRPC:      GRPC{Port: 2379},
+		TSDB:      TSDB{Dir: "/tmp/lindb", Tiering: Tiering{Backend: "local"}},
+	}
+	assert.Error(t, checkStorageBaseCfg(storageCfg5))
 }
 
 func Test_checkCoordinatorCfg(t *testing.T) {
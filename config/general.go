@@ -123,6 +123,7 @@ type GRPC struct {
 	Port                 uint16         `toml:"port"`
 	MaxConcurrentStreams int            `toml:"max-concurrent-streams"`
 	ConnectTimeout       ltoml.Duration `toml:"connect-timeout"`
+	TLS                  TLS            `toml:"tls"`
 }
 
 func (g *GRPC) TOML() string {
@@ -214,7 +215,7 @@ func checkGRPCCfg(grpcCfg *GRPC) error {
 	if grpcCfg.ConnectTimeout <= 0 {
 		grpcCfg.ConnectTimeout = ltoml.Duration(time.Second * 3)
 	}
-	return nil
+	return grpcCfg.TLS.Validate()
 }
 
 func checkQueryCfg(queryCfg *Query) {
@@ -40,6 +40,7 @@ type TSDB struct {
 	SeriesSequenceCache      uint32         `toml:"series-sequence-cache"`
 	MetaSequenceCache        uint32         `toml:"meta-sequence-cache"`
 	MaxTagKeysNumber         int            `toml:"max-tagKeys"`
+	Tiering                  Tiering        `toml:"tiering"`
 }
 
 func (t *TSDB) TOML() string {
@@ -98,6 +99,62 @@ max-tagKeys = %d`,
 	)
 }
 
+// Tiering represents the cold storage backend config for tiering old segments.
+type Tiering struct {
+	Backend     string         `toml:"backend"` // local/s3, empty means disable tiering
+	Dir         string         `toml:"dir"`     // cold segments dir for local backend, cache dir for s3 backend
+	S3Endpoint  string         `toml:"s3-endpoint"`
+	S3Region    string         `toml:"s3-region"`
+	S3Bucket    string         `toml:"s3-bucket"`
+	S3AccessKey string         `toml:"s3-access-key"`
+	S3SecretKey string         `toml:"s3-secret-key"`
+	S3Timeout   ltoml.Duration `toml:"s3-timeout"` // timeout of each request to object store
+}
+
+// TOML returns Tiering's toml config string.
+func (t *Tiering) TOML() string {
+	return fmt.Sprintf(`
+## Backend of cold storage, old segments move to it based on tiering policy of database.
+## Available backend: local/s3, empty means disable tiering.
+## Default: %s
+backend = "%s"
+## The directory stores cold segments for local backend,
+## or caches the segments restored from object store for s3 backend.
+## Default: %s
+dir = "%s"
+## S3 compatible object store configuration, only for s3 backend.
+## Default: %s
+s3-endpoint = "%s"
+## Default: %s
+s3-region = "%s"
+## Default: %s
+s3-bucket = "%s"
+## Default: %s
+s3-access-key = "%s"
+## Default: %s
+s3-secret-key = "%s"
+## Timeout of each request(upload/download file etc.) to object store.
+## Default: %s
+s3-timeout = "%s"`,
+		t.Backend,
+		t.Backend,
+		strings.ReplaceAll(t.Dir, "\\", "\\\\"),
+		strings.ReplaceAll(t.Dir, "\\", "\\\\"),
+		t.S3Endpoint,
+		t.S3Endpoint,
+		t.S3Region,
+		t.S3Region,
+		t.S3Bucket,
+		t.S3Bucket,
+		t.S3AccessKey,
+		t.S3AccessKey,
+		t.S3SecretKey,
+		t.S3SecretKey,
+		t.S3Timeout.String(),
+		t.S3Timeout.String(),
+	)
+}
+
 // StorageBase represents a storage configuration
 type StorageBase struct {
 	Indicator       int            `toml:"indicator"`       // Indicator is unique id under current storage cluster.
@@ -128,14 +185,23 @@ broker-endpoint = "%s"
 ## Storage HTTP related configuration.
 [storage.http]%s
 
+## TLS configuration of Storage HTTP Server.
+[storage.http.tls]%s
+
 ## Storage GRPC related configuration.
 [storage.grpc]%s
 
+## TLS configuration of Storage GRPC Server/Client.
+[storage.grpc.tls]%s
+
 ## Write Ahead Log related configuration.
 [storage.wal]%s
 
 ## TSDB related configuration.
-[storage.tsdb]%s`,
+[storage.tsdb]%s
+
+## Tiering storage related configuration.
+[storage.tsdb.tiering]%s`,
 		s.Indicator,
 		s.Indicator,
 		s.TTLTaskInterval,
@@ -143,9 +209,12 @@ broker-endpoint = "%s"
 		s.BrokerEndpoint,
 		s.BrokerEndpoint,
 		s.HTTP.TOML(),
+		s.HTTP.TLS.TOML(),
 		s.GRPC.TOML(),
+		s.GRPC.TLS.TOML(),
 		s.WAL.TOML(),
 		s.TSDB.TOML(),
+		s.TSDB.Tiering.TOML(),
 	)
 }
 
@@ -225,11 +294,13 @@ func NewDefaultStorageBase() *StorageBase {
 			IdleTimeout:  ltoml.Duration(time.Minute * 2),
 			ReadTimeout:  ltoml.Duration(time.Second * 5),
 			WriteTimeout: ltoml.Duration(time.Second * 5),
+			TLS:          TLS{ClientAuth: ClientAuthNone},
 		},
 		GRPC: GRPC{
 			Port:                 2891,
 			MaxConcurrentStreams: runtime.GOMAXPROCS(-1) * 40,
 			ConnectTimeout:       ltoml.Duration(time.Second * 3),
+			TLS:                  TLS{ClientAuth: ClientAuthNone},
 		},
 		WAL: WAL{
 			Dir:                filepath.Join(defaultParentDir, "storage", "wal"),
@@ -247,6 +318,10 @@ func NewDefaultStorageBase() *StorageBase {
 			SeriesSequenceCache:      1000,
 			MetaSequenceCache:        100,
 			MaxTagKeysNumber:         32,
+			Tiering: Tiering{
+				Dir:       filepath.Join(defaultParentDir, "storage", "cold"),
+				S3Timeout: ltoml.Duration(5 * time.Minute),
+			},
 		},
 	}
 }
@@ -301,6 +376,9 @@ func checkTSDBCfg(tsdbCfg *TSDB) error {
 	if tsdbCfg.MaxTagKeysNumber <= 0 {
 		tsdbCfg.MaxTagKeysNumber = defaultStorageCfg.TSDB.MaxTagKeysNumber
 	}
+	if tsdbCfg.Tiering.Backend != "" && tsdbCfg.Tiering.Dir == "" {
+		return fmt.Errorf("tiering dir cannot be empty")
+	}
 	return nil
 }
 
@@ -312,6 +390,9 @@ func checkStorageBaseCfg(storageBaseCfg *StorageBase) error {
 	if err := checkGRPCCfg(&storageBaseCfg.GRPC); err != nil {
 		return err
 	}
+	if err := storageBaseCfg.HTTP.TLS.Validate(); err != nil {
+		return err
+	}
 	defaultStorageCfg := NewDefaultStorageBase()
 	if storageBaseCfg.TTLTaskInterval <= 0 {
 		storageBaseCfg.TTLTaskInterval = defaultStorageCfg.TTLTaskInterval
@@ -0,0 +1,116 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+)
+
+// Defines all client auth modes of tls server.
+const (
+	ClientAuthNone             = "none"
+	ClientAuthRequest          = "request"
+	ClientAuthRequire          = "require"
+	ClientAuthVerifyIfGiven    = "verify-if-given"
+	ClientAuthRequireAndVerify = "require-and-verify"
+)
+
+var clientAuthTypes = map[string]tls.ClientAuthType{
+	"":                         tls.NoClientCert,
+	ClientAuthNone:             tls.NoClientCert,
+	ClientAuthRequest:          tls.RequestClientCert,
+	ClientAuthRequire:          tls.RequireAnyClientCert,
+	ClientAuthVerifyIfGiven:    tls.VerifyClientCertIfGiven,
+	ClientAuthRequireAndVerify: tls.RequireAndVerifyClientCert,
+}
+
+// TLS represents the tls/mutual-tls configuration of HTTP/GRPC server,
+// the cert is also used as client cert when current node connects other nodes.
+type TLS struct {
+	Enabled    bool   `toml:"enabled"`
+	CertFile   string `toml:"cert-file"`
+	KeyFile    string `toml:"key-file"`
+	CAFile     string `toml:"ca-file"`     // verify peer's cert, using system roots if empty
+	ClientAuth string `toml:"client-auth"` // none/request/require/verify-if-given/require-and-verify
+	ServerName string `toml:"server-name"` // overrides the server name which client verifies, using host of target if empty
+}
+
+// GetClientAuth returns the client auth type of tls server.
+func (t *TLS) GetClientAuth() tls.ClientAuthType {
+	return clientAuthTypes[strings.ToLower(t.ClientAuth)]
+}
+
+// Validate checks if tls configuration is valid when tls enabled.
+func (t *TLS) Validate() error {
+	if !t.Enabled {
+		return nil
+	}
+	if t.CertFile == "" || t.KeyFile == "" {
+		return fmt.Errorf("cert file and key file are required when tls enabled")
+	}
+	clientAuth, ok := clientAuthTypes[strings.ToLower(t.ClientAuth)]
+	if !ok {
+		return fmt.Errorf("unknown tls client auth: %s", t.ClientAuth)
+	}
+	if (clientAuth == tls.VerifyClientCertIfGiven || clientAuth == tls.RequireAndVerifyClientCert) && t.CAFile == "" {
+		return fmt.Errorf("ca file is required when verifying client cert")
+	}
+	if _, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile); err != nil {
+		return fmt.Errorf("load tls cert/key failure: %w", err)
+	}
+	return nil
+}
+
+// TOML returns TLS's toml config string.
+func (t *TLS) TOML() string {
+	return fmt.Sprintf(`
+## enable tls, cert/key/ca files are reloaded without restarting when changed.
+## Default: %v
+enabled = %v
+## PEM encoded cert file, also used as client cert when connecting other nodes.
+## Default: %s
+cert-file = "%s"
+## PEM encoded private key file.
+## Default: %s
+key-file = "%s"
+## PEM encoded CA file which verifies the cert of peer, using system roots if empty.
+## Default: %s
+ca-file = "%s"
+## client auth mode of server: none/request/require/verify-if-given/require-and-verify,
+## require-and-verify enables mutual-tls.
+## Default: %s
+client-auth = "%s"
+## overrides the server name which client verifies, using host of target node if empty.
+## Default: %s
+server-name = "%s"`,
+		t.Enabled,
+		t.Enabled,
+		t.CertFile,
+		t.CertFile,
+		t.KeyFile,
+		t.KeyFile,
+		t.CAFile,
+		t.CAFile,
+		t.ClientAuth,
+		t.ClientAuth,
+		t.ServerName,
+		t.ServerName,
+	)
+}
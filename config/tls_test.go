@@ -0,0 +1,87 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/internal/mock"
+)
+
+func TestTLS_Validate(t *testing.T) {
+	files := mock.GenerateTLSFiles(t, t.TempDir())
+	cases := []struct {
+		name    string
+		cfg     TLS
+		wantErr bool
+	}{
+		{
+			name: "tls disabled",
+			cfg:  TLS{CertFile: "not-exist"},
+		},
+		{
+			name:    "cert file empty",
+			cfg:     TLS{Enabled: true, KeyFile: files.KeyFile},
+			wantErr: true,
+		},
+		{
+			name:    "unknown client auth",
+			cfg:     TLS{Enabled: true, CertFile: files.CertFile, KeyFile: files.KeyFile, ClientAuth: "abc"},
+			wantErr: true,
+		},
+		{
+			name: "ca file empty when verifying client cert",
+			cfg: TLS{Enabled: true, CertFile: files.CertFile, KeyFile: files.KeyFile,
+				ClientAuth: ClientAuthRequireAndVerify},
+			wantErr: true,
+		},
+		{
+			name:    "load cert failure",
+			cfg:     TLS{Enabled: true, CertFile: files.CAFile, KeyFile: files.KeyFile},
+			wantErr: true,
+		},
+		{
+			name: "mutual tls",
+			cfg: TLS{Enabled: true, CertFile: files.CertFile, KeyFile: files.KeyFile, CAFile: files.CAFile,
+				ClientAuth: "Require-And-Verify"},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTLS_GetClientAuth(t *testing.T) {
+	assert.Equal(t, tls.NoClientCert, (&TLS{}).GetClientAuth())
+	assert.Equal(t, tls.RequestClientCert, (&TLS{ClientAuth: ClientAuthRequest}).GetClientAuth())
+	assert.Equal(t, tls.RequireAnyClientCert, (&TLS{ClientAuth: ClientAuthRequire}).GetClientAuth())
+	assert.Equal(t, tls.VerifyClientCertIfGiven, (&TLS{ClientAuth: ClientAuthVerifyIfGiven}).GetClientAuth())
+	assert.Equal(t, tls.RequireAndVerifyClientCert, (&TLS{ClientAuth: ClientAuthRequireAndVerify}).GetClientAuth())
+	assert.NotEmpty(t, (&TLS{}).TOML())
+}
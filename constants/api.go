@@ -30,4 +30,10 @@ const (
 	ContentTypeProto = "application/protobuf"
 	// ContentTypeInflux represents influx content type.
 	ContentTypeInflux = "application/influx"
+	// ContentTypeXProto represents proto buffer content type used by OpenTelemetry/Prometheus.
+	ContentTypeXProto = "application/x-protobuf"
+	// ContentTypeJSON represents json content type.
+	ContentTypeJSON = "application/json"
+	// InternalAuthorizationHeader represents the header of authorization token for internal api between nodes.
+	InternalAuthorizationHeader = "X-Lindb-Internal-Authorization"
 )
@@ -39,6 +39,7 @@ const (
 	ShardAssignment = "ShardAssignment"
 	Master          = "Master"
 	StorageConfig   = "StorageConfig"
+	RebalanceJob    = "RebalanceJob"
 )
 
 // defines common constants will be used in broker and storage.
@@ -61,6 +62,19 @@ const (
 	StorageConfigPath = "/storage/config"
 	// StorageStatePath represents storage cluster's state.
 	StorageStatePath = "/storage/state"
+	// RebalanceJobPath represents database's shard replica rebalance job.
+	RebalanceJobPath = "/database/rebalance"
+	// ShardMigrationPath represents shard which is migrating data to new replica(in storage state repo).
+	ShardMigrationPath = "/database/migration"
+	// ContinuousQueryPath represents continuous query definition path.
+	ContinuousQueryPath = "/continuous-query/config"
+	// ContinuousQueryStatePath represents continuous query's execution state(watermark etc.).
+	ContinuousQueryStatePath = "/continuous-query/state"
+	// UserPath represents user account path, includes human user and api token of ingestion agent.
+	UserPath = "/auth/user"
+	// InternalSecretPath represents the secret of cluster which signs the token of internal api between nodes,
+	// created in broker state repo, synced into storage state repo by master.
+	InternalSecretPath = "/auth/internal-secret"
 )
 
 // GetStorageClusterConfigPath returns path which storing config of storage cluster
@@ -82,6 +96,31 @@ func GetDatabaseAssignPath(name string) string {
 	return fmt.Sprintf("%s/%s", ShardAssignmentPath, name)
 }
 
+// GetRebalanceJobPath returns path which storing rebalance job of database
+func GetRebalanceJobPath(name string) string {
+	return fmt.Sprintf("%s/%s", RebalanceJobPath, name)
+}
+
+// GetContinuousQueryPath returns path which storing definition of continuous query
+func GetContinuousQueryPath(name string) string {
+	return fmt.Sprintf("%s/%s", ContinuousQueryPath, name)
+}
+
+// GetContinuousQueryStatePath returns path which storing execution state of continuous query
+func GetContinuousQueryStatePath(name string) string {
+	return fmt.Sprintf("%s/%s", ContinuousQueryStatePath, name)
+}
+
+// GetUserPath returns path which storing user account
+func GetUserPath(name string) string {
+	return fmt.Sprintf("%s/%s", UserPath, name)
+}
+
+// GetShardMigrationPath returns path which storing migration of database's shard
+func GetShardMigrationPath(name string, shardID int32) string {
+	return fmt.Sprintf("%s/%s/%d", ShardMigrationPath, name, shardID)
+}
+
 // GetLiveNodePath returns live node register path.
 func GetLiveNodePath(node string) string {
 	return fmt.Sprintf("%s/%s", LiveNodesPath, node)
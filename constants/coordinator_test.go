@@ -42,3 +42,20 @@ func TestGetStorageClusterConfigPath(t *testing.T) {
 func TestGetStorageStatePath(t *testing.T) {
 	assert.Equal(t, StorageStatePath+"/name", GetStorageStatePath("name"))
 }
+
+func TestGetRebalanceJobPath(t *testing.T) {
+	assert.Equal(t, RebalanceJobPath+"/name", GetRebalanceJobPath("name"))
+}
+
+func TestGetContinuousQueryPath(t *testing.T) {
+	assert.Equal(t, ContinuousQueryPath+"/name", GetContinuousQueryPath("name"))
+	assert.Equal(t, ContinuousQueryStatePath+"/name", GetContinuousQueryStatePath("name"))
+}
+
+func TestGetUserPath(t *testing.T) {
+	assert.Equal(t, UserPath+"/name", GetUserPath("name"))
+}
+
+func TestGetShardMigrationPath(t *testing.T) {
+	assert.Equal(t, ShardMigrationPath+"/name/1", GetShardMigrationPath("name", 1))
+}
@@ -60,6 +60,18 @@ var (
 	ErrDatabaseNameRequired = errors.New("database name cannot be empty")
 	// ErrStorageNameRequired represents storage name not input.
 	ErrStorageNameRequired = errors.New("storage name cannot be empty")
+	// ErrReduceShardsNotSupported represents reducing the number of shards is not supported.
+	ErrReduceShardsNotSupported = errors.New("reduce the number of shards is not supported")
+	// ErrRebalanceJobRunning represents database already has a running rebalance job.
+	ErrRebalanceJobRunning = errors.New("database has a running rebalance job")
+	// ErrStaleLeaderEpoch represents the leader epoch of write/replica request is stale(leader changed).
+	ErrStaleLeaderEpoch = errors.New("stale leader epoch, shard leader changed")
+	// ErrSeriesQuotaExceeded represents new series rejected because series quota exceeded.
+	ErrSeriesQuotaExceeded = errors.New("series quota exceeded")
+	// ErrPermissionDenied represents user has no privilege to access the resource.
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrServiceBusy represents server cannot handle request temporarily, client should retry later.
+	ErrServiceBusy = errors.New("service busy, retry later")
 
 	// ErrEmptySelectList represents empty select list.
 	ErrEmptySelectList = errors.New("select item list is empty")
@@ -204,6 +204,15 @@ func (m *stateManager) onDatabaseCfgChange(key string, data []byte) error {
 	}
 
 	m.databases[cfg.Name] = cfg
+
+	// notify shard state change with new database config, if database option altered
+	if storageState, ok := m.storages[cfg.Storage]; ok {
+		if shards, ok := storageState.ShardStates[cfg.Name]; ok {
+			for _, fn := range m.callbacks {
+				fn(cfg, shards, storageState.LiveNodes)
+			}
+		}
+	}
 	return nil
 }
 
@@ -295,6 +295,16 @@ func TestStateManager_ShardState(t *testing.T) {
 	assert.Len(t, replicas, 1)
 
 	assert.True(t, c > 0)
+
+	// database config altered, notify shard state change with new config
+	c = 0
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.DatabaseConfigChanged,
+		Key:   "/database/db",
+		Value: []byte(`{"name":"db","storage":"test"}`),
+	})
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 1, c)
 }
 
 func TestStateManager_GetLiveNodes(t *testing.T) {
@@ -31,6 +31,7 @@ const (
 	StorageStateDeletion
 	StorageConfigChanged
 	StorageConfigDeletion
+	RebalanceJobChanged
 )
 
 // String returns string value of EventType.
@@ -56,6 +57,8 @@ func (e EventType) String() string {
 		return "StorageConfigChanged"
 	case StorageConfigDeletion:
 		return "StorageConfigDeletion"
+	case RebalanceJobChanged:
+		return "RebalanceJobChanged"
 	default:
 		return "unknown"
 	}
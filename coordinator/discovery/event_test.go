@@ -35,4 +35,5 @@ func TestEventType_String(t *testing.T) {
 	assert.Equal(t, "ShardAssignmentChanged", ShardAssignmentChanged.String())
 	assert.Equal(t, "StorageConfigDeletion", StorageConfigDeletion.String())
 	assert.Equal(t, "StorageConfigChanged", StorageConfigChanged.String())
+	assert.Equal(t, "RebalanceJobChanged", RebalanceJobChanged.String())
 }
@@ -42,6 +42,7 @@ const (
 	StorageStatusStateMachine
 	StorageConfigStateMachine
 	StorageNodeStateMachine
+	RebalanceJobStateMachine
 )
 
 // String returns state machine type desc.
@@ -59,6 +60,8 @@ func (st StateMachineType) String() string {
 		return "StorageConfigStateMachine"
 	case StorageNodeStateMachine:
 		return "StorageNodeStateMachine"
+	case RebalanceJobStateMachine:
+		return "RebalanceJobStateMachine"
 	default:
 		return "Unknown"
 	}
@@ -33,6 +33,7 @@ func TestStateMachineType_String(t *testing.T) {
 	assert.Equal(t, StorageStatusStateMachine.String(), "StorageStatusStateMachine")
 	assert.Equal(t, StorageConfigStateMachine.String(), "StorageConfigStateMachine")
 	assert.Equal(t, StorageNodeStateMachine.String(), "StorageNodeStateMachine")
+	assert.Equal(t, RebalanceJobStateMachine.String(), "RebalanceJobStateMachine")
 	assert.Equal(t, (StateMachineType(0)).String(), "Unknown")
 }
 
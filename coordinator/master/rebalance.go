@@ -0,0 +1,550 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	statepkg "github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// for testing
+var (
+	catchUpCheckInterval = 5 * time.Second
+	catchUpTimeout       = 30 * time.Minute
+)
+
+var errCopyDataInterrupted = errors.New("copy shard data is interrupted, need submit rebalance job again")
+
+// PlanRebalance plans the shard replica moves which make replicas balanced between live storage nodes,
+// moves a replica from the node holding the most replicas to the node holding the fewest replicas each time,
+// each shard moves one replica at most in a rebalance job.
+func PlanRebalance(shardAssign *models.ShardAssignment, liveNodes []models.NodeID) (moves []models.ShardMove) {
+	if len(liveNodes) < 2 {
+		return nil
+	}
+	nodes := make([]models.NodeID, len(liveNodes))
+	copy(nodes, liveNodes)
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i] < nodes[j]
+	})
+	counts := make(map[models.NodeID]int)
+	for _, nodeID := range nodes {
+		counts[nodeID] = 0
+	}
+	var shardIDs []models.ShardID
+	for shardID, replica := range shardAssign.Shards {
+		shardIDs = append(shardIDs, shardID)
+		for _, nodeID := range replica.Replicas {
+			if _, ok := counts[nodeID]; ok {
+				counts[nodeID]++
+			}
+		}
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+	moved := make(map[models.ShardID]struct{})
+	for {
+		from, to := nodes[0], nodes[0]
+		for _, nodeID := range nodes {
+			if counts[nodeID] > counts[from] {
+				from = nodeID
+			}
+			if counts[nodeID] < counts[to] {
+				to = nodeID
+			}
+		}
+		if counts[from]-counts[to] <= 1 {
+			return moves
+		}
+		found := false
+		for _, shardID := range shardIDs {
+			if _, ok := moved[shardID]; ok {
+				continue
+			}
+			replica := shardAssign.Shards[shardID]
+			if replica.Contain(from) && !replica.Contain(to) {
+				moves = append(moves, models.ShardMove{
+					ShardID: shardID,
+					From:    from,
+					To:      to,
+					State:   models.RebalancePending,
+				})
+				moved[shardID] = struct{}{}
+				counts[from]--
+				counts[to]++
+				found = true
+				break
+			}
+		}
+		if !found {
+			return moves
+		}
+	}
+}
+
+// rebalancer executes the rebalance job of database, moves shard replicas between storage nodes.
+// each shard move executes the steps as below:
+// 1. copy data families of shard from leader to target node(target node keeps migration marker until replica added);
+// 2. add target node into shard's replicas, then wal replicator of leader replicates the new writes to it;
+// 3. wait target node catches up the wal of leader;
+// 4. switch leadership from source node to target node, if source node is the leader;
+// 5. remove source node from shard's replicas, source node drops the shard data later.
+type rebalancer struct {
+	ctx        context.Context
+	masterRepo statepkg.Repository
+	stateMgr   StateManager
+	migrator   ShardMigrator
+
+	running map[string]struct{}
+	mutex   sync.Mutex
+
+	logger *logger.Logger
+}
+
+// newRebalancer creates a shard replica rebalancer.
+func newRebalancer(ctx context.Context,
+	masterRepo statepkg.Repository,
+	stateMgr StateManager,
+	migrator ShardMigrator,
+) *rebalancer {
+	return &rebalancer{
+		ctx:        ctx,
+		masterRepo: masterRepo,
+		stateMgr:   stateMgr,
+		migrator:   migrator,
+		running:    make(map[string]struct{}),
+		logger:     logger.GetLogger("Master", "Rebalancer"),
+	}
+}
+
+// Submit submits the rebalance job, executes it in background if the database hasn't running job.
+// NOTE: latest job will be loaded from repo when executing, because the job maybe changed by previous execution.
+func (r *rebalancer) Submit(job *models.RebalanceJob) {
+	if job.State.IsTerminal() {
+		return
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.running[job.Database]; ok {
+		return
+	}
+	r.running[job.Database] = struct{}{}
+	go r.execute(job.Database)
+}
+
+// IsRunning checks if the database has running rebalance job.
+func (r *rebalancer) IsRunning(databaseName string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	_, ok := r.running[databaseName]
+	return ok
+}
+
+// execute executes the rebalance job of database.
+func (r *rebalancer) execute(databaseName string) {
+	defer func() {
+		r.mutex.Lock()
+		delete(r.running, databaseName)
+		r.mutex.Unlock()
+	}()
+
+	job, err := r.getJob(databaseName)
+	if err != nil {
+		r.logger.Error("get rebalance job failure", logger.String("database", databaseName), logger.Error(err))
+		return
+	}
+	if job.State.IsTerminal() {
+		return
+	}
+	r.logger.Info("start executing rebalance job",
+		logger.String("database", databaseName), logger.String("state", job.State.String()))
+	if err := r.run(job); err != nil {
+		if r.ctx.Err() != nil {
+			// master is stopped, new master will resume the job
+			r.logger.Warn("master is stopped, rebalance job will be resumed by new master",
+				logger.String("database", databaseName))
+			return
+		}
+		r.logger.Error("execute rebalance job failure", logger.String("database", databaseName), logger.Error(err))
+		job.State = models.RebalanceFailed
+		job.ErrMsg = err.Error()
+	} else {
+		job.State = models.RebalanceDone
+		r.logger.Info("execute rebalance job successfully", logger.String("database", databaseName))
+	}
+	if err := r.saveJob(job); err != nil {
+		r.logger.Error("save rebalance job failure", logger.String("database", databaseName), logger.Error(err))
+	}
+}
+
+// run executes all shard moves of rebalance job.
+func (r *rebalancer) run(job *models.RebalanceJob) error {
+	db, err := r.getDatabase(job.Database)
+	if err != nil {
+		return err
+	}
+	cluster := r.stateMgr.GetStorageCluster(db.Storage)
+	if cluster == nil {
+		return fmt.Errorf("storage cluster %s %w", db.Storage, constants.ErrNotFound)
+	}
+	if job.State == models.RebalancePending && len(job.Moves) == 0 {
+		shardAssign, err := r.getShardAssign(db.Name)
+		if err != nil {
+			return err
+		}
+		liveNodes, err := cluster.GetLiveNodes()
+		if err != nil {
+			return err
+		}
+		var nodeIDs []models.NodeID
+		for idx := range liveNodes {
+			nodeIDs = append(nodeIDs, liveNodes[idx].ID)
+		}
+		job.Moves = PlanRebalance(shardAssign, nodeIDs)
+		r.logger.Info("plan rebalance job",
+			logger.String("database", db.Name), logger.Any("moves", job.Moves))
+	}
+	for idx := range job.Moves {
+		move := &job.Moves[idx]
+		if err := r.executeMove(job, db, cluster, move); err != nil {
+			move.State = models.RebalanceFailed
+			return fmt.Errorf("move replica of shard %d from %d to %d failure: %w", move.ShardID, move.From, move.To, err)
+		}
+	}
+	return nil
+}
+
+// executeMove executes the shard move step by step, saves the state after each step.
+func (r *rebalancer) executeMove(job *models.RebalanceJob, db *models.Database,
+	cluster StorageCluster, move *models.ShardMove,
+) error {
+	for !move.State.IsTerminal() {
+		var err error
+		next := move.State
+		switch move.State {
+		case models.RebalancePending:
+			if err = r.updateState(job, move, models.RebalanceCopyingData); err != nil {
+				return err
+			}
+			err = r.copyData(db, cluster, move)
+			if err != nil {
+				r.removeMigration(db, cluster, move)
+			}
+			next = models.RebalanceAddingReplica
+		case models.RebalanceCopyingData:
+			// master failover when copying data, the data of target node maybe incomplete
+			r.removeMigration(db, cluster, move)
+			err = errCopyDataInterrupted
+		case models.RebalanceAddingReplica:
+			err = r.modifyReplicas(db, cluster, move.ShardID, func(replicas []models.NodeID) []models.NodeID {
+				for _, nodeID := range replicas {
+					if nodeID == move.To {
+						return replicas
+					}
+				}
+				return append(replicas, move.To)
+			})
+			if err == nil {
+				r.removeMigration(db, cluster, move)
+			}
+			next = models.RebalanceCatchingUp
+		case models.RebalanceCatchingUp:
+			err = r.waitCatchUp(db, cluster, move)
+			next = models.RebalanceSwitchingLeader
+		case models.RebalanceSwitchingLeader:
+			// target node must acknowledge the append index of leader before cut-over,
+			// if not caught up, go back to catch up.
+			caughtUp, err0 := r.isCaughtUp(db, cluster, move)
+			if err0 != nil || !caughtUp {
+				r.logger.Warn("target node doesn't catch up before switching leader, wait it catch up",
+					logger.String("database", db.Name), logger.Any("shardID", move.ShardID), logger.Error(err0))
+				next = models.RebalanceCatchingUp
+				break
+			}
+			// put target node at the position of source node, leader is the first live replica
+			err = r.modifyReplicas(db, cluster, move.ShardID, func(replicas []models.NodeID) []models.NodeID {
+				var rs []models.NodeID
+				for _, nodeID := range replicas {
+					switch nodeID {
+					case move.From:
+						rs = append(rs, move.To)
+					case move.To:
+					default:
+						rs = append(rs, nodeID)
+					}
+				}
+				return append(rs, move.From)
+			})
+			next = models.RebalanceRemovingReplica
+		case models.RebalanceRemovingReplica:
+			err = r.modifyReplicas(db, cluster, move.ShardID, func(replicas []models.NodeID) []models.NodeID {
+				var rs []models.NodeID
+				for _, nodeID := range replicas {
+					if nodeID != move.From {
+						rs = append(rs, nodeID)
+					}
+				}
+				return rs
+			})
+			next = models.RebalanceDone
+		default:
+			err = fmt.Errorf("unknown shard move state: %s", move.State)
+		}
+		if err != nil {
+			return err
+		}
+		if err := r.updateState(job, move, next); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyData copies all data families of shard from leader to target node.
+func (r *rebalancer) copyData(db *models.Database, cluster StorageCluster, move *models.ShardMove) error {
+	shardAssign, err := r.getShardAssign(db.Name)
+	if err != nil {
+		return err
+	}
+	replica, ok := shardAssign.Shards[move.ShardID]
+	if !ok {
+		return constants.ErrShardNotFound
+	}
+	if !replica.Contain(move.From) {
+		return fmt.Errorf("source node %d isn't the replica of shard", move.From)
+	}
+	if replica.Contain(move.To) {
+		return fmt.Errorf("target node %d is already the replica of shard", move.To)
+	}
+	liveNodes, err := r.getLiveNodes(cluster)
+	if err != nil {
+		return err
+	}
+	target, ok := liveNodes[move.To]
+	if !ok {
+		return fmt.Errorf("target node %d isn't alive", move.To)
+	}
+	leader, err := r.getLeader(db.Name, liveNodes, move.ShardID)
+	if err != nil {
+		return err
+	}
+	source := liveNodes[leader]
+	// mark shard is migrating, target node cannot drop it before replica added
+	if err := cluster.GetRepo().Put(r.ctx,
+		constants.GetShardMigrationPath(db.Name, int32(move.ShardID)), encoding.JSONMarshal(move)); err != nil {
+		return err
+	}
+	r.logger.Info("start copying shard data",
+		logger.String("database", db.Name), logger.Any("shardID", move.ShardID),
+		logger.String("source", source.Indicator()), logger.String("target", target.Indicator()))
+	return r.migrator.Migrate(&source, &target, &models.ShardMigration{
+		Database: db.Name,
+		ShardID:  move.ShardID,
+		Option:   db.Option,
+	})
+}
+
+// getLeader returns the current live leader of shard.
+func (r *rebalancer) getLeader(databaseName string,
+	liveNodes map[models.NodeID]models.StatefulNode,
+	shardID models.ShardID,
+) (models.NodeID, error) {
+	shardState, ok := r.stateMgr.GetShardState(databaseName, shardID)
+	if !ok {
+		return models.NoLeader, constants.ErrShardNotFound
+	}
+	if _, alive := liveNodes[shardState.Leader]; !alive {
+		return models.NoLeader, constants.ErrNoLiveReplica
+	}
+	return shardState.Leader, nil
+}
+
+// waitCatchUp waits target node catches up the wal of shard's leader.
+func (r *rebalancer) waitCatchUp(db *models.Database, cluster StorageCluster, move *models.ShardMove) error {
+	ctx, cancel := context.WithTimeout(r.ctx, catchUpTimeout)
+	defer cancel()
+	ticker := time.NewTicker(catchUpCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		caughtUp, err := r.isCaughtUp(db, cluster, move)
+		if err != nil {
+			r.logger.Warn("check if target node catches up failure",
+				logger.String("database", db.Name), logger.Any("shardID", move.ShardID), logger.Error(err))
+		} else if caughtUp {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			if r.ctx.Err() != nil {
+				return r.ctx.Err()
+			}
+			return fmt.Errorf("wait target node %d catches up timeout", move.To)
+		case <-ticker.C:
+		}
+	}
+}
+
+// isCaughtUp checks if all wal replicators from leader to target node have acknowledged the append index of leader,
+// returns false if no replicator from leader to target node(fail closed).
+func (r *rebalancer) isCaughtUp(db *models.Database, cluster StorageCluster, move *models.ShardMove) (bool, error) {
+	liveNodes, err := r.getLiveNodes(cluster)
+	if err != nil {
+		return false, err
+	}
+	leader, err := r.getLeader(db.Name, liveNodes, move.ShardID)
+	if err != nil {
+		return false, err
+	}
+	if leader == move.To {
+		return true, nil
+	}
+	leaderNode := liveNodes[leader]
+	states, err := r.migrator.GetReplicaState(&leaderNode, db.Name)
+	if err != nil {
+		return false, err
+	}
+	tracked := false
+	for _, state := range states {
+		if state.ShardID != move.ShardID || state.Leader != leader {
+			continue
+		}
+		caughtUp := false
+		for _, peer := range state.Replicators {
+			if models.ParseNodeID(peer.Replicator) == move.To && peer.Pending == 0 && peer.ACK >= state.Append {
+				caughtUp = true
+				break
+			}
+		}
+		if !caughtUp {
+			return false, nil
+		}
+		tracked = true
+	}
+	return tracked, nil
+}
+
+// modifyReplicas modifies the replicas of shard, then saves shard assignment.
+func (r *rebalancer) modifyReplicas(db *models.Database, cluster StorageCluster,
+	shardID models.ShardID, fn func(replicas []models.NodeID) []models.NodeID,
+) error {
+	shardAssign, err := r.getShardAssign(db.Name)
+	if err != nil {
+		return err
+	}
+	replica, ok := shardAssign.Shards[shardID]
+	if !ok {
+		return constants.ErrShardNotFound
+	}
+	replica.Replicas = fn(replica.Replicas)
+	r.logger.Info("modify replicas of shard",
+		logger.String("database", db.Name), logger.Any("shardID", shardID), logger.Any("replicas", replica.Replicas))
+	if err := r.masterRepo.Put(r.ctx, constants.GetDatabaseAssignPath(db.Name), encoding.JSONMarshal(shardAssign)); err != nil {
+		return err
+	}
+	// save shard assignment into related storage repo.
+	return cluster.SaveDatabaseAssignment(shardAssign, db.Option)
+}
+
+// removeMigration removes the migration marker of shard.
+func (r *rebalancer) removeMigration(db *models.Database, cluster StorageCluster, move *models.ShardMove) {
+	if err := cluster.GetRepo().Delete(r.ctx, constants.GetShardMigrationPath(db.Name, int32(move.ShardID))); err != nil {
+		r.logger.Warn("remove shard migration failure",
+			logger.String("database", db.Name), logger.Any("shardID", move.ShardID), logger.Error(err))
+	}
+}
+
+// updateState updates the state of shard move/job, then saves job.
+func (r *rebalancer) updateState(job *models.RebalanceJob, move *models.ShardMove, state models.RebalanceState) error {
+	move.State = state
+	if state != models.RebalanceDone {
+		// job's state is the state of executing move, job is done after all moves completed
+		job.State = state
+	}
+	return r.saveJob(job)
+}
+
+// saveJob saves rebalance job into master repo.
+func (r *rebalancer) saveJob(job *models.RebalanceJob) error {
+	job.UpdateTime = timeutil.Now()
+	return r.masterRepo.Put(r.ctx, constants.GetRebalanceJobPath(job.Database), encoding.JSONMarshal(job))
+}
+
+// getJob returns rebalance job of database from master repo.
+func (r *rebalancer) getJob(databaseName string) (*models.RebalanceJob, error) {
+	data, err := r.masterRepo.Get(r.ctx, constants.GetRebalanceJobPath(databaseName))
+	if err != nil {
+		return nil, err
+	}
+	job := &models.RebalanceJob{}
+	if err := encoding.JSONUnmarshal(data, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// getShardAssign returns shard assignment of database from master repo.
+func (r *rebalancer) getShardAssign(databaseName string) (*models.ShardAssignment, error) {
+	data, err := r.masterRepo.Get(r.ctx, constants.GetDatabaseAssignPath(databaseName))
+	if err != nil {
+		return nil, err
+	}
+	shardAssign := &models.ShardAssignment{}
+	if err := encoding.JSONUnmarshal(data, shardAssign); err != nil {
+		return nil, err
+	}
+	return shardAssign, nil
+}
+
+// getDatabase returns database config by name.
+func (r *rebalancer) getDatabase(databaseName string) (*models.Database, error) {
+	dbs := r.stateMgr.GetDatabases()
+	for idx := range dbs {
+		if dbs[idx].Name == databaseName {
+			return &dbs[idx], nil
+		}
+	}
+	return nil, constants.ErrDatabaseNotFound
+}
+
+// getLiveNodes returns live nodes of storage cluster.
+func (r *rebalancer) getLiveNodes(cluster StorageCluster) (map[models.NodeID]models.StatefulNode, error) {
+	nodes, err := cluster.GetLiveNodes()
+	if err != nil {
+		return nil, err
+	}
+	rs := make(map[models.NodeID]models.StatefulNode)
+	for idx := range nodes {
+		rs[nodes[idx].ID] = nodes[idx]
+	}
+	return rs, nil
+}
@@ -0,0 +1,327 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/state"
+)
+
+func TestPlanRebalance(t *testing.T) {
+	shardAssign := &models.ShardAssignment{
+		Name: "test",
+		Shards: map[models.ShardID]*models.Replica{
+			0: {Replicas: []models.NodeID{1, 2}},
+			1: {Replicas: []models.NodeID{2, 1}},
+			2: {Replicas: []models.NodeID{1, 2}},
+		},
+	}
+	// case 1: only one live node
+	assert.Empty(t, PlanRebalance(shardAssign, []models.NodeID{1}))
+	// case 2: replicas balanced
+	assert.Empty(t, PlanRebalance(shardAssign, []models.NodeID{2, 1}))
+	// case 3: new node joins
+	assert.Equal(t, []models.ShardMove{
+		{ShardID: 0, From: 1, To: 3, State: models.RebalancePending},
+		{ShardID: 1, From: 2, To: 3, State: models.RebalancePending},
+	}, PlanRebalance(shardAssign, []models.NodeID{3, 2, 1}))
+	// case 4: no shard can be moved
+	shardAssign = &models.ShardAssignment{
+		Name: "test",
+		Shards: map[models.ShardID]*models.Replica{
+			0: {Replicas: []models.NodeID{1}},
+			1: {Replicas: []models.NodeID{1}},
+			2: {Replicas: []models.NodeID{1}},
+		},
+	}
+	assert.Equal(t, []models.ShardMove{
+		{ShardID: 0, From: 1, To: 2, State: models.RebalancePending},
+	}, PlanRebalance(shardAssign, []models.NodeID{1, 2}))
+}
+
+type rebalanceMockContext struct {
+	masterRepo  *state.MockRepository
+	storageRepo *state.MockRepository
+	stateMgr    *MockStateManager
+	cluster     *MockStorageCluster
+	migrator    *MockShardMigrator
+
+	r *rebalancer
+
+	mutex       sync.Mutex
+	shardAssign *models.ShardAssignment
+	job         *models.RebalanceJob
+}
+
+func newRebalanceMockContext(ctrl *gomock.Controller, job *models.RebalanceJob) *rebalanceMockContext {
+	mc := &rebalanceMockContext{
+		masterRepo:  state.NewMockRepository(ctrl),
+		storageRepo: state.NewMockRepository(ctrl),
+		stateMgr:    NewMockStateManager(ctrl),
+		cluster:     NewMockStorageCluster(ctrl),
+		migrator:    NewMockShardMigrator(ctrl),
+		shardAssign: &models.ShardAssignment{
+			Name:   "test",
+			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2}}},
+		},
+		job: job,
+	}
+	mc.r = newRebalancer(context.TODO(), mc.masterRepo, mc.stateMgr, mc.migrator)
+	mc.stateMgr.EXPECT().GetDatabases().
+		Return([]models.Database{{Name: "test", Storage: "s", Option: &option.DatabaseOption{}}}).AnyTimes()
+	mc.stateMgr.EXPECT().GetStorageCluster("s").Return(mc.cluster).AnyTimes()
+	mc.stateMgr.EXPECT().GetShardState("test", gomock.Any()).
+		DoAndReturn(func(_ string, shardID models.ShardID) (models.ShardState, bool) {
+			mc.mutex.Lock()
+			defer mc.mutex.Unlock()
+			replica, ok := mc.shardAssign.Shards[shardID]
+			if !ok {
+				return models.ShardState{}, false
+			}
+			// leader is the first replica in tests
+			return models.ShardState{ID: shardID, Leader: replica.Replicas[0]}, true
+		}).AnyTimes()
+	mc.cluster.EXPECT().GetLiveNodes().Return([]models.StatefulNode{{ID: 1}, {ID: 2}, {ID: 3}}, nil).AnyTimes()
+	mc.cluster.EXPECT().GetRepo().Return(mc.storageRepo).AnyTimes()
+	mc.cluster.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mc.storageRepo.EXPECT().Put(gomock.Any(), constants.GetShardMigrationPath("test", 1), gomock.Any()).
+		Return(nil).AnyTimes()
+	mc.storageRepo.EXPECT().Delete(gomock.Any(), constants.GetShardMigrationPath("test", 1)).
+		Return(fmt.Errorf("err")).AnyTimes()
+	mc.masterRepo.EXPECT().Get(gomock.Any(), constants.GetRebalanceJobPath("test")).
+		DoAndReturn(func(_ context.Context, _ string) ([]byte, error) {
+			mc.mutex.Lock()
+			defer mc.mutex.Unlock()
+			return encoding.JSONMarshal(mc.job), nil
+		}).AnyTimes()
+	mc.masterRepo.EXPECT().Put(gomock.Any(), constants.GetRebalanceJobPath("test"), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+			mc.mutex.Lock()
+			defer mc.mutex.Unlock()
+			mc.job = &models.RebalanceJob{}
+			return encoding.JSONUnmarshal(data, mc.job)
+		}).AnyTimes()
+	mc.masterRepo.EXPECT().Get(gomock.Any(), constants.GetDatabaseAssignPath("test")).
+		DoAndReturn(func(_ context.Context, _ string) ([]byte, error) {
+			mc.mutex.Lock()
+			defer mc.mutex.Unlock()
+			return encoding.JSONMarshal(mc.shardAssign), nil
+		}).AnyTimes()
+	mc.masterRepo.EXPECT().Put(gomock.Any(), constants.GetDatabaseAssignPath("test"), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+			mc.mutex.Lock()
+			defer mc.mutex.Unlock()
+			mc.shardAssign = &models.ShardAssignment{}
+			return encoding.JSONUnmarshal(data, mc.shardAssign)
+		}).AnyTimes()
+	return mc
+}
+
+func (mc *rebalanceMockContext) execute(t *testing.T) *models.RebalanceJob {
+	mc.r.Submit(mc.job)
+	assert.Eventually(t, func() bool {
+		return !mc.r.IsRunning("test")
+	}, time.Second, time.Millisecond)
+	mc.mutex.Lock()
+	defer mc.mutex.Unlock()
+	return mc.job
+}
+
+func TestRebalancer_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// case 1: no moves planned
+	mc := newRebalanceMockContext(ctrl, &models.RebalanceJob{Database: "test", State: models.RebalancePending})
+	job := mc.execute(t)
+	assert.Equal(t, models.RebalanceDone, job.State)
+	assert.Empty(t, job.Moves)
+	// case 2: move replica
+	mc = newRebalanceMockContext(ctrl, &models.RebalanceJob{
+		Database: "test",
+		State:    models.RebalancePending,
+		Moves:    []models.ShardMove{{ShardID: 1, From: 1, To: 3, State: models.RebalancePending}},
+	})
+	mc.migrator.EXPECT().Migrate(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(source, target *models.StatefulNode, migration *models.ShardMigration) error {
+			assert.Equal(t, models.NodeID(1), source.ID)
+			assert.Equal(t, models.NodeID(3), target.ID)
+			assert.Equal(t, models.ShardID(1), migration.ShardID)
+			return nil
+		})
+	// catching up and switching leader
+	mc.migrator.EXPECT().GetReplicaState(gomock.Any(), "test").Return([]models.FamilyLogReplicaState{
+		{ShardID: 2, Leader: 1},
+		{ShardID: 1, Leader: 1, Append: 10, Replicators: []models.ReplicaPeerState{{Replicator: "2"}, {Replicator: "3", ACK: 10}}},
+	}, nil).Times(2)
+	job = mc.execute(t)
+	assert.Equal(t, models.RebalanceDone, job.State)
+	assert.Equal(t, []models.ShardMove{{ShardID: 1, From: 1, To: 3, State: models.RebalanceDone}}, job.Moves)
+	assert.Equal(t, []models.NodeID{3, 2}, mc.shardAssign.Shards[1].Replicas)
+	// case 3: target node not catch up before switching leader, go back to catch up
+	mc = newRebalanceMockContext(ctrl, &models.RebalanceJob{
+		Database: "test",
+		State:    models.RebalancePending,
+		Moves:    []models.ShardMove{{ShardID: 1, From: 1, To: 3, State: models.RebalanceSwitchingLeader}},
+	})
+	gomock.InOrder(
+		mc.migrator.EXPECT().GetReplicaState(gomock.Any(), "test").Return([]models.FamilyLogReplicaState{
+			{ShardID: 1, Leader: 1, Append: 10, Replicators: []models.ReplicaPeerState{{Replicator: "3", ACK: 9}}},
+		}, nil),
+		mc.migrator.EXPECT().GetReplicaState(gomock.Any(), "test").Return([]models.FamilyLogReplicaState{
+			{ShardID: 1, Leader: 1, Append: 10, Replicators: []models.ReplicaPeerState{{Replicator: "3", ACK: 10}}},
+		}, nil).Times(2),
+	)
+	job = mc.execute(t)
+	assert.Equal(t, models.RebalanceDone, job.State)
+	assert.Equal(t, []models.NodeID{3, 2}, mc.shardAssign.Shards[1].Replicas)
+	// job completed, ignore it
+	mc.r.Submit(job)
+	assert.False(t, mc.r.IsRunning("test"))
+}
+
+func TestRebalancer_Execute_Failure(t *testing.T) {
+	defer func() {
+		catchUpCheckInterval = 5 * time.Second
+		catchUpTimeout = 30 * time.Minute
+	}()
+	catchUpCheckInterval = time.Millisecond
+	catchUpTimeout = 50 * time.Millisecond
+
+	newJob := func(state models.RebalanceState, to models.NodeID) *models.RebalanceJob {
+		return &models.RebalanceJob{
+			Database: "test",
+			State:    state,
+			Moves:    []models.ShardMove{{ShardID: 1, From: 2, To: to, State: state}},
+		}
+	}
+	cases := []struct {
+		name    string
+		job     *models.RebalanceJob
+		prepare func(mc *rebalanceMockContext)
+	}{
+		{
+			name: "database not found",
+			job:  &models.RebalanceJob{Database: "test2", State: models.RebalancePending},
+		},
+		{
+			name: "target node is replica",
+			job:  newJob(models.RebalancePending, 1),
+		},
+		{
+			name: "target node not alive",
+			job:  newJob(models.RebalancePending, 4),
+		},
+		{
+			name: "migrate failure",
+			job:  newJob(models.RebalancePending, 3),
+			prepare: func(mc *rebalanceMockContext) {
+				mc.migrator.EXPECT().Migrate(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "copy data interrupted",
+			job:  newJob(models.RebalanceCopyingData, 3),
+		},
+		{
+			name: "wait catch up timeout",
+			job:  newJob(models.RebalanceCatchingUp, 3),
+			prepare: func(mc *rebalanceMockContext) {
+				mc.migrator.EXPECT().GetReplicaState(gomock.Any(), "test").Return(nil, fmt.Errorf("err"))
+				mc.migrator.EXPECT().GetReplicaState(gomock.Any(), "test").Return([]models.FamilyLogReplicaState{
+					{ShardID: 1, Leader: 1, Replicators: []models.ReplicaPeerState{{Replicator: "3", Pending: 10}}},
+				}, nil).AnyTimes()
+			},
+		},
+		{
+			name: "target node not tracked",
+			job:  newJob(models.RebalanceCatchingUp, 3),
+			prepare: func(mc *rebalanceMockContext) {
+				mc.migrator.EXPECT().GetReplicaState(gomock.Any(), "test").Return(nil, nil).AnyTimes()
+			},
+		},
+		{
+			name: "target node not ack append index",
+			job:  newJob(models.RebalanceCatchingUp, 3),
+			prepare: func(mc *rebalanceMockContext) {
+				mc.migrator.EXPECT().GetReplicaState(gomock.Any(), "test").Return([]models.FamilyLogReplicaState{
+					{ShardID: 1, Leader: 1, Append: 10, Replicators: []models.ReplicaPeerState{{Replicator: "3", ACK: 5}}},
+				}, nil).AnyTimes()
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mc := newRebalanceMockContext(ctrl, tt.job)
+			if tt.job.Database != "test" {
+				mc.masterRepo.EXPECT().Get(gomock.Any(), constants.GetRebalanceJobPath(tt.job.Database)).
+					Return(encoding.JSONMarshal(tt.job), nil)
+				mc.masterRepo.EXPECT().Put(gomock.Any(), constants.GetRebalanceJobPath(tt.job.Database), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						mc.mutex.Lock()
+						defer mc.mutex.Unlock()
+						return encoding.JSONUnmarshal(data, mc.job)
+					})
+				mc.r.Submit(tt.job)
+				assert.Eventually(t, func() bool {
+					return !mc.r.IsRunning(tt.job.Database)
+				}, time.Second, time.Millisecond)
+				assert.Equal(t, models.RebalanceFailed, mc.job.State)
+				return
+			}
+			if tt.prepare != nil {
+				tt.prepare(mc)
+			}
+			job := mc.execute(t)
+			assert.Equal(t, models.RebalanceFailed, job.State)
+			assert.Equal(t, models.RebalanceFailed, job.Moves[0].State)
+			assert.NotEmpty(t, job.ErrMsg)
+		})
+	}
+}
+
+func TestRebalancer_Execute_GetJobFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	masterRepo := state.NewMockRepository(ctrl)
+	r := newRebalancer(context.TODO(), masterRepo, nil, nil)
+	masterRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	r.execute("test")
+	masterRepo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("abc"), nil)
+	r.execute("test")
+	masterRepo.EXPECT().Get(gomock.Any(), gomock.Any()).
+		Return(encoding.JSONMarshal(&models.RebalanceJob{State: models.RebalanceDone}), nil)
+	r.execute("test")
+}
@@ -18,35 +18,142 @@
 package master
 
 import (
+	"sync"
+
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 //go:generate mockgen -source=./replica_leader_elector.go -destination=./replica_leader_elector_mock.go -package=master
 
+// ReplicaStateFetcher represents the fetcher which fetches wal replica state from storage node.
+type ReplicaStateFetcher interface {
+	// GetReplicaState returns the wal replica state of database on storage node.
+	GetReplicaState(node *models.StatefulNode, databaseName string) ([]models.FamilyLogReplicaState, error)
+	// GetReplicaAckIndex returns the ack index of family log which is replicated from leader on storage node.
+	GetReplicaAckIndex(node *models.StatefulNode, databaseName string,
+		shardID models.ShardID, leader models.NodeID, familyTime int64) (int64, error)
+}
+
+// familyLogKey represents the key of family log which is replicated from leader.
+type familyLogKey struct {
+	shardID    models.ShardID
+	leader     models.NodeID
+	familyTime int64
+}
+
+// ReplicaProgress represents the replica log progress of database on live storage nodes,
+// node id => (family log => ack index), node not exist if fetch progress failure.
+type ReplicaProgress map[models.NodeID]map[familyLogKey]int64
+
 // ReplicaLeaderElector represents replica leader elector for shard.
 type ReplicaLeaderElector interface {
-	// ElectLeader elects the replica's leader based on shard assignment.
+	// FetchReplicaProgress fetches the replica log progress of database from live nodes in parallel
+	// (one replica state request per node), only fetches the family logs replicated from given leaders.
+	FetchReplicaProgress(databaseName string,
+		liveNodes map[models.NodeID]models.StatefulNode,
+		leaders map[models.NodeID]struct{},
+	) ReplicaProgress
+	// ElectLeader elects the replica's leader based on shard assignment and replica log progress,
+	// prevLeader is the leader of shard before election(models.NoLeader if not exist).
 	ElectLeader(shardAssignment *models.ShardAssignment,
 		liveNodes map[models.NodeID]models.StatefulNode,
 		shardID models.ShardID,
-	) (leader models.NodeID, err error)
+		prevLeader models.NodeID,
+		progress ReplicaProgress,
+	) (leader models.NodeID, reason models.LeaderElectReason, err error)
 }
 
 // replicaLeaderElector implements ReplicaLeaderElector interface.
 type replicaLeaderElector struct {
+	fetcher ReplicaStateFetcher
+
+	logger *logger.Logger
 }
 
 // newReplicaLeaderElector creates a ReplicaLeaderElector instance.
-func newReplicaLeaderElector() ReplicaLeaderElector {
-	return &replicaLeaderElector{}
+func newReplicaLeaderElector(fetcher ReplicaStateFetcher) ReplicaLeaderElector {
+	return &replicaLeaderElector{
+		fetcher: fetcher,
+		logger:  logger.GetLogger("Master", "ReplicaLeaderElector"),
+	}
+}
+
+// FetchReplicaProgress fetches the replica log progress of database from live nodes in parallel
+// (one replica state request per node), only fetches the family logs replicated from given leaders.
+func (r *replicaLeaderElector) FetchReplicaProgress(databaseName string,
+	liveNodes map[models.NodeID]models.StatefulNode,
+	leaders map[models.NodeID]struct{},
+) ReplicaProgress {
+	progress := make(ReplicaProgress)
+	if r.fetcher == nil || len(leaders) == 0 {
+		return progress
+	}
+	var (
+		wait  sync.WaitGroup
+		mutex sync.Mutex
+	)
+	for nodeID := range liveNodes {
+		node := liveNodes[nodeID]
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			acks, err := r.fetchAckIndexes(&node, databaseName, leaders)
+			if err != nil {
+				r.logger.Warn("get replica progress failure, ignore replica when elect leader",
+					logger.String("database", databaseName),
+					logger.String("replica", node.Indicator()),
+					logger.Error(err))
+				return
+			}
+			mutex.Lock()
+			progress[node.ID] = acks
+			mutex.Unlock()
+		}()
+	}
+	wait.Wait()
+	return progress
+}
+
+// fetchAckIndexes fetches the ack index of family logs replicated from given leaders on storage node.
+func (r *replicaLeaderElector) fetchAckIndexes(node *models.StatefulNode, databaseName string,
+	leaders map[models.NodeID]struct{},
+) (map[familyLogKey]int64, error) {
+	states, err := r.fetcher.GetReplicaState(node, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	acks := make(map[familyLogKey]int64)
+	for _, state := range states {
+		if _, ok := leaders[state.Leader]; !ok {
+			continue
+		}
+		familyTime, err := timeutil.ParseTimestamp(state.FamilyTime, timeutil.DataTimeFormat2)
+		if err != nil {
+			return nil, err
+		}
+		ackIndex, err := r.fetcher.GetReplicaAckIndex(node, databaseName, state.ShardID, state.Leader, familyTime)
+		if err != nil {
+			return nil, err
+		}
+		acks[familyLogKey{shardID: state.ShardID, leader: state.Leader, familyTime: familyTime}] = ackIndex
+	}
+	return acks, nil
 }
 
-// ElectLeader elects the replica's leader based on shard assignment.
+// ElectLeader elects the replica's leader based on shard assignment and replica log progress.
+// 1. if previous leader is still alive, keeps it as leader, avoid moving leadership(e.g. alter database);
+// 2. if previous leader not exist, elects the first live replica based on shard assignment;
+// 3. if previous leader is dead, elects the live replica which has the minimum lag of ack index of
+// the family logs replicated from previous leader, avoid losing acknowledged writes.
 func (r *replicaLeaderElector) ElectLeader(shardAssignment *models.ShardAssignment,
 	liveNodes map[models.NodeID]models.StatefulNode,
 	shardID models.ShardID,
-) (leader models.NodeID, err error) {
+	prevLeader models.NodeID,
+	progress ReplicaProgress,
+) (leader models.NodeID, reason models.LeaderElectReason, err error) {
 	replicas, ok := shardAssignment.Shards[shardID]
 	if !ok {
 		// shard not exist
@@ -65,7 +172,53 @@ func (r *replicaLeaderElector) ElectLeader(shardAssignment *models.ShardAssignme
 		err = constants.ErrNoLiveReplica
 		return
 	}
+	if prevLeader != models.NoLeader && liveReplicaNodes.Contain(prevLeader) {
+		// previous leader alive, keep it
+		return prevLeader, models.ElectByAssignment, nil
+	}
 	// elect leader from live replicas
 	leader = liveReplicaNodes.Replicas[0]
-	return
+	reason = models.ElectByAssignment
+
+	if prevLeader == models.NoLeader {
+		return
+	}
+	// previous leader is dead, find the max ack index of each family log replicated from previous leader
+	maxAcks := make(map[familyLogKey]int64)
+	for _, replica := range liveReplicaNodes.Replicas {
+		for key, ack := range progress[replica] {
+			if key.shardID != shardID || key.leader != prevLeader {
+				continue
+			}
+			if maxAck, ok := maxAcks[key]; !ok || ack > maxAck {
+				maxAcks[key] = ack
+			}
+		}
+	}
+	// elect the replica which has the minimum lag of ack index
+	found := false
+	minLag := int64(0)
+	for _, replica := range liveReplicaNodes.Replicas {
+		acks, ok := progress[replica]
+		if !ok {
+			// fetch replica progress failure
+			continue
+		}
+		lag := int64(0)
+		for key, maxAck := range maxAcks {
+			lag += maxAck - acks[key]
+		}
+		if !found || lag < minLag {
+			found = true
+			minLag = lag
+			leader = replica
+			reason = models.ElectByLogProgress
+		}
+	}
+	if !found {
+		r.logger.Warn("cannot get replica progress from all live replicas, elect leader based on shard assignment",
+			logger.String("database", shardAssignment.Name),
+			logger.Any("shardID", shardID))
+	}
+	return leader, reason, nil
 }
@@ -18,28 +18,162 @@
 package master
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 func TestReplicaLeaderElector_ElectLeader(t *testing.T) {
-	elect := newReplicaLeaderElector()
-	_, err := elect.ElectLeader(models.NewShardAssignment("test"), nil, models.ShardID(1))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fetcher := NewMockReplicaStateFetcher(ctrl)
+	elect := newReplicaLeaderElector(fetcher)
+	_, _, err := elect.ElectLeader(models.NewShardAssignment("test"), nil, models.ShardID(1), models.NoLeader, nil)
 	assert.Equal(t, constants.ErrShardNotFound, err)
 
 	shardAssignment := models.NewShardAssignment("test")
 	shardAssignment.AddReplica(models.ShardID(1), models.NodeID(1))
 	liveNodes := make(map[models.NodeID]models.StatefulNode)
 
-	_, err = elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1))
+	_, _, err = elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1), models.NoLeader, nil)
 	assert.Equal(t, constants.ErrNoLiveReplica, err)
 	liveNodes[models.NodeID(1)] = models.StatefulNode{}
 
-	leader, err := elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1))
+	leader, reason, err := elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1), models.NoLeader, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, models.NodeID(1), leader)
+	assert.Equal(t, models.ElectByAssignment, reason)
+}
+
+func TestReplicaLeaderElector_ElectLeader_LogProgress(t *testing.T) {
+	elect := newReplicaLeaderElector(nil)
+	shardAssignment := models.NewShardAssignment("test")
+	shardAssignment.AddReplica(models.ShardID(1), models.NodeID(1))
+	shardAssignment.AddReplica(models.ShardID(1), models.NodeID(2))
+	shardAssignment.AddReplica(models.ShardID(1), models.NodeID(3))
+	liveNodes := map[models.NodeID]models.StatefulNode{
+		1: {ID: 1},
+		2: {ID: 2},
+		3: {ID: 3},
+	}
+	// ack index of family logs(family time => ack index) replicated from leader
+	acks := func(leader models.NodeID, familyAcks map[int64]int64) map[familyLogKey]int64 {
+		rs := make(map[familyLogKey]int64)
+		for familyTime, ack := range familyAcks {
+			rs[familyLogKey{shardID: 1, leader: leader, familyTime: familyTime}] = ack
+		}
+		// other shard
+		rs[familyLogKey{shardID: 2, leader: leader, familyTime: 1}] = 1000
+		return rs
+	}
+
+	cases := []struct {
+		name       string
+		prevLeader models.NodeID
+		progress   ReplicaProgress
+		leader     models.NodeID
+		reason     models.LeaderElectReason
+	}{
+		{
+			name:       "previous leader alive, keep it",
+			prevLeader: 2,
+			leader:     2,
+			reason:     models.ElectByAssignment,
+		},
+		{
+			name:       "elect replica with minimum lag of ack index",
+			prevLeader: 4,
+			progress: ReplicaProgress{
+				1: acks(4, map[int64]int64{1: 10, 2: 10}),
+				2: acks(4, map[int64]int64{1: 10, 2: 15}),
+				3: acks(5, map[int64]int64{1: 100}),
+			},
+			leader: 2,
+			reason: models.ElectByLogProgress,
+		},
+		{
+			name:       "replica misses family log",
+			prevLeader: 4,
+			progress: ReplicaProgress{
+				1: acks(4, map[int64]int64{2: 20}),
+				2: acks(4, map[int64]int64{1: 10, 2: 15}),
+			},
+			leader: 2,
+			reason: models.ElectByLogProgress,
+		},
+		{
+			name:       "same progress, elect by assignment order",
+			prevLeader: 4,
+			progress: ReplicaProgress{
+				2: acks(4, map[int64]int64{1: 10}),
+				3: acks(4, map[int64]int64{1: 10}),
+			},
+			leader: 2,
+			reason: models.ElectByLogProgress,
+		},
+		{
+			name:       "get replica progress failure",
+			prevLeader: 4,
+			leader:     1,
+			reason:     models.ElectByAssignment,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			leader, reason, err := elect.ElectLeader(shardAssignment, liveNodes, models.ShardID(1), tt.prevLeader, tt.progress)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.leader, leader)
+			assert.Equal(t, tt.reason, reason)
+		})
+	}
+}
+
+func TestReplicaLeaderElector_FetchReplicaProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fetcher := NewMockReplicaStateFetcher(ctrl)
+	elect := newReplicaLeaderElector(fetcher)
+	liveNodes := map[models.NodeID]models.StatefulNode{
+		1: {ID: 1},
+		2: {ID: 2},
+		3: {ID: 3},
+		4: {ID: 4},
+	}
+	leaders := map[models.NodeID]struct{}{5: {}}
+	familyTime := "2022-01-01 10:00:00"
+	timestamp, _ := timeutil.ParseTimestamp(familyTime, timeutil.DataTimeFormat2)
+	// no leader
+	assert.Empty(t, elect.FetchReplicaProgress("test", liveNodes, nil))
+
+	// node 1: get replica state failure
+	fetcher.EXPECT().GetReplicaState(&models.StatefulNode{ID: 1}, "test").Return(nil, fmt.Errorf("err"))
+	// node 2: parse family time failure
+	fetcher.EXPECT().GetReplicaState(&models.StatefulNode{ID: 2}, "test").
+		Return([]models.FamilyLogReplicaState{{ShardID: 1, Leader: 5, FamilyTime: "abc"}}, nil)
+	// node 3: get ack index failure
+	fetcher.EXPECT().GetReplicaState(&models.StatefulNode{ID: 3}, "test").
+		Return([]models.FamilyLogReplicaState{{ShardID: 1, Leader: 5, FamilyTime: familyTime}}, nil)
+	fetcher.EXPECT().GetReplicaAckIndex(&models.StatefulNode{ID: 3}, "test", models.ShardID(1), models.NodeID(5), timestamp).
+		Return(int64(0), fmt.Errorf("err"))
+	// node 4: fetch successfully, ignore family log of other leader
+	fetcher.EXPECT().GetReplicaState(&models.StatefulNode{ID: 4}, "test").
+		Return([]models.FamilyLogReplicaState{
+			{ShardID: 1, Leader: 5, FamilyTime: familyTime},
+			{ShardID: 1, Leader: 4, FamilyTime: familyTime},
+		}, nil)
+	fetcher.EXPECT().GetReplicaAckIndex(&models.StatefulNode{ID: 4}, "test", models.ShardID(1), models.NodeID(5), timestamp).
+		Return(int64(10), nil)
+	progress := elect.FetchReplicaProgress("test", liveNodes, leaders)
+	assert.Equal(t, ReplicaProgress{
+		4: {{shardID: 1, leader: 5, familyTime: timestamp}: 10},
+	}, progress)
 }
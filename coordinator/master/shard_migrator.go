@@ -0,0 +1,120 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
+	"github.com/lindb/lindb/rpc"
+)
+
+//go:generate mockgen -source=./shard_migrator.go -destination=./shard_migrator_mock.go -package=master
+
+// for testing
+var (
+	newRestyFn                = httppkg.NewRestyClient
+	newReplicaServiceClientFn = protoReplicaV1.NewReplicaServiceClient
+)
+
+// replicaStateTimeout is the timeout of fetching replica state, leader election waits for it.
+const replicaStateTimeout = 5 * time.Second
+
+// ShardMigrator represents the operator of shard data migration between storage nodes.
+type ShardMigrator interface {
+	// Migrate migrates all data of shard from source node(shard leader) to target node.
+	Migrate(source, target *models.StatefulNode, migration *models.ShardMigration) error
+	// GetReplicaState returns the wal replica state of database on storage node.
+	GetReplicaState(node *models.StatefulNode, databaseName string) ([]models.FamilyLogReplicaState, error)
+	// GetReplicaAckIndex returns the ack index of family log which is replicated from leader on storage node.
+	GetReplicaAckIndex(node *models.StatefulNode, databaseName string,
+		shardID models.ShardID, leader models.NodeID, familyTime int64) (int64, error)
+}
+
+// shardMigrator implements ShardMigrator interface, invokes storage node's http/grpc api.
+type shardMigrator struct {
+	connFct rpc.ClientConnFactory
+}
+
+// newShardMigrator creates a ShardMigrator instance.
+func newShardMigrator() ShardMigrator {
+	return &shardMigrator{
+		connFct: rpc.GetBrokerClientConnFactory(),
+	}
+}
+
+// Migrate migrates all data of shard from source node(shard leader) to target node.
+func (m *shardMigrator) Migrate(source, target *models.StatefulNode, migration *models.ShardMigration) error {
+	migration.Target = target.HTTPAddress()
+	resp, err := newRestyFn().R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader(constants.InternalAuthorizationHeader, httppkg.InternalAuthorization()).
+		SetBody(migration).
+		Put(source.HTTPAddress() + constants.APIVersion1CliPath + "/shard/migrate")
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("migrate shard data failure, source: %s, target: %s, err: %s",
+			source.Indicator(), target.Indicator(), resp.String())
+	}
+	return nil
+}
+
+// GetReplicaState returns the wal replica state of database on storage node.
+func (m *shardMigrator) GetReplicaState(node *models.StatefulNode, databaseName string) ([]models.FamilyLogReplicaState, error) {
+	var state []models.FamilyLogReplicaState
+	resp, err := newRestyFn().SetTimeout(replicaStateTimeout).R().SetQueryParams(map[string]string{"db": databaseName}).
+		SetHeader("Accept", "application/json").
+		SetResult(&state).
+		Get(node.HTTPAddress() + constants.APIVersion1CliPath + "/state/replica")
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("get replica state failure, node: %s, err: %s", node.Indicator(), resp.String())
+	}
+	return state, nil
+}
+
+// GetReplicaAckIndex returns the ack index of family log which is replicated from leader on storage node.
+func (m *shardMigrator) GetReplicaAckIndex(node *models.StatefulNode, databaseName string,
+	shardID models.ShardID, leader models.NodeID, familyTime int64,
+) (int64, error) {
+	conn, err := m.connFct.GetClientConn(node)
+	if err != nil {
+		return 0, err
+	}
+	ctx, cancel := context.WithTimeout(context.TODO(), replicaStateTimeout)
+	defer cancel()
+	resp, err := newReplicaServiceClientFn(conn).GetReplicaAckIndex(ctx, &protoReplicaV1.GetReplicaAckIndexRequest{
+		Database:   databaseName,
+		Shard:      int32(shardID),
+		Leader:     int32(leader),
+		FamilyTime: familyTime,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.AckIndex, nil
+}
@@ -0,0 +1,176 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package master
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	protoReplicaV1 "github.com/lindb/lindb/proto/gen/v1/replica"
+	"github.com/lindb/lindb/rpc"
+)
+
+func newTestNode(t *testing.T, address string) *models.StatefulNode {
+	u, err := url.Parse(address)
+	assert.NoError(t, err)
+	host, port, err := net.SplitHostPort(u.Host)
+	assert.NoError(t, err)
+	p, err := strconv.Atoi(port)
+	assert.NoError(t, err)
+	return &models.StatefulNode{ID: 1, StatelessNode: models.StatelessNode{HostIP: host, HTTPPort: uint16(p)}}
+}
+
+func TestShardMigrator_Migrate(t *testing.T) {
+	httppkg.SetClientInternalAuthorization(func() string {
+		return "internal-token"
+	})
+	defer httppkg.SetClientInternalAuthorization(nil)
+
+	cases := []struct {
+		name    string
+		prepare func(w http.ResponseWriter)
+		wantErr bool
+	}{
+		{
+			name: "migrate successfully",
+		},
+		{
+			name: "migrate failure",
+			prepare: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/api/v1/shard/migrate", r.URL.Path)
+					assert.Equal(t, "internal-token", r.Header.Get(constants.InternalAuthorizationHeader))
+					if tt.prepare != nil {
+						tt.prepare(w)
+					}
+				}))
+			defer ts.Close()
+
+			node := newTestNode(t, ts.URL)
+			err := newShardMigrator().Migrate(node, node, &models.ShardMigration{Database: "test"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Migrate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	// connect failure
+	err := newShardMigrator().Migrate(&models.StatefulNode{}, &models.StatefulNode{}, &models.ShardMigration{})
+	assert.Error(t, err)
+}
+
+func TestShardMigrator_GetReplicaState(t *testing.T) {
+	cases := []struct {
+		name    string
+		prepare func(w http.ResponseWriter)
+		wantErr bool
+	}{
+		{
+			name: "get replica state successfully",
+			prepare: func(w http.ResponseWriter) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`[{"shardId":1,"leader":1}]`))
+			},
+		},
+		{
+			name: "get replica state failure",
+			prepare: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ts := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					assert.Equal(t, "/api/v1/state/replica", r.URL.Path)
+					assert.Equal(t, "test", r.URL.Query().Get("db"))
+					tt.prepare(w)
+				}))
+			defer ts.Close()
+
+			state, err := newShardMigrator().GetReplicaState(newTestNode(t, ts.URL), "test")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetReplicaState() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				assert.Len(t, state, 1)
+			}
+		})
+	}
+	// connect failure
+	_, err := newShardMigrator().GetReplicaState(&models.StatefulNode{}, "test")
+	assert.Error(t, err)
+}
+
+func TestShardMigrator_GetReplicaAckIndex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newReplicaServiceClientFn = protoReplicaV1.NewReplicaServiceClient
+		ctrl.Finish()
+	}()
+
+	connFct := rpc.NewMockClientConnFactory(ctrl)
+	cli := protoReplicaV1.NewMockReplicaServiceClient(ctrl)
+	newReplicaServiceClientFn = func(_ *grpc.ClientConn) protoReplicaV1.ReplicaServiceClient {
+		return cli
+	}
+	migrator := &shardMigrator{connFct: connFct}
+	node := &models.StatefulNode{ID: 1}
+	// case 1: get client conn failure
+	connFct.EXPECT().GetClientConn(node).Return(nil, fmt.Errorf("err"))
+	_, err := migrator.GetReplicaAckIndex(node, "test", 1, 2, 10)
+	assert.Error(t, err)
+	connFct.EXPECT().GetClientConn(node).Return(nil, nil).AnyTimes()
+	// case 2: get ack index failure
+	cli.EXPECT().GetReplicaAckIndex(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	_, err = migrator.GetReplicaAckIndex(node, "test", 1, 2, 10)
+	assert.Error(t, err)
+	// case 3: get ack index successfully
+	cli.EXPECT().GetReplicaAckIndex(gomock.Any(), &protoReplicaV1.GetReplicaAckIndexRequest{
+		Database:   "test",
+		Shard:      1,
+		Leader:     2,
+		FamilyTime: 10,
+	}).Return(&protoReplicaV1.GetReplicaAckIndexResponse{AckIndex: 100}, nil)
+	ackIndex, err := migrator.GetReplicaAckIndex(node, "test", 1, 2, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), ackIndex)
+}
@@ -57,6 +57,12 @@ func init() {
 			return &models.ShardAssignment{}
 		},
 	}
+	StateMachinePaths[constants.RebalanceJob] = models.StateMachineInfo{
+		Path: constants.RebalanceJobPath,
+		CreateState: func() interface{} {
+			return &models.RebalanceJob{}
+		},
+	}
 	StateMachinePaths[constants.StorageState] = models.StateMachineInfo{
 		Path: constants.StorageStatePath,
 		CreateState: func() interface{} {
@@ -110,6 +116,12 @@ func (f *StateMachineFactory) Start() (err error) {
 		return err
 	}
 	f.stateMachines = append(f.stateMachines, sm)
+	f.logger.Debug("starting RebalanceJobStateMachine")
+	sm, err = f.createRebalanceJobStateMachine()
+	if err != nil {
+		return err
+	}
+	f.stateMachines = append(f.stateMachines, sm)
 
 	f.logger.Info("started MasterStateMachines")
 	return nil
@@ -194,6 +206,24 @@ func (f *StateMachineFactory) createShardAssignmentStateMachine() (discovery.Sta
 		})
 }
 
+// createRebalanceJobStateMachine creates rebalance job state machine.
+func (f *StateMachineFactory) createRebalanceJobStateMachine() (discovery.StateMachine, error) {
+	return discovery.NewStateMachine(
+		f.ctx,
+		discovery.RebalanceJobStateMachine,
+		f.discoveryFactory,
+		constants.RebalanceJobPath,
+		true,
+		func(key string, data []byte) {
+			f.stateMgr.EmitEvent(&discovery.Event{
+				Type:  discovery.RebalanceJobChanged,
+				Key:   key,
+				Value: data,
+			})
+		},
+		nil)
+}
+
 // createStorageNodeStateMachine creates storage node state machine.
 func (f *StateMachineFactory) createStorageNodeStateMachine(storageName string,
 	discoveryFactory discovery.Factory,
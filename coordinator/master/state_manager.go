@@ -59,6 +59,10 @@ type StateManager interface {
 	GetShardAssignments() []models.ShardAssignment
 	// GetStorageStates returns current storage state list.
 	GetStorageStates() []*models.StorageState
+	// GetShardState returns the current state of shard by database's name and shard id.
+	GetShardState(databaseName string, shardID models.ShardID) (models.ShardState, bool)
+	// GetInternalSecret returns the cluster internal secret which signs the token of internal api between nodes.
+	GetInternalSecret() ([]byte, error)
 }
 
 // stateManager implements StateManager.
@@ -71,6 +75,7 @@ type stateManager struct {
 
 	masterRepo statepkg.Repository
 	elector    ReplicaLeaderElector
+	rebalancer *rebalancer
 
 	newStorageClusterFn func(ctx context.Context, cfg *config.StorageCluster,
 		stateMgr StateManager,
@@ -79,6 +84,12 @@ type stateManager struct {
 	storages         map[string]StorageCluster
 	databases        map[string]*models.Database
 	shardAssignments map[string]*models.ShardAssignment
+	// persisted shard states loaded from state repo(database's name => shard state),
+	// keep leader epoch increasing after master failover.
+	persistedShardStates map[string]map[models.ShardID]models.ShardState
+	// replica log progress of databases which are fetched before handling event(database's name => progress),
+	// used for electing shard leader when previous leader is dead.
+	replicaProgress map[string]ReplicaProgress
 
 	events chan *discovery.Event
 
@@ -105,7 +116,7 @@ func NewStateManager(
 		storages:              make(map[string]StorageCluster),
 		databases:             make(map[string]*models.Database),
 		shardAssignments:      make(map[string]*models.ShardAssignment),
-		elector:               newReplicaLeaderElector(),
+		persistedShardStates:  make(map[string]map[models.ShardID]models.ShardState),
 		events:                make(chan *discovery.Event, 10),
 		running:               atomic.NewBool(true),
 		newStorageClusterFn:   newStorageCluster,
@@ -114,6 +125,10 @@ func NewStateManager(
 		logger:                logger.GetLogger("Master", "StateManager"),
 	}
 
+	migrator := newShardMigrator()
+	mgr.elector = newReplicaLeaderElector(migrator)
+	mgr.rebalancer = newRebalancer(c, masterRepo, mgr, migrator)
+
 	// start consume event then do coordinate
 	go mgr.consumeEvent()
 
@@ -149,9 +164,17 @@ func (m *stateManager) processEvent(event *discovery.Event) {
 		}
 	}()
 
+	// fetch replica log progress before acquiring lock, avoid blocking state manager by remote calls
+	replicaProgress := m.fetchReplicaProgress(event)
+
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	m.replicaProgress = replicaProgress
+	defer func() {
+		m.replicaProgress = nil
+	}()
+
 	if !m.running.Load() {
 		m.logger.Warn("master state manager is closed")
 		return
@@ -168,6 +191,8 @@ func (m *stateManager) processEvent(event *discovery.Event) {
 		err = m.onDatabaseCfgDelete(event.Key)
 	case discovery.ShardAssignmentChanged:
 		err = m.onShardAssignmentChange(event.Key, event.Value)
+	case discovery.RebalanceJobChanged:
+		err = m.onRebalanceJobChange(event.Key, event.Value)
 	case discovery.NodeStartup:
 		err = m.onStorageNodeStartup(event.Attributes[storageNameKey], event.Key, event.Value)
 	case discovery.NodeFailure:
@@ -180,6 +205,101 @@ func (m *stateManager) processEvent(event *discovery.Event) {
 	}
 }
 
+// replicaProgressFetchTask represents the task which fetches replica log progress of database.
+type replicaProgressFetchTask struct {
+	database  string
+	liveNodes map[models.NodeID]models.StatefulNode
+	leaders   map[models.NodeID]struct{} // dead leaders of shards
+}
+
+// fetchReplicaProgress fetches the replica log progress of databases which have shard with dead leader
+// (new leader may be elected when handling event), one request per (node, database) in parallel.
+func (m *stateManager) fetchReplicaProgress(event *discovery.Event) map[string]ReplicaProgress {
+	var (
+		storageName   string
+		databaseName  string // all databases of storage if empty
+		offlineNodeID = models.NoLeader
+	)
+	switch event.Type {
+	case discovery.NodeFailure:
+		_, nodeIDStr := filepath.Split(event.Key)
+		id, err := strconv.ParseInt(nodeIDStr, 10, 64)
+		if err != nil {
+			return nil
+		}
+		storageName = event.Attributes[storageNameKey]
+		offlineNodeID = models.NodeID(id)
+	case discovery.ShardAssignmentChanged:
+		shardAssignment := &models.ShardAssignment{}
+		if err := encoding.JSONUnmarshal(event.Value, shardAssignment); err != nil {
+			return nil
+		}
+		databaseName = shardAssignment.Name
+	default:
+		// no leader election
+		return nil
+	}
+	tasks := m.prepareReplicaProgressFetchTasks(storageName, databaseName, offlineNodeID)
+	if len(tasks) == 0 {
+		return nil
+	}
+	replicaProgress := make(map[string]ReplicaProgress)
+	for _, task := range tasks {
+		replicaProgress[task.database] = m.elector.FetchReplicaProgress(task.database, task.liveNodes, task.leaders)
+	}
+	return replicaProgress
+}
+
+// prepareReplicaProgressFetchTasks prepares the tasks which fetch replica log progress of databases
+// which have shard with dead leader.
+func (m *stateManager) prepareReplicaProgressFetchTasks(
+	storageName, databaseName string,
+	offlineNodeID models.NodeID,
+) (tasks []replicaProgressFetchTask) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if databaseName != "" {
+		databaseCfg, ok := m.databases[databaseName]
+		if !ok {
+			return nil
+		}
+		storageName = databaseCfg.Storage
+	}
+	storage, ok := m.storages[storageName]
+	if !ok {
+		return nil
+	}
+	state := storage.GetState()
+	liveNodes := make(map[models.NodeID]models.StatefulNode)
+	for nodeID, node := range state.LiveNodes {
+		if nodeID != offlineNodeID {
+			liveNodes[nodeID] = node
+		}
+	}
+	shardStatesOfDBs := state.ShardStates
+	if databaseName != "" {
+		shardStates, ok := state.ShardStates[databaseName]
+		if !ok {
+			// maybe master failover, use shard states from state repo
+			shardStates = m.persistedShardStates[databaseName]
+		}
+		shardStatesOfDBs = map[string]map[models.ShardID]models.ShardState{databaseName: shardStates}
+	}
+	for db, shardStates := range shardStatesOfDBs {
+		leaders := make(map[models.NodeID]struct{})
+		for _, shardState := range shardStates {
+			if _, alive := liveNodes[shardState.Leader]; shardState.Leader != models.NoLeader && !alive {
+				leaders[shardState.Leader] = struct{}{}
+			}
+		}
+		if len(leaders) > 0 {
+			tasks = append(tasks, replicaProgressFetchTask{database: db, liveNodes: liveNodes, leaders: leaders})
+		}
+	}
+	return tasks
+}
+
 // SetStateMachineFactory sets state machine factory.
 func (m *stateManager) SetStateMachineFactory(stateMachineFct *StateMachineFactory) {
 	m.stateMachineFct = stateMachineFct
@@ -248,16 +368,34 @@ func (m *stateManager) onShardAssignmentChange(key string, data []byte) error {
 			logger.Error(err))
 		return err
 	}
+	databaseCfg, ok := m.databases[shardAssignment.Name]
+	if !ok {
+		// database config not sync yet(restoring database), initialize shard state when config change.
+		m.logger.Info("database config not found, ignore shard assignment change event",
+			logger.String("database", shardAssignment.Name))
+		return nil
+	}
 	m.shardAssignments[shardAssignment.Name] = shardAssignment
 
-	databaseCfg := m.databases[shardAssignment.Name]
-
 	storage := m.storages[databaseCfg.Storage]
 
 	m.initializeShardState(storage, shardAssignment)
 	return m.syncState(storage.GetState())
 }
 
+// onRebalanceJobChange triggers when rebalance job submit/modify, executes/resumes the job if it isn't completed.
+func (m *stateManager) onRebalanceJobChange(key string, data []byte) error {
+	job := &models.RebalanceJob{}
+	if err := encoding.JSONUnmarshal(data, job); err != nil {
+		m.logger.Error("database's rebalance job is changed, but unmarshal error",
+			logger.String("key", key),
+			logger.Error(err))
+		return err
+	}
+	m.rebalancer.Submit(job)
+	return nil
+}
+
 // onStorageConfigChange triggers when storage config create/modify.
 func (m *stateManager) onStorageConfigChange(key string, data []byte) error {
 	m.logger.Info("storage config is changed",
@@ -356,6 +494,7 @@ func (m *stateManager) register(cfg *config.StorageCluster) error {
 		return err
 	}
 	m.storages[name] = cluster
+	m.loadPersistedShardStates(name)
 	if exist {
 		// if storage is existed, need to load shard assigment for this storage
 		for k := range m.shardAssignments {
@@ -384,6 +523,26 @@ func (m *stateManager) register(cfg *config.StorageCluster) error {
 	return nil
 }
 
+// loadPersistedShardStates loads the shard states of storage cluster from state repo,
+// which are used for initializing shard leader/epoch.
+func (m *stateManager) loadPersistedShardStates(name string) {
+	data, err := m.masterRepo.Get(m.ctx, constants.GetStorageStatePath(name))
+	if err != nil {
+		if err != statepkg.ErrNotExist {
+			m.logger.Warn("load storage state failure", logger.String("storage", name), logger.Error(err))
+		}
+		return
+	}
+	state := models.NewStorageState(name)
+	if err := encoding.JSONUnmarshal(data, state); err != nil {
+		m.logger.Warn("unmarshal storage state failure", logger.String("storage", name), logger.Error(err))
+		return
+	}
+	for db, shardStates := range state.ShardStates {
+		m.persistedShardStates[db] = shardStates
+	}
+}
+
 // deleteCluster deletes the storageCluster if exist.
 func (m *stateManager) unRegister(name string) {
 	if cluster, ok := m.storages[name]; ok {
@@ -453,8 +612,9 @@ func (m *stateManager) shardAssignment(databaseCfg *models.Database) {
 			return
 		}
 	default:
-		// TODO remove it ???
-		m.logger.Info("no data changed, just trigger shard assignment data modify event",
+		// shard assignment not changed, but database option may be altered(retention/intervals etc.),
+		// re-save shard assignment with latest option, storage nodes apply the option online.
+		m.logger.Info("shard assignment not changed, trigger shard assignment data modify event with latest option",
 			logger.String("storage", databaseCfg.Storage),
 			logger.Any("database", databaseCfg.Name))
 		data := encoding.JSONMarshal(shardAssign)
@@ -465,19 +625,34 @@ func (m *stateManager) shardAssignment(databaseCfg *models.Database) {
 				logger.Error(err))
 			return
 		}
+		// save shard assignment into related storage repo, storage nodes load the shards which are restored.
+		if err := cluster.SaveDatabaseAssignment(shardAssign, databaseCfg.Option); err != nil {
+			m.logger.Error("save shard assignment into storage repo error",
+				logger.String("storage", databaseCfg.Storage),
+				logger.Any("database", databaseCfg.Name),
+				logger.Error(err))
+			return
+		}
 	}
 }
 
 func (m *stateManager) onNodeStartup(state *models.StorageState, node models.StatefulNode) {
 	// 1. do when a new node come up is send it the entire list of shards that it is supposed to host.
 	replicasOnOnlineNode := state.ReplicasOnNode(node.ID)
+	liveNodes := state.LiveNodes
 	for db, shards := range replicasOnOnlineNode {
 		if shardStates, ok := state.ShardStates[db]; ok {
+			shardAssignment := state.ShardAssignments[db]
 			for _, shardID := range shards {
 				shardState := shardStates[shardID]
 				if shardState.State != models.OnlineShard {
-					shardState.State = models.OnlineShard
-					shardState.Leader = node.ID
+					// elect leader of offline shard by elector like node failure, instead of
+					// making the online node leader directly.
+					prevLeader := shardState.Leader
+					m.electShardLeader(shardAssignment, liveNodes, shardID, &shardState)
+					if shardState.State == models.OnlineShard && shardState.Leader != prevLeader {
+						shardState.ElectReason = models.ElectByNodeOnline
+					}
 				}
 				shardStates[shardID] = shardState
 			}
@@ -496,24 +671,8 @@ func (m *stateManager) onNodeFailure(state *models.StorageState, nodeID models.N
 		shardAssignment := state.ShardAssignments[db]
 		shardStates := state.ShardStates[db]
 		for _, shardID := range shards {
-			leader, err := m.elector.ElectLeader(shardAssignment, liveNodes, shardID)
 			shardState := shardStates[shardID]
-			m.shardLeaderStatistics.LeaderElections.Incr()
-			if err != nil {
-				shardState.State = models.OfflineShard
-				shardState.Leader = models.NoLeader
-				m.shardLeaderStatistics.LeaderElectFailures.Incr()
-				m.logger.Warn("elect shard leader err",
-					logger.String("db", shardAssignment.Name),
-					logger.Any("shard", shardID), logger.Error(err))
-			} else {
-				shardState.State = models.OnlineShard
-				shardState.Leader = leader
-				m.logger.Info("elect new leader for shard",
-					logger.String("db", shardAssignment.Name),
-					logger.Any("shard", shardID),
-					logger.Any("leader", leader))
-			}
+			m.electShardLeader(shardAssignment, liveNodes, shardID, &shardState)
 			shardStates[shardID] = shardState
 		}
 	}
@@ -589,8 +748,8 @@ func (m *stateManager) modifyShardAssignment(
 ) error {
 	nodes := make(map[models.NodeID]*models.StatefulNode)
 	if len(shardAssign.Shards) > cfg.NumOfShard { // reduce shardAssign's shards
-		// TODO implement the reduce shards, is needed?
-		panic("not implemented")
+		// reduce shards need merge the data of shards, not supported
+		return constants.ErrReduceShardsNotSupported
 	} else if len(shardAssign.Shards) < cfg.NumOfShard { // add shardAssign's shards
 		liveNodes, err := cluster.GetLiveNodes()
 		if err != nil {
@@ -698,29 +857,84 @@ func (m *stateManager) GetStorageStates() (rs []*models.StorageState) {
 	return
 }
 
+// GetInternalSecret returns the cluster internal secret which signs the token of internal api between nodes,
+// the secret is stored in master state repo, creates it if not exist.
+func (m *stateManager) GetInternalSecret() ([]byte, error) {
+	return statepkg.LoadOrCreateInternalSecret(m.ctx, m.masterRepo)
+}
+
 // initializeShardState initializes the shard state based on shard assignment for storage cluster.
 func (m *stateManager) initializeShardState(storage StorageCluster, shardAssignment *models.ShardAssignment) {
 	storageState := storage.GetState()
 	liveNodes := storageState.LiveNodes
 	shardStates := make(map[models.ShardID]models.ShardState)
+	prevShardStates, ok := storageState.ShardStates[shardAssignment.Name]
+	if !ok {
+		// maybe master failover, use shard states from state repo
+		prevShardStates = m.persistedShardStates[shardAssignment.Name]
+	}
+	delete(m.persistedShardStates, shardAssignment.Name)
 	for shardID, replicas := range shardAssignment.Shards {
-		leader, err := m.elector.ElectLeader(shardAssignment, liveNodes, shardID)
-		shardState := models.ShardState{ID: shardID, Replica: *replicas}
-		m.shardLeaderStatistics.LeaderElections.Incr()
-		if err != nil {
-			shardState.State = models.OfflineShard
-			shardState.Leader = models.NoLeader
-			m.shardLeaderStatistics.LeaderElectFailures.Incr()
-			m.logger.Warn("elect shard leader err",
-				logger.String("db", shardAssignment.Name),
-				logger.Any("shard", shardID), logger.Error(err))
-		} else {
-			shardState.State = models.OnlineShard
-			shardState.Leader = leader
+		shardState := models.ShardState{ID: shardID, Leader: models.NoLeader}
+		if prevShardState, ok := prevShardStates[shardID]; ok {
+			shardState.Leader = prevShardState.Leader
+			shardState.Epoch = prevShardState.Epoch
+			shardState.ElectReason = prevShardState.ElectReason
 		}
+		shardState.Replica = *replicas
+		m.electShardLeader(shardAssignment, liveNodes, shardID, &shardState)
 		shardStates[shardID] = shardState
 	}
 	// TODO set shard assignments
 	storageState.ShardAssignments[shardAssignment.Name] = shardAssignment
 	storageState.ShardStates[shardAssignment.Name] = shardStates
 }
+
+// GetShardState returns the current state of shard by database's name and shard id.
+func (m *stateManager) GetShardState(databaseName string, shardID models.ShardID) (models.ShardState, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	db, ok := m.databases[databaseName]
+	if !ok {
+		return models.ShardState{}, false
+	}
+	storage, ok := m.storages[db.Storage]
+	if !ok {
+		return models.ShardState{}, false
+	}
+	shardState, ok := storage.GetState().ShardStates[databaseName][shardID]
+	return shardState, ok
+}
+
+// electShardLeader elects the leader of shard, increases leader epoch if leader changed.
+func (m *stateManager) electShardLeader(shardAssignment *models.ShardAssignment,
+	liveNodes map[models.NodeID]models.StatefulNode,
+	shardID models.ShardID,
+	shardState *models.ShardState,
+) {
+	m.shardLeaderStatistics.LeaderElections.Incr()
+	leader, reason, err := m.elector.ElectLeader(shardAssignment, liveNodes, shardID, shardState.Leader,
+		m.replicaProgress[shardAssignment.Name])
+	if err != nil {
+		shardState.State = models.OfflineShard
+		shardState.Leader = models.NoLeader
+		m.shardLeaderStatistics.LeaderElectFailures.Incr()
+		m.logger.Warn("elect shard leader err",
+			logger.String("db", shardAssignment.Name),
+			logger.Any("shard", shardID), logger.Error(err))
+		return
+	}
+	shardState.State = models.OnlineShard
+	if shardState.Leader != leader {
+		shardState.Leader = leader
+		shardState.Epoch++
+		shardState.ElectReason = reason
+		m.logger.Info("elect new leader for shard",
+			logger.String("db", shardAssignment.Name),
+			logger.Any("shard", shardID),
+			logger.Any("leader", leader),
+			logger.Int64("epoch", shardState.Epoch),
+			logger.String("reason", string(reason)))
+	}
+}
@@ -27,11 +27,13 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/discovery"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 func TestStateManager_Close(t *testing.T) {
@@ -117,7 +119,10 @@ func TestStateManager_StorageCfg(t *testing.T) {
 	defer func() {
 		ctrl.Finish()
 	}()
-	mgr := NewStateManager(context.TODO(), nil, nil)
+	repo := state.NewMockRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), constants.GetStorageStatePath("/storage/test")).
+		Return(nil, state.ErrNotExist).AnyTimes()
+	mgr := NewStateManager(context.TODO(), repo, nil)
 	mgr1 := mgr.(*stateManager)
 	mgr1.mutex.Lock()
 	shardAssignment := models.NewShardAssignment("test-db")
@@ -215,6 +220,8 @@ func TestStateManager_DatabaseCfg(t *testing.T) {
 		ctrl.Finish()
 	}()
 	repo := state.NewMockRepository(ctrl)
+	repo.EXPECT().Get(gomock.Any(), constants.GetStorageStatePath("/storage/test")).
+		Return(nil, state.ErrNotExist).AnyTimes()
 	mgr := NewStateManager(context.TODO(), repo, nil)
 	mgr1 := mgr.(*stateManager)
 	storage1 := NewMockStorageCluster(ctrl)
@@ -292,6 +299,24 @@ func TestStateManager_DatabaseCfg(t *testing.T) {
 		Key:   "/database/test",
 		Value: data,
 	})
+	// case 7: save assignment into storage err
+	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(encoding.JSONMarshal(&models.ShardAssignment{
+		Shards: map[models.ShardID]*models.Replica{1: nil, 2: nil, 3: nil},
+	}), nil).Times(2)
+	storage1.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.DatabaseConfigChanged,
+		Key:   "/database/test",
+		Value: data,
+	})
+	// case 8: save assignment into storage(restored database)
+	storage1.EXPECT().SaveDatabaseAssignment(gomock.Any(), gomock.Any()).Return(nil)
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.DatabaseConfigChanged,
+		Key:   "/database/test",
+		Value: data,
+	})
 
 	time.Sleep(100 * time.Millisecond)
 	fmt.Println(mgr.GetDatabases())
@@ -322,23 +347,34 @@ func TestStateManager_ShardAssignment(t *testing.T) {
 		Key:   "/shard/assign/test",
 		Value: []byte("valuek"),
 	})
-	// case 2: put state err
+	// case 2: database config not sync(restoring database)
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.ShardAssignmentChanged,
+		Key:   "/shard/assign/restore",
+		Value: encoding.JSONMarshal(&models.ShardAssignment{Name: "restore"}),
+	})
+	// case 3: put state err
 	data := encoding.JSONMarshal(&models.ShardAssignment{
 		Name:   "test",
 		Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{2, 3}}, 2: {Replicas: []models.NodeID{2, 3}}},
 	})
 	storage.EXPECT().GetState().Return(models.NewStorageState("test")).AnyTimes()
-	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(2), nil)
-	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(0), fmt.Errorf("err"))
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), models.ShardID(1), gomock.Any(), gomock.Any()).
+		Return(models.NodeID(2), models.ElectByAssignment, nil)
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), models.ShardID(2), gomock.Any(), gomock.Any()).
+		Return(models.NodeID(0), models.LeaderElectReason(""), fmt.Errorf("err"))
 	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 	mgr.EmitEvent(&discovery.Event{
 		Type:  discovery.ShardAssignmentChanged,
 		Key:   "/shard/assign/test",
 		Value: data,
 	})
-	// case 2: put state err
-	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(2), nil)
-	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.NodeID(0), fmt.Errorf("err"))
+	// case 2: put state err, fetch replica progress because leader of shard 1 is not alive
+	elector.EXPECT().FetchReplicaProgress("test", gomock.Any(), map[models.NodeID]struct{}{2: {}}).Return(nil)
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), models.ShardID(1), gomock.Any(), gomock.Any()).
+		Return(models.NodeID(2), models.ElectByAssignment, nil)
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), models.ShardID(2), gomock.Any(), gomock.Any()).
+		Return(models.NodeID(0), models.LeaderElectReason(""), fmt.Errorf("err"))
 	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 	mgr.EmitEvent(&discovery.Event{
 		Type:  discovery.ShardAssignmentChanged,
@@ -347,6 +383,55 @@ func TestStateManager_ShardAssignment(t *testing.T) {
 	})
 	time.Sleep(100 * time.Millisecond)
 	assert.Len(t, mgr.GetShardAssignments(), 1)
+	// leader not changed, keep epoch
+	shardState, ok := mgr.GetShardState("test", 1)
+	assert.True(t, ok)
+	assert.Equal(t, models.NodeID(2), shardState.Leader)
+	assert.Equal(t, int64(1), shardState.Epoch)
+	assert.Equal(t, models.ElectByAssignment, shardState.ElectReason)
+	shardState, ok = mgr.GetShardState("test", 2)
+	assert.True(t, ok)
+	assert.Equal(t, models.OfflineShard, shardState.State)
+	_, ok = mgr.GetShardState("test", 3)
+	assert.False(t, ok)
+	_, ok = mgr.GetShardState("not-exist", 1)
+	assert.False(t, ok)
+	mgr.Close()
+}
+
+func TestStateManager_RebalanceJob(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	// case 1: unmarshal err
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.RebalanceJobChanged,
+		Key:   "/database/rebalance/test",
+		Value: []byte("value"),
+	})
+	// case 2: job completed
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.RebalanceJobChanged,
+		Key:   "/database/rebalance/test",
+		Value: encoding.JSONMarshal(&models.RebalanceJob{Database: "test", State: models.RebalanceDone}),
+	})
+	// case 3: execute job
+	ch := make(chan struct{})
+	repo.EXPECT().Get(gomock.Any(), constants.GetRebalanceJobPath("test")).
+		DoAndReturn(func(_ context.Context, _ string) ([]byte, error) {
+			close(ch)
+			return nil, fmt.Errorf("err")
+		})
+	mgr.EmitEvent(&discovery.Event{
+		Type:  discovery.RebalanceJobChanged,
+		Key:   "/database/rebalance/test",
+		Value: encoding.JSONMarshal(&models.RebalanceJob{Database: "test", State: models.RebalancePending}),
+	})
+	<-ch
+	time.Sleep(100 * time.Millisecond)
+	assert.False(t, mgr.(*stateManager).rebalancer.IsRunning("test"))
 	mgr.Close()
 }
 
@@ -409,15 +494,14 @@ func TestStateManager_modifyShardAssign(t *testing.T) {
 	storage.EXPECT().Close().AnyTimes()
 	mgr := NewStateManager(context.TODO(), repo, nil)
 	mgr1 := mgr.(*stateManager)
-	// case 1: no impl
-	assert.Panics(t, func() {
-		_ = mgr1.modifyShardAssignment(storage,
-			&models.Database{Name: "test"},
-			&models.ShardAssignment{Shards: map[models.ShardID]*models.Replica{1: {}, 2: {}}})
-	})
+	// case 1: reduce shards not supported
+	err := mgr1.modifyShardAssignment(storage,
+		&models.Database{Name: "test"},
+		&models.ShardAssignment{Shards: map[models.ShardID]*models.Replica{1: {}, 2: {}}})
+	assert.Equal(t, constants.ErrReduceShardsNotSupported, err)
 	// case 2: get live nodes err
 	storage.EXPECT().GetLiveNodes().Return(nil, fmt.Errorf("err"))
-	err := mgr1.modifyShardAssignment(storage,
+	err = mgr1.modifyShardAssignment(storage,
 		&models.Database{Name: "test", NumOfShard: 3},
 		&models.ShardAssignment{Shards: map[models.ShardID]*models.Replica{1: {}, 2: {}}})
 	assert.Error(t, err)
@@ -522,6 +606,97 @@ func TestStateManager_StorageNodeStartup(t *testing.T) {
 	mgr.Close()
 }
 
+func TestStateManager_onNodeStartup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mgr := NewStateManager(context.TODO(), nil, nil)
+	defer mgr.Close()
+	mgr1 := mgr.(*stateManager)
+	elector := NewMockReplicaLeaderElector(ctrl)
+	mgr1.elector = elector
+	newState := func() *models.StorageState {
+		return &models.StorageState{
+			Name:      "test",
+			LiveNodes: map[models.NodeID]models.StatefulNode{1: {ID: 1}, 2: {ID: 2}},
+			ShardStates: map[string]map[models.ShardID]models.ShardState{"test": {
+				1: {ID: 1, State: models.OfflineShard, Leader: models.NoLeader, Epoch: 2},
+				2: {ID: 2, State: models.OnlineShard, Leader: 2, Epoch: 3},
+			}},
+			ShardAssignments: map[string]*models.ShardAssignment{"test": {
+				Name: "test",
+				Shards: map[models.ShardID]*models.Replica{
+					1: {Replicas: []models.NodeID{2, 1}},
+					2: {Replicas: []models.NodeID{2, 1}},
+				},
+			}},
+		}
+	}
+	// case 1: elect leader of offline shard failure, keep shard offline
+	s := newState()
+	elector.EXPECT().ElectLeader(s.ShardAssignments["test"], s.LiveNodes, models.ShardID(1), models.NoLeader, gomock.Any()).
+		Return(models.NoLeader, models.LeaderElectReason(""), fmt.Errorf("err"))
+	mgr1.onNodeStartup(s, models.StatefulNode{ID: 1})
+	assert.Equal(t, models.ShardState{ID: 1, State: models.OfflineShard, Leader: models.NoLeader, Epoch: 2},
+		s.ShardStates["test"][1])
+	// case 2: elect leader of offline shard by elector, online shard not changed
+	s = newState()
+	elector.EXPECT().ElectLeader(s.ShardAssignments["test"], s.LiveNodes, models.ShardID(1), models.NoLeader, gomock.Any()).
+		Return(models.NodeID(2), models.ElectByAssignment, nil)
+	mgr1.onNodeStartup(s, models.StatefulNode{ID: 1})
+	assert.Equal(t, models.ShardState{
+		ID: 1, State: models.OnlineShard, Leader: 2, Epoch: 3, ElectReason: models.ElectByNodeOnline,
+	}, s.ShardStates["test"][1])
+	assert.Equal(t, models.ShardState{ID: 2, State: models.OnlineShard, Leader: 2, Epoch: 3},
+		s.ShardStates["test"][2])
+}
+
+func TestStateManager_PersistedShardStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	storage := NewMockStorageCluster(ctrl)
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	defer mgr.Close()
+	mgr1 := mgr.(*stateManager)
+	elector := NewMockReplicaLeaderElector(ctrl)
+	mgr1.elector = elector
+	path := constants.GetStorageStatePath("test")
+	// case 1: get state err
+	repo.EXPECT().Get(gomock.Any(), path).Return(nil, fmt.Errorf("err"))
+	mgr1.loadPersistedShardStates("test")
+	assert.Empty(t, mgr1.persistedShardStates)
+	// case 2: unmarshal err
+	repo.EXPECT().Get(gomock.Any(), path).Return([]byte("value"), nil)
+	mgr1.loadPersistedShardStates("test")
+	assert.Empty(t, mgr1.persistedShardStates)
+	// case 3: load state
+	persisted := models.NewStorageState("test")
+	persisted.ShardStates["db"] = map[models.ShardID]models.ShardState{
+		1: {ID: 1, Leader: 1, Epoch: 5, State: models.OnlineShard},
+		2: {ID: 2, Leader: 2, Epoch: 3, State: models.OnlineShard},
+	}
+	repo.EXPECT().Get(gomock.Any(), path).Return(encoding.JSONMarshal(persisted), nil)
+	mgr1.loadPersistedShardStates("test")
+	assert.Len(t, mgr1.persistedShardStates, 1)
+	// case 4: keep epoch increasing after master failover
+	storageState := models.NewStorageState("test")
+	storage.EXPECT().GetState().Return(storageState)
+	shardAssignment := models.NewShardAssignment("db")
+	shardAssignment.AddReplica(1, 1)
+	shardAssignment.AddReplica(2, 1)
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), models.ShardID(1), models.NodeID(1), gomock.Any()).
+		Return(models.NodeID(1), models.ElectByAssignment, nil)
+	elector.EXPECT().ElectLeader(gomock.Any(), gomock.Any(), models.ShardID(2), models.NodeID(2), gomock.Any()).
+		Return(models.NodeID(1), models.ElectByAssignment, nil)
+	mgr1.initializeShardState(storage, shardAssignment)
+	assert.Equal(t, int64(5), storageState.ShardStates["db"][1].Epoch)
+	assert.Equal(t, int64(4), storageState.ShardStates["db"][2].Epoch)
+	assert.Equal(t, models.NodeID(1), storageState.ShardStates["db"][2].Leader)
+	assert.Empty(t, mgr1.persistedShardStates)
+}
+
 func TestStateManager_StorageNodeFailure(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -530,10 +705,12 @@ func TestStateManager_StorageNodeFailure(t *testing.T) {
 	repo := state.NewMockRepository(ctrl)
 	storage := NewMockStorageCluster(ctrl)
 	storage.EXPECT().Close().AnyTimes()
+	fetcher := NewMockReplicaStateFetcher(ctrl)
 	mgr := NewStateManager(context.TODO(), repo, nil)
 	mgr1 := mgr.(*stateManager)
 	mgr1.mutex.Lock()
 	mgr1.storages["test"] = storage
+	mgr1.elector = newReplicaLeaderElector(fetcher)
 	mgr1.mutex.Unlock()
 	// case 1: unmarshal node id err
 	mgr.EmitEvent(&discovery.Event{
@@ -543,7 +720,7 @@ func TestStateManager_StorageNodeFailure(t *testing.T) {
 	})
 	// case 2: sync err
 	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
-	storage.EXPECT().GetState().Return(models.NewStorageState("test"))
+	storage.EXPECT().GetState().Return(models.NewStorageState("test")).Times(2)
 	mgr.EmitEvent(&discovery.Event{
 		Type:       discovery.NodeFailure,
 		Key:        "/test/1",
@@ -558,7 +735,7 @@ func TestStateManager_StorageNodeFailure(t *testing.T) {
 		ShardAssignments: map[string]*models.ShardAssignment{"test": {
 			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3, 4}}},
 		}},
-	})
+	}).Times(2)
 	mgr.EmitEvent(&discovery.Event{
 		Type:       discovery.NodeFailure,
 		Key:        "/test/1",
@@ -567,15 +744,23 @@ func TestStateManager_StorageNodeFailure(t *testing.T) {
 	// case 4: change shard state ok, leader elect success
 	shardStates := map[string]map[models.ShardID]models.ShardState{"test": {1: {Leader: 1}}}
 	liveNodes := map[models.NodeID]models.StatefulNode{1: {ID: 1}, 2: {ID: 2}}
+	familyTime := "2022-01-01 10:00:00"
+	familyTimestamp, _ := timeutil.ParseTimestamp(familyTime, timeutil.DataTimeFormat2)
+	// fetch replica progress from live node(exclude offline node) before handling event
+	fetcher.EXPECT().GetReplicaState(&models.StatefulNode{ID: 2}, "test").
+		Return([]models.FamilyLogReplicaState{{ShardID: 1, Leader: 1, FamilyTime: familyTime}}, nil)
+	fetcher.EXPECT().GetReplicaAckIndex(&models.StatefulNode{ID: 2}, "test",
+		models.ShardID(1), models.NodeID(1), familyTimestamp).Return(int64(10), nil)
 	repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	storage.EXPECT().GetState().Return(&models.StorageState{
 		Name:        "test",
 		LiveNodes:   liveNodes,
 		ShardStates: shardStates,
 		ShardAssignments: map[string]*models.ShardAssignment{"test": {
+			Name:   "test",
 			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3, 4}}},
 		}},
-	})
+	}).Times(2)
 	mgr.EmitEvent(&discovery.Event{
 		Type:       discovery.NodeFailure,
 		Key:        "/test/1",
@@ -586,8 +771,114 @@ func TestStateManager_StorageNodeFailure(t *testing.T) {
 	// get new shard state
 	mgr1.mutex.Lock()
 	assert.Equal(t, shardStates["test"][1].Leader, models.NodeID(2))
+	assert.Equal(t, int64(1), shardStates["test"][1].Epoch)
+	assert.Equal(t, models.ElectByLogProgress, shardStates["test"][1].ElectReason)
 	assert.Len(t, liveNodes, 1)
 	assert.Equal(t, liveNodes[models.NodeID(2)].ID, models.NodeID(2))
 	mgr1.mutex.Unlock()
 	mgr.Close()
 }
+
+func TestStateManager_fetchReplicaProgress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := NewMockStorageCluster(ctrl)
+	elector := NewMockReplicaLeaderElector(ctrl)
+	mgr := NewStateManager(context.TODO(), nil, nil)
+	defer mgr.Close()
+	mgr1 := mgr.(*stateManager)
+	mgr1.elector = elector
+	mgr1.storages["storage"] = storage
+	mgr1.databases["db"] = &models.Database{Storage: "storage"}
+	mgr1.persistedShardStates["db"] = map[models.ShardID]models.ShardState{1: {ID: 1, Leader: 3}}
+	storageState := models.NewStorageState("storage")
+	storageState.LiveNodes = map[models.NodeID]models.StatefulNode{1: {ID: 1}, 2: {ID: 2}}
+	storageState.ShardStates["db2"] = map[models.ShardID]models.ShardState{
+		1: {ID: 1, Leader: 1},
+		2: {ID: 2, Leader: 2},
+		3: {ID: 3, Leader: models.NoLeader},
+	}
+	storage.EXPECT().GetState().Return(storageState).AnyTimes()
+	storage.EXPECT().Close().AnyTimes()
+	liveNodes := map[models.NodeID]models.StatefulNode{2: {ID: 2}}
+
+	cases := []struct {
+		name    string
+		event   *discovery.Event
+		prepare func()
+		len     int
+	}{
+		{
+			name:  "no leader election",
+			event: &discovery.Event{Type: discovery.DatabaseConfigDeletion},
+		},
+		{
+			name:  "parse offline node failure",
+			event: &discovery.Event{Type: discovery.NodeFailure, Key: "/test/a"},
+		},
+		{
+			name:  "storage not found",
+			event: &discovery.Event{Type: discovery.NodeFailure, Key: "/test/1", Attributes: map[string]string{storageNameKey: "test"}},
+		},
+		{
+			name:  "unmarshal shard assignment failure",
+			event: &discovery.Event{Type: discovery.ShardAssignmentChanged, Value: []byte("abc")},
+		},
+		{
+			name: "database not found",
+			event: &discovery.Event{
+				Type:  discovery.ShardAssignmentChanged,
+				Value: encoding.JSONMarshal(&models.ShardAssignment{Name: "not-exist"}),
+			},
+		},
+		{
+			name: "node failure, fetch progress of dead leader",
+			event: &discovery.Event{
+				Type:       discovery.NodeFailure,
+				Key:        "/test/1",
+				Attributes: map[string]string{storageNameKey: "storage"},
+			},
+			prepare: func() {
+				elector.EXPECT().FetchReplicaProgress("db2", liveNodes, map[models.NodeID]struct{}{1: {}}).
+					Return(ReplicaProgress{})
+			},
+			len: 1,
+		},
+		{
+			name: "shard assignment changed, fetch progress based on persisted shard states",
+			event: &discovery.Event{
+				Type:  discovery.ShardAssignmentChanged,
+				Value: encoding.JSONMarshal(&models.ShardAssignment{Name: "db"}),
+			},
+			prepare: func() {
+				elector.EXPECT().FetchReplicaProgress("db", storageState.LiveNodes, map[models.NodeID]struct{}{3: {}}).
+					Return(ReplicaProgress{})
+			},
+			len: 1,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			assert.Len(t, mgr1.fetchReplicaProgress(tt.event), tt.len)
+		})
+	}
+}
+
+func TestStateManager_GetInternalSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := state.NewMockRepository(ctrl)
+	mgr := NewStateManager(context.TODO(), repo, nil)
+	defer mgr.Close()
+
+	repo.EXPECT().Get(gomock.Any(), constants.InternalSecretPath).Return([]byte("secret"), nil)
+	secret, err := mgr.GetInternalSecret()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("secret"), secret)
+}
@@ -106,6 +106,14 @@ func (c *storageCluster) GetConfig() *config.StorageCluster {
 
 // Start starts the state machine for storage state change.
 func (c *storageCluster) Start() error {
+	// sync cluster internal secret into storage state repo, storage nodes validate the request of internal api by it.
+	secret, err := c.stateMgr.GetInternalSecret()
+	if err != nil {
+		return err
+	}
+	if err = c.storageRepo.Put(c.ctx, constants.InternalSecretPath, secret); err != nil {
+		return err
+	}
 	sm, err := c.stateMgr.GetStateMachineFactory().
 		createStorageNodeStateMachine(c.cfg.Config.Namespace, discovery.NewFactory(c.storageRepo))
 	if err != nil {
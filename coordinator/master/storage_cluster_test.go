@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/discovery"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
@@ -108,10 +109,20 @@ func TestStorageCluster_Start(t *testing.T) {
 		storageRepo: repo,
 		logger:      logger.GetLogger("Master", "Test"),
 	}
-	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	stateMgr.EXPECT().GetInternalSecret().Return(nil, fmt.Errorf("err"))
 	err := sc.Start()
 	assert.Error(t, err)
 
+	stateMgr.EXPECT().GetInternalSecret().Return([]byte("secret"), nil).AnyTimes()
+	repo.EXPECT().Put(gomock.Any(), constants.InternalSecretPath, []byte("secret")).Return(fmt.Errorf("err"))
+	err = sc.Start()
+	assert.Error(t, err)
+
+	repo.EXPECT().Put(gomock.Any(), constants.InternalSecretPath, []byte("secret")).Return(nil).AnyTimes()
+	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+	err = sc.Start()
+	assert.Error(t, err)
+
 	repo.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil)
 	ch := make(<-chan *state.Event)
 	repo.EXPECT().WatchPrefix(gomock.Any(), gomock.Any(), gomock.Any()).Return(ch)
@@ -178,6 +178,17 @@ func (m *stateManager) onShardAssignmentChange(key string, data []byte) error {
 	if len(shardIDs) == 0 {
 		return constants.ErrShardNotFound
 	}
+	if param.Option != nil {
+		// apply altered database option online if database exist
+		if db, ok := m.engine.GetDatabase(param.ShardAssignment.Name); ok {
+			if err := db.UpdateOption(param.Option); err != nil {
+				m.logger.Error("update database option err",
+					logger.String("db", param.ShardAssignment.Name),
+					logger.Error(err))
+				return err
+			}
+		}
+	}
 	if err := m.engine.CreateShards(
 		param.ShardAssignment.Name,
 		param.Option,
@@ -29,6 +29,7 @@ import (
 	"github.com/lindb/lindb/coordinator/discovery"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/tsdb"
 )
@@ -159,6 +160,30 @@ func TestStateManager_OnShardAssignment(t *testing.T) {
 			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3}}},
 		}}),
 	})
+	// case 1: update database option err
+	db := tsdb.NewMockDatabase(ctrl)
+	opt := &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}}
+	engine.EXPECT().GetDatabase("test").Return(db, true).MaxTimes(2)
+	db.EXPECT().UpdateOption(gomock.Any()).Return(fmt.Errorf("err"))
+	mgr.EmitEvent(&discovery.Event{
+		Type: discovery.ShardAssignmentChanged,
+		Key:  "/shard/assign/test",
+		Value: encoding.JSONMarshal(&models.DatabaseAssignment{ShardAssignment: &models.ShardAssignment{
+			Name:   "test",
+			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3}}},
+		}, Option: opt}),
+	})
+	// case 1: update database option successfully
+	db.EXPECT().UpdateOption(gomock.Any()).Return(nil)
+	engine.EXPECT().CreateShards(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	mgr.EmitEvent(&discovery.Event{
+		Type: discovery.ShardAssignmentChanged,
+		Key:  "/shard/assign/test",
+		Value: encoding.JSONMarshal(&models.DatabaseAssignment{ShardAssignment: &models.ShardAssignment{
+			Name:   "test",
+			Shards: map[models.ShardID]*models.Replica{1: {Replicas: []models.NodeID{1, 2, 3}}},
+		}, Option: opt}),
+	})
 	// case 1: unmarshal shard assign err
 	mgr.EmitEvent(&discovery.Event{
 		Type:  discovery.ShardAssignmentChanged,
@@ -36,6 +36,9 @@ type GroupingContext interface {
 	// ScanTagValueIDs scans grouping context by high key/container of series ids,
 	// then returns grouped tag value ids for each tag key
 	ScanTagValueIDs(highKey uint16, container roaring.Container) []*roaring.Bitmap
+	// ScanSeriesTagValueIDs scans tag value id of each series(series ids=>tag value ids)
+	// by the high key of series id and the container includes low keys of series id
+	ScanSeriesTagValueIDs(ctx *DataLoadContext, fn func(seriesIdxFromQuery uint16, tagKeyIDIdx int, tagValueID uint32))
 }
 
 // GroupingScanner represents the scanner which scans the group by data by high key of series id
@@ -114,6 +117,14 @@ func (g *groupingContext) BuildGroup(ctx *DataLoadContext) {
 	}
 }
 
+// ScanSeriesTagValueIDs scans tag value id of each series(series ids=>tag value ids)
+// by the high key of series id and the container includes low keys of series id
+func (g *groupingContext) ScanSeriesTagValueIDs(ctx *DataLoadContext,
+	fn func(seriesIdxFromQuery uint16, tagKeyIDIdx int, tagValueID uint32),
+) {
+	g.scanGroupingTags(ctx, fn)
+}
+
 // buildGroupForMultiTags builds grouping for multi-tags.
 func (g *groupingContext) buildGroupForMultiTags(ctx *DataLoadContext) {
 	tagSize := len(g.tagKeys)
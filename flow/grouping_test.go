@@ -145,3 +145,24 @@ func TestGroupingContext_ScanTagValueIDs(t *testing.T) {
 	result = ctx.ScanTagValueIDs(1, roaring.BitmapOf(1, 2, 6, 10).GetContainerAtIndex(0))
 	assert.Equal(t, roaring.New(), result[0])
 }
+
+func TestGroupingContext_ScanSeriesTagValueIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scanner := NewMockGroupingScanner(ctrl)
+	ctx := NewGroupContext([]tag.KeyID{1}, map[tag.KeyID][]GroupingScanner{1: {scanner}})
+	scanner.EXPECT().GetSeriesAndTagValue(uint16(1)).
+		Return(roaring.BitmapOf(1, 2, 3, 10).GetContainerAtIndex(0), []uint32{10, 20, 30, 10})
+	dataLoadCtx := &DataLoadContext{
+		SeriesIDHighKey:       1,
+		LowSeriesIDsContainer: roaring.BitmapOf(1, 2, 6, 10).GetContainerAtIndex(0),
+	}
+	dataLoadCtx.Grouping()
+	rs := make(map[uint16]uint32)
+	ctx.ScanSeriesTagValueIDs(dataLoadCtx, func(seriesIdxFromQuery uint16, tagKeyIDIdx int, tagValueID uint32) {
+		assert.Equal(t, 0, tagKeyIDIdx)
+		rs[seriesIdxFromQuery+dataLoadCtx.MinSeriesID] = tagValueID
+	})
+	assert.Equal(t, map[uint16]uint32{1: 10, 2: 20, 10: 10}, rs)
+}
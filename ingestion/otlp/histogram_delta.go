@@ -0,0 +1,222 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	protoOTLPV1 "github.com/lindb/lindb/proto/gen/v1/otlp"
+)
+
+const (
+	// maxCumulativeHistogramSeries represents the max num. of cumulative histogram series kept for delta converting.
+	maxCumulativeHistogramSeries = 100000
+	// cumulativeHistogramTTL represents the ttl of cumulative histogram series which not receive data point.
+	cumulativeHistogramTTL = time.Hour
+)
+
+// for testing
+var (
+	nowFunc = time.Now
+)
+
+// cumulativeHistograms converts cumulative histogram data points of all OTLP requests(http/grpc) received by this broker.
+//
+// NOTE: histogram field of LinDB is delta based, so cumulative histogram is converted to delta by the previous data point
+// of same series, which is kept in broker's memory:
+// 1. the first data point of series is kept as baseline, not written;
+// 2. if series is reset(start time changed or count decreased), data point is written as delta since reset;
+// 3. min/max of cumulative data point are kept as is, they are the min/max since start time;
+// 4. series of same exporter must be sent to the same broker, otherwise deltas are lost.
+var cumulativeHistograms = newHistogramDeltaConverter(maxCumulativeHistogramSeries, cumulativeHistogramTTL)
+
+// cumulativeHistogram represents the previous data point of cumulative histogram series.
+type cumulativeHistogram struct {
+	startTime    uint64
+	time         uint64
+	count        uint64
+	sum          float64
+	bucketCounts []uint64
+	lastSeen     time.Time
+}
+
+// histogramDeltaConverter converts cumulative histogram data point to delta by the previous data point of series.
+type histogramDeltaConverter struct {
+	maxSeries int
+	ttl       time.Duration
+	series    map[string]*cumulativeHistogram
+	lastEvict time.Time
+
+	mutex sync.Mutex
+}
+
+// newHistogramDeltaConverter creates a histogram delta converter.
+func newHistogramDeltaConverter(maxSeries int, ttl time.Duration) *histogramDeltaConverter {
+	return &histogramDeltaConverter{
+		maxSeries: maxSeries,
+		ttl:       ttl,
+		series:    make(map[string]*cumulativeHistogram),
+	}
+}
+
+// ToDelta converts cumulative histogram data point to delta, returns nil if data point is kept as baseline,
+// returns rejected if data point is stale or too many series.
+func (c *histogramDeltaConverter) ToDelta(
+	seriesKey string,
+	point *protoOTLPV1.HistogramDataPoint,
+) (delta *protoOTLPV1.HistogramDataPoint, rejected bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := nowFunc()
+	prev, ok := c.series[seriesKey]
+	if !ok {
+		if len(c.series) >= c.maxSeries {
+			c.evict(now)
+		}
+		if len(c.series) >= c.maxSeries {
+			otlpIngestionStatistics.CumulativeHistogramDropped.Incr()
+			return nil, true
+		}
+		c.series[seriesKey] = newCumulativeHistogram(point, now)
+		otlpIngestionStatistics.CumulativeHistogramBaseline.Incr()
+		return nil, false
+	}
+	if point.StartTimeUnixNano == prev.startTime && point.TimeUnixNano != 0 && point.TimeUnixNano <= prev.time {
+		// duplicate or out of order data point
+		otlpIngestionStatistics.CumulativeHistogramDropped.Incr()
+		return nil, true
+	}
+	delta = &protoOTLPV1.HistogramDataPoint{
+		Attributes:        point.Attributes,
+		StartTimeUnixNano: prev.time,
+		TimeUnixNano:      point.TimeUnixNano,
+		Count:             point.Count,
+		Sum:               point.Sum,
+		BucketCounts:      point.BucketCounts,
+		ExplicitBounds:    point.ExplicitBounds,
+		Flags:             point.Flags,
+		Min:               point.Min,
+		Max:               point.Max,
+	}
+	if prev.isReset(point) {
+		// counts since reset
+		delta.StartTimeUnixNano = point.StartTimeUnixNano
+		otlpIngestionStatistics.CumulativeHistogramReset.Incr()
+	} else {
+		delta.Count = point.Count - prev.count
+		delta.Sum = point.Sum - prev.sum
+		delta.BucketCounts = make([]uint64, len(point.BucketCounts))
+		for idx, count := range point.BucketCounts {
+			delta.BucketCounts[idx] = count - prev.bucketCounts[idx]
+		}
+	}
+	c.series[seriesKey] = newCumulativeHistogram(point, now)
+	return delta, false
+}
+
+// evict removes the series which not receive data point in ttl, evicts at most once per minute.
+func (c *histogramDeltaConverter) evict(now time.Time) {
+	if now.Sub(c.lastEvict) < time.Minute {
+		return
+	}
+	c.lastEvict = now
+	for key, s := range c.series {
+		if now.Sub(s.lastSeen) > c.ttl {
+			delete(c.series, key)
+		}
+	}
+}
+
+// newCumulativeHistogram creates the previous data point of cumulative histogram series.
+func newCumulativeHistogram(point *protoOTLPV1.HistogramDataPoint, now time.Time) *cumulativeHistogram {
+	return &cumulativeHistogram{
+		startTime:    point.StartTimeUnixNano,
+		time:         point.TimeUnixNano,
+		count:        point.Count,
+		sum:          point.Sum,
+		bucketCounts: append([]uint64(nil), point.BucketCounts...),
+		lastSeen:     now,
+	}
+}
+
+// isReset checks if cumulative histogram series is reset, start time changed or counts decreased.
+func (h *cumulativeHistogram) isReset(point *protoOTLPV1.HistogramDataPoint) bool {
+	if point.StartTimeUnixNano != h.startTime || point.Count < h.count || len(point.BucketCounts) != len(h.bucketCounts) {
+		return true
+	}
+	for idx, count := range point.BucketCounts {
+		if count < h.bucketCounts[idx] {
+			return true
+		}
+	}
+	return false
+}
+
+// histogramSeriesKey returns the series key of histogram data point,
+// includes namespace, metric name, tags(point's attribute overrides same key) and explicit bounds.
+func (ctx *rowContext) histogramSeriesKey(point *protoOTLPV1.HistogramDataPoint) string {
+	pointTags := appendTags(nil, point.Attributes)
+	tags := make([]string, 0, len(ctx.baseTags)+len(pointTags)+len(ctx.enrichedTags))
+	for _, base := range ctx.baseTags {
+		if !hasTag(pointTags, base.key) {
+			tags = append(tags, base.key+"="+base.value)
+		}
+	}
+	for _, pointTag := range pointTags {
+		tags = append(tags, pointTag.key+"="+pointTag.value)
+	}
+	for _, enrichedTag := range ctx.enrichedTags {
+		tags = append(tags, string(enrichedTag.Key)+"="+string(enrichedTag.Value))
+	}
+	sort.Strings(tags)
+	var sb strings.Builder
+	sb.WriteString(ctx.namespace)
+	sb.WriteByte(0)
+	sb.WriteString(ctx.metricName)
+	for _, t := range tags {
+		sb.WriteByte(0)
+		sb.WriteString(t)
+	}
+	for _, bound := range point.ExplicitBounds {
+		sb.WriteByte(0)
+		sb.WriteString(strconv.FormatFloat(bound, 'f', -1, 64))
+	}
+	return sb.String()
+}
+
+// toDeltaHistogramPoints converts cumulative histogram data points to delta, returns the number of rejected data points.
+func (ctx *rowContext) toDeltaHistogramPoints(
+	points []*protoOTLPV1.HistogramDataPoint,
+) (deltas []*protoOTLPV1.HistogramDataPoint, rejected int64) {
+	for _, point := range points {
+		delta, reject := cumulativeHistograms.ToDelta(ctx.histogramSeriesKey(point), point)
+		if reject {
+			rejected++
+			continue
+		}
+		if delta != nil {
+			deltas = append(deltas, delta)
+		}
+	}
+	return deltas, rejected
+}
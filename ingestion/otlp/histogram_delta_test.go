@@ -0,0 +1,130 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	protoOTLPV1 "github.com/lindb/lindb/proto/gen/v1/otlp"
+	"github.com/lindb/lindb/series/tag"
+)
+
+func TestHistogramDeltaConverter_ToDelta(t *testing.T) {
+	now := time.Now()
+	defer func() {
+		nowFunc = time.Now
+	}()
+	nowFunc = func() time.Time {
+		return now
+	}
+	newPoint := func(start, ts, count uint64, sum float64, buckets ...uint64) *protoOTLPV1.HistogramDataPoint {
+		return &protoOTLPV1.HistogramDataPoint{
+			StartTimeUnixNano: start,
+			TimeUnixNano:      ts,
+			Count:             count,
+			Sum:               sum,
+			BucketCounts:      buckets,
+			ExplicitBounds:    []float64{0.1},
+		}
+	}
+	c := newHistogramDeltaConverter(1, time.Hour)
+	cases := []struct {
+		name     string
+		key      string
+		point    *protoOTLPV1.HistogramDataPoint
+		delta    *protoOTLPV1.HistogramDataPoint
+		rejected bool
+	}{
+		{
+			name:  "baseline",
+			key:   "a",
+			point: newPoint(1, 10, 2, 1, 1, 1),
+		},
+		{
+			name:     "too many series",
+			key:      "b",
+			point:    newPoint(1, 10, 2, 1, 1, 1),
+			rejected: true,
+		},
+		{
+			name:  "delta",
+			key:   "a",
+			point: newPoint(1, 20, 5, 4, 3, 2),
+			delta: newPoint(10, 20, 3, 3, 2, 1),
+		},
+		{
+			name:     "out of order",
+			key:      "a",
+			point:    newPoint(1, 15, 6, 5, 3, 3),
+			rejected: true,
+		},
+		{
+			name:  "count decreased",
+			key:   "a",
+			point: newPoint(1, 30, 1, 1, 1, 0),
+			delta: newPoint(1, 30, 1, 1, 1, 0),
+		},
+		{
+			name:  "start time changed",
+			key:   "a",
+			point: newPoint(25, 40, 4, 2, 2, 2),
+			delta: newPoint(25, 40, 4, 2, 2, 2),
+		},
+		{
+			name:  "bucket decreased",
+			key:   "a",
+			point: newPoint(25, 50, 4, 2, 1, 3),
+			delta: newPoint(25, 50, 4, 2, 1, 3),
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			delta, rejected := c.ToDelta(tt.key, tt.point)
+			assert.Equal(t, tt.rejected, rejected)
+			assert.Equal(t, tt.delta, delta)
+		})
+	}
+	// evict expired series
+	now = now.Add(2 * time.Hour)
+	delta, rejected := c.ToDelta("b", newPoint(1, 10, 2, 1, 1, 1))
+	assert.Nil(t, delta)
+	assert.False(t, rejected)
+	// evict at most once per minute
+	now = now.Add(2 * time.Hour)
+	c.lastEvict = now
+	_, rejected = c.ToDelta("c", newPoint(1, 10, 2, 1, 1, 1))
+	assert.True(t, rejected)
+}
+
+func TestRowContext_histogramSeriesKey(t *testing.T) {
+	ctx := &rowContext{
+		namespace:    "ns",
+		metricName:   "latency",
+		baseTags:     []tagPair{{key: "host", value: "h1"}, {key: "env", value: "prod"}},
+		enrichedTags: tag.Tags{tag.NewTag([]byte("dc"), []byte("sh"))},
+	}
+	point := &protoOTLPV1.HistogramDataPoint{
+		Attributes:     newAttributes("env", "dev"),
+		ExplicitBounds: []float64{0.1, 1},
+	}
+	assert.Equal(t, "ns\x00latency\x00dc=sh\x00env=dev\x00host=h1\x000.1\x001", ctx.histogramSeriesKey(point))
+}
@@ -0,0 +1,333 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	commonseries "github.com/lindb/common/series"
+
+	"github.com/lindb/lindb/constants"
+	ingestCommon "github.com/lindb/lindb/ingestion/common"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/pkg/timeutil"
+	protoOTLPV1 "github.com/lindb/lindb/proto/gen/v1/otlp"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+)
+
+const (
+	// ValueField represents the field name of sum/gauge data point.
+	ValueField = "value"
+)
+
+var (
+	otlpIngestionStatistics = metrics.NewOTLPIngestionStatistics()
+)
+
+// DecodeRequest decodes OTLP/HTTP metrics export request(protobuf or json encoding).
+// https://opentelemetry.io/docs/specs/otlp/#otlphttp
+func DecodeRequest(req *http.Request) (*protoOTLPV1.ExportMetricsServiceRequest, error) {
+	var reader = req.Body
+	if strings.EqualFold(req.Header.Get("Content-Encoding"), "gzip") {
+		gzipReader, err := ingestCommon.GetGzipReader(req.Body)
+		if err != nil {
+			otlpIngestionStatistics.CorruptedData.Incr()
+			return nil, fmt.Errorf("ingestion corrupted gzip data: %w", err)
+		}
+		defer ingestCommon.PutGzipReader(gzipReader)
+		reader = gzipReader
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	otlpIngestionStatistics.ReadBytes.Add(float64(len(data)))
+
+	exportReq := &protoOTLPV1.ExportMetricsServiceRequest{}
+	if IsJSON(req) {
+		err = (&jsonpb.Unmarshaler{AllowUnknownFields: true}).Unmarshal(bytes.NewReader(data), exportReq)
+	} else {
+		err = exportReq.Unmarshal(data)
+	}
+	if err != nil {
+		otlpIngestionStatistics.CorruptedData.Incr()
+		return nil, err
+	}
+	return exportReq, nil
+}
+
+// IsJSON checks if OTLP/HTTP request is json encoding.
+func IsJSON(req *http.Request) bool {
+	return strings.HasPrefix(strings.ToLower(req.Header.Get("Content-Type")), constants.ContentTypeJSON)
+}
+
+// NewExportResponse creates OTLP metrics export response, sets partial success if some data points rejected.
+func NewExportResponse(rejected int64) *protoOTLPV1.ExportMetricsServiceResponse {
+	resp := &protoOTLPV1.ExportMetricsServiceResponse{}
+	if rejected > 0 {
+		resp.PartialSuccess = &protoOTLPV1.ExportMetricsPartialSuccess{
+			RejectedDataPoints: rejected,
+			ErrorMessage:       fmt.Sprintf("%d data points rejected, invalid or not supported", rejected),
+		}
+	}
+	return resp
+}
+
+// ParseRequest converts OTLP metrics export request to LinDB rows, returns rows and the number of rejected data points.
+//
+// resource/scope/data point attributes are mapped to tags(data point's attribute overrides same key),
+// metric name is mapped to metric name,
+// 1. gauge => Last field named "value";
+// 2. sum with delta temporality => DeltaSum field named "value";
+// 3. sum with cumulative temporality => Last field named "value", use increase function when query;
+// 4. explicit-bucket histogram with delta temporality => histogram compound field;
+// 5. explicit-bucket histogram with cumulative temporality => converted to delta by previous data point of series,
+// then histogram compound field, first data point of series is kept as baseline(see cumulativeHistograms).
+func ParseRequest(
+	exportReq *protoOTLPV1.ExportMetricsServiceRequest,
+	enrichedTags tag.Tags,
+	namespace string,
+) (
+	batch *metric.BrokerBatchRows, rejected int64,
+) {
+	batch = metric.NewBrokerBatchRows()
+	rowBuilder, releaseFunc := commonseries.NewRowBuilder()
+	defer releaseFunc(rowBuilder)
+
+	var scopeTags []tagPair
+	for _, rm := range exportReq.ResourceMetrics {
+		resourceTags := appendTags(nil, rm.GetResource().GetAttributes())
+		for _, sm := range rm.ScopeMetrics {
+			scopeTags = appendTags(append(scopeTags[:0], resourceTags...), sm.GetScope().GetAttributes())
+			for _, m := range sm.Metrics {
+				ctx := &rowContext{
+					rowBuilder:   rowBuilder,
+					namespace:    namespace,
+					metricName:   m.Name,
+					baseTags:     scopeTags,
+					enrichedTags: enrichedTags,
+				}
+				switch data := m.Data.(type) {
+				case *protoOTLPV1.Metric_Gauge:
+					rejected += ctx.appendNumberPoints(batch, data.Gauge.DataPoints, flatMetricsV1.SimpleFieldTypeLast)
+				case *protoOTLPV1.Metric_Sum:
+					fieldType := flatMetricsV1.SimpleFieldTypeLast
+					if data.Sum.AggregationTemporality == protoOTLPV1.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA {
+						fieldType = flatMetricsV1.SimpleFieldTypeDeltaSum
+					}
+					rejected += ctx.appendNumberPoints(batch, data.Sum.DataPoints, fieldType)
+				case *protoOTLPV1.Metric_Histogram:
+					points := data.Histogram.DataPoints
+					if data.Histogram.AggregationTemporality == protoOTLPV1.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE {
+						var dropped int64
+						points, dropped = ctx.toDeltaHistogramPoints(points)
+						rejected += dropped
+					}
+					rejected += ctx.appendHistogramPoints(batch, points)
+				default:
+					// exponential histogram/summary or no data
+					rejected++
+				}
+			}
+		}
+	}
+	otlpIngestionStatistics.DroppedMetrics.Add(float64(rejected))
+	otlpIngestionStatistics.IngestedMetrics.Add(float64(batch.Len()))
+	return batch, rejected
+}
+
+// tagPair represents the tag converted from OTLP attribute.
+type tagPair struct {
+	key, value string
+}
+
+// rowContext represents the context of converting data points of one metric.
+type rowContext struct {
+	rowBuilder   *commonseries.RowBuilder
+	namespace    string
+	metricName   string
+	baseTags     []tagPair
+	enrichedTags tag.Tags
+}
+
+// appendNumberPoints converts number data points of sum/gauge to rows, returns the number of rejected data points.
+func (ctx *rowContext) appendNumberPoints(
+	batch *metric.BrokerBatchRows,
+	points []*protoOTLPV1.NumberDataPoint,
+	fieldType flatMetricsV1.SimpleFieldType,
+) (rejected int64) {
+	for _, point := range points {
+		var value float64
+		switch v := point.Value.(type) {
+		case *protoOTLPV1.NumberDataPoint_AsDouble:
+			value = v.AsDouble
+		case *protoOTLPV1.NumberDataPoint_AsInt:
+			value = float64(v.AsInt)
+		default:
+			rejected++
+			continue
+		}
+		if err := ctx.buildRowPrefix(point.Attributes, point.TimeUnixNano); err != nil {
+			rejected++
+			continue
+		}
+		if err := ctx.rowBuilder.AddSimpleField([]byte(ValueField), fieldType, value); err != nil {
+			rejected++
+			continue
+		}
+		if !appendRow(batch, ctx.rowBuilder) {
+			rejected++
+		}
+	}
+	return rejected
+}
+
+// appendHistogramPoints converts explicit-bucket histogram data points to rows, returns the number of rejected data points.
+func (ctx *rowContext) appendHistogramPoints(
+	batch *metric.BrokerBatchRows,
+	points []*protoOTLPV1.HistogramDataPoint,
+) (rejected int64) {
+	for _, point := range points {
+		if err := ctx.buildRowPrefix(point.Attributes, point.TimeUnixNano); err != nil {
+			rejected++
+			continue
+		}
+		if err := addHistogram(ctx.rowBuilder, point); err != nil {
+			rejected++
+			continue
+		}
+		if !appendRow(batch, ctx.rowBuilder) {
+			rejected++
+		}
+	}
+	return rejected
+}
+
+// buildRowPrefix resets row builder, then sets namespace/metric name/tags/timestamp of row.
+func (ctx *rowContext) buildRowPrefix(attributes []*protoOTLPV1.KeyValue, timeUnixNano uint64) error {
+	rowBuilder := ctx.rowBuilder
+	rowBuilder.Reset()
+	rowBuilder.AddNameSpace(strutil.String2ByteSlice(ctx.namespace))
+	rowBuilder.AddMetricName(strutil.String2ByteSlice(ctx.metricName))
+	if timeUnixNano == 0 {
+		rowBuilder.AddTimestamp(timeutil.Now())
+	} else {
+		rowBuilder.AddTimestamp(int64(timeUnixNano / 1e6))
+	}
+	pointTags := appendTags(nil, attributes)
+	for _, base := range ctx.baseTags {
+		if hasTag(pointTags, base.key) {
+			continue
+		}
+		if err := rowBuilder.AddTag(strutil.String2ByteSlice(base.key), strutil.String2ByteSlice(base.value)); err != nil {
+			return err
+		}
+	}
+	for _, pointTag := range pointTags {
+		if err := rowBuilder.AddTag(strutil.String2ByteSlice(pointTag.key), strutil.String2ByteSlice(pointTag.value)); err != nil {
+			return err
+		}
+	}
+	for _, enrichedTag := range ctx.enrichedTags {
+		if err := rowBuilder.AddTag(enrichedTag.Key, enrichedTag.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addHistogram converts OTLP explicit-bucket histogram to LinDB histogram compound field,
+// bucket counts of OTLP are not cumulative, the last bucket's upper bound is +Inf.
+func addHistogram(rowBuilder *commonseries.RowBuilder, point *protoOTLPV1.HistogramDataPoint) error {
+	if len(point.BucketCounts) != len(point.ExplicitBounds)+1 {
+		return fmt.Errorf("histogram bucket counts: %d not match explicit bounds: %d",
+			len(point.BucketCounts), len(point.ExplicitBounds))
+	}
+	bounds := make([]float64, 0, len(point.BucketCounts))
+	bounds = append(bounds, point.ExplicitBounds...)
+	bounds = append(bounds, math.Inf(1))
+	values := make([]float64, len(point.BucketCounts))
+	for idx, count := range point.BucketCounts {
+		values[idx] = float64(count)
+	}
+	if err := rowBuilder.AddCompoundFieldData(values, bounds); err != nil {
+		return err
+	}
+	return rowBuilder.AddCompoundFieldMMSC(point.Min, point.Max, point.Sum, float64(point.Count))
+}
+
+// appendRow appends the row built by builder into batch, returns if append successfully.
+func appendRow(batch *metric.BrokerBatchRows, rowBuilder *commonseries.RowBuilder) bool {
+	return batch.TryAppend(func(row *metric.BrokerRow) error {
+		data, err := rowBuilder.Build()
+		if err != nil {
+			return err
+		}
+		row.FromBlock(data)
+		return nil
+	}) == nil
+}
+
+// appendTags converts OTLP attributes to tags, ignores attribute which value is empty or not primitive type.
+func appendTags(tags []tagPair, attributes []*protoOTLPV1.KeyValue) []tagPair {
+	for _, attr := range attributes {
+		value := attributeValue(attr.Value)
+		if attr.Key == "" || value == "" {
+			continue
+		}
+		tags = append(tags, tagPair{key: attr.Key, value: value})
+	}
+	return tags
+}
+
+// hasTag checks if tags include the tag key.
+func hasTag(tags []tagPair, key string) bool {
+	for _, t := range tags {
+		if t.key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// attributeValue returns the string value of primitive OTLP attribute value.
+func attributeValue(value *protoOTLPV1.AnyValue) string {
+	switch v := value.GetValue().(type) {
+	case *protoOTLPV1.AnyValue_StringValue:
+		return v.StringValue
+	case *protoOTLPV1.AnyValue_BoolValue:
+		return strconv.FormatBool(v.BoolValue)
+	case *protoOTLPV1.AnyValue_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	case *protoOTLPV1.AnyValue_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
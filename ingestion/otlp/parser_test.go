@@ -0,0 +1,261 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+
+	protoOTLPV1 "github.com/lindb/lindb/proto/gen/v1/otlp"
+	"github.com/lindb/lindb/series/tag"
+)
+
+func newAttributes(kvs ...string) []*protoOTLPV1.KeyValue {
+	var attributes []*protoOTLPV1.KeyValue
+	for i := 0; i < len(kvs); i += 2 {
+		attributes = append(attributes, &protoOTLPV1.KeyValue{
+			Key:   kvs[i],
+			Value: &protoOTLPV1.AnyValue{Value: &protoOTLPV1.AnyValue_StringValue{StringValue: kvs[i+1]}},
+		})
+	}
+	return attributes
+}
+
+func newExportRequest(metrics ...*protoOTLPV1.Metric) *protoOTLPV1.ExportMetricsServiceRequest {
+	return &protoOTLPV1.ExportMetricsServiceRequest{
+		ResourceMetrics: []*protoOTLPV1.ResourceMetrics{{
+			Resource: &protoOTLPV1.Resource{Attributes: newAttributes("service.name", "order", "host", "h1")},
+			ScopeMetrics: []*protoOTLPV1.ScopeMetrics{{
+				Scope:   &protoOTLPV1.InstrumentationScope{Name: "lib", Attributes: newAttributes("env", "prod")},
+				Metrics: metrics,
+			}},
+		}},
+	}
+}
+
+func newRequest(t *testing.T, exportReq *protoOTLPV1.ExportMetricsServiceRequest) *http.Request {
+	data, err := exportReq.Marshal()
+	assert.NoError(t, err)
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPost, "", bytes.NewReader(data))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	return req
+}
+
+func Test_Parse(t *testing.T) {
+	req := newRequest(t, newExportRequest(
+		&protoOTLPV1.Metric{
+			Name: "requests",
+			Data: &protoOTLPV1.Metric_Sum{Sum: &protoOTLPV1.Sum{
+				AggregationTemporality: protoOTLPV1.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+				IsMonotonic:            true,
+				DataPoints: []*protoOTLPV1.NumberDataPoint{{
+					Attributes:   newAttributes("path", "/api", "host", "h2"),
+					TimeUnixNano: 1000 * 1e6,
+					Value:        &protoOTLPV1.NumberDataPoint_AsInt{AsInt: 10},
+				}},
+			}},
+		},
+		&protoOTLPV1.Metric{
+			Name: "total",
+			Data: &protoOTLPV1.Metric_Sum{Sum: &protoOTLPV1.Sum{
+				AggregationTemporality: protoOTLPV1.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				DataPoints: []*protoOTLPV1.NumberDataPoint{{
+					Value: &protoOTLPV1.NumberDataPoint_AsDouble{AsDouble: 100},
+				}},
+			}},
+		},
+		&protoOTLPV1.Metric{
+			Name: "memory",
+			Data: &protoOTLPV1.Metric_Gauge{Gauge: &protoOTLPV1.Gauge{
+				DataPoints: []*protoOTLPV1.NumberDataPoint{
+					{TimeUnixNano: 1000 * 1e6, Value: &protoOTLPV1.NumberDataPoint_AsDouble{AsDouble: 1.5}},
+					// no value
+					{TimeUnixNano: 1000 * 1e6},
+					// nan value
+					{TimeUnixNano: 1000 * 1e6, Value: &protoOTLPV1.NumberDataPoint_AsDouble{AsDouble: math.NaN()}},
+				},
+			}},
+		},
+		// no data
+		&protoOTLPV1.Metric{Name: "summary"},
+	))
+	exportReq, err := DecodeRequest(req)
+	assert.NoError(t, err)
+	enrichedTags := []tag.Tag{tag.NewTag([]byte("region"), []byte("nj"))}
+	batch, rejected := ParseRequest(exportReq, enrichedTags, "ns")
+	assert.Equal(t, int64(3), rejected)
+	assert.Equal(t, 3, batch.Len())
+
+	var sf flatMetricsV1.SimpleField
+	sum := batch.Rows()[0].Metric()
+	assert.Equal(t, "requests", string(sum.Name()))
+	assert.Equal(t, "ns", string(sum.Namespace()))
+	assert.Equal(t, int64(1000), sum.Timestamp())
+	// service.name/host/env/path/region
+	assert.Equal(t, 5, sum.KeyValuesLength())
+	assert.True(t, sum.SimpleFields(&sf, 0))
+	assert.Equal(t, ValueField, string(sf.Name()))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeDeltaSum, sf.Type())
+	assert.Equal(t, 10.0, sf.Value())
+
+	cumulative := batch.Rows()[1].Metric()
+	assert.Equal(t, "total", string(cumulative.Name()))
+	assert.True(t, cumulative.Timestamp() > 0)
+	assert.True(t, cumulative.SimpleFields(&sf, 0))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeLast, sf.Type())
+
+	gauge := batch.Rows()[2].Metric()
+	assert.Equal(t, "memory", string(gauge.Name()))
+	assert.Equal(t, 4, gauge.KeyValuesLength())
+	assert.True(t, gauge.SimpleFields(&sf, 0))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeLast, sf.Type())
+	assert.Equal(t, 1.5, sf.Value())
+}
+
+func Test_Parse_Histogram(t *testing.T) {
+	exportReq := newExportRequest(
+		&protoOTLPV1.Metric{
+			Name: "latency",
+			Data: &protoOTLPV1.Metric_Histogram{Histogram: &protoOTLPV1.Histogram{
+				AggregationTemporality: protoOTLPV1.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+				DataPoints: []*protoOTLPV1.HistogramDataPoint{
+					{
+						TimeUnixNano:   1000 * 1e6,
+						Count:          5,
+						Sum:            3.5,
+						Min:            0.05,
+						Max:            2,
+						BucketCounts:   []uint64{1, 1, 3},
+						ExplicitBounds: []float64{0.1, 0.5},
+					},
+					// bucket counts not match
+					{BucketCounts: []uint64{1, 1}, ExplicitBounds: []float64{0.1, 0.5}},
+					// negative bound
+					{BucketCounts: []uint64{1, 1}, ExplicitBounds: []float64{-1}},
+				},
+			}},
+		},
+		&protoOTLPV1.Metric{
+			Name: "cumulative_latency",
+			Data: &protoOTLPV1.Metric_Histogram{Histogram: &protoOTLPV1.Histogram{
+				AggregationTemporality: protoOTLPV1.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				DataPoints: []*protoOTLPV1.HistogramDataPoint{
+					// baseline
+					{StartTimeUnixNano: 1, TimeUnixNano: 1000 * 1e6, Count: 2, Sum: 1, BucketCounts: []uint64{1, 1}, ExplicitBounds: []float64{0.1}},
+					{StartTimeUnixNano: 1, TimeUnixNano: 2000 * 1e6, Count: 5, Sum: 4, BucketCounts: []uint64{3, 2}, ExplicitBounds: []float64{0.1}},
+				},
+			}},
+		},
+	)
+	batch, rejected := ParseRequest(exportReq, nil, "ns")
+	assert.Equal(t, int64(2), rejected)
+	assert.Equal(t, 2, batch.Len())
+
+	histogram := batch.Rows()[0].Metric()
+	assert.Equal(t, "latency", string(histogram.Name()))
+	compound := histogram.CompoundField(nil)
+	assert.NotNil(t, compound)
+	assert.Equal(t, 3, compound.ValuesLength())
+	assert.Equal(t, []float64{1, 1, 3}, []float64{compound.Values(0), compound.Values(1), compound.Values(2)})
+	assert.Equal(t, 0.1, compound.ExplicitBounds(0))
+	assert.True(t, math.IsInf(compound.ExplicitBounds(2), 1))
+	assert.Equal(t, 3.5, compound.Sum())
+	assert.Equal(t, 5.0, compound.Count())
+	assert.Equal(t, 0.05, compound.Min())
+	assert.Equal(t, 2.0, compound.Max())
+
+	// cumulative histogram converted to delta
+	histogram = batch.Rows()[1].Metric()
+	assert.Equal(t, "cumulative_latency", string(histogram.Name()))
+	compound = histogram.CompoundField(nil)
+	assert.Equal(t, []float64{2, 1}, []float64{compound.Values(0), compound.Values(1)})
+	assert.Equal(t, 3.0, compound.Sum())
+	assert.Equal(t, 3.0, compound.Count())
+}
+
+func Test_Parse_JSON(t *testing.T) {
+	body := `{"resourceMetrics":[{"resource":{"attributes":[` +
+		`{"key":"service.name","value":{"stringValue":"order"}},` +
+		`{"key":"pid","value":{"intValue":"100"}},` +
+		`{"key":"debug","value":{"boolValue":true}},` +
+		`{"key":"ratio","value":{"doubleValue":0.5}},` +
+		`{"key":"list","value":{"arrayValue":{"values":[]}}}]},` +
+		`"scopeMetrics":[{"scope":{"name":"lib"},"metrics":[{"name":"requests","unknown":1,` +
+		`"sum":{"aggregationTemporality":1,"isMonotonic":true,` +
+		`"dataPoints":[{"timeUnixNano":"1000000000","asInt":"10"}]}}]}]}]}`
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPost, "", strings.NewReader(body))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	assert.True(t, IsJSON(req))
+	exportReq, err := DecodeRequest(req)
+	assert.NoError(t, err)
+	batch, rejected := ParseRequest(exportReq, nil, "ns")
+	assert.Zero(t, rejected)
+	assert.Equal(t, 1, batch.Len())
+	sum := batch.Rows()[0].Metric()
+	assert.Equal(t, "requests", string(sum.Name()))
+	assert.Equal(t, int64(1000), sum.Timestamp())
+	assert.Equal(t, 4, sum.KeyValuesLength())
+	var sf flatMetricsV1.SimpleField
+	assert.True(t, sum.SimpleFields(&sf, 0))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeDeltaSum, sf.Type())
+	assert.Equal(t, 10.0, sf.Value())
+}
+
+func Test_NewExportResponse(t *testing.T) {
+	assert.Nil(t, NewExportResponse(0).PartialSuccess)
+	assert.Equal(t, int64(2), NewExportResponse(2).PartialSuccess.RejectedDataPoints)
+}
+
+func Test_DecodeRequest_Error(t *testing.T) {
+	// bad gzip data
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPost, "", strings.NewReader("bad-data"))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Encoding", "gzip")
+	_, err = DecodeRequest(req)
+	assert.Error(t, err)
+	// bad proto data
+	req, err = http.NewRequestWithContext(context.TODO(), http.MethodPost, "", strings.NewReader("bad-data"))
+	assert.NoError(t, err)
+	_, err = DecodeRequest(req)
+	assert.Error(t, err)
+	// bad json data
+	req, err = http.NewRequestWithContext(context.TODO(), http.MethodPost, "", strings.NewReader("bad-data"))
+	assert.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	_, err = DecodeRequest(req)
+	assert.Error(t, err)
+	// bad enriched tag
+	batch, rejected := ParseRequest(newExportRequest(&protoOTLPV1.Metric{
+		Name: "memory",
+		Data: &protoOTLPV1.Metric_Gauge{Gauge: &protoOTLPV1.Gauge{
+			DataPoints: []*protoOTLPV1.NumberDataPoint{{Value: &protoOTLPV1.NumberDataPoint_AsInt{AsInt: 1}}},
+		}},
+	}), []tag.Tag{tag.NewTag([]byte("key"), nil)}, "ns")
+	assert.Equal(t, int64(1), rejected)
+	assert.Equal(t, 0, batch.Len())
+}
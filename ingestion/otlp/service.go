@@ -0,0 +1,229 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	commonconstants "github.com/lindb/common/constants"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	protoOTLPV1 "github.com/lindb/lindb/proto/gen/v1/otlp"
+	"github.com/lindb/lindb/replica"
+)
+
+//go:generate mockgen -source=./service.go -destination=./service_mock.go -package=otlp
+
+const (
+	// DatabaseKey represents the grpc metadata key of database name.
+	DatabaseKey = "db"
+	// NamespaceKey represents the grpc metadata key of namespace.
+	NamespaceKey = "ns"
+	// AuthorizationKey represents the grpc metadata key of authorization token, same as http header.
+	AuthorizationKey = "authorization"
+
+	exportMethod = "/opentelemetry.proto.collector.metrics.v1.MetricsService/Export"
+)
+
+// MetricsServiceServer represents OTLP/gRPC metrics service server.
+type MetricsServiceServer interface {
+	// Export receives OTLP metrics export request.
+	Export(ctx context.Context, req *protoOTLPV1.ExportMetricsServiceRequest) (*protoOTLPV1.ExportMetricsServiceResponse, error)
+}
+
+// metricsServiceDesc represents the grpc service descriptor of OTLP metrics service,
+// service name must be same as OTLP's, so that OTLP exporter can send data to LinDB directly.
+var metricsServiceDesc = grpc.ServiceDesc{
+	ServiceName: "opentelemetry.proto.collector.metrics.v1.MetricsService",
+	HandlerType: (*MetricsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Export",
+			Handler:    exportHandler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "opentelemetry/proto/collector/metrics/v1/metrics_service.proto",
+}
+
+// RegisterMetricsServiceServer registers OTLP metrics service into grpc server,
+// interceptor(e.g. authentication) only applies to OTLP metrics service if not nil,
+// because grpc server is shared with internal rpc services.
+func RegisterMetricsServiceServer(s *grpc.Server, srv MetricsServiceServer, interceptor grpc.UnaryServerInterceptor) {
+	if interceptor != nil {
+		srv = &interceptedServer{
+			MetricsServiceServer: srv,
+			interceptor:          interceptor,
+		}
+	}
+	s.RegisterService(&metricsServiceDesc, srv)
+}
+
+// interceptedServer represents OTLP metrics service server with interceptor.
+type interceptedServer struct {
+	MetricsServiceServer
+	interceptor grpc.UnaryServerInterceptor
+}
+
+// Export intercepts OTLP metrics export request, then calls the service server.
+func (s *interceptedServer) Export(ctx context.Context,
+	req *protoOTLPV1.ExportMetricsServiceRequest,
+) (*protoOTLPV1.ExportMetricsServiceResponse, error) {
+	info := &grpc.UnaryServerInfo{
+		Server:     s.MetricsServiceServer,
+		FullMethod: exportMethod,
+	}
+	resp, err := s.interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.MetricsServiceServer.Export(ctx, req.(*protoOTLPV1.ExportMetricsServiceRequest))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*protoOTLPV1.ExportMetricsServiceResponse), nil
+}
+
+// NewAuthInterceptor creates grpc interceptor which authenticates the request by authorization metadata,
+// same as http api(Bearer <jwt token> or Token <name:secret>), then checks write role on database.
+func NewAuthInterceptor(authentication middleware.Authentication) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		_ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		var token string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(AuthorizationKey); len(values) > 0 {
+				token = values[0]
+			}
+		}
+		user, ok := authentication.Authenticate(token)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "authorization token invalid")
+		}
+		database, _ := getMetadata(ctx)
+		if !user.HasRole(database, models.RoleWrite) {
+			return nil, status.Errorf(codes.PermissionDenied, "user: %s hasn't %s role on database: %s",
+				user.Name, models.RoleWrite, database)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// exportHandler handles grpc unary call of OTLP metrics export.
+func exportHandler(srv interface{}, ctx context.Context,
+	dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor,
+) (interface{}, error) {
+	in := new(protoOTLPV1.ExportMetricsServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: exportMethod,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).Export(ctx, req.(*protoOTLPV1.ExportMetricsServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MetricsService implements OTLP/gRPC metrics service, writes received metrics via database channel.
+// database/namespace are passed by grpc metadata(header of OTLP exporter).
+type MetricsService struct {
+	cm            replica.ChannelManager
+	limiter       *concurrent.Limiter
+	ingestTimeout time.Duration
+}
+
+// NewMetricsService creates an OTLP/gRPC metrics service.
+func NewMetricsService(cm replica.ChannelManager, limiter *concurrent.Limiter, ingestTimeout time.Duration) *MetricsService {
+	return &MetricsService{
+		cm:            cm,
+		limiter:       limiter,
+		ingestTimeout: ingestTimeout,
+	}
+}
+
+// Export receives OTLP metrics export request with ingest limit.
+func (s *MetricsService) Export(ctx context.Context,
+	req *protoOTLPV1.ExportMetricsServiceRequest,
+) (resp *protoOTLPV1.ExportMetricsServiceResponse, err error) {
+	database, namespace := getMetadata(ctx)
+	if database == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "database not found in metadata: %s", DatabaseKey)
+	}
+	if err = s.limiter.Do(func() error {
+		resp, err = s.export(database, namespace, req)
+		return err
+	}); err != nil {
+		if errors.Is(err, concurrent.ErrConcurrencyLimiterTimeout) ||
+			errors.Is(err, constants.ErrSeriesQuotaExceeded) {
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	if resp == nil {
+		// limiter is closed, broker is shutting down
+		return nil, status.Error(codes.Unavailable, "broker is shutting down")
+	}
+	return resp, nil
+}
+
+// export converts OTLP metrics to rows, then writes them to database's write channel.
+func (s *MetricsService) export(database, namespace string,
+	req *protoOTLPV1.ExportMetricsServiceRequest,
+) (*protoOTLPV1.ExportMetricsServiceResponse, error) {
+	batch, rejected := ParseRequest(req, nil, namespace)
+	resp := NewExportResponse(rejected)
+	if batch.Len() == 0 {
+		return resp, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.ingestTimeout)
+	defer cancel()
+	if err := s.cm.Write(ctx, database, batch); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// getMetadata returns database/namespace from grpc metadata.
+func getMetadata(ctx context.Context) (database, namespace string) {
+	namespace = commonconstants.DefaultNamespace
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", namespace
+	}
+	if values := md.Get(DatabaseKey); len(values) > 0 {
+		database = values[0]
+	}
+	if values := md.Get(NamespaceKey); len(values) > 0 && values[0] != "" {
+		namespace = values[0]
+	}
+	return database, namespace
+}
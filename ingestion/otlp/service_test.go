@@ -0,0 +1,199 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package otlp
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/lindb/lindb/internal/concurrent"
+	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/http/middleware"
+	protoOTLPV1 "github.com/lindb/lindb/proto/gen/v1/otlp"
+	"github.com/lindb/lindb/replica"
+)
+
+func TestMetricsService_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cm := replica.NewMockChannelManager(ctrl)
+	service := NewMetricsService(cm, concurrent.NewLimiter(
+		context.TODO(),
+		32,
+		time.Second,
+		metrics.NewLimitStatistics("otlp_export_test", linmetric.BrokerRegistry)), time.Second)
+	req := newExportRequest(&protoOTLPV1.Metric{
+		Name: "memory",
+		Data: &protoOTLPV1.Metric_Gauge{Gauge: &protoOTLPV1.Gauge{
+			DataPoints: []*protoOTLPV1.NumberDataPoint{
+				{Value: &protoOTLPV1.NumberDataPoint_AsInt{AsInt: 1}},
+				{},
+			},
+		}},
+	})
+
+	// missing database
+	_, err := service.Export(context.TODO(), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(DatabaseKey, "db", NamespaceKey, "ns"))
+	// write failure
+	cm.EXPECT().Write(gomock.Any(), "db", gomock.Any()).Return(fmt.Errorf("err"))
+	_, err = service.Export(ctx, req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	// series quota exceeded
+	cm.EXPECT().Write(gomock.Any(), "db", gomock.Any()).
+		Return(fmt.Errorf("%w, rejected rows: 1", &models.SeriesQuotaError{Scope: models.ShardQuotaScope, Name: "db"}))
+	_, err = service.Export(ctx, req)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	// write successfully
+	cm.EXPECT().Write(gomock.Any(), "db", gomock.Any()).Return(nil)
+	resp, err := service.Export(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), resp.PartialSuccess.RejectedDataPoints)
+	// all data points rejected
+	resp, err = service.Export(ctx, newExportRequest(&protoOTLPV1.Metric{Name: "summary"}))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), resp.PartialSuccess.RejectedDataPoints)
+}
+
+func TestMetricsService_Export_Limit(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(DatabaseKey, "db"))
+	service := NewMetricsService(nil, concurrent.NewLimiter(
+		context.TODO(),
+		0,
+		time.Millisecond,
+		metrics.NewLimitStatistics("otlp_export_limit_test", linmetric.BrokerRegistry)), time.Second)
+	_, err := service.Export(ctx, newExportRequest())
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	// limiter closed
+	closed, cancel := context.WithCancel(context.TODO())
+	cancel()
+	service = NewMetricsService(nil, concurrent.NewLimiter(
+		closed,
+		0,
+		time.Second,
+		metrics.NewLimitStatistics("otlp_export_closed_test", linmetric.BrokerRegistry)), time.Second)
+	_, err = service.Export(ctx, newExportRequest())
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func Test_getMetadata(t *testing.T) {
+	database, namespace := getMetadata(context.TODO())
+	assert.Empty(t, database)
+	assert.Equal(t, "default-ns", namespace)
+	database, namespace = getMetadata(metadata.NewIncomingContext(context.TODO(), metadata.Pairs(DatabaseKey, "db")))
+	assert.Equal(t, "db", database)
+	assert.Equal(t, "default-ns", namespace)
+}
+
+func TestMetricsService_Auth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	authentication := middleware.NewMockAuthentication(ctrl)
+	srv := NewMockMetricsServiceServer(ctrl)
+	// register into grpc server with/without interceptor
+	RegisterMetricsServiceServer(grpc.NewServer(), srv, nil)
+	RegisterMetricsServiceServer(grpc.NewServer(), srv, NewAuthInterceptor(authentication))
+
+	service := &interceptedServer{
+		MetricsServiceServer: srv,
+		interceptor:          NewAuthInterceptor(authentication),
+	}
+	user := &models.User{
+		Name:       "agent",
+		Privileges: []models.Privilege{{Database: "db", Role: models.RoleWrite}},
+	}
+	req := &protoOTLPV1.ExportMetricsServiceRequest{}
+	cases := []struct {
+		name    string
+		md      metadata.MD
+		prepare func()
+		code    codes.Code
+	}{
+		{
+			name: "no metadata",
+			prepare: func() {
+				authentication.EXPECT().Authenticate("").Return(nil, false)
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "token invalid",
+			md:   metadata.Pairs(AuthorizationKey, "Token agent:bad", DatabaseKey, "db"),
+			prepare: func() {
+				authentication.EXPECT().Authenticate("Token agent:bad").Return(nil, false)
+			},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "no write role",
+			md:   metadata.Pairs(AuthorizationKey, "Token agent:secret", DatabaseKey, "other"),
+			prepare: func() {
+				authentication.EXPECT().Authenticate("Token agent:secret").Return(user, true)
+			},
+			code: codes.PermissionDenied,
+		},
+		{
+			name: "export failure",
+			md:   metadata.Pairs(AuthorizationKey, "Bearer jwt", DatabaseKey, "db"),
+			prepare: func() {
+				authentication.EXPECT().Authenticate("Bearer jwt").Return(user, true)
+				srv.EXPECT().Export(gomock.Any(), req).Return(nil, status.Error(codes.Unavailable, "err"))
+			},
+			code: codes.Unavailable,
+		},
+		{
+			name: "export successfully",
+			md:   metadata.Pairs(AuthorizationKey, "Token agent:secret", DatabaseKey, "db"),
+			prepare: func() {
+				authentication.EXPECT().Authenticate("Token agent:secret").Return(user, true)
+				srv.EXPECT().Export(gomock.Any(), req).Return(&protoOTLPV1.ExportMetricsServiceResponse{}, nil)
+			},
+			code: codes.OK,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			ctx := context.TODO()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+			resp, err := service.Export(ctx, req)
+			assert.Equal(t, tt.code, status.Code(err))
+			if err == nil {
+				assert.NotNil(t, resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/snappy"
+
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	commonseries "github.com/lindb/common/series"
+
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/strutil"
+	protoPrometheusV1 "github.com/lindb/lindb/proto/gen/v1/prometheus"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+)
+
+const (
+	// MetricNameLabel represents the label name of metric name in prometheus.
+	MetricNameLabel = "__name__"
+	// BucketLabel represents the label name of histogram bucket upper bound in prometheus.
+	BucketLabel = "le"
+	// ValueField represents the field name of counter/gauge sample.
+	ValueField = "value"
+
+	// BucketSuffix represents the metric name suffix of histogram bucket.
+	BucketSuffix = "_bucket"
+	// SumSuffix represents the metric name suffix of histogram sum.
+	SumSuffix = "_sum"
+	// CountSuffix represents the metric name suffix of histogram count.
+	CountSuffix = "_count"
+)
+
+var (
+	prometheusIngestionStatistics = metrics.NewPrometheusIngestionStatistics()
+)
+
+// Parse parses prometheus remote write request(snappy compressed protobuf) to LinDB rows.
+// https://prometheus.io/docs/concepts/remote_write_spec/
+//
+// labels are mapped to tags, __name__ is mapped to metric name,
+// 1. counter/gauge/unknown => Last field named "value", counter keeps the cumulative value,
+// its increase/rate is calculated at query time(counter reset is detected there);
+// 2. histogram(xxx_bucket/xxx_sum/xxx_count) => histogram field of metric xxx.
+func Parse(req *http.Request, enrichedTags tag.Tags, namespace string) (*metric.BrokerBatchRows, error) {
+	compressed, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	prometheusIngestionStatistics.ReadBytes.Add(float64(len(compressed)))
+	data, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		prometheusIngestionStatistics.CorruptedData.Incr()
+		return nil, fmt.Errorf("ingestion corrupted snappy data: %w", err)
+	}
+	var writeReq protoPrometheusV1.WriteRequest
+	if err := writeReq.Unmarshal(data); err != nil {
+		prometheusIngestionStatistics.CorruptedData.Incr()
+		return nil, err
+	}
+	batch, err := parseWriteRequest(&writeReq, enrichedTags, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if batch.Len() == 0 {
+		return nil, fmt.Errorf("empty metrics")
+	}
+	prometheusIngestionStatistics.IngestedMetrics.Add(float64(batch.Len()))
+	return batch, nil
+}
+
+// histogramPoint represents the histogram data of one series at one timestamp.
+type histogramPoint struct {
+	buckets  map[float64]float64 // upper bound => cumulative count
+	sum      float64
+	count    float64
+	hasCount bool
+}
+
+// histogramSeries represents a histogram series which combines xxx_bucket/xxx_sum/xxx_count.
+type histogramSeries struct {
+	metricName string
+	labels     []*protoPrometheusV1.Label
+	points     map[int64]*histogramPoint
+	timestamps []int64
+}
+
+// getPoint returns the histogram point of timestamp, creates it if not exist.
+func (hs *histogramSeries) getPoint(timestamp int64) *histogramPoint {
+	point, ok := hs.points[timestamp]
+	if !ok {
+		point = &histogramPoint{buckets: make(map[float64]float64)}
+		hs.points[timestamp] = point
+		hs.timestamps = append(hs.timestamps, timestamp)
+	}
+	return point
+}
+
+// parseWriteRequest converts prometheus time series to LinDB rows.
+func parseWriteRequest(
+	writeReq *protoPrometheusV1.WriteRequest,
+	enrichedTags tag.Tags,
+	namespace string,
+) (
+	batch *metric.BrokerBatchRows, err error,
+) {
+	batch = metric.NewBrokerBatchRows()
+	rowBuilder, releaseFunc := commonseries.NewRowBuilder()
+	defer releaseFunc(rowBuilder)
+
+	families := make(map[string]protoPrometheusV1.MetricMetadata_MetricType)
+	for _, meta := range writeReq.Metadata {
+		families[meta.MetricFamilyName] = meta.Type
+	}
+	// find all histogram base names which have buckets in this request
+	histogramNames := make(map[string]struct{})
+	for _, ts := range writeReq.Timeseries {
+		name := getLabelValue(ts.Labels, MetricNameLabel)
+		if strings.HasSuffix(name, BucketSuffix) && getLabelValue(ts.Labels, BucketLabel) != "" {
+			histogramNames[strings.TrimSuffix(name, BucketSuffix)] = struct{}{}
+		}
+	}
+	isHistogram := func(baseName string) bool {
+		if metricType, ok := families[baseName]; ok {
+			return metricType == protoPrometheusV1.MetricMetadata_HISTOGRAM ||
+				metricType == protoPrometheusV1.MetricMetadata_GAUGEHISTOGRAM
+		}
+		_, ok := histogramNames[baseName]
+		return ok
+	}
+
+	var (
+		histograms    = make(map[string]*histogramSeries)
+		histogramKeys []string
+	)
+	for _, ts := range writeReq.Timeseries {
+		name := getLabelValue(ts.Labels, MetricNameLabel)
+		if name == "" {
+			prometheusIngestionStatistics.DroppedMetrics.Add(float64(len(ts.Samples)))
+			continue
+		}
+		baseName, suffix := SplitHistogramName(name)
+		if suffix != "" && isHistogram(baseName) {
+			// xxx_bucket/xxx_sum/xxx_count of histogram, combine them by series
+			var upperBound float64
+			if suffix == BucketSuffix {
+				upperBound, err = strconv.ParseFloat(getLabelValue(ts.Labels, BucketLabel), 64)
+				if err != nil {
+					prometheusIngestionStatistics.DroppedMetrics.Add(float64(len(ts.Samples)))
+					continue
+				}
+			}
+			labels := filterLabels(ts.Labels, MetricNameLabel, BucketLabel)
+			key := seriesKey(baseName, labels)
+			hs, ok := histograms[key]
+			if !ok {
+				hs = &histogramSeries{metricName: baseName, labels: labels, points: make(map[int64]*histogramPoint)}
+				histograms[key] = hs
+				histogramKeys = append(histogramKeys, key)
+			}
+			for _, sample := range ts.Samples {
+				point := hs.getPoint(sample.Timestamp)
+				switch suffix {
+				case BucketSuffix:
+					point.buckets[upperBound] = sample.Value
+				case SumSuffix:
+					point.sum = sample.Value
+				case CountSuffix:
+					point.count = sample.Value
+					point.hasCount = true
+				}
+			}
+			continue
+		}
+
+		labels := filterLabels(ts.Labels, MetricNameLabel)
+		for _, sample := range ts.Samples {
+			// skip stale marker
+			if math.IsNaN(sample.Value) {
+				continue
+			}
+			rowBuilder.Reset()
+			if err := buildRowPrefix(rowBuilder, namespace, name, labels, enrichedTags, sample.Timestamp); err != nil {
+				return nil, err
+			}
+			if err := rowBuilder.AddSimpleField([]byte(ValueField), flatMetricsV1.SimpleFieldTypeLast, sample.Value); err != nil {
+				prometheusIngestionStatistics.DroppedMetrics.Incr()
+				continue
+			}
+			appendRow(batch, rowBuilder)
+		}
+	}
+
+	for _, key := range histogramKeys {
+		hs := histograms[key]
+		for _, timestamp := range hs.timestamps {
+			rowBuilder.Reset()
+			if err := buildRowPrefix(rowBuilder, namespace, hs.metricName, hs.labels, enrichedTags, timestamp); err != nil {
+				return nil, err
+			}
+			if err := addHistogram(rowBuilder, hs.points[timestamp]); err != nil {
+				prometheusIngestionStatistics.DroppedMetrics.Incr()
+				continue
+			}
+			appendRow(batch, rowBuilder)
+		}
+	}
+	return batch, nil
+}
+
+// buildRowPrefix sets namespace/metric name/tags/timestamp of row.
+func buildRowPrefix(
+	rowBuilder *commonseries.RowBuilder,
+	namespace, metricName string,
+	labels []*protoPrometheusV1.Label,
+	enrichedTags tag.Tags,
+	timestamp int64,
+) error {
+	rowBuilder.AddNameSpace(strutil.String2ByteSlice(namespace))
+	rowBuilder.AddMetricName(strutil.String2ByteSlice(metricName))
+	rowBuilder.AddTimestamp(timestamp)
+	for _, label := range labels {
+		if label.Value == "" {
+			// empty label value means label not exist in prometheus
+			continue
+		}
+		if err := rowBuilder.AddTag(strutil.String2ByteSlice(label.Name), strutil.String2ByteSlice(label.Value)); err != nil {
+			return err
+		}
+	}
+	for _, enrichedTag := range enrichedTags {
+		if err := rowBuilder.AddTag(enrichedTag.Key, enrichedTag.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addHistogram converts prometheus cumulative buckets to LinDB histogram compound field.
+// min/max cannot be got from prometheus histogram, so set them to 0.
+func addHistogram(rowBuilder *commonseries.RowBuilder, point *histogramPoint) error {
+	bounds := make([]float64, 0, len(point.buckets))
+	for upperBound := range point.buckets {
+		bounds = append(bounds, upperBound)
+	}
+	sort.Float64s(bounds)
+	values := make([]float64, len(bounds))
+	prev := 0.0
+	for idx, upperBound := range bounds {
+		cumulative := point.buckets[upperBound]
+		values[idx] = math.Max(cumulative-prev, 0)
+		prev = cumulative
+	}
+	if err := rowBuilder.AddCompoundFieldData(values, bounds); err != nil {
+		return err
+	}
+	count := prev
+	if point.hasCount {
+		count = point.count
+	}
+	return rowBuilder.AddCompoundFieldMMSC(0, 0, point.sum, count)
+}
+
+// appendRow appends the row built by builder into batch.
+func appendRow(batch *metric.BrokerBatchRows, rowBuilder *commonseries.RowBuilder) {
+	if err := batch.TryAppend(func(row *metric.BrokerRow) error {
+		data, err := rowBuilder.Build()
+		if err != nil {
+			return err
+		}
+		row.FromBlock(data)
+		return nil
+	}); err != nil {
+		prometheusIngestionStatistics.DroppedMetrics.Incr()
+	}
+}
+
+// SplitHistogramName splits metric name to base name and histogram suffix(_bucket/_sum/_count).
+func SplitHistogramName(name string) (baseName, suffix string) {
+	for _, s := range []string{BucketSuffix, SumSuffix, CountSuffix} {
+		if strings.HasSuffix(name, s) {
+			return strings.TrimSuffix(name, s), s
+		}
+	}
+	return name, ""
+}
+
+// getLabelValue returns the value of label name.
+func getLabelValue(labels []*protoPrometheusV1.Label, name string) string {
+	for _, label := range labels {
+		if label.Name == name {
+			return label.Value
+		}
+	}
+	return ""
+}
+
+// filterLabels returns labels excluding given label names.
+func filterLabels(labels []*protoPrometheusV1.Label, excludes ...string) []*protoPrometheusV1.Label {
+	result := make([]*protoPrometheusV1.Label, 0, len(labels))
+	for _, label := range labels {
+		excluded := false
+		for _, exclude := range excludes {
+			if label.Name == exclude {
+				excluded = true
+				break
+			}
+		}
+		if !excluded {
+			result = append(result, label)
+		}
+	}
+	return result
+}
+
+// seriesKey returns the unique key of series based on metric name and sorted labels.
+func seriesKey(metricName string, labels []*protoPrometheusV1.Label) string {
+	sorted := make([]*protoPrometheusV1.Label, len(labels))
+	copy(sorted, labels)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	var sb strings.Builder
+	sb.WriteString(metricName)
+	for _, label := range sorted {
+		sb.WriteByte(0)
+		sb.WriteString(label.Name)
+		sb.WriteByte('=')
+		sb.WriteString(label.Value)
+	}
+	return sb.String()
+}
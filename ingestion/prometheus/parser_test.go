@@ -0,0 +1,211 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+
+	protoPrometheusV1 "github.com/lindb/lindb/proto/gen/v1/prometheus"
+	"github.com/lindb/lindb/series/tag"
+)
+
+func newLabels(kvs ...string) []*protoPrometheusV1.Label {
+	var labels []*protoPrometheusV1.Label
+	for i := 0; i < len(kvs); i += 2 {
+		labels = append(labels, &protoPrometheusV1.Label{Name: kvs[i], Value: kvs[i+1]})
+	}
+	return labels
+}
+
+func newRequest(t *testing.T, writeReq *protoPrometheusV1.WriteRequest) *http.Request {
+	data, err := writeReq.Marshal()
+	assert.NoError(t, err)
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPost, "",
+		bytes.NewReader(snappy.Encode(nil, data)))
+	assert.NoError(t, err)
+	return req
+}
+
+func Test_Parse(t *testing.T) {
+	req := newRequest(t, &protoPrometheusV1.WriteRequest{
+		Timeseries: []*protoPrometheusV1.TimeSeries{
+			{
+				Labels:  newLabels(MetricNameLabel, "http_requests_total", "path", "/api"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 10, Timestamp: 1000}, {Value: math.NaN(), Timestamp: 2000}},
+			},
+			{
+				Labels:  newLabels(MetricNameLabel, "memory_used", "host", "h1", "empty", ""),
+				Samples: []*protoPrometheusV1.Sample{{Value: 100, Timestamp: 1000}},
+			},
+		},
+	})
+	enrichedTags := []tag.Tag{tag.NewTag([]byte("region"), []byte("nj"))}
+	batch, err := Parse(req, enrichedTags, "ns")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, batch.Len())
+
+	var sf flatMetricsV1.SimpleField
+	counter := batch.Rows()[0].Metric()
+	assert.Equal(t, "http_requests_total", string(counter.Name()))
+	assert.Equal(t, "ns", string(counter.Namespace()))
+	assert.Equal(t, int64(1000), counter.Timestamp())
+	assert.Equal(t, 2, counter.KeyValuesLength())
+	assert.True(t, counter.SimpleFields(&sf, 0))
+	assert.Equal(t, ValueField, string(sf.Name()))
+	// cumulative counter keeps raw value, rate is calculated at query time
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeLast, sf.Type())
+	assert.Equal(t, 10.0, sf.Value())
+
+	gauge := batch.Rows()[1].Metric()
+	assert.Equal(t, "memory_used", string(gauge.Name()))
+	assert.Equal(t, 2, gauge.KeyValuesLength())
+	assert.True(t, gauge.SimpleFields(&sf, 0))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeLast, sf.Type())
+}
+
+func Test_Parse_Metadata(t *testing.T) {
+	req := newRequest(t, &protoPrometheusV1.WriteRequest{
+		Timeseries: []*protoPrometheusV1.TimeSeries{
+			{
+				Labels:  newLabels(MetricNameLabel, "requests"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 10, Timestamp: 1000}},
+			},
+			{
+				Labels:  newLabels(MetricNameLabel, "temperature_total"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 10, Timestamp: 1000}},
+			},
+		},
+		Metadata: []*protoPrometheusV1.MetricMetadata{
+			{Type: protoPrometheusV1.MetricMetadata_COUNTER, MetricFamilyName: "requests"},
+			{Type: protoPrometheusV1.MetricMetadata_GAUGE, MetricFamilyName: "temperature"},
+		},
+	})
+	batch, err := Parse(req, nil, "ns")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, batch.Len())
+	var sf flatMetricsV1.SimpleField
+	counter := batch.Rows()[0].Metric()
+	assert.True(t, counter.SimpleFields(&sf, 0))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeLast, sf.Type())
+	gauge := batch.Rows()[1].Metric()
+	assert.True(t, gauge.SimpleFields(&sf, 0))
+	assert.Equal(t, flatMetricsV1.SimpleFieldTypeLast, sf.Type())
+}
+
+func Test_Parse_Histogram(t *testing.T) {
+	req := newRequest(t, &protoPrometheusV1.WriteRequest{
+		Timeseries: []*protoPrometheusV1.TimeSeries{
+			{
+				Labels:  newLabels(MetricNameLabel, "latency_bucket", "host", "h1", BucketLabel, "0.5"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 2, Timestamp: 1000}},
+			},
+			{
+				Labels:  newLabels(MetricNameLabel, "latency_bucket", BucketLabel, "0.1", "host", "h1"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 1, Timestamp: 1000}},
+			},
+			{
+				Labels:  newLabels(MetricNameLabel, "latency_bucket", "host", "h1", BucketLabel, "+Inf"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 5, Timestamp: 1000}},
+			},
+			{
+				Labels:  newLabels(MetricNameLabel, "latency_sum", "host", "h1"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 3.5, Timestamp: 1000}},
+			},
+			{
+				Labels:  newLabels(MetricNameLabel, "latency_count", "host", "h1"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 5, Timestamp: 1000}},
+			},
+			// bad bucket
+			{
+				Labels:  newLabels(MetricNameLabel, "latency_bucket", "host", "h1", BucketLabel, "bad"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 5, Timestamp: 1000}},
+			},
+			// only one bucket
+			{
+				Labels:  newLabels(MetricNameLabel, "latency_bucket", "host", "h2", BucketLabel, "+Inf"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 5, Timestamp: 1000}},
+			},
+			// not histogram
+			{
+				Labels:  newLabels(MetricNameLabel, "gc_count"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 5, Timestamp: 1000}},
+			},
+		},
+	})
+	batch, err := Parse(req, nil, "ns")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, batch.Len())
+	gauge := batch.Rows()[0].Metric()
+	assert.Equal(t, "gc_count", string(gauge.Name()))
+
+	histogram := batch.Rows()[1].Metric()
+	assert.Equal(t, "latency", string(histogram.Name()))
+	assert.Equal(t, 1, histogram.KeyValuesLength())
+	compound := histogram.CompoundField(nil)
+	assert.NotNil(t, compound)
+	assert.Equal(t, 3, compound.ValuesLength())
+	assert.Equal(t, []float64{1, 1, 3}, []float64{compound.Values(0), compound.Values(1), compound.Values(2)})
+	assert.Equal(t, 0.1, compound.ExplicitBounds(0))
+	assert.True(t, math.IsInf(compound.ExplicitBounds(2), 1))
+	assert.Equal(t, 3.5, compound.Sum())
+	assert.Equal(t, 5.0, compound.Count())
+}
+
+func Test_Parse_Error(t *testing.T) {
+	// bad snappy data
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPost, "", strings.NewReader("bad-data"))
+	assert.NoError(t, err)
+	_, err = Parse(req, nil, "ns")
+	assert.Error(t, err)
+	// bad proto data
+	req, err = http.NewRequestWithContext(context.TODO(), http.MethodPost, "",
+		bytes.NewReader(snappy.Encode(nil, []byte("bad-data"))))
+	assert.NoError(t, err)
+	_, err = Parse(req, nil, "ns")
+	assert.Error(t, err)
+	// empty metrics
+	_, err = Parse(newRequest(t, &protoPrometheusV1.WriteRequest{
+		Timeseries: []*protoPrometheusV1.TimeSeries{
+			{
+				Labels:  newLabels("host", "h1"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 1, Timestamp: 1000}},
+			},
+		},
+	}), nil, "ns")
+	assert.Error(t, err)
+	// bad enriched tag
+	_, err = Parse(newRequest(t, &protoPrometheusV1.WriteRequest{
+		Timeseries: []*protoPrometheusV1.TimeSeries{
+			{
+				Labels:  newLabels(MetricNameLabel, "cpu"),
+				Samples: []*protoPrometheusV1.Sample{{Value: 1, Timestamp: 1000}},
+			},
+		},
+	}), []tag.Tag{tag.NewTag([]byte("key"), nil)}, "ns")
+	assert.Error(t, err)
+}
@@ -0,0 +1,134 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	httppkg "github.com/lindb/lindb/pkg/http"
+)
+
+//go:generate mockgen -source=./backup.go -destination=./backup_mock.go -package=client
+
+// BackupCli represents database snapshot backup/restore client.
+type BackupCli interface {
+	// Backup streams the backup archive of database into writer.
+	Backup(database string, w io.Writer) error
+	// Plan creates the plan of restoring backup into storage cluster.
+	Plan(storage string, manifest *models.BackupManifest) (*models.RestorePlan, error)
+	// RestoreNode uploads the snapshot of storage node(tar archive) into target node.
+	RestoreNode(database, storage string, target models.NodeID, snapshot io.Reader) error
+	// Restore commits the restore plan after all snapshots uploaded.
+	Restore(plan *models.RestorePlan) error
+}
+
+// backupCli implements BackupCli interface.
+type backupCli struct {
+	Base
+}
+
+// NewBackupCli creates a database backup client instance.
+func NewBackupCli(endpoint string) BackupCli {
+	cli := httppkg.NewRestyClient()
+	cli.SetBaseURL(endpoint)
+	return &backupCli{
+		Base{
+			cli: cli,
+		}}
+}
+
+// Backup streams the backup archive of database into writer.
+func (cli *backupCli) Backup(database string, w io.Writer) error {
+	resp, err := cli.cli.R().
+		SetDoNotParseResponse(true).
+		SetQueryParam("db", database).
+		Get("/database/backup")
+	if err != nil {
+		return err
+	}
+	body := resp.RawBody()
+	defer func() {
+		_ = body.Close()
+	}()
+	if resp.StatusCode() != http.StatusOK {
+		msg, _ := io.ReadAll(body)
+		return errors.New(string(msg))
+	}
+	_, err = io.Copy(w, body)
+	return err
+}
+
+// Plan creates the plan of restoring backup into storage cluster.
+func (cli *backupCli) Plan(storage string, manifest *models.BackupManifest) (*models.RestorePlan, error) {
+	resp, err := cli.cli.R().
+		SetBody(map[string]interface{}{
+			"storage":  storage,
+			"manifest": manifest,
+		}).
+		Post("/database/restore/plan")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, errors.New(string(resp.Body()))
+	}
+	plan := &models.RestorePlan{}
+	if err := encoding.JSONUnmarshal(resp.Body(), plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// RestoreNode uploads the snapshot of storage node(tar archive) into target node.
+func (cli *backupCli) RestoreNode(database, storage string, target models.NodeID, snapshot io.Reader) error {
+	resp, err := cli.cli.R().
+		SetHeader("Content-Type", "application/x-tar").
+		SetQueryParams(map[string]string{
+			"db":      database,
+			"storage": storage,
+			"node":    strconv.Itoa(int(target)),
+		}).
+		SetBody(snapshot).
+		Put("/database/restore/node")
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return errors.New(string(resp.Body()))
+	}
+	return nil
+}
+
+// Restore commits the restore plan after all snapshots uploaded.
+func (cli *backupCli) Restore(plan *models.RestorePlan) error {
+	resp, err := cli.cli.R().
+		SetBody(plan).
+		Post("/database/restore")
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return errors.New(string(resp.Body()))
+	}
+	return nil
+}
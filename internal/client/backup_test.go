@@ -0,0 +1,82 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func TestBackupCli(t *testing.T) {
+	status := http.StatusOK
+	var response []byte
+	var request []byte
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		request, _ = io.ReadAll(req.Body)
+		rw.WriteHeader(status)
+		_, _ = rw.Write(response)
+	}))
+	defer server.Close()
+
+	cli := NewBackupCli(server.URL)
+	// backup
+	response = []byte("archive")
+	buf := &bytes.Buffer{}
+	assert.NoError(t, cli.Backup("test", buf))
+	assert.Equal(t, "archive", buf.String())
+	// plan
+	plan := &models.RestorePlan{NodeMapping: map[models.NodeID]models.NodeID{1: 10}}
+	response = encoding.JSONMarshal(plan)
+	plan1, err := cli.Plan("storage", &models.BackupManifest{Version: models.BackupVersion})
+	assert.NoError(t, err)
+	assert.Equal(t, plan, plan1)
+	response = []byte("abc")
+	_, err = cli.Plan("storage", &models.BackupManifest{})
+	assert.Error(t, err)
+	// restore
+	response = nil
+	assert.NoError(t, cli.RestoreNode("test", "storage", 10, bytes.NewBufferString("snapshot")))
+	assert.Equal(t, "snapshot", string(request))
+	assert.NoError(t, cli.Restore(plan))
+
+	// failure
+	status = http.StatusInternalServerError
+	response = []byte("err")
+	assert.Error(t, cli.Backup("test", buf))
+	_, err = cli.Plan("storage", &models.BackupManifest{})
+	assert.Error(t, err)
+	assert.Error(t, cli.RestoreNode("test", "storage", 10, bytes.NewBufferString("snapshot")))
+	assert.Error(t, cli.Restore(plan))
+
+	// wrong url
+	cli = NewBackupCli(fmt.Sprintf("http://localhost:%d", 30001))
+	assert.Error(t, cli.Backup("test", buf))
+	_, err = cli.Plan("storage", &models.BackupManifest{})
+	assert.Error(t, err)
+	assert.Error(t, cli.RestoreNode("test", "storage", 10, bytes.NewBufferString("snapshot")))
+	assert.Error(t, cli.Restore(plan))
+}
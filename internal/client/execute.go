@@ -23,10 +23,9 @@ import (
 	"net/http"
 	"time"
 
-	resty "github.com/go-resty/resty/v2"
-
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
+	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/ltoml"
 )
 
@@ -47,7 +46,7 @@ type executeCli struct {
 
 // NewExecuteCli creates a lin query language execute client instance.
 func NewExecuteCli(endpoint string) ExecuteCli {
-	cli := resty.New()
+	cli := httppkg.NewRestyClient()
 	cli.SetBaseURL(endpoint)
 	return &executeCli{
 		Base{
@@ -28,6 +28,7 @@ import (
 // Get will resets the underlying delta value
 type BoundCounter struct {
 	delta     atomic.Float64
+	total     atomic.Float64 // cumulative value, never be reset, used for prometheus exposition
 	fieldName string
 }
 
@@ -40,21 +41,25 @@ func newCounter(fieldName string) *BoundCounter {
 // Incr increments c.
 func (c *BoundCounter) Incr() {
 	c.delta.Add(1)
+	c.total.Add(1)
 }
 
 // Decr decrements g.
 func (c *BoundCounter) Decr() {
 	c.delta.Sub(1)
+	c.total.Sub(1)
 }
 
 // Add adds v to c.
 func (c *BoundCounter) Add(v float64) {
 	c.delta.Add(v)
+	c.total.Add(v)
 }
 
 // Sub subs v to c.
 func (c *BoundCounter) Sub(v float64) {
 	c.delta.Sub(v)
+	c.total.Sub(v)
 }
 
 // Get returns the current delta counter value
@@ -70,6 +75,11 @@ func (c *BoundCounter) gather() float64 {
 	return v
 }
 
+// cumulative returns the cumulative counter value, which isn't reset by gather.
+func (c *BoundCounter) cumulative() float64 {
+	return c.total.Load()
+}
+
 func (c *BoundCounter) name() string { return c.fieldName }
 
 func (c *BoundCounter) flatType() flatMetricsV1.SimpleFieldType {
@@ -0,0 +1,222 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linmetric
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lindb/lindb/series/tag"
+)
+
+// prometheus metric types, see: https://prometheus.io/docs/instrumenting/exposition_formats/
+const (
+	promTypeCounter   = "counter"
+	promTypeGauge     = "gauge"
+	promTypeHistogram = "histogram"
+)
+
+var promLabelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// promFamily represents a prometheus metric family, all samples of family must be written together.
+type promFamily struct {
+	name    string
+	typ     string
+	samples []string
+}
+
+// promFamilies represents prometheus metric families.
+type promFamilies map[string]*promFamily
+
+// add adds a sample into metric family.
+func (pf promFamilies) add(familyName, typ, sample string) {
+	family, ok := pf[familyName]
+	if !ok {
+		family = &promFamily{name: familyName, typ: typ}
+		pf[familyName] = family
+	}
+	family.samples = append(family.samples, sample)
+}
+
+// WritePrometheus writes all metrics of registry with prometheus text exposition format,
+// tags of metric and global tags are written as labels.
+// Counter is written with cumulative value, histogram is written with cumulative buckets.
+func (r *Registry) WritePrometheus(writer io.Writer, globalKeyValues tag.Tags) error {
+	var buffer []*taggedSeries
+	r.mu.RLock()
+	for _, nm := range r.series {
+		buffer = append(buffer, nm)
+	}
+	r.mu.RUnlock()
+
+	// sort series for stable output
+	sort.Slice(buffer, func(i, j int) bool {
+		if buffer[i].metricName != buffer[j].metricName {
+			return buffer[i].metricName < buffer[j].metricName
+		}
+		return buffer[i].seriesID < buffer[j].seriesID
+	})
+
+	families := make(promFamilies)
+	for _, s := range buffer {
+		s.gatherPrometheus(families, globalKeyValues)
+	}
+	familyNames := make([]string, 0, len(families))
+	for name := range families {
+		familyNames = append(familyNames, name)
+	}
+	sort.Strings(familyNames)
+
+	w := bufio.NewWriter(writer)
+	for _, name := range familyNames {
+		family := families[name]
+		_, _ = w.WriteString("# TYPE " + family.name + " " + family.typ + "\n")
+		for _, sample := range family.samples {
+			_, _ = w.WriteString(sample)
+			_ = w.WriteByte('\n')
+		}
+	}
+	return w.Flush()
+}
+
+// gatherPrometheus gathers the fields of series as prometheus samples.
+func (s *taggedSeries) gatherPrometheus(families promFamilies, globalKeyValues tag.Tags) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.payload == nil {
+		return
+	}
+	metricName := promMetricName(s.metricName)
+	labels := promLabels(s.tags, globalKeyValues)
+	for _, sf := range s.payload.simpleFields {
+		name := metricName + "_" + promMetricName(sf.name())
+		switch f := sf.(type) {
+		case *BoundCounter:
+			name += "_total"
+			families.add(name, promTypeCounter, promSample(name, labels, f.cumulative()))
+		default:
+			// gauge/min/max
+			families.add(name, promTypeGauge, promSample(name, labels, sf.Get()))
+		}
+	}
+	if s.payload.histogramDelta != nil {
+		s.payload.histogramDelta.gatherPrometheus(families, metricName, s.tags, globalKeyValues)
+	}
+}
+
+// gatherPrometheus gathers histogram as prometheus samples with cumulative buckets.
+func (h *BoundHistogram) gatherPrometheus(families promFamilies, metricName string, tags, globalKeyValues tag.Tags) {
+	h.mu.Lock()
+	values := cloneFloat64Slice(h.bkts.values)
+	upperBounds := cloneFloat64Slice(h.bkts.upperBounds)
+	totalSum := h.bkts.totalSum
+	totalCount := h.bkts.totalCount
+	h.mu.Unlock()
+
+	cumulative := 0.0
+	for idx, value := range values {
+		cumulative += value
+		families.add(metricName, promTypeHistogram,
+			promSample(metricName+"_bucket", promLabels(tags, globalKeyValues, "le", promValue(upperBounds[idx])), cumulative))
+	}
+	labels := promLabels(tags, globalKeyValues)
+	families.add(metricName, promTypeHistogram, promSample(metricName+"_sum", labels, totalSum))
+	families.add(metricName, promTypeHistogram, promSample(metricName+"_count", labels, totalCount))
+}
+
+// promSample returns a sample line, like: name{k="v"} value.
+func promSample(name, labels string, value float64) string {
+	return name + labels + " " + promValue(value)
+}
+
+// promValue formats the sample value, +Inf/-Inf/NaN are supported.
+func promValue(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// promLabels returns the labels of sample, global tags override the tags of metric.
+func promLabels(tags, globalKeyValues tag.Tags, extraKeyValues ...string) string {
+	labels := make(map[string]string)
+	for _, kv := range tags {
+		labels[promLabelName(string(kv.Key))] = string(kv.Value)
+	}
+	for _, kv := range globalKeyValues {
+		labels[promLabelName(string(kv.Key))] = string(kv.Value)
+	}
+	for i := 0; i+1 < len(extraKeyValues); i += 2 {
+		labels[extraKeyValues[i]] = extraKeyValues[i+1]
+	}
+	if len(labels) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for idx, key := range keys {
+		if idx > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(key)
+		sb.WriteString(`="`)
+		sb.WriteString(promLabelValueReplacer.Replace(labels[key]))
+		sb.WriteByte('"')
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// promMetricName returns a valid prometheus metric name, invalid chars are replaced with '_'.
+func promMetricName(name string) string {
+	return promSanitize(name, true)
+}
+
+// promLabelName returns a valid prometheus label name, invalid chars are replaced with '_'.
+func promLabelName(name string) string {
+	return promSanitize(name, false)
+}
+
+// promSanitize replaces the chars which don't match [a-zA-Z_:][a-zA-Z0-9_:]* with '_',
+// colon is only allowed in metric name.
+func promSanitize(name string, allowColon bool) string {
+	var sb strings.Builder
+	for idx, c := range name {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c == '_':
+			sb.WriteRune(c)
+		case c >= '0' && c <= '9':
+			if idx == 0 {
+				sb.WriteByte('_')
+			}
+			sb.WriteRune(c)
+		case c == ':' && allowColon:
+			sb.WriteRune(c)
+		default:
+			sb.WriteByte('_')
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,92 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linmetric
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/series/tag"
+)
+
+type errWriter struct{}
+
+func (w *errWriter) Write(_ []byte) (int, error) {
+	return 0, fmt.Errorf("err")
+}
+
+func TestRegistry_WritePrometheus(t *testing.T) {
+	r := &Registry{series: make(map[uint64]*taggedSeries)}
+	// scope without field
+	r.NewScope("lindb.empty")
+	scope := r.NewScope("lindb.ut", "db", "a\"b")
+	counter := scope.NewCounter("write.rows")
+	counter.Add(10)
+	counter.Decr()
+	// counter is cumulative, not reset by gather
+	assert.Equal(t, float64(9), counter.gather())
+	counter.Incr()
+	scope.NewGauge("active").Update(3)
+	scope.NewMax("max_latency").Update(5)
+	scope.NewMin("min_latency").Update(1)
+	scope.NewCounterVec("errors", "type").WithTagValues("timeout").Incr()
+	h := r.NewScope("lindb.ut.latency").NewHistogram().WithLinearBuckets(time.Millisecond, 3*time.Millisecond, 3)
+	h.UpdateMilliseconds(1)
+	h.UpdateMilliseconds(2)
+	h.UpdateMilliseconds(10)
+
+	var buf bytes.Buffer
+	assert.NoError(t, r.WritePrometheus(&buf, tag.Tags{
+		{Key: []byte("node"), Value: []byte("1.1.1.1:9000")},
+		{Key: []byte("1-role"), Value: []byte("broker")},
+	}))
+	expect := []string{
+		`# TYPE lindb_ut_active gauge`,
+		`lindb_ut_active{_1_role="broker",db="a\"b",node="1.1.1.1:9000"} 3`,
+		`# TYPE lindb_ut_errors_total counter`,
+		`lindb_ut_errors_total{_1_role="broker",db="a\"b",node="1.1.1.1:9000",type="timeout"} 1`,
+		`# TYPE lindb_ut_latency histogram`,
+		`lindb_ut_latency_bucket{_1_role="broker",le="1",node="1.1.1.1:9000"} 1`,
+		`lindb_ut_latency_bucket{_1_role="broker",le="3",node="1.1.1.1:9000"} 2`,
+		`lindb_ut_latency_bucket{_1_role="broker",le="+Inf",node="1.1.1.1:9000"} 3`,
+		`lindb_ut_latency_sum{_1_role="broker",node="1.1.1.1:9000"} 13`,
+		`lindb_ut_latency_count{_1_role="broker",node="1.1.1.1:9000"} 3`,
+		`# TYPE lindb_ut_max_latency gauge`,
+		`lindb_ut_max_latency{_1_role="broker",db="a\"b",node="1.1.1.1:9000"} 5`,
+		`# TYPE lindb_ut_min_latency gauge`,
+		`lindb_ut_min_latency{_1_role="broker",db="a\"b",node="1.1.1.1:9000"} 1`,
+		`# TYPE lindb_ut_write_rows_total counter`,
+		`lindb_ut_write_rows_total{_1_role="broker",db="a\"b",node="1.1.1.1:9000"} 10`,
+		``,
+	}
+	assert.Equal(t, strings.Join(expect, "\n"), buf.String())
+
+	assert.Error(t, r.WritePrometheus(&errWriter{}, nil))
+}
+
+func Test_promLabels(t *testing.T) {
+	assert.Equal(t, "", promLabels(nil, nil))
+	assert.Equal(t, `{a_b="x\\y\nz"}`, promLabels(tag.Tags{{Key: []byte("a.b"), Value: []byte("x\\y\nz")}}, nil))
+	assert.Equal(t, "abc:d_e", promMetricName("abc:d-e"))
+	assert.Equal(t, "abc_d_e", promLabelName("abc:d-e"))
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/internal/linmetric"
+	httppkg "github.com/lindb/lindb/pkg/http"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/series/tag"
 )
@@ -84,7 +85,7 @@ func NewNativeProtoPusher(
 			linmetric.WithReadRuntimeOption(newRuntimeObserver(r)),
 			linmetric.WithGlobalKeyValueOption(globalKeyValues),
 		),
-		client: &http.Client{Timeout: pushTimeout},
+		client: &http.Client{Timeout: pushTimeout, Transport: httppkg.ClientTransport()},
 		buffer: &bytes.Buffer{},
 	}
 
@@ -0,0 +1,69 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitoring
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/internal/linmetric"
+	httppkg "github.com/lindb/lindb/pkg/http"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/series/tag"
+)
+
+var (
+	PrometheusMetricsPath = "/metrics"
+)
+
+// prometheusContentType represents the content type of prometheus text exposition format.
+const prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// PrometheusAPI represents prometheus metric exposition rest api.
+type PrometheusAPI struct {
+	globalKeyValues tag.Tags
+	r               *linmetric.Registry
+	logger          *logger.Logger
+}
+
+// NewPrometheusAPI creates prometheus metric exposition api instance.
+func NewPrometheusAPI(globalKeyValues tag.Tags, r *linmetric.Registry) *PrometheusAPI {
+	return &PrometheusAPI{
+		globalKeyValues: globalKeyValues,
+		r:               r,
+		logger:          logger.GetLogger("Monitoring", "PrometheusAPI"),
+	}
+}
+
+// Register adds prometheus metric exposition url route.
+func (d *PrometheusAPI) Register(route gin.IRoutes) {
+	route.GET(PrometheusMetricsPath, d.Metrics)
+}
+
+// Metrics exposes current node monitoring metric with prometheus text format.
+func (d *PrometheusAPI) Metrics(c *gin.Context) {
+	var buf bytes.Buffer
+	if err := d.r.WritePrometheus(&buf, d.globalKeyValues); err != nil {
+		d.logger.Error("write prometheus metric failure", logger.Error(err))
+		httppkg.Error(c, err)
+		return
+	}
+	c.Data(http.StatusOK, prometheusContentType, buf.Bytes())
+}
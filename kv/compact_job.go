@@ -41,7 +41,8 @@ type compactJob struct {
 	family    Family
 	state     *compactionState
 	newMerger NewMerger
-	rollup    Rollup // if rollup isn't nil, need do rollup job
+	rollup    Rollup      // if rollup isn't nil, need do rollup job
+	tombstone interface{} // if tombstone isn't nil, need purge deleted data
 
 	compactType string
 }
@@ -49,14 +50,18 @@ type compactJob struct {
 // newCompactJob creates a compaction job
 func newCompactJob(family Family, state *compactionState, rollup Rollup) CompactJob {
 	cType := "merge"
+	var tombstone interface{}
 	if rollup != nil {
 		cType = "rollup"
+	} else {
+		tombstone = family.getTombstone()
 	}
 	return &compactJob{
 		family:      family,
 		newMerger:   family.getNewMerger(),
 		state:       state,
 		rollup:      rollup,
+		tombstone:   tombstone,
 		compactType: cType,
 	}
 }
@@ -71,8 +76,8 @@ func (c *compactJob) Run() error {
 	}()
 	compaction := c.state.compaction
 	switch {
-	case c.rollup == nil && compaction.IsTrivialMove():
-		// compact job can move file
+	case c.rollup == nil && c.tombstone == nil && compaction.IsTrivialMove():
+		// compact job can move file, if it has deleted data, need merge file for purging them
 		c.moveCompaction()
 	default:
 		if err := c.mergeCompaction(); err != nil {
@@ -130,8 +135,11 @@ func (c *compactJob) doMerge() error {
 	if err != nil {
 		return err
 	}
-	if c.rollup != nil {
+	switch {
+	case c.rollup != nil:
 		merger.Init(map[string]interface{}{RollupContext: c.rollup})
+	case c.tombstone != nil:
+		merger.Init(map[string]interface{}{TombstoneContext: c.tombstone})
 	}
 
 	var needMerge [][]byte
@@ -73,6 +73,34 @@ func TestCompactJob_move_compact(t *testing.T) {
 	assert.Equal(t, version.CreateNewFile(1, f1), logs[1])
 }
 
+func TestCompactJob_tombstone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	snapshot := version.NewMockSnapshot(ctrl)
+	reader := table.NewMockReader(ctrl)
+	reader.EXPECT().Iterator().Return(generateIterator(ctrl, map[uint32][]byte{
+		1: []byte("value1"),
+	}))
+	snapshot.EXPECT().GetReader(gomock.Any()).Return(reader, nil)
+	merge := NewMockMerger(ctrl)
+	family := NewMockFamily(ctrl)
+	family.EXPECT().getNewMerger().Return(func(flusher Flusher) (Merger, error) {
+		return merge, nil
+	})
+	family.EXPECT().getTombstone().Return("tombstone")
+	family.EXPECT().familyInfo().Return("family").AnyTimes()
+	// has tombstone, cannot move file, need purge deleted data
+	merge.EXPECT().Init(map[string]interface{}{TombstoneContext: "tombstone"})
+	merge.EXPECT().Merge(uint32(1), gomock.Any()).Return(fmt.Errorf("err"))
+	f1 := version.NewFileMeta(1, 1, 100, 100)
+	compaction := version.NewCompaction(1, 0, []*version.FileMeta{f1}, nil)
+	state := newCompactionState(1000, snapshot, compaction)
+	compactJob := newCompactJob(family, state, nil)
+	err := compactJob.Run()
+	assert.Error(t, err)
+}
+
 func TestCompactJob_merge_compact_get_read_fail(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -268,6 +296,7 @@ func TestCompactJob_finish_output_fail(t *testing.T) {
 	snapshot := version.NewMockSnapshot(ctrl)
 	family := NewMockFamily(ctrl)
 	family.EXPECT().getNewMerger().Return(nil)
+	family.EXPECT().getTombstone().Return(nil)
 	compaction := version.NewCompaction(1, 0, nil, nil)
 	state := newCompactionState(1000, snapshot, compaction)
 	compact := newCompactJob(family, state, nil)
@@ -359,6 +388,7 @@ func generateMockFamily(ctrl *gomock.Controller, merger NewMerger) *MockFamily {
 	family.EXPECT().getNewMerger().Return(merger).AnyTimes()
 	family.EXPECT().Name().Return("test-family").AnyTimes()
 	family.EXPECT().commitEditLog(gomock.Any()).Return(true).AnyTimes()
+	family.EXPECT().getTombstone().Return(nil).AnyTimes()
 	return family
 }
 
@@ -22,6 +22,7 @@ import "github.com/lindb/lindb/pkg/logger"
 const (
 	dummy                   = ""
 	RollupContext           = "RollupContext"
+	TombstoneContext        = "TombstoneContext"
 	defaultMaxFileSize      = uint32(256 * 1024 * 1024)
 	defaultCompactThreshold = 4
 	defaultRollupThreshold  = 3
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 
@@ -37,8 +38,12 @@ import (
 var (
 	newCompactJobFunc = newCompactJob
 	removeDirFunc     = fileutil.RemoveDir
+	sleepFunc         = time.Sleep
 )
 
+// purgeWaitInterval is the interval of checking background compaction job when purge family.
+const purgeWaitInterval = 100 * time.Millisecond
+
 // Family implements column family for data isolation each family.
 type Family interface {
 	// ID return family's id.
@@ -51,6 +56,12 @@ type Family interface {
 	GetSnapshot() version.Snapshot
 	// Compact compacts all files of level0.
 	Compact()
+	// SetTombstone sets the deleted data context, which passes to merger when does compact job,
+	// merger purges the deleted data based on it.
+	SetTombstone(tombstone interface{})
+	// Purge compacts all files of level0/level1 with the deleted data context synchronously,
+	// so that the deleted data is purged from all files, does nothing if tombstone not set.
+	Purge() error
 
 	getStore() Store
 	// familyInfo return family info
@@ -68,6 +79,8 @@ type Family interface {
 	compact()
 	// getNewMerger returns new merger function, merger need implement Merger interface
 	getNewMerger() NewMerger
+	// getTombstone returns the deleted data context of family.
+	getTombstone() interface{}
 	// addPendingOutput add a file which current writing file number
 	addPendingOutput(fileNumber table.FileNumber)
 	// removePendingOutput removes pending output file after compact or flush
@@ -93,6 +106,7 @@ type family struct {
 	merger        NewMerger
 	familyVersion version.FamilyVersion
 	maxFileSize   uint32
+	compression   table.CompressionType
 
 	pendingOutputs    sync.Map // keep all pending output files, includes flush/compact/rollup.
 	newCompactJobFunc func(family Family, state *compactionState, rollup Rollup) CompactJob
@@ -100,6 +114,7 @@ type family struct {
 	rolluping      atomic.Bool
 	lastRollupTime *atomic.Int64
 	compacting     atomic.Bool
+	tombstone      atomic.Value
 
 	condition sync.WaitGroup // compact/rollup job if it's doing
 }
@@ -119,6 +134,10 @@ func newFamily(store Store, option FamilyOption) (Family, error) {
 	if !ok {
 		return nil, fmt.Errorf("merger of option not impelement Merger interface, merger is [%s]", option.Merger)
 	}
+	compression, err := table.ParseCompressionType(option.ValueCompression)
+	if err != nil {
+		return nil, fmt.Errorf("value compression of family option is invalid: %w", err)
+	}
 	maxFileSize := defaultMaxFileSize
 	if option.MaxFileSize > 0 {
 		maxFileSize = option.MaxFileSize
@@ -131,6 +150,7 @@ func newFamily(store Store, option FamilyOption) (Family, error) {
 		option:            option,
 		merger:            merger,
 		maxFileSize:       maxFileSize,
+		compression:       compression,
 		newCompactJobFunc: newCompactJobFunc,
 		familyVersion:     store.createFamilyVersion(name, version.FamilyID(option.ID)),
 		lastRollupTime:    atomic.NewInt64(timeutil.Now()),
@@ -176,7 +196,7 @@ func (f *family) familyInfo() string {
 func (f *family) newTableBuilder() (table.Builder, error) {
 	fileNumber := f.store.nextFileNumber()
 	fileName := filepath.Join(f.familyPath, version.Table(fileNumber))
-	return table.NewStoreBuilder(fileNumber, fileName)
+	return table.NewStoreBuilder(fileNumber, fileName, f.compression)
 }
 
 // commitEditLog persists edit logs into manifest file.
@@ -272,6 +292,39 @@ func (f *family) backgroundCompactionJob() error {
 	return nil
 }
 
+// Purge compacts all files of level0/level1 with the deleted data context synchronously,
+// so that the deleted data is purged from all files, does nothing if tombstone not set.
+func (f *family) Purge() error {
+	if f.getTombstone() == nil {
+		return nil
+	}
+	// wait background compaction job completed
+	for !f.compacting.CAS(false, true) {
+		sleepFunc(purgeWaitInterval)
+	}
+	f.condition.Add(1)
+	defer func() {
+		f.condition.Done()
+		f.compacting.Store(false)
+	}()
+
+	snapshot := f.GetSnapshot()
+	defer func() {
+		snapshot.Close()
+		// clean up unused files, maybe some file not used
+		f.deleteObsoleteFiles()
+	}()
+	current := snapshot.GetCurrent()
+	levelInputs := current.GetFiles(0)
+	levelUpInputs := current.GetFiles(1)
+	if len(levelInputs) == 0 && len(levelUpInputs) == 0 {
+		return nil
+	}
+	compaction := version.NewCompaction(f.ID(), 0, levelInputs, levelUpInputs)
+	compactionState := newCompactionState(f.maxFileSize, snapshot, compaction)
+	return f.newCompactJobFunc(f, compactionState, nil).Run()
+}
+
 // addPendingOutput add a file which current writing file number
 func (f *family) addPendingOutput(fileNumber table.FileNumber) {
 	f.pendingOutputs.Store(fileNumber, dummy)
@@ -290,6 +343,20 @@ func (f *family) deleteSST(fileNumber table.FileNumber) error {
 	return nil
 }
 
+// SetTombstone sets the deleted data context, which passes to merger when does compact job.
+func (f *family) SetTombstone(tombstone interface{}) {
+	f.tombstone.Store(&tombstone)
+}
+
+// getTombstone returns the deleted data context of family.
+func (f *family) getTombstone() interface{} {
+	tombstone, ok := f.tombstone.Load().(*interface{})
+	if !ok {
+		return nil
+	}
+	return *tombstone
+}
+
 // getFamilyVersion returns the family version
 func (f *family) getFamilyVersion() version.FamilyVersion {
 	return f.familyVersion
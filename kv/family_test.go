@@ -18,6 +18,7 @@
 package kv
 
 import (
+	"bytes"
 	"fmt"
 	"path/filepath"
 	"testing"
@@ -58,12 +59,17 @@ func TestFamily_New(t *testing.T) {
 	f, err = newFamily(store, FamilyOption{Merger: "mockMerger_not_exist"})
 	assert.Error(t, err)
 	assert.Nil(t, f)
-	// case 3: create family success
+	// case 3: create family err, compression not exist
+	f, err = newFamily(store, FamilyOption{Merger: "mockMerger", ValueCompression: "compression_not_exist"})
+	assert.Error(t, err)
+	assert.Nil(t, f)
+	// case 4: create family success
 	vs := version.NewMockFamilyVersion(ctrl)
 	store.EXPECT().createFamilyVersion(gomock.Any(), gomock.Any()).Return(vs)
-	f, err = newFamily(store, FamilyOption{Merger: "mockMerger", ID: 10, Name: "f", MaxFileSize: 10})
+	f, err = newFamily(store, FamilyOption{Merger: "mockMerger", ID: 10, Name: "f", MaxFileSize: 10, ValueCompression: "zstd"})
 	assert.NoError(t, err)
 	assert.NotNil(t, f)
+	assert.Equal(t, table.ZstdCompression, f.(*family).compression)
 	assert.Equal(t, version.FamilyID(10), f.ID())
 	assert.Equal(t, "f", f.Name())
 	vs.EXPECT().GetSnapshot().Return(version.NewMockSnapshot(ctrl))
@@ -74,6 +80,12 @@ func TestFamily_New(t *testing.T) {
 
 	assert.NotNil(t, f.getFamilyVersion())
 	assert.NotNil(t, f.getNewMerger())
+
+	assert.Nil(t, f.getTombstone())
+	f.SetTombstone("tombstone")
+	assert.Equal(t, "tombstone", f.getTombstone())
+	f.SetTombstone(nil)
+	assert.Nil(t, f.getTombstone())
 }
 
 func TestFamily_Data_Write_Read(t *testing.T) {
@@ -105,6 +117,46 @@ func TestFamily_Data_Write_Read(t *testing.T) {
 	snapshot.Close()
 }
 
+func TestFamily_ValueCompression(t *testing.T) {
+	for _, compression := range []string{"none", "snappy", "zstd", "lz4"} {
+		compression := compression
+		t.Run(compression, func(t *testing.T) {
+			testKVPath := filepath.Join(t.TempDir(), "test_data")
+			kv, err := newStore("test_kv", testKVPath, DefaultStoreOption())
+			assert.NoError(t, err)
+			f, err := kv.CreateFamily("f", FamilyOption{Merger: "mockMerger", ValueCompression: compression})
+			assert.NoError(t, err)
+			value := bytes.Repeat([]byte("test-value"), 100)
+			flusher := f.NewFlusher()
+			assert.NoError(t, flusher.Add(1, value))
+			assert.NoError(t, flusher.Add(10, []byte("test10")))
+			assert.NoError(t, flusher.Commit())
+			flusher.Release()
+			assert.NoError(t, kv.close())
+
+			// reopen store, values can be read after restart
+			kv, err = newStore("test_kv", testKVPath, DefaultStoreOption())
+			assert.NoError(t, err)
+			defer func() {
+				_ = kv.close()
+			}()
+			f = kv.GetFamily("f")
+			assert.Equal(t, compression, f.(*family).option.ValueCompression)
+			snapshot := f.GetSnapshot()
+			defer snapshot.Close()
+			readers, err := snapshot.FindReaders(10)
+			assert.NoError(t, err)
+			assert.Len(t, readers, 1)
+			v, err := readers[0].Get(1)
+			assert.NoError(t, err)
+			assert.Equal(t, value, v)
+			v, err = readers[0].Get(10)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("test10"), v)
+		})
+	}
+}
+
 func TestFamily_commitEditLog(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -329,3 +381,60 @@ func TestFamily_Comapct(t *testing.T) {
 	f.Compact()
 	time.Sleep(100 * time.Millisecond)
 }
+
+func TestFamily_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		sleepFunc = time.Sleep
+		ctrl.Finish()
+	}()
+	store := NewMockStore(ctrl)
+	store.EXPECT().Option().Return(DefaultStoreOption()).AnyTimes()
+	store.EXPECT().Path().Return(t.TempDir())
+	fv := version.NewMockFamilyVersion(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	v := version.NewMockVersion(ctrl)
+	snapshot.EXPECT().Close().AnyTimes()
+	snapshot.EXPECT().GetCurrent().Return(v).AnyTimes()
+	fv.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	fv.EXPECT().GetID().Return(version.FamilyID(1)).AnyTimes()
+	fv.EXPECT().GetAllActiveFiles().Return(nil).AnyTimes()
+	fv.EXPECT().GetLiveRollupFiles().Return(nil).AnyTimes()
+	store.EXPECT().createFamilyVersion(gomock.Any(), gomock.Any()).Return(fv)
+	f, err := newFamily(store, FamilyOption{Merger: "mockMerger", Name: "purge"})
+	assert.NoError(t, err)
+	f1 := f.(*family)
+	compactJob := NewMockCompactJob(ctrl)
+	var compaction *version.Compaction
+	f1.newCompactJobFunc = func(family Family, state *compactionState, rollup Rollup) CompactJob {
+		assert.Nil(t, rollup)
+		compaction = state.compaction
+		return compactJob
+	}
+	// case 1: no tombstone
+	assert.NoError(t, f.Purge())
+	f.SetTombstone("tombstone")
+	// case 2: no files
+	v.EXPECT().GetFiles(0).Return(nil)
+	v.EXPECT().GetFiles(1).Return(nil)
+	assert.NoError(t, f.Purge())
+	// case 3: wait running compaction job, then purge all files
+	f1.compacting.Store(true)
+	sleepFunc = func(d time.Duration) {
+		f1.compacting.Store(false)
+	}
+	l0 := []*version.FileMeta{version.NewFileMeta(1, 1, 10, 1024)}
+	l1 := []*version.FileMeta{version.NewFileMeta(2, 1, 10, 1024), version.NewFileMeta(3, 20, 30, 1024)}
+	v.EXPECT().GetFiles(0).Return(l0)
+	v.EXPECT().GetFiles(1).Return(l1)
+	compactJob.EXPECT().Run().Return(nil)
+	assert.NoError(t, f.Purge())
+	assert.Equal(t, [][]*version.FileMeta{l0, l1}, compaction.GetInputs())
+	assert.False(t, f1.compacting.Load())
+	// case 4: compact job failure
+	v.EXPECT().GetFiles(0).Return(nil)
+	v.EXPECT().GetFiles(1).Return(l1)
+	compactJob.EXPECT().Run().Return(fmt.Errorf("err"))
+	assert.Error(t, f.Purge())
+	assert.False(t, f1.compacting.Load())
+}
@@ -32,6 +32,7 @@ type FamilyOption struct {
 	RollupThreshold  int    `toml:"rollupThreshold"`  // level 0 rollup threshold
 	Merger           string `toml:"merger"`           // merger which need implement Merger interface
 	MaxFileSize      uint32 `toml:"maxFileSize"`      // max file size
+	ValueCompression string `toml:"valueCompression"` // compression codec of each value(none/snappy/zstd/lz4)
 }
 
 // StoreOption defines config item for store level
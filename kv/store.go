@@ -33,6 +33,7 @@ import (
 	"github.com/lindb/lindb/pkg/lockers"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 //go:generate mockgen -source ./store.go -destination=./store_mock.go -package kv
@@ -65,8 +66,14 @@ type Store interface {
 	ListFamilyNames() []string
 	// Option returns the store configuration options
 	Option() StoreOption
+	// SetRollup sets the rollup source/target intervals of store,
+	// new rollup option applies on next flush/rollup job.
+	SetRollup(source timeutil.Interval, rollup []timeutil.Interval)
 	// ForceRollup does rollup job manual.
 	ForceRollup()
+	// Checkpoint creates a point-in-time snapshot of store into given dir,
+	// links(copies if link not supported) all active files of each family.
+	Checkpoint(dir string) error
 
 	// compact the families under store.
 	compact()
@@ -88,6 +95,8 @@ type store struct {
 	name   string
 	path   string
 	option StoreOption
+	// RWMutex for accessing store option
+	optionMutex sync.RWMutex
 	// file-lock restricts access to store by allowing only one instance
 	lock     lockers.FileLock
 	versions version.StoreVersionSet
@@ -264,9 +273,20 @@ func (s *store) ListFamilyNames() []string {
 
 // Option returns the store configuration options
 func (s *store) Option() StoreOption {
+	s.optionMutex.RLock()
+	defer s.optionMutex.RUnlock()
 	return s.option
 }
 
+// SetRollup sets the rollup source/target intervals of store,
+// new rollup option applies on next flush/rollup job.
+func (s *store) SetRollup(source timeutil.Interval, rollup []timeutil.Interval) {
+	s.optionMutex.Lock()
+	defer s.optionMutex.Unlock()
+	s.option.Source = source
+	s.option.Rollup = rollup
+}
+
 // ForceRollup does rollup job manual.
 func (s *store) ForceRollup() {
 	families := s.getCurrentFamilies()
@@ -275,6 +295,46 @@ func (s *store) ForceRollup() {
 	}
 }
 
+// Checkpoint creates a point-in-time snapshot of store into given dir,
+// links(copies if link not supported) all active files of each family.
+func (s *store) Checkpoint(dir string) error {
+	// family cannot be created when do checkpoint
+	s.rwMutex.RLock()
+	defer s.rwMutex.RUnlock()
+
+	if err := mkDirFunc(dir); err != nil {
+		return err
+	}
+	snapshots, err := s.versions.Checkpoint(dir)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, snapshot := range snapshots {
+			snapshot.Close()
+		}
+	}()
+	for familyName, snapshot := range snapshots {
+		familyDir := filepath.Join(dir, familyName)
+		if err := mkDirFunc(familyDir); err != nil {
+			return err
+		}
+		for _, file := range snapshot.GetCurrent().GetAllFiles() {
+			fileName := version.Table(file.GetFileNumber())
+			if err := fileutil.LinkOrCopy(filepath.Join(s.path, familyName, fileName), filepath.Join(familyDir, fileName)); err != nil {
+				return err
+			}
+		}
+	}
+	// write store info
+	infoPath := filepath.Join(dir, version.Options)
+	if err := encodeTomlFunc(infoPath, s.storeInfo); err != nil {
+		return fmt.Errorf("write store info to file[%s] error:%s", infoPath, err)
+	}
+	kvLogger.Info("checkpoint store successfully", logger.String("store", s.path), logger.String("dir", dir))
+	return nil
+}
+
 // close the store, then release some resource
 func (s *store) close() error {
 	// close each family in kv store.
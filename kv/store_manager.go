@@ -63,6 +63,9 @@ type StoreManager interface {
 	// CreateStore creates the store by name/option.
 	// NOTICE: name need include relatively path based on root path.
 	CreateStore(name string, option StoreOption) (Store, error)
+	// CreateStoreWithPath creates the store by name/option, the store's files are under the given path,
+	// which maybe not under root path(like cold storage).
+	CreateStoreWithPath(name, path string, option StoreOption) (Store, error)
 	// GetStoreByName returns Store by given name.
 	GetStoreByName(name string) (Store, bool)
 	// GetStores returns all Store under manager cache.
@@ -90,6 +93,12 @@ func newStoreManager(options StoreOptions) StoreManager {
 // CreateStore creates the store by name/option.
 // NOTICE: name need include relatively path based on root path.
 func (s *storeManager) CreateStore(name string, option StoreOption) (Store, error) {
+	return s.CreateStoreWithPath(name, filepath.Join(s.options.Dir, name), option)
+}
+
+// CreateStoreWithPath creates the store by name/option, the store's files are under the given path,
+// which maybe not under root path(like cold storage).
+func (s *storeManager) CreateStoreWithPath(name, path string, option StoreOption) (Store, error) {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
@@ -97,7 +106,7 @@ func (s *storeManager) CreateStore(name string, option StoreOption) (Store, erro
 		return store, nil
 	}
 
-	store, err := newStoreFunc(name, filepath.Join(s.options.Dir, name), option)
+	store, err := newStoreFunc(name, path, option)
 	if err != nil {
 		return nil, err
 	}
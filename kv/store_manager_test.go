@@ -128,6 +128,26 @@ func TestStoreManager_CreateStore(t *testing.T) {
 	}
 }
 
+func TestStoreManager_CreateStoreWithPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newStoreFunc = newStore
+		ctrl.Finish()
+	}()
+	storeMgr := newStoreManager(StoreOptions{Dir: "/data"})
+	newStoreFunc = func(name, path string, option StoreOption) (s Store, err error) {
+		assert.Equal(t, "cold-store", name)
+		assert.Equal(t, "/cold/cold-store", path)
+		return NewMockStore(ctrl), nil
+	}
+	store, err := storeMgr.CreateStoreWithPath("cold-store", "/cold/cold-store", StoreOption{})
+	assert.NoError(t, err)
+	assert.NotNil(t, store)
+	store1, ok := storeMgr.GetStoreByName("cold-store")
+	assert.True(t, ok)
+	assert.Equal(t, store, store1)
+}
+
 func TestMockStoreManager_CloseStore(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
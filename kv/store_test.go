@@ -32,6 +32,7 @@ import (
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/lockers"
 	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 var mergerStr = "mockMergerAppend"
@@ -271,6 +272,69 @@ func TestStore_Compact(t *testing.T) {
 	snapshot.Close()
 }
 
+func TestStore_Checkpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dir := t.TempDir()
+	defer func() {
+		mkDirFunc = fileutil.MkDirIfNotExist
+		encodeTomlFunc = ltoml.EncodeToml
+		ctrl.Finish()
+	}()
+	option := DefaultStoreOption()
+	kv, err := newStore("test_kv", filepath.Join(dir, "store"), option)
+	assert.NoError(t, err)
+	f1, err := kv.CreateFamily("f", FamilyOption{Merger: mergerStr})
+	assert.NoError(t, err)
+	flusher := f1.NewFlusher()
+	assert.NoError(t, flusher.Add(1, []byte("test")))
+	flusher.Sequence(1, 100)
+	assert.NoError(t, flusher.Commit())
+	flusher.Release()
+
+	// case 1: checkpoint successfully
+	checkpoint := filepath.Join(dir, "checkpoint")
+	assert.NoError(t, kv.Checkpoint(checkpoint))
+	// case 2: make dir err
+	mkDirFunc = func(path string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, kv.Checkpoint(filepath.Join(dir, "checkpoint2")))
+	mkDirFunc = fileutil.MkDirIfNotExist
+	// case 3: write store info err
+	encodeTomlFunc = func(fileName string, v interface{}) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, kv.Checkpoint(filepath.Join(dir, "checkpoint3")))
+	encodeTomlFunc = ltoml.EncodeToml
+	// case 4: version set checkpoint err
+	kv1 := kv.(*store)
+	versionSet := kv1.versions
+	mockVersionSet := version.NewMockStoreVersionSet(ctrl)
+	mockVersionSet.EXPECT().Checkpoint(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	kv1.versions = mockVersionSet
+	assert.Error(t, kv.Checkpoint(filepath.Join(dir, "checkpoint4")))
+	kv1.versions = versionSet
+	assert.NoError(t, kv.close())
+
+	// open store from checkpoint
+	kv, err = newStore("test_kv", checkpoint, option)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, kv.close())
+	}()
+	f1 = kv.GetFamily("f")
+	assert.NotNil(t, f1)
+	snapshot := f1.GetSnapshot()
+	defer snapshot.Close()
+	// replica sequence excluded
+	assert.Empty(t, snapshot.GetCurrent().GetSequences())
+	readers, err := snapshot.FindReaders(1)
+	assert.NoError(t, err)
+	assert.Len(t, readers, 1)
+	value, _ := readers[0].Get(1)
+	assert.Equal(t, []byte("test"), value)
+}
+
 func TestStore_Rollup(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	path := "rollup_test"
@@ -307,6 +371,10 @@ func TestStore_Rollup(t *testing.T) {
 
 	family.EXPECT().rollup()
 	kv.ForceRollup()
+
+	kv.SetRollup(timeutil.Interval(10*timeutil.OneSecond), []timeutil.Interval{timeutil.Interval(timeutil.OneHour)})
+	assert.Equal(t, timeutil.Interval(10*timeutil.OneSecond), kv.Option().Source)
+	assert.Equal(t, []timeutil.Interval{timeutil.Interval(timeutil.OneHour)}, kv.Option().Rollup)
 }
 
 func TestStore_Close(t *testing.T) {
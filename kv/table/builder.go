@@ -18,6 +18,7 @@
 package table
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"hash"
@@ -97,6 +98,8 @@ type storeBuilder struct {
 	fileName   string
 	writer     bufioutil.BufioWriter
 	offset     *encoding.FixedOffsetEncoder
+	// compression codec of values
+	compression CompressionType
 
 	// see paper of roaring bitmap: https://arxiv.org/pdf/1603.06549.pdf
 	keys   *roaring.Bitmap
@@ -106,19 +109,21 @@ type storeBuilder struct {
 	first bool
 }
 
-// NewStoreBuilder creates store builder instance for building store file
-func NewStoreBuilder(fileNumber FileNumber, fileName string) (Builder, error) {
+// NewStoreBuilder creates store builder instance for building store file,
+// values are compressed by given compression codec.
+func NewStoreBuilder(fileNumber FileNumber, fileName string, compression CompressionType) (Builder, error) {
 	writer, err := newBufioWriterFunc(fileName)
 	if err != nil {
 		return nil, fmt.Errorf("create file write for store builder error:%s", err)
 	}
 	return &storeBuilder{
-		fileNumber: fileNumber,
-		fileName:   fileName,
-		keys:       roaring.New(),
-		writer:     writer,
-		first:      true,
-		offset:     encoding.NewFixedOffsetEncoder(true),
+		fileNumber:  fileNumber,
+		fileName:    fileName,
+		keys:        roaring.New(),
+		writer:      writer,
+		first:       true,
+		offset:      encoding.NewFixedOffsetEncoder(true),
+		compression: compression,
 	}, nil
 }
 
@@ -162,7 +167,7 @@ func (b *storeBuilder) Add(key uint32, value []byte) error {
 
 	// get write offset
 	offset := b.writer.Size()
-	if _, err := b.writer.Write(value); err != nil {
+	if err := b.writeValue(value); err != nil {
 		return fmt.Errorf("write data into store file error:%s", err)
 	}
 	metrics.TableWriteStatistics.AddKeys.Incr()
@@ -171,6 +176,22 @@ func (b *storeBuilder) Add(key uint32, value []byte) error {
 	return nil
 }
 
+// writeValue compresses value if builder set compression codec, then writes it into store file.
+func (b *storeBuilder) writeValue(value []byte) error {
+	if b.compression != NoCompression {
+		compressed, err := b.compression.compress(value)
+		if err != nil {
+			metrics.TableWriteStatistics.CompressFailures.Incr()
+			return err
+		}
+		metrics.TableWriteStatistics.CompressBytes.Add(float64(len(value)))
+		metrics.TableWriteStatistics.CompressedBytes.Add(float64(len(compressed)))
+		value = compressed
+	}
+	_, err := b.writer.Write(value)
+	return err
+}
+
 // MinKey returns min key in store
 func (b *storeBuilder) MinKey() uint32 {
 	return b.minKey
@@ -222,11 +243,20 @@ func (b *storeBuilder) Close() (err error) {
 		return err
 	}
 
+	fileVersion := byte(version0)
+	if b.compression != NoCompression {
+		// write compression type before footer
+		fileVersion = version1
+		if _, err = b.writer.Write([]byte{byte(b.compression)}); err != nil {
+			return err
+		}
+	}
+
 	// for file footer for offsets/keys index, length=1+4+4+8
 	var buf [17]byte
 	binary.LittleEndian.PutUint32(buf[:4], uint32(posOfOffset))
 	binary.LittleEndian.PutUint32(buf[4:8], uint32(posOfKeys))
-	buf[8] = version0
+	buf[8] = fileVersion
 	binary.LittleEndian.PutUint64(buf[9:], magicNumberOffsetFile)
 	if _, err = b.writer.Write(buf[:]); err != nil {
 		return err
@@ -253,6 +283,8 @@ type streamWriter struct {
 	offset  int64
 	badKey  bool
 	crc32   hash.Hash32
+	// buffers value if builder set compression codec, compresses it when commit
+	buf bytes.Buffer
 }
 
 func (sw *streamWriter) Prepare(key uint32) {
@@ -261,13 +293,22 @@ func (sw *streamWriter) Prepare(key uint32) {
 	sw.key = key
 	sw.size = 0
 	sw.crc32.Reset()
+	sw.buf.Reset()
 }
 
 func (sw *streamWriter) Write(data []byte) (int, error) {
 	if sw.badKey {
 		return 0, nil
 	}
-	n, err := sw.builder.writer.Write(data)
+	var (
+		n   int
+		err error
+	)
+	if sw.builder.compression == NoCompression {
+		n, err = sw.builder.writer.Write(data)
+	} else {
+		n, err = sw.buf.Write(data)
+	}
 	_, _ = sw.crc32.Write(data)
 	if err == nil {
 		sw.size += uint32(n)
@@ -288,6 +329,12 @@ func (sw *streamWriter) Commit() error {
 	if sw.badKey {
 		return nil
 	}
+	if sw.builder.compression != NoCompression {
+		if err := sw.builder.writeValue(sw.buf.Bytes()); err != nil {
+			return err
+		}
+		sw.buf.Reset()
+	}
 	sw.builder.afterWrite(sw.key, int(sw.offset))
 	// preventing committing twice
 	sw.badKey = true
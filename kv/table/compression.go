@@ -0,0 +1,110 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"fmt"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// CompressionType represents the compression codec of values in sst file,
+// each value(the whole data of a key) is compressed independently for random access by key.
+type CompressionType uint8
+
+const (
+	// NoCompression writes raw value.
+	NoCompression CompressionType = iota
+	// SnappyCompression compresses value using snappy.
+	SnappyCompression
+	// ZstdCompression compresses value using zstd.
+	ZstdCompression
+	// LZ4Compression compresses value using lz4 block format.
+	LZ4Compression
+)
+
+// shared zstd codec, EncodeAll/DecodeAll can be used concurrently.
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// ParseCompressionType returns the compression type by name, empty name means no compression.
+func ParseCompressionType(name string) (CompressionType, error) {
+	switch name {
+	case "", "none":
+		return NoCompression, nil
+	case "snappy":
+		return SnappyCompression, nil
+	case "zstd":
+		return ZstdCompression, nil
+	case "lz4":
+		return LZ4Compression, nil
+	default:
+		return NoCompression, fmt.Errorf("unknown compression type: %s", name)
+	}
+}
+
+// String returns the name of compression type.
+func (t CompressionType) String() string {
+	switch t {
+	case NoCompression:
+		return "none"
+	case SnappyCompression:
+		return "snappy"
+	case ZstdCompression:
+		return "zstd"
+	case LZ4Compression:
+		return "lz4"
+	default:
+		return "unknown"
+	}
+}
+
+// compress compresses the value, returns raw value if no compression.
+func (t CompressionType) compress(value []byte) ([]byte, error) {
+	switch t {
+	case NoCompression:
+		return value, nil
+	case SnappyCompression:
+		return snappy.Encode(nil, value), nil
+	case ZstdCompression:
+		return zstdEncoder.EncodeAll(value, nil), nil
+	case LZ4Compression:
+		return lz4Encode(value), nil
+	default:
+		return nil, fmt.Errorf("unknown compression type: %d", t)
+	}
+}
+
+// decompress decompresses the value, returns raw value if no compression.
+func (t CompressionType) decompress(value []byte) ([]byte, error) {
+	switch t {
+	case NoCompression:
+		return value, nil
+	case SnappyCompression:
+		return snappy.Decode(nil, value)
+	case ZstdCompression:
+		return zstdDecoder.DecodeAll(value, nil)
+	case LZ4Compression:
+		return lz4Decode(value)
+	default:
+		return nil, fmt.Errorf("unknown compression type: %d", t)
+	}
+}
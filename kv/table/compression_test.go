@@ -0,0 +1,77 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCompressionType(t *testing.T) {
+	cases := []struct {
+		name        string
+		compression CompressionType
+		wantErr     bool
+	}{
+		{name: "", compression: NoCompression},
+		{name: "none", compression: NoCompression},
+		{name: "snappy", compression: SnappyCompression},
+		{name: "zstd", compression: ZstdCompression},
+		{name: "lz4", compression: LZ4Compression},
+		{name: "lz", compression: NoCompression, wantErr: true},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			compression, err := ParseCompressionType(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+			assert.Equal(t, tt.compression, compression)
+		})
+	}
+	assert.Equal(t, "none", NoCompression.String())
+	assert.Equal(t, "snappy", SnappyCompression.String())
+	assert.Equal(t, "zstd", ZstdCompression.String())
+	assert.Equal(t, "lz4", LZ4Compression.String())
+	assert.Equal(t, "unknown", CompressionType(100).String())
+}
+
+func TestCompressionType_compress(t *testing.T) {
+	value := []byte("test-value-test-value-test-value")
+	for _, compression := range []CompressionType{NoCompression, SnappyCompression, ZstdCompression, LZ4Compression} {
+		compressed, err := compression.compress(value)
+		assert.NoError(t, err)
+		decompressed, err := compression.decompress(compressed)
+		assert.NoError(t, err)
+		assert.Equal(t, value, decompressed)
+	}
+	// bad compressed data
+	_, err := SnappyCompression.decompress([]byte{0xff, 0xff, 0xff})
+	assert.Error(t, err)
+	_, err = ZstdCompression.decompress([]byte{0xff, 0xff, 0xff})
+	assert.Error(t, err)
+	_, err = LZ4Compression.decompress([]byte{0xff, 0xff, 0xff})
+	assert.Error(t, err)
+	// unknown compression type
+	_, err = CompressionType(100).compress(value)
+	assert.Error(t, err)
+	_, err = CompressionType(100).decompress(value)
+	assert.Error(t, err)
+}
@@ -0,0 +1,200 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// lz4 block format(https://github.com/lz4/lz4/blob/dev/doc/lz4_Block_format.md),
+// raw length(uvarint) is written before the block for allocating decompressed buffer.
+const (
+	lz4MinMatch     = 4
+	lz4LastLiterals = 5  // last 5 bytes are always literals
+	lz4MFLimit      = 12 // last match must start at least 12 bytes before the end of block
+	lz4HashLog      = 14
+	lz4MaxOffset    = 65535
+	lz4MaxRatio     = 255 // max compression ratio of lz4 block
+)
+
+var errLZ4Corrupt = errors.New("lz4: corrupt input")
+
+// lz4Encode compresses src using lz4 block format.
+func lz4Encode(src []byte) []byte {
+	dst := make([]byte, binary.MaxVarintLen64, len(src)+len(src)/255+16+binary.MaxVarintLen64)
+	dst = dst[:binary.PutUvarint(dst, uint64(len(src)))]
+	if len(src) <= lz4MFLimit {
+		return lz4AppendSequence(dst, src, 0, 0)
+	}
+	var table [1 << lz4HashLog]int32 // position+1 of sequence, 0 means empty
+	anchor := 0
+	limit := len(src) - lz4MFLimit
+	for i := 0; i < limit; {
+		seq := binary.LittleEndian.Uint32(src[i:])
+		h := lz4Hash(seq)
+		ref := int(table[h]) - 1
+		table[h] = int32(i + 1)
+		if ref < 0 || i-ref > lz4MaxOffset || binary.LittleEndian.Uint32(src[ref:]) != seq {
+			i++
+			continue
+		}
+		// extend match forward
+		end := i + lz4MinMatch
+		for refEnd := ref + lz4MinMatch; end < len(src)-lz4LastLiterals && src[end] == src[refEnd]; refEnd++ {
+			end++
+		}
+		// extend match backward
+		for i > anchor && ref > 0 && src[i-1] == src[ref-1] {
+			i--
+			ref--
+		}
+		dst = lz4AppendSequence(dst, src[anchor:i], i-ref, end-i)
+		i = end
+		anchor = end
+	}
+	// last literals
+	return lz4AppendSequence(dst, src[anchor:], 0, 0)
+}
+
+// lz4Decode decompresses src which compressed by lz4Encode.
+func lz4Decode(src []byte) ([]byte, error) {
+	size, n := binary.Uvarint(src)
+	if n <= 0 {
+		return nil, errLZ4Corrupt
+	}
+	src = src[n:]
+	// check raw length before allocating, avoid allocating huge buffer for corrupt input
+	if size > uint64(len(src))*lz4MaxRatio {
+		return nil, errLZ4Corrupt
+	}
+	dst := make([]byte, 0, size)
+	for i := 0; i < len(src); {
+		token := src[i]
+		i++
+		// copy literals
+		literals := int(token >> 4)
+		if literals == 15 {
+			var ok bool
+			if literals, i, ok = lz4ReadLength(src, i, literals); !ok {
+				return nil, errLZ4Corrupt
+			}
+		}
+		if literals > len(src)-i || uint64(len(dst)+literals) > size {
+			return nil, errLZ4Corrupt
+		}
+		dst = append(dst, src[i:i+literals]...)
+		i += literals
+		if i == len(src) {
+			// last sequence has no match
+			break
+		}
+		// copy match
+		if i+2 > len(src) {
+			return nil, errLZ4Corrupt
+		}
+		offset := int(src[i]) | int(src[i+1])<<8
+		i += 2
+		if offset == 0 || offset > len(dst) {
+			return nil, errLZ4Corrupt
+		}
+		matchLen := int(token & 0xf)
+		if matchLen == 15 {
+			var ok bool
+			if matchLen, i, ok = lz4ReadLength(src, i, matchLen); !ok {
+				return nil, errLZ4Corrupt
+			}
+		}
+		matchLen += lz4MinMatch
+		if uint64(len(dst)+matchLen) > size {
+			return nil, errLZ4Corrupt
+		}
+		start := len(dst) - offset
+		if offset >= matchLen {
+			dst = append(dst, dst[start:start+matchLen]...)
+		} else {
+			// overlapped match, copy byte by byte
+			for k := 0; k < matchLen; k++ {
+				dst = append(dst, dst[start+k])
+			}
+		}
+	}
+	if uint64(len(dst)) != size {
+		return nil, errLZ4Corrupt
+	}
+	return dst, nil
+}
+
+// lz4AppendSequence appends a sequence(literals + match) into dst, offset 0 means no match(last sequence).
+func lz4AppendSequence(dst, literals []byte, offset, matchLen int) []byte {
+	var token byte
+	if len(literals) >= 15 {
+		token = 15 << 4
+	} else {
+		token = byte(len(literals)) << 4
+	}
+	matchLen -= lz4MinMatch
+	if offset > 0 {
+		if matchLen >= 15 {
+			token |= 15
+		} else {
+			token |= byte(matchLen)
+		}
+	}
+	dst = append(dst, token)
+	if len(literals) >= 15 {
+		dst = lz4AppendLength(dst, len(literals)-15)
+	}
+	dst = append(dst, literals...)
+	if offset == 0 {
+		return dst
+	}
+	dst = append(dst, byte(offset), byte(offset>>8))
+	if matchLen >= 15 {
+		dst = lz4AppendLength(dst, matchLen-15)
+	}
+	return dst
+}
+
+// lz4AppendLength appends the extra length bytes(255 bytes + remainder).
+func lz4AppendLength(dst []byte, length int) []byte {
+	for ; length >= 255; length -= 255 {
+		dst = append(dst, 255)
+	}
+	return append(dst, byte(length))
+}
+
+// lz4ReadLength reads the extra length bytes, returns false if input is truncated.
+func lz4ReadLength(src []byte, i, length int) (newLength, pos int, ok bool) {
+	for {
+		if i >= len(src) {
+			return 0, i, false
+		}
+		b := src[i]
+		i++
+		length += int(b)
+		if b != 255 {
+			return length, i, true
+		}
+	}
+}
+
+// lz4Hash returns the hash of 4 bytes sequence.
+func lz4Hash(seq uint32) uint32 {
+	return (seq * 2654435761) >> (32 - lz4HashLog)
+}
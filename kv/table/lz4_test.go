@@ -0,0 +1,115 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package table
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLZ4_Encode_Decode(t *testing.T) {
+	random := make([]byte, 100000)
+	_, _ = rand.New(rand.NewSource(1)).Read(random)
+	cases := []struct {
+		name  string
+		value []byte
+	}{
+		{name: "empty", value: nil},
+		{name: "short", value: []byte("abc")},
+		{name: "mf limit", value: []byte("abcabcabcabca")},
+		{name: "repeat byte", value: bytes.Repeat([]byte{'a'}, 100000)},
+		{name: "repeat text", value: bytes.Repeat([]byte("test-value-123-"), 10000)},
+		{name: "random", value: random},
+		{name: "random with repeat", value: append(append(random[:1000:1000], random[:300]...), random[:100]...)},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			compressed := lz4Encode(tt.value)
+			decompressed, err := lz4Decode(compressed)
+			assert.NoError(t, err)
+			assert.Equal(t, len(tt.value), len(decompressed))
+			assert.True(t, bytes.Equal(tt.value, decompressed))
+		})
+	}
+	// repeated data need be compressed
+	assert.Less(t, len(lz4Encode(bytes.Repeat([]byte("test-value-123-"), 10000))), 1000)
+}
+
+func TestLZ4_Decode_Corrupt(t *testing.T) {
+	compressed := lz4Encode(bytes.Repeat([]byte("test-value-123-"), 100))
+	cases := []struct {
+		name  string
+		value []byte
+	}{
+		{name: "empty", value: nil},
+		{name: "bad size", value: []byte{0xff}},
+		{name: "size too large", value: []byte{0xff, 0xff, 0xff, 0x0f, 0x00}},
+		{name: "truncated", value: compressed[:len(compressed)-10]},
+		{name: "literals out of range", value: []byte{2, 0x30, 'a'}},
+		{name: "literal length truncated", value: []byte{20, 0xf0}},
+		{name: "offset truncated", value: []byte{8, 0x10, 'a', 0x01}},
+		{name: "offset out of range", value: []byte{8, 0x10, 'a', 0x02, 0x00}},
+		{name: "match length truncated", value: []byte{20, 0x1f, 'a', 0x01, 0x00}},
+		{name: "match too long", value: []byte{5, 0x1f, 'a', 0x01, 0x00, 0x00}},
+		{name: "size mismatch", value: []byte{4, 0x10, 'a'}},
+		{name: "literals exceed size", value: []byte{1, 0x20, 'a', 'b'}},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := lz4Decode(tt.value)
+			assert.Equal(t, errLZ4Corrupt, err)
+		})
+	}
+}
+
+func FuzzLZ4RoundTrip(f *testing.F) {
+	f.Add([]byte(nil))
+	f.Add([]byte("abcabcabcabca"))
+	f.Add(bytes.Repeat([]byte("test-value-123-"), 100))
+	f.Add(bytes.Repeat([]byte{'a'}, 1000))
+	f.Fuzz(func(t *testing.T, value []byte) {
+		decompressed, err := lz4Decode(lz4Encode(value))
+		if err != nil {
+			t.Fatalf("decode failure: %v", err)
+		}
+		if !bytes.Equal(value, decompressed) {
+			t.Fatalf("decompressed value not match, len: %d, decompressed len: %d", len(value), len(decompressed))
+		}
+	})
+}
+
+func FuzzLZ4Decode(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0xff, 0xff, 0xff, 0x0f, 0x00})
+	f.Add([]byte{5, 0x1f, 'a', 0x01, 0x00, 0x00})
+	f.Add(lz4Encode(bytes.Repeat([]byte("test-value-123-"), 100)))
+	f.Fuzz(func(t *testing.T, src []byte) {
+		// arbitrary input must not panic, decoded value must match raw length
+		size, n := binary.Uvarint(src)
+		decompressed, err := lz4Decode(src)
+		if err == nil && (n <= 0 || uint64(len(decompressed)) != size) {
+			t.Fatalf("decoded length: %d not match raw length: %d", len(decompressed), size)
+		}
+	})
+}
@@ -69,6 +69,7 @@ type storeMMapReader struct {
 	entriesBlock []byte                       // mmaped file content without footer
 	keys         *roaring.Bitmap              // bitmap of keys
 	offsets      *encoding.FixedOffsetDecoder // offset of values
+	compression  CompressionType              // compression codec of values
 }
 
 // newMMapStoreReader creates mmap store file reader.
@@ -128,8 +129,21 @@ func (r *storeMMapReader) initialize() error {
 	}
 	posOfOffset := int(binary.LittleEndian.Uint32(r.fullBlock[footerStart : footerStart+4]))
 	posOfKeys := int(binary.LittleEndian.Uint32(r.fullBlock[footerStart+4 : footerStart+8]))
+	endOfKeys := footerStart
+	switch fileVersion := r.fullBlock[footerStart+versionAtFooter]; fileVersion {
+	case version0:
+	case version1:
+		// read compression type before footer
+		if endOfKeys <= posOfKeys {
+			return fmt.Errorf("bad footer data, compression type not found in sstfile:%s", r.path)
+		}
+		endOfKeys--
+		r.compression = CompressionType(r.fullBlock[endOfKeys])
+	default:
+		return fmt.Errorf("unknown version:%d of sstfile:%s", fileVersion, r.path)
+	}
 	if !intsAreSortedFunc([]int{
-		0, posOfOffset, posOfKeys, footerStart}) {
+		0, posOfOffset, posOfKeys, endOfKeys}) {
 		return fmt.Errorf("bad footer data, posOfOffsets: %d posOfKeys: %d,"+
 			" footerStart: %d", posOfOffset, posOfKeys, footerStart)
 	}
@@ -140,7 +154,7 @@ func (r *storeMMapReader) initialize() error {
 		return fmt.Errorf("unmarshal fixed-offsets decoder with error: %s", err)
 	}
 	// decode keys
-	if err := encoding.BitmapUnmarshal(r.keys, r.fullBlock[posOfKeys:]); err != nil {
+	if err := encoding.BitmapUnmarshal(r.keys, r.fullBlock[posOfKeys:endOfKeys]); err != nil {
 		return fmt.Errorf("unmarshal keys data from file[%s] error:%s", r.path, err)
 	}
 	// validate keys and offsets
@@ -179,13 +193,21 @@ func (r *storeMMapReader) Get(key uint32) ([]byte, error) {
 
 func (r *storeMMapReader) getBlock(idx int) ([]byte, error) {
 	block, err := r.offsets.GetBlock(idx, r.entriesBlock)
-	if err == nil {
-		metrics.TableReadStatistics.Gets.Incr()
-		metrics.TableReadStatistics.ReadBytes.Add(float64(len(block)))
-	} else {
+	if err != nil {
 		metrics.TableReadStatistics.GetFailures.Get()
+		return nil, err
+	}
+	metrics.TableReadStatistics.Gets.Incr()
+	metrics.TableReadStatistics.ReadBytes.Add(float64(len(block)))
+	if r.compression == NoCompression {
+		return block, nil
+	}
+	block, err = r.compression.decompress(block)
+	if err != nil {
+		metrics.TableReadStatistics.DecompressFailures.Incr()
+		return nil, fmt.Errorf("decompress value from file[%s] error:%s", r.path, err)
 	}
-	return block, err
+	return block, nil
 }
 
 // Iterator iterates over a store's key/value pairs in key order.
@@ -85,7 +85,7 @@ func TestStoreMMapReader_readBytes_Err(t *testing.T) {
 		unmarshalFixedOffsetFunc = unmarshalFixedOffset
 		assert.NoError(t, os.RemoveAll(testKVPath))
 	}()
-	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000010.sst"))
+	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000010.sst"), NoCompression)
 	assert.NoError(t, err)
 
 	_ = builder.Add(1, []byte("test"))
@@ -157,7 +157,7 @@ func TestReader(t *testing.T) {
 		_ = os.RemoveAll(testKVPath)
 	}()
 
-	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000010.sst"))
+	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000010.sst"), NoCompression)
 	assert.NoError(t, err)
 
 	_ = builder.Add(1, []byte("test"))
@@ -200,7 +200,7 @@ func TestStoreIterator(t *testing.T) {
 	defer func() {
 		_ = os.RemoveAll(testKVPath)
 	}()
-	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000010.sst"))
+	builder, err := NewStoreBuilder(10, filepath.Join(testKVPath, "000010.sst"), NoCompression)
 	assert.NoError(t, err)
 
 	_ = builder.Add(1, []byte("test"))
@@ -227,3 +227,74 @@ func TestStoreIterator(t *testing.T) {
 
 	assert.False(t, it.HasNext())
 }
+
+func TestReader_Compression(t *testing.T) {
+	for _, compression := range []CompressionType{SnappyCompression, ZstdCompression} {
+		compression := compression
+		t.Run(compression.String(), func(t *testing.T) {
+			dir := t.TempDir()
+			builder, err := NewStoreBuilder(10, filepath.Join(dir, "000010.sst"), compression)
+			assert.NoError(t, err)
+			assert.NoError(t, builder.Add(1, []byte("test")))
+			// stream write value
+			writer := builder.StreamWriter()
+			writer.Prepare(10)
+			_, _ = writer.Write([]byte("test"))
+			_, _ = writer.Write([]byte("10"))
+			assert.Equal(t, uint32(6), writer.Size())
+			assert.NoError(t, writer.Commit())
+			assert.NoError(t, builder.Close())
+
+			r, err := newMMapStoreReader(filepath.Join(dir, "000010.sst"), "000010.sst")
+			assert.NoError(t, err)
+			defer func() {
+				assert.NoError(t, r.Close())
+			}()
+			value, err := r.Get(1)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("test"), value)
+			value, err = r.Get(10)
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("test10"), value)
+
+			it := r.Iterator()
+			assert.True(t, it.HasNext())
+			assert.Equal(t, uint32(1), it.Key())
+			assert.Equal(t, []byte("test"), it.Value())
+			assert.True(t, it.HasNext())
+			assert.Equal(t, uint32(10), it.Key())
+			assert.Equal(t, []byte("test10"), it.Value())
+			assert.False(t, it.HasNext())
+
+			// decompress failure
+			r.(*storeMMapReader).compression = CompressionType(100)
+			value, err = r.Get(1)
+			assert.Error(t, err)
+			assert.Nil(t, value)
+		})
+	}
+}
+
+func TestReader_BadVersion(t *testing.T) {
+	dir := t.TempDir()
+	builder, err := NewStoreBuilder(10, filepath.Join(dir, "000010.sst"), NoCompression)
+	assert.NoError(t, err)
+	assert.NoError(t, builder.Add(1, []byte("test")))
+	assert.NoError(t, builder.Close())
+
+	r, err := newMMapStoreReader(filepath.Join(dir, "000010.sst"), "000010.sst")
+	assert.NoError(t, err)
+	reader := r.(*storeMMapReader)
+	footerStart := len(reader.fullBlock) - sstFileFooterSize
+	fullBlock := append([]byte{}, reader.fullBlock...)
+	assert.NoError(t, r.Close())
+
+	// unknown version
+	fullBlock[footerStart+versionAtFooter] = 100
+	reader.fullBlock = fullBlock
+	assert.Error(t, reader.initialize())
+	// compression type not found
+	fullBlock[footerStart+versionAtFooter] = version1
+	binary.LittleEndian.PutUint32(fullBlock[footerStart+4:], uint32(footerStart))
+	assert.Error(t, reader.initialize())
+}
@@ -60,6 +60,10 @@ type StoreVersionSet interface {
 	CreateFamilyVersion(family string, familyID FamilyID) FamilyVersion
 	// GetFamilyVersion returns family version if it existed, else return nil
 	GetFamilyVersion(family string) FamilyVersion
+	// Checkpoint writes the manifest of current versions into given dir,
+	// returns the snapshot of each family which retains the active files, invoker must close them.
+	// NOTICE: replica sequences are excluded, because they are related with the write ahead log of current node.
+	Checkpoint(dir string) (map[string]Snapshot, error)
 
 	// newVersionID generates new version id
 	newVersionID() int64
@@ -375,14 +379,61 @@ func (vs *storeVersionSet) createSnapshot() (editLogs []EditLog) {
 	return
 }
 
+// Checkpoint writes the manifest of current versions into given dir,
+// returns the snapshot of each family which retains the active files, invoker must close them.
+// NOTICE: replica sequences are excluded, because they are related with the write ahead log of current node.
+func (vs *storeVersionSet) Checkpoint(dir string) (snapshots map[string]Snapshot, err error) {
+	// lock version set, make sure version cannot be changed when do checkpoint
+	vs.mutex.Lock()
+	defer vs.mutex.Unlock()
+
+	snapshots = make(map[string]Snapshot)
+	defer func() {
+		if err != nil {
+			for _, snapshot := range snapshots {
+				snapshot.Close()
+			}
+			snapshots = nil
+		}
+	}()
+	var editLogs []EditLog
+	for id, name := range vs.familyIDs {
+		snapshot := vs.familyVersions[name].GetSnapshot()
+		snapshots[name] = snapshot
+		editLogs = append(editLogs, vs.buildFamilyEditLog(id, snapshot.GetCurrent(), false))
+	}
+	editLogs = append(editLogs, vs.createStoreSnapshot())
+
+	manifestFileName := ManifestFileName(table.FileNumber(vs.manifestFileNumber.Load()))
+	writer, err := newBufferWriterFunc(filepath.Join(dir, manifestFileName))
+	if err != nil {
+		return nil, err
+	}
+	if err = vs.persistEditLogs(writer, editLogs); err != nil {
+		_ = writer.Close()
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	if err = writeFileFunc(filepath.Join(dir, current()), []byte(manifestFileName), 0666); err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
 // createFamilySnapshot creates snapshot of edit log for family level.
 // NOTICE: IMPORTANT!!!!!, need write edit logs for all data of version.
 func (vs *storeVersionSet) createFamilySnapshot(familyID FamilyID, familyVersion FamilyVersion) EditLog {
-	editLog := NewEditLog(familyID)
 	// save current version all active files
 	snapshot := familyVersion.GetSnapshot()
 	defer snapshot.Close()
-	current := snapshot.GetCurrent()
+	return vs.buildFamilyEditLog(familyID, snapshot.GetCurrent(), true)
+}
+
+// buildFamilyEditLog builds the edit log which includes all data of given version.
+func (vs *storeVersionSet) buildFamilyEditLog(familyID FamilyID, current Version, withSequence bool) EditLog {
+	editLog := NewEditLog(familyID)
 
 	// write log for current file list under this family.
 	levels := current.Levels()
@@ -395,10 +446,12 @@ func (vs *storeVersionSet) createFamilySnapshot(familyID FamilyID, familyVersion
 		}
 	}
 	// write log if family has replica sequences.
-	sequences := current.GetSequences()
-	for leader, seq := range sequences {
-		// leader -> replica sequence
-		editLog.Add(CreateSequence(leader, seq))
+	if withSequence {
+		sequences := current.GetSequences()
+		for leader, seq := range sequences {
+			// leader -> replica sequence
+			editLog.Add(CreateSequence(leader, seq))
+		}
 	}
 
 	// write log if family has reference files
@@ -305,6 +305,58 @@ func TestStoreVersionSet_Destroy(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestStoreVersionSet_Checkpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newBufferWriterFunc = bufioutil.NewBufioEntryWriter
+		writeFileFunc = ioutil.WriteFile
+		ctrl.Finish()
+	}()
+	dir := t.TempDir()
+	cache := table.NewMockCache(ctrl)
+	cache.EXPECT().ReleaseReaders(gomock.Any()).AnyTimes()
+	vs := NewStoreVersionSet(dir, cache, 2)
+	vs.CreateFamilyVersion("family", 1)
+	// case 1: new writer err
+	newBufferWriterFunc = func(fileName string) (writer bufioutil.BufioWriter, err error) {
+		return nil, fmt.Errorf("err")
+	}
+	snapshots, err := vs.Checkpoint(dir)
+	assert.Error(t, err)
+	assert.Nil(t, snapshots)
+	// case 2: write edit log err
+	mockWriter := bufioutil.NewMockBufioWriter(ctrl)
+	newBufferWriterFunc = func(fileName string) (writer bufioutil.BufioWriter, err error) {
+		return mockWriter, nil
+	}
+	mockWriter.EXPECT().Write(gomock.Any()).Return(0, fmt.Errorf("err"))
+	mockWriter.EXPECT().Close().Return(nil)
+	snapshots, err = vs.Checkpoint(dir)
+	assert.Error(t, err)
+	assert.Nil(t, snapshots)
+	// case 3: close writer err
+	mockWriter.EXPECT().Write(gomock.Any()).Return(0, nil).AnyTimes()
+	mockWriter.EXPECT().Sync().Return(nil).AnyTimes()
+	mockWriter.EXPECT().Close().Return(fmt.Errorf("err"))
+	snapshots, err = vs.Checkpoint(dir)
+	assert.Error(t, err)
+	assert.Nil(t, snapshots)
+	// case 4: write current file err
+	mockWriter.EXPECT().Close().Return(nil).AnyTimes()
+	writeFileFunc = func(filename string, data []byte, perm os.FileMode) error {
+		return fmt.Errorf("err")
+	}
+	snapshots, err = vs.Checkpoint(dir)
+	assert.Error(t, err)
+	assert.Nil(t, snapshots)
+	// case 5: checkpoint successfully
+	writeFileFunc = ioutil.WriteFile
+	snapshots, err = vs.Checkpoint(dir)
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+	snapshots["family"].Close()
+}
+
 func initVersionSetTestData() {
 	if err := fileutil.MkDirIfNotExist(vsTestPath); err != nil {
 		fmt.Println("create test path error")
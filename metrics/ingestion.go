@@ -54,6 +54,14 @@ type NativeIngestionStatistics struct {
 	DroppedMetrics  *linmetric.BoundCounter // drop metric when append
 }
 
+// OTLPIngestionStatistics represents OpenTelemetry OTLP metrics ingestion statistics.
+type OTLPIngestionStatistics struct {
+	NativeIngestionStatistics
+	CumulativeHistogramBaseline *linmetric.BoundCounter // first cumulative histogram point of series, kept as baseline of delta
+	CumulativeHistogramReset    *linmetric.BoundCounter // cumulative histogram reset(restart), written as delta since reset
+	CumulativeHistogramDropped  *linmetric.BoundCounter // cumulative histogram dropped(stale or too many series)
+}
+
 // CommonIngestionStatistics represents ingestion common statistics.
 type CommonIngestionStatistics struct {
 	Duration *linmetric.DeltaHistogramVec // ingest duration(include count)
@@ -70,6 +78,33 @@ func NewNativeIngestionStatistics() *NativeIngestionStatistics {
 	}
 }
 
+// NewPrometheusIngestionStatistics creates a prometheus remote write ingestion statistics.
+func NewPrometheusIngestionStatistics() *NativeIngestionStatistics {
+	prometheusIngestionScope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.prometheus")
+	return &NativeIngestionStatistics{
+		CorruptedData:   prometheusIngestionScope.NewCounter("data_corrupted"),
+		IngestedMetrics: prometheusIngestionScope.NewCounter("ingested_metrics"),
+		ReadBytes:       prometheusIngestionScope.NewCounter("read_bytes"),
+		DroppedMetrics:  prometheusIngestionScope.NewCounter("dropped_metrics"),
+	}
+}
+
+// NewOTLPIngestionStatistics creates an OpenTelemetry OTLP metrics ingestion statistics.
+func NewOTLPIngestionStatistics() *OTLPIngestionStatistics {
+	otlpIngestionScope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.otlp")
+	return &OTLPIngestionStatistics{
+		NativeIngestionStatistics: NativeIngestionStatistics{
+			CorruptedData:   otlpIngestionScope.NewCounter("data_corrupted"),
+			IngestedMetrics: otlpIngestionScope.NewCounter("ingested_metrics"),
+			ReadBytes:       otlpIngestionScope.NewCounter("read_bytes"),
+			DroppedMetrics:  otlpIngestionScope.NewCounter("dropped_metrics"),
+		},
+		CumulativeHistogramBaseline: otlpIngestionScope.NewCounter("cumulative_histogram_baseline"),
+		CumulativeHistogramReset:    otlpIngestionScope.NewCounter("cumulative_histogram_reset"),
+		CumulativeHistogramDropped:  otlpIngestionScope.NewCounter("cumulative_histogram_dropped"),
+	}
+}
+
 // NewInfluxIngestionStatistics creates an influx ingestion statistics.
 func NewInfluxIngestionStatistics() *InfluxIngestionStatistics {
 	influxIngestionScope := linmetric.BrokerRegistry.NewScope("lindb.ingestion.influx")
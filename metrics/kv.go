@@ -44,34 +44,42 @@ var (
 	tableWriteScope = linmetric.StorageRegistry.NewScope("lindb.kv.table.write")
 	// TableWriteStatistics represents table file write statistics.
 	TableWriteStatistics = struct {
-		AddBadKeys *linmetric.BoundCounter // add bad key count
-		AddKeys    *linmetric.BoundCounter // add key success
-		WriteBytes *linmetric.BoundCounter // write data bytes
+		AddBadKeys       *linmetric.BoundCounter // add bad key count
+		AddKeys          *linmetric.BoundCounter // add key success
+		WriteBytes       *linmetric.BoundCounter // write data bytes
+		CompressBytes    *linmetric.BoundCounter // bytes of data before compression
+		CompressedBytes  *linmetric.BoundCounter // bytes of data after compression
+		CompressFailures *linmetric.BoundCounter // compress data failure
 	}{
-		AddBadKeys: tableWriteScope.NewCounter("bad_keys"),
-		AddKeys:    tableWriteScope.NewCounter("add_keys"),
-		WriteBytes: tableWriteScope.NewCounter("write_bytes"),
+		AddBadKeys:       tableWriteScope.NewCounter("bad_keys"),
+		AddKeys:          tableWriteScope.NewCounter("add_keys"),
+		WriteBytes:       tableWriteScope.NewCounter("write_bytes"),
+		CompressBytes:    tableWriteScope.NewCounter("compress_bytes"),
+		CompressedBytes:  tableWriteScope.NewCounter("compressed_bytes"),
+		CompressFailures: tableWriteScope.NewCounter("compress_failures"),
 	}
 
 	// table read
 	tableReadScope = linmetric.StorageRegistry.NewScope("lindb.kv.table.read")
 	// TableReadStatistics represents table file read statistics.
 	TableReadStatistics = struct {
-		Gets           *linmetric.BoundCounter // get data by key success
-		GetFailures    *linmetric.BoundCounter // get data by key failure
-		ReadBytes      *linmetric.BoundCounter // bytes of read data
-		MMaps          *linmetric.BoundCounter // map file success
-		MMapFailures   *linmetric.BoundCounter // map file failure
-		UnMMaps        *linmetric.BoundCounter // unmap file success
-		UnMMapFailures *linmetric.BoundCounter // unmap file failures
+		Gets               *linmetric.BoundCounter // get data by key success
+		GetFailures        *linmetric.BoundCounter // get data by key failure
+		ReadBytes          *linmetric.BoundCounter // bytes of read data
+		MMaps              *linmetric.BoundCounter // map file success
+		MMapFailures       *linmetric.BoundCounter // map file failure
+		UnMMaps            *linmetric.BoundCounter // unmap file success
+		UnMMapFailures     *linmetric.BoundCounter // unmap file failures
+		DecompressFailures *linmetric.BoundCounter // decompress data failure
 	}{
-		Gets:           tableReadScope.NewCounter("gets"),
-		GetFailures:    tableReadScope.NewCounter("get_failures"),
-		ReadBytes:      tableReadScope.NewCounter("read_bytes"),
-		MMaps:          tableReadScope.NewCounter("mmaps"),
-		MMapFailures:   tableReadScope.NewCounter("mmap_failures"),
-		UnMMaps:        tableReadScope.NewCounter("unmmaps"),
-		UnMMapFailures: tableReadScope.NewCounter("unmmap_failures"),
+		Gets:               tableReadScope.NewCounter("gets"),
+		GetFailures:        tableReadScope.NewCounter("get_failures"),
+		ReadBytes:          tableReadScope.NewCounter("read_bytes"),
+		MMaps:              tableReadScope.NewCounter("mmaps"),
+		MMapFailures:       tableReadScope.NewCounter("mmap_failures"),
+		UnMMaps:            tableReadScope.NewCounter("unmmaps"),
+		UnMMapFailures:     tableReadScope.NewCounter("unmmap_failures"),
+		DecompressFailures: tableReadScope.NewCounter("decompress_failures"),
 	}
 
 	// compact job
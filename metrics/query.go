@@ -23,6 +23,7 @@ import "github.com/lindb/lindb/internal/linmetric"
 type BrokerQueryStatistics struct {
 	CreatedTasks         *linmetric.BoundCounter // create query task
 	ExpireTasks          *linmetric.BoundCounter // task expire, long-term no response
+	CanceledTasks        *linmetric.BoundCounter // task canceled, killed or timeout before completed
 	AliveTask            *linmetric.BoundGauge   // current executing task(alive)
 	EmitResponse         *linmetric.BoundCounter // emit response to parent node
 	OmitResponse         *linmetric.BoundCounter // omit response because task evicted
@@ -39,6 +40,14 @@ type StorageQueryStatistics struct {
 	MetaQuery           *linmetric.BoundCounter // metadata query success
 	MetaQueryFailures   *linmetric.BoundCounter // metadata query failure
 	OmitRequest         *linmetric.BoundCounter // omit request(task no belong to current node, wrong stream etc.)
+	CanceledQuery       *linmetric.BoundCounter // metric query canceled by root node
+}
+
+// ContinuousQueryStatistics represents continuous query statistics.
+type ContinuousQueryStatistics struct {
+	Runs        *linmetric.BoundCounter // execute continuous query window success
+	RunFailures *linmetric.BoundCounter // execute continuous query window failure
+	WrittenRows *linmetric.BoundCounter // rows written into target metric
 }
 
 // NewBrokerQueryStatistics creates broker query statistics.
@@ -48,6 +57,7 @@ func NewBrokerQueryStatistics() *BrokerQueryStatistics {
 		CreatedTasks:         scope.NewCounter("created_tasks"),
 		AliveTask:            scope.NewGauge("alive_tasks"),
 		ExpireTasks:          scope.NewCounter("expire_tasks"),
+		CanceledTasks:        scope.NewCounter("canceled_tasks"),
 		EmitResponse:         scope.NewCounter("emitted_responses"),
 		OmitResponse:         scope.NewCounter("omitted_responses"),
 		SentRequest:          scope.NewCounter("sent_requests"),
@@ -66,5 +76,16 @@ func NewStorageQueryStatistics() *StorageQueryStatistics {
 		MetaQuery:           scope.NewCounter("meta_queries"),
 		MetaQueryFailures:   scope.NewCounter("meta_query_failures"),
 		OmitRequest:         scope.NewCounter("omitted_requests"),
+		CanceledQuery:       scope.NewCounter("canceled_queries"),
+	}
+}
+
+// NewContinuousQueryStatistics creates continuous query statistics.
+func NewContinuousQueryStatistics() *ContinuousQueryStatistics {
+	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.continuous_query")
+	return &ContinuousQueryStatistics{
+		Runs:        scope.NewCounter("runs"),
+		RunFailures: scope.NewCounter("run_failures"),
+		WrittenRows: scope.NewCounter("written_rows"),
 	}
 }
@@ -26,4 +26,5 @@ import (
 func TestQueryStatistics(t *testing.T) {
 	assert.NotNil(t, NewBrokerQueryStatistics())
 	assert.NotNil(t, NewStorageQueryStatistics())
+	assert.NotNil(t, NewContinuousQueryStatistics())
 }
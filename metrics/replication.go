@@ -21,8 +21,9 @@ import "github.com/lindb/lindb/internal/linmetric"
 
 // BrokerDatabaseWriteStatistics represents database channel write statistics.
 type BrokerDatabaseWriteStatistics struct {
-	OutOfTimeRange *linmetric.BoundCounter // timestamp of metrics out of acceptable write time range
-	ShardNotFound  *linmetric.BoundCounter // shard not found count
+	OutOfTimeRange      *linmetric.BoundCounter // timestamp of metrics out of acceptable write time range
+	ShardNotFound       *linmetric.BoundCounter // shard not found count
+	SeriesQuotaRejected *linmetric.BoundCounter // number of rows rejected because series quota exceeded
 }
 
 // BrokerFamilyWriteStatistics represents family channel write statistics.
@@ -41,6 +42,11 @@ type BrokerFamilyWriteStatistics struct {
 	CloseStream          *linmetric.BoundCounter // close replica stream success count
 	CloseStreamFailures  *linmetric.BoundCounter // close replica stream failure count
 	LeaderChanged        *linmetric.BoundCounter // shard leader changed
+	SpoolDepth           *linmetric.BoundGauge   // number of message in write spool
+	SpoolSize            *linmetric.BoundGauge   // bytes of message in write spool
+	SpoolReplay          *linmetric.BoundCounter // replay spooled message success count
+	SpoolDrop            *linmetric.BoundCounter // number of drop spooled message(expired/spool full)
+	SpoolFailures        *linmetric.BoundCounter // spool message failure count
 }
 
 // StorageLocalReplicatorStatistics represents local replicator statistics.
@@ -101,8 +107,9 @@ type StorageWriteAheadLogStatistics struct {
 func NewBrokerDatabaseWriteStatistics(database string) *BrokerDatabaseWriteStatistics {
 	scope := linmetric.BrokerRegistry.NewScope("lindb.broker.database.write")
 	return &BrokerDatabaseWriteStatistics{
-		OutOfTimeRange: scope.NewCounterVec("out_of_time_range", "db").WithTagValues(database),
-		ShardNotFound:  scope.NewCounterVec("shard_not_found", "db").WithTagValues(database),
+		OutOfTimeRange:      scope.NewCounterVec("out_of_time_range", "db").WithTagValues(database),
+		ShardNotFound:       scope.NewCounterVec("shard_not_found", "db").WithTagValues(database),
+		SeriesQuotaRejected: scope.NewCounterVec("series_quota_rejected", "db").WithTagValues(database),
 	}
 }
 
@@ -124,6 +131,11 @@ func NewBrokerFamilyWriteStatistics(database string) *BrokerFamilyWriteStatistic
 		CloseStream:          scope.NewCounterVec("close_stream", "db").WithTagValues(database),
 		CloseStreamFailures:  scope.NewCounterVec("close_stream_failures", "db").WithTagValues(database),
 		LeaderChanged:        scope.NewCounterVec("leader_changed", "db").WithTagValues(database),
+		SpoolDepth:           scope.NewGaugeVec("spool_depth", "db").WithTagValues(database),
+		SpoolSize:            scope.NewGaugeVec("spool_size", "db").WithTagValues(database),
+		SpoolReplay:          scope.NewCounterVec("spool_replay", "db").WithTagValues(database),
+		SpoolDrop:            scope.NewCounterVec("spool_drop", "db").WithTagValues(database),
+		SpoolFailures:        scope.NewCounterVec("spool_failures", "db").WithTagValues(database),
 	}
 }
 
@@ -35,7 +35,10 @@ var (
 
 // IndexDBStatistics represents index database statistics.
 type IndexDBStatistics = struct {
-	BuildInvertedIndex *linmetric.BoundCounter // build inverted index count
+	BuildInvertedIndex           *linmetric.BoundCounter // build inverted index count
+	ShardSeriesQuotaExceeded     *linmetric.BoundCounter // reject new series because shard series quota exceeded
+	NamespaceSeriesQuotaExceeded *linmetric.BoundCounter // reject new series because namespace series quota exceeded
+	MetricSeriesQuotaExceeded    *linmetric.BoundCounter // reject new series because metric series quota exceeded
 }
 
 // MemDBStatistics represents memory database statistics.
@@ -69,6 +72,7 @@ type MetaDBStatistics struct {
 // ShardStatistics represents shard statistics.
 type ShardStatistics struct {
 	LookupMetricMetaFailures *linmetric.BoundCounter   // lookup meta of metric failure
+	SeriesQuotaRejectedRows  *linmetric.BoundCounter   // rows rejected because series quota exceeded
 	IndexDBFlushDuration     *linmetric.BoundHistogram // flush index database duration(include count)
 	IndexDBFlushFailures     *linmetric.BoundCounter   // flush index database failure
 }
@@ -115,6 +119,8 @@ func NewShardStatistics(database, shard string) *ShardStatistics {
 	return &ShardStatistics{
 		LookupMetricMetaFailures: shardScope.NewCounterVec("lookup_metric_meta_failures", "db", "shard").
 			WithTagValues(database, shard),
+		SeriesQuotaRejectedRows: shardScope.NewCounterVec("series_quota_rejected_rows", "db", "shard").
+			WithTagValues(database, shard),
 		IndexDBFlushFailures: shardScope.NewCounterVec("indexdb_flush_failures", "db", "shard").
 			WithTagValues(database, shard),
 		IndexDBFlushDuration: shardScope.Scope("indexdb_flush_duration").NewHistogramVec("db", "shard").
@@ -163,7 +169,11 @@ func NewMemDBStatistics(database string) *MemDBStatistics {
 // NewIndexDBStatistics creates an index database statistics.
 func NewIndexDBStatistics(database string) *IndexDBStatistics {
 	scope := linmetric.StorageRegistry.NewScope("lindb.tsdb.indexdb")
+	seriesQuotaExceeded := scope.NewCounterVec("series_quota_exceeded", "db", "scope")
 	return &IndexDBStatistics{
-		BuildInvertedIndex: scope.NewCounterVec("build_inverted_index", "db").WithTagValues(database),
+		BuildInvertedIndex:           scope.NewCounterVec("build_inverted_index", "db").WithTagValues(database),
+		ShardSeriesQuotaExceeded:     seriesQuotaExceeded.WithTagValues(database, "shard"),
+		NamespaceSeriesQuotaExceeded: seriesQuotaExceeded.WithTagValues(database, "namespace"),
+		MetricSeriesQuotaExceeded:    seriesQuotaExceeded.WithTagValues(database, "metric"),
 	}
 }
@@ -0,0 +1,125 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/lindb/lindb/pkg/archive"
+)
+
+const (
+	// BackupVersion represents the current version of backup format.
+	BackupVersion = 1
+	// BackupManifestFile represents the manifest file name of database backup.
+	BackupManifestFile = "manifest.json"
+	// BackupNodeManifestFile represents the manifest file name of storage node's snapshot.
+	BackupNodeManifestFile = "node.json"
+	// BackupNodesDir represents the parent dir of storage nodes' snapshot.
+	BackupNodesDir = "nodes"
+)
+
+// BackupNode represents the snapshot of database on a storage node.
+type BackupNode struct {
+	NodeID NodeID `json:"nodeId"`
+	// ReplicaState represents the replica wal position of each family when taking snapshot.
+	ReplicaState []FamilyLogReplicaState `json:"replicaState"`
+	Files        []archive.Entry         `json:"files"`
+}
+
+// BackupManifest represents the manifest of database backup.
+type BackupManifest struct {
+	Version         int              `json:"version"`
+	Database        *Database        `json:"database"`
+	ShardAssignment *ShardAssignment `json:"shardAssignment"`
+	Nodes           []*BackupNode    `json:"nodes"`
+	CreateTime      int64            `json:"createTime"`
+}
+
+// BackupNodeDir returns the dir of storage node's snapshot in backup.
+func BackupNodeDir(nodeID NodeID) string {
+	return BackupNodesDir + "/" + strconv.Itoa(int(nodeID))
+}
+
+// Files returns all files of backup, the name of file is prefixed by the dir of storage node.
+func (m *BackupManifest) Files() (files []archive.Entry) {
+	for _, node := range m.Nodes {
+		dir := BackupNodeDir(node.NodeID)
+		for _, file := range node.Files {
+			file.Name = archive.Join(dir, file.Name)
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// RestorePlan represents the plan of restoring database backup into storage cluster.
+type RestorePlan struct {
+	Database        *Database         `json:"database"`
+	NodeMapping     map[NodeID]NodeID `json:"nodeMapping"` // source node id => target node id
+	ShardAssignment *ShardAssignment  `json:"shardAssignment"`
+}
+
+// NewRestorePlan creates the restore plan, maps each source node of backup to a distinct live node,
+// then rebuilds the shard assignment based on node mapping.
+func NewRestorePlan(manifest *BackupManifest, storage string, liveNodes []NodeID) (*RestorePlan, error) {
+	if manifest.Database == nil || manifest.ShardAssignment == nil {
+		return nil, fmt.Errorf("database config or shard assignment not found in backup manifest")
+	}
+	if len(liveNodes) < len(manifest.Nodes) {
+		return nil, fmt.Errorf("not enough live nodes for restoring, backup nodes: %d, live nodes: %d",
+			len(manifest.Nodes), len(liveNodes))
+	}
+	sources := make([]NodeID, 0, len(manifest.Nodes))
+	for _, node := range manifest.Nodes {
+		sources = append(sources, node.NodeID)
+	}
+	targets := make([]NodeID, len(liveNodes))
+	copy(targets, liveNodes)
+	sort.Slice(sources, func(i, j int) bool { return sources[i] < sources[j] })
+	sort.Slice(targets, func(i, j int) bool { return targets[i] < targets[j] })
+	mapping := make(map[NodeID]NodeID, len(sources))
+	for idx, source := range sources {
+		mapping[source] = targets[idx]
+	}
+
+	shardAssignment := NewShardAssignment(manifest.ShardAssignment.Name)
+	for shardID, replica := range manifest.ShardAssignment.Shards {
+		var replicas []NodeID
+		for _, source := range replica.Replicas {
+			if target, ok := mapping[source]; ok {
+				replicas = append(replicas, target)
+			}
+		}
+		if len(replicas) == 0 {
+			return nil, fmt.Errorf("shard %d hasn't any replica in backup", shardID)
+		}
+		shardAssignment.Shards[shardID] = &Replica{Replicas: replicas}
+	}
+	database := *manifest.Database
+	if storage != "" {
+		database.Storage = storage
+	}
+	return &RestorePlan{
+		Database:        &database,
+		NodeMapping:     mapping,
+		ShardAssignment: shardAssignment,
+	}, nil
+}
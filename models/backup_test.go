@@ -0,0 +1,71 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/archive"
+)
+
+func TestNewRestorePlan(t *testing.T) {
+	manifest := &BackupManifest{
+		Database: &Database{Name: "test", Storage: "source", NumOfShard: 3, ReplicaFactor: 2},
+		ShardAssignment: &ShardAssignment{
+			Name: "test",
+			Shards: map[ShardID]*Replica{
+				0: {Replicas: []NodeID{1, 2}},
+				1: {Replicas: []NodeID{2, 3}},
+				2: {Replicas: []NodeID{3, 1}},
+			},
+		},
+		Nodes: []*BackupNode{{NodeID: 3}, {NodeID: 1}},
+	}
+	_, err := NewRestorePlan(&BackupManifest{}, "", nil)
+	assert.Error(t, err)
+	_, err = NewRestorePlan(manifest, "", []NodeID{10})
+	assert.Error(t, err)
+
+	plan, err := NewRestorePlan(manifest, "target", []NodeID{20, 10, 30})
+	assert.NoError(t, err)
+	assert.Equal(t, "target", plan.Database.Storage)
+	assert.Equal(t, "source", manifest.Database.Storage)
+	assert.Equal(t, map[NodeID]NodeID{1: 10, 3: 20}, plan.NodeMapping)
+	assert.Equal(t, []NodeID{10}, plan.ShardAssignment.Shards[0].Replicas)
+	assert.Equal(t, []NodeID{20}, plan.ShardAssignment.Shards[1].Replicas)
+	assert.Equal(t, []NodeID{20, 10}, plan.ShardAssignment.Shards[2].Replicas)
+
+	manifest.Nodes = []*BackupNode{{NodeID: 1}}
+	_, err = NewRestorePlan(manifest, "", []NodeID{10})
+	assert.Error(t, err)
+	assert.Equal(t, "nodes/1", BackupNodeDir(1))
+}
+
+func TestBackupManifest_Files(t *testing.T) {
+	manifest := &BackupManifest{Nodes: []*BackupNode{
+		{NodeID: 1, Files: []archive.Entry{{Name: "test/OPTIONS", Size: 1, Checksum: "a"}}},
+		{NodeID: 2, Files: []archive.Entry{{Name: "test/OPTIONS", Size: 2, Checksum: "b"}}},
+	}}
+	assert.Equal(t, []archive.Entry{
+		{Name: "nodes/1/test/OPTIONS", Size: 1, Checksum: "a"},
+		{Name: "nodes/2/test/OPTIONS", Size: 2, Checksum: "b"},
+	}, manifest.Files())
+	assert.Equal(t, "test/OPTIONS", manifest.Nodes[0].Files[0].Name)
+}
@@ -0,0 +1,129 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// ContinuousQuery represents the definition of continuous query,
+// which periodically executes the aggregation query over the last window of source database,
+// then writes the result into target metric(maybe in other database).
+//
+// The window [start, end) is aligned with interval, end = (now - delay) aligned by interval,
+// delay waits for the data of window arriving. The windows within lateness before watermark
+// are re-computed when running, so that the late data is included(result field is last value, overwrite old result).
+type ContinuousQuery struct {
+	Name     string `json:"name" validate:"required"`
+	Database string `json:"database" validate:"required"` // source database
+	SQL      string `json:"sql" validate:"required"`      // aggregation query, time range is set by scheduler
+
+	TargetDatabase  string `json:"targetDatabase,omitempty"`  // default source database
+	TargetNamespace string `json:"targetNamespace,omitempty"` // default namespace of query
+	TargetMetric    string `json:"targetMetric" validate:"required"`
+
+	Interval string `json:"interval" validate:"required"` // execution interval, also down sampling interval of result
+	Delay    string `json:"delay,omitempty"`              // wait time for data arriving before window executing
+	Lateness string `json:"lateness,omitempty"`           // how long late data is accepted(re-compute windows)
+}
+
+// Validate checks if the continuous query definition is valid.
+func (cq *ContinuousQuery) Validate() error {
+	if cq.Name == "" {
+		return constants.ErrNameEmpty
+	}
+	if cq.Database == "" {
+		return constants.ErrDatabaseNameRequired
+	}
+	if cq.TargetMetric == "" {
+		return fmt.Errorf("target metric of continuous query cannot be empty")
+	}
+	interval, err := cq.GetInterval()
+	if err != nil {
+		return err
+	}
+	if interval <= 0 {
+		return fmt.Errorf("interval of continuous query must be positive")
+	}
+	if _, err := cq.GetDelay(); err != nil {
+		return err
+	}
+	if _, err := cq.GetLateness(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTargetDatabase returns the database which result written into.
+func (cq *ContinuousQuery) GetTargetDatabase() string {
+	if cq.TargetDatabase == "" {
+		return cq.Database
+	}
+	return cq.TargetDatabase
+}
+
+// GetInterval returns the execution interval(milliseconds).
+func (cq *ContinuousQuery) GetInterval() (int64, error) {
+	return parseDuration("interval", cq.Interval)
+}
+
+// GetDelay returns the delay(milliseconds), 0 if not set.
+func (cq *ContinuousQuery) GetDelay() (int64, error) {
+	return parseDuration("delay", cq.Delay)
+}
+
+// GetLateness returns the lateness(milliseconds), 0 if not set.
+func (cq *ContinuousQuery) GetLateness() (int64, error) {
+	return parseDuration("lateness", cq.Lateness)
+}
+
+// parseDuration parses the duration string like 1m, returns 0 if empty.
+func parseDuration(name, value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	var interval timeutil.Interval
+	if err := interval.ValueOf(value); err != nil {
+		return 0, fmt.Errorf("%s of continuous query is invalid: %s", name, value)
+	}
+	if interval < 0 {
+		return 0, fmt.Errorf("%s of continuous query cannot be negative: %s", name, value)
+	}
+	return interval.Int64(), nil
+}
+
+// ContinuousQueryState represents the execution state of continuous query.
+type ContinuousQueryState struct {
+	Name string `json:"name"`
+	// Watermark represents the end time of window which computed successfully,
+	// all windows before watermark(except lateness) are completed.
+	Watermark    int64  `json:"watermark"`
+	LastRunTime  int64  `json:"lastRunTime"`
+	LastRows     int    `json:"lastRows"` // the number of rows written by last run
+	LastError    string `json:"lastError,omitempty"`
+	FailureCount int64  `json:"failureCount"` // the number of consecutive failures
+}
+
+// ContinuousQueryInfo represents the definition with execution state of continuous query.
+type ContinuousQueryInfo struct {
+	*ContinuousQuery
+	State *ContinuousQueryState `json:"state,omitempty"`
+}
@@ -0,0 +1,104 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestContinuousQuery_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		cq      ContinuousQuery
+		wantErr bool
+	}{
+		{
+			name:    "name empty",
+			cq:      ContinuousQuery{},
+			wantErr: true,
+		},
+		{
+			name:    "database empty",
+			cq:      ContinuousQuery{Name: "cq"},
+			wantErr: true,
+		},
+		{
+			name:    "target metric empty",
+			cq:      ContinuousQuery{Name: "cq", Database: "db"},
+			wantErr: true,
+		},
+		{
+			name:    "interval invalid",
+			cq:      ContinuousQuery{Name: "cq", Database: "db", TargetMetric: "m", Interval: "1x"},
+			wantErr: true,
+		},
+		{
+			name:    "interval empty",
+			cq:      ContinuousQuery{Name: "cq", Database: "db", TargetMetric: "m"},
+			wantErr: true,
+		},
+		{
+			name:    "interval zero",
+			cq:      ContinuousQuery{Name: "cq", Database: "db", TargetMetric: "m", Interval: "0m"},
+			wantErr: true,
+		},
+		{
+			name:    "delay invalid",
+			cq:      ContinuousQuery{Name: "cq", Database: "db", TargetMetric: "m", Interval: "1m", Delay: "a"},
+			wantErr: true,
+		},
+		{
+			name:    "lateness negative",
+			cq:      ContinuousQuery{Name: "cq", Database: "db", TargetMetric: "m", Interval: "1m", Lateness: "-1m"},
+			wantErr: true,
+		},
+		{
+			name: "valid",
+			cq: ContinuousQuery{Name: "cq", Database: "db", TargetMetric: "m",
+				Interval: "1m", Delay: "30s", Lateness: "5m"},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cq.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestContinuousQuery_Get(t *testing.T) {
+	cq := &ContinuousQuery{Database: "db", Interval: "1m", Delay: "30s"}
+	assert.Equal(t, "db", cq.GetTargetDatabase())
+	cq.TargetDatabase = "target"
+	assert.Equal(t, "target", cq.GetTargetDatabase())
+	interval, _ := cq.GetInterval()
+	assert.Equal(t, timeutil.OneMinute, interval)
+	delay, _ := cq.GetDelay()
+	assert.Equal(t, 30*timeutil.OneSecond, delay)
+	lateness, _ := cq.GetLateness()
+	assert.Zero(t, lateness)
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/jedib0t/go-pretty/v6/table"
 
+	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/option"
 )
 
@@ -91,6 +92,25 @@ func (db *Database) String() string {
 	return result
 }
 
+// AlterOption returns a new database config which option is altered by the option json,
+// the fields in option json override the current option, others keep unchanged.
+func (db *Database) AlterOption(optionJSON []byte) (*Database, error) {
+	if db.Option == nil {
+		return nil, fmt.Errorf("option of database %s not found", db.Name)
+	}
+	opt := db.Option.Clone()
+	if err := encoding.JSONUnmarshal(optionJSON, opt); err != nil {
+		return nil, err
+	}
+	if err := db.Option.ValidateUpdate(opt); err != nil {
+		return nil, err
+	}
+	opt.Default()
+	database := *db
+	database.Option = opt
+	return &database, nil
+}
+
 type DatabaseAssignment struct {
 	ShardAssignment *ShardAssignment       `json:"shardAssignment"`
 	Option          *option.DatabaseOption `json:"option"`
@@ -111,6 +131,19 @@ func (r Replica) Contain(nodeID NodeID) bool {
 	return false
 }
 
+// Equal returns if replica list is same as other(include order).
+func (r Replica) Equal(other Replica) bool {
+	if len(r.Replicas) != len(other.Replicas) {
+		return false
+	}
+	for idx, id := range r.Replicas {
+		if id != other.Replicas[idx] {
+			return false
+		}
+	}
+	return true
+}
+
 // ShardAssignment defines shard assignment for database.
 type ShardAssignment struct {
 	Name   string               `json:"name"` // database's name
@@ -50,6 +50,33 @@ func TestDatabase_String(t *testing.T) {
 	assert.Equal(t, "create database test with shard 10, replica 1, intervals [10s->1M,10m->1M]", database.String())
 }
 
+func TestDatabase_AlterOption(t *testing.T) {
+	db := &Database{Name: "test"}
+	_, err := db.AlterOption([]byte(`{}`))
+	assert.Error(t, err)
+
+	db.Option = &option.DatabaseOption{
+		Intervals: option.Intervals{{Interval: timeutil.Interval(10 * timeutil.OneSecond),
+			Retention: timeutil.Interval(timeutil.OneMonth)}},
+		Ahead: "1h",
+	}
+	_, err = db.AlterOption([]byte(`bad`))
+	assert.Error(t, err)
+	_, err = db.AlterOption([]byte(`{"intervals":[{"interval":"5m","retention":"1M"}]}`))
+	assert.Error(t, err)
+
+	newDB, err := db.AlterOption([]byte(`{"intervals":[{"interval":"10s","retention":"7d"},` +
+		`{"interval":"1h","retention":"1y"}],"behind":"2h","autoCreateNS":true}`))
+	assert.NoError(t, err)
+	assert.Len(t, newDB.Option.Intervals, 2)
+	assert.Equal(t, "1h", newDB.Option.Ahead)
+	assert.Equal(t, "2h", newDB.Option.Behind)
+	assert.True(t, newDB.Option.AutoCreateNS)
+	// old option not changed
+	assert.Len(t, db.Option.Intervals, 1)
+	assert.Empty(t, db.Option.Behind)
+}
+
 func TestParseShardID(t *testing.T) {
 	assert.Equal(t, ShardID(1), ParseShardID("1"))
 	assert.Equal(t, "1", ShardID(1).String())
@@ -70,3 +97,10 @@ func TestReplica_Contain(t *testing.T) {
 	assert.True(t, replica.Contain(2))
 	assert.False(t, replica.Contain(4))
 }
+
+func TestReplica_Equal(t *testing.T) {
+	replica := Replica{Replicas: []NodeID{1, 2}}
+	assert.True(t, replica.Equal(Replica{Replicas: []NodeID{1, 2}}))
+	assert.False(t, replica.Equal(Replica{Replicas: []NodeID{2, 1}}))
+	assert.False(t, replica.Equal(Replica{Replicas: []NodeID{1}}))
+}
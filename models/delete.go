@@ -0,0 +1,62 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// DeleteParam represents the param of deleting series on storage node.
+type DeleteParam struct {
+	Database  string       `json:"database" binding:"required"`
+	ShardIDs  []ShardID    `json:"shardIds" binding:"required"`
+	Statement *stmt.Delete `json:"statement" binding:"required"`
+}
+
+// DeleteResult represents the result of deleting series.
+type DeleteResult struct {
+	// Shards represents the number of deleted series for each shard.
+	Shards map[ShardID]uint64 `json:"shards,omitempty"`
+	// MetricDropped represents if the metadata of metric dropped after all series deleted.
+	MetricDropped bool `json:"metricDropped"`
+}
+
+// Merge merges the result of other storage node, replicas of same shard delete same series,
+// so takes max number of deleted series for each shard.
+func (r *DeleteResult) Merge(other *DeleteResult) {
+	if other == nil {
+		return
+	}
+	if r.Shards == nil {
+		r.Shards = make(map[ShardID]uint64)
+	}
+	for shardID, num := range other.Shards {
+		if num > r.Shards[shardID] {
+			r.Shards[shardID] = num
+		}
+	}
+	r.MetricDropped = r.MetricDropped || other.MetricDropped
+}
+
+// NumOfSeries returns the total number of deleted series.
+func (r *DeleteResult) NumOfSeries() (total uint64) {
+	for _, num := range r.Shards {
+		total += num
+	}
+	return total
+}
@@ -0,0 +1,35 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteResult_Merge(t *testing.T) {
+	rs := &DeleteResult{}
+	rs.Merge(nil)
+	assert.Zero(t, rs.NumOfSeries())
+	rs.Merge(&DeleteResult{Shards: map[ShardID]uint64{1: 10, 2: 5}})
+	rs.Merge(&DeleteResult{Shards: map[ShardID]uint64{1: 8, 3: 2}, MetricDropped: true})
+	assert.Equal(t, map[ShardID]uint64{1: 10, 2: 5, 3: 2}, rs.Shards)
+	assert.Equal(t, uint64(17), rs.NumOfSeries())
+	assert.True(t, rs.MetricDropped)
+}
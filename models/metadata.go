@@ -43,6 +43,10 @@ func (m *Metadata) ToTable() (rows int, tableStr string) {
 		return m.toTableForStringValues(table.Row{"Tag Value"}, writer)
 	case stmt.Field.String():
 		return m.toTableForMapValues(table.Row{"Name", "Type"}, []string{"name", "type"}, writer)
+	case stmt.MetricCardinality.String():
+		return m.toTableForMapValues(table.Row{"Metric", "Series"}, []string{"name", "count"}, writer)
+	case stmt.TagCardinality.String():
+		return m.toTableForMapValues(table.Row{"Tag Key", "Approx. Values"}, []string{"name", "count"}, writer)
 	default:
 		return 0, ""
 	}
@@ -78,3 +82,12 @@ type Field struct {
 	Name string `json:"name"`
 	Type string `json:"type"`
 }
+
+// Cardinality represents the number of series of metric or tag values of tag key.
+// Tag values are stored in metadata of each storage node, the count of tag values is the max one of all storage nodes,
+// it is an approximation(lower bound of distinct tag values) if shards of database are distributed across storage nodes.
+type Cardinality struct {
+	Name        string `json:"name"`
+	Count       uint64 `json:"count"`
+	Approximate bool   `json:"approximate,omitempty"` // true if count is approximate value
+}
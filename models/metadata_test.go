@@ -48,6 +48,18 @@ func TestMetadata_ToTable(t *testing.T) {
 	}).ToTable()
 	assert.Equal(t, rows, 1)
 	assert.NotEmpty(t, rs)
+	rows, rs = (&Metadata{
+		Type:   stmt.MetricCardinality.String(),
+		Values: []interface{}{map[string]interface{}{"name": "cpu", "count": 10}},
+	}).ToTable()
+	assert.Equal(t, rows, 1)
+	assert.NotEmpty(t, rs)
+	rows, rs = (&Metadata{
+		Type:   stmt.TagCardinality.String(),
+		Values: []interface{}{map[string]interface{}{"name": "host", "count": 10}},
+	}).ToTable()
+	assert.Equal(t, rows, 1)
+	assert.NotEmpty(t, rs)
 }
 
 func TestDatabaseNames_ToTable(t *testing.T) {
@@ -83,6 +83,7 @@ type StatelessNode struct {
 	HostName string `json:"hostName"`
 	GRPCPort uint16 `json:"grpcPort"`
 	HTTPPort uint16 `json:"httpPort"`
+	HTTPS    bool   `json:"https,omitempty"` // if http server enables tls
 
 	Version    string `json:"version"`
 	OnlineTime int64  `json:"onlineTime"` // node online time(millisecond)
@@ -93,8 +94,13 @@ func (n *StatelessNode) Indicator() string {
 	return fmt.Sprintf("%s:%d", n.HostIP, n.GRPCPort)
 }
 
+// HTTPAddress returns the http address of node, using https scheme if http server enables tls.
 func (n *StatelessNode) HTTPAddress() string {
-	return fmt.Sprintf("http://%s:%d", n.HostIP, n.HTTPPort)
+	scheme := "http"
+	if n.HTTPS {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s:%d", scheme, n.HostIP, n.HTTPPort)
 }
 
 // ParseNode parses Node from indicator,
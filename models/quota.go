@@ -0,0 +1,68 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/lindb/lindb/constants"
+)
+
+// SeriesQuotaScope represents the scope of series quota.
+type SeriesQuotaScope string
+
+// Defines all scopes of series quota.
+const (
+	ShardQuotaScope     SeriesQuotaScope = "shard"
+	NamespaceQuotaScope SeriesQuotaScope = "namespace"
+	MetricQuotaScope    SeriesQuotaScope = "metric"
+)
+
+// SeriesQuotaError represents the new series rejected because the series quota of scope exceeded.
+type SeriesQuotaError struct {
+	Scope     SeriesQuotaScope `json:"scope"`
+	Namespace string           `json:"namespace,omitempty"` // namespace of metric, only for metric scope
+	Name      string           `json:"name"`                // name of database(shard scope)/namespace/metric
+	Quota     uint32           `json:"quota"`
+	Usage     uint32           `json:"usage"`
+}
+
+// Error returns the error message of series quota exceeded.
+func (e *SeriesQuotaError) Error() string {
+	return fmt.Sprintf("%s, scope: %s, name: %s, quota: %d, usage: %d",
+		constants.ErrSeriesQuotaExceeded, e.Scope, e.Name, e.Quota, e.Usage)
+}
+
+// Unwrap returns constants.ErrSeriesQuotaExceeded, so that errors.Is can check it.
+func (e *SeriesQuotaError) Unwrap() error {
+	return constants.ErrSeriesQuotaExceeded
+}
+
+// SeriesQuotaUsage represents the series usage of shard/namespace/metric.
+type SeriesQuotaUsage struct {
+	Scope SeriesQuotaScope `json:"scope"`
+	Name  string           `json:"name"`  // name of database(shard scope)/namespace/metric
+	Quota uint32           `json:"quota"` // 0 means unlimited
+	Usage uint32           `json:"usage"`
+}
+
+// ShardSeriesQuota represents the series quota usages of shard.
+type ShardSeriesQuota struct {
+	ShardID ShardID            `json:"shardId"`
+	Usages  []SeriesQuotaUsage `json:"usages"`
+}
@@ -0,0 +1,37 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+)
+
+func TestSeriesQuotaError(t *testing.T) {
+	err := fmt.Errorf("%w, rejected rows: 2", &SeriesQuotaError{Scope: MetricQuotaScope, Name: "cpu", Quota: 10, Usage: 10})
+	assert.True(t, errors.Is(err, constants.ErrSeriesQuotaExceeded))
+	var quotaErr *SeriesQuotaError
+	assert.True(t, errors.As(err, &quotaErr))
+	assert.Equal(t, MetricQuotaScope, quotaErr.Scope)
+	assert.Equal(t, "series quota exceeded, scope: metric, name: cpu, quota: 10, usage: 10, rejected rows: 2", err.Error())
+}
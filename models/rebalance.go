@@ -0,0 +1,110 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"encoding/json"
+
+	"github.com/lindb/lindb/pkg/option"
+)
+
+// RebalanceState represents the state of rebalance job/shard move.
+type RebalanceState int
+
+const (
+	RebalancePending RebalanceState = iota + 1
+	RebalanceCopyingData
+	RebalanceAddingReplica
+	RebalanceCatchingUp
+	RebalanceSwitchingLeader
+	RebalanceRemovingReplica
+	RebalanceDone
+	RebalanceFailed
+)
+
+var rebalanceStateNames = map[RebalanceState]string{
+	RebalancePending:         "Pending",
+	RebalanceCopyingData:     "CopyingData",
+	RebalanceAddingReplica:   "AddingReplica",
+	RebalanceCatchingUp:      "CatchingUp",
+	RebalanceSwitchingLeader: "SwitchingLeader",
+	RebalanceRemovingReplica: "RemovingReplica",
+	RebalanceDone:            "Done",
+	RebalanceFailed:          "Failed",
+}
+
+// String returns the string value of RebalanceState.
+func (s RebalanceState) String() string {
+	if name, ok := rebalanceStateNames[s]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
+// IsTerminal returns if rebalance state is completed(done/failed).
+func (s RebalanceState) IsTerminal() bool {
+	return s == RebalanceDone || s == RebalanceFailed
+}
+
+// MarshalJSON encodes rebalance state.
+func (s RebalanceState) MarshalJSON() ([]byte, error) {
+	val := s.String()
+	return json.Marshal(&val)
+}
+
+// UnmarshalJSON decodes rebalance state.
+func (s *RebalanceState) UnmarshalJSON(value []byte) error {
+	var val string
+	if err := json.Unmarshal(value, &val); err != nil {
+		return err
+	}
+	*s = 0
+	for state, name := range rebalanceStateNames {
+		if name == val {
+			*s = state
+			break
+		}
+	}
+	return nil
+}
+
+// ShardMove represents moving a replica of shard from one storage node to another.
+type ShardMove struct {
+	ShardID ShardID        `json:"shardId"`
+	From    NodeID         `json:"from"`
+	To      NodeID         `json:"to"`
+	State   RebalanceState `json:"state"`
+}
+
+// RebalanceJob represents a job which moves shard replicas of database between storage nodes.
+type RebalanceJob struct {
+	Database   string         `json:"database"`
+	State      RebalanceState `json:"state"`
+	Moves      []ShardMove    `json:"moves"`
+	ErrMsg     string         `json:"errMsg,omitempty"`
+	CreateTime int64          `json:"createTime"`
+	UpdateTime int64          `json:"updateTime"`
+}
+
+// ShardMigration represents the param of migrating shard data from leader to new replica.
+type ShardMigration struct {
+	Database string                 `json:"database" binding:"required"`
+	ShardID  ShardID                `json:"shardId"`
+	Option   *option.DatabaseOption `json:"option" binding:"required"`
+	Target   string                 `json:"target" binding:"required"` // http address of target node
+}
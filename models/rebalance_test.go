@@ -0,0 +1,55 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/encoding"
+)
+
+func TestRebalanceState(t *testing.T) {
+	for state := RebalancePending; state <= RebalanceFailed; state++ {
+		data := encoding.JSONMarshal(state)
+		newState := RebalanceState(0)
+		assert.NoError(t, encoding.JSONUnmarshal(data, &newState))
+		assert.Equal(t, state, newState)
+	}
+	assert.Equal(t, "Unknown", RebalanceState(100).String())
+	assert.True(t, RebalanceDone.IsTerminal())
+	assert.True(t, RebalanceFailed.IsTerminal())
+	assert.False(t, RebalanceCatchingUp.IsTerminal())
+
+	newState := RebalanceState(0)
+	assert.Error(t, encoding.JSONUnmarshal([]byte("abc"), &newState))
+	assert.NoError(t, encoding.JSONUnmarshal([]byte(`"abc"`), &newState))
+	assert.Equal(t, RebalanceState(0), newState)
+}
+
+func TestRebalanceJob(t *testing.T) {
+	job := &RebalanceJob{
+		Database: "db",
+		State:    RebalanceCatchingUp,
+		Moves:    []ShardMove{{ShardID: 1, From: 1, To: 2, State: RebalanceCatchingUp}},
+	}
+	newJob := &RebalanceJob{}
+	assert.NoError(t, encoding.JSONUnmarshal(encoding.JSONMarshal(job), newJob))
+	assert.Equal(t, job, newJob)
+}
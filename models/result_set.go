@@ -18,7 +18,9 @@
 package models
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"path"
 	"sort"
 
@@ -29,7 +31,8 @@ import (
 
 // SuggestResult represents the suggest result set
 type SuggestResult struct {
-	Values []string `json:"values"`
+	Values      []string          `json:"values"`
+	Cardinality map[string]uint64 `json:"cardinality,omitempty"` // key: metric name/tag key, value: series/tag values count
 }
 
 // ResultSet represents the query result set
@@ -128,6 +131,71 @@ type Series struct {
 	TagValues string `json:"-"` // return series in order by tag values
 }
 
+// nullableSeries represents the series which field value is nullable, nil value means null.
+type nullableSeries struct {
+	Tags   map[string]string             `json:"tags,omitempty"`
+	Fields map[string]map[int64]*float64 `json:"fields,omitempty"`
+}
+
+// MarshalJSON encodes series, encodes NaN value(fill with null) as null.
+func (s Series) MarshalJSON() ([]byte, error) {
+	type series Series
+	if !s.hasNaN() {
+		return json.Marshal(series(s))
+	}
+	ns := nullableSeries{Tags: s.Tags, Fields: make(map[string]map[int64]*float64, len(s.Fields))}
+	for fieldName, points := range s.Fields {
+		nullablePoints := make(map[int64]*float64, len(points))
+		for timestamp, value := range points {
+			if math.IsNaN(value) {
+				nullablePoints[timestamp] = nil
+				continue
+			}
+			v := value
+			nullablePoints[timestamp] = &v
+		}
+		ns.Fields[fieldName] = nullablePoints
+	}
+	return json.Marshal(ns)
+}
+
+// UnmarshalJSON decodes series, decodes null value as NaN.
+func (s *Series) UnmarshalJSON(data []byte) error {
+	ns := nullableSeries{}
+	if err := json.Unmarshal(data, &ns); err != nil {
+		return err
+	}
+	s.Tags = ns.Tags
+	if ns.Fields == nil {
+		return nil
+	}
+	s.Fields = make(map[string]map[int64]float64, len(ns.Fields))
+	for fieldName, nullablePoints := range ns.Fields {
+		points := make(map[int64]float64, len(nullablePoints))
+		for timestamp, value := range nullablePoints {
+			if value == nil {
+				points[timestamp] = math.NaN()
+				continue
+			}
+			points[timestamp] = *value
+		}
+		s.Fields[fieldName] = points
+	}
+	return nil
+}
+
+// hasNaN checks if series has NaN value.
+func (s *Series) hasNaN() bool {
+	for _, points := range s.Fields {
+		for _, value := range points {
+			if math.IsNaN(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // NewSeries creates a new series.
 func NewSeries(tags map[string]string, tagValues string) *Series {
 	return &Series{
@@ -19,6 +19,7 @@ package models
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -78,3 +79,31 @@ func TestResultSet_Stats_ToTable(t *testing.T) {
 	fmt.Println(rows)
 	fmt.Println(table)
 }
+
+func TestSeries_JSON(t *testing.T) {
+	s := NewSeries(map[string]string{"host": "host1"}, "host1")
+	points := NewPoints()
+	points.AddPoint(10, 1.0)
+	s.AddField("f1", points)
+	data := encoding.JSONMarshal(s)
+	assert.Equal(t, `{"tags":{"host":"host1"},"fields":{"f1":{"10":1}}}`, string(data))
+
+	// NaN value encodes as null
+	points = NewPoints()
+	points.AddPoint(20, math.NaN())
+	s.AddField("f1", points)
+	data = encoding.JSONMarshal(s)
+	assert.Equal(t, `{"tags":{"host":"host1"},"fields":{"f1":{"10":1,"20":null}}}`, string(data))
+
+	s1 := &Series{}
+	err := encoding.JSONUnmarshal(data, s1)
+	assert.NoError(t, err)
+	assert.Equal(t, s.Tags, s1.Tags)
+	assert.Equal(t, 1.0, s1.Fields["f1"][10])
+	assert.True(t, math.IsNaN(s1.Fields["f1"][20]))
+
+	err = encoding.JSONUnmarshal([]byte("abc"), s1)
+	assert.Error(t, err)
+	err = encoding.JSONUnmarshal([]byte(`{"tags":{"host":"host1"}}`), s1)
+	assert.NoError(t, err)
+}
@@ -125,6 +125,7 @@ type ReplicaState struct {
 	Leader     NodeID  `json:"leader"`
 	Follower   NodeID  `json:"follower"`
 	FamilyTime int64   `json:"familyTime"`
+	Epoch      int64   `json:"epoch,omitempty"` // leader epoch of shard, follower rejects replica with stale epoch
 }
 
 // String returns the string value of ReplicaState.
@@ -138,12 +139,27 @@ func (r ReplicaState) String() string {
 		"]"
 }
 
+// LeaderElectReason represents the reason why the replica is elected as shard's leader.
+type LeaderElectReason string
+
+const (
+	// ElectByAssignment elects the first live replica of shard assignment.
+	ElectByAssignment LeaderElectReason = "Assignment"
+	// ElectByLogProgress elects the live replica which has the highest ack index of previous(offline) leader's log.
+	ElectByLogProgress LeaderElectReason = "LogProgress"
+	// ElectByNodeOnline elects the leader of offline shard when its replica node online.
+	ElectByNodeOnline LeaderElectReason = "NodeOnline"
+)
+
 // ShardState represents current state of shard.
 type ShardState struct {
 	ID      ShardID        `json:"id"`
 	State   ShardStateType `json:"state"`
 	Leader  NodeID         `json:"leader"`
 	Replica Replica        `json:"replica"`
+	// Epoch increases when leader changed, storage rejects the write/replica with stale epoch(fence stale leader).
+	Epoch       int64             `json:"epoch"`
+	ElectReason LeaderElectReason `json:"electReason,omitempty"`
 }
 
 // FamilyState represents current state of shard's family.
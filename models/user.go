@@ -0,0 +1,219 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/lindb/lindb/constants"
+)
+
+// Role represents the access role of user on database.
+type Role string
+
+const (
+	// RoleRead represents querying data/metadata of database.
+	RoleRead Role = "read"
+	// RoleWrite represents writing/deleting data of database, includes read role.
+	RoleWrite Role = "write"
+	// RoleAdmin represents managing database(schema, continuous query etc.), includes write role,
+	// if granted on all databases, user can manage cluster/user also.
+	RoleAdmin Role = "admin"
+)
+
+// AllDatabases represents the privilege which granted on all databases.
+const AllDatabases = "*"
+
+// roleLevels defines the level of role, high level role includes low level role.
+var roleLevels = map[Role]int{
+	RoleRead:  1,
+	RoleWrite: 2,
+	RoleAdmin: 3,
+}
+
+// ParseRole returns the role by name.
+func ParseRole(name string) (Role, error) {
+	role := Role(strings.ToLower(name))
+	if _, ok := roleLevels[role]; !ok {
+		return "", fmt.Errorf("unknown role: %s", name)
+	}
+	return role, nil
+}
+
+// Includes checks if the role includes other role.
+func (r Role) Includes(other Role) bool {
+	return roleLevels[r] >= roleLevels[other]
+}
+
+// UserType represents the type of user account.
+type UserType string
+
+const (
+	// UserTypeUser represents human user, login with password.
+	UserTypeUser UserType = "user"
+	// UserTypeToken represents api token of ingestion agent, cannot login.
+	UserTypeToken UserType = "token"
+)
+
+// tokenSeparator separates user name and secret of api token.
+const tokenSeparator = ":"
+
+// Privilege represents the role granted on database.
+type Privilege struct {
+	Database string `json:"database"`
+	Role     Role   `json:"role"`
+}
+
+// User represents the user account, includes human user and api token.
+type User struct {
+	Name string   `json:"name"`
+	Type UserType `json:"type"`
+	// Password is bcrypt hash of password for human user, sha256 hash of secret for api token.
+	Password   string      `json:"password,omitempty"`
+	Privileges []Privilege `json:"privileges,omitempty"`
+}
+
+// NewUser creates a human user with password.
+func NewUser(name, password string) (*User, error) {
+	if name == "" {
+		return nil, constants.ErrNameEmpty
+	}
+	if password == "" {
+		return nil, fmt.Errorf("password cannot be empty")
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	return &User{Name: name, Type: UserTypeUser, Password: string(hash)}, nil
+}
+
+// NewToken creates an api token, returns the token which presents by ingestion agent.
+func NewToken(name string) (user *User, token string, err error) {
+	if name == "" {
+		return nil, "", constants.ErrNameEmpty
+	}
+	if strings.Contains(name, tokenSeparator) {
+		return nil, "", fmt.Errorf("token name cannot contain '%s'", tokenSeparator)
+	}
+	secret := make([]byte, 24)
+	if _, err = rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	secretStr := hex.EncodeToString(secret)
+	return &User{Name: name, Type: UserTypeToken, Password: hashSecret(secretStr)}, name + tokenSeparator + secretStr, nil
+}
+
+// ParseToken returns the name and secret of api token.
+func ParseToken(token string) (name, secret string, ok bool) {
+	idx := strings.LastIndex(token, tokenSeparator)
+	if idx <= 0 || idx == len(token)-1 {
+		return "", "", false
+	}
+	return token[:idx], token[idx+1:], true
+}
+
+// CheckPassword checks if password matches the password of human user.
+func (u *User) CheckPassword(password string) bool {
+	if u.Type != UserTypeUser {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)) == nil
+}
+
+// CheckSecret checks if secret matches the secret of api token.
+func (u *User) CheckSecret(secret string) bool {
+	if u.Type != UserTypeToken {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(u.Password), []byte(hashSecret(secret))) == 1
+}
+
+// HasRole checks if user has the role on database, the privilege on all databases is also checked.
+func (u *User) HasRole(database string, role Role) bool {
+	for _, p := range u.Privileges {
+		if (p.Database == AllDatabases || p.Database == database) && p.Role.Includes(role) {
+			return true
+		}
+	}
+	return false
+}
+
+// Grant grants the role on database, returns false if already granted.
+func (u *User) Grant(database string, role Role) bool {
+	for _, p := range u.Privileges {
+		if p.Database == database && p.Role == role {
+			return false
+		}
+	}
+	u.Privileges = append(u.Privileges, Privilege{Database: database, Role: role})
+	return true
+}
+
+// Revoke revokes the role on database, returns false if not granted.
+func (u *User) Revoke(database string, role Role) bool {
+	for idx, p := range u.Privileges {
+		if p.Database == database && p.Role == role {
+			u.Privileges = append(u.Privileges[:idx], u.Privileges[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Desensitize returns the copy of user without password.
+func (u *User) Desensitize() User {
+	user := *u
+	user.Password = ""
+	return user
+}
+
+// hashSecret returns sha256 hash of api token's secret.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// Users represents the user list.
+type Users []User
+
+// ToTable returns user list as table if it has value, else return empty string.
+func (us Users) ToTable() (rows int, tableStr string) {
+	if len(us) == 0 {
+		return 0, ""
+	}
+	writer := NewTableFormatter()
+	writer.AppendHeader(table.Row{"Name", "Type", "Privileges"})
+	for i := range us {
+		u := us[i]
+		privileges := make([]string, 0, len(u.Privileges))
+		for _, p := range u.Privileges {
+			privileges = append(privileges, fmt.Sprintf("%s on %s", p.Role, p.Database))
+		}
+		writer.AppendRow(table.Row{u.Name, u.Type, strings.Join(privileges, ",")})
+	}
+	return len(us), writer.Render()
+}
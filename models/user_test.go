@@ -0,0 +1,109 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRole(t *testing.T) {
+	role, err := ParseRole("WRITE")
+	assert.NoError(t, err)
+	assert.Equal(t, RoleWrite, role)
+	_, err = ParseRole("owner")
+	assert.Error(t, err)
+
+	assert.True(t, RoleAdmin.Includes(RoleWrite))
+	assert.True(t, RoleWrite.Includes(RoleRead))
+	assert.True(t, RoleRead.Includes(RoleRead))
+	assert.False(t, RoleRead.Includes(RoleWrite))
+	assert.False(t, RoleWrite.Includes(RoleAdmin))
+}
+
+func TestNewUser(t *testing.T) {
+	_, err := NewUser("", "pwd")
+	assert.Error(t, err)
+	_, err = NewUser("bob", "")
+	assert.Error(t, err)
+	user, err := NewUser("bob", "pwd")
+	assert.NoError(t, err)
+	assert.Equal(t, UserTypeUser, user.Type)
+	assert.NotEqual(t, "pwd", user.Password)
+	assert.True(t, user.CheckPassword("pwd"))
+	assert.False(t, user.CheckPassword("pwd1"))
+	assert.False(t, user.CheckSecret("pwd"))
+	assert.Empty(t, user.Desensitize().Password)
+	assert.NotEmpty(t, user.Password)
+}
+
+func TestNewToken(t *testing.T) {
+	_, _, err := NewToken("")
+	assert.Error(t, err)
+	_, _, err = NewToken("a:b")
+	assert.Error(t, err)
+	user, token, err := NewToken("agent")
+	assert.NoError(t, err)
+	assert.Equal(t, UserTypeToken, user.Type)
+	name, secret, ok := ParseToken(token)
+	assert.True(t, ok)
+	assert.Equal(t, "agent", name)
+	assert.True(t, user.CheckSecret(secret))
+	assert.False(t, user.CheckSecret("secret"))
+	assert.False(t, user.CheckPassword(secret))
+
+	for _, token := range []string{"", "agent", ":secret", "agent:"} {
+		_, _, ok = ParseToken(token)
+		assert.False(t, ok)
+	}
+}
+
+func TestUser_Privileges(t *testing.T) {
+	user := &User{Name: "bob"}
+	assert.False(t, user.HasRole("db", RoleRead))
+
+	assert.True(t, user.Grant("db", RoleWrite))
+	assert.False(t, user.Grant("db", RoleWrite))
+	assert.True(t, user.HasRole("db", RoleRead))
+	assert.True(t, user.HasRole("db", RoleWrite))
+	assert.False(t, user.HasRole("db", RoleAdmin))
+	assert.False(t, user.HasRole("db2", RoleRead))
+
+	assert.True(t, user.Grant(AllDatabases, RoleRead))
+	assert.True(t, user.HasRole("db2", RoleRead))
+	assert.False(t, user.HasRole("db2", RoleWrite))
+
+	assert.True(t, user.Revoke("db", RoleWrite))
+	assert.False(t, user.Revoke("db", RoleWrite))
+	assert.False(t, user.HasRole("db", RoleWrite))
+	assert.True(t, user.HasRole("db", RoleRead))
+}
+
+func TestUsers_ToTable(t *testing.T) {
+	rows, tableStr := Users{}.ToTable()
+	assert.Zero(t, rows)
+	assert.Empty(t, tableStr)
+	rows, tableStr = Users{{
+		Name:       "bob",
+		Type:       UserTypeUser,
+		Privileges: []Privilege{{Database: AllDatabases, Role: RoleAdmin}},
+	}}.ToTable()
+	assert.Equal(t, 1, rows)
+	assert.Contains(t, tableStr, "admin on *")
+}
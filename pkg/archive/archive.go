@@ -0,0 +1,207 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package archive
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// for testing
+var (
+	openFileFn = os.Open
+)
+
+// Entry represents a file entry of archive.
+type Entry struct {
+	Name     string `json:"name"`
+	Size     int64  `json:"size"`
+	Checksum string `json:"checksum"` // sha256 of file content
+}
+
+// WriteFile writes the file of path into archive with given name, returns the entry with checksum.
+func WriteFile(tw *tar.Writer, name, path string) (*Entry, error) {
+	f, err := openFileFn(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return Copy(tw, name, stat.Size(), f)
+}
+
+// WriteDir writes all regular files under dir into archive, the name of entry is prefix join relative path.
+func WriteDir(tw *tar.Writer, dir, prefix string) (entries []Entry, err error) {
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		entry, err := WriteFile(tw, Join(prefix, filepath.ToSlash(rel)), path)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, *entry)
+		return nil
+	})
+	return entries, err
+}
+
+// WriteBytes writes the data into archive with given name.
+func WriteBytes(tw *tar.Writer, name string, data []byte) error {
+	if err := tw.WriteHeader(newHeader(name, int64(len(data)))); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+// Copy copies the content of reader into archive with given name, returns the entry with checksum.
+func Copy(tw *tar.Writer, name string, size int64, r io.Reader) (*Entry, error) {
+	if err := tw.WriteHeader(newHeader(name, size)); err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(tw, h), r)
+	if err != nil {
+		return nil, err
+	}
+	return &Entry{Name: name, Size: n, Checksum: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+// Extract extracts the content of reader into the file which is dir join name, returns the entry with checksum.
+func Extract(dir, name string, r io.Reader) (*Entry, error) {
+	path, err := SafeJoin(dir, name)
+	if err != nil {
+		return nil, err
+	}
+	if err0 := os.MkdirAll(filepath.Dir(path), os.ModePerm); err0 != nil {
+		return nil, err0
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(f, h), r)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Sync(); err != nil {
+		return nil, err
+	}
+	return &Entry{Name: name, Size: n, Checksum: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+// Checksum returns the entry with checksum of the file which is dir join name.
+func Checksum(dir, name string) (*Entry, error) {
+	path, err := SafeJoin(dir, name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := openFileFn(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return Sum(name, f)
+}
+
+// Sum returns the entry with checksum of the content of reader.
+func Sum(name string, r io.Reader) (*Entry, error) {
+	h := sha256.New()
+	n, err := io.Copy(h, r)
+	if err != nil {
+		return nil, err
+	}
+	return &Entry{Name: name, Size: n, Checksum: hex.EncodeToString(h.Sum(nil))}, nil
+}
+
+// Verify checks if actual entries are same as expected entries.
+func Verify(expected, actual []Entry) error {
+	if len(expected) != len(actual) {
+		return fmt.Errorf("num. of files not match, expect: %d, actual: %d", len(expected), len(actual))
+	}
+	sortEntries(expected)
+	sortEntries(actual)
+	for idx := range expected {
+		if expected[idx] != actual[idx] {
+			return fmt.Errorf("file %s checksum not match, expect: %s/%d, actual: %s/%d",
+				expected[idx].Name, expected[idx].Checksum, expected[idx].Size, actual[idx].Checksum, actual[idx].Size)
+		}
+	}
+	return nil
+}
+
+// Join joins the name of entry with prefix.
+func Join(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return strings.TrimSuffix(prefix, "/") + "/" + name
+}
+
+// SafeJoin joins dir and name of entry, returns error if the name escapes from dir.
+func SafeJoin(dir, name string) (string, error) {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid file name in archive: %s", name)
+	}
+	return path, nil
+}
+
+// newHeader creates the tar header of regular file.
+func newHeader(name string, size int64) *tar.Header {
+	return &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0o644,
+	}
+}
+
+// sortEntries sorts entries by name.
+func sortEntries(entries []Entry) {
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name < entries[j].Name
+	})
+}
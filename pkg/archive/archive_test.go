@@ -0,0 +1,126 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArchive_WriteAndExtract(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "a", "b"), os.ModePerm))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "a", "b", "1.sst"), []byte("data"), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "OPTIONS"), []byte("options"), 0o644))
+
+	buf := &bytes.Buffer{}
+	tw := tar.NewWriter(buf)
+	entries, err := WriteDir(tw, dir, "db")
+	assert.NoError(t, err)
+	assert.Len(t, entries, 2)
+	assert.NoError(t, WriteBytes(tw, "manifest.json", []byte("{}")))
+	assert.NoError(t, tw.Close())
+
+	target := t.TempDir()
+	tr := tar.NewReader(buf)
+	var extracted []Entry
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		if hdr.Name == "manifest.json" {
+			continue
+		}
+		entry, err := Extract(target, hdr.Name, tr)
+		assert.NoError(t, err)
+		extracted = append(extracted, *entry)
+	}
+	assert.NoError(t, Verify(entries, extracted))
+	data, err := os.ReadFile(filepath.Join(target, "db", "a", "b", "1.sst"))
+	assert.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+
+	entry, err := Checksum(target, "db/OPTIONS")
+	assert.NoError(t, err)
+	assert.Equal(t, entries[0], *entry)
+	_, err = Checksum(target, "db/not_exist")
+	assert.Error(t, err)
+	_, err = Checksum(target, "../OPTIONS")
+	assert.Error(t, err)
+}
+
+func TestArchive_Verify(t *testing.T) {
+	assert.Error(t, Verify([]Entry{{Name: "a"}}, nil))
+	assert.Error(t, Verify([]Entry{{Name: "a", Checksum: "1"}}, []Entry{{Name: "a", Checksum: "2"}}))
+	assert.NoError(t, Verify(
+		[]Entry{{Name: "a", Checksum: "1"}, {Name: "b", Checksum: "2"}},
+		[]Entry{{Name: "b", Checksum: "2"}, {Name: "a", Checksum: "1"}}))
+}
+
+func TestArchive_SafeJoin(t *testing.T) {
+	cases := []struct {
+		name    string
+		wantErr bool
+	}{
+		{name: "db/OPTIONS"},
+		{name: "../etc/passwd", wantErr: true},
+		{name: "db/../../a", wantErr: true},
+		{name: ".", wantErr: true},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := SafeJoin("/tmp/restore", tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SafeJoin() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	assert.Equal(t, "a", Join("", "a"))
+	assert.Equal(t, "p/a", Join("p/", "a"))
+}
+
+func TestArchive_WriteFile_Error(t *testing.T) {
+	defer func() {
+		openFileFn = os.Open
+	}()
+	openFileFn = func(name string) (*os.File, error) {
+		return nil, fmt.Errorf("err")
+	}
+	tw := tar.NewWriter(&bytes.Buffer{})
+	_, err := WriteFile(tw, "a", "a")
+	assert.Error(t, err)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "a"), []byte("a"), 0o644))
+	_, err = WriteDir(tw, dir, "")
+	assert.Error(t, err)
+	_, err = WriteDir(tw, filepath.Join(dir, "not_exist"), "")
+	assert.Error(t, err)
+	_, err = Extract(dir, "../a", bytes.NewReader(nil))
+	assert.Error(t, err)
+}
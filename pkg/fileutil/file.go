@@ -18,6 +18,7 @@
 package fileutil
 
 import (
+	"io"
 	"os"
 	"path/filepath"
 )
@@ -26,6 +27,7 @@ var (
 	mkdirAllFunc  = os.MkdirAll
 	removeAllFunc = os.RemoveAll
 	removeFunc    = os.Remove
+	linkFunc      = os.Link
 )
 
 // MkDirIfNotExist creates given dir if it's not exist
@@ -102,3 +104,35 @@ func GetExistPath(path string) string {
 	}
 	return GetExistPath(dir)
 }
+
+// LinkOrCopy creates hard link of source file, copies the file if hard link not supported(such as cross device).
+func LinkOrCopy(src, dst string) error {
+	if err := linkFunc(src, dst); err == nil {
+		return nil
+	}
+	return CopyFile(src, dst)
+}
+
+// CopyFile copies the content of source file into target file, then syncs target file.
+func CopyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err0 := out.Close(); err == nil {
+			err = err0
+		}
+	}()
+	if _, err = io.Copy(out, in); err != nil {
+		return err
+	}
+	return out.Sync()
+}
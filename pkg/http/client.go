@@ -0,0 +1,138 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"crypto/tls"
+	"net/http"
+	"sync"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// authorizationHeader represents the header of authorization.
+const authorizationHeader = "Authorization"
+
+var (
+	clientTLSConfig     *tls.Config
+	clientTransport     http.RoundTripper = http.DefaultTransport
+	clientAuthorization func() string
+	// internal authorization isn't set by default, because the client may call external endpoint(such as monitoring).
+	clientInternalAuthorization func() string
+	clientConfigMutex           sync.RWMutex
+)
+
+// SetClientTLSConfig sets the tls config of http client which calls the api of other nodes,
+// the cert of current node is used as client cert if server requires.
+func SetClientTLSConfig(tlsConfig *tls.Config) {
+	clientConfigMutex.Lock()
+	defer clientConfigMutex.Unlock()
+
+	clientTLSConfig = tlsConfig
+	if tlsConfig == nil {
+		clientTransport = http.DefaultTransport
+		return
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	clientTransport = transport
+}
+
+// SetClientAuthorization sets the provider of authorization header for http client which calls the api of other nodes,
+// the header is set if request doesn't carry authorization header.
+func SetClientAuthorization(authorization func() string) {
+	clientConfigMutex.Lock()
+	defer clientConfigMutex.Unlock()
+
+	clientAuthorization = authorization
+}
+
+// SetClientInternalAuthorization sets the provider of authorization token for internal api between nodes.
+func SetClientInternalAuthorization(authorization func() string) {
+	clientConfigMutex.Lock()
+	defer clientConfigMutex.Unlock()
+
+	clientInternalAuthorization = authorization
+}
+
+// InternalAuthorization returns the authorization token for internal api between nodes,
+// which is set into X-Lindb-Internal-Authorization header by caller, empty if not set.
+func InternalAuthorization() string {
+	clientConfigMutex.RLock()
+	authorization := clientInternalAuthorization
+	clientConfigMutex.RUnlock()
+
+	if authorization == nil {
+		return ""
+	}
+	return authorization()
+}
+
+// NewRestyClient creates the resty client with the tls config/authorization of http client.
+func NewRestyClient() *resty.Client {
+	client := resty.New()
+	clientConfigMutex.RLock()
+	defer clientConfigMutex.RUnlock()
+
+	if clientTLSConfig != nil {
+		client.SetTLSClientConfig(clientTLSConfig)
+	}
+	if authorization := clientAuthorization; authorization != nil {
+		// set authorization for each request, because token may be expired for long-lived client
+		client.OnBeforeRequest(func(_ *resty.Client, req *resty.Request) error {
+			if req.Header.Get(authorizationHeader) == "" {
+				req.Header.Set(authorizationHeader, authorization())
+			}
+			return nil
+		})
+	}
+	return client
+}
+
+// ClientTransport returns the http transport which uses the tls config/authorization of http client.
+func ClientTransport() http.RoundTripper {
+	return transport{}
+}
+
+// ClientScheme returns the scheme of http client, https if tls enabled.
+func ClientScheme() string {
+	clientConfigMutex.RLock()
+	defer clientConfigMutex.RUnlock()
+
+	if clientTLSConfig != nil {
+		return "https"
+	}
+	return "http"
+}
+
+// transport implements http.RoundTripper, delegates to the transport with latest tls config.
+type transport struct{}
+
+// RoundTrip executes http request with the transport of http client.
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	clientConfigMutex.RLock()
+	rt := clientTransport
+	authorization := clientAuthorization
+	clientConfigMutex.RUnlock()
+	if authorization != nil && req.Header.Get(authorizationHeader) == "" {
+		// round tripper should not modify the request
+		req = req.Clone(req.Context())
+		req.Header.Set(authorizationHeader, authorization())
+	}
+	return rt.RoundTrip(req)
+}
@@ -0,0 +1,105 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_TLS(t *testing.T) {
+	defer SetClientTLSConfig(nil)
+
+	svr := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer svr.Close()
+
+	doRequest := func() error {
+		req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, svr.URL, http.NoBody)
+		resp, err := ClientTransport().RoundTrip(req)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+	// server cert not trusted
+	assert.Equal(t, "http", ClientScheme())
+	assert.Error(t, doRequest())
+	_, err := NewRestyClient().R().Get(svr.URL)
+	assert.Error(t, err)
+
+	SetClientTLSConfig(svr.Client().Transport.(*http.Transport).TLSClientConfig.Clone())
+	assert.Equal(t, "https", ClientScheme())
+	assert.NoError(t, doRequest())
+	resp, err := NewRestyClient().R().Get(svr.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+
+	SetClientTLSConfig(nil)
+	assert.Equal(t, "http", ClientScheme())
+	assert.Error(t, doRequest())
+}
+
+func TestClient_Authorization(t *testing.T) {
+	defer SetClientAuthorization(nil)
+
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(r.Header.Get(authorizationHeader)))
+	}))
+	defer svr.Close()
+
+	doRequest := func(authorization string) string {
+		req, _ := http.NewRequestWithContext(context.TODO(), http.MethodGet, svr.URL, http.NoBody)
+		if authorization != "" {
+			req.Header.Set(authorizationHeader, authorization)
+		}
+		resp, err := ClientTransport().RoundTrip(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		return string(body)
+	}
+	assert.Empty(t, doRequest(""))
+
+	SetClientAuthorization(func() string {
+		return "Bearer token"
+	})
+	assert.Equal(t, "Bearer token", doRequest(""))
+	// keep the authorization of request
+	assert.Equal(t, "Bearer user", doRequest("Bearer user"))
+	resp, err := NewRestyClient().R().Get(svr.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer token", resp.String())
+}
+
+func TestClient_InternalAuthorization(t *testing.T) {
+	defer SetClientInternalAuthorization(nil)
+
+	assert.Empty(t, InternalAuthorization())
+	SetClientInternalAuthorization(func() string {
+		return "token"
+	})
+	assert.Equal(t, "token", InternalAuthorization())
+}
@@ -19,8 +19,10 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"io/fs"
+	"net"
 	"net/http"
 
 	"github.com/felixge/fgprof"
@@ -39,6 +41,7 @@ import (
 	"github.com/lindb/lindb/pkg/hostutil"
 	"github.com/lindb/lindb/pkg/http/middleware"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/tlsutil"
 )
 
 //go:generate mockgen -source ./http_server.go -destination=./http_server_mock.go -package=http
@@ -47,6 +50,8 @@ import (
 type Server interface {
 	// GetAPIRouter returns api router.
 	GetAPIRouter() *gin.RouterGroup
+	// GetRootRouter returns root router, which serves url without api prefix.
+	GetRootRouter() *gin.RouterGroup
 	// Run runs the HTTP server.
 	Run() error
 	// Close closes the server.
@@ -105,8 +110,12 @@ func (s *server) init() {
 
 	if config.Doc {
 		ip, _ := hostutil.GetHostIP()
+		scheme := "http"
+		if s.cfg.TLS.Enabled {
+			scheme = "https"
+		}
 		s.gin.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler,
-			ginSwagger.URL(fmt.Sprintf("http://%s:%d/swagger/doc.json", ip, s.cfg.Port)),
+			ginSwagger.URL(fmt.Sprintf("%s://%s:%d/swagger/doc.json", scheme, ip, s.cfg.Port)),
 			ginSwagger.DefaultModelsExpandDepth(-1)))
 	}
 	if s.staticResource {
@@ -131,16 +140,32 @@ func (s *server) GetAPIRouter() *gin.RouterGroup {
 	return s.gin.Group(constants.APIRoot)
 }
 
-// Run runs the HTTP server.
+// GetRootRouter returns root router, which serves url without api prefix.
+func (s *server) GetRootRouter() *gin.RouterGroup {
+	return &s.gin.RouterGroup
+}
+
+// Run runs the HTTP server, serves https if tls enabled.
 func (s *server) Run() error {
-	s.logger.Info("starting http server", logger.String("addr", s.server.Addr))
+	s.logger.Info("starting http server", logger.String("addr", s.server.Addr), logger.Any("tls", s.cfg.TLS.Enabled))
 	s.server.Handler = s.gin
+	var loader *tlsutil.Loader
+	if s.cfg.TLS.Enabled {
+		var err error
+		if loader, err = tlsutil.NewLoader(s.cfg.TLS); err != nil {
+			return err
+		}
+	}
 	// Open listener.
 	trackedListener, err := conntrack.NewTrackedListener("tcp", s.addr, s.r)
 	if err != nil {
 		return err
 	}
-	return s.server.Serve(trackedListener)
+	var listener net.Listener = trackedListener
+	if loader != nil {
+		listener = tls.NewListener(trackedListener, loader.ServerConfig("http/1.1"))
+	}
+	return s.server.Serve(listener)
 }
 
 // Close closes the server.
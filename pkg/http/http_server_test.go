@@ -19,13 +19,17 @@ package http
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
+	"github.com/lindb/lindb/pkg/tlsutil"
 )
 
 func init() {
@@ -37,6 +41,7 @@ func TestNewHTTPServer(t *testing.T) {
 	config.Doc = true
 	s := NewServer(config.HTTP{Port: 9999}, true, linmetric.BrokerRegistry)
 	assert.NotNil(t, s.GetAPIRouter())
+	assert.NotNil(t, s.GetRootRouter())
 	go func() {
 		_ = s.Run()
 	}()
@@ -44,3 +49,44 @@ func TestNewHTTPServer(t *testing.T) {
 		_ = s.Close(context.TODO())
 	}()
 }
+
+func TestNewHTTPServer_TLS(t *testing.T) {
+	defer SetClientTLSConfig(nil)
+
+	files := mock.GenerateTLSFiles(t, t.TempDir())
+	tlsCfg := config.TLS{
+		Enabled:    true,
+		CertFile:   files.CertFile,
+		KeyFile:    files.KeyFile,
+		CAFile:     files.CAFile,
+		ClientAuth: config.ClientAuthRequireAndVerify,
+	}
+	// load tls config failure
+	s := NewServer(config.HTTP{Port: 9998, TLS: config.TLS{Enabled: true}}, false, linmetric.BrokerRegistry)
+	assert.Error(t, s.Run())
+
+	s = NewServer(config.HTTP{Port: 9998, TLS: tlsCfg}, false, linmetric.BrokerRegistry)
+	s.GetAPIRouter().GET("/ping", func(c *gin.Context) {
+		OK(c, "pong")
+	})
+	go func() {
+		_ = s.Run()
+	}()
+	defer func() {
+		_ = s.Close(context.TODO())
+	}()
+	// wait server start finish
+	time.Sleep(50 * time.Millisecond)
+
+	url := "https://127.0.0.1:9998/api/ping"
+	// client without cert
+	_, err := NewRestyClient().R().Get(url)
+	assert.Error(t, err)
+
+	loader, err := tlsutil.NewLoader(tlsCfg)
+	assert.NoError(t, err)
+	SetClientTLSConfig(loader.ClientConfig())
+	resp, err := NewRestyClient().R().Get(url)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode())
+}
@@ -18,39 +18,36 @@
 package middleware
 
 import (
-	"crypto/md5"
-	"fmt"
+	"crypto/sha256"
+	"time"
 
 	jwt "github.com/dgrijalva/jwt-go"
 
-	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
 )
 
 // CustomClaims represents jwt custom claims param
-// need username and password and some standard claims
+// need username and some standard claims(expires at etc.)
 type CustomClaims struct {
 	jwt.StandardClaims
 	UserName string `json:"username"`
-	Password string `json:"password"`
 }
 
-// CreateToken returns token use jwt with custom claims
-func CreateToken(user config.User) (string, error) {
+// CreateToken returns token use jwt with custom claims, which expires after ttl.
+func CreateToken(user *models.User, ttl time.Duration) (string, error) {
 	claims := CustomClaims{
-		UserName: user.UserName,
-		Password: user.Password,
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: time.Now().Add(ttl).Unix(),
+		},
+		UserName: user.Name,
 	}
-	cid := Md5Encrypt(user)
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &claims)
-	return token.SignedString([]byte(cid))
+	return token.SignedString(signingKey(user))
 }
 
-// Md5Encrypt returns secret key use Mk5 encryption with username and password
-func Md5Encrypt(user config.User) string {
-	/* #nosec */
-	md5Encrypt := md5.New()
-	key := fmt.Sprintf("%s/%s", user.UserName, user.Password)
-	_, _ = md5Encrypt.Write([]byte(key))
-	cipher := md5Encrypt.Sum(nil)
-	return string(cipher)
+// signingKey returns secret key use sha256 with username and password hash,
+// so that the token is invalid after user dropped or password changed.
+func signingKey(user *models.User) []byte {
+	key := sha256.Sum256([]byte(user.Name + "/" + user.Password))
+	return key[:]
 }
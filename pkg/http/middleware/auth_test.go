@@ -19,18 +19,30 @@ package middleware
 
 import (
 	"testing"
+	"time"
 
+	jwt "github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
 
-	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
 )
 
-var tokenStr = "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJ1c2VybmFtZSI6ImFkbWluIiwicGF" +
-	"zc3dvcmQiOiJhZG1pbjEyMyJ9.YbNGN0V-U5Y3xOIGNXcgbQkK2VV30UDDEZV19FN62hk"
-
 func Test_CreateToken(t *testing.T) {
-	user := config.User{UserName: "admin", Password: "admin123"}
-	token, err := CreateToken(user)
-	assert.Equal(t, true, err == nil)
-	assert.Equal(t, tokenStr, token)
+	user := &models.User{Name: "admin", Type: models.UserTypeUser, Password: "hash"}
+	token, err := CreateToken(user, time.Hour)
+	assert.NoError(t, err)
+
+	claims := CustomClaims{}
+	_, err = jwt.ParseWithClaims(token, &claims, func(token *jwt.Token) (interface{}, error) {
+		return signingKey(user), nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", claims.UserName)
+	assert.True(t, claims.ExpiresAt > time.Now().Unix())
+
+	// password changed
+	_, err = jwt.ParseWithClaims(token, &CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return signingKey(&models.User{Name: "admin", Password: "hash2"}), nil
+	})
+	assert.Error(t, err)
 }
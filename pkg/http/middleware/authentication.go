@@ -19,62 +19,139 @@ package middleware
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
 	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
 
-	"github.com/lindb/lindb/config"
-	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
 )
 
 //go:generate mockgen -source=./authentication.go -destination=./authentication_mock.go -package=middleware
 
+const (
+	// bearerPrefix represents the prefix of login token(jwt) in Authorization header.
+	bearerPrefix = "Bearer "
+	// apiTokenPrefix represents the prefix of api token in Authorization header.
+	apiTokenPrefix = "Token "
+	// userKey represents the key of authenticated user in context.
+	userKey = "lindb.user"
+)
+
+// UserProvider represents the provider of user account.
+type UserProvider interface {
+	// GetUser returns user account by name.
+	GetUser(name string) (*models.User, bool)
+}
+
+// Authentication represents the authentication of http api.
 type Authentication interface {
-	// Validate validates the token
-	Validate(next http.Handler) http.Handler
+	// Validate validates the token of request, sets the user into context if valid.
+	Validate() gin.HandlerFunc
+	// Authenticate returns the user of authorization token(Bearer <jwt token> or Token <name:secret>) if valid.
+	Authenticate(token string) (*models.User, bool)
 }
 
-// userAuthentication represents user authentication using jwt
+// userAuthentication represents user authentication using jwt(login user) or api token(ingestion agent).
 type userAuthentication struct {
-	user config.User
+	users UserProvider
 }
 
 // NewAuthentication creates authentication api instance
-func NewAuthentication(user config.User) Authentication {
+func NewAuthentication(users UserProvider) Authentication {
 	return &userAuthentication{
-		user: user,
+		users: users,
 	}
 }
 
 // Validate creates middleware for user permissions validation by request header Authorization
+// 1. Authorization: Bearer <jwt token>, token of login user;
+// 2. Authorization: Token <name:secret>, api token of ingestion agent;
 // if not authorization throw error
 // else perform the next action
-func (u *userAuthentication) Validate(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		token := r.Header.Get("Authorization")
-		if len(token) > 0 {
-			claims := parseToken(token, u.user)
-			if claims.UserName == u.user.UserName && claims.Password == u.user.Password {
-				next.ServeHTTP(w, r)
-				return
-			}
+func (u *userAuthentication) Validate() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if user, ok := u.Authenticate(c.GetHeader("Authorization")); ok {
+			c.Set(userKey, user)
+			c.Next()
+			return
 		}
-		err := errors.New("authorization token invalid")
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(http.StatusUnauthorized)
-		b := encoding.JSONMarshal(err.Error())
-		_, _ = w.Write(b)
-	})
+		c.AbortWithStatusJSON(http.StatusUnauthorized, "authorization token invalid")
+	}
 }
 
-// ParseToken returns jwt claims by token
-// get secret key use Md5Encrypt method with username and password
-// then jwt parse token by secret key
-func parseToken(tokenString string, user config.User) *CustomClaims {
+// Authenticate returns the user of authorization token if valid.
+func (u *userAuthentication) Authenticate(token string) (*models.User, bool) {
+	if strings.HasPrefix(token, apiTokenPrefix) {
+		name, secret, ok := models.ParseToken(strings.TrimPrefix(token, apiTokenPrefix))
+		if !ok {
+			return nil, false
+		}
+		user, ok := u.users.GetUser(name)
+		if !ok || !user.CheckSecret(secret) {
+			return nil, false
+		}
+		return user, true
+	}
+	return u.parseToken(strings.TrimPrefix(token, bearerPrefix))
+}
+
+// parseToken returns the user of jwt token,
+// get secret key of the user in claims, then jwt parse token by secret key.
+func (u *userAuthentication) parseToken(tokenString string) (*models.User, bool) {
+	if tokenString == "" {
+		return nil, false
+	}
+	var user *models.User
 	claims := CustomClaims{}
-	cid := Md5Encrypt(user)
-	_, _ = jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
-		return cid, nil
+	token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		found, ok := u.users.GetUser(claims.UserName)
+		if !ok || found.Type != models.UserTypeUser {
+			return nil, errors.New("user not found")
+		}
+		user = found
+		return signingKey(user), nil
 	})
-	return &claims
+	if err != nil || !token.Valid {
+		return nil, false
+	}
+	return user, true
+}
+
+// GetUser returns the authenticated user of request, returns false if authentication not enabled.
+func GetUser(c *gin.Context) (*models.User, bool) {
+	val, ok := c.Get(userKey)
+	if !ok {
+		return nil, false
+	}
+	user, ok := val.(*models.User)
+	return user, ok
+}
+
+// Authorize checks if the user of request has the role on database,
+// if authentication not enabled(no user in context), always passes.
+func Authorize(c *gin.Context, database string, role models.Role) error {
+	user, ok := GetUser(c)
+	if !ok || user.HasRole(database, role) {
+		return nil
+	}
+	return fmt.Errorf("%w, user: %s hasn't %s role on database: %s",
+		constants.ErrPermissionDenied, user.Name, role, database)
+}
+
+// RequireRole returns middleware which checks if the user of request has the role on all databases.
+func RequireRole(role models.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := Authorize(c, models.AllDatabases, role); err != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, err.Error())
+			return
+		}
+		c.Next()
+	}
 }
@@ -18,54 +18,107 @@
 package middleware
 
 import (
-	"bytes"
-	"context"
-	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
-	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/models"
 )
 
-func Test_ParseToken(t *testing.T) {
-	user := config.User{UserName: "admin", Password: "admin123"}
-	claim := parseToken(tokenStr, user)
-	assert.Equal(t, user.UserName, claim.UserName)
-	assert.Equal(t, user.Password, claim.Password)
-}
-
 func TestUserAuthentication_Validate(t *testing.T) {
-	req, err := http.NewRequestWithContext(context.TODO(), "GET", "/health-check", bytes.NewReader([]byte("test")))
-	if err != nil {
-		t.Fatal(err)
-	}
-	req.Header.Set("Authorization", "Bearer abc123")
-
-	rr := httptest.NewRecorder()
-	user := config.User{UserName: "admin", Password: "admin123"}
-	auth := NewAuthentication(user)
-
-	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Header().Set("Content-Type", "application/json")
-		_, _ = io.WriteString(w, "ok")
-	})
-	authHandler := auth.Validate(handler)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	authHandler.ServeHTTP(rr, req)
+	user, err := models.NewUser("bob", "pwd")
+	assert.NoError(t, err)
+	user.Grant("db", models.RoleRead)
+	agent, apiToken, err := models.NewToken("agent")
+	assert.NoError(t, err)
+	loginToken, err := CreateToken(user, time.Hour)
+	assert.NoError(t, err)
+	expiredToken, err := CreateToken(user, -time.Hour)
+	assert.NoError(t, err)
+	agentLoginToken, err := CreateToken(agent, time.Hour)
+	assert.NoError(t, err)
+	noneToken, err := jwt.NewWithClaims(jwt.SigningMethodNone, &CustomClaims{UserName: "bob"}).
+		SignedString(jwt.UnsafeAllowNoneSignatureType)
+	assert.NoError(t, err)
 
-	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	users := NewMockUserProvider(ctrl)
+	users.EXPECT().GetUser(gomock.Any()).DoAndReturn(func(name string) (*models.User, bool) {
+		switch name {
+		case user.Name:
+			return user, true
+		case agent.Name:
+			return agent, true
+		default:
+			return nil, false
+		}
+	}).AnyTimes()
 
-	req, err = http.NewRequestWithContext(context.TODO(), "GET", "/health-check", bytes.NewReader([]byte("test")))
-	assert.NoError(t, err)
-	req.Header.Set("Authorization", tokenStr)
-	rr = httptest.NewRecorder()
+	r := gin.New()
+	r.Use(NewAuthentication(users).Validate())
+	r.GET("/read", func(c *gin.Context) {
+		if err := Authorize(c, "db", models.RoleRead); err != nil {
+			c.JSON(http.StatusForbidden, err.Error())
+			return
+		}
+		u, ok := GetUser(c)
+		assert.True(t, ok)
+		c.JSON(http.StatusOK, u.Name)
+	})
+	r.GET("/admin", RequireRole(models.RoleAdmin), func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	})
 
-	authHandler.ServeHTTP(rr, req)
+	cases := []struct {
+		name  string
+		path  string
+		token string
+		code  int
+	}{
+		{name: "no token", path: "/read", code: http.StatusUnauthorized},
+		{name: "invalid jwt token", path: "/read", token: "Bearer abc123", code: http.StatusUnauthorized},
+		{name: "expired token", path: "/read", token: "Bearer " + expiredToken, code: http.StatusUnauthorized},
+		{name: "none signing method", path: "/read", token: "Bearer " + noneToken, code: http.StatusUnauthorized},
+		{name: "api token cannot login", path: "/read", token: "Bearer " + agentLoginToken, code: http.StatusUnauthorized},
+		{name: "invalid api token", path: "/read", token: "Token agent", code: http.StatusUnauthorized},
+		{name: "api token secret not match", path: "/read", token: "Token agent:abc", code: http.StatusUnauthorized},
+		{name: "api token user not found", path: "/read", token: "Token unknown:abc", code: http.StatusUnauthorized},
+		{name: "login token", path: "/read", token: "Bearer " + loginToken, code: http.StatusOK},
+		{name: "login token without bearer", path: "/read", token: loginToken, code: http.StatusOK},
+		{name: "api token without role", path: "/read", token: "Token " + apiToken, code: http.StatusForbidden},
+		{name: "user without admin role", path: "/admin", token: "Bearer " + loginToken, code: http.StatusForbidden},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, http.NoBody)
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
 
-	assert.Equal(t, http.StatusOK, rr.Code)
-	assert.Equal(t, "ok", rr.Body.String())
+func TestAuthorize_Disabled(t *testing.T) {
+	r := gin.New()
+	r.GET("/admin", RequireRole(models.RoleAdmin), func(c *gin.Context) {
+		_, ok := GetUser(c)
+		assert.False(t, ok)
+		c.JSON(http.StatusOK, "ok")
+	})
+	req := httptest.NewRequest(http.MethodGet, "/admin", http.NoBody)
+	resp := httptest.NewRecorder()
+	r.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
 }
@@ -0,0 +1,75 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/constants"
+)
+
+// internalIssuer represents the issuer of internal token.
+const internalIssuer = "lindb-internal"
+
+// CreateInternalToken returns jwt token of internal api between nodes, which is signed by cluster internal secret
+// and expires after ttl.
+func CreateInternalToken(secret []byte, ttl time.Duration) (string, error) {
+	claims := jwt.StandardClaims{
+		ExpiresAt: time.Now().Add(ttl).Unix(),
+		Issuer:    internalIssuer,
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &claims)
+	return token.SignedString(secret)
+}
+
+// ValidateInternal returns middleware which checks the internal token of request(X-Lindb-Internal-Authorization header),
+// rejects all requests if cluster internal secret isn't available.
+func ValidateInternal(secretProvider func() []byte) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		secret := secretProvider()
+		if len(secret) == 0 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, "internal secret not available")
+			return
+		}
+		if !validInternalToken(secret, c.GetHeader(constants.InternalAuthorizationHeader)) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, "internal token invalid")
+			return
+		}
+		c.Next()
+	}
+}
+
+// validInternalToken checks if the internal token is signed by cluster internal secret and not expired.
+func validInternalToken(secret []byte, tokenString string) bool {
+	if tokenString == "" {
+		return false
+	}
+	claims := jwt.StandardClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("unexpected signing method")
+		}
+		return secret, nil
+	})
+	return err == nil && token.Valid && claims.Issuer == internalIssuer
+}
@@ -0,0 +1,81 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+)
+
+func TestValidateInternal(t *testing.T) {
+	secret := []byte("secret")
+	token, err := CreateInternalToken(secret, time.Minute)
+	assert.NoError(t, err)
+	expiredToken, err := CreateInternalToken(secret, -time.Minute)
+	assert.NoError(t, err)
+	otherToken, err := CreateInternalToken([]byte("other"), time.Minute)
+	assert.NoError(t, err)
+	user, err := models.NewUser("bob", "pwd")
+	assert.NoError(t, err)
+	userToken, err := CreateToken(user, time.Minute)
+	assert.NoError(t, err)
+	noneToken, err := jwt.NewWithClaims(jwt.SigningMethodNone, &jwt.StandardClaims{Issuer: internalIssuer}).
+		SignedString(jwt.UnsafeAllowNoneSignatureType)
+	assert.NoError(t, err)
+
+	cases := []struct {
+		name   string
+		secret []byte
+		token  string
+		code   int
+	}{
+		{name: "secret not available", token: token, code: http.StatusUnauthorized},
+		{name: "no token", secret: secret, code: http.StatusUnauthorized},
+		{name: "invalid token", secret: secret, token: "abc123", code: http.StatusUnauthorized},
+		{name: "expired token", secret: secret, token: expiredToken, code: http.StatusUnauthorized},
+		{name: "token signed by other secret", secret: secret, token: otherToken, code: http.StatusUnauthorized},
+		{name: "user token", secret: []byte("any"), token: userToken, code: http.StatusUnauthorized},
+		{name: "none signing method", secret: secret, token: noneToken, code: http.StatusUnauthorized},
+		{name: "valid token", secret: secret, token: token, code: http.StatusOK},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.GET("/internal", ValidateInternal(func() []byte { return tt.secret }), func(c *gin.Context) {
+				c.JSON(http.StatusOK, "ok")
+			})
+			req := httptest.NewRequest(http.MethodGet, "/internal", http.NoBody)
+			if tt.token != "" {
+				req.Header.Set(constants.InternalAuthorizationHeader, tt.token)
+			}
+			resp := httptest.NewRecorder()
+			r.ServeHTTP(resp, req)
+			assert.Equal(t, tt.code, resp.Code)
+		})
+	}
+}
@@ -22,6 +22,8 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+
+	"github.com/lindb/lindb/constants"
 )
 
 // OK responses with content and set the http status code 200.
@@ -40,9 +42,30 @@ func NotFound(c *gin.Context) {
 	response(c, http.StatusNotFound, nil)
 }
 
-// Error responses error message and set the http status code 500.
+// Unauthorized responses error message and set the http status code 401.
+func Unauthorized(c *gin.Context, err error) {
+	_ = c.Error(err)
+	response(c, http.StatusUnauthorized, err.Error())
+}
+
+// Error responses error message and set the http status code 500,
+// if user has no privilege, set the http status code 403,
+// if server is busy, set the http status code 503,
+// if series quota exceeded, set the http status code 429.
 func Error(c *gin.Context, err error) {
 	_ = c.Error(err)
+	if errors.Is(err, constants.ErrPermissionDenied) {
+		response(c, http.StatusForbidden, err.Error())
+		return
+	}
+	if errors.Is(err, constants.ErrServiceBusy) {
+		response(c, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	if errors.Is(err, constants.ErrSeriesQuotaExceeded) {
+		response(c, http.StatusTooManyRequests, err.Error())
+		return
+	}
 	response(c, http.StatusInternalServerError, err.Error())
 }
 
@@ -25,6 +25,9 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
 )
 
 func TestOK(t *testing.T) {
@@ -51,6 +54,14 @@ func TestNotFound(t *testing.T) {
 	assert.Equal(t, 4, resp.Body.Len())
 }
 
+func TestUnauthorized(t *testing.T) {
+	resp := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(resp)
+	Unauthorized(c, fmt.Errorf("err"))
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Equal(t, `"err"`, resp.Body.String())
+}
+
 func TestError(t *testing.T) {
 	resp := httptest.NewRecorder()
 	c, _ := gin.CreateTestContext(resp)
@@ -58,3 +69,27 @@ func TestError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
 	assert.Equal(t, `"err"`, resp.Body.String())
 }
+
+func TestError_PermissionDenied(t *testing.T) {
+	resp := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(resp)
+	Error(c, fmt.Errorf("%w, no privilege", constants.ErrPermissionDenied))
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	assert.Equal(t, `"permission denied, no privilege"`, resp.Body.String())
+}
+
+func TestError_ServiceBusy(t *testing.T) {
+	resp := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(resp)
+	Error(c, fmt.Errorf("write spool is full, %w", constants.ErrServiceBusy))
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, `"write spool is full, service busy, retry later"`, resp.Body.String())
+}
+
+func TestError_SeriesQuotaExceeded(t *testing.T) {
+	resp := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(resp)
+	Error(c, fmt.Errorf("%w, rejected rows: 1",
+		&models.SeriesQuotaError{Scope: models.MetricQuotaScope, Namespace: "ns", Name: "cpu", Quota: 1, Usage: 1}))
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+}
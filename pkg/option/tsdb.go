@@ -62,6 +62,36 @@ type FlusherOption struct {
 	SizeThreshold int64 `toml:"sizeThreshold" json:"sizeThreshold"` // size level flush threshold, unit(MB)
 }
 
+// TieringOption represents the tiering policy which moves old segments into cold storage.
+type TieringOption struct {
+	// segments older than cold after(like 7d) move to cold storage, empty means disable tiering.
+	ColdAfter string `toml:"coldAfter" json:"coldAfter,omitempty"`
+}
+
+// ShardSeriesQuotaOption represents the series quotas of each shard, 0 means unlimited.
+// Quotas are enforced on each shard independently when series created,
+// so the series of database/namespace/metric can reach quota * the number of shards.
+type ShardSeriesQuotaOption struct {
+	MaxSeries          uint32 `toml:"maxSeries" json:"maxSeries,omitempty"`                   // max series of shard
+	MaxNamespaceSeries uint32 `toml:"maxNamespaceSeries" json:"maxNamespaceSeries,omitempty"` // max series of each namespace in shard
+	MaxMetricSeries    uint32 `toml:"maxMetricSeries" json:"maxMetricSeries,omitempty"`       // max series of each metric in shard
+	// max series of given metric(metric name => quota), overrides max metric series
+	Metrics map[string]uint32 `toml:"metrics" json:"metrics,omitempty"`
+}
+
+// Enabled returns if any series quota is set.
+func (q *ShardSeriesQuotaOption) Enabled() bool {
+	return q.MaxSeries > 0 || q.MaxNamespaceSeries > 0 || q.MaxMetricSeries > 0 || len(q.Metrics) > 0
+}
+
+// MetricQuota returns the series quota of given metric, 0 means unlimited.
+func (q *ShardSeriesQuotaOption) MetricQuota(metricName string) uint32 {
+	if quota, ok := q.Metrics[metricName]; ok {
+		return quota
+	}
+	return q.MaxMetricSeries
+}
+
 // DatabaseOption represents a database option include shard ids and shard's option
 type DatabaseOption struct {
 	// write interval(the number of second) => TTL
@@ -77,6 +107,14 @@ type DatabaseOption struct {
 	Index FlusherOption `toml:"index" json:"index,omitempty"` // index flusher option
 	Data  FlusherOption `toml:"data" json:"data,omitempty"`   // data flusher data
 
+	Tiering TieringOption `toml:"tiering" json:"tiering,omitempty"` // tiering policy of segments
+
+	ShardSeriesQuota ShardSeriesQuotaOption `toml:"shardSeriesQuota" json:"shardSeriesQuota,omitempty"` // series quotas of each shard
+
+	// compression codec(none/snappy/zstd/lz4) of each value in kv sst files, each value is compressed independently,
+	// only applies to the kv families created after it set, empty means no compression.
+	ValueCompression string `toml:"valueCompression" json:"valueCompression,omitempty"`
+
 	ahead, behind int64
 }
 
@@ -115,9 +153,79 @@ func (e *DatabaseOption) Validate() error {
 	if err := validateInterval(e.Behind, false); err != nil {
 		return err
 	}
+	if err := validateInterval(e.Tiering.ColdAfter, false); err != nil {
+		return err
+	}
+	if e.Tiering.ColdAfter != "" && e.GetColdAfter() < timeutil.OneDay {
+		// make sure segment not written/rolled up after moving into cold storage
+		return fmt.Errorf("cold after of tiering must be greater than or equal to 1d")
+	}
+	switch e.ValueCompression {
+	case "", "none", "snappy", "zstd", "lz4":
+	default:
+		return fmt.Errorf("unknown value compression: %s", e.ValueCompression)
+	}
 	return nil
 }
 
+// GetColdAfter returns the age of segment which need move to cold storage, 0 means disable tiering.
+func (e *DatabaseOption) GetColdAfter() int64 {
+	return e.getIntervalVal(e.Tiering.ColdAfter)
+}
+
+// ValidateUpdate validates if the option can be updated to target option online,
+// the writable(smallest) interval cannot be changed and the existing intervals cannot be removed.
+func (e *DatabaseOption) ValidateUpdate(target *DatabaseOption) error {
+	if err := target.Validate(); err != nil {
+		return err
+	}
+	intervalTypes := make(map[timeutil.IntervalType]struct{})
+	for _, interval := range target.Intervals {
+		intervalType := interval.Interval.Type()
+		if _, ok := intervalTypes[intervalType]; ok {
+			return fmt.Errorf("interval type %s is duplicated", intervalType)
+		}
+		intervalTypes[intervalType] = struct{}{}
+	}
+	current := e.Clone().Intervals
+	targetIntervals := target.Clone().Intervals
+	sort.Sort(current)
+	sort.Sort(targetIntervals)
+	if len(current) > 0 && current[0].Interval != targetIntervals[0].Interval {
+		return fmt.Errorf("writable interval cannot be changed, current: %s, target: %s",
+			current[0].Interval, targetIntervals[0].Interval)
+	}
+	for _, interval := range current {
+		found := false
+		for _, targetInterval := range targetIntervals {
+			if interval.Interval == targetInterval.Interval {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("interval %s cannot be removed", interval.Interval)
+		}
+	}
+	return nil
+}
+
+// Clone returns a copy of database option.
+func (e *DatabaseOption) Clone() *DatabaseOption {
+	opt := *e
+	opt.Intervals = make(Intervals, len(e.Intervals))
+	copy(opt.Intervals, e.Intervals)
+	if e.ShardSeriesQuota.Metrics != nil {
+		opt.ShardSeriesQuota.Metrics = make(map[string]uint32, len(e.ShardSeriesQuota.Metrics))
+		for metricName, quota := range e.ShardSeriesQuota.Metrics {
+			opt.ShardSeriesQuota.Metrics[metricName] = quota
+		}
+	}
+	// reset cached writable range, because ahead/behind maybe changed
+	opt.ahead, opt.behind = 0, 0
+	return &opt
+}
+
 // GetAcceptWritableRange returns accept writable time range.
 func (e *DatabaseOption) GetAcceptWritableRange() (ahead, behind int64) {
 	if e.ahead <= 0 {
@@ -53,11 +53,36 @@ func TestDatabaseOption_Validate(t *testing.T) {
 			DatabaseOption{Intervals: Intervals{{}}, Behind: "0h"},
 			true,
 		},
+		{
+			"cold after invalid",
+			DatabaseOption{Intervals: Intervals{{}}, Tiering: TieringOption{ColdAfter: "aa"}},
+			true,
+		},
+		{
+			"cold after too small",
+			DatabaseOption{Intervals: Intervals{{}}, Tiering: TieringOption{ColdAfter: "1h"}},
+			true,
+		},
+		{
+			"value compression invalid",
+			DatabaseOption{Intervals: Intervals{{}}, ValueCompression: "lz"},
+			true,
+		},
+		{
+			"validation pass with value compression",
+			DatabaseOption{Intervals: Intervals{{}}, ValueCompression: "lz4"},
+			false,
+		},
 		{
 			"validation pass",
 			DatabaseOption{Intervals: Intervals{{}}, Behind: "1h", Ahead: "1h"},
 			false,
 		},
+		{
+			"validation pass with tiering",
+			DatabaseOption{Intervals: Intervals{{}}, Tiering: TieringOption{ColdAfter: "7d"}},
+			false,
+		},
 	}
 
 	for _, tt := range cases {
@@ -156,3 +181,103 @@ func TestDatabaseOption_FindMatchSmallestInterval(t *testing.T) {
 	interval := opt.FindMatchSmallestInterval(timeutil.Interval(timeutil.OneMinute * 3))
 	assert.Equal(t, timeutil.Interval(timeutil.OneMinute), interval)
 }
+
+func TestDatabaseOption_ValidateUpdate(t *testing.T) {
+	current := &DatabaseOption{Intervals: Intervals{
+		{timeutil.Interval(5 * timeutil.OneMinute), timeutil.Interval(timeutil.OneMonth)},
+		{timeutil.Interval(10 * timeutil.OneSecond), timeutil.Interval(timeutil.OneMonth)},
+	}}
+	cases := []struct {
+		name    string
+		target  *DatabaseOption
+		wantErr bool
+	}{
+		{
+			name:    "target option invalid",
+			target:  &DatabaseOption{},
+			wantErr: true,
+		},
+		{
+			name: "interval type duplicated",
+			target: &DatabaseOption{Intervals: Intervals{
+				{timeutil.Interval(10 * timeutil.OneSecond), timeutil.Interval(timeutil.OneMonth)},
+				{timeutil.Interval(5 * timeutil.OneMinute), timeutil.Interval(timeutil.OneMonth)},
+				{timeutil.Interval(10 * timeutil.OneMinute), timeutil.Interval(timeutil.OneMonth)},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "writable interval changed",
+			target: &DatabaseOption{Intervals: Intervals{
+				{timeutil.Interval(5 * timeutil.OneMinute), timeutil.Interval(timeutil.OneMonth)},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "interval removed",
+			target: &DatabaseOption{Intervals: Intervals{
+				{timeutil.Interval(10 * timeutil.OneSecond), timeutil.Interval(timeutil.OneMonth)},
+				{timeutil.Interval(timeutil.OneHour), timeutil.Interval(timeutil.OneYear)},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "change retention and add rollup interval",
+			target: &DatabaseOption{Intervals: Intervals{
+				{timeutil.Interval(timeutil.OneHour), timeutil.Interval(timeutil.OneYear)},
+				{timeutil.Interval(10 * timeutil.OneSecond), timeutil.Interval(timeutil.OneDay)},
+				{timeutil.Interval(5 * timeutil.OneMinute), timeutil.Interval(timeutil.OneMonth)},
+			}, Ahead: "2h"},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if err := current.ValidateUpdate(tt.target); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	// current option not changed
+	assert.Equal(t, timeutil.Interval(5*timeutil.OneMinute), current.Intervals[0].Interval)
+}
+
+func TestDatabaseOption_Clone(t *testing.T) {
+	opt := &DatabaseOption{
+		Intervals: Intervals{{timeutil.Interval(timeutil.OneSecond), timeutil.Interval(timeutil.OneMonth)}},
+		Ahead:     "1h",
+		ShardSeriesQuota: ShardSeriesQuotaOption{
+			Metrics: map[string]uint32{"cpu": 10},
+		},
+	}
+	ahead, _ := opt.GetAcceptWritableRange()
+	assert.Equal(t, timeutil.OneHour, ahead)
+	clone := opt.Clone()
+	clone.Intervals[0].Retention = timeutil.Interval(timeutil.OneDay)
+	clone.Ahead = "2h"
+	ahead, _ = clone.GetAcceptWritableRange()
+	assert.Equal(t, 2*timeutil.OneHour, ahead)
+	assert.Equal(t, timeutil.Interval(timeutil.OneMonth), opt.Intervals[0].Retention)
+	clone.ShardSeriesQuota.Metrics["cpu"] = 20
+	assert.Equal(t, uint32(10), opt.ShardSeriesQuota.Metrics["cpu"])
+}
+
+func TestSeriesQuotaOption(t *testing.T) {
+	quota := &ShardSeriesQuotaOption{}
+	assert.False(t, quota.Enabled())
+	assert.Equal(t, uint32(0), quota.MetricQuota("cpu"))
+	quota.MaxMetricSeries = 100
+	assert.True(t, quota.Enabled())
+	assert.Equal(t, uint32(100), quota.MetricQuota("cpu"))
+	quota = &ShardSeriesQuotaOption{Metrics: map[string]uint32{"cpu": 10}}
+	assert.True(t, quota.Enabled())
+	assert.Equal(t, uint32(10), quota.MetricQuota("cpu"))
+	assert.Equal(t, uint32(0), quota.MetricQuota("memory"))
+}
+
+func TestDatabaseOption_GetColdAfter(t *testing.T) {
+	opt := &DatabaseOption{}
+	assert.Equal(t, int64(0), opt.GetColdAfter())
+	opt.Tiering.ColdAfter = "7d"
+	assert.Equal(t, 7*timeutil.OneDay, opt.GetColdAfter())
+}
@@ -0,0 +1,66 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+
+	"github.com/lindb/lindb/constants"
+)
+
+// internalSecretLength represents the length of random bytes of cluster internal secret.
+const internalSecretLength = 32
+
+// errSecretExist represents the secret has been created by other node.
+var errSecretExist = errors.New("internal secret exist")
+
+// for testing
+var (
+	randReadFn = rand.Read
+)
+
+// LoadOrCreateInternalSecret returns the secret of cluster which signs the token of internal api between nodes,
+// creates a random secret if not exist, all nodes of cluster share the secret which created first.
+func LoadOrCreateInternalSecret(ctx context.Context, repo Repository) ([]byte, error) {
+	secret, err := repo.Get(ctx, constants.InternalSecretPath)
+	if err == nil {
+		return secret, nil
+	}
+	if !errors.Is(err, ErrNotExist) {
+		return nil, err
+	}
+	buf := make([]byte, internalSecretLength)
+	if _, err = randReadFn(buf); err != nil {
+		return nil, err
+	}
+	secret = []byte(hex.EncodeToString(buf))
+	ok, err := repo.PutWithTX(ctx, constants.InternalSecretPath, secret, func(_ []byte) error {
+		return errSecretExist
+	})
+	if ok {
+		return secret, nil
+	}
+	if err != nil && !errors.Is(err, errSecretExist) {
+		return nil, err
+	}
+	// secret created by other node concurrently
+	return repo.Get(ctx, constants.InternalSecretPath)
+}
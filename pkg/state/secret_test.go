@@ -0,0 +1,115 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package state
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+)
+
+func TestLoadOrCreateInternalSecret(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		randReadFn = rand.Read
+		ctrl.Finish()
+	}()
+	repo := NewMockRepository(ctrl)
+
+	cases := []struct {
+		name    string
+		prepare func()
+		secret  string
+		wantErr bool
+	}{
+		{
+			name: "secret exist",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), constants.InternalSecretPath).Return([]byte("secret"), nil)
+			},
+			secret: "secret",
+		},
+		{
+			name: "get secret failure",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "generate secret failure",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, ErrNotExist)
+				randReadFn = func(_ []byte) (int, error) {
+					return 0, fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "put secret failure",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, ErrNotExist)
+				repo.EXPECT().PutWithTX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "create secret successfully",
+			prepare: func() {
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, ErrNotExist)
+				repo.EXPECT().PutWithTX(gomock.Any(), constants.InternalSecretPath, gomock.Any(), gomock.Any()).Return(true, nil)
+			},
+			secret: "0000000000000000000000000000000000000000000000000000000000000000",
+		},
+		{
+			name: "secret created by other node",
+			prepare: func() {
+				gomock.InOrder(
+					repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, ErrNotExist),
+					repo.EXPECT().PutWithTX(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, _ string, _ []byte, check func(oldVal []byte) error) (bool, error) {
+							return false, check([]byte("other"))
+						}),
+					repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("other"), nil),
+				)
+			},
+			secret: "other",
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			randReadFn = func(b []byte) (int, error) {
+				return len(b), nil
+			}
+			tt.prepare()
+			secret, err := LoadOrCreateInternalSecret(context.TODO(), repo)
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+			assert.Equal(t, tt.secret, string(secret))
+		})
+	}
+}
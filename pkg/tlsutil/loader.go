@@ -0,0 +1,216 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/pkg/logger"
+)
+
+// for testing
+var (
+	// reloadCheckInterval represents the min interval of checking if cert/key/ca files changed.
+	reloadCheckInterval = 10 * time.Second
+	readFileFn          = os.ReadFile
+)
+
+// Loader loads cert/key/ca files of tls configuration, reloads files when changed(checked when handshaking),
+// so that the certs can be rotated without restarting the process.
+// If reloading failure, keeps using the old cert/ca.
+type Loader struct {
+	cfg config.TLS
+
+	cert      *tls.Certificate
+	caPool    *x509.CertPool
+	modTimes  map[string]time.Time
+	lastCheck time.Time
+	mutex     sync.RWMutex
+
+	logger *logger.Logger
+}
+
+// NewLoader creates the tls loader, returns error if load cert/key/ca files failure.
+func NewLoader(cfg config.TLS) (*Loader, error) {
+	l := &Loader{
+		cfg:    cfg,
+		logger: logger.GetLogger("TLS", "Loader"),
+	}
+	modTimes, err := l.getModTimes()
+	if err != nil {
+		return nil, err
+	}
+	if err := l.load(modTimes); err != nil {
+		return nil, err
+	}
+	l.lastCheck = time.Now()
+	return l, nil
+}
+
+// ServerConfig returns the tls config of server, next protos is the ALPN protocols supported by server.
+func (l *Loader) ServerConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// build config when handshaking, so that latest cert/ca is used
+		GetConfigForClient: func(_ *tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, caPool := l.get()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				ClientAuth:   l.cfg.GetClientAuth(),
+				ClientCAs:    caPool,
+				NextProtos:   nextProtos,
+			}, nil
+		},
+	}
+}
+
+// ClientConfig returns the tls config of client, the cert is used as client cert when server requests.
+func (l *Loader) ClientConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: l.cfg.ServerName,
+		// verifies server cert by VerifyConnection with the latest ca, because RootCAs cannot be changed.
+		InsecureSkipVerify: true, //nolint:gosec
+		VerifyConnection:   l.verifyConnection,
+		GetClientCertificate: func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := l.get()
+			return cert, nil
+		},
+	}
+}
+
+// verifyConnection verifies the cert chain and host name of server.
+func (l *Loader) verifyConnection(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("tls: server doesn't provide certificate")
+	}
+	_, caPool := l.get()
+	opts := x509.VerifyOptions{
+		DNSName:       cs.ServerName,
+		Roots:         caPool, // using system roots if nil
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
+// get returns the current cert and ca pool, reloads them if files changed.
+func (l *Loader) get() (*tls.Certificate, *x509.CertPool) {
+	l.reloadIfChanged()
+
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	return l.cert, l.caPool
+}
+
+// reloadIfChanged reloads cert/key/ca files if the modification time of files changed.
+func (l *Loader) reloadIfChanged() {
+	now := time.Now()
+	l.mutex.RLock()
+	needCheck := now.Sub(l.lastCheck) >= reloadCheckInterval
+	l.mutex.RUnlock()
+	if !needCheck {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if now.Sub(l.lastCheck) < reloadCheckInterval {
+		// double check, other goroutine checked
+		return
+	}
+	l.lastCheck = now
+	modTimes, err := l.getModTimes()
+	if err != nil {
+		l.logger.Warn("check tls files failure, keep using old cert", logger.Error(err))
+		return
+	}
+	changed := false
+	for file, modTime := range modTimes {
+		if !l.modTimes[file].Equal(modTime) {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return
+	}
+	if err := l.load(modTimes); err != nil {
+		l.logger.Warn("reload tls files failure, keep using old cert", logger.Error(err))
+		return
+	}
+	l.logger.Info("reload tls files successfully", logger.String("cert", l.cfg.CertFile))
+}
+
+// load loads cert/key/ca files, need hold lock if called after loader created.
+func (l *Loader) load(modTimes map[string]time.Time) error {
+	certPEM, err := readFileFn(l.cfg.CertFile)
+	if err != nil {
+		return err
+	}
+	keyPEM, err := readFileFn(l.cfg.KeyFile)
+	if err != nil {
+		return err
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return err
+	}
+	var caPool *x509.CertPool
+	if l.cfg.CAFile != "" {
+		caPEM, err := readFileFn(l.cfg.CAFile)
+		if err != nil {
+			return err
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caPEM) {
+			return fmt.Errorf("no valid ca cert found in file: %s", l.cfg.CAFile)
+		}
+	}
+	l.cert = &cert
+	l.caPool = caPool
+	l.modTimes = modTimes
+	return nil
+}
+
+// getModTimes returns the modification time of cert/key/ca files.
+func (l *Loader) getModTimes() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time)
+	for _, file := range []string{l.cfg.CertFile, l.cfg.KeyFile, l.cfg.CAFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[file] = info.ModTime()
+	}
+	return modTimes, nil
+}
@@ -0,0 +1,196 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/mock"
+)
+
+func TestNewLoader(t *testing.T) {
+	dir := t.TempDir()
+	files := mock.GenerateTLSFiles(t, dir)
+	cases := []struct {
+		name    string
+		cfg     config.TLS
+		wantErr bool
+	}{
+		{
+			name:    "cert file not exist",
+			cfg:     config.TLS{CertFile: filepath.Join(dir, "not-exist"), KeyFile: files.KeyFile},
+			wantErr: true,
+		},
+		{
+			name:    "key invalid",
+			cfg:     config.TLS{CertFile: files.CertFile, KeyFile: files.CAFile},
+			wantErr: true,
+		},
+		{
+			name:    "ca invalid",
+			cfg:     config.TLS{CertFile: files.CertFile, KeyFile: files.KeyFile, CAFile: files.KeyFile},
+			wantErr: true,
+		},
+		{
+			name: "load successfully",
+			cfg:  config.TLS{CertFile: files.CertFile, KeyFile: files.KeyFile, CAFile: files.CAFile},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := NewLoader(tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, l)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, l)
+			}
+		})
+	}
+}
+
+func TestLoader_ReadFailure(t *testing.T) {
+	defer func() {
+		readFileFn = os.ReadFile
+	}()
+	files := mock.GenerateTLSFiles(t, t.TempDir())
+	cfg := config.TLS{CertFile: files.CertFile, KeyFile: files.KeyFile, CAFile: files.CAFile}
+	for i := 1; i <= 3; i++ {
+		count := 0
+		failAt := i
+		readFileFn = func(name string) ([]byte, error) {
+			count++
+			if count == failAt {
+				return nil, fmt.Errorf("err")
+			}
+			return os.ReadFile(name)
+		}
+		_, err := NewLoader(cfg)
+		assert.Error(t, err)
+	}
+}
+
+func TestLoader_MutualTLS(t *testing.T) {
+	defer func() {
+		reloadCheckInterval = 10 * time.Second
+	}()
+	dir := t.TempDir()
+	files := mock.GenerateTLSFiles(t, dir)
+	cfg := config.TLS{
+		Enabled:    true,
+		CertFile:   files.CertFile,
+		KeyFile:    files.KeyFile,
+		CAFile:     files.CAFile,
+		ClientAuth: config.ClientAuthRequireAndVerify,
+	}
+	l, err := NewLoader(cfg)
+	assert.NoError(t, err)
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", l.ServerConfig("h2"))
+	assert.NoError(t, err)
+	defer func() {
+		_ = lis.Close()
+	}()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				_, _ = conn.Write([]byte("ok"))
+				_ = conn.Close()
+			}(conn)
+		}
+	}()
+	handshake := func(clientCfg *tls.Config) (*x509.Certificate, error) {
+		conn, err := tls.Dial("tcp", lis.Addr().String(), clientCfg)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = conn.Close()
+		}()
+		// client cert is verified by server after client handshake completed, read data to make sure.
+		if _, err := io.ReadAll(conn); err != nil {
+			return nil, err
+		}
+		return conn.ConnectionState().PeerCertificates[0], nil
+	}
+	clientCfg := l.ClientConfig()
+	oldCert, err := handshake(clientCfg)
+	assert.NoError(t, err)
+
+	// client without cert is rejected
+	noCertCfg := clientCfg.Clone()
+	noCertCfg.GetClientCertificate = nil
+	_, err = handshake(noCertCfg)
+	assert.Error(t, err)
+
+	// server name not match
+	serverNameCfg := clientCfg.Clone()
+	serverNameCfg.ServerName = "lindb.io"
+	_, err = handshake(serverNameCfg)
+	assert.Error(t, err)
+
+	// server cert not trusted by client(client uses other ca)
+	otherFiles := mock.GenerateTLSFiles(t, t.TempDir())
+	otherLoader, err := NewLoader(config.TLS{CertFile: otherFiles.CertFile, KeyFile: otherFiles.KeyFile, CAFile: otherFiles.CAFile})
+	assert.NoError(t, err)
+	_, err = handshake(otherLoader.ClientConfig())
+	assert.Error(t, err)
+
+	// rotate cert/ca, reload without restarting
+	reloadCheckInterval = 0
+	time.Sleep(10 * time.Millisecond)
+	mock.GenerateTLSFiles(t, dir)
+	newCert, err := handshake(clientCfg)
+	assert.NoError(t, err)
+	assert.NotEqual(t, oldCert.SerialNumber, newCert.SerialNumber)
+
+	// reload failure, keep using old cert
+	assert.NoError(t, os.WriteFile(files.CertFile, []byte("abc"), 0600))
+	cert, err := handshake(clientCfg)
+	assert.NoError(t, err)
+	assert.Equal(t, newCert.SerialNumber, cert.SerialNumber)
+	// file removed, keep using old cert
+	assert.NoError(t, os.Remove(files.CAFile))
+	cert, err = handshake(clientCfg)
+	assert.NoError(t, err)
+	assert.Equal(t, newCert.SerialNumber, cert.SerialNumber)
+}
+
+func TestLoader_VerifyConnection(t *testing.T) {
+	files := mock.GenerateTLSFiles(t, t.TempDir())
+	l, err := NewLoader(config.TLS{CertFile: files.CertFile, KeyFile: files.KeyFile})
+	assert.NoError(t, err)
+	assert.Error(t, l.verifyConnection(tls.ConnectionState{}))
+}
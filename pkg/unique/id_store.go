@@ -47,8 +47,13 @@ type IDStore interface {
 	Delete(key []byte) error
 	// IterKeys iterates the key list by given prefix, returns the key list.
 	IterKeys(prefix []byte, limit int) (rs [][]byte, err error)
+	// WalkKeys walks the keys by given prefix, stops walking if fn returns false.
+	// NOTICE: the key is only valid until fn returns.
+	WalkKeys(prefix []byte, fn func(key []byte) bool) error
 	// Flush flushes the memory table data under pebble db.
 	Flush() error
+	// Checkpoint creates a point-in-time snapshot of store into given dir, the dir cannot exist.
+	Checkpoint(dir string) error
 }
 
 // idStore implements IDStore interface.
@@ -114,6 +119,25 @@ func (s *idStore) Delete(key []byte) error {
 
 // IterKeys iterates the key list by given prefix, returns the key list.
 func (s *idStore) IterKeys(prefix []byte, limit int) (rs [][]byte, err error) {
+	if err := s.WalkKeys(prefix, func(key []byte) bool {
+		if len(rs) >= limit {
+			return false
+		}
+		// copy it
+		dst := make([]byte, len(key))
+		copy(dst, key)
+		rs = append(rs, dst)
+		return true
+	}); err != nil {
+		// if iterator has err, returns nil result and err
+		return nil, err
+	}
+	return rs, nil
+}
+
+// WalkKeys walks the keys by given prefix, stops walking if fn returns false.
+// NOTICE: the key is only valid until fn returns.
+func (s *idStore) WalkKeys(prefix []byte, fn func(key []byte) bool) error {
 	it := s.db.NewIter(&pebble.IterOptions{
 		LowerBound: prefix,
 	})
@@ -126,24 +150,15 @@ func (s *idStore) IterKeys(prefix []byte, limit int) (rs [][]byte, err error) {
 	}()
 
 	for it.First(); it.Valid(); it.Next() {
-		if len(rs) >= limit {
-			break
-		}
 		key := it.Key()
 		if !bytes.HasPrefix(key, prefix) {
 			break
 		}
-		// copy it
-		dst := make([]byte, len(key))
-		copy(dst, key)
-		rs = append(rs, dst)
-	}
-
-	// if iterator has err, returns nil result and err
-	if err := it.Error(); err != nil {
-		return nil, err
+		if !fn(key) {
+			break
+		}
 	}
-	return rs, nil
+	return it.Error()
 }
 
 // Flush flushes the memory table data under pebble db.
@@ -151,6 +166,15 @@ func (s *idStore) Flush() error {
 	return s.db.Flush()
 }
 
+// Checkpoint creates a point-in-time snapshot of store into given dir, the dir cannot exist.
+func (s *idStore) Checkpoint(dir string) error {
+	// wal of pebble db is disabled, need flush memory table first
+	if err := s.db.Flush(); err != nil {
+		return err
+	}
+	return s.db.Checkpoint(dir, pebble.WithFlushedWAL())
+}
+
 // Close closes backend pebble db.
 // NOTICE: need flush first
 func (s *idStore) Close() error {
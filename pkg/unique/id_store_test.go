@@ -19,6 +19,7 @@ package unique
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/cockroachdb/pebble"
@@ -215,6 +216,29 @@ func TestIdStore_IterIDKeys(t *testing.T) {
 	}
 }
 
+func TestIDStore_WalkKeys(t *testing.T) {
+	store, err := NewIDStore(t.TempDir())
+	assert.NoError(t, err)
+	defer func() {
+		_ = store.Close()
+	}()
+	mock(t, store)
+
+	count := 0
+	assert.NoError(t, store.WalkKeys([]byte("ns"), func(key []byte) bool {
+		count++
+		return true
+	}))
+	assert.Equal(t, 10, count)
+	// stop walking
+	count = 0
+	assert.NoError(t, store.WalkKeys(nil, func(key []byte) bool {
+		count++
+		return count < 3
+	}))
+	assert.Equal(t, 3, count)
+}
+
 func mock(t *testing.T, store IDStore) {
 	for i := 0; i < 10; i++ {
 		key := fmt.Sprintf("ns-%d", i)
@@ -241,3 +265,23 @@ func TestPebble_Reopen(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, db2)
 }
+
+func TestIDStore_Checkpoint(t *testing.T) {
+	p := t.TempDir()
+	store, err := NewIDStore(filepath.Join(p, "db"))
+	assert.NoError(t, err)
+	assert.NoError(t, store.Put([]byte("key"), []byte("value")))
+	// case 1: checkpoint successfully
+	assert.NoError(t, store.Checkpoint(filepath.Join(p, "checkpoint")))
+	// case 2: dir exist
+	assert.Error(t, store.Checkpoint(filepath.Join(p, "checkpoint")))
+	assert.NoError(t, store.Close())
+
+	store, err = NewIDStore(filepath.Join(p, "checkpoint"))
+	assert.NoError(t, err)
+	val, ok, err := store.Get([]byte("key"))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), val)
+	assert.NoError(t, store.Close())
+}
@@ -76,16 +76,19 @@ type RequestType int32
 const (
 	RequestType_Data     RequestType = 0
 	RequestType_Metadata RequestType = 1
+	RequestType_Cancel   RequestType = 2
 )
 
 var RequestType_name = map[int32]string{
 	0: "Data",
 	1: "Metadata",
+	2: "Cancel",
 }
 
 var RequestType_value = map[string]int32{
 	"Data":     0,
 	"Metadata": 1,
+	"Cancel":   2,
 }
 
 func (x RequestType) String() string {
@@ -465,44 +468,44 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
+	// 584 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0x26, 0x69, 0x9a, 0x8c, 0x9d, 0xc8, 0x5a, 0x21, 0x30, 0xa1, 0x44, 0x91, 0xa5, 0x4a,
-	0x56, 0x91, 0x22, 0x68, 0x2f, 0x80, 0xe0, 0x50, 0x5a, 0x3e, 0x2a, 0xda, 0x80, 0xb6, 0xa1, 0x9c,
+	0x10, 0xcd, 0x26, 0xa9, 0x9b, 0x4c, 0x9c, 0xc8, 0x5a, 0x21, 0x30, 0xa1, 0x44, 0x91, 0x25, 0x24,
+	0xab, 0x48, 0x11, 0x6d, 0x2f, 0x80, 0xe0, 0x50, 0x5a, 0x3e, 0x2a, 0xda, 0x80, 0xb6, 0xa1, 0x9c,
 	0x17, 0x7b, 0x6a, 0xac, 0xfa, 0x0b, 0xef, 0xb6, 0x92, 0xff, 0x49, 0xc5, 0x2f, 0xe2, 0xc8, 0x85,
 	0x3b, 0x2a, 0x7f, 0x82, 0x23, 0xda, 0xb5, 0x93, 0xd4, 0x51, 0x2b, 0x71, 0xf2, 0xce, 0xdb, 0xf7,
 	0x66, 0xf6, 0x8d, 0x67, 0xc0, 0xf4, 0xd2, 0x38, 0x4e, 0x93, 0x49, 0x96, 0xa7, 0x32, 0xa5, 0x7d,
-	0xfd, 0xd9, 0xd3, 0xd0, 0xc9, 0x13, 0xe7, 0x2f, 0x01, 0x63, 0xc6, 0xc5, 0x19, 0xc3, 0x6f, 0xe7,
-	0x28, 0x24, 0xdd, 0x80, 0x5e, 0x5e, 0x1e, 0x0f, 0xf6, 0x6d, 0x32, 0x26, 0x6e, 0x8f, 0x2d, 0x01,
+	0xfd, 0xd9, 0xd3, 0xd0, 0xc9, 0x96, 0xf3, 0x97, 0x40, 0x6f, 0xc6, 0xc5, 0x19, 0xc3, 0x6f, 0xe7,
+	0x28, 0x24, 0xdd, 0x80, 0x6e, 0x5e, 0x1e, 0x0f, 0xf6, 0x6d, 0x32, 0x26, 0x6e, 0x97, 0x2d, 0x01,
 	0xea, 0x80, 0x99, 0xf1, 0x1c, 0x13, 0xa9, 0x24, 0x07, 0xfb, 0x76, 0x53, 0x13, 0x6a, 0x18, 0x7d,
-	0x04, 0x6d, 0x59, 0x64, 0x68, 0xb7, 0xc6, 0xc4, 0x1d, 0x6c, 0xdf, 0x9b, 0xd4, 0xea, 0x4d, 0x14,
-	0x69, 0x56, 0x64, 0xc8, 0x34, 0x89, 0xbe, 0x00, 0xa3, 0xca, 0xae, 0x40, 0xbb, 0xad, 0x35, 0xc3,
-	0x15, 0x0d, 0x5b, 0x32, 0xd8, 0x75, 0xba, 0x7e, 0xce, 0xd7, 0x42, 0x84, 0x1e, 0x8f, 0x3e, 0x46,
-	0x3c, 0xb1, 0xd7, 0xc6, 0xc4, 0x35, 0x59, 0x0d, 0xa3, 0x36, 0xac, 0x67, 0xbc, 0x88, 0x52, 0xee,
-	0xdb, 0x1d, 0x7d, 0x3d, 0x0f, 0x9d, 0x5f, 0x04, 0xcc, 0xd2, 0xba, 0xc8, 0xd2, 0x44, 0x20, 0xbd,
-	0x0b, 0x1d, 0x59, 0xfa, 0x2a, 0x8d, 0x57, 0xd1, 0xc2, 0x51, 0xf3, 0x7f, 0x1c, 0x6d, 0x40, 0xcf,
-	0x4b, 0xe3, 0x2c, 0x42, 0x89, 0xbe, 0xee, 0x41, 0x97, 0x2d, 0x01, 0x55, 0x02, 0xf3, 0xfc, 0x48,
-	0x04, 0xda, 0x6a, 0x8f, 0x55, 0x11, 0x1d, 0x42, 0x57, 0x60, 0xe2, 0xcf, 0xc2, 0x18, 0xb5, 0x8b,
-	0x16, 0x5b, 0xc4, 0xb7, 0x3b, 0xa0, 0x77, 0x60, 0x4d, 0x48, 0x2e, 0x85, 0xbd, 0xae, 0xf1, 0x32,
-	0x70, 0x2e, 0x09, 0x0c, 0x94, 0xf0, 0x18, 0xf3, 0x10, 0xc5, 0x61, 0x28, 0x24, 0xdd, 0x85, 0x81,
-	0xac, 0x21, 0x36, 0x19, 0xb7, 0x5c, 0x63, 0xfb, 0xfe, 0xaa, 0x97, 0x05, 0x89, 0xad, 0x08, 0xe8,
-	0x1e, 0xf4, 0x4f, 0x43, 0x8c, 0xfc, 0xdd, 0x20, 0x38, 0xce, 0xd0, 0x13, 0x76, 0x53, 0x67, 0x78,
-	0xb8, 0x92, 0x61, 0x37, 0x08, 0x72, 0x0c, 0xb8, 0x4c, 0x73, 0xc5, 0x62, 0x75, 0x8d, 0xf3, 0x9d,
-	0x00, 0x2c, 0x6b, 0x50, 0x0a, 0x6d, 0xc9, 0x03, 0x51, 0xb5, 0x5b, 0x9f, 0xe9, 0x4b, 0xe8, 0x68,
-	0xcd, 0xbc, 0xc0, 0xe6, 0xad, 0x4f, 0x9c, 0xbc, 0xd1, 0xbc, 0xd7, 0x89, 0xcc, 0x0b, 0x56, 0x89,
-	0x86, 0xcf, 0xc0, 0xb8, 0x06, 0x53, 0x0b, 0x5a, 0x67, 0x58, 0x54, 0x05, 0xd4, 0x51, 0xf5, 0xec,
+	0x0c, 0x6d, 0x59, 0x64, 0x68, 0xb7, 0xc6, 0xc4, 0x1d, 0x6c, 0xdf, 0x9b, 0xd4, 0xea, 0x4d, 0x14,
+	0x69, 0x56, 0x64, 0xc8, 0x34, 0x89, 0xbe, 0x80, 0x5e, 0x95, 0x5d, 0x81, 0x76, 0x5b, 0x6b, 0x86,
+	0x2b, 0x1a, 0xb6, 0x64, 0xb0, 0xeb, 0x74, 0xfd, 0x9c, 0xaf, 0x85, 0x08, 0x3d, 0x1e, 0x7d, 0x8c,
+	0x78, 0x62, 0xaf, 0x8d, 0x89, 0x6b, 0xb2, 0x1a, 0x46, 0x6d, 0x58, 0xcf, 0x78, 0x11, 0xa5, 0xdc,
+	0xb7, 0x0d, 0x7d, 0x3d, 0x0f, 0x9d, 0x5f, 0x04, 0xcc, 0xd2, 0xba, 0xc8, 0xd2, 0x44, 0x20, 0xbd,
+	0x0b, 0x86, 0x2c, 0x7d, 0x95, 0xc6, 0x0d, 0x59, 0x77, 0xd4, 0xfc, 0x1f, 0x47, 0x1b, 0xd0, 0xf5,
+	0xd2, 0x38, 0x8b, 0x50, 0xa2, 0xaf, 0x7b, 0xd0, 0x61, 0x4b, 0x40, 0x95, 0xc0, 0x3c, 0x3f, 0x12,
+	0x81, 0xb6, 0xda, 0x65, 0x55, 0x44, 0x87, 0xd0, 0x11, 0x98, 0xf8, 0xb3, 0x30, 0x46, 0xed, 0xa2,
+	0xc5, 0x16, 0xf1, 0xed, 0x0e, 0xe8, 0x1d, 0x58, 0x13, 0x92, 0x4b, 0x61, 0xaf, 0x6b, 0xbc, 0x0c,
+	0x9c, 0x4b, 0x02, 0x03, 0x25, 0x3c, 0xc6, 0x3c, 0x44, 0x71, 0x18, 0x0a, 0x49, 0x77, 0x61, 0x20,
+	0x6b, 0x88, 0x4d, 0xc6, 0x2d, 0xb7, 0xb7, 0x7d, 0x7f, 0xd5, 0xcb, 0x82, 0xc4, 0x56, 0x04, 0x74,
+	0x0f, 0xfa, 0xa7, 0x21, 0x46, 0xfe, 0x6e, 0x10, 0x1c, 0x67, 0xe8, 0x09, 0xbb, 0xa9, 0x33, 0x3c,
+	0x5c, 0xc9, 0xb0, 0x1b, 0x04, 0x39, 0x06, 0x5c, 0xa6, 0xb9, 0x62, 0xb1, 0xba, 0xc6, 0xf9, 0x4e,
+	0x00, 0x96, 0x35, 0x28, 0x85, 0xb6, 0xe4, 0x81, 0xa8, 0xda, 0xad, 0xcf, 0xf4, 0x25, 0x18, 0x5a,
+	0x33, 0x2f, 0xf0, 0xe8, 0xd6, 0x27, 0x4e, 0xde, 0x68, 0xde, 0xeb, 0x44, 0xe6, 0x05, 0xab, 0x44,
+	0xc3, 0x67, 0xd0, 0xbb, 0x06, 0x53, 0x0b, 0x5a, 0x67, 0x58, 0x54, 0x05, 0xd4, 0x51, 0xf5, 0xec,
 	0x82, 0x47, 0xe7, 0xe5, 0xdf, 0x34, 0x59, 0x19, 0x3c, 0x6f, 0x3e, 0x25, 0x4e, 0x06, 0x83, 0xfa,
 	0xeb, 0xd5, 0xbf, 0xd4, 0x69, 0xa7, 0x3c, 0xc6, 0xf9, 0x32, 0x2c, 0x80, 0xc5, 0xed, 0x6c, 0x3e,
 	0x1b, 0x7d, 0xb6, 0x04, 0xd4, 0x6c, 0x9e, 0x9e, 0x27, 0x9e, 0x3a, 0xeb, 0x86, 0xb7, 0xc6, 0x2d,
-	0xb7, 0xcf, 0x6a, 0xd8, 0xd6, 0x0e, 0x74, 0xe7, 0xd3, 0x43, 0x0d, 0x58, 0xff, 0x34, 0x7d, 0x3f,
-	0xfd, 0xf0, 0x79, 0x6a, 0x35, 0xa8, 0x05, 0xe6, 0x41, 0x22, 0x31, 0x8f, 0xd1, 0x0f, 0xb9, 0x44,
-	0x8b, 0xd0, 0x2e, 0xb4, 0x0f, 0x91, 0x9f, 0x5a, 0xcd, 0xad, 0x4d, 0x30, 0xae, 0x2d, 0x84, 0xba,
-	0xd8, 0xe7, 0x92, 0x5b, 0x0d, 0x6a, 0x42, 0xf7, 0x08, 0x25, 0xf7, 0x55, 0x44, 0xb6, 0x4f, 0xca,
-	0xbd, 0x3e, 0xc6, 0xfc, 0x22, 0xf4, 0x90, 0xbe, 0x85, 0xce, 0x3b, 0x9e, 0xf8, 0x11, 0xd2, 0xe1,
-	0x0d, 0xf3, 0x5b, 0x25, 0x1c, 0x3e, 0xb8, 0xf1, 0xae, 0x5c, 0x0f, 0xa7, 0xe1, 0x92, 0xc7, 0xe4,
-	0x95, 0xf5, 0xe3, 0x6a, 0x44, 0x7e, 0x5e, 0x8d, 0xc8, 0xef, 0xab, 0x11, 0xb9, 0xfc, 0x33, 0x6a,
-	0x7c, 0xe9, 0x68, 0xcd, 0xce, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x7c, 0xa6, 0xcf, 0x68,
-	0x04, 0x00, 0x00,
+	0xb7, 0xcf, 0x6a, 0xd8, 0xe6, 0x0e, 0x74, 0xe6, 0xd3, 0x43, 0x7b, 0xb0, 0xfe, 0x69, 0xfa, 0x7e,
+	0xfa, 0xe1, 0xf3, 0xd4, 0x6a, 0x50, 0x0b, 0xcc, 0x83, 0x44, 0x62, 0x1e, 0xa3, 0x1f, 0x72, 0x89,
+	0x16, 0xa1, 0x1d, 0x68, 0x1f, 0x22, 0x3f, 0xb5, 0x9a, 0x9b, 0x5b, 0xd0, 0xbb, 0xb6, 0x10, 0xea,
+	0x62, 0x9f, 0x4b, 0x6e, 0x35, 0xa8, 0x09, 0x9d, 0x23, 0x94, 0xdc, 0x57, 0x11, 0xa1, 0x00, 0xc6,
+	0x1e, 0x4f, 0x3c, 0x8c, 0xac, 0xe6, 0xf6, 0x49, 0xb9, 0xe3, 0xc7, 0x98, 0x5f, 0x84, 0x1e, 0xd2,
+	0xb7, 0x60, 0xbc, 0xe3, 0x89, 0x1f, 0x21, 0x1d, 0xde, 0x30, 0xcb, 0x55, 0xf2, 0xe1, 0x83, 0x1b,
+	0xef, 0xca, 0x55, 0x71, 0x1a, 0x2e, 0x79, 0x42, 0x5e, 0x59, 0x3f, 0xae, 0x46, 0xe4, 0xe7, 0xd5,
+	0x88, 0xfc, 0xbe, 0x1a, 0x91, 0xcb, 0x3f, 0xa3, 0xc6, 0x17, 0x43, 0x6b, 0x76, 0xfe, 0x0d, 0x00,
+	0xbf, 0x57, 0xbf, 0x60, 0x74, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -92,6 +92,7 @@ func (m *WriteRequest) GetRecord() []byte {
 
 type WriteResponse struct {
 	Err                  string   `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	SeriesQuotaErrors    []byte   `protobuf:"bytes,2,opt,name=seriesQuotaErrors,proto3" json:"seriesQuotaErrors,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -137,6 +138,13 @@ func (m *WriteResponse) GetErr() string {
 	return ""
 }
 
+func (m *WriteResponse) GetSeriesQuotaErrors() []byte {
+	if m != nil {
+		return m.SeriesQuotaErrors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*WriteRequest)(nil), "protoWriteV1.WriteRequest")
 	proto.RegisterType((*WriteResponse)(nil), "protoWriteV1.WriteResponse")
@@ -145,18 +153,19 @@ func init() {
 func init() { proto.RegisterFile("write.proto", fileDescriptor_67966b2b12a73214) }
 
 var fileDescriptor_67966b2b12a73214 = []byte{
-	// 163 bytes of a gzipped FileDescriptorProto
+	// 188 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x2e, 0x2f, 0xca, 0x2c,
 	0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x01, 0x53, 0xe1, 0x20, 0x91, 0x30, 0x43,
 	0x25, 0x35, 0x2e, 0x1e, 0x30, 0x33, 0x28, 0xb5, 0xb0, 0x34, 0xb5, 0xb8, 0x44, 0x48, 0x8c, 0x8b,
 	0xad, 0x28, 0x35, 0x39, 0xbf, 0x28, 0x45, 0x82, 0x51, 0x81, 0x51, 0x83, 0x27, 0x08, 0xca, 0x53,
-	0x52, 0xe4, 0xe2, 0x85, 0xaa, 0x2b, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x12, 0xe0, 0x62, 0x4e,
-	0x2d, 0x2a, 0x02, 0xab, 0xe2, 0x0c, 0x02, 0x31, 0x8d, 0xc2, 0xa0, 0x46, 0x05, 0xa7, 0x16, 0x95,
-	0x65, 0x26, 0xa7, 0x0a, 0xb9, 0x71, 0xb1, 0x82, 0xf9, 0x42, 0x52, 0x7a, 0xc8, 0x56, 0xea, 0x21,
-	0xdb, 0x27, 0x25, 0x8d, 0x55, 0x0e, 0x62, 0x87, 0x12, 0x83, 0x06, 0xa3, 0x01, 0xa3, 0x93, 0xc0,
-	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe3, 0xb1, 0x1c,
-	0x43, 0x12, 0x1b, 0x58, 0x8f, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x48, 0xda, 0x57, 0x19, 0xd8,
-	0x00, 0x00, 0x00,
+	0xf2, 0xe7, 0xe2, 0x85, 0xaa, 0x2b, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x12, 0xe0, 0x62, 0x4e,
+	0x2d, 0x2a, 0x02, 0xab, 0xe2, 0x0c, 0x02, 0x31, 0x85, 0x74, 0xb8, 0x04, 0x8b, 0x53, 0x8b, 0x32,
+	0x53, 0x8b, 0x03, 0x4b, 0xf3, 0x4b, 0x12, 0x5d, 0x8b, 0x8a, 0xf2, 0x8b, 0x8a, 0x25, 0x98, 0xc0,
+	0xa6, 0x60, 0x4a, 0x18, 0x85, 0x41, 0x2d, 0x0e, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x15, 0x72,
+	0xe3, 0x62, 0x05, 0xf3, 0x85, 0xa4, 0xf4, 0x90, 0x1d, 0xa8, 0x87, 0xec, 0x3a, 0x29, 0x69, 0xac,
+	0x72, 0x10, 0x17, 0x29, 0x31, 0x68, 0x30, 0x1a, 0x30, 0x3a, 0x09, 0x9c, 0x78, 0x24, 0xc7, 0x78,
+	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x33, 0x1e, 0xcb, 0x31, 0x24, 0xb1, 0x81, 0xf5,
+	0x18, 0x03, 0x06, 0x00, 0x54, 0xb7, 0x63, 0xe9, 0x06, 0x01, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -329,6 +338,13 @@ func (m *WriteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SeriesQuotaErrors) > 0 {
+		i -= len(m.SeriesQuotaErrors)
+		copy(dAtA[i:], m.SeriesQuotaErrors)
+		i = encodeVarintWrite(dAtA, i, uint64(len(m.SeriesQuotaErrors)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Err) > 0 {
 		i -= len(m.Err)
 		copy(dAtA[i:], m.Err)
@@ -376,6 +392,10 @@ func (m *WriteResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWrite(uint64(l))
 	}
+	l = len(m.SeriesQuotaErrors)
+	if l > 0 {
+		n += 1 + l + sovWrite(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -534,6 +554,40 @@ func (m *WriteResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeriesQuotaErrors", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWrite
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWrite
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWrite
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeriesQuotaErrors = append(m.SeriesQuotaErrors[:0], dAtA[iNdEx:postIndex]...)
+			if m.SeriesQuotaErrors == nil {
+				m.SeriesQuotaErrors = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWrite(dAtA[iNdEx:])
@@ -46,6 +46,9 @@ func (qh *queryFactory) NewMetricQuery(
 	databaseName string,
 	sql *stmtpkg.Query,
 ) MetricQuery {
+	if sql.IsCrossMetric() {
+		return newCrossMetricQuery(ctx, root, databaseName, sql, qh)
+	}
 	return newMetricQuery(ctx, root, databaseName, sql, qh)
 }
 
@@ -38,6 +38,12 @@ func TestExecutorFactory_NewExecutor(t *testing.T) {
 		&models.StatelessNode{},
 		"",
 		&stmt.Query{}))
+	q := factory.NewMetricQuery(
+		context.Background(),
+		&models.StatelessNode{},
+		"",
+		&stmt.Query{Sources: []*stmt.MetricSource{{MetricName: "a"}, {MetricName: "b"}}})
+	assert.IsType(t, &crossMetricQuery{}, q)
 	assert.NotNil(t, factory.NewMetadataQuery(
 		context.Background(),
 		"",
@@ -74,9 +74,14 @@ type MetricQuery interface {
 // 2. suggest tag keys by spec metric name
 // 3. suggest tag values by spec metric name and tag key
 // 4. suggest fields by spec metric name
+// 5. metric series cardinality and tag values cardinality
 
 type MetaDataQuery interface {
+	// WaitResponse waits metadata query result, returns deduped and sorted values.
 	WaitResponse() ([]string, error)
+	// WaitCardinality waits metric/tag cardinality query result, returns merged cardinality of all storage nodes,
+	// series count is the sum of all shards, tag values count is the max one of all storage nodes(approximation).
+	WaitCardinality() (map[string]uint64, error)
 }
 
 // Factory is the handler for executing querying tasks
@@ -30,6 +30,7 @@ import (
 	"github.com/lindb/lindb/query"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
 	"github.com/lindb/lindb/sql/stmt"
 )
 
@@ -141,7 +142,7 @@ func (p *intermediateTaskProcessor) processIntermediateTask(
 		if event.Stats != nil {
 			event.Stats.WaitCost = time.Since(startTime).Nanoseconds()
 		}
-		taskResponse := p.makeTaskResponse(req, event)
+		taskResponse := p.makeTaskResponse(req, &stmtQuery, event)
 		return p.taskManager.SendResponse(intermediate.Parent, taskResponse)
 	case <-ctx.Ctx.Done():
 		// ignore timeout case, as the caller is already timed out
@@ -179,6 +180,7 @@ func (p *intermediateTaskProcessor) decodePhysicalPlan(
 
 func (p *intermediateTaskProcessor) makeTaskResponse(
 	req *protoCommonV1.TaskRequest,
+	stmtQuery *stmt.Query,
 	event *series.TimeSeriesEvent,
 ) *protoCommonV1.TaskResponse {
 	var stats []byte
@@ -196,6 +198,10 @@ func (p *intermediateTaskProcessor) makeTaskResponse(
 			}
 			fields[string(fieldItr.FieldName())] = data
 		}
+		// time series of same group always route to same intermediate node, so can filter by having condition here
+		if len(fields) > 0 && stmtQuery.Having != nil && !p.having(stmtQuery, itr.Tags(), fields) {
+			continue
+		}
 		if len(fields) > 0 {
 			// always have group by
 			timeSeriesList = append(timeSeriesList, &protoCommonV1.TimeSeries{
@@ -223,3 +229,19 @@ func (p *intermediateTaskProcessor) makeTaskResponse(
 		Payload:   data,
 	}
 }
+
+// having evaluates the having condition based on grouped time series data, returns if keeps the time series.
+func (p *intermediateTaskProcessor) having(stmtQuery *stmt.Query, tags string, fields map[string][]byte) bool {
+	fieldsData := make(map[field.Name][]byte)
+	for fieldName, data := range fields {
+		fieldsData[field.Name(fieldName)] = data
+	}
+	expression := newExpressionFn(
+		stmtQuery.TimeRange,
+		stmtQuery.GetResultTimeRange(),
+		stmtQuery.Interval.Int64(),
+		stmtQuery.SelectItems,
+	)
+	expression.Eval(series.NewGroupedIterator(tags, fieldsData))
+	return expression.Filter(stmtQuery.Having)
+}
@@ -26,12 +26,16 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/timeutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 	"github.com/lindb/lindb/series"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/sql/stmt"
 )
 
 func Test_Intermediate_decodePhysicalPlan(t *testing.T) {
@@ -157,3 +161,44 @@ func Test_Intermediate_processIntermediateTask(t *testing.T) {
 			PhysicalPlan: planData,
 		}))
 }
+
+func Test_Intermediate_makeTaskResponse_having(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newExpressionFn = aggregation.NewExpressionWithResultRange
+		ctrl.Finish()
+	}()
+	expression := aggregation.NewMockExpression(ctrl)
+	newExpressionFn = func(_, _ timeutil.TimeRange, _ int64, _ []stmt.Expr) aggregation.Expression {
+		return expression
+	}
+	taskProcessor := intermediateTaskProcessor{}
+	stmtQuery := &stmt.Query{
+		GroupBy: []string{"host"},
+		Having: &stmt.BinaryExpr{
+			Left:     &stmt.FieldExpr{Name: "f1"},
+			Operator: stmt.GREATER,
+			Right:    &stmt.NumberLiteral{Val: 10},
+		},
+	}
+	mockSeries := func() series.GroupedIterator {
+		fieldIt := series.NewMockIterator(ctrl)
+		fieldIt.EXPECT().FieldName().Return(field.Name("f1"))
+		fieldIt.EXPECT().MarshalBinary().Return([]byte{1, 2, 3}, nil)
+		it := series.NewMockGroupedIterator(ctrl)
+		it.EXPECT().HasNext().Return(true)
+		it.EXPECT().Next().Return(fieldIt)
+		it.EXPECT().HasNext().Return(false)
+		it.EXPECT().Tags().Return("host1").AnyTimes()
+		return it
+	}
+	expression.EXPECT().Eval(gomock.Any()).Times(2)
+	expression.EXPECT().Filter(gomock.Any()).Return(false)
+	expression.EXPECT().Filter(gomock.Any()).Return(true)
+	resp := taskProcessor.makeTaskResponse(&protoCommonV1.TaskRequest{}, stmtQuery, &series.TimeSeriesEvent{
+		SeriesList: series.GroupedIterators{mockSeries(), mockSeries()},
+	})
+	tsList := &protoCommonV1.TimeSeriesList{}
+	assert.NoError(t, tsList.Unmarshal(resp.Payload))
+	assert.Len(t, tsList.TimeSeriesList, 1)
+}
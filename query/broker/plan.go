@@ -18,6 +18,10 @@
 package brokerquery
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/timeutil"
 	querypkg "github.com/lindb/lindb/query"
@@ -87,8 +91,25 @@ func (p *brokerPlan) Plan() error {
 	p.query.StorageInterval = storageInterval
 	p.query.Interval = interval
 	p.query.IntervalRatio = intervalRatio
-	p.query.TimeRange.Start = timeutil.Truncate(p.query.TimeRange.Start, intervalVal)
-	p.query.TimeRange.End = timeutil.Truncate(p.query.TimeRange.End, intervalVal)
+	// offset must be multiple of query interval, make sure re-aligned result in same time slots
+	offset := p.query.Offset
+	if offset%intervalVal != 0 {
+		return fmt.Errorf("%w, offset: %s, interval: %s",
+			querypkg.ErrOffsetNotAligned, time.Duration(offset)*time.Millisecond, interval)
+	}
+	// shift time range backward by offset when query storage data, result will be re-aligned by offset
+	p.query.TimeRange.Start = timeutil.Truncate(p.query.TimeRange.Start-offset, intervalVal)
+	p.query.TimeRange.End = timeutil.Truncate(p.query.TimeRange.End-offset, intervalVal)
+	// widen time range by timeshift function when query storage data, points shifted into result are read,
+	// result will be trimmed to original time range.
+	before, after := timeShiftOf(p.query.SelectItems)
+	before = before / intervalVal * intervalVal
+	after = after / intervalVal * intervalVal
+	if before > 0 || after > 0 {
+		p.query.ResultTimeRange = p.query.TimeRange
+		p.query.TimeRange.Start -= before
+		p.query.TimeRange.End += after
+	}
 
 	root := p.currentBrokerNode
 
@@ -196,3 +217,38 @@ func (p *brokerPlan) buildLeafs(parentID string, nodeIDs []string, receivers []m
 		p.physicalPlan.AddLeaf(leaf)
 	}
 }
+
+// timeShiftOf returns the max duration of timeshift function in select list,
+// before is the max forward shift duration, after is the max backward shift duration.
+func timeShiftOf(exprs []stmtpkg.Expr) (before, after int64) {
+	for _, expr := range exprs {
+		var b, a int64
+		switch e := expr.(type) {
+		case *stmtpkg.SelectItem:
+			b, a = timeShiftOf([]stmtpkg.Expr{e.Expr})
+		case *stmtpkg.ParenExpr:
+			b, a = timeShiftOf([]stmtpkg.Expr{e.Expr})
+		case *stmtpkg.BinaryExpr:
+			b, a = timeShiftOf([]stmtpkg.Expr{e.Left, e.Right})
+		case *stmtpkg.CallExpr:
+			b, a = timeShiftOf(e.Params)
+			if e.FuncType == function.TimeShift && len(e.Params) == 2 {
+				if duration, ok := e.Params[1].(*stmtpkg.DurationLiteral); ok {
+					// shifted points of param need to be read also
+					if duration.Val > 0 {
+						b += duration.Val
+					} else {
+						a -= duration.Val
+					}
+				}
+			}
+		}
+		if b > before {
+			before = b
+		}
+		if a > after {
+			after = a
+		}
+	}
+	return before, after
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/query"
 	"github.com/lindb/lindb/sql"
 	"github.com/lindb/lindb/sql/stmt"
@@ -74,6 +75,65 @@ func TestBrokerPlan_No_GroupBy(t *testing.T) {
 	assert.Equal(t, 0, len(plan.physicalPlan.Intermediates))
 }
 
+func TestBrokerPlan_Offset(t *testing.T) {
+	storageNodes := map[string][]models.ShardID{"1.1.1.1:9000": {1, 2, 4}}
+	currentNode := generateBrokerActiveNode("1.1.1.3", 8000)
+	q, err := sql.Parse("select f from cpu where time>'20190729 11:00:00' and time<'20190729 12:00:00' offset 1d")
+	assert.NoError(t, err)
+	queryStmt := q.(*stmt.Query)
+	timeRange := queryStmt.TimeRange
+	plan := newBrokerPlan(queryStmt,
+		models.Database{Option: &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}}},
+		storageNodes, currentNode, nil)
+	err = plan.Plan()
+	assert.NoError(t, err)
+	assert.Equal(t, timeutil.OneDay, queryStmt.Offset)
+	assert.Equal(t, timeRange.Start-timeutil.OneDay, queryStmt.TimeRange.Start)
+	assert.Equal(t, timeRange.End-timeutil.OneDay, queryStmt.TimeRange.End)
+
+	// offset not multiple of interval
+	q, err = sql.Parse("select f from cpu where time>'20190729 11:00:00' and time<'20190729 12:00:00' offset 15s")
+	assert.NoError(t, err)
+	queryStmt = q.(*stmt.Query)
+	timeRange = queryStmt.TimeRange
+	plan = newBrokerPlan(queryStmt,
+		models.Database{Option: &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}}},
+		storageNodes, currentNode, nil)
+	err = plan.Plan()
+	assert.ErrorIs(t, err, query.ErrOffsetNotAligned)
+	assert.Equal(t, timeRange, queryStmt.TimeRange)
+}
+
+func TestBrokerPlan_TimeShift(t *testing.T) {
+	storageNodes := map[string][]models.ShardID{"1.1.1.1:9000": {1, 2, 4}}
+	currentNode := generateBrokerActiveNode("1.1.1.3", 8000)
+	cases := []struct {
+		sql           string
+		before, after int64
+	}{
+		{sql: "select f from cpu", before: 0, after: 0},
+		{sql: "select f, timeshift(f, 1d) from cpu", before: timeutil.OneDay, after: 0},
+		{sql: "select timeshift(f, -2h)+timeshift(f, 1h) from cpu", before: timeutil.OneHour, after: 2 * timeutil.OneHour},
+		{sql: "select abs(timeshift(f, 15s)) as f from cpu", before: 10 * 1000, after: 0},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.sql, func(t *testing.T) {
+			q, err := sql.Parse(tt.sql + " where time>'20190729 11:00:00' and time<'20190729 12:00:00'")
+			assert.NoError(t, err)
+			queryStmt := q.(*stmt.Query)
+			plan := newBrokerPlan(queryStmt,
+				models.Database{Option: &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}}},
+				storageNodes, currentNode, nil)
+			err = plan.Plan()
+			assert.NoError(t, err)
+			resultTimeRange := queryStmt.GetResultTimeRange()
+			assert.Equal(t, resultTimeRange.Start-tt.before, queryStmt.TimeRange.Start)
+			assert.Equal(t, resultTimeRange.End+tt.after, queryStmt.TimeRange.End)
+		})
+	}
+}
+
 func TestBrokerPlan_GroupBy_oddCount(t *testing.T) {
 	// odd number
 	oddStorageNodes := map[string][]models.ShardID{
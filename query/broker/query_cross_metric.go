@@ -0,0 +1,346 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/lindb/lindb/aggregation"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// for testing
+var (
+	newSubMetricQueryFn = newMetricQuery
+)
+
+// crossMetricQuery implements MetricQuery, executes the query which selects fields from multiple metrics.
+// 1) splits select list into sub queries, one sub query per metric
+// 2) executes all sub queries concurrently
+// 3) joins the results of sub queries on group by tag values and time slot
+// 4) evaluates the arithmetic of select list at broker
+type crossMetricQuery struct {
+	queryFactory *queryFactory
+
+	ctx      context.Context
+	database string
+	root     models.Node
+
+	stmtQuery *stmt.Query
+}
+
+// newCrossMetricQuery creates the execution which executes the cross metric query.
+func newCrossMetricQuery(
+	ctx context.Context,
+	root models.Node,
+	database string,
+	sql *stmt.Query,
+	queryFactory *queryFactory,
+) MetricQuery {
+	return &crossMetricQuery{
+		stmtQuery:    sql,
+		root:         root,
+		database:     database,
+		ctx:          ctx,
+		queryFactory: queryFactory,
+	}
+}
+
+// WaitResponse executes all sub queries, then joins the results of sub queries.
+func (q *crossMetricQuery) WaitResponse() (*models.ResultSet, error) {
+	splitter := newCrossMetricSplitter(q.stmtQuery)
+	if err := splitter.split(); err != nil {
+		return nil, err
+	}
+	var (
+		wait    sync.WaitGroup
+		results = make([]*models.ResultSet, len(splitter.subQueries))
+		errs    = make([]error, len(splitter.subQueries))
+	)
+	for idx := range splitter.subQueries {
+		wait.Add(1)
+		go func(idx int) {
+			defer wait.Done()
+			results[idx], errs[idx] = newSubMetricQueryFn(q.ctx, q.root, q.database,
+				splitter.subQueries[idx], q.queryFactory).WaitResponse()
+		}(idx)
+	}
+	wait.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q.makeResultSet(splitter, results), nil
+}
+
+// makeResultSet joins the results of sub queries on group by tag values and time slot,
+// then evaluates the select list with joined field values.
+func (q *crossMetricQuery) makeResultSet(splitter *crossMetricSplitter, results []*models.ResultSet) *models.ResultSet {
+	resultSet := models.NewResultSet()
+	var metricNames []string
+	for _, source := range q.stmtQuery.Sources {
+		metricNames = append(metricNames, source.MetricName)
+	}
+	resultSet.MetricName = strings.Join(metricNames, ",")
+	resultSet.GroupBy = q.stmtQuery.GroupBy
+	for _, selectItem := range splitter.selectItems {
+		resultSet.Fields = append(resultSet.Fields, selectItem.Alias)
+	}
+	if len(results) == 0 || results[0] == nil {
+		return resultSet
+	}
+	first := results[0]
+	resultSet.StartTime = first.StartTime
+	resultSet.EndTime = first.EndTime
+	resultSet.Interval = first.Interval
+	if first.Interval <= 0 {
+		return resultSet
+	}
+
+	// sub queries use same time range/interval which are reset by broker plan
+	queryStmt := *q.stmtQuery
+	queryStmt.TimeRange = timeutil.TimeRange{Start: first.StartTime, End: first.EndTime}
+	queryStmt.Interval = timeutil.Interval(first.Interval)
+	// time range of sub query result is re-aligned by offset
+	queryStmt.Offset = 0
+	pointMaker := &metricQuery{stmtQuery: &queryStmt}
+	pointCount := timeutil.CalPointCount(first.StartTime, first.EndTime, first.Interval) + 1
+
+	seriesOfResults := make([]map[string]*models.Series, len(results))
+	for idx, rs := range results {
+		seriesOfResults[idx] = make(map[string]*models.Series)
+		if rs == nil {
+			continue
+		}
+		for _, s := range rs.Series {
+			seriesOfResults[idx][s.TagValues] = s
+		}
+	}
+
+	sort.Slice(first.Series, func(i, j int) bool {
+		return first.Series[i].TagValues < first.Series[j].TagValues
+	})
+	for _, s := range first.Series {
+		if len(resultSet.Series) >= q.stmtQuery.GetLimit() {
+			break
+		}
+		fieldValues := make(map[string]*collections.FloatArray)
+		joined := true
+		for _, seriesMap := range seriesOfResults {
+			series, ok := seriesMap[s.TagValues]
+			if !ok {
+				joined = false
+				break
+			}
+			for fieldName, points := range series.Fields {
+				fieldValues[fieldName] = toFloatArray(points, first.StartTime, first.Interval, pointCount)
+			}
+		}
+		if !joined {
+			// tag values not exist in all metrics
+			continue
+		}
+		timeSeries := models.NewSeries(s.Tags, s.TagValues)
+		for _, selectItem := range splitter.selectItems {
+			values := aggregation.EvalJoinedExpr(selectItem.Expr, pointCount, fieldValues)
+			if values == nil || values.IsEmpty() {
+				continue
+			}
+			timeSeries.AddField(selectItem.Alias, pointMaker.makePoints(values))
+		}
+		if len(timeSeries.Fields) > 0 {
+			resultSet.AddSeries(timeSeries)
+		}
+	}
+	return resultSet
+}
+
+// toFloatArray converts data points into float array indexed by time slot.
+func toFloatArray(points map[int64]float64, startTime, interval int64, pointCount int) *collections.FloatArray {
+	values := collections.NewFloatArray(pointCount)
+	for timestamp, value := range points {
+		if math.IsNaN(value) || timestamp < startTime {
+			continue
+		}
+		values.SetValue(int((timestamp-startTime)/interval), value)
+	}
+	return values
+}
+
+// crossMetricSplitter splits the select list of cross metric query into sub queries,
+// the expression which references only one metric is pushed down into sub query of this metric,
+// and is replaced with a field reference of sub query result.
+type crossMetricSplitter struct {
+	query *stmt.Query
+
+	subQueries  []*stmt.Query
+	subQueryIdx map[int]int // metric source index => sub query index
+	selectItems []*stmt.SelectItem
+}
+
+// newCrossMetricSplitter creates a splitter for cross metric query.
+func newCrossMetricSplitter(query *stmt.Query) *crossMetricSplitter {
+	return &crossMetricSplitter{
+		query:       query,
+		subQueryIdx: make(map[int]int),
+	}
+}
+
+// split splits the select list into sub queries.
+func (s *crossMetricSplitter) split() error {
+	for _, item := range s.query.SelectItems {
+		name := item.Rewrite()
+		expr := item
+		if selectItem, ok := item.(*stmt.SelectItem); ok {
+			expr = selectItem.Expr
+			if selectItem.Alias != "" {
+				name = selectItem.Alias
+			}
+		}
+		rewritten, err := s.rewrite(expr)
+		if err != nil {
+			return err
+		}
+		s.selectItems = append(s.selectItems, &stmt.SelectItem{Expr: rewritten, Alias: name})
+	}
+	if len(s.subQueries) == 0 {
+		return fmt.Errorf("cross metric query must select fields of metric")
+	}
+	return nil
+}
+
+// rewrite pushes down the expression which references only one metric into sub query,
+// returns the expression evaluated at broker.
+func (s *crossMetricSplitter) rewrite(expr stmt.Expr) (stmt.Expr, error) {
+	sources, err := s.sourcesOf(expr)
+	if err != nil {
+		return nil, err
+	}
+	switch len(sources) {
+	case 0:
+		if _, ok := expr.(*stmt.NumberLiteral); ok {
+			return expr, nil
+		}
+		if paren, ok := expr.(*stmt.ParenExpr); ok {
+			return s.rewrite(paren.Expr)
+		}
+		return nil, fmt.Errorf("cannot find metric of expr: %s in cross metric query", expr.Rewrite())
+	case 1:
+		for sourceIdx := range sources {
+			return s.pushDown(sourceIdx, expr), nil
+		}
+	}
+	switch e := expr.(type) {
+	case *stmt.ParenExpr:
+		inner, err := s.rewrite(e.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &stmt.ParenExpr{Expr: inner}, nil
+	case *stmt.BinaryExpr:
+		left, err := s.rewrite(e.Left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := s.rewrite(e.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &stmt.BinaryExpr{Left: left, Right: right, Operator: e.Operator}, nil
+	default:
+		return nil, fmt.Errorf("expr: %s cannot be evaluated across metrics", expr.Rewrite())
+	}
+}
+
+// pushDown pushes down the expression into sub query of metric, returns the field reference of sub query result.
+func (s *crossMetricSplitter) pushDown(sourceIdx int, expr stmt.Expr) stmt.Expr {
+	source := s.query.Sources[sourceIdx]
+	idx, ok := s.subQueryIdx[sourceIdx]
+	if !ok {
+		idx = len(s.subQueries)
+		s.subQueryIdx[sourceIdx] = idx
+		s.subQueries = append(s.subQueries, &stmt.Query{
+			Namespace:  s.query.Namespace,
+			MetricName: source.MetricName,
+			Condition:  s.query.Condition,
+			TimeRange:  s.query.TimeRange,
+			Offset:     s.query.Offset + source.Offset,
+			Interval:   s.query.Interval,
+			GroupBy:    s.query.GroupBy,
+			// need all grouped series for joining
+			Limit: math.MaxInt32,
+		})
+	}
+	subQuery := s.subQueries[idx]
+	fieldName := fmt.Sprintf("%s#%d", source.Name(), len(subQuery.SelectItems))
+	subQuery.SelectItems = append(subQuery.SelectItems, &stmt.SelectItem{
+		Expr:  unqualify(expr, source),
+		Alias: fieldName,
+	})
+	return &stmt.FieldExpr{Name: fieldName}
+}
+
+// sourcesOf returns the metric sources which are referenced by expression.
+func (s *crossMetricSplitter) sourcesOf(expr stmt.Expr) (map[int]struct{}, error) {
+	sources := make(map[int]struct{})
+	var err error
+	stmt.WalkFieldExpr(expr, func(fieldExpr *stmt.FieldExpr) {
+		for idx, source := range s.query.Sources {
+			if _, ok := source.FieldName(fieldExpr.Name); ok {
+				sources[idx] = struct{}{}
+				return
+			}
+		}
+		err = fmt.Errorf("field: %s must be qualified by metric alias in cross metric query", fieldExpr.Name)
+	})
+	return sources, err
+}
+
+// unqualify returns a copy of expression which field names are not qualified by metric.
+func unqualify(expr stmt.Expr, source *stmt.MetricSource) stmt.Expr {
+	switch e := expr.(type) {
+	case *stmt.FieldExpr:
+		fieldName, _ := source.FieldName(e.Name)
+		return &stmt.FieldExpr{Name: fieldName}
+	case *stmt.CallExpr:
+		call := &stmt.CallExpr{FuncType: e.FuncType}
+		for _, param := range e.Params {
+			call.Params = append(call.Params, unqualify(param, source))
+		}
+		return call
+	case *stmt.ParenExpr:
+		return &stmt.ParenExpr{Expr: unqualify(e.Expr, source)}
+	case *stmt.BinaryExpr:
+		return &stmt.BinaryExpr{
+			Left:     unqualify(e.Left, source),
+			Right:    unqualify(e.Right, source),
+			Operator: e.Operator,
+		}
+	default:
+		return expr
+	}
+}
@@ -0,0 +1,262 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package brokerquery
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func newTestResultSet(metricName string, fieldName string, series map[string]map[int64]float64) *models.ResultSet {
+	rs := &models.ResultSet{
+		MetricName: metricName,
+		StartTime:  0,
+		EndTime:    30,
+		Interval:   10,
+	}
+	for host, points := range series {
+		s := models.NewSeries(map[string]string{"host": host}, host)
+		s.AddField(fieldName, &models.Points{Points: points})
+		rs.AddSeries(s)
+	}
+	return rs
+}
+
+func TestCrossMetricQuery_WaitResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newSubMetricQueryFn = newMetricQuery
+		ctrl.Finish()
+	}()
+	q, err := sql.Parse("select (e.count/r.count)*100 as rate, r.count from errors as e, requests as r group by host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+
+	errorsRS := newTestResultSet("errors", "e#0", map[string]map[int64]float64{
+		"a": {0: 1, 10: 2},
+		"b": {0: 3},
+	})
+	requestsRS := newTestResultSet("requests", "r#0", map[string]map[int64]float64{
+		"a": {0: 10, 20: 20},
+		"c": {0: 30},
+	})
+	for _, s := range requestsRS.Series {
+		if s.TagValues == "a" {
+			s.AddField("r#1", &models.Points{Points: map[int64]float64{0: 10, 20: 20}})
+		}
+	}
+
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+		assert  func(rs *models.ResultSet)
+	}{
+		{
+			name: "sub query failure",
+			prepare: func() {
+				newSubMetricQueryFn = func(_ context.Context, _ models.Node, _ string,
+					_ *stmt.Query, _ *queryFactory) MetricQuery {
+					subQuery := NewMockMetricQuery(ctrl)
+					subQuery.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+					return subQuery
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "join results",
+			prepare: func() {
+				newSubMetricQueryFn = func(_ context.Context, _ models.Node, _ string,
+					sq *stmt.Query, _ *queryFactory) MetricQuery {
+					subQuery := NewMockMetricQuery(ctrl)
+					if sq.MetricName == "errors" {
+						subQuery.EXPECT().WaitResponse().Return(errorsRS, nil)
+					} else {
+						subQuery.EXPECT().WaitResponse().Return(requestsRS, nil)
+					}
+					return subQuery
+				}
+			},
+			assert: func(rs *models.ResultSet) {
+				assert.Equal(t, "errors,requests", rs.MetricName)
+				assert.Equal(t, []string{"rate", "r.count"}, rs.Fields)
+				assert.Equal(t, int64(10), rs.Interval)
+				assert.Len(t, rs.Series, 1)
+				assert.Equal(t, map[string]string{"host": "a"}, rs.Series[0].Tags)
+				assert.Equal(t, map[int64]float64{0: 10}, rs.Series[0].Fields["rate"])
+				assert.Equal(t, map[int64]float64{0: 10, 20: 20}, rs.Series[0].Fields["r.count"])
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			rs, err := newCrossMetricQuery(context.TODO(), &models.StatelessNode{}, "db", query, &queryFactory{}).WaitResponse()
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+			if tt.assert != nil {
+				tt.assert(rs)
+			}
+		})
+	}
+}
+
+func TestCrossMetricQuery_makeResultSet(t *testing.T) {
+	query := &stmt.Query{
+		Sources: []*stmt.MetricSource{{MetricName: "a"}, {MetricName: "b"}},
+		Limit:   1,
+	}
+	q := newCrossMetricQuery(context.TODO(), &models.StatelessNode{}, "db", query, &queryFactory{}).(*crossMetricQuery)
+	splitter := &crossMetricSplitter{selectItems: []*stmt.SelectItem{{
+		Expr:  &stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "a#0"}, Operator: stmt.ADD, Right: &stmt.FieldExpr{Name: "b#0"}},
+		Alias: "f",
+	}}}
+	// no result
+	rs := q.makeResultSet(splitter, nil)
+	assert.Empty(t, rs.Series)
+	// interval not set
+	rs = q.makeResultSet(splitter, []*models.ResultSet{{}, {}})
+	assert.Empty(t, rs.Series)
+	// limit
+	a := newTestResultSet("a", "a#0", map[string]map[int64]float64{"1": {0: 1}, "2": {0: 2}})
+	b := newTestResultSet("b", "b#0", map[string]map[int64]float64{"1": {0: 1}, "2": {0: 2}})
+	rs = q.makeResultSet(splitter, []*models.ResultSet{a, b})
+	assert.Len(t, rs.Series, 1)
+	assert.Equal(t, map[int64]float64{0: 2}, rs.Series[0].Fields["f"])
+	// limit not set, unlimited
+	query.Limit = 0
+	rs = q.makeResultSet(splitter, []*models.ResultSet{a, b})
+	assert.Len(t, rs.Series, 2)
+	// no joined value
+	b = newTestResultSet("b", "b#0", map[string]map[int64]float64{"1": {10: 1}})
+	rs = q.makeResultSet(splitter, []*models.ResultSet{a, b})
+	assert.Empty(t, rs.Series)
+}
+
+func TestCrossMetricSplitter_split(t *testing.T) {
+	q, err := sql.Parse("select sum(e.count)/(sum(r.count)+1) as rate, max(e.cost) " +
+		"from errors as e, requests as r where app='x' group by host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	splitter := newCrossMetricSplitter(query)
+	assert.NoError(t, splitter.split())
+	assert.Len(t, splitter.subQueries, 2)
+
+	errorsQuery := splitter.subQueries[0]
+	assert.Equal(t, "errors", errorsQuery.MetricName)
+	assert.Equal(t, query.Condition, errorsQuery.Condition)
+	assert.Equal(t, []string{"host"}, errorsQuery.GroupBy)
+	assert.Equal(t, []stmt.Expr{
+		&stmt.SelectItem{
+			Expr:  &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "count"}}},
+			Alias: "e#0",
+		},
+		&stmt.SelectItem{
+			Expr:  &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{&stmt.FieldExpr{Name: "cost"}}},
+			Alias: "e#1",
+		},
+	}, errorsQuery.SelectItems)
+	requestsQuery := splitter.subQueries[1]
+	assert.Equal(t, "requests", requestsQuery.MetricName)
+	assert.Equal(t, []stmt.Expr{
+		&stmt.SelectItem{
+			Expr: &stmt.ParenExpr{Expr: &stmt.BinaryExpr{
+				Left:     &stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{&stmt.FieldExpr{Name: "count"}}},
+				Operator: stmt.ADD,
+				Right:    &stmt.NumberLiteral{Val: 1},
+			}},
+			Alias: "r#0",
+		},
+	}, requestsQuery.SelectItems)
+
+	assert.Equal(t, []*stmt.SelectItem{
+		{
+			Expr:  &stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "e#0"}, Operator: stmt.DIV, Right: &stmt.FieldExpr{Name: "r#0"}},
+			Alias: "rate",
+		},
+		{Expr: &stmt.FieldExpr{Name: "e#1"}, Alias: "max(e.cost)"},
+	}, splitter.selectItems)
+}
+
+func TestCrossMetricSplitter_split_offset(t *testing.T) {
+	q, err := sql.Parse("select sum(today.count)-sum(lw.count) as diff " +
+		"from http as today, http offset 7d as lw where time > now()-1h offset 1h group by host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	splitter := newCrossMetricSplitter(query)
+	assert.NoError(t, splitter.split())
+	assert.Len(t, splitter.subQueries, 2)
+	assert.Equal(t, timeutil.OneHour, splitter.subQueries[0].Offset)
+	assert.Equal(t, 7*timeutil.OneDay+timeutil.OneHour, splitter.subQueries[1].Offset)
+}
+
+func TestCrossMetricSplitter_split_fail(t *testing.T) {
+	sources := []*stmt.MetricSource{{MetricName: "a"}, {MetricName: "b"}}
+	cases := []stmt.Expr{
+		// no metric
+		&stmt.NumberLiteral{Val: 1},
+		// not qualified
+		&stmt.FieldExpr{Name: "f"},
+		// function without field
+		&stmt.CallExpr{FuncType: function.Quantile, Params: []stmt.Expr{&stmt.NumberLiteral{Val: 0.99}}},
+		// function across metrics
+		&stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{
+			&stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "a.f"}, Operator: stmt.ADD, Right: &stmt.FieldExpr{Name: "b.f"}},
+		}},
+		&stmt.ParenExpr{Expr: &stmt.BinaryExpr{
+			Left:     &stmt.FieldExpr{Name: "f"},
+			Operator: stmt.ADD,
+			Right:    &stmt.FieldExpr{Name: "b.f"},
+		}},
+		&stmt.BinaryExpr{
+			Left: &stmt.BinaryExpr{
+				Left:     &stmt.FieldExpr{Name: "a.f"},
+				Operator: stmt.ADD,
+				Right:    &stmt.FieldExpr{Name: "b.f"},
+			},
+			Operator: stmt.ADD,
+			Right:    &stmt.CallExpr{FuncType: function.Quantile, Params: []stmt.Expr{&stmt.NumberLiteral{Val: 0.99}}},
+		},
+	}
+	for _, expr := range cases {
+		splitter := newCrossMetricSplitter(&stmt.Query{Sources: sources, SelectItems: []stmt.Expr{expr}})
+		assert.Error(t, splitter.split(), expr.Rewrite())
+	}
+	// paren of cross metric expr
+	splitter := newCrossMetricSplitter(&stmt.Query{Sources: sources, SelectItems: []stmt.Expr{
+		&stmt.ParenExpr{Expr: &stmt.BinaryExpr{
+			Left:     &stmt.FieldExpr{Name: "a.f"},
+			Operator: stmt.ADD,
+			Right:    &stmt.ParenExpr{Expr: &stmt.NumberLiteral{Val: 1}},
+		}},
+	}})
+	assert.NoError(t, splitter.split())
+}
@@ -37,7 +37,8 @@ type metadataQuery struct {
 	database      string
 	metaStmtQuery *stmtpkg.MetricMetadata
 
-	results []string
+	results     []string
+	cardinality map[string]uint64
 }
 
 // newMetadataQuery creates the execution which executes the job of parallel query
@@ -55,33 +56,52 @@ func newMetadataQuery(
 	}
 }
 
+// WaitResponse waits metadata query result, returns deduped and sorted values.
 func (mq *metadataQuery) WaitResponse() ([]string, error) {
+	if err := mq.wait(); err != nil {
+		return nil, err
+	}
+	deduped := strutil.DeDupStringSlice(mq.results)
+	sort.Strings(deduped)
+	return deduped, nil
+}
+
+// WaitCardinality waits metric/tag cardinality query result, returns merged cardinality of all storage nodes,
+// series count is the sum of all shards, tag values count is the max one of all storage nodes(approximation).
+func (mq *metadataQuery) WaitCardinality() (map[string]uint64, error) {
+	if err := mq.wait(); err != nil {
+		return nil, err
+	}
+	return mq.cardinality, nil
+}
+
+// wait submits metadata query task and waits all task responses.
+func (mq *metadataQuery) wait() error {
 	physicalPlan, err := mq.makePlan()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	resultCh, err := mq.runtime.taskManager.SubmitMetaDataTask(mq.ctx, physicalPlan, mq.metaStmtQuery)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	mq.cardinality = make(map[string]uint64)
 	for {
 		select {
 		case result, ok := <-resultCh:
 			// received all data, break for loop
 			if !ok {
-				deduped := strutil.DeDupStringSlice(mq.results)
-				sort.Strings(deduped)
-				return deduped, nil
+				return nil
 			}
 			if result.ErrMsg != "" {
-				return nil, errors.New(result.ErrMsg)
+				return errors.New(result.ErrMsg)
 			}
 			if err := mq.handleTaskResponse(result); err != nil {
-				return nil, err
+				return err
 			}
 		case <-mq.ctx.Done():
-			return nil, ErrTimeout
+			return ErrTimeout
 		}
 	}
 }
@@ -127,5 +147,17 @@ func (mq *metadataQuery) handleTaskResponse(resp *protoCommonV1.TaskResponse) er
 		return err
 	}
 	mq.results = append(mq.results, result.Values...)
+	for name, count := range result.Cardinality {
+		if mq.metaStmtQuery.Type == stmtpkg.TagCardinality {
+			// tag values are stored in database level metadata of each storage node, tag value ids are
+			// generated by each node, cannot union them, take the max one as approximation(lower bound).
+			if count > mq.cardinality[name] {
+				mq.cardinality[name] = count
+			}
+			continue
+		}
+		// series are partitioned by shard, sum series of all shards
+		mq.cardinality[name] += count
+	}
 	return nil
 }
@@ -120,3 +120,65 @@ func Test_MetadataQuery(t *testing.T) {
 	_, err = metaDataQuery.WaitResponse()
 	assert.Error(t, err)
 }
+
+func Test_MetadataQuery_WaitCardinality(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stateMgr := broker.NewMockStateManager(ctrl)
+	thisTaskManager := NewMockTaskManager(ctrl)
+	stateMgr.EXPECT().GetQueryableReplicas("db").
+		Return(map[string][]models.ShardID{
+			"1.1.1.1:9000": {1, 2, 4},
+			"1.1.1.2:9000": {3, 5, 6},
+		}, nil).AnyTimes()
+	stateMgr.EXPECT().GetCurrentNode().Return(models.StatelessNode{
+		HostIP: "1.1.1.3", GRPCPort: 8000,
+	}).AnyTimes()
+
+	data1 := encoding.JSONMarshal(models.SuggestResult{Cardinality: map[string]uint64{"a": 10, "b": 5}})
+	data2 := encoding.JSONMarshal(models.SuggestResult{Cardinality: map[string]uint64{"a": 3, "c": 1}})
+	cases := []struct {
+		name   string
+		in     *stmt.MetricMetadata
+		result map[string]uint64
+	}{
+		{
+			name:   "sum metric series of all nodes",
+			in:     &stmt.MetricMetadata{Type: stmt.MetricCardinality},
+			result: map[string]uint64{"a": 13, "b": 5, "c": 1},
+		},
+		{
+			name:   "max tag values of all nodes",
+			in:     &stmt.MetricMetadata{Type: stmt.TagCardinality},
+			result: map[string]uint64{"a": 10, "b": 5, "c": 1},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			metaDataQuery := newMetadataQuery(context.TODO(), "db", tt.in, &queryFactory{
+				stateMgr:    stateMgr,
+				taskManager: thisTaskManager,
+			})
+			responseCh := make(chan *protoCommonV1.TaskResponse, 2)
+			responseCh <- &protoCommonV1.TaskResponse{Payload: data1}
+			responseCh <- &protoCommonV1.TaskResponse{Payload: data2}
+			close(responseCh)
+			thisTaskManager.EXPECT().SubmitMetaDataTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(responseCh, nil)
+			rs, err := metaDataQuery.WaitCardinality()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.result, rs)
+		})
+	}
+
+	// wait error
+	metaDataQuery := newMetadataQuery(context.TODO(), "db", &stmt.MetricMetadata{}, &queryFactory{
+		stateMgr:    stateMgr,
+		taskManager: thisTaskManager,
+	})
+	thisTaskManager.EXPECT().SubmitMetaDataTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, io.ErrClosedPipe)
+	rs, err := metaDataQuery.WaitCardinality()
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+}
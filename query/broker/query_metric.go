@@ -29,6 +29,7 @@ import (
 	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/query"
 	"github.com/lindb/lindb/series"
@@ -39,7 +40,7 @@ import (
 
 // for testing
 var (
-	newExpressionFn = aggregation.NewExpression
+	newExpressionFn = aggregation.NewExpressionWithResultRange
 	newBrokerPlanFn = newBrokerPlan
 )
 
@@ -141,6 +142,10 @@ func (mq *metricQuery) WaitResponse() (*models.ResultSet, error) {
 			return nil, event.Err
 		}
 	case <-mq.ctx.Done():
+		if errors.Is(mq.ctx.Err(), context.Canceled) {
+			// request is killed or client is disconnected
+			return nil, query.ErrQueryCanceled
+		}
 		return nil, ErrTimeout
 	}
 	return mq.makeResultSet(event)
@@ -152,7 +157,7 @@ func (mq *metricQuery) buildOrderBy(event *series.TimeSeriesEvent) (aggregation.
 	orderByExprs := mq.stmtQuery.OrderByItems
 	if len(orderByExprs) == 0 {
 		// use default limiter
-		return aggregation.NewResultLimiter(mq.stmtQuery.Limit), nil
+		return aggregation.NewResultLimiter(mq.stmtQuery.GetLimit()), nil
 	}
 	var orderByItems []*aggregation.OrderByItem
 	fields := event.AggregatorSpecs
@@ -181,7 +186,7 @@ func (mq *metricQuery) buildOrderBy(event *series.TimeSeriesEvent) (aggregation.
 			Desc:     expr.Desc,
 		})
 	}
-	return aggregation.NewTopNOrderBy(orderByItems, mq.stmtQuery.Limit), nil
+	return aggregation.NewTopNOrderBy(orderByItems, mq.stmtQuery.GetLimit()), nil
 }
 
 // makeResultSet makes final result set from time series event(GroupedIterators).
@@ -205,11 +210,16 @@ func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *
 		// TODO: reuse expression??
 		expression := newExpressionFn(
 			queryStmt.TimeRange,
+			queryStmt.GetResultTimeRange(),
 			queryStmt.Interval.Int64(),
 			queryStmt.SelectItems,
 		)
 		// do expression eval
 		expression.Eval(ts)
+		// filter grouped series by having condition
+		if queryStmt.Having != nil && !expression.Filter(queryStmt.Having) {
+			continue
+		}
 
 		// result order by/limit
 		orderBy.Push(aggregation.NewOrderByRow(ts.Tags(), expression.ResultSet()))
@@ -238,17 +248,7 @@ func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *
 				continue
 			}
 
-			points := models.NewPoints()
-			it := values.NewIterator()
-			for it.HasNext() {
-				slot, val := it.Next()
-				if math.IsNaN(val) {
-					// TODO: need check
-					continue
-				}
-				points.AddPoint(timeutil.CalcTimestamp(mq.stmtQuery.TimeRange.Start, slot, mq.stmtQuery.Interval), val)
-			}
-			timeSeries.AddField(fieldName, points)
+			timeSeries.AddField(fieldName, mq.makePoints(values))
 			fieldsMap[fieldName] = struct{}{}
 		}
 	}
@@ -262,8 +262,10 @@ func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *
 	for fName := range fieldsMap {
 		resultSet.Fields = append(resultSet.Fields, fName)
 	}
-	resultSet.StartTime = mq.stmtQuery.TimeRange.Start
-	resultSet.EndTime = mq.stmtQuery.TimeRange.End
+	// re-align time range of result set if query with offset
+	resultTimeRange := mq.stmtQuery.GetResultTimeRange()
+	resultSet.StartTime = resultTimeRange.Start + mq.stmtQuery.Offset
+	resultSet.EndTime = resultTimeRange.End + mq.stmtQuery.Offset
 	resultSet.Interval = mq.stmtQuery.Interval.Int64()
 
 	resultSet.Stats = event.Stats
@@ -285,3 +287,50 @@ func (mq *metricQuery) makeResultSet(event *series.TimeSeriesEvent) (resultSet *
 	}
 	return resultSet, nil
 }
+
+// makePoints makes the data points of field, fills the down sampling interval without data based on fill option,
+// timestamps of points are calculated from result time range and re-aligned by offset if query with offset.
+func (mq *metricQuery) makePoints(values *collections.FloatArray) *models.Points {
+	queryStmt := mq.stmtQuery
+	offset := queryStmt.Offset
+	timeRange := queryStmt.GetResultTimeRange()
+	points := models.NewPoints()
+	if queryStmt.Fill == stmt.NoFill {
+		it := values.NewIterator()
+		for it.HasNext() {
+			slot, val := it.Next()
+			if math.IsNaN(val) {
+				// TODO: need check
+				continue
+			}
+			points.AddPoint(timeutil.CalcTimestamp(timeRange.Start, slot, queryStmt.Interval)+offset, val)
+		}
+		return points
+	}
+	// fill all down sampling intervals in query time range, make dense time axis
+	previous := math.NaN()
+	for slot := 0; slot < values.Capacity(); slot++ {
+		timestamp := timeutil.CalcTimestamp(timeRange.Start, slot, queryStmt.Interval)
+		if timestamp > timeRange.End {
+			break
+		}
+		timestamp += offset
+		if values.HasValue(slot) {
+			if val := values.GetValue(slot); !math.IsNaN(val) {
+				previous = val
+				points.AddPoint(timestamp, val)
+				continue
+			}
+		}
+		switch queryStmt.Fill {
+		case stmt.NullFill:
+			points.AddPoint(timestamp, math.NaN())
+		case stmt.PreviousFill:
+			// if no previous value, fill with null
+			points.AddPoint(timestamp, previous)
+		case stmt.ValueFill:
+			points.AddPoint(timestamp, queryStmt.FillValue)
+		}
+	}
+	return points
+}
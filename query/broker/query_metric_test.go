@@ -141,6 +141,22 @@ func Test_MetricQuery(t *testing.T) {
 		},
 		{
 			name: "timeout",
+			prepare: func() context.Context {
+				stateMgr.EXPECT().GetDatabaseCfg("test_db").
+					Return(models.Database{Option: opt}, true)
+				stateMgr.EXPECT().GetQueryableReplicas("test_db").
+					Return(storageNodes, nil)
+				eventCh1 := make(chan *series.TimeSeriesEvent)
+				taskManager.EXPECT().SubmitMetricTask(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(eventCh1, nil)
+				ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*200)
+				time.AfterFunc(time.Second, cancel)
+				return ctx
+			},
+			wantErr: true,
+		},
+		{
+			name: "canceled",
 			prepare: func() context.Context {
 				stateMgr.EXPECT().GetDatabaseCfg("test_db").
 					Return(models.Database{Option: opt}, true)
@@ -231,11 +247,11 @@ func Test_MetricQuery(t *testing.T) {
 func Test_MetricQuery_makeResultSet(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
-		newExpressionFn = aggregation.NewExpression
+		newExpressionFn = aggregation.NewExpressionWithResultRange
 		ctrl.Finish()
 	}()
 	expression := aggregation.NewMockExpression(ctrl)
-	newExpressionFn = func(_ timeutil.TimeRange, _ int64, _ []stmt.Expr) aggregation.Expression {
+	newExpressionFn = func(_, _ timeutil.TimeRange, _ int64, _ []stmt.Expr) aggregation.Expression {
 		return expression
 	}
 	expression.EXPECT().Eval(gomock.Any()).AnyTimes()
@@ -257,6 +273,18 @@ func Test_MetricQuery_makeResultSet(t *testing.T) {
 				return timeSeries
 			},
 		},
+		{
+			name: "filter by having",
+			prepare: func(query *stmt.Query) series.GroupedIterator {
+				query.Having = &stmt.BinaryExpr{
+					Left:     &stmt.FieldExpr{Name: "f1"},
+					Operator: stmt.GREATER,
+					Right:    &stmt.NumberLiteral{Val: 10},
+				}
+				expression.EXPECT().Filter(gomock.Any()).Return(false).Times(2)
+				return timeSeries
+			},
+		},
 		{
 			name: "group values not match",
 			prepare: func(_ *stmt.Query) series.GroupedIterator {
@@ -372,3 +400,79 @@ func Test_MetricQuery_makeResultSet(t *testing.T) {
 		})
 	}
 }
+
+func Test_MetricQuery_makePoints(t *testing.T) {
+	values := collections.NewFloatArray(5)
+	values.SetValue(1, 1.0)
+	values.SetValue(2, math.NaN())
+	values.SetValue(3, 3.0)
+
+	cases := []struct {
+		name   string
+		fill   stmt.FillOption
+		points map[int64]float64
+	}{
+		{
+			name:   "no fill",
+			fill:   stmt.NoFill,
+			points: map[int64]float64{10: 1, 30: 3},
+		},
+		{
+			name:   "fill with null",
+			fill:   stmt.NullFill,
+			points: map[int64]float64{0: math.NaN(), 10: 1, 20: math.NaN(), 30: 3},
+		},
+		{
+			name:   "fill with previous",
+			fill:   stmt.PreviousFill,
+			points: map[int64]float64{0: math.NaN(), 10: 1, 20: 1, 30: 3},
+		},
+		{
+			name:   "fill with value",
+			fill:   stmt.ValueFill,
+			points: map[int64]float64{0: 100, 10: 1, 20: 100, 30: 3},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			qry := &metricQuery{
+				stmtQuery: &stmt.Query{
+					Interval:  timeutil.Interval(10),
+					TimeRange: timeutil.TimeRange{Start: 0, End: 30},
+					Fill:      tt.fill,
+					FillValue: 100,
+				},
+			}
+			points := qry.makePoints(values)
+			assert.Len(t, points.Points, len(tt.points))
+			for timestamp, value := range tt.points {
+				v, ok := points.Points[timestamp]
+				assert.True(t, ok)
+				if math.IsNaN(value) {
+					assert.True(t, math.IsNaN(v))
+				} else {
+					assert.Equal(t, value, v)
+				}
+			}
+			// re-align timestamps with offset
+			qry.stmtQuery.Offset = 100
+			points = qry.makePoints(values)
+			assert.Len(t, points.Points, len(tt.points))
+			for timestamp := range tt.points {
+				_, ok := points.Points[timestamp+100]
+				assert.True(t, ok)
+			}
+			// time range widened by timeshift, timestamps are based on result time range
+			qry.stmtQuery.Offset = 0
+			qry.stmtQuery.ResultTimeRange = timeutil.TimeRange{Start: 1000, End: 1030}
+			qry.stmtQuery.TimeRange = timeutil.TimeRange{Start: 0, End: 1030}
+			points = qry.makePoints(values)
+			assert.Len(t, points.Points, len(tt.points))
+			for timestamp := range tt.points {
+				_, ok := points.Points[timestamp+1000]
+				assert.True(t, ok)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@
 package brokerquery
 
 import (
+	"context"
 	"sync"
 
 	"github.com/google/uuid"
@@ -32,10 +33,13 @@ var (
 
 // RequestManager represents the request manager which store lin query reuqest.
 type RequestManager interface {
-	// NewRequest creates a new request and returns request id.
-	NewRequest(req *models.Request) string
+	// NewRequest creates a new request and returns request id,
+	// cancel func is used for canceling the request.
+	NewRequest(req *models.Request, cancel context.CancelFunc) string
 	// CompleteRequest completes a request by given request id.
 	CompleteRequest(requestID string)
+	// CancelRequest cancels an alive request by given request id, returns false if request not exist.
+	CancelRequest(requestID string) bool
 	// GetAliveRequests returns all alive request.
 	GetAliveRequests() []*models.Request
 }
@@ -51,9 +55,15 @@ func GetRequestManager() RequestManager {
 	return rManager
 }
 
+// aliveRequest represents the alive request with cancel func.
+type aliveRequest struct {
+	req    *models.Request
+	cancel context.CancelFunc
+}
+
 // requestManager implements RequestManager interface.
 type requestManager struct {
-	requests map[string]*models.Request
+	requests map[string]*aliveRequest
 
 	mutex sync.RWMutex
 }
@@ -61,19 +71,22 @@ type requestManager struct {
 // newRequestManager creates a RequestManager instance.
 func newRequestManager() RequestManager {
 	return &requestManager{
-		requests: make(map[string]*models.Request),
+		requests: make(map[string]*aliveRequest),
 	}
 }
 
 // NewRequest creates a new request and returns request id.
-func (r *requestManager) NewRequest(req *models.Request) string {
+func (r *requestManager) NewRequest(req *models.Request, cancel context.CancelFunc) string {
 	requestID := uuid.New().String()
 	req.RequestID = requestID
 
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
-	r.requests[requestID] = req
+	r.requests[requestID] = &aliveRequest{
+		req:    req,
+		cancel: cancel,
+	}
 	return requestID
 }
 
@@ -85,13 +98,28 @@ func (r *requestManager) CompleteRequest(requestID string) {
 	delete(r.requests, requestID)
 }
 
+// CancelRequest cancels an alive request by given request id, returns false if request not exist.
+func (r *requestManager) CancelRequest(requestID string) bool {
+	r.mutex.RLock()
+	req, ok := r.requests[requestID]
+	r.mutex.RUnlock()
+
+	if !ok {
+		return false
+	}
+	if req.cancel != nil {
+		req.cancel()
+	}
+	return true
+}
+
 // GetAliveRequests returns all alive request.
 func (r *requestManager) GetAliveRequests() (rs []*models.Request) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
 	for _, v := range r.requests {
-		rs = append(rs, v)
+		rs = append(rs, v.req)
 	}
 	return
 }
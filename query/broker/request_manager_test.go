@@ -18,6 +18,7 @@
 package brokerquery
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -34,9 +35,22 @@ func TestRequestManager(t *testing.T) {
 	mgr := newRequestManager()
 	assert.Empty(t, mgr.GetAliveRequests())
 
-	req := mgr.NewRequest(&models.Request{})
+	req := mgr.NewRequest(&models.Request{}, nil)
 	assert.Len(t, mgr.GetAliveRequests(), 1)
 
 	mgr.CompleteRequest(req)
 	assert.Empty(t, mgr.GetAliveRequests())
 }
+
+func TestRequestManager_CancelRequest(t *testing.T) {
+	mgr := newRequestManager()
+	assert.False(t, mgr.CancelRequest("not-exist"))
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	req := mgr.NewRequest(&models.Request{}, cancel)
+	assert.True(t, mgr.CancelRequest(req))
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+
+	mgr.CompleteRequest(req)
+	assert.False(t, mgr.CancelRequest(req))
+}
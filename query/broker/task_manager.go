@@ -285,8 +285,37 @@ func (t *taskManager) SubmitMetricTask(
 
 	if sendError.Load() != nil {
 		t.evictTask(rootTaskID)
+		return responseCh, sendError.Load()
+	}
+	if requestID != "" {
+		go t.cancelTaskOnDone(ctx, rootTaskID, requestID, physicalPlan.Leaves)
+	}
+	return responseCh, nil
+}
+
+// cancelTaskOnDone waits the context done(killed/timeout), if the task is still alive,
+// evicts the task and sends cancel request to leaf nodes, so that leaf nodes abort the execution of query.
+// intermediate nodes will complete after receiving the responses of canceled leaf nodes.
+func (t *taskManager) cancelTaskOnDone(ctx context.Context, taskID, requestID string, leaves []*models.Leaf) {
+	<-ctx.Done()
+	if t.Get(taskID) == nil {
+		// task completed
+		return
+	}
+	t.evictTask(taskID)
+	t.statistics.CanceledTasks.Incr()
+	req := &protoCommonV1.TaskRequest{
+		RequestID:    requestID,
+		ParentTaskID: taskID,
+		Type:         protoCommonV1.TaskType_Leaf,
+		RequestType:  protoCommonV1.RequestType_Cancel,
+	}
+	for _, leaf := range leaves {
+		if err := t.SendRequest(leaf.Indicator, req); err != nil {
+			t.logger.Warn("send cancel task request failure",
+				logger.String("requestID", requestID), logger.String("target", leaf.Indicator), logger.Error(err))
+		}
 	}
-	return responseCh, sendError.Load()
 }
 
 func (t *taskManager) SubmitIntermediateMetricTask(
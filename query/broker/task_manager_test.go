@@ -214,3 +214,46 @@ func TestTaskManager_cleaner(t *testing.T) {
 	tm.tasks.Store("1", task)
 	time.Sleep(time.Second)
 }
+
+func TestTaskManager_cancelTaskOnDone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskClientFactory := rpc.NewMockTaskClientFactory(ctrl)
+	tm := NewTaskManager(
+		context.Background(),
+		&models.StatelessNode{},
+		taskClientFactory,
+		nil,
+		concurrent.NewPool(
+			"p",
+			10,
+			time.Minute,
+			metrics.NewConcurrentStatistics("test", linmetric.BrokerRegistry),
+		),
+		time.Second*10,
+	).(*taskManager)
+	leaves := []*models.Leaf{
+		{BaseNode: models.BaseNode{Indicator: "1.1.1.1:9000"}},
+		{BaseNode: models.BaseNode{Indicator: "1.1.1.2:9000"}},
+	}
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	// task completed
+	tm.cancelTaskOnDone(ctx, "task-1", "req", leaves)
+
+	// task alive, send cancel request to leaves
+	tm.storeTask("task-1", NewMockTaskContext(ctrl))
+	client := protoCommonV1.NewMockTaskService_HandleClient(ctrl)
+	taskClientFactory.EXPECT().GetTaskClient("1.1.1.1:9000").Return(client)
+	taskClientFactory.EXPECT().GetTaskClient("1.1.1.2:9000").Return(nil)
+	client.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *protoCommonV1.TaskRequest) error {
+		assert.Equal(t, protoCommonV1.RequestType_Cancel, req.RequestType)
+		assert.Equal(t, "req", req.RequestID)
+		assert.Equal(t, "task-1", req.ParentTaskID)
+		return nil
+	})
+	tm.cancelTaskOnDone(ctx, "task-1", "req", leaves)
+	assert.Nil(t, tm.Get("task-1"))
+}
@@ -34,8 +34,9 @@ type LeafMetadataContext struct {
 
 	StorageExecuteCtx *flow.StorageExecuteContext
 
-	ResultSet []string
-	TagKeyID  tag.KeyID // for tag values suggest
+	ResultSet   []string
+	Cardinality map[string]uint64 // for metric/tag cardinality
+	TagKeyID    tag.KeyID         // for tag values suggest
 
 	Limit int
 }
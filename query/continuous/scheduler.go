@@ -0,0 +1,377 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package continuous
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	commonconstants "github.com/lindb/common/constants"
+	"github.com/lindb/common/proto/gen/v1/flatMetricsV1"
+	commonseries "github.com/lindb/common/series"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/pkg/timeutil"
+	brokerquery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/replica"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/sql"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+//go:generate mockgen -source=./scheduler.go -destination=./scheduler_mock.go -package=continuous
+
+var (
+	// for testing
+	checkInterval = 10 * time.Second
+	// maxCatchUpWindows represents the max windows computed by one run, older windows are skipped
+	// if the continuous query is not executed for a long time(no master etc.).
+	maxCatchUpWindows int64 = 60
+)
+
+// Scheduler represents the continuous query scheduler, which runs on the broker.
+// Only the broker which is master executes continuous queries, so that each window is computed by one broker.
+type Scheduler interface {
+	// Start starts the scheduler in background.
+	Start()
+	// Stop stops the scheduler.
+	Stop()
+}
+
+// scheduler implements Scheduler interface.
+type scheduler struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	node         models.Node
+	repo         state.Repository
+	master       coordinator.MasterController
+	queryFactory brokerquery.Factory
+	cm           replica.ChannelManager
+	timeout      time.Duration
+
+	statistics *metrics.ContinuousQueryStatistics
+	logger     *logger.Logger
+}
+
+// NewScheduler creates the continuous query scheduler.
+func NewScheduler(
+	ctx context.Context,
+	node models.Node,
+	repo state.Repository,
+	master coordinator.MasterController,
+	queryFactory brokerquery.Factory,
+	cm replica.ChannelManager,
+	timeout time.Duration,
+) Scheduler {
+	c, cancel := context.WithCancel(ctx)
+	return &scheduler{
+		ctx:          c,
+		cancel:       cancel,
+		node:         node,
+		repo:         repo,
+		master:       master,
+		queryFactory: queryFactory,
+		cm:           cm,
+		timeout:      timeout,
+		statistics:   metrics.NewContinuousQueryStatistics(),
+		logger:       logger.GetLogger("Broker", "ContinuousQuery"),
+	}
+}
+
+// Start starts the scheduler in background.
+func (s *scheduler) Start() {
+	go s.run()
+}
+
+// Stop stops the scheduler.
+func (s *scheduler) Stop() {
+	s.cancel()
+}
+
+// run checks and executes the continuous queries periodically.
+func (s *scheduler) run() {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+			if s.master.IsMaster() {
+				s.schedule(timeutil.Now())
+			}
+		}
+	}
+}
+
+// schedule executes all continuous queries which have completed window.
+func (s *scheduler) schedule(now int64) {
+	kvs, err := s.repo.List(s.ctx, constants.ContinuousQueryPath)
+	if err != nil {
+		s.logger.Error("list continuous queries failure", logger.Error(err))
+		return
+	}
+	for _, kv := range kvs {
+		if s.ctx.Err() != nil {
+			return
+		}
+		cq := &models.ContinuousQuery{}
+		if err := encoding.JSONUnmarshal(kv.Value, cq); err != nil {
+			s.logger.Warn("unmarshal continuous query failure", logger.String("key", kv.Key), logger.Error(err))
+			continue
+		}
+		s.scheduleQuery(cq, now)
+	}
+}
+
+// scheduleQuery executes the continuous query if it has completed window, then saves the execution state.
+func (s *scheduler) scheduleQuery(cq *models.ContinuousQuery, now int64) {
+	if err := cq.Validate(); err != nil {
+		s.logger.Warn("continuous query is invalid", logger.String("name", cq.Name), logger.Error(err))
+		return
+	}
+	cqState, err := s.getState(cq.Name)
+	if err != nil {
+		s.logger.Error("get continuous query state failure", logger.String("name", cq.Name), logger.Error(err))
+		return
+	}
+	// validated, ignore error
+	interval, _ := cq.GetInterval()
+	delay, _ := cq.GetDelay()
+	lateness, _ := cq.GetLateness()
+	start, end, ok := nextWindow(cqState.Watermark, interval, delay, lateness, now)
+	if !ok {
+		return
+	}
+	rows, err := s.execute(cq, interval, start, end)
+	cqState.LastRunTime = now
+	if err != nil {
+		s.statistics.RunFailures.Incr()
+		s.logger.Error("execute continuous query failure",
+			logger.String("name", cq.Name), logger.Int64("start", start), logger.Int64("end", end), logger.Error(err))
+		cqState.LastError = err.Error()
+		cqState.FailureCount++
+	} else {
+		s.statistics.Runs.Incr()
+		s.statistics.WrittenRows.Add(float64(rows))
+		// window completed, move watermark forward
+		cqState.Watermark = end
+		cqState.LastRows = rows
+		cqState.LastError = ""
+		cqState.FailureCount = 0
+	}
+	if err := s.repo.Put(s.ctx, constants.GetContinuousQueryStatePath(cq.Name), encoding.JSONMarshal(cqState)); err != nil {
+		s.logger.Error("save continuous query state failure", logger.String("name", cq.Name), logger.Error(err))
+	}
+}
+
+// execute executes the aggregation query of window [start, end), then writes the result into target metric,
+// returns the number of rows written.
+func (s *scheduler) execute(cq *models.ContinuousQuery, interval, start, end int64) (int, error) {
+	query, err := ParseQuery(cq.SQL)
+	if err != nil {
+		return 0, err
+	}
+	query.TimeRange = timeutil.TimeRange{Start: start, End: end - 1}
+	query.Interval = timeutil.Interval(interval)
+	if len(query.OrderByItems) == 0 {
+		// all grouped series are written into target metric,
+		// order by must set limit explicitly(checked when parse), keep it for top n.
+		query.Limit = math.MaxInt32
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, s.timeout)
+	defer cancel()
+
+	// track request, so that the query of continuous query can be found
+	req := &models.Request{
+		DB:    cq.Database,
+		SQL:   cq.SQL,
+		Start: time.Now().UnixNano(),
+	}
+	reqID := brokerquery.GetRequestManager().NewRequest(req, cancel)
+	defer brokerquery.GetRequestManager().CompleteRequest(reqID)
+
+	resultSet, err := s.queryFactory.NewMetricQuery(context.WithValue(ctx, constants.ContextKeySQL, req),
+		s.node, cq.Database, query).WaitResponse()
+	if err != nil {
+		return 0, err
+	}
+	namespace := cq.TargetNamespace
+	if namespace == "" {
+		namespace = query.Namespace
+	}
+	if namespace == "" {
+		namespace = commonconstants.DefaultNamespace
+	}
+	batch, err := buildRows(resultSet, namespace, cq.TargetMetric, start, end)
+	if err != nil {
+		return 0, err
+	}
+	if batch.Len() == 0 {
+		return 0, nil
+	}
+	if err := s.cm.Write(ctx, cq.GetTargetDatabase(), batch); err != nil {
+		return 0, err
+	}
+	return batch.Len(), nil
+}
+
+// getState returns the execution state of continuous query, returns new state if not exist.
+func (s *scheduler) getState(name string) (*models.ContinuousQueryState, error) {
+	data, err := s.repo.Get(s.ctx, constants.GetContinuousQueryStatePath(name))
+	if err != nil {
+		if errors.Is(err, state.ErrNotExist) {
+			return &models.ContinuousQueryState{Name: name}, nil
+		}
+		return nil, err
+	}
+	cqState := &models.ContinuousQueryState{}
+	if err := encoding.JSONUnmarshal(data, cqState); err != nil {
+		return nil, err
+	}
+	return cqState, nil
+}
+
+// ParseQuery parses the sql of continuous query, only metric query is supported.
+func ParseQuery(sqlStr string) (*stmtpkg.Query, error) {
+	stmt, err := sql.Parse(sqlStr)
+	if err != nil {
+		return nil, err
+	}
+	query, ok := stmt.(*stmtpkg.Query)
+	if !ok || query.Explain {
+		return nil, fmt.Errorf("continuous query only supports metric query")
+	}
+	if len(query.OrderByItems) > 0 && !query.ExplicitLimit {
+		// parser sets default limit if limit not set, groups will be truncated silently
+		return nil, fmt.Errorf("continuous query with order by must set limit")
+	}
+	return query, nil
+}
+
+// nextWindow returns the window [start, end) which need to be computed, returns false if no completed window.
+// 1. end = now - delay, aligned by interval;
+// 2. start = watermark - lateness, aligned by interval, so that the late data is included;
+// 3. first run only computes the last window, and at most maxCatchUpWindows windows computed by one run.
+func nextWindow(watermark, interval, delay, lateness, now int64) (start, end int64, ok bool) {
+	end = (now - delay) / interval * interval
+	if end <= watermark {
+		return 0, 0, false
+	}
+	switch {
+	case watermark <= 0:
+		start = end - interval
+	case end-watermark > maxCatchUpWindows*interval:
+		start = end - maxCatchUpWindows*interval
+	default:
+		start = (watermark - lateness) / interval * interval
+	}
+	return start, end, true
+}
+
+// buildRows converts the series of query result to rows of target metric,
+// group by tags are tags of row, all fields are last value field(re-computed window overwrites old value).
+func buildRows(resultSet *models.ResultSet, namespace, metricName string, start, end int64) (*metric.BrokerBatchRows, error) {
+	batch := metric.NewBrokerBatchRows()
+	if resultSet == nil {
+		return batch, nil
+	}
+	rowBuilder, releaseFunc := commonseries.NewRowBuilder()
+	defer releaseFunc(rowBuilder)
+
+	for _, series := range resultSet.Series {
+		if series == nil {
+			continue
+		}
+		// collect all timestamps of series
+		var timestamps []int64
+		seen := make(map[int64]struct{})
+		for _, points := range series.Fields {
+			for timestamp := range points {
+				if timestamp < start || timestamp >= end {
+					continue
+				}
+				if _, ok := seen[timestamp]; !ok {
+					seen[timestamp] = struct{}{}
+					timestamps = append(timestamps, timestamp)
+				}
+			}
+		}
+		sort.Slice(timestamps, func(i, j int) bool {
+			return timestamps[i] < timestamps[j]
+		})
+		fieldNames := make([]string, 0, len(series.Fields))
+		for fieldName := range series.Fields {
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+
+		for _, timestamp := range timestamps {
+			rowBuilder.Reset()
+			rowBuilder.AddNameSpace(strutil.String2ByteSlice(namespace))
+			rowBuilder.AddMetricName(strutil.String2ByteSlice(metricName))
+			rowBuilder.AddTimestamp(timestamp)
+			for tagKey, tagValue := range series.Tags {
+				if tagValue == "" {
+					continue
+				}
+				if err := rowBuilder.AddTag(strutil.String2ByteSlice(tagKey), strutil.String2ByteSlice(tagValue)); err != nil {
+					return nil, err
+				}
+			}
+			fields := 0
+			for _, fieldName := range fieldNames {
+				value, ok := series.Fields[fieldName][timestamp]
+				if !ok || math.IsNaN(value) || math.IsInf(value, 0) {
+					continue
+				}
+				if err := rowBuilder.AddSimpleField(
+					strutil.String2ByteSlice(fieldName), flatMetricsV1.SimpleFieldTypeLast, value); err != nil {
+					return nil, err
+				}
+				fields++
+			}
+			if fields == 0 {
+				continue
+			}
+			if err := batch.TryAppend(func(row *metric.BrokerRow) error {
+				data, err := rowBuilder.Build()
+				if err != nil {
+					return err
+				}
+				row.FromBlock(data)
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return batch, nil
+}
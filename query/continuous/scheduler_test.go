@@ -0,0 +1,336 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package continuous
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/coordinator"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/state"
+	"github.com/lindb/lindb/pkg/timeutil"
+	brokerquery "github.com/lindb/lindb/query/broker"
+	"github.com/lindb/lindb/replica"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+)
+
+func TestScheduler_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		checkInterval = 10 * time.Second
+		ctrl.Finish()
+	}()
+	checkInterval = 10 * time.Millisecond
+	master := coordinator.NewMockMasterController(ctrl)
+	repo := state.NewMockRepository(ctrl)
+	s := NewScheduler(context.TODO(), &models.StatelessNode{}, repo, master, nil, nil, time.Second)
+	master.EXPECT().IsMaster().Return(false).AnyTimes()
+	s.Start()
+	time.Sleep(50 * time.Millisecond)
+	s.Stop()
+	time.Sleep(20 * time.Millisecond)
+}
+
+func TestScheduler_Schedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := 10*timeutil.OneMinute + 40*timeutil.OneSecond
+	cq := &models.ContinuousQuery{
+		Name:         "cq",
+		Database:     "db",
+		SQL:          "select sum(f) as f from cpu group by service",
+		TargetMetric: "cpu_by_service",
+		Interval:     "1m",
+		Delay:        "30s",
+		Lateness:     "2m",
+	}
+	cases := []struct {
+		name    string
+		prepare func(repo *state.MockRepository, factory *brokerquery.MockFactory,
+			query *brokerquery.MockMetricQuery, cm *replica.MockChannelManager)
+	}{
+		{
+			name: "list continuous query failure",
+			prepare: func(repo *state.MockRepository, _ *brokerquery.MockFactory,
+				_ *brokerquery.MockMetricQuery, _ *replica.MockChannelManager) {
+				repo.EXPECT().List(gomock.Any(), constants.ContinuousQueryPath).Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "unmarshal continuous query failure",
+			prepare: func(repo *state.MockRepository, _ *brokerquery.MockFactory,
+				_ *brokerquery.MockMetricQuery, _ *replica.MockChannelManager) {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{{Key: "cq", Value: []byte("abc")}}, nil)
+			},
+		},
+		{
+			name: "continuous query invalid",
+			prepare: func(repo *state.MockRepository, _ *brokerquery.MockFactory,
+				_ *brokerquery.MockMetricQuery, _ *replica.MockChannelManager) {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{
+					{Key: "cq", Value: encoding.JSONMarshal(&models.ContinuousQuery{Name: "cq"})}}, nil)
+			},
+		},
+		{
+			name: "get state failure",
+			prepare: func(repo *state.MockRepository, _ *brokerquery.MockFactory,
+				_ *brokerquery.MockMetricQuery, _ *replica.MockChannelManager) {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{{Key: "cq", Value: encoding.JSONMarshal(cq)}}, nil)
+				repo.EXPECT().Get(gomock.Any(), constants.GetContinuousQueryStatePath("cq")).Return(nil, fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "unmarshal state failure",
+			prepare: func(repo *state.MockRepository, _ *brokerquery.MockFactory,
+				_ *brokerquery.MockMetricQuery, _ *replica.MockChannelManager) {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{{Key: "cq", Value: encoding.JSONMarshal(cq)}}, nil)
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return([]byte("abc"), nil)
+			},
+		},
+		{
+			name: "window not completed",
+			prepare: func(repo *state.MockRepository, _ *brokerquery.MockFactory,
+				_ *brokerquery.MockMetricQuery, _ *replica.MockChannelManager) {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{{Key: "cq", Value: encoding.JSONMarshal(cq)}}, nil)
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+					encoding.JSONMarshal(&models.ContinuousQueryState{Name: "cq", Watermark: 10 * timeutil.OneMinute}), nil)
+			},
+		},
+		{
+			name: "query failure, keep watermark",
+			prepare: func(repo *state.MockRepository, factory *brokerquery.MockFactory,
+				query *brokerquery.MockMetricQuery, _ *replica.MockChannelManager) {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{{Key: "cq", Value: encoding.JSONMarshal(cq)}}, nil)
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+					encoding.JSONMarshal(&models.ContinuousQueryState{Name: "cq", Watermark: 9 * timeutil.OneMinute}), nil)
+				factory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), "db", gomock.Any()).Return(query)
+				query.EXPECT().WaitResponse().Return(nil, fmt.Errorf("err"))
+				repo.EXPECT().Put(gomock.Any(), constants.GetContinuousQueryStatePath("cq"), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						cqState := &models.ContinuousQueryState{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, cqState))
+						assert.Equal(t, 9*timeutil.OneMinute, cqState.Watermark)
+						assert.Equal(t, "err", cqState.LastError)
+						assert.Equal(t, int64(1), cqState.FailureCount)
+						return fmt.Errorf("err")
+					})
+			},
+		},
+		{
+			name: "write failure",
+			prepare: func(repo *state.MockRepository, factory *brokerquery.MockFactory,
+				query *brokerquery.MockMetricQuery, cm *replica.MockChannelManager) {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{{Key: "cq", Value: encoding.JSONMarshal(cq)}}, nil)
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, state.ErrNotExist)
+				factory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(query)
+				query.EXPECT().WaitResponse().Return(&models.ResultSet{Series: []*models.Series{{
+					Tags:   map[string]string{"service": "a"},
+					Fields: map[string]map[int64]float64{"f": {9 * timeutil.OneMinute: 1}},
+				}}}, nil)
+				cm.EXPECT().Write(gomock.Any(), "db", gomock.Any()).Return(fmt.Errorf("err"))
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "execute successfully, re-compute late windows",
+			prepare: func(repo *state.MockRepository, factory *brokerquery.MockFactory,
+				query *brokerquery.MockMetricQuery, cm *replica.MockChannelManager) {
+				repo.EXPECT().List(gomock.Any(), gomock.Any()).Return([]state.KeyValue{{Key: "cq", Value: encoding.JSONMarshal(cq)}}, nil)
+				repo.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+					encoding.JSONMarshal(&models.ContinuousQueryState{Name: "cq", Watermark: 9 * timeutil.OneMinute}), nil)
+				factory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ models.Node, _ string, q *stmtpkg.Query) brokerquery.MetricQuery {
+						assert.Equal(t, timeutil.TimeRange{Start: 7 * timeutil.OneMinute, End: 10*timeutil.OneMinute - 1}, q.TimeRange)
+						assert.Equal(t, timeutil.Interval(timeutil.OneMinute), q.Interval)
+						return query
+					})
+				query.EXPECT().WaitResponse().Return(&models.ResultSet{Series: []*models.Series{{
+					Tags: map[string]string{"service": "a"},
+					Fields: map[string]map[int64]float64{"f": {
+						7 * timeutil.OneMinute:  1,
+						8 * timeutil.OneMinute:  2,
+						10 * timeutil.OneMinute: 3, // out of window
+					}},
+				}}}, nil)
+				cm.EXPECT().Write(gomock.Any(), "db", gomock.Any()).Return(nil)
+				repo.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, data []byte) error {
+						cqState := &models.ContinuousQueryState{}
+						assert.NoError(t, encoding.JSONUnmarshal(data, cqState))
+						assert.Equal(t, 10*timeutil.OneMinute, cqState.Watermark)
+						assert.Equal(t, 2, cqState.LastRows)
+						assert.Empty(t, cqState.LastError)
+						return nil
+					})
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			repo := state.NewMockRepository(ctrl)
+			factory := brokerquery.NewMockFactory(ctrl)
+			query := brokerquery.NewMockMetricQuery(ctrl)
+			cm := replica.NewMockChannelManager(ctrl)
+			tt.prepare(repo, factory, query, cm)
+			s := NewScheduler(context.TODO(), &models.StatelessNode{}, repo, nil, factory, cm, time.Second)
+			s.(*scheduler).schedule(now)
+		})
+	}
+}
+
+func TestScheduler_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	factory := brokerquery.NewMockFactory(ctrl)
+	query := brokerquery.NewMockMetricQuery(ctrl)
+	cm := replica.NewMockChannelManager(ctrl)
+	s := NewScheduler(context.TODO(), &models.StatelessNode{}, nil, nil, factory, cm, time.Second).(*scheduler)
+
+	// parse sql failure
+	_, err := s.execute(&models.ContinuousQuery{SQL: "select"}, timeutil.OneMinute, 0, timeutil.OneMinute)
+	assert.Error(t, err)
+	// order by without limit
+	_, err = s.execute(&models.ContinuousQuery{SQL: "select sum(f) as f from cpu group by host order by f"},
+		timeutil.OneMinute, 0, timeutil.OneMinute)
+	assert.Error(t, err)
+	// no rows, all groups are written
+	factory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ models.Node, _ string, q *stmtpkg.Query) brokerquery.MetricQuery {
+			assert.Equal(t, math.MaxInt32, q.Limit)
+			return query
+		})
+	query.EXPECT().WaitResponse().Return(&models.ResultSet{}, nil)
+	rows, err := s.execute(&models.ContinuousQuery{SQL: "select f from cpu"}, timeutil.OneMinute, 0, timeutil.OneMinute)
+	assert.NoError(t, err)
+	assert.Zero(t, rows)
+	// write into target database/namespace, keep limit if order by
+	factory.EXPECT().NewMetricQuery(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ models.Node, _ string, q *stmtpkg.Query) brokerquery.MetricQuery {
+			assert.Equal(t, 10, q.Limit)
+			return query
+		})
+	query.EXPECT().WaitResponse().Return(&models.ResultSet{Series: []*models.Series{{
+		Fields: map[string]map[int64]float64{"f": {0: 1}},
+	}}}, nil)
+	cm.EXPECT().Write(gomock.Any(), "target", gomock.Any()).Return(nil)
+	rows, err = s.execute(&models.ContinuousQuery{
+		Database:        "db",
+		SQL:             "select sum(f) as f from cpu group by host order by f desc limit 10",
+		TargetDatabase:  "target",
+		TargetNamespace: "ns",
+		TargetMetric:    "top_host",
+	}, timeutil.OneMinute, 0, timeutil.OneMinute)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, rows)
+}
+
+func TestParseQuery(t *testing.T) {
+	_, err := ParseQuery("show databases")
+	assert.Error(t, err)
+	_, err = ParseQuery("explain select f from cpu")
+	assert.Error(t, err)
+	q, err := ParseQuery("select f from cpu")
+	assert.NoError(t, err)
+	assert.Equal(t, "cpu", q.MetricName)
+	// order by without limit
+	_, err = ParseQuery("select sum(f) as f from cpu group by host order by f desc")
+	assert.Error(t, err)
+	_, err = ParseQuery("select sum(f) as f from cpu where host='limit' group by host order by f desc")
+	assert.Error(t, err)
+	// identifier named limit isn't limit clause
+	_, err = ParseQuery("select sum(limit) as f from cpu group by host order by f desc")
+	assert.Error(t, err)
+	_, err = ParseQuery("select sum(f) as limit from cpu group by limit order by limit desc")
+	assert.Error(t, err)
+	q, err = ParseQuery("select sum(f) as limit from cpu group by limit order by limit desc limit 5")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, q.Limit)
+	q, err = ParseQuery("select sum(f) as f from cpu group by host order by f desc LIMIT 20")
+	assert.NoError(t, err)
+	assert.Equal(t, 20, q.Limit)
+}
+
+func TestNextWindow(t *testing.T) {
+	defer func() {
+		maxCatchUpWindows = 60
+	}()
+	maxCatchUpWindows = 5
+	minute := timeutil.OneMinute
+	cases := []struct {
+		name       string
+		watermark  int64
+		lateness   int64
+		now        int64
+		start, end int64
+		ok         bool
+	}{
+		{name: "first run", now: 10*minute + 40*timeutil.OneSecond, start: 9 * minute, end: 10 * minute, ok: true},
+		{name: "window not completed", watermark: 10 * minute, now: 10*minute + 50*timeutil.OneSecond},
+		{name: "next window", watermark: 9 * minute, now: 10*minute + 40*timeutil.OneSecond, start: 9 * minute, end: 10 * minute, ok: true},
+		{name: "lateness", watermark: 9 * minute, lateness: 90 * timeutil.OneSecond, now: 10*minute + 40*timeutil.OneSecond,
+			start: 7 * minute, end: 10 * minute, ok: true},
+		{name: "catch up", watermark: minute, now: 20*minute + 40*timeutil.OneSecond, start: 15 * minute, end: 20 * minute, ok: true},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, ok := nextWindow(tt.watermark, minute, 30*timeutil.OneSecond, tt.lateness, tt.now)
+			assert.Equal(t, tt.ok, ok)
+			if ok {
+				assert.Equal(t, tt.start, start)
+				assert.Equal(t, tt.end, end)
+			}
+		})
+	}
+}
+
+func TestBuildRows(t *testing.T) {
+	batch, err := buildRows(nil, "ns", "m", 0, 10)
+	assert.NoError(t, err)
+	assert.Zero(t, batch.Len())
+
+	batch, err = buildRows(&models.ResultSet{Series: []*models.Series{
+		nil,
+		{
+			Tags: map[string]string{"service": "a", "empty": ""},
+			Fields: map[string]map[int64]float64{
+				"sum": {1: 1, 2: 2},
+				"max": {2: 3, 3: 1.0 / zero()},
+			},
+		},
+	}}, "ns", "m", 0, 10)
+	assert.NoError(t, err)
+	// timestamp 3 only has Inf value
+	assert.Equal(t, 2, batch.Len())
+}
+
+func zero() float64 {
+	return 0
+}
@@ -33,4 +33,6 @@ var (
 	ErrTaskSend                    = errors.New("send task request error")
 	ErrResponseSend                = errors.New("send response error")
 	ErrNoDatabase                  = errors.New("not found database")
+	ErrQueryCanceled               = errors.New("query canceled")
+	ErrOffsetNotAligned            = errors.New("offset must be multiple of query interval")
 )
@@ -100,6 +100,11 @@ func (p *leafTaskProcessor) process(
 	ctx *flow.TaskContext,
 	req *protoCommonV1.TaskRequest,
 ) error {
+	if req.RequestType == protoCommonV1.RequestType_Cancel {
+		// cancel request doesn't carry physical plan
+		p.processCancel(ctx, req)
+		return nil
+	}
 	physicalPlan := models.PhysicalPlan{}
 	if err := encoding.JSONUnmarshal(req.PhysicalPlan, &physicalPlan); err != nil {
 		return fmt.Errorf("%w: %s", ErrUnmarshalPlan, err)
@@ -169,7 +174,10 @@ func (p *leafTaskProcessor) processMetadataSuggest(
 			errMsg = err.Error()
 			p.statistics.MetaQueryFailures.Incr()
 		} else {
-			payload = encoding.JSONMarshal(&models.SuggestResult{Values: leafExecuteCtx.ResultSet})
+			payload = encoding.JSONMarshal(&models.SuggestResult{
+				Values:      leafExecuteCtx.ResultSet,
+				Cardinality: leafExecuteCtx.Cardinality,
+			})
 		}
 		// send result to upstream
 		if err := stream.Send(&protoCommonV1.TaskResponse{
@@ -190,6 +198,25 @@ func (p *leafTaskProcessor) processMetadataSuggest(
 	return nil
 }
 
+// processCancel cancels the executing pipeline of the task which is killed/timeout in root node,
+// cancels all pipelines of the request if task id not set.
+func (p *leafTaskProcessor) processCancel(ctx *flow.TaskContext, req *protoCommonV1.TaskRequest) {
+	defer ctx.Release()
+	var pipelines []Pipeline
+	if req.ParentTaskID == "" {
+		pipelines = GetPipelineManager().GetPipelines(req.RequestID)
+	} else if pipeline := GetPipelineManager().GetPipeline(req.RequestID, req.ParentTaskID); pipeline != nil {
+		pipelines = append(pipelines, pipeline)
+	}
+	// if pipeline not found, pipeline completed
+	for _, pipeline := range pipelines {
+		pipeline.Cancel(ErrQueryCanceled)
+		p.statistics.CanceledQuery.Incr()
+		p.logger.Info("cancel query pipeline",
+			logger.String("requestID", req.RequestID), logger.String("taskID", req.ParentTaskID))
+	}
+}
+
 // processDataSearch processes metric data search.
 func (p *leafTaskProcessor) processDataSearch(
 	ctx *flow.TaskContext,
@@ -208,12 +235,12 @@ func (p *leafTaskProcessor) processDataSearch(
 
 	pipeline := newExecutePipelineFn(tracker, func(err error) {
 		// remove pipeline from cache after execute completed
-		defer GetPipelineManager().RemovePipeline(req.RequestID)
+		defer GetPipelineManager().RemovePipeline(req.RequestID, req.ParentTaskID)
 
 		leafExecuteCtx.SendResponse(err)
 	})
 	// cache pipeline
-	GetPipelineManager().AddPipeline(req.RequestID, pipeline)
+	GetPipelineManager().AddPipeline(req.RequestID, req.ParentTaskID, pipeline)
 	pipeline.Execute(stage.NewMetadataLookupStage(leafExecuteCtx))
 	return nil
 }
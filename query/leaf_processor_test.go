@@ -220,6 +220,76 @@ func TestLeafProcessor_Process(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestLeafProcessor_Process_Cancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newExecutePipelineFn = NewExecutePipeline
+		ctrl.Finish()
+	}()
+
+	taskServerFactory := rpc.NewMockTaskServerFactory(ctrl)
+	engine := tsdb.NewMockEngine(ctrl)
+	currentNode := models.StatelessNode{HostIP: "1.1.1.3", GRPCPort: 8000}
+	processor := NewLeafTaskProcessor(&currentNode, engine, taskServerFactory)
+	cancelReq := func(taskID string) *protoCommonV1.TaskRequest {
+		return &protoCommonV1.TaskRequest{
+			RequestID:    "cancel-req",
+			ParentTaskID: taskID,
+			RequestType:  protoCommonV1.RequestType_Cancel,
+		}
+	}
+	// pipeline not found
+	processor.Process(flow.NewTaskContextWithTimeout(context.Background(), time.Second), nil, cancelReq("task-1"))
+
+	mockDatabase := tsdb.NewMockDatabase(ctrl)
+	engine.EXPECT().GetDatabase(gomock.Any()).Return(mockDatabase, true).AnyTimes()
+	serverStream := protoCommonV1.NewMockTaskService_HandleServer(ctrl)
+	serverStream.EXPECT().Send(gomock.Any()).Return(nil).AnyTimes()
+	taskServerFactory.EXPECT().GetStream(gomock.Any()).Return(serverStream).AnyTimes()
+	pipeline1 := NewMockPipeline(ctrl)
+	pipeline2 := NewMockPipeline(ctrl)
+	pipeline1.EXPECT().Execute(gomock.Any())
+	pipeline2.EXPECT().Execute(gomock.Any())
+	var completeCallbacks []func(err error)
+	pipelines := []Pipeline{pipeline1, pipeline2}
+	newExecutePipelineFn = func(_ *trackerpkg.StageTracker, completeCallback func(err error)) Pipeline {
+		completeCallbacks = append(completeCallbacks, completeCallback)
+		return pipelines[len(completeCallbacks)-1]
+	}
+	// sub queries of cross metric query run concurrently with same request id
+	plan := encoding.JSONMarshal(&models.PhysicalPlan{
+		Database: "test_db",
+		Leaves:   []*models.Leaf{{BaseNode: models.BaseNode{Indicator: "1.1.1.3:8000"}}},
+	})
+	for _, taskID := range []string{"task-1", "task-2"} {
+		processor.Process(flow.NewTaskContextWithTimeout(context.Background(), time.Second), nil,
+			&protoCommonV1.TaskRequest{
+				RequestID:    "cancel-req",
+				ParentTaskID: taskID,
+				PhysicalPlan: plan,
+				Payload:      encoding.JSONMarshal(&stmt.Query{MetricName: "cpu"}),
+			})
+	}
+	assert.Len(t, GetPipelineManager().GetPipelines("cancel-req"), 2)
+
+	// cancel each pipeline by task id
+	pipeline1.EXPECT().Cancel(ErrQueryCanceled)
+	processor.Process(flow.NewTaskContextWithTimeout(context.Background(), time.Second), nil, cancelReq("task-1"))
+	pipeline2.EXPECT().Cancel(ErrQueryCanceled)
+	processor.Process(flow.NewTaskContextWithTimeout(context.Background(), time.Second), nil, cancelReq("task-2"))
+	// cancel all pipelines of request if task id not set
+	pipeline1.EXPECT().Cancel(ErrQueryCanceled)
+	pipeline2.EXPECT().Cancel(ErrQueryCanceled)
+	processor.Process(flow.NewTaskContextWithTimeout(context.Background(), time.Second), nil, cancelReq(""))
+
+	// pipeline completed, only removes itself
+	completeCallbacks[0](ErrQueryCanceled)
+	assert.Nil(t, GetPipelineManager().GetPipeline("cancel-req", "task-1"))
+	assert.NotNil(t, GetPipelineManager().GetPipeline("cancel-req", "task-2"))
+	completeCallbacks[1](ErrQueryCanceled)
+	assert.Empty(t, GetPipelineManager().GetPipelines("cancel-req"))
+}
+
 func TestLeafTask_Suggest_Process(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
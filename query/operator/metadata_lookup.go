@@ -159,9 +159,17 @@ func (op *metadataLookup) field(parentFunc *stmt.CallExpr, expr stmt.Expr) {
 	case *stmt.SelectItem:
 		op.field(nil, e.Expr)
 	case *stmt.CallExpr:
-		if e.FuncType == function.Quantile {
+		switch e.FuncType {
+		case function.Quantile:
 			op.planHistogramFields(e)
 			return
+		case function.Percentile:
+			op.planPercentileField(e)
+			return
+		}
+		if function.IsTransform(e.FuncType) {
+			op.planTransformFunc(e)
+			return
 		}
 		for _, param := range e.Params {
 			op.field(e, param)
@@ -208,11 +216,65 @@ func (op *metadataLookup) field(parentFunc *stmt.CallExpr, expr stmt.Expr) {
 			funcType = parentFunc.FuncType
 			// TODO: ignore down sampling func?
 			aggregator.Aggregator.AddFunctionType(parentFunc.FuncType)
+			if fieldType == field.LastField && funcType == function.Sum {
+				// sum of last field sums the last value of each series,
+				// sum all points of one series in a time bucket will count a series multiple times.
+				funcType = function.Last
+			}
 		}
 		aggregator.DownSampling.AddFunctionType(funcType)
 	}
 }
 
+// planPercentileField plans the field of percentile function, e.g. percentile(field, 99).
+func (op *metadataLookup) planPercentileField(e *stmt.CallExpr) {
+	if len(e.Params) != 2 {
+		op.err = fmt.Errorf("percentile params must be field and percentile value")
+		return
+	}
+	if _, ok := e.Params[0].(*stmt.FieldExpr); !ok {
+		op.err = fmt.Errorf("percentile param: %s is not field", e.Params[0].Rewrite())
+		return
+	}
+	p, ok := e.Params[1].(*stmt.NumberLiteral)
+	if !ok {
+		op.err = fmt.Errorf("percentile param: %s is not float", e.Params[1].Rewrite())
+		return
+	}
+	if p.Val <= 0 || p.Val > 100 {
+		op.err = fmt.Errorf("percentile param: %f is illegal", p.Val)
+		return
+	}
+	op.field(e, e.Params[0])
+}
+
+// planTransformFunc plans the time series param of transform function, e.g. derivative(f), clamp_min(f, 0).
+func (op *metadataLookup) planTransformFunc(e *stmt.CallExpr) {
+	var argOK bool
+	switch e.FuncType {
+	case function.MovingAverage, function.ClampMin, function.ClampMax:
+		if len(e.Params) == 2 {
+			var arg *stmt.NumberLiteral
+			arg, argOK = e.Params[1].(*stmt.NumberLiteral)
+			if argOK && e.FuncType == function.MovingAverage {
+				argOK = arg.Val >= 1
+			}
+		}
+	case function.TimeShift:
+		if len(e.Params) == 2 {
+			_, argOK = e.Params[1].(*stmt.DurationLiteral)
+		}
+	default:
+		argOK = len(e.Params) == 1
+	}
+	if !argOK {
+		op.err = fmt.Errorf("invalid params for function[%s]", e.FuncType)
+		return
+	}
+	// time series param using field's default down sampling func
+	op.field(nil, e.Params[0])
+}
+
 func (op *metadataLookup) planHistogramFields(e *stmt.CallExpr) {
 	if len(e.Params) != 1 {
 		op.err = fmt.Errorf("qunantile params more than one")
@@ -165,6 +165,29 @@ func TestMetadataLookup_field(t *testing.T) {
 		})
 		assert.Error(t, op.err)
 	})
+	t.Run("sum of last field", func(t *testing.T) {
+		metaDB2 := metadb.NewMockMetadataDatabase(ctrl)
+		metaDB2.EXPECT().GetField(gomock.Any(), gomock.Any(), gomock.Any()).Return(field.Meta{
+			ID:   field.ID(10),
+			Type: field.LastField,
+			Name: "f",
+		}, nil)
+		op := &metadataLookup{
+			executeCtx: ctx,
+			metadata:   metaDB2,
+			fields:     make(map[field.ID]*aggregation.Aggregator),
+		}
+		op.field(nil, &stmtpkg.CallExpr{
+			FuncType: function.Sum,
+			Params:   []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "f"}},
+		})
+		assert.NoError(t, op.err)
+		// down sampling keeps last value of each series, then sums values of all series
+		_, ok := op.fields[10].DownSampling.Functions()[function.Last]
+		assert.True(t, ok)
+		_, ok = op.fields[10].Aggregator.Functions()[function.Sum]
+		assert.True(t, ok)
+	})
 
 	cases := []struct {
 		name    string
@@ -306,6 +329,176 @@ func TestMetadataLookup_planHistogramFields(t *testing.T) {
 	}
 }
 
+func TestMetadataLookup_planPercentileField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metaDB := metadb.NewMockMetadataDatabase(ctrl)
+	cases := []struct {
+		name    string
+		in      *stmtpkg.CallExpr
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name:    "invalid params",
+			in:      &stmtpkg.CallExpr{FuncType: function.Percentile, Params: []stmtpkg.Expr{&stmtpkg.FieldExpr{Name: "f"}}},
+			wantErr: true,
+		},
+		{
+			name: "first param not field",
+			in: &stmtpkg.CallExpr{FuncType: function.Percentile, Params: []stmtpkg.Expr{
+				&stmtpkg.NumberLiteral{Val: 1}, &stmtpkg.NumberLiteral{Val: 99},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "second param not number",
+			in: &stmtpkg.CallExpr{FuncType: function.Percentile, Params: []stmtpkg.Expr{
+				&stmtpkg.FieldExpr{Name: "f"}, &stmtpkg.FieldExpr{Name: "f"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "percentile out of range",
+			in: &stmtpkg.CallExpr{FuncType: function.Percentile, Params: []stmtpkg.Expr{
+				&stmtpkg.FieldExpr{Name: "f"}, &stmtpkg.NumberLiteral{Val: 100.1},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "field type not support",
+			in: &stmtpkg.CallExpr{FuncType: function.Percentile, Params: []stmtpkg.Expr{
+				&stmtpkg.FieldExpr{Name: "f"}, &stmtpkg.NumberLiteral{Val: 99},
+			}},
+			prepare: func() {
+				metaDB.EXPECT().GetField(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(field.Meta{ID: 10, Type: field.SumField, Name: "f"}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "plan field successfully",
+			in: &stmtpkg.CallExpr{FuncType: function.Percentile, Params: []stmtpkg.Expr{
+				&stmtpkg.FieldExpr{Name: "f"}, &stmtpkg.NumberLiteral{Val: 99},
+			}},
+			prepare: func() {
+				metaDB.EXPECT().GetField(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(field.Meta{ID: 10, Type: field.LastField, Name: "f"}, nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			op := &metadataLookup{
+				executeCtx: &flow.StorageExecuteContext{
+					Query: &stmtpkg.Query{},
+				},
+				metadata: metaDB,
+				fields:   make(map[field.ID]*aggregation.Aggregator),
+			}
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			op.field(nil, tt.in)
+			if (op.err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+			if !tt.wantErr {
+				_, ok := op.fields[10].DownSampling.Functions()[function.Percentile]
+				assert.True(t, ok)
+			}
+		})
+	}
+}
+
+func TestMetadataLookup_planTransformFunc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	metaDB := metadb.NewMockMetadataDatabase(ctrl)
+	metaDB.EXPECT().GetField(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(field.Meta{ID: 10, Type: field.SumField, Name: "f"}, nil).AnyTimes()
+	f := &stmtpkg.FieldExpr{Name: "f"}
+	cases := []struct {
+		name    string
+		in      *stmtpkg.CallExpr
+		wantErr bool
+	}{
+		{
+			name:    "no params",
+			in:      &stmtpkg.CallExpr{FuncType: function.Derivative},
+			wantErr: true,
+		},
+		{
+			name:    "too many params",
+			in:      &stmtpkg.CallExpr{FuncType: function.Abs, Params: []stmtpkg.Expr{f, f}},
+			wantErr: true,
+		},
+		{
+			name:    "moving average without window",
+			in:      &stmtpkg.CallExpr{FuncType: function.MovingAverage, Params: []stmtpkg.Expr{f}},
+			wantErr: true,
+		},
+		{
+			name:    "moving average with invalid window",
+			in:      &stmtpkg.CallExpr{FuncType: function.MovingAverage, Params: []stmtpkg.Expr{f, &stmtpkg.NumberLiteral{Val: 0}}},
+			wantErr: true,
+		},
+		{
+			name:    "clamp with field",
+			in:      &stmtpkg.CallExpr{FuncType: function.ClampMin, Params: []stmtpkg.Expr{f, f}},
+			wantErr: true,
+		},
+		{
+			name:    "timeshift without duration",
+			in:      &stmtpkg.CallExpr{FuncType: function.TimeShift, Params: []stmtpkg.Expr{f, &stmtpkg.NumberLiteral{Val: 1}}},
+			wantErr: true,
+		},
+		{
+			name: "derivative",
+			in:   &stmtpkg.CallExpr{FuncType: function.Derivative, Params: []stmtpkg.Expr{f}},
+		},
+		{
+			name: "moving average",
+			in:   &stmtpkg.CallExpr{FuncType: function.MovingAverage, Params: []stmtpkg.Expr{f, &stmtpkg.NumberLiteral{Val: 5}}},
+		},
+		{
+			name: "clamp max",
+			in:   &stmtpkg.CallExpr{FuncType: function.ClampMax, Params: []stmtpkg.Expr{f, &stmtpkg.NumberLiteral{Val: 5}}},
+		},
+		{
+			name: "timeshift",
+			in: &stmtpkg.CallExpr{FuncType: function.TimeShift, Params: []stmtpkg.Expr{
+				&stmtpkg.CallExpr{FuncType: function.Max, Params: []stmtpkg.Expr{f}},
+				&stmtpkg.DurationLiteral{Val: 1000},
+			}},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			op := &metadataLookup{
+				executeCtx: &flow.StorageExecuteContext{
+					Query: &stmtpkg.Query{},
+				},
+				metadata: metaDB,
+				fields:   make(map[field.ID]*aggregation.Aggregator),
+			}
+			op.field(nil, tt.in)
+			if (op.err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+			if !tt.wantErr {
+				assert.Len(t, op.fields, 1)
+			}
+		})
+	}
+}
+
 func TestMetadataLookup_Identifier(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
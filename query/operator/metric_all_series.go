@@ -29,6 +29,7 @@ import (
 type metricAllSeries struct {
 	executeCtx *flow.ShardExecuteContext
 	indexDB    indexdb.IndexDatabase
+	tombstones tsdb.TombstoneStore
 }
 
 // NewMetricAllSeries creates a metricAllSeries instance.
@@ -36,6 +37,7 @@ func NewMetricAllSeries(executeCtx *flow.ShardExecuteContext, shard tsdb.Shard)
 	return &metricAllSeries{
 		executeCtx: executeCtx,
 		indexDB:    shard.IndexDatabase(),
+		tombstones: shard.Tombstones(),
 	}
 }
 
@@ -47,6 +49,7 @@ func (op *metricAllSeries) Execute() error {
 	if err != nil {
 		return err
 	}
+	removeDeletedSeries(op.tombstones, op.executeCtx.StorageExecuteCtx, seriesIDs)
 	if !queryStmt.HasGroupBy() {
 		// add series id without tags, maybe metric has too many series, but one series without tags
 		seriesIDs.Add(series.IDWithoutTags)
@@ -42,6 +42,7 @@ func TestMetricAllSeries_Execute(t *testing.T) {
 	shard := tsdb.NewMockShard(ctrl)
 	indexDB := indexdb.NewMockIndexDatabase(ctrl)
 	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	shard.EXPECT().Tombstones().Return(nil).AnyTimes()
 
 	ctx := &flow.ShardExecuteContext{
 		StorageExecuteCtx: &flow.StorageExecuteContext{
@@ -71,6 +72,18 @@ func TestMetricAllSeries_Execute(t *testing.T) {
 		assert.NoError(t, op.Execute())
 		assert.Equal(t, roaring.BitmapOf(0, 3, 5), ctx.SeriesIDsAfterFiltering)
 	})
+	t.Run("remove deleted series ids", func(t *testing.T) {
+		shard2 := tsdb.NewMockShard(ctrl)
+		tombstones := tsdb.NewMockTombstoneStore(ctrl)
+		shard2.EXPECT().IndexDatabase().Return(indexDB)
+		shard2.EXPECT().Tombstones().Return(tombstones)
+		ctx.SeriesIDsAfterFiltering = roaring.New()
+		op := NewMetricAllSeries(ctx, shard2)
+		indexDB.EXPECT().GetSeriesIDsForMetric(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(3, 5), nil)
+		tombstones.EXPECT().DeletedSeriesIDs(gomock.Any(), gomock.Any()).Return(roaring.BitmapOf(3))
+		assert.NoError(t, op.Execute())
+		assert.Equal(t, roaring.BitmapOf(0, 5), ctx.SeriesIDsAfterFiltering)
+	})
 }
 
 func TestMetricAllSeries_Stats(t *testing.T) {
@@ -80,6 +93,7 @@ func TestMetricAllSeries_Stats(t *testing.T) {
 	shard := tsdb.NewMockShard(ctrl)
 	indexDB := indexdb.NewMockIndexDatabase(ctrl)
 	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	shard.EXPECT().Tombstones().Return(nil).AnyTimes()
 
 	ctx := &flow.ShardExecuteContext{
 		StorageExecuteCtx: &flow.StorageExecuteContext{
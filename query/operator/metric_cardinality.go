@@ -0,0 +1,74 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"errors"
+	"math"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/query/context"
+)
+
+// metricCardinality represents metric cardinality operator, counts the series of each metric under namespace.
+type metricCardinality struct {
+	ctx *context.LeafMetadataContext
+}
+
+// NewMetricCardinality creates a metricCardinality instance.
+func NewMetricCardinality(ctx *context.LeafMetadataContext) Operator {
+	return &metricCardinality{
+		ctx: ctx,
+	}
+}
+
+// Execute counts the series of each metric under namespace from index database of query shards.
+func (op *metricCardinality) Execute() error {
+	req := op.ctx.Request
+	metricNames, err := op.ctx.Database.Metadata().MetadataDatabase().SuggestMetrics(req.Namespace, "", math.MaxInt32)
+	if err != nil {
+		return err
+	}
+	cardinality := make(map[string]uint64)
+	for _, shardID := range op.ctx.ShardIDs {
+		shard, ok := op.ctx.Database.GetShard(shardID)
+		if !ok {
+			continue
+		}
+		indexDB := shard.IndexDatabase()
+		for _, metricName := range metricNames {
+			seriesIDs, err := indexDB.GetSeriesIDsForMetric(req.Namespace, metricName)
+			if err != nil {
+				if errors.Is(err, constants.ErrNotFound) {
+					continue
+				}
+				return err
+			}
+			if count := seriesIDs.GetCardinality(); count > 0 {
+				cardinality[metricName] += count
+			}
+		}
+	}
+	op.ctx.Cardinality = cardinality
+	return nil
+}
+
+// Identifier returns identifier string value of metric cardinality operator.
+func (op *metricCardinality) Identifier() string {
+	return "Metric Cardinality"
+}
@@ -0,0 +1,113 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/query/context"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+func TestMetricCardinality_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := tsdb.NewMockDatabase(ctrl)
+	shard := tsdb.NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	meta := metadb.NewMockMetadata(ctrl)
+	metaDB := metadb.NewMockMetadataDatabase(ctrl)
+	meta.EXPECT().MetadataDatabase().Return(metaDB).AnyTimes()
+	db.EXPECT().Metadata().Return(meta).AnyTimes()
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+
+	ctx := &context.LeafMetadataContext{
+		Database: db,
+		ShardIDs: []models.ShardID{1, 2},
+		Request:  &stmtpkg.MetricMetadata{Namespace: "ns"},
+	}
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+		result  map[string]uint64
+	}{
+		{
+			name: "metric suggest failure",
+			prepare: func() {
+				metaDB.EXPECT().SuggestMetrics("ns", "", gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get series ids failure",
+			prepare: func() {
+				metaDB.EXPECT().SuggestMetrics("ns", "", gomock.Any()).Return([]string{"cpu"}, nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "count series successfully",
+			prepare: func() {
+				metaDB.EXPECT().SuggestMetrics("ns", "", gomock.Any()).Return([]string{"cpu", "mem", "disk"}, nil)
+				db.EXPECT().GetShard(models.ShardID(1)).Return(shard, true)
+				db.EXPECT().GetShard(models.ShardID(2)).Return(nil, false)
+				indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(roaring.BitmapOf(1, 2, 3), nil)
+				indexDB.EXPECT().GetSeriesIDsForMetric("ns", "mem").Return(nil, constants.ErrNotFound)
+				indexDB.EXPECT().GetSeriesIDsForMetric("ns", "disk").Return(roaring.New(), nil)
+			},
+			result: map[string]uint64{"cpu": 3},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx.Cardinality = nil
+			op := NewMetricCardinality(ctx)
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			err := op.Execute()
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, tt.result, ctx.Cardinality)
+			}
+		})
+	}
+}
+
+func TestMetricCardinality_Identifier(t *testing.T) {
+	assert.Equal(t, "Metric Cardinality", NewMetricCardinality(nil).Identifier())
+}
@@ -35,6 +35,7 @@ import (
 type seriesFiltering struct {
 	executeCtx *flow.ShardExecuteContext
 	indexDB    indexdb.IndexDatabase
+	tombstones tsdb.TombstoneStore
 
 	err error
 }
@@ -44,6 +45,7 @@ func NewSeriesFiltering(executeCtx *flow.ShardExecuteContext, shard tsdb.Shard)
 	return &seriesFiltering{
 		executeCtx: executeCtx,
 		indexDB:    shard.IndexDatabase(),
+		tombstones: shard.Tombstones(),
 	}
 }
 
@@ -55,10 +57,21 @@ func (op *seriesFiltering) Execute() error {
 	if op.err != nil {
 		return op.err
 	}
+	removeDeletedSeries(op.tombstones, op.executeCtx.StorageExecuteCtx, seriesIDs)
 	op.executeCtx.SeriesIDsAfterFiltering.Or(seriesIDs)
 	return nil
 }
 
+// removeDeletedSeries removes the series ids which deleted in whole query time range.
+func removeDeletedSeries(tombstones tsdb.TombstoneStore, ctx *flow.StorageExecuteContext, seriesIDs *roaring.Bitmap) {
+	if tombstones == nil {
+		return
+	}
+	if deletedSeriesIDs := tombstones.DeletedSeriesIDs(ctx.MetricID, ctx.Query.TimeRange); deletedSeriesIDs != nil {
+		seriesIDs.AndNot(deletedSeriesIDs)
+	}
+}
+
 // findSeriesIDsByExpr finds series ids by expr, recursion filter for expr
 func (op *seriesFiltering) findSeriesIDsByExpr(condition stmt.Expr) (tag.KeyID, *roaring.Bitmap) {
 	if condition == nil {
@@ -40,6 +40,7 @@ func TestSeriesFiltering_Execute(t *testing.T) {
 	shard := tsdb.NewMockShard(ctrl)
 	indexDB := indexdb.NewMockIndexDatabase(ctrl)
 	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	shard.EXPECT().Tombstones().Return(nil).AnyTimes()
 	storageCtx := &flow.StorageExecuteContext{
 		Query: &stmtpkg.Query{},
 		TagFilterResult: map[string]*flow.TagFilterResult{
@@ -211,6 +212,7 @@ func TestSeriesFiltering_Stats(t *testing.T) {
 	shard := tsdb.NewMockShard(ctrl)
 	indexDB := indexdb.NewMockIndexDatabase(ctrl)
 	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	shard.EXPECT().Tombstones().Return(nil).AnyTimes()
 	shardCtx := &flow.ShardExecuteContext{
 		SeriesIDsAfterFiltering: roaring.BitmapOf(1, 2),
 	}
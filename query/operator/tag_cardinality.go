@@ -0,0 +1,66 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"errors"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/query/context"
+)
+
+// tagCardinality represents tag cardinality operator, counts the distinct values of each tag key under metric.
+type tagCardinality struct {
+	ctx *context.LeafMetadataContext
+}
+
+// NewTagCardinality creates a tagCardinality instance.
+func NewTagCardinality(ctx *context.LeafMetadataContext) Operator {
+	return &tagCardinality{
+		ctx: ctx,
+	}
+}
+
+// Execute counts the distinct values of each tag key from tag metadata.
+func (op *tagCardinality) Execute() error {
+	req := op.ctx.Request
+	metadata := op.ctx.Database.Metadata()
+	tagKeys, err := metadata.MetadataDatabase().GetAllTagKeys(req.Namespace, req.MetricName)
+	if err != nil {
+		return err
+	}
+	cardinality := make(map[string]uint64)
+	for _, tagKey := range tagKeys {
+		tagValueIDs, err := metadata.TagMetadata().GetTagValueIDsForTag(tagKey.ID)
+		if err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				cardinality[tagKey.Key] = 0
+				continue
+			}
+			return err
+		}
+		cardinality[tagKey.Key] = tagValueIDs.GetCardinality()
+	}
+	op.ctx.Cardinality = cardinality
+	return nil
+}
+
+// Identifier returns identifier string value of tag cardinality operator.
+func (op *tagCardinality) Identifier() string {
+	return "Tag Cardinality"
+}
@@ -0,0 +1,106 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package operator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/query/context"
+	"github.com/lindb/lindb/series/tag"
+	stmtpkg "github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+func TestTagCardinality_Execute(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db := tsdb.NewMockDatabase(ctrl)
+	meta := metadb.NewMockMetadata(ctrl)
+	metaDB := metadb.NewMockMetadataDatabase(ctrl)
+	tagMeta := metadb.NewMockTagMetadata(ctrl)
+	meta.EXPECT().MetadataDatabase().Return(metaDB).AnyTimes()
+	meta.EXPECT().TagMetadata().Return(tagMeta).AnyTimes()
+	db.EXPECT().Metadata().Return(meta).AnyTimes()
+
+	ctx := &context.LeafMetadataContext{
+		Database: db,
+		Request:  &stmtpkg.MetricMetadata{Namespace: "ns", MetricName: "cpu"},
+	}
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+		result  map[string]uint64
+	}{
+		{
+			name: "get tag keys failure",
+			prepare: func() {
+				metaDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "get tag value ids failure",
+			prepare: func() {
+				metaDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(tag.Metas{{Key: "host", ID: 1}}, nil)
+				tagMeta.EXPECT().GetTagValueIDsForTag(tag.KeyID(1)).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "count tag values successfully",
+			prepare: func() {
+				metaDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(tag.Metas{{Key: "host", ID: 1}, {Key: "zone", ID: 2}}, nil)
+				tagMeta.EXPECT().GetTagValueIDsForTag(tag.KeyID(1)).Return(roaring.BitmapOf(1, 2, 3), nil)
+				tagMeta.EXPECT().GetTagValueIDsForTag(tag.KeyID(2)).Return(nil, constants.ErrNotFound)
+			},
+			result: map[string]uint64{"host": 3, "zone": 0},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ctx.Cardinality = nil
+			op := NewTagCardinality(ctx)
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			err := op.Execute()
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+			if !tt.wantErr {
+				assert.Equal(t, tt.result, ctx.Cardinality)
+			}
+		})
+	}
+}
+
+func TestTagCardinality_Identifier(t *testing.T) {
+	assert.Equal(t, "Tag Cardinality", NewTagCardinality(nil).Identifier())
+}
@@ -35,6 +35,8 @@ type Pipeline interface {
 	Execute(stage stagepkg.Stage)
 	// Stats returns the stats of stages.
 	Stats() []*models.StageStats
+	// Cancel cancels the pipeline with err, the stages not executed will be skipped.
+	Cancel(err error)
 }
 
 // pipeline implements Pipeline interface.
@@ -62,6 +64,11 @@ func (p *pipeline) Execute(stage stagepkg.Stage) {
 		}
 	}()
 
+	if err := p.sm.canceled.Load(); err != nil {
+		// pipeline canceled before executing
+		p.sm.complete(err)
+		return
+	}
 	p.executeStage("", stage)
 }
 
@@ -70,6 +77,12 @@ func (p *pipeline) Stats() []*models.StageStats {
 	return p.sm.GetStats()
 }
 
+// Cancel cancels the pipeline with err, the stages not executed will be skipped,
+// the executing stages will abort, then completes pipeline with err.
+func (p *pipeline) Cancel(err error) {
+	p.sm.cancel(err)
+}
+
 // executeStage executes current the plan tree of current stage,
 // if it executes success, plan next stages and executes them.
 //
@@ -84,7 +97,7 @@ func (p *pipeline) Stats() []*models.StageStats {
 //	|  +-------+   +-------+  |
 //	+-------------------------+
 func (p *pipeline) executeStage(parentStageID string, stage stagepkg.Stage) {
-	if stage == nil || p.sm.isCompleted() {
+	if stage == nil || p.sm.isCompleted() || p.sm.isCanceled() {
 		return
 	}
 
@@ -24,14 +24,17 @@ var (
 	once4Mgr sync.Once
 )
 
-// PipelineManager represents the manager which store current exuecting Pipeline.
+// PipelineManager represents the manager which store current exuecting Pipeline,
+// a request may execute multi pipelines concurrently(such as cross metric query), each task has its own pipeline.
 type PipelineManager interface {
-	// AddPipeline adds a Pipeline when it starts.
-	AddPipeline(requestID string, pipeline Pipeline)
-	// RemovePipeline removes a Pipeline when it completed.
-	RemovePipeline(requestID string)
-	// GetPipeline returns a Pipeline by given request id, if not exist then return nil.
-	GetPipeline(requestID string) Pipeline
+	// AddPipeline adds a Pipeline of request's task when it starts.
+	AddPipeline(requestID, taskID string, pipeline Pipeline)
+	// RemovePipeline removes a Pipeline of request's task when it completed.
+	RemovePipeline(requestID, taskID string)
+	// GetPipeline returns a Pipeline by given request id and task id, if not exist then return nil.
+	GetPipeline(requestID, taskID string) Pipeline
+	// GetPipelines returns all Pipelines of request by given request id.
+	GetPipelines(requestID string) []Pipeline
 	// GetAllAlivePipelines returns all alive request ids for pipelines.
 	GetAllAlivePipelines() []string
 }
@@ -49,7 +52,7 @@ func GetPipelineManager() PipelineManager {
 
 // pipelineManager implements PipelineManager interface.
 type pipelineManager struct {
-	pipelines map[string]Pipeline
+	pipelines map[string]map[string]Pipeline // request id => task id => pipeline
 
 	mutex sync.RWMutex
 }
@@ -57,33 +60,55 @@ type pipelineManager struct {
 // newPipelineManager creates a PipelineManager instance.
 func newPipelineManager() PipelineManager {
 	return &pipelineManager{
-		pipelines: make(map[string]Pipeline),
+		pipelines: make(map[string]map[string]Pipeline),
 	}
 }
 
-// AddPipeline adds a Pipeline when it starts.
-func (m *pipelineManager) AddPipeline(requestID string, pipeline Pipeline) {
+// AddPipeline adds a Pipeline of request's task when it starts.
+func (m *pipelineManager) AddPipeline(requestID, taskID string, pipeline Pipeline) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	m.pipelines[requestID] = pipeline
+	tasks, ok := m.pipelines[requestID]
+	if !ok {
+		tasks = make(map[string]Pipeline)
+		m.pipelines[requestID] = tasks
+	}
+	tasks[taskID] = pipeline
+}
+
+// GetPipeline returns a Pipeline by given request id and task id, if not exist then return nil.
+func (m *pipelineManager) GetPipeline(requestID, taskID string) Pipeline {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	return m.pipelines[requestID][taskID]
 }
 
-// GetPipeline returns a Pipeline by given request id, if not exist then return nil.
-func (m *pipelineManager) GetPipeline(requestID string) Pipeline {
+// GetPipelines returns all Pipelines of request by given request id.
+func (m *pipelineManager) GetPipelines(requestID string) (rs []Pipeline) {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
 
-	p := m.pipelines[requestID]
-	return p
+	for _, pipeline := range m.pipelines[requestID] {
+		rs = append(rs, pipeline)
+	}
+	return
 }
 
-// RemovePipeline removes a Pipeline when it completed.
-func (m *pipelineManager) RemovePipeline(requestID string) {
+// RemovePipeline removes a Pipeline of request's task when it completed.
+func (m *pipelineManager) RemovePipeline(requestID, taskID string) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	delete(m.pipelines, requestID)
+	tasks, ok := m.pipelines[requestID]
+	if !ok {
+		return
+	}
+	delete(tasks, taskID)
+	if len(tasks) == 0 {
+		delete(m.pipelines, requestID)
+	}
 }
 
 // GetAllAlivePipelines returns all alive request ids for pipelines.
@@ -38,11 +38,21 @@ func TestPipelineManager(t *testing.T) {
 
 	req := "requestID"
 	assert.Empty(t, mgr.GetAllAlivePipelines())
+	assert.Empty(t, mgr.GetPipelines(req))
 
-	mgr.AddPipeline(req, pipeline)
+	// pipelines of cross metric query with same request id
+	mgr.AddPipeline(req, "task-1", pipeline)
+	mgr.AddPipeline(req, "task-2", pipeline)
 	assert.Len(t, mgr.GetAllAlivePipelines(), 1)
-	assert.NotNil(t, mgr.GetPipeline(req))
-
-	mgr.RemovePipeline(req)
+	assert.NotNil(t, mgr.GetPipeline(req, "task-1"))
+	assert.NotNil(t, mgr.GetPipeline(req, "task-2"))
+	assert.Nil(t, mgr.GetPipeline(req, "task-3"))
+	assert.Len(t, mgr.GetPipelines(req), 2)
+
+	mgr.RemovePipeline(req, "task-1")
+	assert.Nil(t, mgr.GetPipeline(req, "task-1"))
+	assert.Len(t, mgr.GetPipelines(req), 1)
+	mgr.RemovePipeline(req, "task-2")
+	mgr.RemovePipeline("not-exist", "task-1")
 	assert.Empty(t, mgr.GetAllAlivePipelines())
 }
@@ -44,6 +44,7 @@ type pipelineStateMachine struct {
 	completedCallbackFn func(err error)          // pipeline execute completed will invoke
 	mutex               sync.Mutex
 	completed           atomic.Bool
+	canceled            atomic.Error // cancel err if pipeline is canceled
 
 	tracker *trackerpkg.StageTracker
 }
@@ -114,6 +115,9 @@ func (sm *pipelineStateMachine) completeStage(stageID string, err error) {
 	sm.mutex.Unlock()
 
 	if sm.pending.Dec() == 0 {
+		if cancelErr := sm.canceled.Load(); cancelErr != nil {
+			err = cancelErr
+		}
 		// check if all stages execute completed
 		sm.complete(err)
 	}
@@ -127,6 +131,20 @@ func (sm *pipelineStateMachine) complete(err error) {
 	}
 }
 
+// cancel cancels the pipeline, aborts the executing stages.
+func (sm *pipelineStateMachine) cancel(err error) {
+	if sm.isCompleted() || sm.isCanceled() {
+		return
+	}
+	sm.canceled.Store(err)
+	sm.tracker.Cancel()
+}
+
+// isCanceled checks if the pipeline is canceled.
+func (sm *pipelineStateMachine) isCanceled() bool {
+	return sm.canceled.Load() != nil
+}
+
 // isCompleted checks if the pipeline is completed.
 func (sm *pipelineStateMachine) isCompleted() bool {
 	return sm.completed.Load()
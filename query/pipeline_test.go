@@ -102,3 +102,46 @@ func TestPipeline_Execute(t *testing.T) {
 		p.Execute(s)
 	})
 }
+
+func TestPipeline_Cancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("cancel before execute", func(t *testing.T) {
+		tracker := trackerpkg.NewStageTracker(flow.NewTaskContextWithTimeout(context.TODO(), time.Minute))
+		var completeErr error
+		p := NewExecutePipeline(tracker, func(err error) {
+			completeErr = err
+		})
+		p.Cancel(ErrQueryCanceled)
+		p.Execute(stage.NewMockStage(ctrl))
+		assert.ErrorIs(t, completeErr, ErrQueryCanceled)
+	})
+	t.Run("cancel executing stage", func(t *testing.T) {
+		taskCtx := flow.NewTaskContextWithTimeout(context.TODO(), time.Minute)
+		tracker := trackerpkg.NewStageTracker(taskCtx)
+		var completeErr error
+		p := NewExecutePipeline(tracker, func(err error) {
+			completeErr = err
+		})
+		s := stage.NewMockStage(ctrl)
+		s.EXPECT().Plan()
+		s.EXPECT().NextStages().Return([]stage.Stage{stage.NewMockStage(ctrl)})
+		s.EXPECT().Complete()
+		s.EXPECT().Stats()
+		s.EXPECT().Identifier()
+		s.EXPECT().IsAsync().Return(true)
+		s.EXPECT().Execute(gomock.Any(), gomock.Any(), gomock.Any()).Do(
+			func(_ stage.PlanNode, completeFn func(), _ func(err error)) {
+				p.Cancel(ErrQueryCanceled)
+				// cancel twice
+				p.Cancel(fmt.Errorf("err"))
+				assert.Error(t, taskCtx.Ctx.Err())
+				completeFn()
+			})
+		p.Execute(s)
+		assert.ErrorIs(t, completeErr, ErrQueryCanceled)
+		// cancel after completed
+		p.Cancel(fmt.Errorf("err"))
+	})
+}
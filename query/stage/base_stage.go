@@ -62,6 +62,11 @@ func (stage *baseStage) Execute(node PlanNode, completeHandle func(), errHandle
 		}
 	}
 	if stage.IsAsync() {
+		if err := stage.ctx.Err(); err != nil {
+			// task context is canceled/timeout, pool will reject the task
+			errHandle(err)
+			return
+		}
 		stage.execPool.Submit(stage.ctx, concurrent.NewTask(func() {
 			execFn()
 		}, errHandle))
@@ -75,6 +80,12 @@ func (stage *baseStage) execute(node PlanNode) (err error) {
 	if node == nil {
 		return nil
 	}
+	if stage.ctx != nil {
+		// abort if task context is canceled/timeout
+		if err := stage.ctx.Err(); err != nil {
+			return err
+		}
+	}
 
 	var stats *models.OperatorStats
 	// execute current plan node logic
@@ -106,6 +106,34 @@ func TestBaseStage_Execute(t *testing.T) {
 				assert.Error(t, err)
 			},
 		},
+		{
+			name: "task context canceled",
+			plan: NewMockPlanNode(ctrl),
+			prepare: func(_ *MockPlanNode) {
+				ctx, cancel := context.WithCancel(context.TODO())
+				cancel()
+				s.ctx = ctx
+			},
+			errHandler: func(err error) {
+				assert.ErrorIs(t, err, context.Canceled)
+			},
+		},
+		{
+			name: "task context canceled when executing plan",
+			plan: NewMockPlanNode(ctrl),
+			prepare: func(p *MockPlanNode) {
+				ctx, cancel := context.WithCancel(context.TODO())
+				s.ctx = ctx
+				p.EXPECT().ExecuteWithStats().DoAndReturn(func() (*models.OperatorStats, error) {
+					cancel()
+					return &models.OperatorStats{}, nil
+				})
+				p.EXPECT().Children().Return([]PlanNode{NewMockPlanNode(ctrl)})
+			},
+			errHandler: func(err error) {
+				assert.ErrorIs(t, err, context.Canceled)
+			},
+		},
 		{
 			name: "execute plan sync",
 			plan: NewMockPlanNode(ctrl),
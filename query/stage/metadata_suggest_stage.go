@@ -53,6 +53,10 @@ func (stage *metadataSuggestStage) Plan() PlanNode {
 		return NewPlanNode(operator.NewTagKeySuggest(stage.ctx))
 	case stmt.Field:
 		return NewPlanNode(operator.NewFieldSuggest(stage.ctx))
+	case stmt.MetricCardinality:
+		return NewPlanNode(operator.NewMetricCardinality(stage.ctx))
+	case stmt.TagCardinality:
+		return NewPlanNode(operator.NewTagCardinality(stage.ctx))
 	case stmt.TagValue:
 		execPlan := NewEmptyPlanNode()
 		execPlan.AddChild(NewPlanNode(operator.NewTagKeyIDLookup(stage.ctx)))
@@ -61,6 +61,14 @@ func TestMetadataSuggestStage_Plan(t *testing.T) {
 			name: "field suggest",
 			in:   &stmtpkg.MetricMetadata{Type: stmtpkg.Field},
 		},
+		{
+			name: "metric cardinality",
+			in:   &stmtpkg.MetricMetadata{Type: stmtpkg.MetricCardinality},
+		},
+		{
+			name: "tag cardinality",
+			in:   &stmtpkg.MetricMetadata{Type: stmtpkg.TagCardinality},
+		},
 		{
 			name: "tag value suggest without condition",
 			in:   &stmtpkg.MetricMetadata{Type: stmtpkg.TagValue},
@@ -35,6 +35,7 @@ func TestShardLookupStage(t *testing.T) {
 	shard := tsdb.NewMockShard(ctrl)
 	indexDB := indexdb.NewMockIndexDatabase(ctrl)
 	shard.EXPECT().IndexDatabase().Return(indexDB)
+	shard.EXPECT().Tombstones().Return(nil).AnyTimes()
 
 	s := NewShardLookupStage(nil, nil, shard)
 	assert.NotNil(t, s.Plan())
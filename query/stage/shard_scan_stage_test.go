@@ -63,6 +63,7 @@ func TestShardScanStage(t *testing.T) {
 	db.EXPECT().ExecutorPool().Return(&tsdb.ExecutorPool{}).AnyTimes()
 	indexDB := indexdb.NewMockIndexDatabase(ctrl)
 	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	shard.EXPECT().Tombstones().Return(nil).AnyTimes()
 	s := NewShardScanStage(ctx, shardExecuteCtx, shard)
 
 	t.Run("no family", func(t *testing.T) {
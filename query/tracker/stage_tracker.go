@@ -104,6 +104,13 @@ func (s *StageTracker) SetGroupingCollectStageValues(fn func(stage *models.Stage
 	fn(s.groupingCollectStage)
 }
 
+// Cancel cancels the task context, the executing stages will abort.
+func (s *StageTracker) Cancel() {
+	if s.taskCtx != nil {
+		s.taskCtx.Cancel()
+	}
+}
+
 // Complete completes stage stats track, build result.
 func (s *StageTracker) Complete() {
 	s.mutex.Lock()
@@ -27,6 +27,7 @@ import (
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/metric"
@@ -41,7 +42,8 @@ var (
 
 // DatabaseChannel represents the database level replication shardChannel
 type DatabaseChannel interface {
-	// Write writes the metric data into shardChannel's buffer
+	// Write writes the metric data into shardChannel's buffer,
+	// returns error wraps *models.SeriesQuotaError if some rows rejected because series quota exceeded.
 	Write(ctx context.Context, brokerBatchRows *metric.BrokerBatchRows) error
 	// CreateChannel creates the shard level replication shardChannel by given shard id
 	CreateChannel(numOfShard int32, shardID models.ShardID) (ShardChannel, error)
@@ -50,6 +52,8 @@ type DatabaseChannel interface {
 
 	// garbageCollect recycles write families which is expired.
 	garbageCollect()
+	// updateOption updates the accept writable time range after database option altered.
+	updateOption(opt *option.DatabaseOption)
 }
 
 type (
@@ -106,6 +110,13 @@ func newDatabaseChannel(
 	return ch
 }
 
+// updateOption updates the accept writable time range after database option altered.
+func (dc *databaseChannel) updateOption(opt *option.DatabaseOption) {
+	ahead, behind := opt.Clone().GetAcceptWritableRange()
+	dc.ahead.Store(ahead)
+	dc.behind.Store(behind)
+}
+
 // garbageCollect recycles write families which is expired.
 func (dc *databaseChannel) garbageCollect() {
 	dc.shardChannels.mu.Lock()
@@ -123,7 +134,10 @@ func (dc *databaseChannel) garbageCollect() {
 
 // Write writes the metric data into shardChannel's buffer
 func (dc *databaseChannel) Write(ctx context.Context, brokerBatchRows *metric.BrokerBatchRows) error {
-	var err error
+	var (
+		err      error
+		quotaErr error
+	)
 
 	behind := dc.behind.Load()
 	ahead := dc.ahead.Load()
@@ -148,6 +162,16 @@ func (dc *databaseChannel) Write(ctx context.Context, brokerBatchRows *metric.Br
 		}
 		for familyIterator.HasNextFamily() {
 			familyTime, rows := familyIterator.NextFamily()
+			// reject the rows of series quota exceeded scopes which reported by storage
+			accepted, rejectErr := channel.FilterSeriesQuotaExceeded(rows)
+			if rejectErr != nil {
+				dc.statistics.SeriesQuotaRejected.Add(float64(len(rows) - len(accepted)))
+				quotaErr = rejectErr
+				if len(accepted) == 0 {
+					continue
+				}
+				rows = accepted
+			}
 			familyChannel := channel.GetOrCreateFamilyChannel(familyTime)
 			if err = familyChannel.Write(ctx, rows); err != nil {
 				dc.logger.Error("failed writing rows to family shardChannel",
@@ -159,7 +183,11 @@ func (dc *databaseChannel) Write(ctx context.Context, brokerBatchRows *metric.Br
 			}
 		}
 	}
-	return err
+	if err != nil {
+		return err
+	}
+	// returns series quota error to client if no other error
+	return quotaErr
 }
 
 // CreateChannel creates the shard level replication shardChannel by given shard id
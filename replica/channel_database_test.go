@@ -19,17 +19,24 @@ package replica
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
 
 	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
 
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/rpc"
 	"github.com/lindb/lindb/series/metric"
 )
 
@@ -65,6 +72,10 @@ func TestDatabaseChannel_Write(t *testing.T) {
 	familyChannel := NewMockFamilyChannel(ctrl)
 	familyChannel.EXPECT().Write(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
 	shardCh.EXPECT().GetOrCreateFamilyChannel(gomock.Any()).Return(familyChannel).AnyTimes()
+	shardCh.EXPECT().FilterSeriesQuotaExceeded(gomock.Any()).
+		DoAndReturn(func(rows []metric.BrokerRow) ([]metric.BrokerRow, error) {
+			return rows, nil
+		})
 
 	batch = metric.NewBrokerBatchRows()
 	_ = batch.TryAppend(func(row *metric.BrokerRow) error {
@@ -78,6 +89,105 @@ func TestDatabaseChannel_Write(t *testing.T) {
 	})
 	err = ch.Write(context.TODO(), batch)
 	assert.Error(t, err)
+
+	// all rows rejected because series quota exceeded
+	quotaErr := fmt.Errorf("%w, rejected rows: 1", &models.SeriesQuotaError{Scope: models.MetricQuotaScope, Name: "cpu"})
+	shardCh.EXPECT().FilterSeriesQuotaExceeded(gomock.Any()).Return(nil, quotaErr)
+	err = ch.Write(context.TODO(), batch)
+	assert.Equal(t, quotaErr, err)
+	// some rows rejected, write accepted rows
+	shardCh.EXPECT().FilterSeriesQuotaExceeded(gomock.Any()).
+		DoAndReturn(func(rows []metric.BrokerRow) ([]metric.BrokerRow, error) {
+			return rows[:1], quotaErr
+		})
+	familyChannel.EXPECT().Write(gomock.Any(), gomock.Len(1)).Return(nil)
+	err = ch.Write(context.TODO(), batch)
+	assert.Equal(t, quotaErr, err)
+}
+
+func TestDatabaseChannel_Write_SeriesQuotaExceeded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+
+	opt := &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}}
+	ch := newDatabaseChannel(ctx, models.Database{Name: "database", Option: opt}, 1, nil)
+	shardCh := newShardChannel(ctx, "database", 0, nil).(*shardChannel)
+	ch.(*databaseChannel).insertShardChannel(0, shardCh)
+
+	// storage rejects metric "cpu", reports series quota errors in write response
+	quotaErrs := []models.SeriesQuotaError{{Scope: models.MetricQuotaScope, Namespace: "default-ns", Name: "cpu", Quota: 1, Usage: 1}}
+	stream := rpc.NewMockWriteStream(ctrl)
+	stream.EXPECT().Close().Return(nil).AnyTimes()
+	familyCh := &familyChannel{
+		ctx:                 ctx,
+		cancel:              cancel,
+		database:            "database",
+		ch:                  make(chan *compressedChunk, 2),
+		leaderChangedSignal: make(chan struct{}, 1),
+		stoppedSignal:       make(chan struct{}, 1),
+		stoppingSignal:      make(chan struct{}, 1),
+		checkFlushInterval:  10 * time.Millisecond,
+		batchTimeout:        time.Millisecond,
+		maxRetryBuf:         10,
+		chunk:               newChunk(1024),
+		lastFlushTime:       atomic.NewInt64(timeutil.Now()),
+		shardState:          models.ShardState{ID: 0, Leader: 1},
+		liveNodes:           map[models.NodeID]models.StatefulNode{1: {}},
+		quotaGuard:          shardCh.quotaGuard,
+		statistics:          metrics.NewBrokerFamilyWriteStatistics("database"),
+		logger:              logger.GetLogger("Replica", "Test"),
+	}
+	familyCh.newWriteStreamFn = func(_ context.Context, _ models.Node,
+		_ string, _ *models.ShardState, _ int64,
+		_ rpc.ClientStreamFactory, quotaHandler rpc.SeriesQuotaHandler) (rpc.WriteStream, error) {
+		stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(_ []byte) error {
+			quotaHandler(quotaErrs)
+			return nil
+		}).AnyTimes()
+		return stream, nil
+	}
+	go familyCh.writeTask(ctx)
+	newBatch := func() *metric.BrokerBatchRows {
+		converter := metric.NewProtoConverter()
+		batch := metric.NewBrokerBatchRows()
+		for _, name := range []string{"cpu", "memory"} {
+			name := name
+			_ = batch.TryAppend(func(row *metric.BrokerRow) error {
+				return converter.ConvertTo(&protoMetricsV1.Metric{
+					Name:      name,
+					Timestamp: timeutil.Now(),
+					SimpleFields: []*protoMetricsV1.SimpleField{
+						{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1}},
+					Tags: []*protoMetricsV1.KeyValue{{Key: "host", Value: "1.1.1.1"}},
+				}, row)
+			})
+		}
+		return batch
+	}
+	shardItr := newBatch().NewShardGroupIterator(1)
+	assert.True(t, shardItr.HasRowsForNextShard())
+	_, familyItr := shardItr.FamilyRowsForNextShard(timeutil.Interval(10 * 1000))
+	assert.True(t, familyItr.HasNextFamily())
+	familyTime, _ := familyItr.NextFamily()
+	shardCh.families.InsertFamily(familyTime, familyCh)
+
+	// first write accepted, storage reports rejections after data sent
+	assert.NoError(t, ch.Write(ctx, newBatch()))
+	assert.Eventually(t, func() bool {
+		shardCh.quotaGuard.mutex.RLock()
+		defer shardCh.quotaGuard.mutex.RUnlock()
+		return len(shardCh.quotaGuard.rejections) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	// next write rejects rows of "cpu", returns quota error to client
+	err := ch.Write(ctx, newBatch())
+	assert.ErrorIs(t, err, constants.ErrSeriesQuotaExceeded)
+	var quotaErr *models.SeriesQuotaError
+	assert.True(t, errors.As(err, &quotaErr))
+	assert.Equal(t, quotaErrs[0], *quotaErr)
+	assert.Contains(t, err.Error(), "rejected rows: 1")
 }
 
 func TestDatabaseChannel_CreateChannel(t *testing.T) {
@@ -127,3 +237,17 @@ func TestDatabaseChannel_Stop(t *testing.T) {
 	shardCh.EXPECT().Stop()
 	ch.Stop()
 }
+
+func TestDatabaseChannel_updateOption(t *testing.T) {
+	opt := &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}, Ahead: "1h", Behind: "1h"}
+	ch := newDatabaseChannel(context.TODO(),
+		models.Database{
+			Name:   "database",
+			Option: opt,
+		}, 4, nil)
+	ch1 := ch.(*databaseChannel)
+	assert.Equal(t, timeutil.OneHour, ch1.ahead.Load())
+	ch.updateOption(&option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}, Ahead: "2h", Behind: "3h"})
+	assert.Equal(t, 2*timeutil.OneHour, ch1.ahead.Load())
+	assert.Equal(t, 3*timeutil.OneHour, ch1.behind.Load())
+}
@@ -19,6 +19,7 @@ package replica
 
 import (
 	"context"
+	"errors"
 	"io"
 	"runtime/pprof"
 	"sync"
@@ -37,6 +38,11 @@ import (
 
 //go:generate mockgen -source=./channel_family.go -destination=./channel_family_mock.go -package=replica
 
+// for testing
+var (
+	newWriteSpoolFn = NewWriteSpool
+)
+
 // FamilyChannel represents family write shardChannel.
 type FamilyChannel interface {
 	// Write writes the data into the shardChannel,
@@ -68,7 +74,9 @@ type familyChannel struct {
 		target models.Node,
 		database string, shardState *models.ShardState, familyTime int64,
 		fct rpc.ClientStreamFactory,
+		quotaHandler rpc.SeriesQuotaHandler,
 	) (rpc.WriteStream, error)
+	quotaGuard *seriesQuotaGuard // series quota rejections of shard
 
 	fct           rpc.ClientStreamFactory
 	shardState    models.ShardState
@@ -82,6 +90,10 @@ type familyChannel struct {
 	stoppingSignal      chan struct{}
 	chunk               Chunk // buffer current writeTask metric for compress
 
+	// spool buffers compressed chunk on disk if enable write spool
+	spool       WriteSpool
+	spoolSignal chan struct{}
+
 	lastFlushTime      *atomic.Int64 // last flush time
 	checkFlushInterval time.Duration // interval for check flush
 	batchTimeout       time.Duration // interval for flush
@@ -103,6 +115,7 @@ func newFamilyChannel(
 	fct rpc.ClientStreamFactory,
 	shardState models.ShardState,
 	liveNodes map[models.NodeID]models.StatefulNode,
+	quotaGuard *seriesQuotaGuard,
 ) FamilyChannel {
 	c, cancel := context.WithCancel(ctx)
 	fc := &familyChannel{
@@ -115,6 +128,7 @@ func newFamilyChannel(
 		shardState:          shardState,
 		liveNodes:           liveNodes,
 		newWriteStreamFn:    rpc.NewWriteStream,
+		quotaGuard:          quotaGuard,
 		ch:                  make(chan *compressedChunk, 2),
 		leaderChangedSignal: make(chan struct{}, 1),
 		stoppedSignal:       make(chan struct{}, 1),
@@ -124,9 +138,22 @@ func newFamilyChannel(
 		maxRetryBuf:         100, // TODO add config
 		chunk:               newChunk(cfg.BatchBlockSize),
 		lastFlushTime:       atomic.NewInt64(timeutil.Now()),
+		spoolSignal:         make(chan struct{}, 1),
 		statistics:          metrics.NewBrokerFamilyWriteStatistics(database),
 		logger:              logger.GetLogger("Replica", "FamilyChannel"),
 	}
+	if cfg.Spool.Enabled {
+		dir := familySpoolDir(cfg.Spool.Dir, database, shardID, familyTime)
+		spool, err := newWriteSpoolFn(dir, cfg.Spool, fc.statistics)
+		if err != nil {
+			// if create spool failure, fall back to buffer data in memory
+			fc.logger.Error("create write spool failure, use memory buffer",
+				logger.String("dir", dir), logger.Error(err))
+			fc.statistics.SpoolFailures.Incr()
+		} else {
+			fc.spool = spool
+		}
+	}
 
 	fc.statistics.ActiveWriteFamilies.Incr()
 
@@ -191,6 +218,9 @@ func (fc *familyChannel) flushChunkOnFull(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if fc.spool != nil {
+		return fc.spoolChunkOnFull(ctx, compressed)
+	}
 
 	select {
 	case <-ctx.Done(): // timeout of http ingestion api
@@ -203,6 +233,62 @@ func (fc *familyChannel) flushChunkOnFull(ctx context.Context) error {
 	}
 }
 
+// spoolChunkOnFull puts compressed chunk into spool, waits spool releasing space if spool is full.
+func (fc *familyChannel) spoolChunkOnFull(ctx context.Context, compressed *compressedChunk) error {
+	defer compressed.Release()
+
+	for {
+		// get released signal before put, avoid missing the signal
+		released := fc.spool.Released()
+		err := fc.spool.Put(*compressed)
+		if err == nil {
+			fc.lastFlushTime.Store(timeutil.Now())
+			fc.notifySpool()
+			return nil
+		}
+		if !errors.Is(err, ErrWriteSpoolFull) {
+			return err
+		}
+		fc.notifySpool()
+		select {
+		case <-ctx.Done(): // timeout of http ingestion api
+			return ErrWriteSpoolFull
+		case <-fc.ctx.Done():
+			return ErrFamilyChannelCanceled
+		case <-released:
+		}
+	}
+}
+
+// spoolChunk puts compressed chunk into spool without waiting, drops it if put failure.
+func (fc *familyChannel) spoolChunk(compressed *compressedChunk) {
+	if compressed == nil {
+		return
+	}
+	defer compressed.Release()
+
+	if len(*compressed) == 0 {
+		return
+	}
+	if err := fc.spool.Put(*compressed); err != nil {
+		fc.statistics.SpoolDrop.Incr()
+		fc.logger.Error("put compressed chunk into write spool failure, drop it",
+			logger.String("database", fc.database),
+			logger.Any("shard", fc.shardID),
+			logger.Error(err))
+		return
+	}
+	fc.notifySpool()
+}
+
+// notifySpool notifies write task to replay spooled message.
+func (fc *familyChannel) notifySpool() {
+	select {
+	case fc.spoolSignal <- struct{}{}:
+	default:
+	}
+}
+
 // writeTask consumes data from chan, then appends the data into queue
 func (fc *familyChannel) writeTask(_ context.Context) {
 	// on avg 2 * limit could avoid buffer grow
@@ -220,30 +306,23 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 		}
 	}
 	var stream rpc.WriteStream
-	send := func(compressed *compressedChunk) bool {
-		if compressed == nil {
-			return true
-		}
-		if len(*compressed) == 0 {
-			compressed.Release()
-			return true
-		}
+	sendData := func(data []byte) error {
 		if stream == nil {
 			fc.lock4meta.Lock()
 			leader := fc.liveNodes[fc.shardState.Leader]
 			shardState := fc.shardState
 			fc.currentTarget = &leader
 			fc.lock4meta.Unlock()
-			s, err := fc.newWriteStreamFn(fc.ctx, fc.currentTarget, fc.database, &shardState, fc.familyTime, fc.fct)
+			s, err := fc.newWriteStreamFn(fc.ctx, fc.currentTarget, fc.database, &shardState, fc.familyTime, fc.fct,
+				fc.quotaGuard.update)
 			if err != nil {
 				fc.statistics.CreateStreamFailures.Incr()
-				retry(compressed)
-				return false
+				return err
 			}
 			fc.statistics.CreateStream.Incr()
 			stream = s
 		}
-		if err := stream.Send(*compressed); err != nil {
+		if err := stream.Send(data); err != nil {
 			fc.statistics.SendFailure.Incr()
 			fc.logger.Error(
 				"failed writing compressed chunk to storage",
@@ -261,16 +340,38 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 				}
 				stream = nil
 			}
+			return err
+		}
+		fc.statistics.SendSuccess.Incr()
+		fc.statistics.SendSize.Add(float64(len(data)))
+		return nil
+	}
+	send := func(compressed *compressedChunk) bool {
+		if compressed == nil {
+			return true
+		}
+		if len(*compressed) == 0 {
+			compressed.Release()
+			return true
+		}
+		if err := sendData(*compressed); err != nil {
 			// retry if err
 			retry(compressed)
 			return false
 		}
-		fc.statistics.SendSuccess.Incr()
-		fc.statistics.SendSize.Add(float64(len(*compressed)))
 		fc.statistics.PendingSend.Decr()
 		compressed.Release()
 		return true
 	}
+	// replay sends spooled message if enable write spool
+	replay := func() {
+		if fc.spool == nil {
+			return
+		}
+		if err := fc.spool.Replay(sendData); err != nil && !errors.Is(err, ErrWriteSpoolClosed) {
+			stream = nil
+		}
+	}
 
 	defer func() {
 		if stream != nil {
@@ -289,6 +390,10 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 			fc.stoppedSignal <- struct{}{}
 		}()
 		sendLastMsg := func(compressed *compressedChunk) {
+			if fc.spool != nil {
+				fc.spoolChunk(compressed)
+				return
+			}
 			if !send(compressed) {
 				fc.logger.Error("send message failure before close channel, message lost")
 			}
@@ -304,6 +409,11 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 			}
 		}
 		fc.sendPendingMessage(sendLastMsg)
+		if fc.spool != nil {
+			// try to replay spooled message, pending message will be replayed after restart
+			replay()
+			fc.spool.Close()
+		}
 	}
 	var err error
 	for {
@@ -325,6 +435,9 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 				}
 				stream = nil
 			}
+			replay()
+		case <-fc.spoolSignal:
+			replay()
 		case compressed := <-fc.ch:
 			if send(compressed) {
 				// if send ok, retry pending message
@@ -343,6 +456,7 @@ func (fc *familyChannel) writeTask(_ context.Context) {
 		case <-ticker.C:
 			// check
 			fc.checkFlush()
+			replay()
 		}
 	}
 }
@@ -359,7 +473,14 @@ func (fc *familyChannel) sendPendingMessage(sendLastMsg func(compressed *compres
 func (fc *familyChannel) checkFlush() {
 	now := timeutil.Now()
 	if now-fc.lastFlushTime.Load() >= fc.batchTimeout.Milliseconds() {
-		fc.lock4write.Lock()
+		if fc.spool != nil {
+			// writer maybe waits spool space with write lock, cannot block write task which replays spool.
+			if !fc.lock4write.TryLock() {
+				return
+			}
+		} else {
+			fc.lock4write.Lock()
+		}
 		defer fc.lock4write.Unlock()
 
 		if !fc.chunk.IsEmpty() {
@@ -394,6 +515,10 @@ func (fc *familyChannel) flushChunk() {
 	if compressed == nil || len(*compressed) == 0 {
 		return
 	}
+	if fc.spool != nil {
+		fc.spoolChunk(compressed)
+		return
+	}
 	select {
 	case fc.ch <- compressed:
 		fc.statistics.PendingSend.Incr()
@@ -410,6 +535,10 @@ func (fc *familyChannel) isExpire(ahead, _ int64) bool {
 		logger.Any("shard", fc.shardID),
 		logger.Int64("head", ahead),
 		logger.String("family", timeutil.FormatTimestamp(fc.lastFlushTime.Load(), timeutil.DataTimeFormat2)))
+	if fc.spool != nil && fc.spool.Depth() > 0 {
+		// keep family channel until spooled message replayed or expired
+		return false
+	}
 	// add 15 minute buffer
 	return fc.lastFlushTime.Load()+ahead+15*time.Minute.Milliseconds() < now
 }
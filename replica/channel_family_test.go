@@ -34,6 +34,7 @@ import (
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/rpc"
@@ -42,12 +43,12 @@ import (
 
 func TestFamilyChannel_new(t *testing.T) {
 	f := newFamilyChannel(context.TODO(), config.Write{}, "db", 1,
-		1, nil, models.ShardState{}, nil)
+		1, nil, models.ShardState{}, nil, newSeriesQuotaGuard())
 	assert.NotNil(t, f)
 	f.Stop(10)
 
 	f = newFamilyChannel(context.TODO(), config.Write{}, "db", 1,
-		1, nil, models.ShardState{}, nil)
+		1, nil, models.ShardState{}, nil, newSeriesQuotaGuard())
 	assert.NotNil(t, f)
 	go func() {
 		time.Sleep(100 * time.Millisecond)
@@ -326,7 +327,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
 					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					fct rpc.ClientStreamFactory, _ rpc.SeriesQuotaHandler) (rpc.WriteStream, error) {
 					return nil, fmt.Errorf("err")
 				}
 				go func() {
@@ -344,7 +345,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
 					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					fct rpc.ClientStreamFactory, _ rpc.SeriesQuotaHandler) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Close()
@@ -364,7 +365,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
 					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					fct rpc.ClientStreamFactory, _ rpc.SeriesQuotaHandler) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Close().Return(nil)
@@ -384,7 +385,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
 					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					fct rpc.ClientStreamFactory, _ rpc.SeriesQuotaHandler) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Close().Return(fmt.Errorf("err"))
@@ -404,7 +405,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
 					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					fct rpc.ClientStreamFactory, _ rpc.SeriesQuotaHandler) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Close().Return(fmt.Errorf("err"))
@@ -423,7 +424,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				lastCh := make(chan struct{})
 				f.newWriteStreamFn = func(_ context.Context, _ models.Node,
 					_ string, _ *models.ShardState, _ int64,
-					_ rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					_ rpc.ClientStreamFactory, _ rpc.SeriesQuotaHandler) (rpc.WriteStream, error) {
 					time.Sleep(100 * time.Millisecond)
 					return nil, fmt.Errorf("err")
 				}
@@ -449,7 +450,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
 					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					fct rpc.ClientStreamFactory, _ rpc.SeriesQuotaHandler) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Send(gomock.Any()).Return(nil)
@@ -474,7 +475,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
 					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					fct rpc.ClientStreamFactory, _ rpc.SeriesQuotaHandler) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
@@ -498,7 +499,7 @@ func TestFamilyChannel_writeTask(t *testing.T) {
 				stream := rpc.NewMockWriteStream(ctrl)
 				f.newWriteStreamFn = func(ctx context.Context, target models.Node,
 					database string, shardState *models.ShardState, familyTime int64,
-					fct rpc.ClientStreamFactory) (rpc.WriteStream, error) {
+					fct rpc.ClientStreamFactory, _ rpc.SeriesQuotaHandler) (rpc.WriteStream, error) {
 					return stream, nil
 				}
 				stream.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err"))
@@ -562,3 +563,232 @@ func TestFamilyChannel_sendingLastMessage(t *testing.T) {
 	close(f.ch)
 	wait.Wait()
 }
+
+func TestFamilyChannel_newWithSpool(t *testing.T) {
+	defer func() {
+		newWriteSpoolFn = NewWriteSpool
+	}()
+	cfg := config.Write{Spool: newTestSpoolCfg()}
+	cfg.Spool.Dir = t.TempDir()
+	f := newFamilyChannel(context.TODO(), cfg, "db", 1,
+		1, nil, models.ShardState{}, nil, newSeriesQuotaGuard())
+	assert.NotNil(t, f.(*familyChannel).spool)
+	f.Stop(10)
+
+	newWriteSpoolFn = func(_ string, _ config.WriteSpool,
+		_ *metrics.BrokerFamilyWriteStatistics) (WriteSpool, error) {
+		return nil, fmt.Errorf("err")
+	}
+	f = newFamilyChannel(context.TODO(), cfg, "db", 1,
+		1, nil, models.ShardState{}, nil, newSeriesQuotaGuard())
+	assert.Nil(t, f.(*familyChannel).spool)
+	f.Stop(10)
+}
+
+func TestFamilyChannel_spoolChunkOnFull(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chunk := NewMockChunk(ctrl)
+	chunk.EXPECT().IsFull().Return(true).AnyTimes()
+	chunk.EXPECT().Compress().DoAndReturn(func() (*compressedChunk, error) {
+		return &compressedChunk{1, 2, 3}, nil
+	}).AnyTimes()
+	spool := NewMockWriteSpool(ctrl)
+	ctx, cancel := context.WithCancel(context.TODO())
+	f := &familyChannel{
+		cancel:        cancel,
+		ctx:           ctx,
+		chunk:         chunk,
+		spool:         spool,
+		spoolSignal:   make(chan struct{}, 1),
+		lastFlushTime: atomic.NewInt64(timeutil.Now()),
+		statistics:    metrics.NewBrokerFamilyWriteStatistics("db"),
+		logger:        logger.GetLogger("Replica", "Test"),
+	}
+	released := make(chan struct{})
+	close(released)
+	// put successfully
+	spool.EXPECT().Released().Return(released)
+	spool.EXPECT().Put([]byte{1, 2, 3}).Return(nil)
+	assert.NoError(t, f.flushChunkOnFull(context.TODO()))
+	// spool full, put successfully after space released
+	spool.EXPECT().Released().Return(released).Times(2)
+	spool.EXPECT().Put(gomock.Any()).Return(ErrWriteSpoolFull)
+	spool.EXPECT().Put(gomock.Any()).Return(nil)
+	assert.NoError(t, f.flushChunkOnFull(context.TODO()))
+	// put failure
+	spool.EXPECT().Released().Return(released)
+	spool.EXPECT().Put(gomock.Any()).Return(ErrWriteSpoolClosed)
+	assert.Equal(t, ErrWriteSpoolClosed, f.flushChunkOnFull(context.TODO()))
+	// spool full, ingestion timeout
+	spool.EXPECT().Released().Return(make(chan struct{}))
+	spool.EXPECT().Put(gomock.Any()).Return(ErrWriteSpoolFull)
+	ctx1, cancel1 := context.WithCancel(context.TODO())
+	cancel1()
+	assert.Equal(t, ErrWriteSpoolFull, f.flushChunkOnFull(ctx1))
+	// spool full, family channel canceled
+	spool.EXPECT().Released().Return(make(chan struct{}))
+	spool.EXPECT().Put(gomock.Any()).Return(ErrWriteSpoolFull)
+	cancel()
+	assert.Equal(t, ErrFamilyChannelCanceled, f.flushChunkOnFull(context.TODO()))
+}
+
+func TestFamilyChannel_spoolChunk(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chunk := NewMockChunk(ctrl)
+	spool := NewMockWriteSpool(ctrl)
+	f := &familyChannel{
+		chunk:       chunk,
+		spool:       spool,
+		spoolSignal: make(chan struct{}, 1),
+		statistics:  metrics.NewBrokerFamilyWriteStatistics("db"),
+		logger:      logger.GetLogger("Replica", "Test"),
+	}
+	f.spoolChunk(nil)
+	f.spoolChunk(&compressedChunk{})
+	// put failure, drop it
+	chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
+	spool.EXPECT().Put(gomock.Any()).Return(ErrWriteSpoolFull)
+	f.flushChunk()
+	// put successfully, notify write task
+	chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
+	spool.EXPECT().Put(gomock.Any()).Return(nil).Times(2)
+	f.flushChunk()
+	f.spoolChunk(&compressedChunk{1, 2, 3})
+	assert.Len(t, f.spoolSignal, 1)
+}
+
+func TestFamilyChannel_checkFlushWithSpool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	chunk := NewMockChunk(ctrl)
+	spool := NewMockWriteSpool(ctrl)
+	f := &familyChannel{
+		chunk:         chunk,
+		spool:         spool,
+		spoolSignal:   make(chan struct{}, 1),
+		batchTimeout:  5 * time.Second,
+		lastFlushTime: atomic.NewInt64(timeutil.Now() - 6*timeutil.OneSecond),
+		statistics:    metrics.NewBrokerFamilyWriteStatistics("db"),
+		logger:        logger.GetLogger("Replica", "Test"),
+	}
+	// writer holds write lock, skip flush
+	f.lock4write.Lock()
+	f.checkFlush()
+	f.lock4write.Unlock()
+
+	chunk.EXPECT().IsEmpty().Return(false)
+	chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
+	spool.EXPECT().Put(gomock.Any()).Return(nil)
+	f.checkFlush()
+}
+
+func TestFamilyChannel_isExpireWithSpool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	spool := NewMockWriteSpool(ctrl)
+	f := &familyChannel{
+		spool:         spool,
+		statistics:    metrics.NewBrokerFamilyWriteStatistics("db"),
+		lastFlushTime: atomic.NewInt64(timeutil.Now() - 16*timeutil.OneMinute),
+		logger:        logger.GetLogger("Replica", "Test"),
+	}
+	spool.EXPECT().Depth().Return(int64(1))
+	assert.False(t, f.isExpire(0, 0))
+	spool.EXPECT().Depth().Return(int64(0))
+	assert.True(t, f.isExpire(0, 0))
+}
+
+func TestFamilyChannel_writeTaskWithSpool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cases := []struct {
+		name    string
+		prepare func(f *familyChannel, stream *rpc.MockWriteStream)
+		depth   int64
+	}{
+		{
+			name: "replay spooled message",
+			prepare: func(_ *familyChannel, stream *rpc.MockWriteStream) {
+				stream.EXPECT().Send([]byte{1, 2, 3}).Return(nil).Times(2)
+			},
+		},
+		{
+			name: "replay failure, spool pending message before stop",
+			prepare: func(_ *familyChannel, stream *rpc.MockWriteStream) {
+				stream.EXPECT().Send(gomock.Any()).Return(fmt.Errorf("err")).AnyTimes()
+			},
+			depth: 2,
+		},
+		{
+			name: "leader changed, replay spooled message",
+			prepare: func(f *familyChannel, stream *rpc.MockWriteStream) {
+				stream.EXPECT().Send(gomock.Any()).Return(io.EOF)
+				stream.EXPECT().Send(gomock.Any()).Return(nil).AnyTimes()
+				f.leaderChangedSignal <- struct{}{}
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			cfg := newTestSpoolCfg()
+			cfg.MaxSize = 1024
+			spool, err := NewWriteSpool(dir, cfg, metrics.NewBrokerFamilyWriteStatistics("db"))
+			assert.NoError(t, err)
+			assert.NoError(t, spool.Put([]byte{1, 2, 3}))
+
+			chunk := NewMockChunk(ctrl)
+			chunk.EXPECT().IsEmpty().Return(false)
+			chunk.EXPECT().Compress().Return(&compressedChunk{1, 2, 3}, nil)
+			stream := rpc.NewMockWriteStream(ctrl)
+			stream.EXPECT().Close().Return(nil).AnyTimes()
+			ctx, cancel := context.WithCancel(context.TODO())
+			f := &familyChannel{
+				cancel:              cancel,
+				ctx:                 ctx,
+				ch:                  make(chan *compressedChunk, 2),
+				chunk:               chunk,
+				spool:               spool,
+				spoolSignal:         make(chan struct{}, 1),
+				checkFlushInterval:  time.Millisecond * 100,
+				batchTimeout:        time.Hour,
+				lastFlushTime:       atomic.NewInt64(timeutil.Now()),
+				shardState:          models.ShardState{ID: 0, Leader: 1},
+				leaderChangedSignal: make(chan struct{}, 1),
+				stoppedSignal:       make(chan struct{}, 1),
+				stoppingSignal:      make(chan struct{}, 1),
+				currentTarget:       &models.StatefulNode{},
+				liveNodes: map[models.NodeID]models.StatefulNode{
+					1: {},
+				},
+				newWriteStreamFn: func(_ context.Context, _ models.Node,
+					_ string, _ *models.ShardState, _ int64,
+					_ rpc.ClientStreamFactory, _ rpc.SeriesQuotaHandler) (rpc.WriteStream, error) {
+					return stream, nil
+				},
+				statistics: metrics.NewBrokerFamilyWriteStatistics("db"),
+				logger:     logger.GetLogger("Replica", "Test"),
+			}
+			tt.prepare(f, stream)
+			go func() {
+				time.Sleep(200 * time.Millisecond)
+				f.Stop(100)
+			}()
+			f.writeTask(context.TODO())
+
+			assert.Equal(t, tt.depth > 0, fileutil.Exist(dir))
+			spool, err = NewWriteSpool(dir, cfg, metrics.NewBrokerFamilyWriteStatistics("db"))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.depth, spool.Depth())
+			spool.Close()
+		})
+	}
+}
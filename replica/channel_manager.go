@@ -173,6 +173,10 @@ func (cm *channelManager) handleShardStateChangeEvent(
 	shards map[models.ShardID]models.ShardState,
 	liveNodes map[models.NodeID]models.StatefulNode,
 ) {
+	if ch, ok := cm.getDatabaseChannel(databaseCfg.Name); ok && databaseCfg.Option != nil {
+		// apply altered database option(writable time range)
+		ch.updateOption(databaseCfg.Option)
+	}
 	numOfShard := len(shards)
 	for _, shardState := range shards {
 		shardID := shardState.ID
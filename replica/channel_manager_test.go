@@ -138,6 +138,16 @@ func TestChannelManager_handleShardStateChangeEvent(t *testing.T) {
 				3: {ID: 3},
 			},
 		},
+		{
+			name: "update database option",
+			db: models.Database{
+				Name:   "database",
+				Option: &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10 * 1000}}, Ahead: "2h"},
+			},
+			prepare: func() {
+				dbChannel.EXPECT().updateOption(gomock.Any())
+			},
+		},
 		{
 			name: "sync shard state successfully",
 			db:   models.Database{Name: "database"},
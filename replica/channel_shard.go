@@ -19,6 +19,8 @@ package replica
 
 import (
 	"context"
+	"path/filepath"
+	"strconv"
 	"sync"
 
 	"github.com/lindb/lindb/config"
@@ -26,6 +28,7 @@ import (
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/rpc"
+	"github.com/lindb/lindb/series/metric"
 )
 
 //go:generate mockgen -source=./channel_shard.go -destination=./channel_shard_mock.go -package=replica
@@ -41,6 +44,9 @@ type ShardChannel interface {
 	SyncShardState(shardState models.ShardState, liveNodes map[models.NodeID]models.StatefulNode)
 	// GetOrCreateFamilyChannel musts picks the family shardChannel by given family time.
 	GetOrCreateFamilyChannel(familyTime int64) FamilyChannel
+	// FilterSeriesQuotaExceeded returns the rows which are not rejected by series quota rejections reported by storage,
+	// returns error wraps *models.SeriesQuotaError if some rows rejected.
+	FilterSeriesQuotaExceeded(rows []metric.BrokerRow) ([]metric.BrokerRow, error)
 	// Stop stops shard shardChannel.
 	Stop()
 
@@ -59,9 +65,12 @@ type shardChannel struct {
 	fct      rpc.ClientStreamFactory
 
 	families   *familyChannelSet // send shardChannel for each family time
+	quotaGuard *seriesQuotaGuard // series quota rejections reported by storage
 	shardState models.ShardState
 	liveNodes  map[models.NodeID]models.StatefulNode
 
+	spoolRecovered bool // if recovered spooled write families
+
 	mutex sync.Mutex
 
 	logger *logger.Logger
@@ -75,13 +84,14 @@ func newShardChannel(
 	fct rpc.ClientStreamFactory,
 ) ShardChannel {
 	return &shardChannel{
-		ctx:      ctx,
-		cfg:      config.GlobalBrokerConfig().Write,
-		database: database,
-		shardID:  shardID,
-		families: newFamilyChannelSet(),
-		fct:      fct,
-		logger:   logger.GetLogger("Replica", "ShardChannel"),
+		ctx:        ctx,
+		cfg:        config.GlobalBrokerConfig().Write,
+		database:   database,
+		shardID:    shardID,
+		families:   newFamilyChannelSet(),
+		quotaGuard: newSeriesQuotaGuard(),
+		fct:        fct,
+		logger:     logger.GetLogger("Replica", "ShardChannel"),
 	}
 }
 
@@ -89,18 +99,25 @@ func (c *shardChannel) SyncShardState(shardState models.ShardState, liveNodes ma
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	if c.shardState.Leader != shardState.Leader {
-		// leader change, need notify sender
+	if c.shardState.Leader != shardState.Leader || c.shardState.Epoch != shardState.Epoch ||
+		!c.shardState.Replica.Equal(shardState.Replica) {
+		// leader/epoch/replicas change, need notify sender rebuild write stream,
+		// leader will build replica relation for new replicas when handle write stream.
 		c.shardState = shardState
 		c.liveNodes = liveNodes
 		families := c.families.Entries()
 		for _, family := range families {
 			family.leaderChanged(c.shardState, c.liveNodes)
 		}
-		c.logger.Info("shard leader changed, need switch leader sender",
+		c.logger.Info("shard leader/replicas changed, need switch leader sender",
 			logger.String("db", c.database),
 			logger.Any("shardID", c.shardID))
 	}
+	if c.cfg.Spool.Enabled && !c.spoolRecovered {
+		// recover spooled families after shard state synced, so that family channel can replay data to leader.
+		c.spoolRecovered = true
+		c.recoverSpooledFamilies()
+	}
 }
 
 // GetOrCreateFamilyChannel returns family shardChannel by given family time.
@@ -118,12 +135,19 @@ func (c *shardChannel) GetOrCreateFamilyChannel(familyTime int64) FamilyChannel
 	if exist {
 		return familyChannel
 	}
-	familyChannel = newFamilyChannel(c.ctx, c.cfg, c.database, c.shardID, familyTime, c.fct, c.shardState, c.liveNodes)
+	familyChannel = newFamilyChannel(c.ctx, c.cfg, c.database, c.shardID, familyTime, c.fct, c.shardState, c.liveNodes,
+		c.quotaGuard)
 	c.families.InsertFamily(familyTime, familyChannel)
 
 	return familyChannel
 }
 
+// FilterSeriesQuotaExceeded returns the rows which are not rejected by series quota rejections reported by storage,
+// returns error wraps *models.SeriesQuotaError if some rows rejected.
+func (c *shardChannel) FilterSeriesQuotaExceeded(rows []metric.BrokerRow) ([]metric.BrokerRow, error) {
+	return c.quotaGuard.filter(rows)
+}
+
 // Stop stops shard shardChannel.
 func (c *shardChannel) Stop() {
 	c.mutex.Lock()
@@ -159,6 +183,39 @@ func (c *shardChannel) garbageCollect(ahead, behind int64) {
 	}
 }
 
+// recoverSpooledFamilies creates family channels for spooled write data which buffered before broker restart,
+// must be called under lock.
+func (c *shardChannel) recoverSpooledFamilies() {
+	dir := filepath.Join(c.cfg.Spool.Dir, c.database, c.shardID.String())
+	if !fileExistFn(dir) {
+		return
+	}
+	families, err := listDirFn(dir)
+	if err != nil {
+		c.logger.Error("list write spool dir failure",
+			logger.String("dir", dir), logger.Error(err))
+		return
+	}
+	for _, family := range families {
+		familyTime, err := strconv.ParseInt(family, 10, 64)
+		if err != nil {
+			c.logger.Warn("invalid write spool dir, ignore it",
+				logger.String("dir", dir), logger.String("family", family))
+			continue
+		}
+		if _, exist := c.families.GetFamilyChannel(familyTime); exist {
+			continue
+		}
+		familyChannel := newFamilyChannel(c.ctx, c.cfg, c.database, c.shardID, familyTime, c.fct, c.shardState, c.liveNodes,
+			c.quotaGuard)
+		c.families.InsertFamily(familyTime, familyChannel)
+		c.logger.Info("recover spooled write family",
+			logger.String("db", c.database),
+			logger.Any("shardID", c.shardID),
+			logger.String("family", timeutil.FormatTimestamp(familyTime, timeutil.DataTimeFormat4)))
+	}
+}
+
 // getFamily returns family channel by family time.
 func getFamily(families *familyChannelSet, familyTime int64) (FamilyChannel, bool) {
 	return families.GetFamilyChannel(familyTime)
@@ -19,12 +19,22 @@ package replica
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/rpc"
+	"github.com/lindb/lindb/series/metric"
 )
 
 func TestShardChannel_SyncShardState(t *testing.T) {
@@ -59,6 +69,72 @@ func TestShardChannel_SyncShardState(t *testing.T) {
 		Leader: 2,
 	}, ch1.shardState)
 	ch1.mutex.Unlock()
+
+	// replicas change
+	familyCh.EXPECT().leaderChanged(gomock.Any(), gomock.Any())
+	ch.SyncShardState(models.ShardState{
+		Leader:  2,
+		Replica: models.Replica{Replicas: []models.NodeID{2, 3}},
+	}, nil)
+
+	// leader epoch change(leader re-elected on same node)
+	familyCh.EXPECT().leaderChanged(gomock.Any(), gomock.Any())
+	ch.SyncShardState(models.ShardState{
+		Leader:  2,
+		Epoch:   1,
+		Replica: models.Replica{Replicas: []models.NodeID{2, 3}},
+	}, nil)
+}
+
+func TestShardChannel_recoverSpooledFamilies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		listDirFn = fileutil.ListDir
+		ctrl.Finish()
+	}()
+	cfg := config.Write{Spool: newTestSpoolCfg()}
+	cfg.Spool.Dir = t.TempDir()
+	fct := rpc.NewMockClientStreamFactory(ctrl)
+	fct.EXPECT().CreateWriteServiceClient(gomock.Any()).Return(nil, fmt.Errorf("err")).AnyTimes()
+	newCh := func() *shardChannel {
+		ch := newShardChannel(context.TODO(), "database", 1, fct).(*shardChannel)
+		ch.cfg = cfg
+		return ch
+	}
+	// spool dir not exist
+	ch := newCh()
+	ch.SyncShardState(models.ShardState{Leader: 1}, nil)
+	assert.Empty(t, ch.families.Entries())
+
+	spool, err := NewWriteSpool(familySpoolDir(cfg.Spool.Dir, "database", 1, 100),
+		cfg.Spool, metrics.NewBrokerFamilyWriteStatistics("database"))
+	assert.NoError(t, err)
+	assert.NoError(t, spool.Put([]byte{1, 2, 3}))
+	spool.Close()
+	assert.NoError(t, fileutil.MkDirIfNotExist(filepath.Join(cfg.Spool.Dir, "database", "1", "abc")))
+	assert.NoError(t, fileutil.MkDirIfNotExist(familySpoolDir(cfg.Spool.Dir, "database", 1, 200)))
+
+	// list spool dir failure
+	listDirFn = func(_ string) ([]string, error) {
+		return nil, fmt.Errorf("err")
+	}
+	ch = newCh()
+	ch.SyncShardState(models.ShardState{Leader: 1}, nil)
+	assert.Empty(t, ch.families.Entries())
+	listDirFn = fileutil.ListDir
+
+	// recover spooled families, skip invalid dir/exist family
+	ch = newCh()
+	existFamilyCh := NewMockFamilyChannel(ctrl)
+	existFamilyCh.EXPECT().leaderChanged(gomock.Any(), gomock.Any())
+	ch.families.InsertFamily(200, existFamilyCh)
+	ch.SyncShardState(models.ShardState{Leader: 1}, nil)
+	familyCh, ok := ch.families.GetFamilyChannel(100)
+	assert.True(t, ok)
+	assert.Len(t, ch.families.Entries(), 2)
+	// recover only once
+	ch.SyncShardState(models.ShardState{Leader: 1}, nil)
+	familyCh.Stop(10)
 }
 
 func TestShardChannel_Stop(t *testing.T) {
@@ -113,3 +189,23 @@ func TestShardChannel_GetOrCreateFamilyChannel(t *testing.T) {
 	f3 := ch.GetOrCreateFamilyChannel(3)
 	assert.Equal(t, f1, f3)
 }
+
+func TestShardChannel_FilterSeriesQuotaExceeded(t *testing.T) {
+	ch := newShardChannel(context.TODO(), "database", 1, nil)
+	rows, err := ch.FilterSeriesQuotaExceeded(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, rows)
+
+	ch.(*shardChannel).quotaGuard.update([]models.SeriesQuotaError{{Scope: models.ShardQuotaScope, Name: "database"}})
+	converter := metric.NewProtoConverter()
+	var row metric.BrokerRow
+	assert.NoError(t, converter.ConvertTo(&protoMetricsV1.Metric{
+		Name:      "cpu",
+		Timestamp: timeutil.Now(),
+		SimpleFields: []*protoMetricsV1.SimpleField{
+			{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1}},
+	}, &row))
+	rows, err = ch.FilterSeriesQuotaExceeded([]metric.BrokerRow{row})
+	assert.Error(t, err)
+	assert.Empty(t, rows)
+}
@@ -17,7 +17,12 @@
 
 package replica
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lindb/lindb/constants"
+)
 
 var (
 	// define error types
@@ -27,4 +32,8 @@ var (
 	// ErrFamilyChannelCanceled is the error returned when a family channel is closed.
 	ErrFamilyChannelCanceled = errors.New("family Channel is canceled")
 	ErrIngestTimeout         = errors.New("ingest timout")
+	// ErrWriteSpoolFull is the error returned when write spool exceeds the max size limit.
+	ErrWriteSpoolFull = fmt.Errorf("write spool is full, %w", constants.ErrServiceBusy)
+	// ErrWriteSpoolClosed is the error returned when write spool is closed.
+	ErrWriteSpoolClosed = errors.New("write spool is closed")
 )
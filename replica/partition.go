@@ -23,6 +23,8 @@ import (
 	"io"
 	"sync"
 
+	"go.uber.org/atomic"
+
 	"github.com/lindb/lindb/coordinator/storage"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
@@ -56,6 +58,9 @@ type Partition interface {
 	WriteLog(msg []byte) error
 	// ReplicaAckIndex returns the index which replica appended index.
 	ReplicaAckIndex() int64
+	// SeriesQuotaErrors returns the series quota rejections of shard which recorded after given sequence,
+	// and the latest sequence for next fetching.
+	SeriesQuotaErrors(afterSeq int64) (errs []models.SeriesQuotaError, seq int64)
 	// ResetReplicaIndex resets replica index.
 	ResetReplicaIndex(idx int64)
 	// IsExpire returns partition if it is expired.
@@ -80,6 +85,7 @@ type partition struct {
 	shardID       models.ShardID
 	shard         tsdb.Shard
 	family        tsdb.DataFamily
+	epoch         *atomic.Int64 // latest leader epoch of shard
 
 	peers    map[models.NodeID]ReplicatorPeer
 	cliFct   rpc.ClientStreamFactory
@@ -101,6 +107,7 @@ func NewPartition(
 	log queue.FanOutQueue,
 	cliFct rpc.ClientStreamFactory,
 	stateMgr storage.StateManager,
+	epoch *atomic.Int64,
 ) Partition {
 	c, cancel := context.WithCancel(ctx)
 	return &partition{
@@ -111,6 +118,7 @@ func NewPartition(
 		shardID:       shard.ShardID(),
 		shard:         shard,
 		family:        family,
+		epoch:         epoch,
 		currentNodeID: currentNodeID,
 		cliFct:        cliFct,
 		stateMgr:      stateMgr,
@@ -141,6 +149,12 @@ func (p *partition) ReplicaAckIndex() int64 {
 	return p.log.Queue().AppendedSeq()
 }
 
+// SeriesQuotaErrors returns the series quota rejections of shard which recorded after given sequence,
+// and the latest sequence for next fetching.
+func (p *partition) SeriesQuotaErrors(afterSeq int64) (errs []models.SeriesQuotaError, seq int64) {
+	return p.shard.SeriesQuotaErrors(afterSeq)
+}
+
 // ResetReplicaIndex resets replica index.
 func (p *partition) ResetReplicaIndex(idx int64) {
 	p.log.SetAppendedSeq(idx - 1)
@@ -331,6 +345,7 @@ func (p *partition) buildReplica(leader, replica models.NodeID) error {
 			FamilyTime: p.family.TimeRange().Start,
 		},
 		ConsumerGroup: walConsumer,
+		Epoch:         p.epoch,
 	}
 	if replica == p.currentNodeID {
 		// local replicator
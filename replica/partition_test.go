@@ -65,7 +65,7 @@ func TestPartition_BuildReplicaRelation(t *testing.T) {
 	log.EXPECT().Queue().Return(q).AnyTimes()
 	log.EXPECT().GetOrCreateConsumerGroup(gomock.Any()).Return(nil, nil).MaxTimes(3)
 	family.EXPECT().TimeRange().Return(timeutil.TimeRange{}).AnyTimes()
-	p := NewPartition(context.TODO(), shard, family, 1, log, nil, nil)
+	p := NewPartition(context.TODO(), shard, family, 1, log, nil, nil, nil)
 	err := p.BuildReplicaForLeader(2, []models.NodeID{1, 2, 3})
 	assert.Error(t, err)
 
@@ -87,9 +87,13 @@ func TestPartition_BuildReplicaRelation(t *testing.T) {
 	p.ResetReplicaIndex(100)
 	log.EXPECT().Path().Return("path")
 	assert.Equal(t, "path", p.Path())
+	shard.EXPECT().SeriesQuotaErrors(int64(0)).Return([]models.SeriesQuotaError{{Scope: models.ShardQuotaScope}}, int64(1))
+	errs, seq := p.SeriesQuotaErrors(0)
+	assert.Len(t, errs, 1)
+	assert.Equal(t, int64(1), seq)
 
 	// create consume group failure
-	p = NewPartition(context.TODO(), shard, family, 1, log, nil, nil)
+	p = NewPartition(context.TODO(), shard, family, 1, log, nil, nil, nil)
 	log.EXPECT().GetOrCreateConsumerGroup(gomock.Any()).Return(nil, fmt.Errorf("err"))
 	err = p.BuildReplicaForLeader(1, []models.NodeID{1, 2, 3})
 	assert.Error(t, err)
@@ -124,7 +128,7 @@ func TestPartition_BuildReplicaForFollower(t *testing.T) {
 	log := queue.NewMockFanOutQueue(ctrl)
 	log.EXPECT().GetOrCreateConsumerGroup(gomock.Any()).Return(nil, nil)
 	family.EXPECT().TimeRange().Return(timeutil.TimeRange{}).AnyTimes()
-	p := NewPartition(context.TODO(), shard, family, 1, log, nil, nil)
+	p := NewPartition(context.TODO(), shard, family, 1, log, nil, nil, nil)
 	err := p.BuildReplicaForFollower(2, 2)
 	assert.Error(t, err)
 
@@ -136,7 +140,7 @@ func TestPartition_BuildReplicaForFollower(t *testing.T) {
 
 	// create fan ot failure
 	log.EXPECT().GetOrCreateConsumerGroup(gomock.Any()).Return(nil, fmt.Errorf("err"))
-	p = NewPartition(context.TODO(), shard, family, 1, log, nil, nil)
+	p = NewPartition(context.TODO(), shard, family, 1, log, nil, nil, nil)
 	err = p.BuildReplicaForFollower(2, 1)
 	assert.Error(t, err)
 }
@@ -171,7 +175,7 @@ func TestPartition_Close(t *testing.T) {
 
 	l.EXPECT().Close().MaxTimes(2)
 	family.EXPECT().TimeRange().Return(timeutil.TimeRange{}).AnyTimes()
-	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil)
+	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil, nil)
 	err := p.Close()
 	assert.NoError(t, err)
 	r.EXPECT().IsReady().Return(true).AnyTimes()
@@ -198,7 +202,7 @@ func TestPartition_WriteLog(t *testing.T) {
 	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
 	family := tsdb.NewMockDataFamily(ctrl)
 	family.EXPECT().FamilyTime().Return(timeutil.Now()).AnyTimes()
-	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil)
+	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil, nil)
 	q.EXPECT().Put(gomock.Any()).Return(fmt.Errorf("err"))
 	err := p.WriteLog([]byte{1})
 	assert.Error(t, err)
@@ -225,7 +229,7 @@ func TestPartition_ReplicaLog(t *testing.T) {
 	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
 	family := tsdb.NewMockDataFamily(ctrl)
 	family.EXPECT().FamilyTime().Return(timeutil.Now()).AnyTimes()
-	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil)
+	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil, nil)
 	// case 1: replica idx err
 	q.EXPECT().AppendedSeq().Return(int64(8))
 	idx, err := p.ReplicaLog(10, []byte{1})
@@ -262,7 +266,7 @@ func TestPartition_getReplicaState(t *testing.T) {
 	shard := tsdb.NewMockShard(ctrl)
 	shard.EXPECT().Database().Return(db).AnyTimes()
 	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
-	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil)
+	p := NewPartition(context.TODO(), shard, family, 1, l, nil, nil, nil)
 	p1 := p.(*partition)
 	peer := NewMockReplicatorPeer(ctrl)
 	peer.EXPECT().ReplicatorState().Return("remote", &state{state: models.ReplicatorReadyState}).AnyTimes()
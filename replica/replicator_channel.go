@@ -18,6 +18,8 @@
 package replica
 
 import (
+	"go.uber.org/atomic"
+
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/queue"
 )
@@ -25,6 +27,8 @@ import (
 // ReplicatorChannel represents channel peer[from,to] for the shard of database.
 type ReplicatorChannel struct {
 	State *models.ReplicaState
+	// Epoch is the latest leader epoch of shard which current node knows, follower fences stale leader by it.
+	Epoch *atomic.Int64
 
 	// underlying ConsumerGroup records the replication process.
 	ConsumerGroup queue.ConsumerGroup
@@ -18,8 +18,11 @@
 package replica
 
 import (
+	"errors"
+
 	"github.com/golang/snappy"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
@@ -131,13 +134,20 @@ func (r *localReplicator) Replica(sequence int64, msg []byte) {
 
 	// lookup metric metadata
 	if err := r.shard.LookupRowMetricMeta(rows); err != nil {
-		r.statistics.ReplicaFailures.Incr()
-		r.logger.Error("failed lookup row metric meta",
+		if !errors.Is(err, constants.ErrSeriesQuotaExceeded) {
+			r.statistics.ReplicaFailures.Incr()
+			r.logger.Error("failed lookup row metric meta",
+				logger.Int64("sequence", sequence),
+				logger.Int("rows", r.batchRows.Len()),
+				logger.String("replicator", r.String()),
+				logger.Error(err))
+			return
+		}
+		// some rows rejected because series quota exceeded, writes other writable rows
+		r.logger.Warn("reject rows because series quota exceeded",
 			logger.Int64("sequence", sequence),
-			logger.Int("rows", r.batchRows.Len()),
 			logger.String("replicator", r.String()),
 			logger.Error(err))
-		return
 	}
 	// write metric data
 	if err := r.family.WriteRows(rows); err != nil {
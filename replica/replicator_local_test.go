@@ -120,6 +120,11 @@ func TestLocalReplicator_Replica(t *testing.T) {
 	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(fmt.Errorf("err"))
 	replicator.Replica(1, dst)
 
+	// series quota exceeded, write other rows
+	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).
+		Return(fmt.Errorf("%w, rejected rows: 1", &models.SeriesQuotaError{Scope: models.ShardQuotaScope}))
+	family.EXPECT().WriteRows(gomock.Any()).Return(nil)
+	replicator.Replica(1, dst)
 	// write failure
 	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(nil)
 	family.EXPECT().WriteRows(gomock.Any()).Return(fmt.Errorf("err"))
@@ -104,7 +104,11 @@ func (r *remoteReplicator) Connect() bool {
 
 	r.state.Store(&state{state: models.ReplicatorInitState, errMsg: "creating replica stream"})
 	// pass metadata(database/shard state) when create rpc connection.
-	replicaState := encoding.JSONMarshal(&r.channel.State)
+	channelState := *r.channel.State
+	if r.channel.Epoch != nil {
+		channelState.Epoch = r.channel.Epoch.Load()
+	}
+	replicaState := encoding.JSONMarshal(&channelState)
 	ctx := rpc.CreateOutgoingContextWithPairs(r.ctx,
 		constants.RPCMetaReplicaState, string(replicaState))
 	replicaStream, err := r.replicaCli.Replica(ctx) // TODO add timeout ??
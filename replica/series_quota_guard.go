@@ -0,0 +1,149 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"fmt"
+	"sync"
+
+	commonconstants "github.com/lindb/common/constants"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+)
+
+// for testing
+var (
+	seriesQuotaCoolDown = timeutil.OneMinute
+)
+
+// seriesQuotaGuard rejects the writes of series quota exceeded scopes which reported by storage,
+// storage creates series asynchronously after write acked, so the rejections are reported over write stream,
+// broker rejects the writes of these scopes(shard/namespace/metric) in cool-down period, then returns quota error to client.
+type seriesQuotaGuard struct {
+	rejections map[models.SeriesQuotaError]seriesQuotaRejection // key: scope/namespace/name
+	mutex      sync.RWMutex
+}
+
+// seriesQuotaRejection represents the rejection of series quota scope.
+type seriesQuotaRejection struct {
+	err      models.SeriesQuotaError
+	expireAt int64
+}
+
+// newSeriesQuotaGuard creates a series quota guard.
+func newSeriesQuotaGuard() *seriesQuotaGuard {
+	return &seriesQuotaGuard{
+		rejections: make(map[models.SeriesQuotaError]seriesQuotaRejection),
+	}
+}
+
+// update records the series quota rejections reported by storage.
+func (g *seriesQuotaGuard) update(errs []models.SeriesQuotaError) {
+	if len(errs) == 0 {
+		return
+	}
+	now := timeutil.Now()
+	expireAt := now + seriesQuotaCoolDown
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	for key, rejection := range g.rejections {
+		if rejection.expireAt <= now {
+			delete(g.rejections, key)
+		}
+	}
+	for idx := range errs {
+		err := errs[idx]
+		g.rejections[rejectionKey(err.Scope, err.Namespace, err.Name)] = seriesQuotaRejection{err: err, expireAt: expireAt}
+	}
+}
+
+// filter returns the rows which are not rejected, and the error wraps *models.SeriesQuotaError if some rows rejected.
+func (g *seriesQuotaGuard) filter(rows []metric.BrokerRow) ([]metric.BrokerRow, error) {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	if len(g.rejections) == 0 {
+		return rows, nil
+	}
+	var (
+		accepted []metric.BrokerRow
+		quotaErr *models.SeriesQuotaError
+		rejected int
+		now      = timeutil.Now()
+	)
+	for idx := range rows {
+		m := rows[idx].Metric()
+		namespace := commonconstants.DefaultNamespace
+		if len(m.Namespace()) > 0 {
+			namespace = string(m.Namespace())
+		}
+		err := g.check(now, namespace, string(m.Name()))
+		if err == nil {
+			if accepted != nil {
+				accepted = append(accepted, rows[idx])
+			}
+			continue
+		}
+		if accepted == nil {
+			// copy accepted rows before first rejected row
+			accepted = make([]metric.BrokerRow, idx, len(rows))
+			copy(accepted, rows[:idx])
+		}
+		if quotaErr == nil {
+			quotaErr = err
+		}
+		rejected++
+	}
+	if quotaErr == nil {
+		return rows, nil
+	}
+	return accepted, fmt.Errorf("%w, rejected rows: %d", quotaErr, rejected)
+}
+
+// check returns the rejection of row's shard/namespace/metric scope if exists and not expired.
+func (g *seriesQuotaGuard) check(now int64, namespace, metricName string) *models.SeriesQuotaError {
+	if err := g.get(now, rejectionKey(models.MetricQuotaScope, namespace, metricName)); err != nil {
+		return err
+	}
+	if err := g.get(now, rejectionKey(models.NamespaceQuotaScope, "", namespace)); err != nil {
+		return err
+	}
+	return g.get(now, rejectionKey(models.ShardQuotaScope, "", ""))
+}
+
+// get returns the rejection by key if exists and not expired.
+func (g *seriesQuotaGuard) get(now int64, key models.SeriesQuotaError) *models.SeriesQuotaError {
+	rejection, ok := g.rejections[key]
+	if !ok || rejection.expireAt <= now {
+		return nil
+	}
+	err := rejection.err
+	return &err
+}
+
+// rejectionKey returns the key of rejection, the name of shard scope is database name, ignore it.
+func rejectionKey(scope models.SeriesQuotaScope, namespace, name string) models.SeriesQuotaError {
+	if scope == models.ShardQuotaScope {
+		return models.SeriesQuotaError{Scope: scope}
+	}
+	return models.SeriesQuotaError{Scope: scope, Namespace: namespace, Name: name}
+}
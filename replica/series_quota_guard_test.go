@@ -0,0 +1,125 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+)
+
+func TestSeriesQuotaGuard_filter(t *testing.T) {
+	defer func() {
+		seriesQuotaCoolDown = timeutil.OneMinute
+	}()
+	newRows := func() []metric.BrokerRow {
+		converter := metric.NewProtoConverter()
+		var rows []metric.BrokerRow
+		for _, m := range []struct{ ns, name string }{{"", "cpu"}, {"ns", "cpu"}, {"ns", "memory"}, {"other", "disk"}} {
+			var row metric.BrokerRow
+			assert.NoError(t, converter.ConvertTo(&protoMetricsV1.Metric{
+				Namespace: m.ns,
+				Name:      m.name,
+				Timestamp: timeutil.Now(),
+				SimpleFields: []*protoMetricsV1.SimpleField{
+					{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1}},
+			}, &row))
+			rows = append(rows, row)
+		}
+		return rows
+	}
+	cases := []struct {
+		name     string
+		errs     []models.SeriesQuotaError
+		coolDown int64
+		accepted int
+	}{
+		{
+			name:     "no rejections",
+			accepted: 4,
+		},
+		{
+			name:     "metric scope rejected",
+			errs:     []models.SeriesQuotaError{{Scope: models.MetricQuotaScope, Namespace: "ns", Name: "cpu"}},
+			accepted: 3,
+		},
+		{
+			name:     "metric scope rejected, default namespace",
+			errs:     []models.SeriesQuotaError{{Scope: models.MetricQuotaScope, Namespace: "default-ns", Name: "cpu"}},
+			accepted: 3,
+		},
+		{
+			name:     "namespace scope rejected",
+			errs:     []models.SeriesQuotaError{{Scope: models.NamespaceQuotaScope, Name: "ns"}},
+			accepted: 2,
+		},
+		{
+			name:     "shard scope rejected",
+			errs:     []models.SeriesQuotaError{{Scope: models.ShardQuotaScope, Name: "db"}},
+			accepted: 0,
+		},
+		{
+			name:     "rejection expired",
+			errs:     []models.SeriesQuotaError{{Scope: models.ShardQuotaScope, Name: "db"}},
+			coolDown: -1,
+			accepted: 4,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			seriesQuotaCoolDown = timeutil.OneMinute
+			if tt.coolDown != 0 {
+				seriesQuotaCoolDown = tt.coolDown
+			}
+			g := newSeriesQuotaGuard()
+			g.update(tt.errs)
+			rows := newRows()
+			accepted, err := g.filter(rows)
+			assert.Len(t, accepted, tt.accepted)
+			if tt.accepted == len(rows) {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, constants.ErrSeriesQuotaExceeded)
+		})
+	}
+}
+
+func TestSeriesQuotaGuard_update(t *testing.T) {
+	defer func() {
+		seriesQuotaCoolDown = timeutil.OneMinute
+	}()
+	g := newSeriesQuotaGuard()
+	g.update(nil)
+	assert.Empty(t, g.rejections)
+	seriesQuotaCoolDown = -1
+	g.update([]models.SeriesQuotaError{{Scope: models.ShardQuotaScope, Name: "db"}})
+	assert.Len(t, g.rejections, 1)
+	// remove expired rejections
+	seriesQuotaCoolDown = timeutil.OneMinute
+	g.update([]models.SeriesQuotaError{{Scope: models.NamespaceQuotaScope, Name: "ns"}})
+	assert.Len(t, g.rejections, 1)
+}
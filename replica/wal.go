@@ -21,13 +21,19 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"path"
 	"strconv"
 	"sync"
 
+	"go.uber.org/atomic"
+
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/storage"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/rpc"
@@ -36,6 +42,16 @@ import (
 
 //go:generate mockgen -source=./wal.go -destination=./wal_mock.go -package=replica
 
+// epochFileName is the file name which stores the latest leader epoch of shards under database's write ahead log.
+const epochFileName = "EPOCH"
+
+// for testing
+var (
+	readFileFn  = os.ReadFile
+	writeFileFn = os.WriteFile
+	renameFn    = os.Rename
+)
+
 // WriteAheadLog represents write ahead log underlying fan out queue.
 type WriteAheadLog interface {
 	io.Closer
@@ -45,10 +61,14 @@ type WriteAheadLog interface {
 	// GetOrCreatePartition returns a partition of write ahead log.
 	// if exist returns it, else create a new partition.
 	GetOrCreatePartition(shardID models.ShardID, familyTime int64, leader models.NodeID) (Partition, error)
+	// CheckEpoch checks if the leader epoch of write/replica request is stale, records it if it's newer.
+	CheckEpoch(shardID models.ShardID, epoch int64) error
 	// Stop stops all replicator channels.
 	Stop()
 	// Drop drops write ahead log.
 	Drop() error
+	// DropShards drops write ahead log of shards, keep active shards.
+	DropShards(activeShards map[models.ShardID]struct{})
 	// getReplicaState returns the state of replica.
 	getReplicaState() (rs []models.FamilyLogReplicaState)
 	// recovery recoveries database write ahead log from local storage.
@@ -71,6 +91,9 @@ type writeAheadLog struct {
 	mutex sync.Mutex
 	// family log = shard + family + leader
 	familyLogs map[partitionKey]Partition
+	// latest leader epoch of each shard(shard id => *atomic.Int64)
+	epochs     sync.Map
+	epochMutex sync.Mutex // serializes epoch persistence
 
 	logger *logger.Logger
 }
@@ -142,12 +165,88 @@ func (w *writeAheadLog) GetOrCreatePartition(
 	if err != nil {
 		return nil, err
 	}
-	p := NewPartitionFn(w.ctx, shard, family, w.currentNodeID, q, w.cliFct, w.stateMgr)
+	p := NewPartitionFn(w.ctx, shard, family, w.currentNodeID, q, w.cliFct, w.stateMgr, w.getOrCreateEpoch(shardID))
 
 	w.familyLogs[key] = p
 	return p, nil
 }
 
+// CheckEpoch checks if the leader epoch of write/replica request is stale, records it if it's newer.
+// Newer epoch is persisted alongside write ahead log before accepting request,
+// so that stale leader is still fenced after node restart.
+func (w *writeAheadLog) CheckEpoch(shardID models.ShardID, epoch int64) error {
+	latest := w.getOrCreateEpoch(shardID)
+	if current := latest.Load(); epoch < current {
+		return constants.ErrStaleLeaderEpoch
+	} else if epoch == current {
+		return nil
+	}
+
+	w.epochMutex.Lock()
+	defer w.epochMutex.Unlock()
+	// double check, epoch maybe changed by other request
+	if current := latest.Load(); epoch < current {
+		return constants.ErrStaleLeaderEpoch
+	} else if epoch == current {
+		return nil
+	}
+	if err := w.persistEpochs(shardID, epoch); err != nil {
+		w.logger.Error("persist leader epoch failure",
+			logger.String("database", w.database),
+			logger.Any("shardID", shardID),
+			logger.Int64("epoch", epoch),
+			logger.Error(err))
+		return err
+	}
+	latest.Store(epoch)
+	return nil
+}
+
+// persistEpochs writes the latest leader epoch of all shards(include new epoch of given shard) into epoch file.
+func (w *writeAheadLog) persistEpochs(shardID models.ShardID, epoch int64) error {
+	epochs := map[models.ShardID]int64{shardID: epoch}
+	w.epochs.Range(func(key, value any) bool {
+		if id := key.(models.ShardID); id != shardID {
+			epochs[id] = value.(*atomic.Int64).Load()
+		}
+		return true
+	})
+	if err := fileutil.MkDirIfNotExist(w.dir); err != nil {
+		return err
+	}
+	// write temp file then rename it, avoid partial write
+	tmpFile := path.Join(w.dir, epochFileName+".tmp")
+	if err := writeFileFn(tmpFile, encoding.JSONMarshal(epochs), 0644); err != nil {
+		return err
+	}
+	return renameFn(tmpFile, path.Join(w.dir, epochFileName))
+}
+
+// loadEpochs loads the latest leader epoch of shards from epoch file.
+func (w *writeAheadLog) loadEpochs() error {
+	data, err := readFileFn(path.Join(w.dir, epochFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	epochs := make(map[models.ShardID]int64)
+	if err := encoding.JSONUnmarshal(data, &epochs); err != nil {
+		return err
+	}
+	for shardID, epoch := range epochs {
+		w.epochs.Store(shardID, atomic.NewInt64(epoch))
+	}
+	return nil
+}
+
+// getOrCreateEpoch returns the latest leader epoch of shard.
+func (w *writeAheadLog) getOrCreateEpoch(shardID models.ShardID) *atomic.Int64 {
+	epoch, _ := w.epochs.LoadOrStore(shardID, atomic.NewInt64(0))
+	return epoch.(*atomic.Int64)
+}
+
 // getReplicaState returns the state of replica.
 func (w *writeAheadLog) getReplicaState() (rs []models.FamilyLogReplicaState) {
 	w.mutex.Lock()
@@ -163,11 +262,17 @@ func (w *writeAheadLog) getReplicaState() (rs []models.FamilyLogReplicaState) {
 
 // recovery recoveries database write ahead log from local storage.
 func (w *writeAheadLog) recovery() error {
+	if err := w.loadEpochs(); err != nil {
+		return err
+	}
 	shards, err := listDirFn(w.dir)
 	if err != nil {
 		return err
 	}
 	for _, shard := range shards {
+		if shard == epochFileName || shard == epochFileName+".tmp" {
+			continue
+		}
 		families, err := listDirFn(path.Join(w.dir, shard))
 		if err != nil {
 			return err
@@ -260,6 +365,42 @@ func (w *writeAheadLog) destroy() {
 	}
 }
 
+// DropShards drops write ahead log of shards, keep active shards.
+func (w *writeAheadLog) DropShards(activeShards map[models.ShardID]struct{}) {
+	w.mutex.Lock()
+
+	newLogs := make(map[partitionKey]Partition)
+	dropLogs := make(map[partitionKey]Partition)
+	dropShards := make(map[models.ShardID]struct{})
+	for key, log := range w.familyLogs {
+		if _, ok := activeShards[key.shardID]; ok {
+			newLogs[key] = log
+		} else {
+			dropLogs[key] = log
+			dropShards[key.shardID] = struct{}{}
+		}
+	}
+	// set new logs
+	w.familyLogs = newLogs
+	w.mutex.Unlock()
+
+	for _, log := range dropLogs {
+		log.Stop()
+		if err := log.Close(); err != nil {
+			w.logger.Warn("close write ahead log", logger.String("path", log.Path()), logger.Error(err))
+		}
+	}
+	for shardID := range dropShards {
+		w.epochs.Delete(shardID)
+		shardDir := path.Join(w.dir, strconv.Itoa(int(shardID)))
+		if err := removeDirFn(shardDir); err != nil {
+			w.logger.Warn("remove shard write ahead log dir", logger.String("path", shardDir), logger.Error(err))
+			continue
+		}
+		w.logger.Info("drop shard write ahead log successfully", logger.String("path", shardDir))
+	}
+}
+
 // Close closes all log queues.
 func (w *writeAheadLog) Close() error {
 	w.mutex.Lock()
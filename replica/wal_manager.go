@@ -63,6 +63,8 @@ type WriteAheadLogManager interface {
 	GetReplicaState(database string) []models.FamilyLogReplicaState
 	// DropDatabases drops write ahead log of databases, keep active databases.
 	DropDatabases(activeDatabases map[string]struct{})
+	// DropShards drops write ahead log of database's shards, keep active shards.
+	DropShards(database string, activeShards map[models.ShardID]struct{})
 	// StopDatabases stop the replicator for write ahead log of databases, keep active databases.
 	StopDatabases(activeDatabases map[string]struct{})
 	// Recovery recoveries local history wal when server start.
@@ -223,6 +225,17 @@ func (w *writeAheadLogManager) DropDatabases(activeDatabases map[string]struct{}
 	}
 }
 
+// DropShards drops write ahead log of database's shards, keep active shards.
+func (w *writeAheadLogManager) DropShards(database string, activeShards map[models.ShardID]struct{}) {
+	w.mutex.Lock()
+	log, ok := w.databaseLogs[database]
+	w.mutex.Unlock()
+
+	if ok {
+		log.DropShards(activeShards)
+	}
+}
+
 // StopDatabases stop the replicator for write ahead log of databases, keep active databases.
 func (w *writeAheadLogManager) StopDatabases(activeDatabases map[string]struct{}) {
 	logs := w.getDatabaseLogs()
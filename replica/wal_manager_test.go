@@ -281,6 +281,22 @@ func TestMockWriteAheadLogMockRecorder_Drop(t *testing.T) {
 	}
 }
 
+func TestWriteAheadLogManager_DropShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	log1 := NewMockWriteAheadLog(ctrl)
+	mgr := &writeAheadLogManager{
+		databaseLogs: map[string]WriteAheadLog{
+			"test1": log1,
+		},
+		logger: logger.GetLogger("Test", "WAL"),
+	}
+	log1.EXPECT().DropShards(map[models.ShardID]struct{}{1: {}})
+	mgr.DropShards("test1", map[models.ShardID]struct{}{1: {}})
+	// database not exist
+	mgr.DropShards("test2", map[models.ShardID]struct{}{1: {}})
+}
+
 func TestMockWriteAheadLogMockRecorder_Stop(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -20,14 +20,17 @@ package replica
 import (
 	"context"
 	"fmt"
+	"os"
 	"path"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/coordinator/storage"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/fileutil"
@@ -95,7 +98,7 @@ func TestWriteAheadLog_GetOrCreatePartition(t *testing.T) {
 				}
 				NewPartitionFn = func(ctx context.Context, shard tsdb.Shard, family tsdb.DataFamily,
 					currentNodeID models.NodeID, log queue.FanOutQueue,
-					cliFct rpc.ClientStreamFactory, stateMgr storage.StateManager) Partition {
+					cliFct rpc.ClientStreamFactory, stateMgr storage.StateManager, epoch *atomic.Int64) Partition {
 					return NewMockPartition(ctrl)
 				}
 			},
@@ -342,6 +345,44 @@ func TestWriteAheadLog_destroy(t *testing.T) {
 	assert.Len(t, wal.familyLogs, 1)
 }
 
+func TestWriteAheadLog_DropShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		removeDirFn = fileutil.RemoveDir
+		ctrl.Finish()
+	}()
+
+	p1 := NewMockPartition(ctrl)
+	p2 := NewMockPartition(ctrl)
+	p2.EXPECT().Path().Return("p2").AnyTimes()
+	p3 := NewMockPartition(ctrl)
+	p3.EXPECT().Path().Return("p3").AnyTimes()
+	wal := &writeAheadLog{
+		dir: "wal",
+		familyLogs: map[partitionKey]Partition{
+			{shardID: 1}:                p1,
+			{shardID: 2}:                p2,
+			{shardID: 3, familyTime: 1}: p3,
+		},
+		logger: logger.GetLogger("Test", "WAL"),
+	}
+	p2.EXPECT().Stop()
+	p2.EXPECT().Close().Return(fmt.Errorf("err"))
+	p3.EXPECT().Stop()
+	p3.EXPECT().Close().Return(nil)
+	var removed []string
+	removeDirFn = func(path string) error {
+		removed = append(removed, path)
+		if path == "wal/2" {
+			return fmt.Errorf("err")
+		}
+		return nil
+	}
+	wal.DropShards(map[models.ShardID]struct{}{1: {}})
+	assert.Len(t, wal.familyLogs, 1)
+	assert.ElementsMatch(t, []string{"wal/2", "wal/3"}, removed)
+}
+
 func TestWriteAheadLog_Stop_Close(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -377,3 +418,50 @@ func TestWriteAheadLog_Drop(t *testing.T) {
 	}
 	assert.Error(t, wal.Drop())
 }
+
+func TestWriteAheadLog_CheckEpoch(t *testing.T) {
+	defer func() {
+		writeFileFn = os.WriteFile
+		renameFn = os.Rename
+		readFileFn = os.ReadFile
+	}()
+	listDirFn = fileutil.ListDir
+	dir := t.TempDir()
+	l := NewWriteAheadLog(context.TODO(), config.WAL{Dir: dir}, 1, "test", nil, nil, nil)
+	assert.NoError(t, l.CheckEpoch(1, 0))
+	assert.NoError(t, l.CheckEpoch(1, 2))
+	assert.NoError(t, l.CheckEpoch(1, 2))
+	assert.Equal(t, constants.ErrStaleLeaderEpoch, l.CheckEpoch(1, 1))
+	assert.NoError(t, l.CheckEpoch(2, 1))
+	assert.NoError(t, l.CheckEpoch(1, 3))
+	assert.Equal(t, constants.ErrStaleLeaderEpoch, l.CheckEpoch(1, 2))
+
+	// epoch persisted, recovery after restart
+	l2 := NewWriteAheadLog(context.TODO(), config.WAL{Dir: dir}, 1, "test", nil, nil, nil)
+	assert.NoError(t, l2.recovery())
+	assert.Equal(t, constants.ErrStaleLeaderEpoch, l2.CheckEpoch(1, 2))
+	assert.Equal(t, constants.ErrStaleLeaderEpoch, l2.CheckEpoch(2, 0))
+	assert.NoError(t, l2.CheckEpoch(1, 3))
+
+	// persist epoch failure, reject request and keep old epoch
+	writeFileFn = func(_ string, _ []byte, _ os.FileMode) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, l2.CheckEpoch(1, 4))
+	writeFileFn = os.WriteFile
+	renameFn = func(_, _ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, l2.CheckEpoch(1, 4))
+	assert.NoError(t, l2.CheckEpoch(1, 3))
+
+	// load epoch failure
+	readFileFn = func(_ string) ([]byte, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, l2.recovery())
+	readFileFn = func(_ string) ([]byte, error) {
+		return []byte("abc"), nil
+	}
+	assert.Error(t, l2.recovery())
+}
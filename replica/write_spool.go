@@ -0,0 +1,271 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"encoding/binary"
+	"errors"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/queue"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+//go:generate mockgen -source=./write_spool.go -destination=./write_spool_mock.go -package=replica
+
+// for testing
+var (
+	newQueueFn = queue.NewQueue
+)
+
+// spoolMsgHeaderSize represents the header size of spooled message, header just stores spool timestamp.
+const spoolMsgHeaderSize = 8
+
+// WriteSpool represents the durable buffer of family channel, which stores compressed chunk on disk
+// when storage leader is unavailable, then replays it after leader is reachable.
+type WriteSpool interface {
+	// Put appends message into spool, returns ErrWriteSpoolFull if spool exceeds the max size limit.
+	Put(message []byte) error
+	// Released returns a signal channel which will be closed when spool releases space.
+	Released() <-chan struct{}
+	// Replay sends spooled message in order, message is acknowledged after sent successfully,
+	// expired message will be dropped, returns err if send failure.
+	Replay(send func(message []byte) error) error
+	// Depth returns the number of spooled message.
+	Depth() int64
+	// Size returns the bytes of spooled message.
+	Size() int64
+	// Close closes the spool, removes spool dir if no pending message.
+	Close()
+}
+
+// writeSpool implements WriteSpool interface based on queue.
+type writeSpool struct {
+	dir     string
+	maxSize int64
+	maxAge  int64
+
+	q        queue.Queue
+	size     int64
+	released chan struct{}
+	closed   bool
+
+	mutex sync.Mutex
+
+	statistics *metrics.BrokerFamilyWriteStatistics
+	logger     *logger.Logger
+}
+
+// NewWriteSpool creates a write spool under given dir, recovers spooled message if exist.
+func NewWriteSpool(dir string, cfg config.WriteSpool, statistics *metrics.BrokerFamilyWriteStatistics) (WriteSpool, error) {
+	maxSize := int64(cfg.MaxSize)
+	// queue gc removes data page after all message in page acknowledged, so reserve more space for queue.
+	q, err := newQueueFn(dir, 2*maxSize)
+	if err != nil {
+		return nil, err
+	}
+	s := &writeSpool{
+		dir:        dir,
+		maxSize:    maxSize,
+		maxAge:     cfg.MaxAge.Duration().Milliseconds(),
+		q:          q,
+		released:   make(chan struct{}),
+		statistics: statistics,
+		logger:     logger.GetLogger("Replica", "WriteSpool"),
+	}
+	// recover size of spooled message
+	for seq := q.AcknowledgedSeq() + 1; seq <= q.AppendedSeq(); seq++ {
+		msg, err := q.Get(seq)
+		if err != nil {
+			q.Close()
+			return nil, err
+		}
+		s.size += int64(len(msg))
+	}
+	if depth := s.depth(); depth > 0 {
+		s.logger.Info("recover spooled write message",
+			logger.String("dir", dir), logger.Int64("depth", depth), logger.Int64("size", s.size))
+	}
+	s.statistics.SpoolDepth.Add(float64(s.depth()))
+	s.statistics.SpoolSize.Add(float64(s.size))
+	return s, nil
+}
+
+// Put appends message into spool, returns ErrWriteSpoolFull if spool exceeds the max size limit.
+func (s *writeSpool) Put(message []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return ErrWriteSpoolClosed
+	}
+	size := int64(len(message) + spoolMsgHeaderSize)
+	// make sure a single message larger than limit can be put into empty spool
+	if s.size > 0 && s.size+size > s.maxSize {
+		return ErrWriteSpoolFull
+	}
+	data := make([]byte, size)
+	binary.LittleEndian.PutUint64(data, uint64(timeutil.Now()))
+	copy(data[spoolMsgHeaderSize:], message)
+	if err := s.q.Put(data); err != nil {
+		if errors.Is(err, queue.ErrExceedingTotalSizeLimit) {
+			return ErrWriteSpoolFull
+		}
+		s.statistics.SpoolFailures.Incr()
+		return err
+	}
+	s.size += size
+	s.statistics.SpoolDepth.Incr()
+	s.statistics.SpoolSize.Add(float64(size))
+	return nil
+}
+
+// Released returns a signal channel which will be closed when spool releases space.
+func (s *writeSpool) Released() <-chan struct{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.released
+}
+
+// Replay sends spooled message in order, message is acknowledged after sent successfully,
+// expired message will be dropped, returns err if send failure.
+func (s *writeSpool) Replay(send func(message []byte) error) error {
+	acked := false
+	defer func() {
+		if acked {
+			s.mutex.Lock()
+			if !s.closed {
+				s.q.GC()
+			}
+			s.mutex.Unlock()
+		}
+	}()
+
+	now := timeutil.Now()
+	for {
+		s.mutex.Lock()
+		if s.closed {
+			s.mutex.Unlock()
+			return ErrWriteSpoolClosed
+		}
+		seq := s.q.AcknowledgedSeq() + 1
+		if seq > s.q.AppendedSeq() {
+			s.mutex.Unlock()
+			return nil
+		}
+		msg, err := s.q.Get(seq)
+		s.mutex.Unlock()
+
+		switch {
+		case err != nil || len(msg) < spoolMsgHeaderSize:
+			s.logger.Error("get spooled message failure, drop it",
+				logger.String("dir", s.dir), logger.Int64("sequence", seq), logger.Error(err))
+			s.statistics.SpoolFailures.Incr()
+			s.statistics.SpoolDrop.Incr()
+		case now-int64(binary.LittleEndian.Uint64(msg)) > s.maxAge:
+			s.statistics.SpoolDrop.Incr()
+		default:
+			if err0 := send(msg[spoolMsgHeaderSize:]); err0 != nil {
+				return err0
+			}
+			s.statistics.SpoolReplay.Incr()
+		}
+		s.ack(seq, int64(len(msg)))
+		acked = true
+	}
+}
+
+// ack acknowledges the message, then notifies the waiting writer.
+func (s *writeSpool) ack(seq, size int64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.q.SetAcknowledgedSeq(seq)
+	s.size -= size
+	s.statistics.SpoolDepth.Decr()
+	s.statistics.SpoolSize.Sub(float64(size))
+
+	close(s.released)
+	s.released = make(chan struct{})
+}
+
+// Depth returns the number of spooled message.
+func (s *writeSpool) Depth() int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return 0
+	}
+	return s.depth()
+}
+
+// Size returns the bytes of spooled message.
+func (s *writeSpool) Size() int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.size
+}
+
+// Close closes the spool, removes spool dir if no pending message.
+func (s *writeSpool) Close() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.closed {
+		return
+	}
+	depth := s.depth()
+	s.closed = true
+	s.q.Close()
+	close(s.released)
+
+	s.statistics.SpoolDepth.Sub(float64(depth))
+	s.statistics.SpoolSize.Sub(float64(s.size))
+
+	if depth == 0 {
+		if err := removeDirFn(s.dir); err != nil {
+			s.logger.Warn("remove empty write spool dir failure",
+				logger.String("dir", s.dir), logger.Error(err))
+		}
+		return
+	}
+	s.logger.Info("write spool closed with pending message",
+		logger.String("dir", s.dir), logger.Int64("depth", depth), logger.Int64("size", s.size))
+}
+
+// depth returns the number of spooled message, must be called under lock.
+func (s *writeSpool) depth() int64 {
+	return s.q.AppendedSeq() - s.q.AcknowledgedSeq()
+}
+
+// familySpoolDir returns the spool dir of family channel, like: dir/database/shard/family time.
+func familySpoolDir(dir, database string, shardID models.ShardID, familyTime int64) string {
+	return filepath.Join(dir, database, shardID.String(), strconv.FormatInt(familyTime, 10))
+}
@@ -0,0 +1,188 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package replica
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/pkg/fileutil"
+	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/queue"
+)
+
+func newTestSpoolCfg() config.WriteSpool {
+	return config.WriteSpool{
+		Enabled: true,
+		MaxSize: ltoml.Size(20),
+		MaxAge:  ltoml.Duration(time.Hour),
+	}
+}
+
+func TestWriteSpool_New(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newQueueFn = queue.NewQueue
+		ctrl.Finish()
+	}()
+	dir := filepath.Join(t.TempDir(), "spool")
+	statistics := metrics.NewBrokerFamilyWriteStatistics("spool-new")
+
+	// create queue failure
+	newQueueFn = func(_ string, _ int64) (queue.Queue, error) {
+		return nil, fmt.Errorf("err")
+	}
+	s, err := NewWriteSpool(dir, newTestSpoolCfg(), statistics)
+	assert.Error(t, err)
+	assert.Nil(t, s)
+	// get message failure when recover
+	q := queue.NewMockQueue(ctrl)
+	newQueueFn = func(_ string, _ int64) (queue.Queue, error) {
+		return q, nil
+	}
+	q.EXPECT().AcknowledgedSeq().Return(int64(-1))
+	q.EXPECT().AppendedSeq().Return(int64(0))
+	q.EXPECT().Get(int64(0)).Return(nil, fmt.Errorf("err"))
+	q.EXPECT().Close()
+	s, err = NewWriteSpool(dir, newTestSpoolCfg(), statistics)
+	assert.Error(t, err)
+	assert.Nil(t, s)
+	newQueueFn = queue.NewQueue
+
+	// recover spooled message after reopen
+	s, err = NewWriteSpool(dir, newTestSpoolCfg(), statistics)
+	assert.NoError(t, err)
+	assert.NoError(t, s.Put([]byte{1, 2, 3}))
+	assert.Equal(t, int64(1), s.Depth())
+	assert.Equal(t, int64(11), s.Size())
+	s.Close()
+	s.Close()
+	assert.True(t, fileutil.Exist(dir))
+	assert.Equal(t, int64(0), s.Depth())
+	assert.Equal(t, ErrWriteSpoolClosed, s.Put([]byte{1, 2, 3}))
+	assert.Equal(t, ErrWriteSpoolClosed, s.Replay(func(_ []byte) error { return nil }))
+
+	s, err = NewWriteSpool(dir, newTestSpoolCfg(), statistics)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), s.Depth())
+	assert.Equal(t, int64(11), s.Size())
+	assert.Equal(t, float64(1), statistics.SpoolDepth.Get())
+	assert.Equal(t, float64(11), statistics.SpoolSize.Get())
+	var replayed [][]byte
+	assert.NoError(t, s.Replay(func(message []byte) error {
+		replayed = append(replayed, append([]byte{}, message...))
+		return nil
+	}))
+	assert.Equal(t, [][]byte{{1, 2, 3}}, replayed)
+	assert.Equal(t, int64(0), s.Depth())
+	// remove spool dir after all message replayed
+	s.Close()
+	assert.False(t, fileutil.Exist(dir))
+	assert.Equal(t, float64(0), statistics.SpoolDepth.Get())
+	assert.Equal(t, float64(0), statistics.SpoolSize.Get())
+}
+
+func TestWriteSpool_Put(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s, err := NewWriteSpool(t.TempDir(), newTestSpoolCfg(), metrics.NewBrokerFamilyWriteStatistics("spool-put"))
+	assert.NoError(t, err)
+	defer s.Close()
+
+	// message larger than limit can be put into empty spool
+	assert.NoError(t, s.Put(make([]byte, 30)))
+	assert.Equal(t, ErrWriteSpoolFull, s.Put([]byte{1}))
+
+	released := s.Released()
+	assert.Error(t, s.Replay(func(_ []byte) error {
+		return fmt.Errorf("err")
+	}))
+	select {
+	case <-released:
+		assert.Fail(t, "spool shouldn't release space if replay failure")
+	default:
+	}
+	assert.NoError(t, s.Replay(func(_ []byte) error {
+		return nil
+	}))
+	<-released
+	assert.NoError(t, s.Put([]byte{1}))
+
+	// queue put failure
+	q := queue.NewMockQueue(ctrl)
+	s1 := s.(*writeSpool)
+	s1.q = q
+	q.EXPECT().Put(gomock.Any()).Return(queue.ErrExceedingTotalSizeLimit)
+	assert.Equal(t, ErrWriteSpoolFull, s1.Put([]byte{1}))
+	q.EXPECT().Put(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, s1.Put([]byte{1}))
+	q.EXPECT().AppendedSeq().Return(int64(0)).AnyTimes()
+	q.EXPECT().AcknowledgedSeq().Return(int64(0)).AnyTimes()
+	q.EXPECT().Close()
+}
+
+func TestWriteSpool_Replay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	statistics := metrics.NewBrokerFamilyWriteStatistics("spool-replay")
+	s, err := NewWriteSpool(t.TempDir(), newTestSpoolCfg(), statistics)
+	assert.NoError(t, err)
+	defer s.Close()
+
+	assert.NoError(t, s.Put([]byte{1}))
+	assert.NoError(t, s.Put([]byte{2}))
+	// drop expired message
+	s1 := s.(*writeSpool)
+	s1.maxAge = -1
+	dropped := statistics.SpoolDrop.Get()
+	failures := statistics.SpoolFailures.Get()
+	assert.NoError(t, s.Replay(func(_ []byte) error {
+		return fmt.Errorf("err")
+	}))
+	assert.Equal(t, int64(0), s.Depth())
+	assert.Equal(t, int64(0), s.Size())
+	assert.Equal(t, dropped+2, statistics.SpoolDrop.Get())
+
+	// drop invalid message
+	q := queue.NewMockQueue(ctrl)
+	s1.q = q
+	s1.size = 1
+	q.EXPECT().AcknowledgedSeq().Return(int64(1))
+	q.EXPECT().AppendedSeq().Return(int64(2))
+	q.EXPECT().Get(int64(2)).Return([]byte{1}, nil)
+	q.EXPECT().SetAcknowledgedSeq(int64(2))
+	q.EXPECT().AcknowledgedSeq().Return(int64(2))
+	q.EXPECT().AppendedSeq().Return(int64(2))
+	q.EXPECT().GC()
+	assert.NoError(t, s.Replay(func(_ []byte) error {
+		return nil
+	}))
+	assert.Equal(t, failures+1, statistics.SpoolFailures.Get())
+	q.EXPECT().AppendedSeq().Return(int64(2)).AnyTimes()
+	q.EXPECT().AcknowledgedSeq().Return(int64(2)).AnyTimes()
+	q.EXPECT().Close()
+}
@@ -19,10 +19,12 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"sync"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
@@ -61,6 +63,8 @@ type ClientConnFactory interface {
 	GetClientConn(target models.Node) (*grpc.ClientConn, error)
 	// CloseClientConn closes client connection for spec target node.
 	CloseClientConn(target models.Node) error
+	// SetTLSConfig sets the tls config of client connection, only used by the connections created after setting.
+	SetTLSConfig(tlsConfig *tls.Config)
 }
 
 // clientConnFactory implements ClientConnFactory.
@@ -70,6 +74,7 @@ type clientConnFactory struct {
 	// lock to protect connMap
 	mu            sync.RWMutex
 	clientTracker *conntrack.GRPCClientTracker
+	tlsConfig     *tls.Config
 }
 
 // GetBrokerClientConnFactory returns a singleton ClientConnFactory for broker side.
@@ -100,9 +105,13 @@ func (fct *clientConnFactory) GetClientConn(target models.Node) (*grpc.ClientCon
 	if conn0, ok := fct.connMap[indicator]; ok {
 		return conn0, nil
 	}
+	creds := insecure.NewCredentials()
+	if fct.tlsConfig != nil {
+		creds = credentials.NewTLS(fct.tlsConfig)
+	}
 	conn, err := grpc.Dial(
 		target.Indicator(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(creds),
 		grpc.WithStreamInterceptor(fct.clientTracker.StreamClientInterceptor()),
 		grpc.WithUnaryInterceptor(fct.clientTracker.UnaryClientInterceptor()),
 	)
@@ -114,6 +123,14 @@ func (fct *clientConnFactory) GetClientConn(target models.Node) (*grpc.ClientCon
 	return conn, nil
 }
 
+// SetTLSConfig sets the tls config of client connection, only used by the connections created after setting.
+func (fct *clientConnFactory) SetTLSConfig(tlsConfig *tls.Config) {
+	fct.mu.Lock()
+	defer fct.mu.Unlock()
+
+	fct.tlsConfig = tlsConfig
+}
+
 // CloseClientConn closes client connection for spec target node.
 func (fct *clientConnFactory) CloseClientConn(target models.Node) error {
 	indicator := target.Indicator()
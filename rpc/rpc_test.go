@@ -24,11 +24,16 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 
 	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/internal/conntrack"
 	"github.com/lindb/lindb/internal/linmetric"
+	"github.com/lindb/lindb/internal/mock"
 	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/ltoml"
+	"github.com/lindb/lindb/pkg/tlsutil"
 	protoCommonV1 "github.com/lindb/lindb/proto/gen/v1/common"
 )
 
@@ -101,3 +106,58 @@ func TestClientStreamFactory_CreateTaskClient(t *testing.T) {
 	time.Sleep(10 * time.Millisecond)
 	grpcServer.Stop()
 }
+
+func TestGRPCServer_TLS(t *testing.T) {
+	files := mock.GenerateTLSFiles(t, t.TempDir())
+	tlsCfg := config.TLS{
+		Enabled:    true,
+		CertFile:   files.CertFile,
+		KeyFile:    files.KeyFile,
+		CAFile:     files.CAFile,
+		ClientAuth: config.ClientAuthRequireAndVerify,
+	}
+	// load tls config failure
+	grpcServer := NewGRPCServer(config.GRPC{Port: 9011, TLS: config.TLS{Enabled: true}}, linmetric.StorageRegistry)
+	assert.Error(t, grpcServer.Start())
+
+	grpcServer = NewGRPCServer(config.GRPC{Port: 9011, ConnectTimeout: ltoml.Duration(time.Second), TLS: tlsCfg},
+		linmetric.StorageRegistry)
+	go func() {
+		_ = grpcServer.Start()
+	}()
+	defer grpcServer.Stop()
+	// wait server start finish
+	time.Sleep(10 * time.Millisecond)
+
+	target := &models.StatelessNode{HostIP: "127.0.0.1", GRPCPort: 9011}
+	waitReady := func(fct ClientConnFactory, timeout time.Duration) bool {
+		conn, err := fct.GetClientConn(target)
+		assert.NoError(t, err)
+		defer func() {
+			_ = fct.CloseClientConn(target)
+		}()
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		conn.Connect()
+		for state := conn.GetState(); state != connectivity.Ready; state = conn.GetState() {
+			if !conn.WaitForStateChange(ctx, state) {
+				return false
+			}
+		}
+		return true
+	}
+	newFct := func() ClientConnFactory {
+		return &clientConnFactory{
+			connMap:       make(map[string]*grpc.ClientConn),
+			clientTracker: conntrack.NewGRPCClientTracker(linmetric.StorageRegistry),
+		}
+	}
+	// insecure client
+	assert.False(t, waitReady(newFct(), 200*time.Millisecond))
+	// mutual tls client
+	loader, err := tlsutil.NewLoader(tlsCfg)
+	assert.NoError(t, err)
+	fct := newFct()
+	fct.SetTLSConfig(loader.ClientConfig())
+	assert.True(t, waitReady(fct, 5*time.Second))
+}
@@ -25,6 +25,7 @@ import (
 	grpcrecovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 
 	"github.com/lindb/lindb/config"
@@ -32,6 +33,7 @@ import (
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/tlsutil"
 )
 
 //go:generate mockgen -source ./server.go -destination=./server_mock.go -package=rpc
@@ -49,6 +51,7 @@ type grpcServer struct {
 	bindAddress string
 	gs          *grpc.Server
 	statistics  *metrics.GRPCServerStatistics
+	err         error // load tls config failure
 	logger      *logger.Logger
 }
 
@@ -64,28 +67,41 @@ func NewGRPCServer(cfg config.GRPC, r *linmetric.Registry) GRPCServer {
 			return status.Errorf(codes.Internal, "panic triggered: %v", p)
 		}),
 	}
+	serverOpts := []grpc.ServerOption{
+		grpc.ConnectionTimeout(cfg.ConnectTimeout.Duration()),
+		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
+			grpcServerTracker.StreamServerInterceptor(),
+			grpcrecovery.StreamServerInterceptor(opts...),
+		)),
+		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
+			grpcServerTracker.UnaryServerInterceptor(),
+			grpcrecovery.UnaryServerInterceptor(opts...),
+		)),
+		grpc.MaxConcurrentStreams(uint32(cfg.MaxConcurrentStreams)),
+	}
+	var err error
+	if cfg.TLS.Enabled {
+		var loader *tlsutil.Loader
+		loader, err = tlsutil.NewLoader(cfg.TLS)
+		if err == nil {
+			serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(loader.ServerConfig("h2"))))
+		}
+	}
 	return &grpcServer{
 		logger:      log,
 		statistics:  statistics,
 		bindAddress: fmt.Sprintf(":%d", cfg.Port),
-		gs: grpc.NewServer(
-			grpc.ConnectionTimeout(cfg.ConnectTimeout.Duration()),
-			grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
-				grpcServerTracker.StreamServerInterceptor(),
-				grpcrecovery.StreamServerInterceptor(opts...),
-			)),
-			grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
-				grpcServerTracker.UnaryServerInterceptor(),
-				grpcrecovery.UnaryServerInterceptor(opts...),
-			)),
-			grpc.MaxConcurrentStreams(uint32(cfg.MaxConcurrentStreams)),
-		),
+		err:         err,
+		gs:          grpc.NewServer(serverOpts...),
 	}
 }
 
 // Start listens the bind address and serves grpc tcpServer,
 // block the caller, return fatal error or non-nil error if server is not stop gracefully.
 func (s *grpcServer) Start() error {
+	if s.err != nil {
+		return s.err
+	}
 	lis, err := net.Listen("tcp", s.bindAddress)
 	if err != nil {
 		return err
@@ -40,6 +40,9 @@ type WriteStream interface {
 	Send(data []byte) error
 }
 
+// SeriesQuotaHandler handles the series quota rejections which reported by storage.
+type SeriesQuotaHandler func(errs []models.SeriesQuotaError)
+
 // writeStream implements WriteStream interface.
 type writeStream struct {
 	ctx    context.Context
@@ -50,9 +53,10 @@ type writeStream struct {
 	shardState *models.ShardState
 	familyTime int64
 
-	fct    ClientStreamFactory
-	cli    protoWriteV1.WriteService_WriteClient
-	closed *atomic.Bool
+	fct          ClientStreamFactory
+	cli          protoWriteV1.WriteService_WriteClient
+	closed       *atomic.Bool
+	quotaHandler SeriesQuotaHandler
 
 	logger *logger.Logger
 }
@@ -63,18 +67,20 @@ func NewWriteStream(
 	target models.Node,
 	database string, shardState *models.ShardState, familyTime int64,
 	fct ClientStreamFactory,
+	quotaHandler SeriesQuotaHandler,
 ) (WriteStream, error) {
 	c, cancel := context.WithCancel(ctx)
 	s := &writeStream{
-		ctx:        c,
-		cancel:     cancel,
-		target:     target,
-		database:   database,
-		shardState: shardState,
-		familyTime: familyTime,
-		fct:        fct,
-		closed:     atomic.NewBool(false),
-		logger:     logger.GetLogger("RPC", "WriteStream"),
+		ctx:          c,
+		cancel:       cancel,
+		target:       target,
+		database:     database,
+		shardState:   shardState,
+		familyTime:   familyTime,
+		fct:          fct,
+		closed:       atomic.NewBool(false),
+		quotaHandler: quotaHandler,
+		logger:       logger.GetLogger("RPC", "WriteStream"),
 	}
 
 	// initialize write stream
@@ -177,6 +183,25 @@ func (s *writeStream) recvLoop() {
 					logger.String("target", s.target.Indicator()),
 					logger.String("err", resp.Err))
 			}
+			if len(resp.SeriesQuotaErrors) > 0 && s.quotaHandler != nil {
+				s.handleSeriesQuotaErrors(resp.SeriesQuotaErrors)
+			}
 		}
 	}
 }
+
+// handleSeriesQuotaErrors decodes the series quota rejections of shard, then notifies handler.
+func (s *writeStream) handleSeriesQuotaErrors(data []byte) {
+	var errs []models.SeriesQuotaError
+	if err := encoding.JSONUnmarshal(data, &errs); err != nil {
+		s.logger.Error("decode series quota errors from write response failure",
+			logger.String("target", s.target.Indicator()),
+			logger.Error(err))
+		return
+	}
+	s.logger.Warn("series quota exceeded, reject writes of these scopes",
+		logger.String("database", s.database),
+		logger.Any("shard", s.shardState.ID),
+		logger.Any("errs", errs))
+	s.quotaHandler(errs)
+}
@@ -28,6 +28,7 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
 	protoWriteV1 "github.com/lindb/lindb/proto/gen/v1/write"
 )
@@ -40,7 +41,7 @@ func TestNewWriteStream(t *testing.T) {
 
 	// case 1: create write service cli err
 	fct.EXPECT().CreateWriteServiceClient(gomock.Any()).Return(nil, fmt.Errorf("err"))
-	stream, err := NewWriteStream(context.TODO(), nil, "test", &models.ShardState{}, 1, fct)
+	stream, err := NewWriteStream(context.TODO(), nil, "test", &models.ShardState{}, 1, fct, nil)
 	assert.Error(t, err)
 	assert.Nil(t, stream)
 
@@ -48,7 +49,7 @@ func TestNewWriteStream(t *testing.T) {
 	writeSrv := protoWriteV1.NewMockWriteServiceClient(ctrl)
 	fct.EXPECT().CreateWriteServiceClient(gomock.Any()).Return(writeSrv, nil).AnyTimes()
 	writeSrv.EXPECT().Write(gomock.Any()).Return(nil, fmt.Errorf("err"))
-	stream, err = NewWriteStream(context.TODO(), nil, "test", &models.ShardState{}, 1, fct)
+	stream, err = NewWriteStream(context.TODO(), nil, "test", &models.ShardState{}, 1, fct, nil)
 	assert.Error(t, err)
 	assert.Nil(t, stream)
 
@@ -57,7 +58,7 @@ func TestNewWriteStream(t *testing.T) {
 	writeSrv.EXPECT().Write(gomock.Any()).Return(cli, nil)
 	cli.EXPECT().Recv().Return(nil, io.EOF).AnyTimes()
 	cli.EXPECT().Context().Return(context.TODO()).AnyTimes()
-	stream, err = NewWriteStream(context.TODO(), &models.StatefulNode{}, "test", &models.ShardState{}, 1, fct)
+	stream, err = NewWriteStream(context.TODO(), &models.StatefulNode{}, "test", &models.ShardState{}, 1, fct, nil)
 	assert.NoError(t, err)
 	assert.NotNil(t, stream)
 
@@ -118,4 +119,22 @@ func TestWriteStream_Recv(t *testing.T) {
 	cli.EXPECT().Recv().Return(&protoWriteV1.WriteResponse{Err: "err"}, nil)
 	cli.EXPECT().Recv().Return(nil, io.EOF)
 	stream.recvLoop()
+	// case 4: handle series quota errors
+	var quotaErrs []models.SeriesQuotaError
+	stream = &writeStream{
+		cli:        cli,
+		closed:     atomic.NewBool(false),
+		target:     &models.StatefulNode{},
+		shardState: &models.ShardState{},
+		quotaHandler: func(errs []models.SeriesQuotaError) {
+			quotaErrs = append(quotaErrs, errs...)
+		},
+		logger: logger.GetLogger("RPC", "WriteStream"),
+	}
+	errs := []models.SeriesQuotaError{{Scope: models.ShardQuotaScope, Name: "test", Quota: 1, Usage: 1}}
+	cli.EXPECT().Recv().Return(&protoWriteV1.WriteResponse{SeriesQuotaErrors: []byte("xx")}, nil)
+	cli.EXPECT().Recv().Return(&protoWriteV1.WriteResponse{SeriesQuotaErrors: encoding.JSONMarshal(errs)}, nil)
+	cli.EXPECT().Recv().Return(nil, io.EOF)
+	stream.recvLoop()
+	assert.Equal(t, errs, quotaErrs)
 }
@@ -47,6 +47,9 @@ const (
 	Max
 	Last
 	First
+	// Sample keeps the raw values of each time slot without aggregating,
+	// these values are stored as multi layers which one layer holds at most one value for each time slot.
+	Sample
 )
 
 // Aggregate aggregates two float64 values into one
@@ -149,28 +152,28 @@ func (t Type) IsFuncSupported(funcType function.FuncType) bool {
 		}
 	case MinField:
 		switch funcType {
-		case function.Min:
+		case function.Min, function.Percentile:
 			return true
 		default:
 			return false
 		}
 	case MaxField:
 		switch funcType {
-		case function.Max:
+		case function.Max, function.Percentile:
 			return true
 		default:
 			return false
 		}
 	case LastField:
 		switch funcType {
-		case function.Sum, function.Min, function.Max, function.Last:
+		case function.Sum, function.Min, function.Max, function.Last, function.Percentile:
 			return true
 		default:
 			return false
 		}
 	case FirstField:
 		switch funcType {
-		case function.Sum, function.Min, function.Max, function.First:
+		case function.Sum, function.Min, function.Max, function.First, function.Percentile:
 			return true
 		default:
 			return false
@@ -261,6 +264,8 @@ func getFieldParamsForMaxField(funcType function.FuncType) []AggType {
 	switch funcType {
 	case function.Min:
 		return []AggType{Min}
+	case function.Percentile:
+		return []AggType{Sample}
 	default:
 		return []AggType{Max}
 	}
@@ -270,6 +275,8 @@ func getFieldParamsForMinField(funcType function.FuncType) []AggType {
 	switch funcType {
 	case function.Max:
 		return []AggType{Max}
+	case function.Percentile:
+		return []AggType{Sample}
 	default:
 		return []AggType{Min}
 	}
@@ -283,6 +290,8 @@ func getFieldParamsForFirstField(funcType function.FuncType) []AggType {
 		return []AggType{Min}
 	case function.Sum:
 		return []AggType{Sum}
+	case function.Percentile:
+		return []AggType{Sample}
 	default:
 		return []AggType{First}
 	}
@@ -296,6 +305,8 @@ func getFieldParamsForLastField(funcType function.FuncType) []AggType {
 		return []AggType{Min}
 	case function.Sum:
 		return []AggType{Sum}
+	case function.Percentile:
+		return []AggType{Sample}
 	default:
 		return []AggType{Last}
 	}
@@ -68,6 +68,13 @@ func TestIsSupportFunc(t *testing.T) {
 	assert.False(t, MinField.IsFuncSupported(function.Quantile))
 
 	assert.False(t, Unknown.IsFuncSupported(function.Quantile))
+
+	assert.True(t, LastField.IsFuncSupported(function.Percentile))
+	assert.True(t, MaxField.IsFuncSupported(function.Percentile))
+	assert.True(t, MinField.IsFuncSupported(function.Percentile))
+	assert.True(t, FirstField.IsFuncSupported(function.Percentile))
+	assert.False(t, SumField.IsFuncSupported(function.Percentile))
+	assert.False(t, HistogramField.IsFuncSupported(function.Percentile))
 }
 
 func TestAggType_Aggregate(t *testing.T) {
@@ -117,6 +124,11 @@ func TestType_GetFuncFieldParams(t *testing.T) {
 	assert.Equal(t, []AggType{Max}, FirstField.GetFuncFieldParams(function.Max))
 	assert.Equal(t, []AggType{Min}, FirstField.GetFuncFieldParams(function.Min))
 	assert.Equal(t, []AggType{First}, FirstField.GetFuncFieldParams(function.First))
+
+	assert.Equal(t, []AggType{Sample}, LastField.GetFuncFieldParams(function.Percentile))
+	assert.Equal(t, []AggType{Sample}, FirstField.GetFuncFieldParams(function.Percentile))
+	assert.Equal(t, []AggType{Sample}, MaxField.GetFuncFieldParams(function.Percentile))
+	assert.Equal(t, []AggType{Sample}, MinField.GetFuncFieldParams(function.Percentile))
 }
 
 func TestType_GetDefaultFuncFieldParams(t *testing.T) {
@@ -181,20 +181,21 @@ func (itr *CompoundFieldIterator) BucketName() field.Name {
 	return field.Name(BucketNameOfHistogramExplicitBound(itr.NextExplicitBound()))
 }
 
+// field names of histogram's sum/count/max/min.
 const (
-	histogramSum   = field.Name("HistogramSum")
-	histogramCount = field.Name("HistogramCount")
-	histogramMax   = field.Name("HistogramMax")
-	histogramMin   = field.Name("HistogramMin")
+	HistogramSumField   = field.Name("HistogramSum")
+	HistogramCountField = field.Name("HistogramCount")
+	HistogramMaxField   = field.Name("HistogramMax")
+	HistogramMinField   = field.Name("HistogramMin")
 )
 
-func (itr *CompoundFieldIterator) HistogramSumFieldName() field.Name { return histogramSum }
+func (itr *CompoundFieldIterator) HistogramSumFieldName() field.Name { return HistogramSumField }
 
-func (itr *CompoundFieldIterator) HistogramCountFieldName() field.Name { return histogramCount }
+func (itr *CompoundFieldIterator) HistogramCountFieldName() field.Name { return HistogramCountField }
 
-func (itr *CompoundFieldIterator) HistogramMaxFieldName() field.Name { return histogramMax }
+func (itr *CompoundFieldIterator) HistogramMaxFieldName() field.Name { return HistogramMaxField }
 
-func (itr *CompoundFieldIterator) HistogramMinFieldName() field.Name { return histogramMin }
+func (itr *CompoundFieldIterator) HistogramMinFieldName() field.Name { return HistogramMinField }
 
 // BucketNameOfHistogramExplicitBound converts reserved field-name for histogram buckets.
 func BucketNameOfHistogramExplicitBound(upperBound float64) string {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/lindb/lindb/pkg/collections"
 	"github.com/lindb/lindb/pkg/strutil"
+	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/sql/grammar"
 	"github.com/lindb/lindb/sql/stmt"
 )
@@ -30,11 +31,16 @@ import (
 type baseStmtParser struct {
 	namespace  string
 	metricName string
+	sources    []*stmt.MetricSource // all metrics of from clause
 
 	exprStack *collections.Stack
 	condition stmt.Expr
 
-	limit int
+	startTime int64
+	endTime   int64
+
+	limit    int
+	hasLimit bool // limit set by limit clause explicitly
 
 	err error
 }
@@ -50,11 +56,24 @@ func (b *baseStmtParser) visitLimit(ctx *grammar.LimitClauseContext) {
 		return
 	}
 	b.limit = int(limit)
+	b.hasLimit = true
 }
 
 // visitMetricName visits when production metricName expression is entered
 func (b *baseStmtParser) visitMetricName(ctx *grammar.MetricNameContext) {
-	b.metricName = strutil.GetStringValue(ctx.Ident().GetText())
+	metricName := strutil.GetStringValue(ctx.Ident().GetText())
+	if b.metricName == "" {
+		b.metricName = metricName
+	}
+	b.sources = append(b.sources, &stmt.MetricSource{MetricName: metricName})
+}
+
+// visitMetricAlias visits when production metric alias expression is entered
+func (b *baseStmtParser) visitMetricAlias(ctx *grammar.MetricAliasContext) {
+	if len(b.sources) == 0 {
+		return
+	}
+	b.sources[len(b.sources)-1].Alias = strutil.GetStringValue(ctx.Ident().GetText())
 }
 
 // visitPrefix visits when production namespace expression is entered
@@ -69,6 +88,8 @@ func (b *baseStmtParser) visitTagFilterExpr(ctx *grammar.TagFilterExprContext) {
 	switch {
 	case ctx.TagKey() != nil:
 		expr = b.createTagFilterExpr(tagKey, ctx)
+	case ctx.TagRangeKey() != nil:
+		expr = b.createTagRangeFilterExpr(ctx)
 	case ctx.T_OPEN_P() != nil:
 		expr = &stmt.ParenExpr{}
 	case ctx.T_AND() != nil:
@@ -86,7 +107,8 @@ func (b *baseStmtParser) visitTagValue(ctx *grammar.TagValueContext) {
 		return
 	}
 	tagFilterExpr := b.exprStack.Peek()
-	tagValue := strutil.GetStringValue(ctx.Ident().GetText())
+	// tag value is ident(string) or number(e.g. status_code >= 500)
+	tagValue := strutil.GetStringValue(ctx.GetText())
 	switch expr := tagFilterExpr.(type) {
 	case *stmt.NotExpr:
 		b.setTagFilterExprValue(expr.Expr, tagValue)
@@ -106,6 +128,14 @@ func (b *baseStmtParser) setTagFilterExprValue(expr stmt.Expr, tagValue string)
 		e.Regexp = tagValue
 	case *stmt.InExpr:
 		e.Values = append(e.Values, tagValue)
+	case *stmt.CompareExpr:
+		e.Value = tagValue
+	case *stmt.BetweenExpr:
+		if e.Start == "" {
+			e.Start = tagValue
+		} else {
+			e.End = tagValue
+		}
 	}
 }
 
@@ -130,6 +160,8 @@ func (b *baseStmtParser) createTagFilterExpr(tagKey grammar.ITagKeyContext,
 			expr = &stmt.NotExpr{Expr: &stmt.RegexExpr{Key: tagKeyStr}}
 		case ctx.T_NOTEQUAL() != nil || ctx.T_NOTEQUAL2() != nil:
 			expr = &stmt.NotExpr{Expr: &stmt.EqualsExpr{Key: tagKeyStr}}
+		case ctx.T_BETWEEN() != nil:
+			expr = &stmt.BetweenExpr{Key: tagKeyStr}
 		case ctx.T_IN() != nil:
 			if ctx.T_NOT() != nil {
 				expr = &stmt.NotExpr{Expr: &stmt.InExpr{Key: tagKeyStr}}
@@ -141,6 +173,22 @@ func (b *baseStmtParser) createTagFilterExpr(tagKey grammar.ITagKeyContext,
 	return expr
 }
 
+// createTagRangeFilterExpr creates tag range filter expr like <, <=, > and >=.
+func (b *baseStmtParser) createTagRangeFilterExpr(ctx *grammar.TagFilterExprContext) stmt.Expr {
+	expr := &stmt.CompareExpr{Key: strutil.GetStringValue(ctx.TagRangeKey().GetText())}
+	switch {
+	case ctx.T_LESS() != nil:
+		expr.Operator = stmt.LESS
+	case ctx.T_LESSEQUAL() != nil:
+		expr.Operator = stmt.LESSEQUAL
+	case ctx.T_GREATER() != nil:
+		expr.Operator = stmt.GREATER
+	case ctx.T_GREATEREQUAL() != nil:
+		expr.Operator = stmt.GREATEREQUAL
+	}
+	return expr
+}
+
 // completeTagFilterExpr completes a tag filter expression for query condition
 func (b *baseStmtParser) completeTagFilterExpr() {
 	expr := b.exprStack.Pop()
@@ -184,3 +232,86 @@ func (b *baseStmtParser) setExprParam(param stmt.Expr) {
 	default:
 	}
 }
+
+// visitTimeRangeExpr visits when production timeRange expression is entered.
+func (b *baseStmtParser) visitTimeRangeExpr(ctx *grammar.TimeRangeExprContext) {
+	timeExprCtxList := ctx.AllTimeExpr()
+	for _, timeExpr := range timeExprCtxList {
+		timeExprCtx, ok := timeExpr.(*grammar.TimeExprContext)
+		if !ok {
+			continue
+		}
+		var timestamp int64
+		var err error
+		switch {
+		case timeExprCtx.Ident() != nil:
+			timestamp, err = timeutil.ParseTimestamp(strutil.GetStringValue(timeExprCtx.Ident().GetText()))
+		case timeExprCtx.NowExpr() != nil:
+			timestamp = timeutil.Now()
+			durationExpr, durationExist := timeExprCtx.NowExpr().(*grammar.NowExprContext)
+			if durationExist {
+				timestamp += b.parseDuration(durationExpr.DurationLit())
+			}
+		}
+		if err != nil {
+			b.err = err
+			continue
+		}
+		binaryOp := timeExprCtx.BinaryOperator()
+		if binaryOp == nil {
+			continue
+		}
+		binaryOpCtx, ok := binaryOp.(*grammar.BinaryOperatorContext)
+		if !ok {
+			continue
+		}
+		if binaryOpCtx.T_GREATER() != nil || binaryOpCtx.T_GREATEREQUAL() != nil {
+			b.startTime = timestamp
+		}
+		if binaryOpCtx.T_LESS() != nil || binaryOpCtx.T_LESSEQUAL() != nil {
+			b.endTime = timestamp
+		}
+	}
+}
+
+// parseDuration parses time duration from duration string
+func (b *baseStmtParser) parseDuration(ctx grammar.IDurationLitContext) int64 {
+	if ctx == nil {
+		return 0
+	}
+	durationCtx, ok := ctx.(*grammar.DurationLitContext)
+	if !ok {
+		return 0
+	}
+
+	duration, err := strconv.ParseInt(durationCtx.IntNumber().GetText(), 10, 64)
+	if err != nil {
+		b.err = err
+		return 0
+	}
+	var result int64
+	if durationCtx.IntervalItem() == nil {
+		return result
+	}
+	unit, ok := durationCtx.IntervalItem().(*grammar.IntervalItemContext)
+	if !ok {
+		return result
+	}
+	switch {
+	case unit.T_SECOND() != nil:
+		result = duration * timeutil.OneSecond
+	case unit.T_MINUTE() != nil:
+		result = duration * timeutil.OneMinute
+	case unit.T_HOUR() != nil:
+		result = duration * timeutil.OneHour
+	case unit.T_DAY() != nil:
+		result = duration * timeutil.OneDay
+	case unit.T_WEEK() != nil:
+		result = duration * timeutil.OneWeek
+	case unit.T_MONTH() != nil:
+		result = duration * timeutil.OneMonth
+	case unit.T_YEAR() != nil:
+		result = duration * timeutil.OneYear
+	}
+	return result
+}
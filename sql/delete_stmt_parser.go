@@ -0,0 +1,69 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"fmt"
+
+	commonconstants "github.com/lindb/common/constants"
+
+	"github.com/lindb/lindb/pkg/collections"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// deleteStmtParser represents delete statement parser.
+type deleteStmtParser struct {
+	baseStmtParser
+}
+
+// newDeleteStmtParser creates a delete statement parser.
+func newDeleteStmtParser() *deleteStmtParser {
+	return &deleteStmtParser{
+		baseStmtParser: baseStmtParser{
+			exprStack: collections.NewStack(),
+			namespace: commonconstants.DefaultNamespace,
+		},
+	}
+}
+
+// build returns the delete statement, if without time range deletes the whole history(until now) of series.
+func (s *deleteStmtParser) build() (stmt.Statement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.metricName == "" {
+		return nil, fmt.Errorf("metric name cannot be empty")
+	}
+	timeRange := timeutil.TimeRange{Start: s.startTime, End: s.endTime}
+	if timeRange.Start < 0 {
+		timeRange.Start = 0
+	}
+	if timeRange.End <= 0 {
+		timeRange.End = timeutil.Now()
+	}
+	if timeRange.End < timeRange.Start {
+		return nil, fmt.Errorf("start time cannot be larger than end time")
+	}
+	return &stmt.Delete{
+		Namespace:  s.namespace,
+		MetricName: s.metricName,
+		Condition:  s.condition,
+		TimeRange:  timeRange,
+	}, nil
+}
@@ -0,0 +1,85 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestDeleteStmt_validation(t *testing.T) {
+	deleteStmt := newDeleteStmtParser()
+	// case 1: parse err
+	deleteStmt.err = fmt.Errorf("err")
+	s, err := deleteStmt.build()
+	assert.Error(t, err)
+	assert.Nil(t, s)
+	// case 2: metric name empty
+	deleteStmt = newDeleteStmtParser()
+	s, err = deleteStmt.build()
+	assert.Error(t, err)
+	assert.Nil(t, s)
+	// case 3: time range invalid
+	s, err = Parse("delete from cpu where time>'20190410 10:00:00' and time<'20190410 09:00:00'")
+	assert.Error(t, err)
+	assert.Nil(t, s)
+	// case 4: where clause required
+	s, err = Parse("delete from cpu")
+	assert.Error(t, err)
+	assert.Nil(t, s)
+}
+
+func TestDeleteStmt_TagFilter(t *testing.T) {
+	now := timeutil.Now()
+	q, err := Parse("delete from cpu on 'ns' where host='1.1.1.1' and (region in ('sh','bj') or zone like 'a*')")
+	assert.NoError(t, err)
+	deleteStmt := q.(*stmt.Delete)
+	assert.Equal(t, stmt.DeleteStatement, deleteStmt.StatementType())
+	assert.Equal(t, "ns", deleteStmt.Namespace)
+	assert.Equal(t, "cpu", deleteStmt.MetricName)
+	assert.Equal(t,
+		"host=1.1.1.1and(region in (sh,bj)orzone like a*)",
+		deleteStmt.Condition.Rewrite())
+	// without time range, deletes whole history until now
+	assert.True(t, deleteStmt.IsWholeHistory())
+	assert.True(t, deleteStmt.TimeRange.End >= now)
+}
+
+func TestDeleteStmt_TimeRange(t *testing.T) {
+	q, err := Parse("delete from cpu where host='1.1.1.1' and time>'20190410 10:00:00' and time<'20190410 12:00:00'")
+	assert.NoError(t, err)
+	deleteStmt := q.(*stmt.Delete)
+	start, _ := timeutil.ParseTimestamp("20190410 10:00:00")
+	end, _ := timeutil.ParseTimestamp("20190410 12:00:00")
+	assert.Equal(t, "default-ns", deleteStmt.Namespace)
+	assert.Equal(t, "host=1.1.1.1", deleteStmt.Condition.Rewrite())
+	assert.Equal(t, timeutil.TimeRange{Start: start, End: end}, deleteStmt.TimeRange)
+	assert.False(t, deleteStmt.IsWholeHistory())
+
+	// only time range, deletes all series
+	q, err = Parse("delete from cpu where time>now()-1h")
+	assert.NoError(t, err)
+	deleteStmt = q.(*stmt.Delete)
+	assert.Nil(t, deleteStmt.Condition)
+	assert.False(t, deleteStmt.IsWholeHistory())
+}
@@ -8,6 +8,14 @@ statement               : showStmt
                         | queryStmt
                         | createDatabaseStmt
                         | dropDatabaseStmt
+                        | alterDatabaseStmt
+                        | deleteStmt
+                        | createUserStmt
+                        | dropUserStmt
+                        | createTokenStmt
+                        | grantStmt
+                        | revokeStmt
+                        | killQueryStmt
                         | ident // just for suggest filtering.
                         EOF ;
 
@@ -32,11 +40,16 @@ showStmt                : showMasterStmt
                         | showTagValuesStmt
 						| showRequestsStmt
 						| showRequestStmt
+                        | showSeriesQuotaStmt
+                        | showCardinalityStmt
+                        | showTagCardinalityStmt
+                        | showUsersStmt
                         ;
 //meta data query statement
 showMasterStmt       : T_SHOW T_MASTER ;
 showRequestsStmt     : T_SHOW T_REQUESTS ; 
 showRequestStmt      : T_SHOW T_REQUEST T_WHERE T_ID T_EQUAL requestID;
+killQueryStmt        : T_KILL T_QUERY requestID ;
 showStoragesStmt     : T_SHOW T_STORAGES ;
 showMetadataTypesStmt: T_SHOW T_METADATA T_TYPES;
 showBrokerMetaStmt   : T_SHOW T_BROKER T_METADATA T_FROM source T_WHERE typeFilter;
@@ -46,16 +59,31 @@ showAliveStmt        : T_SHOW (T_BROKER | T_STORAGE) T_ALIVE;
 showReplicationStmt  : T_SHOW T_REPLICATION T_WHERE (storageFilter|databaseFilter) T_AND (storageFilter|databaseFilter);
 showBrokerMetricStmt : T_SHOW T_BROKER T_METRIC T_WHERE metricListFilter ;
 showStorageMetricStmt: T_SHOW T_STORAGE T_METRIC T_WHERE (storageFilter|metricListFilter) T_AND (storageFilter|metricListFilter) ;
+showSeriesQuotaStmt  : T_SHOW T_SERIES T_QUOTA (T_WHERE databaseFilter)? limitClause?;
 createStorageStmt    : T_CREATE T_STORAGE json;
 showSchemasStmt      : T_SHOW T_SCHEMAS ;
 createDatabaseStmt   : T_CREATE T_DATASBAE json;
 dropDatabaseStmt     : T_DROP T_DATASBAE databaseName;
+alterDatabaseStmt    : T_ALTER T_DATASBAE databaseName T_WITH json;
+deleteStmt           : T_DELETE T_FROM metricName (T_ON namespace)? whereClause;
 showDatabaseStmt     : T_SHOW T_DATASBAES ;
 showNameSpacesStmt   : T_SHOW T_NAMESPACES (T_WHERE T_NAMESPACE T_EQUAL prefix)? limitClause?;
 showMetricsStmt      : T_SHOW T_METRICS (T_ON namespace)? (T_WHERE T_METRIC T_EQUAL prefix)? limitClause?;
 showFieldsStmt       : T_SHOW T_FIELDS fromClause;
 showTagKeysStmt      : T_SHOW T_TAG T_KEYS fromClause;
 showTagValuesStmt    : T_SHOW T_TAG T_VALUES fromClause T_WITH T_KEY T_EQUAL withTagKey whereClause? limitClause?;
+showCardinalityStmt  : T_SHOW T_CARDINALITY (T_ON namespace)? limitClause?;
+showTagCardinalityStmt: T_SHOW T_TAG T_CARDINALITY fromClause limitClause?;
+showUsersStmt        : T_SHOW T_USERS ;
+createUserStmt       : T_CREATE T_USER userName T_WITH T_PASSWORD password ;
+dropUserStmt         : T_DROP T_USER userName ;
+createTokenStmt      : T_CREATE T_TOKEN userName ;
+grantStmt            : T_GRANT roleName T_ON privilegeDatabase T_TO userName ;
+revokeStmt           : T_REVOKE roleName T_ON privilegeDatabase T_FROM userName ;
+userName             : ident ;
+password             : ident ;
+roleName             : T_READ | T_WRITE | T_ADMIN ;
+privilegeDatabase    : T_MUL | ident ;
 prefix               : ident ;
 withTagKey           : ident ;
 namespace            : ident ;
@@ -64,7 +92,7 @@ requestID            : ident ;
 source               : (T_STATE_MACHINE|T_STATE_REPO) ;
 
 //data query plan
-queryStmt               : T_EXPLAIN? sourceAndSelect whereClause? groupByClause? orderByClause? limitClause? T_WITH_VALUE?;
+queryStmt               : T_EXPLAIN? sourceAndSelect whereClause? offsetClause? groupByClause? orderByClause? limitClause? T_WITH_VALUE?;
 sourceAndSelect         : selectExpr fromClause | fromClause selectExpr ;
 selectExpr              : T_SELECT fields;
 //select fields
@@ -76,7 +104,10 @@ databaseFilter          : T_DATASBAE T_EQUAL ident  ;
 typeFilter              : T_TYPE T_EQUAL ident  ;
 
 //from clause
-fromClause              : T_FROM metricName (T_ON namespace)? ;
+fromClause              : T_FROM metricName (metricOffset? metricAlias)? (T_COMMA metricName (metricOffset? metricAlias)?)* (T_ON namespace)? ;
+metricAlias             : T_AS ident ;
+// offset of metric must be with alias, e.g. from http offset 7d as last_week
+metricOffset            : T_OFFSET durationLit ;
 
 //where clause
 whereClause             : T_WHERE conditionExpr;
@@ -86,6 +117,8 @@ conditionExpr           : tagFilterExpr | tagFilterExpr T_AND timeRangeExpr | ti
 tagFilterExpr           :
                          T_OPEN_P tagFilterExpr T_CLOSE_P
                         | tagKey (T_EQUAL | T_LIKE | T_NOT T_LIKE | T_REGEXP | T_NEQREGEXP | T_NOTEQUAL | T_NOTEQUAL2) tagValue
+                       | tagRangeKey (T_LESS | T_LESSEQUAL | T_GREATER | T_GREATEREQUAL) tagValue
+                       | tagKey T_BETWEEN tagValue T_AND tagValue
                        | tagKey (T_IN | T_NOT T_IN) T_OPEN_P tagValueList T_CLOSE_P
                        | tagFilterExpr (T_AND | T_OR) tagFilterExpr
                        ;
@@ -104,7 +137,7 @@ nowFunc                 : T_NOW T_OPEN_P exprFuncParams? T_CLOSE_P ;
 groupByClause          : T_GROUP T_BY groupByKeys (T_FILL T_OPEN_P fillOption T_CLOSE_P)? havingClause? ;
 groupByKeys            : groupByKey (T_COMMA groupByKey)* ;
 groupByKey             : ident | T_TIME T_OPEN_P durationLit T_CLOSE_P ;
-fillOption             : T_NULL | T_PREVIOUS | L_INT | L_DEC ;
+fillOption             : T_NULL | T_PREVIOUS | T_SUB? (L_INT | L_DEC) ;
 
 orderByClause          : T_ORDER T_BY sortFields ;
 sortField               : fieldExpr ( T_ASC | T_DESC )* ;
@@ -152,7 +185,8 @@ intervalItem            :
                          | T_YEAR
                          ;
 exprFunc                : funcName T_OPEN_P exprFuncParams? T_CLOSE_P ;
-funcName                : T_SUM | T_MIN | T_MAX | T_AVG | T_COUNT | T_LAST | T_FIRST | T_STDDEV | T_QUANTILE | T_RATE;
+funcName                : T_SUM | T_MIN | T_MAX | T_AVG | T_COUNT | T_LAST | T_FIRST | T_STDDEV | T_QUANTILE | T_RATE | T_PERCENTILE
+                        | T_INCREASE | T_DERIVATIVE | T_DELTA | T_MOVING_AVERAGE | T_ABS | T_CLAMP_MIN | T_CLAMP_MAX | T_TIMESHIFT ;
 exprFuncParams          : funcParam (T_COMMA funcParam)* ;
 funcParam               :
                            fieldExpr
@@ -190,7 +224,7 @@ value
    | arr
    | 'true'
    | 'false'
-   | 'null'
+   | T_NULL
    ;
 
 // Integer (positive or negative)
@@ -198,9 +232,12 @@ intNumber               : ('-' | '+')? L_INT ;
 // Decimal number (positive or negative)
 decNumber               : ('-' | '+')? L_DEC ;
 limitClause             : T_LIMIT L_INT ;
+offsetClause            : T_OFFSET durationLit ;
 metricName              : ident ;
 tagKey                  : ident ;
-tagValue                : ident ;
+// keywords(e.g. time) are not allowed as range tag key, avoid ambiguity with time range expr
+tagRangeKey             : L_ID ;
+tagValue                : ident | T_SUB? (L_INT | L_DEC) ;
 ident                    :  (L_ID | nonReservedWords) ('.' (L_ID | nonReservedWords))* ;
 
 nonReservedWords      :
@@ -208,6 +245,8 @@ nonReservedWords      :
                         | T_UPDATE
                         | T_SET
                         | T_DROP
+                        | T_ALTER
+                        | T_DELETE
                         | T_INTERVAL
                         | T_INTERVAL_NAME
                         | T_SHARD
@@ -238,6 +277,7 @@ nonReservedWords      :
                         | T_FROM
                         | T_WHERE
                         | T_LIMIT
+                        | T_OFFSET
                         | T_QUERIES
                         | T_QUERY
                         | T_EXPLAIN
@@ -276,6 +316,15 @@ nonReservedWords      :
                         | T_STDDEV
                         | T_QUANTILE
                         | T_RATE
+                        | T_PERCENTILE
+                        | T_INCREASE
+                        | T_DERIVATIVE
+                        | T_DELTA
+                        | T_MOVING_AVERAGE
+                        | T_ABS
+                        | T_CLAMP_MIN
+                        | T_CLAMP_MAX
+                        | T_TIMESHIFT
                         | T_SECOND
                         | T_MINUTE
                         | T_HOUR
@@ -298,6 +347,19 @@ nonReservedWords      :
                         | T_REQUESTS
                         | T_REQUEST
                         | T_ID
+                        | T_SERIES
+                        | T_QUOTA
+                        | T_CARDINALITY
+                        | T_USER
+                        | T_USERS
+                        | T_PASSWORD
+                        | T_TOKEN
+                        | T_GRANT
+                        | T_REVOKE
+                        | T_TO
+                        | T_READ
+                        | T_WRITE
+                        | T_ADMIN
                         ;
 
 STRING
@@ -333,6 +395,8 @@ T_CREATE             : C R E A T E                      ;
 T_UPDATE             : U P D A T E                      ;
 T_SET                : S E T                            ;
 T_DROP               : D R O P                          ;
+T_ALTER              : A L T E R                        ;
+T_DELETE             : D E L E T E                      ;
 T_INTERVAL           : I N T E R V A L                  ;
 T_INTERVAL_NAME      : N A M E                          ;
 T_SHARD              : S H A R D                        ;
@@ -375,6 +439,7 @@ T_VALUE              : V A L U E                        ;
 T_FROM               : F R O M                          ;
 T_WHERE              : W H E R E                        ;
 T_LIMIT              : L I M I T                        ;
+T_OFFSET             : O F F S E T                      ;
 T_QUERIES            : Q U E R I E S                    ;
 T_QUERY              : Q U E R Y                        ;
 T_EXPLAIN            : E X P L A I N                    ;
@@ -406,7 +471,20 @@ T_LOG                : L O G                            ;
 T_PROFILE            : P R O F I L E                    ;
 T_REQUESTS           : R E Q U E S T S                  ;
 T_REQUEST            : R E Q U E S T                    ;
+T_SERIES             : S E R I E S                      ;
+T_QUOTA              : Q U O T A                        ;
+T_CARDINALITY        : C A R D I N A L I T Y            ;
 T_ID                 : I D                              ;
+T_USER               : U S E R                          ;
+T_USERS              : U S E R S                        ;
+T_PASSWORD           : P A S S W O R D                  ;
+T_TOKEN              : T O K E N                        ;
+T_GRANT              : G R A N T                        ;
+T_REVOKE             : R E V O K E                      ;
+T_TO                 : T O                              ;
+T_READ               : R E A D                          ;
+T_WRITE              : W R I T E                        ;
+T_ADMIN              : A D M I N                        ;
 
 T_SUM                : S U M                            ;
 T_MIN                : M I N                            ;
@@ -418,6 +496,15 @@ T_AVG                : A V G                            ;
 T_STDDEV             : S T D D E V                      ;
 T_QUANTILE           : Q U A N T I L E                  ;
 T_RATE               : R A T E                          ;
+T_PERCENTILE         : P E R C E N T I L E              ;
+T_INCREASE           : I N C R E A S E                  ;
+T_DERIVATIVE         : D E R I V A T I V E              ;
+T_DELTA              : D E L T A                        ;
+T_MOVING_AVERAGE     : M O V I N G '_' A V E R A G E    ;
+T_ABS                : A B S                            ;
+T_CLAMP_MIN          : C L A M P '_' M I N              ;
+T_CLAMP_MAX          : C L A M P '_' M A X              ;
+T_TIMESHIFT          : T I M E S H I F T                ;
 
 //time unit
 T_SECOND             : S                                ;
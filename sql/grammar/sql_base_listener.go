@@ -73,6 +73,12 @@ func (s *BaseSQLListener) EnterShowRequestStmt(ctx *ShowRequestStmtContext) {}
 // ExitShowRequestStmt is called when production showRequestStmt is exited.
 func (s *BaseSQLListener) ExitShowRequestStmt(ctx *ShowRequestStmtContext) {}
 
+// EnterKillQueryStmt is called when production killQueryStmt is entered.
+func (s *BaseSQLListener) EnterKillQueryStmt(ctx *KillQueryStmtContext) {}
+
+// ExitKillQueryStmt is called when production killQueryStmt is exited.
+func (s *BaseSQLListener) ExitKillQueryStmt(ctx *KillQueryStmtContext) {}
+
 // EnterShowStoragesStmt is called when production showStoragesStmt is entered.
 func (s *BaseSQLListener) EnterShowStoragesStmt(ctx *ShowStoragesStmtContext) {}
 
@@ -127,6 +133,12 @@ func (s *BaseSQLListener) EnterShowStorageMetricStmt(ctx *ShowStorageMetricStmtC
 // ExitShowStorageMetricStmt is called when production showStorageMetricStmt is exited.
 func (s *BaseSQLListener) ExitShowStorageMetricStmt(ctx *ShowStorageMetricStmtContext) {}
 
+// EnterShowSeriesQuotaStmt is called when production showSeriesQuotaStmt is entered.
+func (s *BaseSQLListener) EnterShowSeriesQuotaStmt(ctx *ShowSeriesQuotaStmtContext) {}
+
+// ExitShowSeriesQuotaStmt is called when production showSeriesQuotaStmt is exited.
+func (s *BaseSQLListener) ExitShowSeriesQuotaStmt(ctx *ShowSeriesQuotaStmtContext) {}
+
 // EnterCreateStorageStmt is called when production createStorageStmt is entered.
 func (s *BaseSQLListener) EnterCreateStorageStmt(ctx *CreateStorageStmtContext) {}
 
@@ -151,6 +163,18 @@ func (s *BaseSQLListener) EnterDropDatabaseStmt(ctx *DropDatabaseStmtContext) {}
 // ExitDropDatabaseStmt is called when production dropDatabaseStmt is exited.
 func (s *BaseSQLListener) ExitDropDatabaseStmt(ctx *DropDatabaseStmtContext) {}
 
+// EnterAlterDatabaseStmt is called when production alterDatabaseStmt is entered.
+func (s *BaseSQLListener) EnterAlterDatabaseStmt(ctx *AlterDatabaseStmtContext) {}
+
+// ExitAlterDatabaseStmt is called when production alterDatabaseStmt is exited.
+func (s *BaseSQLListener) ExitAlterDatabaseStmt(ctx *AlterDatabaseStmtContext) {}
+
+// EnterDeleteStmt is called when production deleteStmt is entered.
+func (s *BaseSQLListener) EnterDeleteStmt(ctx *DeleteStmtContext) {}
+
+// ExitDeleteStmt is called when production deleteStmt is exited.
+func (s *BaseSQLListener) ExitDeleteStmt(ctx *DeleteStmtContext) {}
+
 // EnterShowDatabaseStmt is called when production showDatabaseStmt is entered.
 func (s *BaseSQLListener) EnterShowDatabaseStmt(ctx *ShowDatabaseStmtContext) {}
 
@@ -187,6 +211,78 @@ func (s *BaseSQLListener) EnterShowTagValuesStmt(ctx *ShowTagValuesStmtContext)
 // ExitShowTagValuesStmt is called when production showTagValuesStmt is exited.
 func (s *BaseSQLListener) ExitShowTagValuesStmt(ctx *ShowTagValuesStmtContext) {}
 
+// EnterShowCardinalityStmt is called when production showCardinalityStmt is entered.
+func (s *BaseSQLListener) EnterShowCardinalityStmt(ctx *ShowCardinalityStmtContext) {}
+
+// ExitShowCardinalityStmt is called when production showCardinalityStmt is exited.
+func (s *BaseSQLListener) ExitShowCardinalityStmt(ctx *ShowCardinalityStmtContext) {}
+
+// EnterShowTagCardinalityStmt is called when production showTagCardinalityStmt is entered.
+func (s *BaseSQLListener) EnterShowTagCardinalityStmt(ctx *ShowTagCardinalityStmtContext) {}
+
+// ExitShowTagCardinalityStmt is called when production showTagCardinalityStmt is exited.
+func (s *BaseSQLListener) ExitShowTagCardinalityStmt(ctx *ShowTagCardinalityStmtContext) {}
+
+// EnterShowUsersStmt is called when production showUsersStmt is entered.
+func (s *BaseSQLListener) EnterShowUsersStmt(ctx *ShowUsersStmtContext) {}
+
+// ExitShowUsersStmt is called when production showUsersStmt is exited.
+func (s *BaseSQLListener) ExitShowUsersStmt(ctx *ShowUsersStmtContext) {}
+
+// EnterCreateUserStmt is called when production createUserStmt is entered.
+func (s *BaseSQLListener) EnterCreateUserStmt(ctx *CreateUserStmtContext) {}
+
+// ExitCreateUserStmt is called when production createUserStmt is exited.
+func (s *BaseSQLListener) ExitCreateUserStmt(ctx *CreateUserStmtContext) {}
+
+// EnterDropUserStmt is called when production dropUserStmt is entered.
+func (s *BaseSQLListener) EnterDropUserStmt(ctx *DropUserStmtContext) {}
+
+// ExitDropUserStmt is called when production dropUserStmt is exited.
+func (s *BaseSQLListener) ExitDropUserStmt(ctx *DropUserStmtContext) {}
+
+// EnterCreateTokenStmt is called when production createTokenStmt is entered.
+func (s *BaseSQLListener) EnterCreateTokenStmt(ctx *CreateTokenStmtContext) {}
+
+// ExitCreateTokenStmt is called when production createTokenStmt is exited.
+func (s *BaseSQLListener) ExitCreateTokenStmt(ctx *CreateTokenStmtContext) {}
+
+// EnterGrantStmt is called when production grantStmt is entered.
+func (s *BaseSQLListener) EnterGrantStmt(ctx *GrantStmtContext) {}
+
+// ExitGrantStmt is called when production grantStmt is exited.
+func (s *BaseSQLListener) ExitGrantStmt(ctx *GrantStmtContext) {}
+
+// EnterRevokeStmt is called when production revokeStmt is entered.
+func (s *BaseSQLListener) EnterRevokeStmt(ctx *RevokeStmtContext) {}
+
+// ExitRevokeStmt is called when production revokeStmt is exited.
+func (s *BaseSQLListener) ExitRevokeStmt(ctx *RevokeStmtContext) {}
+
+// EnterUserName is called when production userName is entered.
+func (s *BaseSQLListener) EnterUserName(ctx *UserNameContext) {}
+
+// ExitUserName is called when production userName is exited.
+func (s *BaseSQLListener) ExitUserName(ctx *UserNameContext) {}
+
+// EnterPassword is called when production password is entered.
+func (s *BaseSQLListener) EnterPassword(ctx *PasswordContext) {}
+
+// ExitPassword is called when production password is exited.
+func (s *BaseSQLListener) ExitPassword(ctx *PasswordContext) {}
+
+// EnterRoleName is called when production roleName is entered.
+func (s *BaseSQLListener) EnterRoleName(ctx *RoleNameContext) {}
+
+// ExitRoleName is called when production roleName is exited.
+func (s *BaseSQLListener) ExitRoleName(ctx *RoleNameContext) {}
+
+// EnterPrivilegeDatabase is called when production privilegeDatabase is entered.
+func (s *BaseSQLListener) EnterPrivilegeDatabase(ctx *PrivilegeDatabaseContext) {}
+
+// ExitPrivilegeDatabase is called when production privilegeDatabase is exited.
+func (s *BaseSQLListener) ExitPrivilegeDatabase(ctx *PrivilegeDatabaseContext) {}
+
 // EnterPrefix is called when production prefix is entered.
 func (s *BaseSQLListener) EnterPrefix(ctx *PrefixContext) {}
 
@@ -283,6 +379,18 @@ func (s *BaseSQLListener) EnterFromClause(ctx *FromClauseContext) {}
 // ExitFromClause is called when production fromClause is exited.
 func (s *BaseSQLListener) ExitFromClause(ctx *FromClauseContext) {}
 
+// EnterMetricAlias is called when production metricAlias is entered.
+func (s *BaseSQLListener) EnterMetricAlias(ctx *MetricAliasContext) {}
+
+// ExitMetricAlias is called when production metricAlias is exited.
+func (s *BaseSQLListener) ExitMetricAlias(ctx *MetricAliasContext) {}
+
+// EnterMetricOffset is called when production metricOffset is entered.
+func (s *BaseSQLListener) EnterMetricOffset(ctx *MetricOffsetContext) {}
+
+// ExitMetricOffset is called when production metricOffset is exited.
+func (s *BaseSQLListener) ExitMetricOffset(ctx *MetricOffsetContext) {}
+
 // EnterWhereClause is called when production whereClause is entered.
 func (s *BaseSQLListener) EnterWhereClause(ctx *WhereClauseContext) {}
 
@@ -523,6 +631,12 @@ func (s *BaseSQLListener) EnterLimitClause(ctx *LimitClauseContext) {}
 // ExitLimitClause is called when production limitClause is exited.
 func (s *BaseSQLListener) ExitLimitClause(ctx *LimitClauseContext) {}
 
+// EnterOffsetClause is called when production offsetClause is entered.
+func (s *BaseSQLListener) EnterOffsetClause(ctx *OffsetClauseContext) {}
+
+// ExitOffsetClause is called when production offsetClause is exited.
+func (s *BaseSQLListener) ExitOffsetClause(ctx *OffsetClauseContext) {}
+
 // EnterMetricName is called when production metricName is entered.
 func (s *BaseSQLListener) EnterMetricName(ctx *MetricNameContext) {}
 
@@ -535,6 +649,12 @@ func (s *BaseSQLListener) EnterTagKey(ctx *TagKeyContext) {}
 // ExitTagKey is called when production tagKey is exited.
 func (s *BaseSQLListener) ExitTagKey(ctx *TagKeyContext) {}
 
+// EnterTagRangeKey is called when production tagRangeKey is entered.
+func (s *BaseSQLListener) EnterTagRangeKey(ctx *TagRangeKeyContext) {}
+
+// ExitTagRangeKey is called when production tagRangeKey is exited.
+func (s *BaseSQLListener) ExitTagRangeKey(ctx *TagRangeKeyContext) {}
+
 // EnterTagValue is called when production tagValue is entered.
 func (s *BaseSQLListener) EnterTagValue(ctx *TagValueContext) {}
 
@@ -48,6 +48,10 @@ func (v *BaseSQLVisitor) VisitShowRequestStmt(ctx *ShowRequestStmtContext) inter
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitKillQueryStmt(ctx *KillQueryStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowStoragesStmt(ctx *ShowStoragesStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -84,6 +88,10 @@ func (v *BaseSQLVisitor) VisitShowStorageMetricStmt(ctx *ShowStorageMetricStmtCo
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitShowSeriesQuotaStmt(ctx *ShowSeriesQuotaStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitCreateStorageStmt(ctx *CreateStorageStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -100,6 +108,14 @@ func (v *BaseSQLVisitor) VisitDropDatabaseStmt(ctx *DropDatabaseStmtContext) int
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitAlterDatabaseStmt(ctx *AlterDatabaseStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitDeleteStmt(ctx *DeleteStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitShowDatabaseStmt(ctx *ShowDatabaseStmtContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -124,6 +140,54 @@ func (v *BaseSQLVisitor) VisitShowTagValuesStmt(ctx *ShowTagValuesStmtContext) i
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitShowCardinalityStmt(ctx *ShowCardinalityStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowTagCardinalityStmt(ctx *ShowTagCardinalityStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitShowUsersStmt(ctx *ShowUsersStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitCreateUserStmt(ctx *CreateUserStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitDropUserStmt(ctx *DropUserStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitCreateTokenStmt(ctx *CreateTokenStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitGrantStmt(ctx *GrantStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitRevokeStmt(ctx *RevokeStmtContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitUserName(ctx *UserNameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitPassword(ctx *PasswordContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitRoleName(ctx *RoleNameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitPrivilegeDatabase(ctx *PrivilegeDatabaseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitPrefix(ctx *PrefixContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -188,6 +252,14 @@ func (v *BaseSQLVisitor) VisitFromClause(ctx *FromClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitMetricAlias(ctx *MetricAliasContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSQLVisitor) VisitMetricOffset(ctx *MetricOffsetContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitWhereClause(ctx *WhereClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -348,6 +420,10 @@ func (v *BaseSQLVisitor) VisitLimitClause(ctx *LimitClauseContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitOffsetClause(ctx *OffsetClauseContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitMetricName(ctx *MetricNameContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -356,6 +432,10 @@ func (v *BaseSQLVisitor) VisitTagKey(ctx *TagKeyContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSQLVisitor) VisitTagRangeKey(ctx *TagRangeKeyContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSQLVisitor) VisitTagValue(ctx *TagValueContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -60,18 +60,46 @@ func sqllexerLexerInit() {
     "DEFAULT_MODE",
   }
   staticData.literalNames = []string{
-    "", "'true'", "'false'", "'null'", "", "", "", "", "", "", "", "", "", 
+    "", "'true'", "'false'", "", "", "", "", "", "", "", "", "", "", "", 
     "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
     "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
     "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
     "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
-    "", "", "", "", "", "", "", "", "", "", "", "", "", "'m'", "", "", "", 
-    "'M'", "", "'.'", "':'", "'='", "'<>'", "'!='", "'>'", "'>='", "'<'", 
-    "'<='", "'=~'", "'!~'", "','", "'{'", "'}'", "'['", "']'", "'('", "')'", 
-    "'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
+    "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
+    "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
+    "", "", "'m'", "", "", "", "'M'", "", "'.'", "':'", "'='", "'<>'", "'!='", 
+    "'>'", "'>='", "'<'", "'<='", "'=~'", "'!~'", "','", "'{'", "'}'", "'['", 
+    "']'", "'('", "')'", "'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
   }
   staticData.symbolicNames = []string{
-    "", "", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP", 
+    "", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP", 
+    "T_ALTER", "T_DELETE", "T_INTERVAL", "T_INTERVAL_NAME", "T_SHARD", "T_REPLICATION", 
+    "T_TTL", "T_META_TTL", "T_PAST_TTL", "T_FUTURE_TTL", "T_KILL", "T_ON", 
+    "T_SHOW", "T_USE", "T_STATE_REPO", "T_STATE_MACHINE", "T_MASTER", "T_METADATA", 
+    "T_TYPES", "T_TYPE", "T_STORAGES", "T_STORAGE", "T_BROKER", "T_ALIVE", 
+    "T_SCHEMAS", "T_DATASBAE", "T_DATASBAES", "T_NAMESPACE", "T_NAMESPACES", 
+    "T_NODE", "T_METRICS", "T_METRIC", "T_FIELD", "T_FIELDS", "T_TAG", "T_INFO", 
+    "T_KEYS", "T_KEY", "T_WITH", "T_VALUES", "T_VALUE", "T_FROM", "T_WHERE", 
+    "T_LIMIT", "T_OFFSET", "T_QUERIES", "T_QUERY", "T_EXPLAIN", "T_WITH_VALUE", 
+    "T_SELECT", "T_AS", "T_AND", "T_OR", "T_FILL", "T_NULL", "T_PREVIOUS", 
+    "T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN", "T_IS", 
+    "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME", "T_NOW", 
+    "T_IN", "T_LOG", "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_SERIES", 
+    "T_QUOTA", "T_CARDINALITY", "T_ID", "T_USER", "T_USERS", "T_PASSWORD", 
+    "T_TOKEN", "T_GRANT", "T_REVOKE", "T_TO", "T_READ", "T_WRITE", "T_ADMIN", 
+    "T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_LAST", "T_FIRST", "T_AVG", 
+    "T_STDDEV", "T_QUANTILE", "T_RATE", "T_PERCENTILE", "T_INCREASE", "T_DERIVATIVE", 
+    "T_DELTA", "T_MOVING_AVERAGE", "T_ABS", "T_CLAMP_MIN", "T_CLAMP_MAX", 
+    "T_TIMESHIFT", "T_SECOND", "T_MINUTE", "T_HOUR", "T_DAY", "T_WEEK", 
+    "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL", "T_NOTEQUAL", "T_NOTEQUAL2", 
+    "T_GREATER", "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL", "T_REGEXP", 
+    "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB", "T_CLOSE_SB", 
+    "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL", "T_MOD", 
+    "T_UNDERLINE", "L_ID", "L_INT", "L_DEC",
+  }
+  staticData.ruleNames = []string{
+    "T__0", "T__1", "STRING", "ESC", "UNICODE", "HEX", "SAFECODEPOINT", 
+    "EXP", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP", "T_ALTER", "T_DELETE", 
     "T_INTERVAL", "T_INTERVAL_NAME", "T_SHARD", "T_REPLICATION", "T_TTL", 
     "T_META_TTL", "T_PAST_TTL", "T_FUTURE_TTL", "T_KILL", "T_ON", "T_SHOW", 
     "T_USE", "T_STATE_REPO", "T_STATE_MACHINE", "T_MASTER", "T_METADATA", 
@@ -79,47 +107,28 @@ func sqllexerLexerInit() {
     "T_SCHEMAS", "T_DATASBAE", "T_DATASBAES", "T_NAMESPACE", "T_NAMESPACES", 
     "T_NODE", "T_METRICS", "T_METRIC", "T_FIELD", "T_FIELDS", "T_TAG", "T_INFO", 
     "T_KEYS", "T_KEY", "T_WITH", "T_VALUES", "T_VALUE", "T_FROM", "T_WHERE", 
-    "T_LIMIT", "T_QUERIES", "T_QUERY", "T_EXPLAIN", "T_WITH_VALUE", "T_SELECT", 
-    "T_AS", "T_AND", "T_OR", "T_FILL", "T_NULL", "T_PREVIOUS", "T_ORDER", 
-    "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN", "T_IS", "T_GROUP", 
-    "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME", "T_NOW", "T_IN", "T_LOG", 
-    "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_ID", "T_SUM", "T_MIN", "T_MAX", 
-    "T_COUNT", "T_LAST", "T_FIRST", "T_AVG", "T_STDDEV", "T_QUANTILE", "T_RATE", 
-    "T_SECOND", "T_MINUTE", "T_HOUR", "T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", 
-    "T_DOT", "T_COLON", "T_EQUAL", "T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER", 
-    "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP", 
-    "T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P", 
-    "T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE", 
-    "L_ID", "L_INT", "L_DEC",
-  }
-  staticData.ruleNames = []string{
-    "T__0", "T__1", "T__2", "STRING", "ESC", "UNICODE", "HEX", "SAFECODEPOINT", 
-    "EXP", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP", "T_INTERVAL", 
-    "T_INTERVAL_NAME", "T_SHARD", "T_REPLICATION", "T_TTL", "T_META_TTL", 
-    "T_PAST_TTL", "T_FUTURE_TTL", "T_KILL", "T_ON", "T_SHOW", "T_USE", "T_STATE_REPO", 
-    "T_STATE_MACHINE", "T_MASTER", "T_METADATA", "T_TYPES", "T_TYPE", "T_STORAGES", 
-    "T_STORAGE", "T_BROKER", "T_ALIVE", "T_SCHEMAS", "T_DATASBAE", "T_DATASBAES", 
-    "T_NAMESPACE", "T_NAMESPACES", "T_NODE", "T_METRICS", "T_METRIC", "T_FIELD", 
-    "T_FIELDS", "T_TAG", "T_INFO", "T_KEYS", "T_KEY", "T_WITH", "T_VALUES", 
-    "T_VALUE", "T_FROM", "T_WHERE", "T_LIMIT", "T_QUERIES", "T_QUERY", "T_EXPLAIN", 
-    "T_WITH_VALUE", "T_SELECT", "T_AS", "T_AND", "T_OR", "T_FILL", "T_NULL", 
-    "T_PREVIOUS", "T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN", 
-    "T_IS", "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME", 
-    "T_NOW", "T_IN", "T_LOG", "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_ID", 
+    "T_LIMIT", "T_OFFSET", "T_QUERIES", "T_QUERY", "T_EXPLAIN", "T_WITH_VALUE", 
+    "T_SELECT", "T_AS", "T_AND", "T_OR", "T_FILL", "T_NULL", "T_PREVIOUS", 
+    "T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN", "T_IS", 
+    "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME", "T_NOW", 
+    "T_IN", "T_LOG", "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_SERIES", 
+    "T_QUOTA", "T_CARDINALITY", "T_ID", "T_USER", "T_USERS", "T_PASSWORD", 
+    "T_TOKEN", "T_GRANT", "T_REVOKE", "T_TO", "T_READ", "T_WRITE", "T_ADMIN", 
     "T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_LAST", "T_FIRST", "T_AVG", 
-    "T_STDDEV", "T_QUANTILE", "T_RATE", "T_SECOND", "T_MINUTE", "T_HOUR", 
-    "T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL", 
-    "T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER", "T_GREATEREQUAL", "T_LESS", 
-    "T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B", 
-    "T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB", 
-    "T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE", "L_ID", "L_INT", "L_DEC", 
-    "BLANK", "L_DIGIT", "L_ID_PART", "A", "B", "C", "D", "E", "F", "G", 
-    "H", "I", "J", "K", "L", "M", "N", "O", "P", "Q", "R", "S", "T", "U", 
-    "V", "W", "X", "Y", "Z",
+    "T_STDDEV", "T_QUANTILE", "T_RATE", "T_PERCENTILE", "T_INCREASE", "T_DERIVATIVE", 
+    "T_DELTA", "T_MOVING_AVERAGE", "T_ABS", "T_CLAMP_MIN", "T_CLAMP_MAX", 
+    "T_TIMESHIFT", "T_SECOND", "T_MINUTE", "T_HOUR", "T_DAY", "T_WEEK", 
+    "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL", "T_NOTEQUAL", "T_NOTEQUAL2", 
+    "T_GREATER", "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL", "T_REGEXP", 
+    "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB", "T_CLOSE_SB", 
+    "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL", "T_MOD", 
+    "T_UNDERLINE", "L_ID", "L_INT", "L_DEC", "BLANK", "L_DIGIT", "L_ID_PART", 
+    "A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "L", "M", "N", 
+    "O", "P", "Q", "R", "S", "T", "U", "V", "W", "X", "Y", "Z",
   }
   staticData.predictionContextCache = antlr.NewPredictionContextCache()
   staticData.serializedATN = []int32{
-	4, 0, 126, 1124, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 
+	4, 0, 150, 1357, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 
 	2, 4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 
 	2, 10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 
 	15, 7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 
@@ -151,485 +160,598 @@ func sqllexerLexerInit() {
 	7, 144, 2, 145, 7, 145, 2, 146, 7, 146, 2, 147, 7, 147, 2, 148, 7, 148, 
 	2, 149, 7, 149, 2, 150, 7, 150, 2, 151, 7, 151, 2, 152, 7, 152, 2, 153, 
 	7, 153, 2, 154, 7, 154, 2, 155, 7, 155, 2, 156, 7, 156, 2, 157, 7, 157, 
-	2, 158, 7, 158, 2, 159, 7, 159, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 
-	3, 5, 3, 341, 8, 3, 10, 3, 12, 3, 344, 9, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 
-	4, 3, 4, 351, 8, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 
-	7, 1, 7, 1, 8, 1, 8, 3, 8, 365, 8, 8, 1, 8, 1, 8, 1, 9, 4, 9, 370, 8, 9, 
-	11, 9, 12, 9, 371, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 
-	1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 
-	12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 
-	1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 
-	16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 
-	1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 
-	18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 
-	1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 
-	21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 
-	1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 
-	25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 1, 26, 
-	1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 
-	27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 
-	1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 
-	29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 
-	1, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 
-	33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 
-	1, 34, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 
-	36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 37, 
-	1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 
-	38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 
-	1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 
-	40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 
-	1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 
-	43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 
-	1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 46, 1, 
-	46, 1, 47, 1, 47, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 
-	1, 49, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 1, 50, 1, 50, 1, 51, 1, 
-	51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 
-	1, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 
-	54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 
-	1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 
-	57, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 59, 1, 59, 
-	1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 1, 
-	60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 
-	1, 62, 1, 63, 1, 63, 1, 63, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 65, 1, 
-	65, 1, 65, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 
-	1, 66, 1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 68, 1, 68, 1, 
-	68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 70, 1, 70, 1, 70, 1, 70, 
-	1, 70, 1, 71, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 
-	72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 
-	1, 74, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 76, 1, 76, 1, 
-	76, 1, 77, 1, 77, 1, 77, 1, 77, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 
-	1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1, 80, 1, 80, 1, 81, 1, 
-	81, 1, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 
-	1, 83, 1, 83, 1, 83, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 
-	84, 1, 84, 1, 85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 86, 
-	1, 86, 1, 86, 1, 87, 1, 87, 1, 87, 1, 87, 1, 88, 1, 88, 1, 88, 1, 88, 1, 
-	89, 1, 89, 1, 89, 1, 89, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 91, 
-	1, 91, 1, 91, 1, 91, 1, 91, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 
-	93, 1, 93, 1, 93, 1, 93, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 
-	1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 96, 1, 
-	96, 1, 96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 98, 1, 98, 1, 99, 1, 99, 1, 100, 
-	1, 100, 1, 101, 1, 101, 1, 102, 1, 102, 1, 103, 1, 103, 1, 104, 1, 104, 
-	1, 105, 1, 105, 1, 106, 1, 106, 1, 107, 1, 107, 1, 107, 1, 108, 1, 108, 
-	1, 108, 1, 109, 1, 109, 1, 110, 1, 110, 1, 110, 1, 111, 1, 111, 1, 112, 
-	1, 112, 1, 112, 1, 113, 1, 113, 1, 113, 1, 114, 1, 114, 1, 114, 1, 115, 
-	1, 115, 1, 116, 1, 116, 1, 117, 1, 117, 1, 118, 1, 118, 1, 119, 1, 119, 
-	1, 120, 1, 120, 1, 121, 1, 121, 1, 122, 1, 122, 1, 123, 1, 123, 1, 124, 
-	1, 124, 1, 125, 1, 125, 1, 126, 1, 126, 1, 127, 1, 127, 1, 128, 1, 128, 
-	1, 129, 4, 129, 992, 8, 129, 11, 129, 12, 129, 993, 1, 130, 4, 130, 997, 
-	8, 130, 11, 130, 12, 130, 998, 1, 130, 1, 130, 1, 130, 5, 130, 1004, 8, 
-	130, 10, 130, 12, 130, 1007, 9, 130, 1, 130, 1, 130, 4, 130, 1011, 8, 130, 
-	11, 130, 12, 130, 1012, 3, 130, 1015, 8, 130, 1, 131, 1, 131, 1, 132, 1, 
-	132, 1, 133, 1, 133, 1, 133, 1, 133, 5, 133, 1025, 8, 133, 10, 133, 12, 
-	133, 1028, 9, 133, 1, 133, 1, 133, 1, 133, 5, 133, 1033, 8, 133, 10, 133, 
-	12, 133, 1036, 9, 133, 1, 133, 1, 133, 1, 133, 1, 133, 1, 133, 4, 133, 
-	1043, 8, 133, 11, 133, 12, 133, 1044, 1, 133, 1, 133, 5, 133, 1049, 8, 
-	133, 10, 133, 12, 133, 1052, 9, 133, 1, 133, 1, 133, 1, 133, 5, 133, 1057, 
-	8, 133, 10, 133, 12, 133, 1060, 9, 133, 1, 133, 1, 133, 1, 133, 5, 133, 
-	1065, 8, 133, 10, 133, 12, 133, 1068, 9, 133, 1, 133, 3, 133, 1071, 8, 
-	133, 1, 134, 1, 134, 1, 135, 1, 135, 1, 136, 1, 136, 1, 137, 1, 137, 1, 
-	138, 1, 138, 1, 139, 1, 139, 1, 140, 1, 140, 1, 141, 1, 141, 1, 142, 1, 
-	142, 1, 143, 1, 143, 1, 144, 1, 144, 1, 145, 1, 145, 1, 146, 1, 146, 1, 
-	147, 1, 147, 1, 148, 1, 148, 1, 149, 1, 149, 1, 150, 1, 150, 1, 151, 1, 
-	151, 1, 152, 1, 152, 1, 153, 1, 153, 1, 154, 1, 154, 1, 155, 1, 155, 1, 
-	156, 1, 156, 1, 157, 1, 157, 1, 158, 1, 158, 1, 159, 1, 159, 4, 1034, 1050, 
-	1058, 1066, 0, 160, 1, 1, 3, 2, 5, 3, 7, 4, 9, 0, 11, 0, 13, 0, 15, 0, 
-	17, 0, 19, 5, 21, 6, 23, 7, 25, 8, 27, 9, 29, 10, 31, 11, 33, 12, 35, 13, 
-	37, 14, 39, 15, 41, 16, 43, 17, 45, 18, 47, 19, 49, 20, 51, 21, 53, 22, 
-	55, 23, 57, 24, 59, 25, 61, 26, 63, 27, 65, 28, 67, 29, 69, 30, 71, 31, 
-	73, 32, 75, 33, 77, 34, 79, 35, 81, 36, 83, 37, 85, 38, 87, 39, 89, 40, 
-	91, 41, 93, 42, 95, 43, 97, 44, 99, 45, 101, 46, 103, 47, 105, 48, 107, 
-	49, 109, 50, 111, 51, 113, 52, 115, 53, 117, 54, 119, 55, 121, 56, 123, 
-	57, 125, 58, 127, 59, 129, 60, 131, 61, 133, 62, 135, 63, 137, 64, 139, 
-	65, 141, 66, 143, 67, 145, 68, 147, 69, 149, 70, 151, 71, 153, 72, 155, 
-	73, 157, 74, 159, 75, 161, 76, 163, 77, 165, 78, 167, 79, 169, 80, 171, 
-	81, 173, 82, 175, 83, 177, 84, 179, 85, 181, 86, 183, 87, 185, 88, 187, 
-	89, 189, 90, 191, 91, 193, 92, 195, 93, 197, 94, 199, 95, 201, 96, 203, 
-	97, 205, 98, 207, 99, 209, 100, 211, 101, 213, 102, 215, 103, 217, 104, 
-	219, 105, 221, 106, 223, 107, 225, 108, 227, 109, 229, 110, 231, 111, 233, 
-	112, 235, 113, 237, 114, 239, 115, 241, 116, 243, 117, 245, 118, 247, 119, 
-	249, 120, 251, 121, 253, 122, 255, 123, 257, 124, 259, 125, 261, 126, 263, 
-	0, 265, 0, 267, 0, 269, 0, 271, 0, 273, 0, 275, 0, 277, 0, 279, 0, 281, 
-	0, 283, 0, 285, 0, 287, 0, 289, 0, 291, 0, 293, 0, 295, 0, 297, 0, 299, 
-	0, 301, 0, 303, 0, 305, 0, 307, 0, 309, 0, 311, 0, 313, 0, 315, 0, 317, 
-	0, 319, 0, 1, 0, 37, 8, 0, 34, 34, 47, 47, 92, 92, 98, 98, 102, 102, 110, 
-	110, 114, 114, 116, 116, 3, 0, 48, 57, 65, 70, 97, 102, 3, 0, 0, 31, 34, 
-	34, 92, 92, 2, 0, 69, 69, 101, 101, 2, 0, 43, 43, 45, 45, 3, 0, 9, 10, 
-	13, 13, 32, 32, 1, 0, 46, 46, 1, 0, 48, 57, 2, 0, 65, 90, 97, 122, 2, 0, 
-	46, 46, 95, 95, 3, 0, 35, 36, 64, 64, 95, 95, 4, 0, 35, 36, 58, 58, 64, 
-	64, 95, 95, 2, 0, 65, 65, 97, 97, 2, 0, 66, 66, 98, 98, 2, 0, 67, 67, 99, 
-	99, 2, 0, 68, 68, 100, 100, 2, 0, 70, 70, 102, 102, 2, 0, 71, 71, 103, 
-	103, 2, 0, 72, 72, 104, 104, 2, 0, 73, 73, 105, 105, 2, 0, 74, 74, 106, 
-	106, 2, 0, 75, 75, 107, 107, 2, 0, 76, 76, 108, 108, 2, 0, 77, 77, 109, 
-	109, 2, 0, 78, 78, 110, 110, 2, 0, 79, 79, 111, 111, 2, 0, 80, 80, 112, 
-	112, 2, 0, 81, 81, 113, 113, 2, 0, 82, 82, 114, 114, 2, 0, 83, 83, 115, 
-	115, 2, 0, 84, 84, 116, 116, 2, 0, 85, 85, 117, 117, 2, 0, 86, 86, 118, 
-	118, 2, 0, 87, 87, 119, 119, 2, 0, 88, 88, 120, 120, 2, 0, 89, 89, 121, 
-	121, 2, 0, 90, 90, 122, 122, 1114, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 
-	0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 
-	0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 
-	0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 
-	0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 
-	1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 
-	53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 
-	0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67, 1, 0, 0, 
-	0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0, 75, 1, 0, 
-	0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0, 0, 0, 83, 1, 
-	0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0, 0, 0, 0, 91, 
-	1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0, 0, 0, 0, 
-	99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0, 105, 1, 0, 0, 
-	0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111, 1, 0, 0, 0, 0, 113, 
-	1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0, 
-	0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0, 0, 127, 1, 
-	0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0, 0, 0, 0, 133, 1, 0, 0, 0, 0, 
-	135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139, 1, 0, 0, 0, 0, 141, 1, 0, 
-	0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147, 1, 0, 0, 0, 0, 149, 
-	1, 0, 0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1, 0, 0, 0, 0, 155, 1, 0, 0, 0, 
-	0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0, 161, 1, 0, 0, 0, 0, 163, 1, 
-	0, 0, 0, 0, 165, 1, 0, 0, 0, 0, 167, 1, 0, 0, 0, 0, 169, 1, 0, 0, 0, 0, 
-	171, 1, 0, 0, 0, 0, 173, 1, 0, 0, 0, 0, 175, 1, 0, 0, 0, 0, 177, 1, 0, 
-	0, 0, 0, 179, 1, 0, 0, 0, 0, 181, 1, 0, 0, 0, 0, 183, 1, 0, 0, 0, 0, 185, 
-	1, 0, 0, 0, 0, 187, 1, 0, 0, 0, 0, 189, 1, 0, 0, 0, 0, 191, 1, 0, 0, 0, 
-	0, 193, 1, 0, 0, 0, 0, 195, 1, 0, 0, 0, 0, 197, 1, 0, 0, 0, 0, 199, 1, 
-	0, 0, 0, 0, 201, 1, 0, 0, 0, 0, 203, 1, 0, 0, 0, 0, 205, 1, 0, 0, 0, 0, 
-	207, 1, 0, 0, 0, 0, 209, 1, 0, 0, 0, 0, 211, 1, 0, 0, 0, 0, 213, 1, 0, 
-	0, 0, 0, 215, 1, 0, 0, 0, 0, 217, 1, 0, 0, 0, 0, 219, 1, 0, 0, 0, 0, 221, 
-	1, 0, 0, 0, 0, 223, 1, 0, 0, 0, 0, 225, 1, 0, 0, 0, 0, 227, 1, 0, 0, 0, 
-	0, 229, 1, 0, 0, 0, 0, 231, 1, 0, 0, 0, 0, 233, 1, 0, 0, 0, 0, 235, 1, 
-	0, 0, 0, 0, 237, 1, 0, 0, 0, 0, 239, 1, 0, 0, 0, 0, 241, 1, 0, 0, 0, 0, 
-	243, 1, 0, 0, 0, 0, 245, 1, 0, 0, 0, 0, 247, 1, 0, 0, 0, 0, 249, 1, 0, 
-	0, 0, 0, 251, 1, 0, 0, 0, 0, 253, 1, 0, 0, 0, 0, 255, 1, 0, 0, 0, 0, 257, 
-	1, 0, 0, 0, 0, 259, 1, 0, 0, 0, 0, 261, 1, 0, 0, 0, 1, 321, 1, 0, 0, 0, 
-	3, 326, 1, 0, 0, 0, 5, 332, 1, 0, 0, 0, 7, 337, 1, 0, 0, 0, 9, 347, 1, 
-	0, 0, 0, 11, 352, 1, 0, 0, 0, 13, 358, 1, 0, 0, 0, 15, 360, 1, 0, 0, 0, 
-	17, 362, 1, 0, 0, 0, 19, 369, 1, 0, 0, 0, 21, 375, 1, 0, 0, 0, 23, 382, 
-	1, 0, 0, 0, 25, 389, 1, 0, 0, 0, 27, 393, 1, 0, 0, 0, 29, 398, 1, 0, 0, 
-	0, 31, 407, 1, 0, 0, 0, 33, 412, 1, 0, 0, 0, 35, 418, 1, 0, 0, 0, 37, 430, 
-	1, 0, 0, 0, 39, 434, 1, 0, 0, 0, 41, 442, 1, 0, 0, 0, 43, 450, 1, 0, 0, 
-	0, 45, 460, 1, 0, 0, 0, 47, 465, 1, 0, 0, 0, 49, 468, 1, 0, 0, 0, 51, 473, 
-	1, 0, 0, 0, 53, 477, 1, 0, 0, 0, 55, 488, 1, 0, 0, 0, 57, 502, 1, 0, 0, 
-	0, 59, 509, 1, 0, 0, 0, 61, 518, 1, 0, 0, 0, 63, 524, 1, 0, 0, 0, 65, 529, 
-	1, 0, 0, 0, 67, 538, 1, 0, 0, 0, 69, 546, 1, 0, 0, 0, 71, 553, 1, 0, 0, 
-	0, 73, 559, 1, 0, 0, 0, 75, 567, 1, 0, 0, 0, 77, 576, 1, 0, 0, 0, 79, 586, 
-	1, 0, 0, 0, 81, 596, 1, 0, 0, 0, 83, 607, 1, 0, 0, 0, 85, 612, 1, 0, 0, 
-	0, 87, 620, 1, 0, 0, 0, 89, 627, 1, 0, 0, 0, 91, 633, 1, 0, 0, 0, 93, 640, 
-	1, 0, 0, 0, 95, 644, 1, 0, 0, 0, 97, 649, 1, 0, 0, 0, 99, 654, 1, 0, 0, 
-	0, 101, 658, 1, 0, 0, 0, 103, 663, 1, 0, 0, 0, 105, 670, 1, 0, 0, 0, 107, 
-	676, 1, 0, 0, 0, 109, 681, 1, 0, 0, 0, 111, 687, 1, 0, 0, 0, 113, 693, 
-	1, 0, 0, 0, 115, 701, 1, 0, 0, 0, 117, 707, 1, 0, 0, 0, 119, 715, 1, 0, 
-	0, 0, 121, 725, 1, 0, 0, 0, 123, 732, 1, 0, 0, 0, 125, 735, 1, 0, 0, 0, 
-	127, 739, 1, 0, 0, 0, 129, 742, 1, 0, 0, 0, 131, 747, 1, 0, 0, 0, 133, 
-	752, 1, 0, 0, 0, 135, 761, 1, 0, 0, 0, 137, 767, 1, 0, 0, 0, 139, 771, 
-	1, 0, 0, 0, 141, 776, 1, 0, 0, 0, 143, 781, 1, 0, 0, 0, 145, 785, 1, 0, 
-	0, 0, 147, 793, 1, 0, 0, 0, 149, 796, 1, 0, 0, 0, 151, 802, 1, 0, 0, 0, 
-	153, 809, 1, 0, 0, 0, 155, 812, 1, 0, 0, 0, 157, 816, 1, 0, 0, 0, 159, 
-	822, 1, 0, 0, 0, 161, 827, 1, 0, 0, 0, 163, 831, 1, 0, 0, 0, 165, 834, 
-	1, 0, 0, 0, 167, 838, 1, 0, 0, 0, 169, 846, 1, 0, 0, 0, 171, 855, 1, 0, 
-	0, 0, 173, 863, 1, 0, 0, 0, 175, 866, 1, 0, 0, 0, 177, 870, 1, 0, 0, 0, 
-	179, 874, 1, 0, 0, 0, 181, 878, 1, 0, 0, 0, 183, 884, 1, 0, 0, 0, 185, 
-	889, 1, 0, 0, 0, 187, 895, 1, 0, 0, 0, 189, 899, 1, 0, 0, 0, 191, 906, 
-	1, 0, 0, 0, 193, 915, 1, 0, 0, 0, 195, 920, 1, 0, 0, 0, 197, 922, 1, 0, 
-	0, 0, 199, 924, 1, 0, 0, 0, 201, 926, 1, 0, 0, 0, 203, 928, 1, 0, 0, 0, 
-	205, 930, 1, 0, 0, 0, 207, 932, 1, 0, 0, 0, 209, 934, 1, 0, 0, 0, 211, 
-	936, 1, 0, 0, 0, 213, 938, 1, 0, 0, 0, 215, 940, 1, 0, 0, 0, 217, 943, 
-	1, 0, 0, 0, 219, 946, 1, 0, 0, 0, 221, 948, 1, 0, 0, 0, 223, 951, 1, 0, 
-	0, 0, 225, 953, 1, 0, 0, 0, 227, 956, 1, 0, 0, 0, 229, 959, 1, 0, 0, 0, 
-	231, 962, 1, 0, 0, 0, 233, 964, 1, 0, 0, 0, 235, 966, 1, 0, 0, 0, 237, 
-	968, 1, 0, 0, 0, 239, 970, 1, 0, 0, 0, 241, 972, 1, 0, 0, 0, 243, 974, 
-	1, 0, 0, 0, 245, 976, 1, 0, 0, 0, 247, 978, 1, 0, 0, 0, 249, 980, 1, 0, 
-	0, 0, 251, 982, 1, 0, 0, 0, 253, 984, 1, 0, 0, 0, 255, 986, 1, 0, 0, 0, 
-	257, 988, 1, 0, 0, 0, 259, 991, 1, 0, 0, 0, 261, 1014, 1, 0, 0, 0, 263, 
-	1016, 1, 0, 0, 0, 265, 1018, 1, 0, 0, 0, 267, 1070, 1, 0, 0, 0, 269, 1072, 
-	1, 0, 0, 0, 271, 1074, 1, 0, 0, 0, 273, 1076, 1, 0, 0, 0, 275, 1078, 1, 
-	0, 0, 0, 277, 1080, 1, 0, 0, 0, 279, 1082, 1, 0, 0, 0, 281, 1084, 1, 0, 
-	0, 0, 283, 1086, 1, 0, 0, 0, 285, 1088, 1, 0, 0, 0, 287, 1090, 1, 0, 0, 
-	0, 289, 1092, 1, 0, 0, 0, 291, 1094, 1, 0, 0, 0, 293, 1096, 1, 0, 0, 0, 
-	295, 1098, 1, 0, 0, 0, 297, 1100, 1, 0, 0, 0, 299, 1102, 1, 0, 0, 0, 301, 
-	1104, 1, 0, 0, 0, 303, 1106, 1, 0, 0, 0, 305, 1108, 1, 0, 0, 0, 307, 1110, 
-	1, 0, 0, 0, 309, 1112, 1, 0, 0, 0, 311, 1114, 1, 0, 0, 0, 313, 1116, 1, 
-	0, 0, 0, 315, 1118, 1, 0, 0, 0, 317, 1120, 1, 0, 0, 0, 319, 1122, 1, 0, 
-	0, 0, 321, 322, 5, 116, 0, 0, 322, 323, 5, 114, 0, 0, 323, 324, 5, 117, 
-	0, 0, 324, 325, 5, 101, 0, 0, 325, 2, 1, 0, 0, 0, 326, 327, 5, 102, 0, 
-	0, 327, 328, 5, 97, 0, 0, 328, 329, 5, 108, 0, 0, 329, 330, 5, 115, 0, 
-	0, 330, 331, 5, 101, 0, 0, 331, 4, 1, 0, 0, 0, 332, 333, 5, 110, 0, 0, 
-	333, 334, 5, 117, 0, 0, 334, 335, 5, 108, 0, 0, 335, 336, 5, 108, 0, 0, 
-	336, 6, 1, 0, 0, 0, 337, 342, 5, 34, 0, 0, 338, 341, 3, 9, 4, 0, 339, 341, 
-	3, 15, 7, 0, 340, 338, 1, 0, 0, 0, 340, 339, 1, 0, 0, 0, 341, 344, 1, 0, 
-	0, 0, 342, 340, 1, 0, 0, 0, 342, 343, 1, 0, 0, 0, 343, 345, 1, 0, 0, 0, 
-	344, 342, 1, 0, 0, 0, 345, 346, 5, 34, 0, 0, 346, 8, 1, 0, 0, 0, 347, 350, 
-	5, 92, 0, 0, 348, 351, 7, 0, 0, 0, 349, 351, 3, 11, 5, 0, 350, 348, 1, 
-	0, 0, 0, 350, 349, 1, 0, 0, 0, 351, 10, 1, 0, 0, 0, 352, 353, 5, 117, 0, 
-	0, 353, 354, 3, 13, 6, 0, 354, 355, 3, 13, 6, 0, 355, 356, 3, 13, 6, 0, 
-	356, 357, 3, 13, 6, 0, 357, 12, 1, 0, 0, 0, 358, 359, 7, 1, 0, 0, 359, 
-	14, 1, 0, 0, 0, 360, 361, 8, 2, 0, 0, 361, 16, 1, 0, 0, 0, 362, 364, 7, 
-	3, 0, 0, 363, 365, 7, 4, 0, 0, 364, 363, 1, 0, 0, 0, 364, 365, 1, 0, 0, 
-	0, 365, 366, 1, 0, 0, 0, 366, 367, 3, 259, 129, 0, 367, 18, 1, 0, 0, 0, 
-	368, 370, 7, 5, 0, 0, 369, 368, 1, 0, 0, 0, 370, 371, 1, 0, 0, 0, 371, 
-	369, 1, 0, 0, 0, 371, 372, 1, 0, 0, 0, 372, 373, 1, 0, 0, 0, 373, 374, 
-	6, 9, 0, 0, 374, 20, 1, 0, 0, 0, 375, 376, 3, 273, 136, 0, 376, 377, 3, 
-	303, 151, 0, 377, 378, 3, 277, 138, 0, 378, 379, 3, 269, 134, 0, 379, 380, 
-	3, 307, 153, 0, 380, 381, 3, 277, 138, 0, 381, 22, 1, 0, 0, 0, 382, 383, 
-	3, 309, 154, 0, 383, 384, 3, 299, 149, 0, 384, 385, 3, 275, 137, 0, 385, 
-	386, 3, 269, 134, 0, 386, 387, 3, 307, 153, 0, 387, 388, 3, 277, 138, 0, 
-	388, 24, 1, 0, 0, 0, 389, 390, 3, 305, 152, 0, 390, 391, 3, 277, 138, 0, 
-	391, 392, 3, 307, 153, 0, 392, 26, 1, 0, 0, 0, 393, 394, 3, 275, 137, 0, 
-	394, 395, 3, 303, 151, 0, 395, 396, 3, 297, 148, 0, 396, 397, 3, 299, 149, 
-	0, 397, 28, 1, 0, 0, 0, 398, 399, 3, 285, 142, 0, 399, 400, 3, 295, 147, 
-	0, 400, 401, 3, 307, 153, 0, 401, 402, 3, 277, 138, 0, 402, 403, 3, 303, 
-	151, 0, 403, 404, 3, 311, 155, 0, 404, 405, 3, 269, 134, 0, 405, 406, 3, 
-	291, 145, 0, 406, 30, 1, 0, 0, 0, 407, 408, 3, 295, 147, 0, 408, 409, 3, 
-	269, 134, 0, 409, 410, 3, 293, 146, 0, 410, 411, 3, 277, 138, 0, 411, 32, 
-	1, 0, 0, 0, 412, 413, 3, 305, 152, 0, 413, 414, 3, 283, 141, 0, 414, 415, 
-	3, 269, 134, 0, 415, 416, 3, 303, 151, 0, 416, 417, 3, 275, 137, 0, 417, 
-	34, 1, 0, 0, 0, 418, 419, 3, 303, 151, 0, 419, 420, 3, 277, 138, 0, 420, 
-	421, 3, 299, 149, 0, 421, 422, 3, 291, 145, 0, 422, 423, 3, 285, 142, 0, 
-	423, 424, 3, 273, 136, 0, 424, 425, 3, 269, 134, 0, 425, 426, 3, 307, 153, 
-	0, 426, 427, 3, 285, 142, 0, 427, 428, 3, 297, 148, 0, 428, 429, 3, 295, 
-	147, 0, 429, 36, 1, 0, 0, 0, 430, 431, 3, 307, 153, 0, 431, 432, 3, 307, 
-	153, 0, 432, 433, 3, 291, 145, 0, 433, 38, 1, 0, 0, 0, 434, 435, 3, 293, 
-	146, 0, 435, 436, 3, 277, 138, 0, 436, 437, 3, 307, 153, 0, 437, 438, 3, 
-	269, 134, 0, 438, 439, 3, 307, 153, 0, 439, 440, 3, 307, 153, 0, 440, 441, 
-	3, 291, 145, 0, 441, 40, 1, 0, 0, 0, 442, 443, 3, 299, 149, 0, 443, 444, 
-	3, 269, 134, 0, 444, 445, 3, 305, 152, 0, 445, 446, 3, 307, 153, 0, 446, 
-	447, 3, 307, 153, 0, 447, 448, 3, 307, 153, 0, 448, 449, 3, 291, 145, 0, 
-	449, 42, 1, 0, 0, 0, 450, 451, 3, 279, 139, 0, 451, 452, 3, 309, 154, 0, 
-	452, 453, 3, 307, 153, 0, 453, 454, 3, 309, 154, 0, 454, 455, 3, 303, 151, 
-	0, 455, 456, 3, 277, 138, 0, 456, 457, 3, 307, 153, 0, 457, 458, 3, 307, 
-	153, 0, 458, 459, 3, 291, 145, 0, 459, 44, 1, 0, 0, 0, 460, 461, 3, 289, 
-	144, 0, 461, 462, 3, 285, 142, 0, 462, 463, 3, 291, 145, 0, 463, 464, 3, 
-	291, 145, 0, 464, 46, 1, 0, 0, 0, 465, 466, 3, 297, 148, 0, 466, 467, 3, 
-	295, 147, 0, 467, 48, 1, 0, 0, 0, 468, 469, 3, 305, 152, 0, 469, 470, 3, 
-	283, 141, 0, 470, 471, 3, 297, 148, 0, 471, 472, 3, 313, 156, 0, 472, 50, 
-	1, 0, 0, 0, 473, 474, 3, 309, 154, 0, 474, 475, 3, 305, 152, 0, 475, 476, 
-	3, 277, 138, 0, 476, 52, 1, 0, 0, 0, 477, 478, 3, 305, 152, 0, 478, 479, 
-	3, 307, 153, 0, 479, 480, 3, 269, 134, 0, 480, 481, 3, 307, 153, 0, 481, 
-	482, 3, 277, 138, 0, 482, 483, 3, 255, 127, 0, 483, 484, 3, 303, 151, 0, 
-	484, 485, 3, 277, 138, 0, 485, 486, 3, 299, 149, 0, 486, 487, 3, 297, 148, 
-	0, 487, 54, 1, 0, 0, 0, 488, 489, 3, 305, 152, 0, 489, 490, 3, 307, 153, 
-	0, 490, 491, 3, 269, 134, 0, 491, 492, 3, 307, 153, 0, 492, 493, 3, 277, 
-	138, 0, 493, 494, 3, 255, 127, 0, 494, 495, 3, 293, 146, 0, 495, 496, 3, 
-	269, 134, 0, 496, 497, 3, 273, 136, 0, 497, 498, 3, 283, 141, 0, 498, 499, 
-	3, 285, 142, 0, 499, 500, 3, 295, 147, 0, 500, 501, 3, 277, 138, 0, 501, 
-	56, 1, 0, 0, 0, 502, 503, 3, 293, 146, 0, 503, 504, 3, 269, 134, 0, 504, 
-	505, 3, 305, 152, 0, 505, 506, 3, 307, 153, 0, 506, 507, 3, 277, 138, 0, 
-	507, 508, 3, 303, 151, 0, 508, 58, 1, 0, 0, 0, 509, 510, 3, 293, 146, 0, 
-	510, 511, 3, 277, 138, 0, 511, 512, 3, 307, 153, 0, 512, 513, 3, 269, 134, 
-	0, 513, 514, 3, 275, 137, 0, 514, 515, 3, 269, 134, 0, 515, 516, 3, 307, 
-	153, 0, 516, 517, 3, 269, 134, 0, 517, 60, 1, 0, 0, 0, 518, 519, 3, 307, 
-	153, 0, 519, 520, 3, 317, 158, 0, 520, 521, 3, 299, 149, 0, 521, 522, 3, 
-	277, 138, 0, 522, 523, 3, 305, 152, 0, 523, 62, 1, 0, 0, 0, 524, 525, 3, 
-	307, 153, 0, 525, 526, 3, 317, 158, 0, 526, 527, 3, 299, 149, 0, 527, 528, 
-	3, 277, 138, 0, 528, 64, 1, 0, 0, 0, 529, 530, 3, 305, 152, 0, 530, 531, 
-	3, 307, 153, 0, 531, 532, 3, 297, 148, 0, 532, 533, 3, 303, 151, 0, 533, 
-	534, 3, 269, 134, 0, 534, 535, 3, 281, 140, 0, 535, 536, 3, 277, 138, 0, 
-	536, 537, 3, 305, 152, 0, 537, 66, 1, 0, 0, 0, 538, 539, 3, 305, 152, 0, 
-	539, 540, 3, 307, 153, 0, 540, 541, 3, 297, 148, 0, 541, 542, 3, 303, 151, 
-	0, 542, 543, 3, 269, 134, 0, 543, 544, 3, 281, 140, 0, 544, 545, 3, 277, 
-	138, 0, 545, 68, 1, 0, 0, 0, 546, 547, 3, 271, 135, 0, 547, 548, 3, 303, 
-	151, 0, 548, 549, 3, 297, 148, 0, 549, 550, 3, 289, 144, 0, 550, 551, 3, 
-	277, 138, 0, 551, 552, 3, 303, 151, 0, 552, 70, 1, 0, 0, 0, 553, 554, 3, 
-	269, 134, 0, 554, 555, 3, 291, 145, 0, 555, 556, 3, 285, 142, 0, 556, 557, 
-	3, 311, 155, 0, 557, 558, 3, 277, 138, 0, 558, 72, 1, 0, 0, 0, 559, 560, 
-	3, 305, 152, 0, 560, 561, 3, 273, 136, 0, 561, 562, 3, 283, 141, 0, 562, 
-	563, 3, 277, 138, 0, 563, 564, 3, 293, 146, 0, 564, 565, 3, 269, 134, 0, 
-	565, 566, 3, 305, 152, 0, 566, 74, 1, 0, 0, 0, 567, 568, 3, 275, 137, 0, 
-	568, 569, 3, 269, 134, 0, 569, 570, 3, 307, 153, 0, 570, 571, 3, 269, 134, 
-	0, 571, 572, 3, 271, 135, 0, 572, 573, 3, 269, 134, 0, 573, 574, 3, 305, 
-	152, 0, 574, 575, 3, 277, 138, 0, 575, 76, 1, 0, 0, 0, 576, 577, 3, 275, 
-	137, 0, 577, 578, 3, 269, 134, 0, 578, 579, 3, 307, 153, 0, 579, 580, 3, 
-	269, 134, 0, 580, 581, 3, 271, 135, 0, 581, 582, 3, 269, 134, 0, 582, 583, 
-	3, 305, 152, 0, 583, 584, 3, 277, 138, 0, 584, 585, 3, 305, 152, 0, 585, 
-	78, 1, 0, 0, 0, 586, 587, 3, 295, 147, 0, 587, 588, 3, 269, 134, 0, 588, 
-	589, 3, 293, 146, 0, 589, 590, 3, 277, 138, 0, 590, 591, 3, 305, 152, 0, 
-	591, 592, 3, 299, 149, 0, 592, 593, 3, 269, 134, 0, 593, 594, 3, 273, 136, 
-	0, 594, 595, 3, 277, 138, 0, 595, 80, 1, 0, 0, 0, 596, 597, 3, 295, 147, 
-	0, 597, 598, 3, 269, 134, 0, 598, 599, 3, 293, 146, 0, 599, 600, 3, 277, 
-	138, 0, 600, 601, 3, 305, 152, 0, 601, 602, 3, 299, 149, 0, 602, 603, 3, 
-	269, 134, 0, 603, 604, 3, 273, 136, 0, 604, 605, 3, 277, 138, 0, 605, 606, 
-	3, 305, 152, 0, 606, 82, 1, 0, 0, 0, 607, 608, 3, 295, 147, 0, 608, 609, 
-	3, 297, 148, 0, 609, 610, 3, 275, 137, 0, 610, 611, 3, 277, 138, 0, 611, 
-	84, 1, 0, 0, 0, 612, 613, 3, 293, 146, 0, 613, 614, 3, 277, 138, 0, 614, 
-	615, 3, 307, 153, 0, 615, 616, 3, 303, 151, 0, 616, 617, 3, 285, 142, 0, 
-	617, 618, 3, 273, 136, 0, 618, 619, 3, 305, 152, 0, 619, 86, 1, 0, 0, 0, 
-	620, 621, 3, 293, 146, 0, 621, 622, 3, 277, 138, 0, 622, 623, 3, 307, 153, 
-	0, 623, 624, 3, 303, 151, 0, 624, 625, 3, 285, 142, 0, 625, 626, 3, 273, 
-	136, 0, 626, 88, 1, 0, 0, 0, 627, 628, 3, 279, 139, 0, 628, 629, 3, 285, 
-	142, 0, 629, 630, 3, 277, 138, 0, 630, 631, 3, 291, 145, 0, 631, 632, 3, 
-	275, 137, 0, 632, 90, 1, 0, 0, 0, 633, 634, 3, 279, 139, 0, 634, 635, 3, 
-	285, 142, 0, 635, 636, 3, 277, 138, 0, 636, 637, 3, 291, 145, 0, 637, 638, 
-	3, 275, 137, 0, 638, 639, 3, 305, 152, 0, 639, 92, 1, 0, 0, 0, 640, 641, 
-	3, 307, 153, 0, 641, 642, 3, 269, 134, 0, 642, 643, 3, 281, 140, 0, 643, 
-	94, 1, 0, 0, 0, 644, 645, 3, 285, 142, 0, 645, 646, 3, 295, 147, 0, 646, 
-	647, 3, 279, 139, 0, 647, 648, 3, 297, 148, 0, 648, 96, 1, 0, 0, 0, 649, 
-	650, 3, 289, 144, 0, 650, 651, 3, 277, 138, 0, 651, 652, 3, 317, 158, 0, 
-	652, 653, 3, 305, 152, 0, 653, 98, 1, 0, 0, 0, 654, 655, 3, 289, 144, 0, 
-	655, 656, 3, 277, 138, 0, 656, 657, 3, 317, 158, 0, 657, 100, 1, 0, 0, 
-	0, 658, 659, 3, 313, 156, 0, 659, 660, 3, 285, 142, 0, 660, 661, 3, 307, 
-	153, 0, 661, 662, 3, 283, 141, 0, 662, 102, 1, 0, 0, 0, 663, 664, 3, 311, 
-	155, 0, 664, 665, 3, 269, 134, 0, 665, 666, 3, 291, 145, 0, 666, 667, 3, 
-	309, 154, 0, 667, 668, 3, 277, 138, 0, 668, 669, 3, 305, 152, 0, 669, 104, 
-	1, 0, 0, 0, 670, 671, 3, 311, 155, 0, 671, 672, 3, 269, 134, 0, 672, 673, 
-	3, 291, 145, 0, 673, 674, 3, 309, 154, 0, 674, 675, 3, 277, 138, 0, 675, 
-	106, 1, 0, 0, 0, 676, 677, 3, 279, 139, 0, 677, 678, 3, 303, 151, 0, 678, 
-	679, 3, 297, 148, 0, 679, 680, 3, 293, 146, 0, 680, 108, 1, 0, 0, 0, 681, 
-	682, 3, 313, 156, 0, 682, 683, 3, 283, 141, 0, 683, 684, 3, 277, 138, 0, 
-	684, 685, 3, 303, 151, 0, 685, 686, 3, 277, 138, 0, 686, 110, 1, 0, 0, 
-	0, 687, 688, 3, 291, 145, 0, 688, 689, 3, 285, 142, 0, 689, 690, 3, 293, 
-	146, 0, 690, 691, 3, 285, 142, 0, 691, 692, 3, 307, 153, 0, 692, 112, 1, 
-	0, 0, 0, 693, 694, 3, 301, 150, 0, 694, 695, 3, 309, 154, 0, 695, 696, 
-	3, 277, 138, 0, 696, 697, 3, 303, 151, 0, 697, 698, 3, 285, 142, 0, 698, 
-	699, 3, 277, 138, 0, 699, 700, 3, 305, 152, 0, 700, 114, 1, 0, 0, 0, 701, 
-	702, 3, 301, 150, 0, 702, 703, 3, 309, 154, 0, 703, 704, 3, 277, 138, 0, 
-	704, 705, 3, 303, 151, 0, 705, 706, 3, 317, 158, 0, 706, 116, 1, 0, 0, 
-	0, 707, 708, 3, 277, 138, 0, 708, 709, 3, 315, 157, 0, 709, 710, 3, 299, 
-	149, 0, 710, 711, 3, 291, 145, 0, 711, 712, 3, 269, 134, 0, 712, 713, 3, 
-	285, 142, 0, 713, 714, 3, 295, 147, 0, 714, 118, 1, 0, 0, 0, 715, 716, 
-	3, 313, 156, 0, 716, 717, 3, 285, 142, 0, 717, 718, 3, 307, 153, 0, 718, 
-	719, 3, 283, 141, 0, 719, 720, 3, 311, 155, 0, 720, 721, 3, 269, 134, 0, 
-	721, 722, 3, 291, 145, 0, 722, 723, 3, 309, 154, 0, 723, 724, 3, 277, 138, 
-	0, 724, 120, 1, 0, 0, 0, 725, 726, 3, 305, 152, 0, 726, 727, 3, 277, 138, 
-	0, 727, 728, 3, 291, 145, 0, 728, 729, 3, 277, 138, 0, 729, 730, 3, 273, 
-	136, 0, 730, 731, 3, 307, 153, 0, 731, 122, 1, 0, 0, 0, 732, 733, 3, 269, 
-	134, 0, 733, 734, 3, 305, 152, 0, 734, 124, 1, 0, 0, 0, 735, 736, 3, 269, 
-	134, 0, 736, 737, 3, 295, 147, 0, 737, 738, 3, 275, 137, 0, 738, 126, 1, 
-	0, 0, 0, 739, 740, 3, 297, 148, 0, 740, 741, 3, 303, 151, 0, 741, 128, 
-	1, 0, 0, 0, 742, 743, 3, 279, 139, 0, 743, 744, 3, 285, 142, 0, 744, 745, 
-	3, 291, 145, 0, 745, 746, 3, 291, 145, 0, 746, 130, 1, 0, 0, 0, 747, 748, 
-	3, 295, 147, 0, 748, 749, 3, 309, 154, 0, 749, 750, 3, 291, 145, 0, 750, 
-	751, 3, 291, 145, 0, 751, 132, 1, 0, 0, 0, 752, 753, 3, 299, 149, 0, 753, 
-	754, 3, 303, 151, 0, 754, 755, 3, 277, 138, 0, 755, 756, 3, 311, 155, 0, 
-	756, 757, 3, 285, 142, 0, 757, 758, 3, 297, 148, 0, 758, 759, 3, 309, 154, 
-	0, 759, 760, 3, 305, 152, 0, 760, 134, 1, 0, 0, 0, 761, 762, 3, 297, 148, 
-	0, 762, 763, 3, 303, 151, 0, 763, 764, 3, 275, 137, 0, 764, 765, 3, 277, 
-	138, 0, 765, 766, 3, 303, 151, 0, 766, 136, 1, 0, 0, 0, 767, 768, 3, 269, 
-	134, 0, 768, 769, 3, 305, 152, 0, 769, 770, 3, 273, 136, 0, 770, 138, 1, 
-	0, 0, 0, 771, 772, 3, 275, 137, 0, 772, 773, 3, 277, 138, 0, 773, 774, 
-	3, 305, 152, 0, 774, 775, 3, 273, 136, 0, 775, 140, 1, 0, 0, 0, 776, 777, 
-	3, 291, 145, 0, 777, 778, 3, 285, 142, 0, 778, 779, 3, 289, 144, 0, 779, 
-	780, 3, 277, 138, 0, 780, 142, 1, 0, 0, 0, 781, 782, 3, 295, 147, 0, 782, 
-	783, 3, 297, 148, 0, 783, 784, 3, 307, 153, 0, 784, 144, 1, 0, 0, 0, 785, 
-	786, 3, 271, 135, 0, 786, 787, 3, 277, 138, 0, 787, 788, 3, 307, 153, 0, 
-	788, 789, 3, 313, 156, 0, 789, 790, 3, 277, 138, 0, 790, 791, 3, 277, 138, 
-	0, 791, 792, 3, 295, 147, 0, 792, 146, 1, 0, 0, 0, 793, 794, 3, 285, 142, 
-	0, 794, 795, 3, 305, 152, 0, 795, 148, 1, 0, 0, 0, 796, 797, 3, 281, 140, 
-	0, 797, 798, 3, 303, 151, 0, 798, 799, 3, 297, 148, 0, 799, 800, 3, 309, 
-	154, 0, 800, 801, 3, 299, 149, 0, 801, 150, 1, 0, 0, 0, 802, 803, 3, 283, 
-	141, 0, 803, 804, 3, 269, 134, 0, 804, 805, 3, 311, 155, 0, 805, 806, 3, 
-	285, 142, 0, 806, 807, 3, 295, 147, 0, 807, 808, 3, 281, 140, 0, 808, 152, 
-	1, 0, 0, 0, 809, 810, 3, 271, 135, 0, 810, 811, 3, 317, 158, 0, 811, 154, 
-	1, 0, 0, 0, 812, 813, 3, 279, 139, 0, 813, 814, 3, 297, 148, 0, 814, 815, 
-	3, 303, 151, 0, 815, 156, 1, 0, 0, 0, 816, 817, 3, 305, 152, 0, 817, 818, 
-	3, 307, 153, 0, 818, 819, 3, 269, 134, 0, 819, 820, 3, 307, 153, 0, 820, 
-	821, 3, 305, 152, 0, 821, 158, 1, 0, 0, 0, 822, 823, 3, 307, 153, 0, 823, 
-	824, 3, 285, 142, 0, 824, 825, 3, 293, 146, 0, 825, 826, 3, 277, 138, 0, 
-	826, 160, 1, 0, 0, 0, 827, 828, 3, 295, 147, 0, 828, 829, 3, 297, 148, 
-	0, 829, 830, 3, 313, 156, 0, 830, 162, 1, 0, 0, 0, 831, 832, 3, 285, 142, 
-	0, 832, 833, 3, 295, 147, 0, 833, 164, 1, 0, 0, 0, 834, 835, 3, 291, 145, 
-	0, 835, 836, 3, 297, 148, 0, 836, 837, 3, 281, 140, 0, 837, 166, 1, 0, 
-	0, 0, 838, 839, 3, 299, 149, 0, 839, 840, 3, 303, 151, 0, 840, 841, 3, 
-	297, 148, 0, 841, 842, 3, 279, 139, 0, 842, 843, 3, 285, 142, 0, 843, 844, 
-	3, 291, 145, 0, 844, 845, 3, 277, 138, 0, 845, 168, 1, 0, 0, 0, 846, 847, 
-	3, 303, 151, 0, 847, 848, 3, 277, 138, 0, 848, 849, 3, 301, 150, 0, 849, 
-	850, 3, 309, 154, 0, 850, 851, 3, 277, 138, 0, 851, 852, 3, 305, 152, 0, 
-	852, 853, 3, 307, 153, 0, 853, 854, 3, 305, 152, 0, 854, 170, 1, 0, 0, 
-	0, 855, 856, 3, 303, 151, 0, 856, 857, 3, 277, 138, 0, 857, 858, 3, 301, 
-	150, 0, 858, 859, 3, 309, 154, 0, 859, 860, 3, 277, 138, 0, 860, 861, 3, 
-	305, 152, 0, 861, 862, 3, 307, 153, 0, 862, 172, 1, 0, 0, 0, 863, 864, 
-	3, 285, 142, 0, 864, 865, 3, 275, 137, 0, 865, 174, 1, 0, 0, 0, 866, 867, 
-	3, 305, 152, 0, 867, 868, 3, 309, 154, 0, 868, 869, 3, 293, 146, 0, 869, 
-	176, 1, 0, 0, 0, 870, 871, 3, 293, 146, 0, 871, 872, 3, 285, 142, 0, 872, 
-	873, 3, 295, 147, 0, 873, 178, 1, 0, 0, 0, 874, 875, 3, 293, 146, 0, 875, 
-	876, 3, 269, 134, 0, 876, 877, 3, 315, 157, 0, 877, 180, 1, 0, 0, 0, 878, 
-	879, 3, 273, 136, 0, 879, 880, 3, 297, 148, 0, 880, 881, 3, 309, 154, 0, 
-	881, 882, 3, 295, 147, 0, 882, 883, 3, 307, 153, 0, 883, 182, 1, 0, 0, 
-	0, 884, 885, 3, 291, 145, 0, 885, 886, 3, 269, 134, 0, 886, 887, 3, 305, 
-	152, 0, 887, 888, 3, 307, 153, 0, 888, 184, 1, 0, 0, 0, 889, 890, 3, 279, 
-	139, 0, 890, 891, 3, 285, 142, 0, 891, 892, 3, 303, 151, 0, 892, 893, 3, 
-	305, 152, 0, 893, 894, 3, 307, 153, 0, 894, 186, 1, 0, 0, 0, 895, 896, 
-	3, 269, 134, 0, 896, 897, 3, 311, 155, 0, 897, 898, 3, 281, 140, 0, 898, 
-	188, 1, 0, 0, 0, 899, 900, 3, 305, 152, 0, 900, 901, 3, 307, 153, 0, 901, 
-	902, 3, 275, 137, 0, 902, 903, 3, 275, 137, 0, 903, 904, 3, 277, 138, 0, 
-	904, 905, 3, 311, 155, 0, 905, 190, 1, 0, 0, 0, 906, 907, 3, 301, 150, 
-	0, 907, 908, 3, 309, 154, 0, 908, 909, 3, 269, 134, 0, 909, 910, 3, 295, 
-	147, 0, 910, 911, 3, 307, 153, 0, 911, 912, 3, 285, 142, 0, 912, 913, 3, 
-	291, 145, 0, 913, 914, 3, 277, 138, 0, 914, 192, 1, 0, 0, 0, 915, 916, 
-	3, 303, 151, 0, 916, 917, 3, 269, 134, 0, 917, 918, 3, 307, 153, 0, 918, 
-	919, 3, 277, 138, 0, 919, 194, 1, 0, 0, 0, 920, 921, 3, 305, 152, 0, 921, 
-	196, 1, 0, 0, 0, 922, 923, 5, 109, 0, 0, 923, 198, 1, 0, 0, 0, 924, 925, 
-	3, 283, 141, 0, 925, 200, 1, 0, 0, 0, 926, 927, 3, 275, 137, 0, 927, 202, 
-	1, 0, 0, 0, 928, 929, 3, 313, 156, 0, 929, 204, 1, 0, 0, 0, 930, 931, 5, 
-	77, 0, 0, 931, 206, 1, 0, 0, 0, 932, 933, 3, 317, 158, 0, 933, 208, 1, 
-	0, 0, 0, 934, 935, 5, 46, 0, 0, 935, 210, 1, 0, 0, 0, 936, 937, 5, 58, 
-	0, 0, 937, 212, 1, 0, 0, 0, 938, 939, 5, 61, 0, 0, 939, 214, 1, 0, 0, 0, 
-	940, 941, 5, 60, 0, 0, 941, 942, 5, 62, 0, 0, 942, 216, 1, 0, 0, 0, 943, 
-	944, 5, 33, 0, 0, 944, 945, 5, 61, 0, 0, 945, 218, 1, 0, 0, 0, 946, 947, 
-	5, 62, 0, 0, 947, 220, 1, 0, 0, 0, 948, 949, 5, 62, 0, 0, 949, 950, 5, 
-	61, 0, 0, 950, 222, 1, 0, 0, 0, 951, 952, 5, 60, 0, 0, 952, 224, 1, 0, 
-	0, 0, 953, 954, 5, 60, 0, 0, 954, 955, 5, 61, 0, 0, 955, 226, 1, 0, 0, 
-	0, 956, 957, 5, 61, 0, 0, 957, 958, 5, 126, 0, 0, 958, 228, 1, 0, 0, 0, 
-	959, 960, 5, 33, 0, 0, 960, 961, 5, 126, 0, 0, 961, 230, 1, 0, 0, 0, 962, 
-	963, 5, 44, 0, 0, 963, 232, 1, 0, 0, 0, 964, 965, 5, 123, 0, 0, 965, 234, 
-	1, 0, 0, 0, 966, 967, 5, 125, 0, 0, 967, 236, 1, 0, 0, 0, 968, 969, 5, 
-	91, 0, 0, 969, 238, 1, 0, 0, 0, 970, 971, 5, 93, 0, 0, 971, 240, 1, 0, 
-	0, 0, 972, 973, 5, 40, 0, 0, 973, 242, 1, 0, 0, 0, 974, 975, 5, 41, 0, 
-	0, 975, 244, 1, 0, 0, 0, 976, 977, 5, 43, 0, 0, 977, 246, 1, 0, 0, 0, 978, 
-	979, 5, 45, 0, 0, 979, 248, 1, 0, 0, 0, 980, 981, 5, 47, 0, 0, 981, 250, 
-	1, 0, 0, 0, 982, 983, 5, 42, 0, 0, 983, 252, 1, 0, 0, 0, 984, 985, 5, 37, 
-	0, 0, 985, 254, 1, 0, 0, 0, 986, 987, 5, 95, 0, 0, 987, 256, 1, 0, 0, 0, 
-	988, 989, 3, 267, 133, 0, 989, 258, 1, 0, 0, 0, 990, 992, 3, 265, 132, 
-	0, 991, 990, 1, 0, 0, 0, 992, 993, 1, 0, 0, 0, 993, 991, 1, 0, 0, 0, 993, 
-	994, 1, 0, 0, 0, 994, 260, 1, 0, 0, 0, 995, 997, 3, 265, 132, 0, 996, 995, 
-	1, 0, 0, 0, 997, 998, 1, 0, 0, 0, 998, 996, 1, 0, 0, 0, 998, 999, 1, 0, 
-	0, 0, 999, 1000, 1, 0, 0, 0, 1000, 1001, 5, 46, 0, 0, 1001, 1005, 8, 6, 
-	0, 0, 1002, 1004, 3, 265, 132, 0, 1003, 1002, 1, 0, 0, 0, 1004, 1007, 1, 
-	0, 0, 0, 1005, 1003, 1, 0, 0, 0, 1005, 1006, 1, 0, 0, 0, 1006, 1015, 1, 
-	0, 0, 0, 1007, 1005, 1, 0, 0, 0, 1008, 1010, 5, 46, 0, 0, 1009, 1011, 3, 
-	265, 132, 0, 1010, 1009, 1, 0, 0, 0, 1011, 1012, 1, 0, 0, 0, 1012, 1010, 
-	1, 0, 0, 0, 1012, 1013, 1, 0, 0, 0, 1013, 1015, 1, 0, 0, 0, 1014, 996, 
-	1, 0, 0, 0, 1014, 1008, 1, 0, 0, 0, 1015, 262, 1, 0, 0, 0, 1016, 1017, 
-	7, 5, 0, 0, 1017, 264, 1, 0, 0, 0, 1018, 1019, 7, 7, 0, 0, 1019, 266, 1, 
-	0, 0, 0, 1020, 1026, 7, 8, 0, 0, 1021, 1025, 7, 8, 0, 0, 1022, 1025, 3, 
-	265, 132, 0, 1023, 1025, 7, 9, 0, 0, 1024, 1021, 1, 0, 0, 0, 1024, 1022, 
-	1, 0, 0, 0, 1024, 1023, 1, 0, 0, 0, 1025, 1028, 1, 0, 0, 0, 1026, 1024, 
-	1, 0, 0, 0, 1026, 1027, 1, 0, 0, 0, 1027, 1071, 1, 0, 0, 0, 1028, 1026, 
-	1, 0, 0, 0, 1029, 1030, 5, 36, 0, 0, 1030, 1034, 5, 123, 0, 0, 1031, 1033, 
-	9, 0, 0, 0, 1032, 1031, 1, 0, 0, 0, 1033, 1036, 1, 0, 0, 0, 1034, 1035, 
-	1, 0, 0, 0, 1034, 1032, 1, 0, 0, 0, 1035, 1037, 1, 0, 0, 0, 1036, 1034, 
-	1, 0, 0, 0, 1037, 1071, 5, 125, 0, 0, 1038, 1042, 7, 10, 0, 0, 1039, 1043, 
-	7, 8, 0, 0, 1040, 1043, 3, 265, 132, 0, 1041, 1043, 7, 11, 0, 0, 1042, 
-	1039, 1, 0, 0, 0, 1042, 1040, 1, 0, 0, 0, 1042, 1041, 1, 0, 0, 0, 1043, 
-	1044, 1, 0, 0, 0, 1044, 1042, 1, 0, 0, 0, 1044, 1045, 1, 0, 0, 0, 1045, 
-	1071, 1, 0, 0, 0, 1046, 1050, 5, 34, 0, 0, 1047, 1049, 9, 0, 0, 0, 1048, 
-	1047, 1, 0, 0, 0, 1049, 1052, 1, 0, 0, 0, 1050, 1051, 1, 0, 0, 0, 1050, 
-	1048, 1, 0, 0, 0, 1051, 1053, 1, 0, 0, 0, 1052, 1050, 1, 0, 0, 0, 1053, 
-	1071, 5, 34, 0, 0, 1054, 1058, 5, 96, 0, 0, 1055, 1057, 9, 0, 0, 0, 1056, 
-	1055, 1, 0, 0, 0, 1057, 1060, 1, 0, 0, 0, 1058, 1059, 1, 0, 0, 0, 1058, 
-	1056, 1, 0, 0, 0, 1059, 1061, 1, 0, 0, 0, 1060, 1058, 1, 0, 0, 0, 1061, 
-	1071, 5, 96, 0, 0, 1062, 1066, 5, 39, 0, 0, 1063, 1065, 9, 0, 0, 0, 1064, 
-	1063, 1, 0, 0, 0, 1065, 1068, 1, 0, 0, 0, 1066, 1067, 1, 0, 0, 0, 1066, 
-	1064, 1, 0, 0, 0, 1067, 1069, 1, 0, 0, 0, 1068, 1066, 1, 0, 0, 0, 1069, 
-	1071, 5, 39, 0, 0, 1070, 1020, 1, 0, 0, 0, 1070, 1029, 1, 0, 0, 0, 1070, 
-	1038, 1, 0, 0, 0, 1070, 1046, 1, 0, 0, 0, 1070, 1054, 1, 0, 0, 0, 1070, 
-	1062, 1, 0, 0, 0, 1071, 268, 1, 0, 0, 0, 1072, 1073, 7, 12, 0, 0, 1073, 
-	270, 1, 0, 0, 0, 1074, 1075, 7, 13, 0, 0, 1075, 272, 1, 0, 0, 0, 1076, 
-	1077, 7, 14, 0, 0, 1077, 274, 1, 0, 0, 0, 1078, 1079, 7, 15, 0, 0, 1079, 
-	276, 1, 0, 0, 0, 1080, 1081, 7, 3, 0, 0, 1081, 278, 1, 0, 0, 0, 1082, 1083, 
-	7, 16, 0, 0, 1083, 280, 1, 0, 0, 0, 1084, 1085, 7, 17, 0, 0, 1085, 282, 
-	1, 0, 0, 0, 1086, 1087, 7, 18, 0, 0, 1087, 284, 1, 0, 0, 0, 1088, 1089, 
-	7, 19, 0, 0, 1089, 286, 1, 0, 0, 0, 1090, 1091, 7, 20, 0, 0, 1091, 288, 
-	1, 0, 0, 0, 1092, 1093, 7, 21, 0, 0, 1093, 290, 1, 0, 0, 0, 1094, 1095, 
-	7, 22, 0, 0, 1095, 292, 1, 0, 0, 0, 1096, 1097, 7, 23, 0, 0, 1097, 294, 
-	1, 0, 0, 0, 1098, 1099, 7, 24, 0, 0, 1099, 296, 1, 0, 0, 0, 1100, 1101, 
-	7, 25, 0, 0, 1101, 298, 1, 0, 0, 0, 1102, 1103, 7, 26, 0, 0, 1103, 300, 
-	1, 0, 0, 0, 1104, 1105, 7, 27, 0, 0, 1105, 302, 1, 0, 0, 0, 1106, 1107, 
-	7, 28, 0, 0, 1107, 304, 1, 0, 0, 0, 1108, 1109, 7, 29, 0, 0, 1109, 306, 
-	1, 0, 0, 0, 1110, 1111, 7, 30, 0, 0, 1111, 308, 1, 0, 0, 0, 1112, 1113, 
-	7, 31, 0, 0, 1113, 310, 1, 0, 0, 0, 1114, 1115, 7, 32, 0, 0, 1115, 312, 
-	1, 0, 0, 0, 1116, 1117, 7, 33, 0, 0, 1117, 314, 1, 0, 0, 0, 1118, 1119, 
-	7, 34, 0, 0, 1119, 316, 1, 0, 0, 0, 1120, 1121, 7, 35, 0, 0, 1121, 318, 
-	1, 0, 0, 0, 1122, 1123, 7, 36, 0, 0, 1123, 320, 1, 0, 0, 0, 20, 0, 340, 
-	342, 350, 364, 371, 993, 998, 1005, 1012, 1014, 1024, 1026, 1034, 1042, 
-	1044, 1050, 1058, 1066, 1070, 1, 6, 0, 0,
+	2, 158, 7, 158, 2, 159, 7, 159, 2, 160, 7, 160, 2, 161, 7, 161, 2, 162, 
+	7, 162, 2, 163, 7, 163, 2, 164, 7, 164, 2, 165, 7, 165, 2, 166, 7, 166, 
+	2, 167, 7, 167, 2, 168, 7, 168, 2, 169, 7, 169, 2, 170, 7, 170, 2, 171, 
+	7, 171, 2, 172, 7, 172, 2, 173, 7, 173, 2, 174, 7, 174, 2, 175, 7, 175, 
+	2, 176, 7, 176, 2, 177, 7, 177, 2, 178, 7, 178, 2, 179, 7, 179, 2, 180, 
+	7, 180, 2, 181, 7, 181, 2, 182, 7, 182, 2, 183, 7, 183, 1, 0, 1, 0, 1, 
+	0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 5, 
+	2, 384, 8, 2, 10, 2, 12, 2, 387, 9, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 3, 
+	3, 394, 8, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 6, 1, 
+	6, 1, 7, 1, 7, 3, 7, 408, 8, 7, 1, 7, 1, 7, 1, 8, 4, 8, 413, 8, 8, 11, 
+	8, 12, 8, 414, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 
+	10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 
+	1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 
+	13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 
+	1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 
+	16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 
+	1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 
+	19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 
+	1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 
+	22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 
+	1, 23, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 
+	26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 1, 27, 
+	1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 
+	28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 
+	1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 
+	30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 
+	1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 
+	33, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 
+	1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 
+	36, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 37, 1, 38, 1, 38, 
+	1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 
+	39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 40, 
+	1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1, 41, 1, 41, 1, 
+	41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 42, 
+	1, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 44, 1, 
+	44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 
+	1, 45, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 47, 1, 47, 1, 
+	47, 1, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 49, 
+	1, 49, 1, 50, 1, 50, 1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 
+	52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 53, 1, 53, 
+	1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 
+	55, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 56, 1, 57, 1, 57, 
+	1, 57, 1, 57, 1, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1, 58, 1, 58, 1, 58, 1, 
+	58, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 60, 1, 60, 
+	1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 
+	61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 
+	1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 64, 1, 64, 1, 64, 1, 64, 1, 65, 1, 
+	65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 
+	1, 67, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 68, 1, 
+	69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 71, 
+	1, 71, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 1, 
+	73, 1, 73, 1, 73, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 
+	1, 75, 1, 75, 1, 75, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 77, 1, 
+	77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 78, 1, 78, 1, 78, 1, 79, 1, 79, 
+	1, 79, 1, 79, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 81, 1, 81, 1, 
+	81, 1, 81, 1, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1, 83, 1, 83, 1, 83, 1, 84, 
+	1, 84, 1, 84, 1, 84, 1, 85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 85, 1, 
+	85, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 87, 
+	1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 88, 1, 88, 1, 88, 1, 
+	88, 1, 88, 1, 88, 1, 88, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 89, 1, 90, 
+	1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 90, 1, 
+	90, 1, 91, 1, 91, 1, 91, 1, 92, 1, 92, 1, 92, 1, 92, 1, 92, 1, 93, 1, 93, 
+	1, 93, 1, 93, 1, 93, 1, 93, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 94, 1, 
+	94, 1, 94, 1, 94, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1, 96, 1, 96, 
+	1, 96, 1, 96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 97, 1, 97, 1, 97, 1, 97, 1, 
+	97, 1, 98, 1, 98, 1, 98, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 100, 1, 
+	100, 1, 100, 1, 100, 1, 100, 1, 100, 1, 101, 1, 101, 1, 101, 1, 101, 1, 
+	101, 1, 101, 1, 102, 1, 102, 1, 102, 1, 102, 1, 103, 1, 103, 1, 103, 1, 
+	103, 1, 104, 1, 104, 1, 104, 1, 104, 1, 105, 1, 105, 1, 105, 1, 105, 1, 
+	105, 1, 105, 1, 106, 1, 106, 1, 106, 1, 106, 1, 106, 1, 107, 1, 107, 1, 
+	107, 1, 107, 1, 107, 1, 107, 1, 108, 1, 108, 1, 108, 1, 108, 1, 109, 1, 
+	109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 109, 1, 110, 1, 110, 1, 110, 1, 
+	110, 1, 110, 1, 110, 1, 110, 1, 110, 1, 110, 1, 111, 1, 111, 1, 111, 1, 
+	111, 1, 111, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 112, 1, 
+	112, 1, 112, 1, 112, 1, 112, 1, 113, 1, 113, 1, 113, 1, 113, 1, 113, 1, 
+	113, 1, 113, 1, 113, 1, 113, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 
+	114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 114, 1, 115, 1, 115, 1, 115, 1, 
+	115, 1, 115, 1, 115, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 
+	116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 116, 1, 
+	117, 1, 117, 1, 117, 1, 117, 1, 118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 
+	118, 1, 118, 1, 118, 1, 118, 1, 118, 1, 119, 1, 119, 1, 119, 1, 119, 1, 
+	119, 1, 119, 1, 119, 1, 119, 1, 119, 1, 119, 1, 120, 1, 120, 1, 120, 1, 
+	120, 1, 120, 1, 120, 1, 120, 1, 120, 1, 120, 1, 120, 1, 121, 1, 121, 1, 
+	122, 1, 122, 1, 123, 1, 123, 1, 124, 1, 124, 1, 125, 1, 125, 1, 126, 1, 
+	126, 1, 127, 1, 127, 1, 128, 1, 128, 1, 129, 1, 129, 1, 130, 1, 130, 1, 
+	131, 1, 131, 1, 131, 1, 132, 1, 132, 1, 132, 1, 133, 1, 133, 1, 134, 1, 
+	134, 1, 134, 1, 135, 1, 135, 1, 136, 1, 136, 1, 136, 1, 137, 1, 137, 1, 
+	137, 1, 138, 1, 138, 1, 138, 1, 139, 1, 139, 1, 140, 1, 140, 1, 141, 1, 
+	141, 1, 142, 1, 142, 1, 143, 1, 143, 1, 144, 1, 144, 1, 145, 1, 145, 1, 
+	146, 1, 146, 1, 147, 1, 147, 1, 148, 1, 148, 1, 149, 1, 149, 1, 150, 1, 
+	150, 1, 151, 1, 151, 1, 152, 1, 152, 1, 153, 4, 153, 1225, 8, 153, 11, 
+	153, 12, 153, 1226, 1, 154, 4, 154, 1230, 8, 154, 11, 154, 12, 154, 1231, 
+	1, 154, 1, 154, 1, 154, 5, 154, 1237, 8, 154, 10, 154, 12, 154, 1240, 9, 
+	154, 1, 154, 1, 154, 4, 154, 1244, 8, 154, 11, 154, 12, 154, 1245, 3, 154, 
+	1248, 8, 154, 1, 155, 1, 155, 1, 156, 1, 156, 1, 157, 1, 157, 1, 157, 1, 
+	157, 5, 157, 1258, 8, 157, 10, 157, 12, 157, 1261, 9, 157, 1, 157, 1, 157, 
+	1, 157, 5, 157, 1266, 8, 157, 10, 157, 12, 157, 1269, 9, 157, 1, 157, 1, 
+	157, 1, 157, 1, 157, 1, 157, 4, 157, 1276, 8, 157, 11, 157, 12, 157, 1277, 
+	1, 157, 1, 157, 5, 157, 1282, 8, 157, 10, 157, 12, 157, 1285, 9, 157, 1, 
+	157, 1, 157, 1, 157, 5, 157, 1290, 8, 157, 10, 157, 12, 157, 1293, 9, 157, 
+	1, 157, 1, 157, 1, 157, 5, 157, 1298, 8, 157, 10, 157, 12, 157, 1301, 9, 
+	157, 1, 157, 3, 157, 1304, 8, 157, 1, 158, 1, 158, 1, 159, 1, 159, 1, 160, 
+	1, 160, 1, 161, 1, 161, 1, 162, 1, 162, 1, 163, 1, 163, 1, 164, 1, 164, 
+	1, 165, 1, 165, 1, 166, 1, 166, 1, 167, 1, 167, 1, 168, 1, 168, 1, 169, 
+	1, 169, 1, 170, 1, 170, 1, 171, 1, 171, 1, 172, 1, 172, 1, 173, 1, 173, 
+	1, 174, 1, 174, 1, 175, 1, 175, 1, 176, 1, 176, 1, 177, 1, 177, 1, 178, 
+	1, 178, 1, 179, 1, 179, 1, 180, 1, 180, 1, 181, 1, 181, 1, 182, 1, 182, 
+	1, 183, 1, 183, 4, 1267, 1283, 1291, 1299, 0, 184, 1, 1, 3, 2, 5, 3, 7, 
+	0, 9, 0, 11, 0, 13, 0, 15, 0, 17, 4, 19, 5, 21, 6, 23, 7, 25, 8, 27, 9, 
+	29, 10, 31, 11, 33, 12, 35, 13, 37, 14, 39, 15, 41, 16, 43, 17, 45, 18, 
+	47, 19, 49, 20, 51, 21, 53, 22, 55, 23, 57, 24, 59, 25, 61, 26, 63, 27, 
+	65, 28, 67, 29, 69, 30, 71, 31, 73, 32, 75, 33, 77, 34, 79, 35, 81, 36, 
+	83, 37, 85, 38, 87, 39, 89, 40, 91, 41, 93, 42, 95, 43, 97, 44, 99, 45, 
+	101, 46, 103, 47, 105, 48, 107, 49, 109, 50, 111, 51, 113, 52, 115, 53, 
+	117, 54, 119, 55, 121, 56, 123, 57, 125, 58, 127, 59, 129, 60, 131, 61, 
+	133, 62, 135, 63, 137, 64, 139, 65, 141, 66, 143, 67, 145, 68, 147, 69, 
+	149, 70, 151, 71, 153, 72, 155, 73, 157, 74, 159, 75, 161, 76, 163, 77, 
+	165, 78, 167, 79, 169, 80, 171, 81, 173, 82, 175, 83, 177, 84, 179, 85, 
+	181, 86, 183, 87, 185, 88, 187, 89, 189, 90, 191, 91, 193, 92, 195, 93, 
+	197, 94, 199, 95, 201, 96, 203, 97, 205, 98, 207, 99, 209, 100, 211, 101, 
+	213, 102, 215, 103, 217, 104, 219, 105, 221, 106, 223, 107, 225, 108, 227, 
+	109, 229, 110, 231, 111, 233, 112, 235, 113, 237, 114, 239, 115, 241, 116, 
+	243, 117, 245, 118, 247, 119, 249, 120, 251, 121, 253, 122, 255, 123, 257, 
+	124, 259, 125, 261, 126, 263, 127, 265, 128, 267, 129, 269, 130, 271, 131, 
+	273, 132, 275, 133, 277, 134, 279, 135, 281, 136, 283, 137, 285, 138, 287, 
+	139, 289, 140, 291, 141, 293, 142, 295, 143, 297, 144, 299, 145, 301, 146, 
+	303, 147, 305, 148, 307, 149, 309, 150, 311, 0, 313, 0, 315, 0, 317, 0, 
+	319, 0, 321, 0, 323, 0, 325, 0, 327, 0, 329, 0, 331, 0, 333, 0, 335, 0, 
+	337, 0, 339, 0, 341, 0, 343, 0, 345, 0, 347, 0, 349, 0, 351, 0, 353, 0, 
+	355, 0, 357, 0, 359, 0, 361, 0, 363, 0, 365, 0, 367, 0, 1, 0, 37, 8, 0, 
+	34, 34, 47, 47, 92, 92, 98, 98, 102, 102, 110, 110, 114, 114, 116, 116, 
+	3, 0, 48, 57, 65, 70, 97, 102, 3, 0, 0, 31, 34, 34, 92, 92, 2, 0, 69, 69, 
+	101, 101, 2, 0, 43, 43, 45, 45, 3, 0, 9, 10, 13, 13, 32, 32, 1, 0, 46, 
+	46, 1, 0, 48, 57, 2, 0, 65, 90, 97, 122, 2, 0, 46, 46, 95, 95, 3, 0, 35, 
+	36, 64, 64, 95, 95, 4, 0, 35, 36, 58, 58, 64, 64, 95, 95, 2, 0, 65, 65, 
+	97, 97, 2, 0, 66, 66, 98, 98, 2, 0, 67, 67, 99, 99, 2, 0, 68, 68, 100, 
+	100, 2, 0, 70, 70, 102, 102, 2, 0, 71, 71, 103, 103, 2, 0, 72, 72, 104, 
+	104, 2, 0, 73, 73, 105, 105, 2, 0, 74, 74, 106, 106, 2, 0, 75, 75, 107, 
+	107, 2, 0, 76, 76, 108, 108, 2, 0, 77, 77, 109, 109, 2, 0, 78, 78, 110, 
+	110, 2, 0, 79, 79, 111, 111, 2, 0, 80, 80, 112, 112, 2, 0, 81, 81, 113, 
+	113, 2, 0, 82, 82, 114, 114, 2, 0, 83, 83, 115, 115, 2, 0, 84, 84, 116, 
+	116, 2, 0, 85, 85, 117, 117, 2, 0, 86, 86, 118, 118, 2, 0, 87, 87, 119, 
+	119, 2, 0, 88, 88, 120, 120, 2, 0, 89, 89, 121, 121, 2, 0, 90, 90, 122, 
+	122, 1347, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0, 0, 5, 1, 0, 0, 0, 0, 17, 
+	1, 0, 0, 0, 0, 19, 1, 0, 0, 0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 
+	25, 1, 0, 0, 0, 0, 27, 1, 0, 0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 
+	0, 33, 1, 0, 0, 0, 0, 35, 1, 0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 
+	0, 0, 41, 1, 0, 0, 0, 0, 43, 1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 
+	0, 0, 0, 49, 1, 0, 0, 0, 0, 51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 
+	0, 0, 0, 0, 57, 1, 0, 0, 0, 0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 
+	1, 0, 0, 0, 0, 65, 1, 0, 0, 0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 
+	71, 1, 0, 0, 0, 0, 73, 1, 0, 0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 
+	0, 79, 1, 0, 0, 0, 0, 81, 1, 0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 
+	0, 0, 87, 1, 0, 0, 0, 0, 89, 1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 
+	0, 0, 0, 95, 1, 0, 0, 0, 0, 97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 
+	1, 0, 0, 0, 0, 103, 1, 0, 0, 0, 0, 105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 
+	0, 109, 1, 0, 0, 0, 0, 111, 1, 0, 0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 
+	0, 0, 0, 0, 117, 1, 0, 0, 0, 0, 119, 1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 
+	123, 1, 0, 0, 0, 0, 125, 1, 0, 0, 0, 0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 
+	0, 0, 0, 131, 1, 0, 0, 0, 0, 133, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 
+	1, 0, 0, 0, 0, 139, 1, 0, 0, 0, 0, 141, 1, 0, 0, 0, 0, 143, 1, 0, 0, 0, 
+	0, 145, 1, 0, 0, 0, 0, 147, 1, 0, 0, 0, 0, 149, 1, 0, 0, 0, 0, 151, 1, 
+	0, 0, 0, 0, 153, 1, 0, 0, 0, 0, 155, 1, 0, 0, 0, 0, 157, 1, 0, 0, 0, 0, 
+	159, 1, 0, 0, 0, 0, 161, 1, 0, 0, 0, 0, 163, 1, 0, 0, 0, 0, 165, 1, 0, 
+	0, 0, 0, 167, 1, 0, 0, 0, 0, 169, 1, 0, 0, 0, 0, 171, 1, 0, 0, 0, 0, 173, 
+	1, 0, 0, 0, 0, 175, 1, 0, 0, 0, 0, 177, 1, 0, 0, 0, 0, 179, 1, 0, 0, 0, 
+	0, 181, 1, 0, 0, 0, 0, 183, 1, 0, 0, 0, 0, 185, 1, 0, 0, 0, 0, 187, 1, 
+	0, 0, 0, 0, 189, 1, 0, 0, 0, 0, 191, 1, 0, 0, 0, 0, 193, 1, 0, 0, 0, 0, 
+	195, 1, 0, 0, 0, 0, 197, 1, 0, 0, 0, 0, 199, 1, 0, 0, 0, 0, 201, 1, 0, 
+	0, 0, 0, 203, 1, 0, 0, 0, 0, 205, 1, 0, 0, 0, 0, 207, 1, 0, 0, 0, 0, 209, 
+	1, 0, 0, 0, 0, 211, 1, 0, 0, 0, 0, 213, 1, 0, 0, 0, 0, 215, 1, 0, 0, 0, 
+	0, 217, 1, 0, 0, 0, 0, 219, 1, 0, 0, 0, 0, 221, 1, 0, 0, 0, 0, 223, 1, 
+	0, 0, 0, 0, 225, 1, 0, 0, 0, 0, 227, 1, 0, 0, 0, 0, 229, 1, 0, 0, 0, 0, 
+	231, 1, 0, 0, 0, 0, 233, 1, 0, 0, 0, 0, 235, 1, 0, 0, 0, 0, 237, 1, 0, 
+	0, 0, 0, 239, 1, 0, 0, 0, 0, 241, 1, 0, 0, 0, 0, 243, 1, 0, 0, 0, 0, 245, 
+	1, 0, 0, 0, 0, 247, 1, 0, 0, 0, 0, 249, 1, 0, 0, 0, 0, 251, 1, 0, 0, 0, 
+	0, 253, 1, 0, 0, 0, 0, 255, 1, 0, 0, 0, 0, 257, 1, 0, 0, 0, 0, 259, 1, 
+	0, 0, 0, 0, 261, 1, 0, 0, 0, 0, 263, 1, 0, 0, 0, 0, 265, 1, 0, 0, 0, 0, 
+	267, 1, 0, 0, 0, 0, 269, 1, 0, 0, 0, 0, 271, 1, 0, 0, 0, 0, 273, 1, 0, 
+	0, 0, 0, 275, 1, 0, 0, 0, 0, 277, 1, 0, 0, 0, 0, 279, 1, 0, 0, 0, 0, 281, 
+	1, 0, 0, 0, 0, 283, 1, 0, 0, 0, 0, 285, 1, 0, 0, 0, 0, 287, 1, 0, 0, 0, 
+	0, 289, 1, 0, 0, 0, 0, 291, 1, 0, 0, 0, 0, 293, 1, 0, 0, 0, 0, 295, 1, 
+	0, 0, 0, 0, 297, 1, 0, 0, 0, 0, 299, 1, 0, 0, 0, 0, 301, 1, 0, 0, 0, 0, 
+	303, 1, 0, 0, 0, 0, 305, 1, 0, 0, 0, 0, 307, 1, 0, 0, 0, 0, 309, 1, 0, 
+	0, 0, 1, 369, 1, 0, 0, 0, 3, 374, 1, 0, 0, 0, 5, 380, 1, 0, 0, 0, 7, 390, 
+	1, 0, 0, 0, 9, 395, 1, 0, 0, 0, 11, 401, 1, 0, 0, 0, 13, 403, 1, 0, 0, 
+	0, 15, 405, 1, 0, 0, 0, 17, 412, 1, 0, 0, 0, 19, 418, 1, 0, 0, 0, 21, 425, 
+	1, 0, 0, 0, 23, 432, 1, 0, 0, 0, 25, 436, 1, 0, 0, 0, 27, 441, 1, 0, 0, 
+	0, 29, 447, 1, 0, 0, 0, 31, 454, 1, 0, 0, 0, 33, 463, 1, 0, 0, 0, 35, 468, 
+	1, 0, 0, 0, 37, 474, 1, 0, 0, 0, 39, 486, 1, 0, 0, 0, 41, 490, 1, 0, 0, 
+	0, 43, 498, 1, 0, 0, 0, 45, 506, 1, 0, 0, 0, 47, 516, 1, 0, 0, 0, 49, 521, 
+	1, 0, 0, 0, 51, 524, 1, 0, 0, 0, 53, 529, 1, 0, 0, 0, 55, 533, 1, 0, 0, 
+	0, 57, 544, 1, 0, 0, 0, 59, 558, 1, 0, 0, 0, 61, 565, 1, 0, 0, 0, 63, 574, 
+	1, 0, 0, 0, 65, 580, 1, 0, 0, 0, 67, 585, 1, 0, 0, 0, 69, 594, 1, 0, 0, 
+	0, 71, 602, 1, 0, 0, 0, 73, 609, 1, 0, 0, 0, 75, 615, 1, 0, 0, 0, 77, 623, 
+	1, 0, 0, 0, 79, 632, 1, 0, 0, 0, 81, 642, 1, 0, 0, 0, 83, 652, 1, 0, 0, 
+	0, 85, 663, 1, 0, 0, 0, 87, 668, 1, 0, 0, 0, 89, 676, 1, 0, 0, 0, 91, 683, 
+	1, 0, 0, 0, 93, 689, 1, 0, 0, 0, 95, 696, 1, 0, 0, 0, 97, 700, 1, 0, 0, 
+	0, 99, 705, 1, 0, 0, 0, 101, 710, 1, 0, 0, 0, 103, 714, 1, 0, 0, 0, 105, 
+	719, 1, 0, 0, 0, 107, 726, 1, 0, 0, 0, 109, 732, 1, 0, 0, 0, 111, 737, 
+	1, 0, 0, 0, 113, 743, 1, 0, 0, 0, 115, 749, 1, 0, 0, 0, 117, 756, 1, 0, 
+	0, 0, 119, 764, 1, 0, 0, 0, 121, 770, 1, 0, 0, 0, 123, 778, 1, 0, 0, 0, 
+	125, 788, 1, 0, 0, 0, 127, 795, 1, 0, 0, 0, 129, 798, 1, 0, 0, 0, 131, 
+	802, 1, 0, 0, 0, 133, 805, 1, 0, 0, 0, 135, 810, 1, 0, 0, 0, 137, 815, 
+	1, 0, 0, 0, 139, 824, 1, 0, 0, 0, 141, 830, 1, 0, 0, 0, 143, 834, 1, 0, 
+	0, 0, 145, 839, 1, 0, 0, 0, 147, 844, 1, 0, 0, 0, 149, 848, 1, 0, 0, 0, 
+	151, 856, 1, 0, 0, 0, 153, 859, 1, 0, 0, 0, 155, 865, 1, 0, 0, 0, 157, 
+	872, 1, 0, 0, 0, 159, 875, 1, 0, 0, 0, 161, 879, 1, 0, 0, 0, 163, 885, 
+	1, 0, 0, 0, 165, 890, 1, 0, 0, 0, 167, 894, 1, 0, 0, 0, 169, 897, 1, 0, 
+	0, 0, 171, 901, 1, 0, 0, 0, 173, 909, 1, 0, 0, 0, 175, 918, 1, 0, 0, 0, 
+	177, 926, 1, 0, 0, 0, 179, 933, 1, 0, 0, 0, 181, 939, 1, 0, 0, 0, 183, 
+	951, 1, 0, 0, 0, 185, 954, 1, 0, 0, 0, 187, 959, 1, 0, 0, 0, 189, 965, 
+	1, 0, 0, 0, 191, 974, 1, 0, 0, 0, 193, 980, 1, 0, 0, 0, 195, 986, 1, 0, 
+	0, 0, 197, 993, 1, 0, 0, 0, 199, 996, 1, 0, 0, 0, 201, 1001, 1, 0, 0, 0, 
+	203, 1007, 1, 0, 0, 0, 205, 1013, 1, 0, 0, 0, 207, 1017, 1, 0, 0, 0, 209, 
+	1021, 1, 0, 0, 0, 211, 1025, 1, 0, 0, 0, 213, 1031, 1, 0, 0, 0, 215, 1036, 
+	1, 0, 0, 0, 217, 1042, 1, 0, 0, 0, 219, 1046, 1, 0, 0, 0, 221, 1053, 1, 
+	0, 0, 0, 223, 1062, 1, 0, 0, 0, 225, 1067, 1, 0, 0, 0, 227, 1078, 1, 0, 
+	0, 0, 229, 1087, 1, 0, 0, 0, 231, 1098, 1, 0, 0, 0, 233, 1104, 1, 0, 0, 
+	0, 235, 1119, 1, 0, 0, 0, 237, 1123, 1, 0, 0, 0, 239, 1133, 1, 0, 0, 0, 
+	241, 1143, 1, 0, 0, 0, 243, 1153, 1, 0, 0, 0, 245, 1155, 1, 0, 0, 0, 247, 
+	1157, 1, 0, 0, 0, 249, 1159, 1, 0, 0, 0, 251, 1161, 1, 0, 0, 0, 253, 1163, 
+	1, 0, 0, 0, 255, 1165, 1, 0, 0, 0, 257, 1167, 1, 0, 0, 0, 259, 1169, 1, 
+	0, 0, 0, 261, 1171, 1, 0, 0, 0, 263, 1173, 1, 0, 0, 0, 265, 1176, 1, 0, 
+	0, 0, 267, 1179, 1, 0, 0, 0, 269, 1181, 1, 0, 0, 0, 271, 1184, 1, 0, 0, 
+	0, 273, 1186, 1, 0, 0, 0, 275, 1189, 1, 0, 0, 0, 277, 1192, 1, 0, 0, 0, 
+	279, 1195, 1, 0, 0, 0, 281, 1197, 1, 0, 0, 0, 283, 1199, 1, 0, 0, 0, 285, 
+	1201, 1, 0, 0, 0, 287, 1203, 1, 0, 0, 0, 289, 1205, 1, 0, 0, 0, 291, 1207, 
+	1, 0, 0, 0, 293, 1209, 1, 0, 0, 0, 295, 1211, 1, 0, 0, 0, 297, 1213, 1, 
+	0, 0, 0, 299, 1215, 1, 0, 0, 0, 301, 1217, 1, 0, 0, 0, 303, 1219, 1, 0, 
+	0, 0, 305, 1221, 1, 0, 0, 0, 307, 1224, 1, 0, 0, 0, 309, 1247, 1, 0, 0, 
+	0, 311, 1249, 1, 0, 0, 0, 313, 1251, 1, 0, 0, 0, 315, 1303, 1, 0, 0, 0, 
+	317, 1305, 1, 0, 0, 0, 319, 1307, 1, 0, 0, 0, 321, 1309, 1, 0, 0, 0, 323, 
+	1311, 1, 0, 0, 0, 325, 1313, 1, 0, 0, 0, 327, 1315, 1, 0, 0, 0, 329, 1317, 
+	1, 0, 0, 0, 331, 1319, 1, 0, 0, 0, 333, 1321, 1, 0, 0, 0, 335, 1323, 1, 
+	0, 0, 0, 337, 1325, 1, 0, 0, 0, 339, 1327, 1, 0, 0, 0, 341, 1329, 1, 0, 
+	0, 0, 343, 1331, 1, 0, 0, 0, 345, 1333, 1, 0, 0, 0, 347, 1335, 1, 0, 0, 
+	0, 349, 1337, 1, 0, 0, 0, 351, 1339, 1, 0, 0, 0, 353, 1341, 1, 0, 0, 0, 
+	355, 1343, 1, 0, 0, 0, 357, 1345, 1, 0, 0, 0, 359, 1347, 1, 0, 0, 0, 361, 
+	1349, 1, 0, 0, 0, 363, 1351, 1, 0, 0, 0, 365, 1353, 1, 0, 0, 0, 367, 1355, 
+	1, 0, 0, 0, 369, 370, 5, 116, 0, 0, 370, 371, 5, 114, 0, 0, 371, 372, 5, 
+	117, 0, 0, 372, 373, 5, 101, 0, 0, 373, 2, 1, 0, 0, 0, 374, 375, 5, 102, 
+	0, 0, 375, 376, 5, 97, 0, 0, 376, 377, 5, 108, 0, 0, 377, 378, 5, 115, 
+	0, 0, 378, 379, 5, 101, 0, 0, 379, 4, 1, 0, 0, 0, 380, 385, 5, 34, 0, 0, 
+	381, 384, 3, 7, 3, 0, 382, 384, 3, 13, 6, 0, 383, 381, 1, 0, 0, 0, 383, 
+	382, 1, 0, 0, 0, 384, 387, 1, 0, 0, 0, 385, 383, 1, 0, 0, 0, 385, 386, 
+	1, 0, 0, 0, 386, 388, 1, 0, 0, 0, 387, 385, 1, 0, 0, 0, 388, 389, 5, 34, 
+	0, 0, 389, 6, 1, 0, 0, 0, 390, 393, 5, 92, 0, 0, 391, 394, 7, 0, 0, 0, 
+	392, 394, 3, 9, 4, 0, 393, 391, 1, 0, 0, 0, 393, 392, 1, 0, 0, 0, 394, 
+	8, 1, 0, 0, 0, 395, 396, 5, 117, 0, 0, 396, 397, 3, 11, 5, 0, 397, 398, 
+	3, 11, 5, 0, 398, 399, 3, 11, 5, 0, 399, 400, 3, 11, 5, 0, 400, 10, 1, 
+	0, 0, 0, 401, 402, 7, 1, 0, 0, 402, 12, 1, 0, 0, 0, 403, 404, 8, 2, 0, 
+	0, 404, 14, 1, 0, 0, 0, 405, 407, 7, 3, 0, 0, 406, 408, 7, 4, 0, 0, 407, 
+	406, 1, 0, 0, 0, 407, 408, 1, 0, 0, 0, 408, 409, 1, 0, 0, 0, 409, 410, 
+	3, 307, 153, 0, 410, 16, 1, 0, 0, 0, 411, 413, 7, 5, 0, 0, 412, 411, 1, 
+	0, 0, 0, 413, 414, 1, 0, 0, 0, 414, 412, 1, 0, 0, 0, 414, 415, 1, 0, 0, 
+	0, 415, 416, 1, 0, 0, 0, 416, 417, 6, 8, 0, 0, 417, 18, 1, 0, 0, 0, 418, 
+	419, 3, 321, 160, 0, 419, 420, 3, 351, 175, 0, 420, 421, 3, 325, 162, 0, 
+	421, 422, 3, 317, 158, 0, 422, 423, 3, 355, 177, 0, 423, 424, 3, 325, 162, 
+	0, 424, 20, 1, 0, 0, 0, 425, 426, 3, 357, 178, 0, 426, 427, 3, 347, 173, 
+	0, 427, 428, 3, 323, 161, 0, 428, 429, 3, 317, 158, 0, 429, 430, 3, 355, 
+	177, 0, 430, 431, 3, 325, 162, 0, 431, 22, 1, 0, 0, 0, 432, 433, 3, 353, 
+	176, 0, 433, 434, 3, 325, 162, 0, 434, 435, 3, 355, 177, 0, 435, 24, 1, 
+	0, 0, 0, 436, 437, 3, 323, 161, 0, 437, 438, 3, 351, 175, 0, 438, 439, 
+	3, 345, 172, 0, 439, 440, 3, 347, 173, 0, 440, 26, 1, 0, 0, 0, 441, 442, 
+	3, 317, 158, 0, 442, 443, 3, 339, 169, 0, 443, 444, 3, 355, 177, 0, 444, 
+	445, 3, 325, 162, 0, 445, 446, 3, 351, 175, 0, 446, 28, 1, 0, 0, 0, 447, 
+	448, 3, 323, 161, 0, 448, 449, 3, 325, 162, 0, 449, 450, 3, 339, 169, 0, 
+	450, 451, 3, 325, 162, 0, 451, 452, 3, 355, 177, 0, 452, 453, 3, 325, 162, 
+	0, 453, 30, 1, 0, 0, 0, 454, 455, 3, 333, 166, 0, 455, 456, 3, 343, 171, 
+	0, 456, 457, 3, 355, 177, 0, 457, 458, 3, 325, 162, 0, 458, 459, 3, 351, 
+	175, 0, 459, 460, 3, 359, 179, 0, 460, 461, 3, 317, 158, 0, 461, 462, 3, 
+	339, 169, 0, 462, 32, 1, 0, 0, 0, 463, 464, 3, 343, 171, 0, 464, 465, 3, 
+	317, 158, 0, 465, 466, 3, 341, 170, 0, 466, 467, 3, 325, 162, 0, 467, 34, 
+	1, 0, 0, 0, 468, 469, 3, 353, 176, 0, 469, 470, 3, 331, 165, 0, 470, 471, 
+	3, 317, 158, 0, 471, 472, 3, 351, 175, 0, 472, 473, 3, 323, 161, 0, 473, 
+	36, 1, 0, 0, 0, 474, 475, 3, 351, 175, 0, 475, 476, 3, 325, 162, 0, 476, 
+	477, 3, 347, 173, 0, 477, 478, 3, 339, 169, 0, 478, 479, 3, 333, 166, 0, 
+	479, 480, 3, 321, 160, 0, 480, 481, 3, 317, 158, 0, 481, 482, 3, 355, 177, 
+	0, 482, 483, 3, 333, 166, 0, 483, 484, 3, 345, 172, 0, 484, 485, 3, 343, 
+	171, 0, 485, 38, 1, 0, 0, 0, 486, 487, 3, 355, 177, 0, 487, 488, 3, 355, 
+	177, 0, 488, 489, 3, 339, 169, 0, 489, 40, 1, 0, 0, 0, 490, 491, 3, 341, 
+	170, 0, 491, 492, 3, 325, 162, 0, 492, 493, 3, 355, 177, 0, 493, 494, 3, 
+	317, 158, 0, 494, 495, 3, 355, 177, 0, 495, 496, 3, 355, 177, 0, 496, 497, 
+	3, 339, 169, 0, 497, 42, 1, 0, 0, 0, 498, 499, 3, 347, 173, 0, 499, 500, 
+	3, 317, 158, 0, 500, 501, 3, 353, 176, 0, 501, 502, 3, 355, 177, 0, 502, 
+	503, 3, 355, 177, 0, 503, 504, 3, 355, 177, 0, 504, 505, 3, 339, 169, 0, 
+	505, 44, 1, 0, 0, 0, 506, 507, 3, 327, 163, 0, 507, 508, 3, 357, 178, 0, 
+	508, 509, 3, 355, 177, 0, 509, 510, 3, 357, 178, 0, 510, 511, 3, 351, 175, 
+	0, 511, 512, 3, 325, 162, 0, 512, 513, 3, 355, 177, 0, 513, 514, 3, 355, 
+	177, 0, 514, 515, 3, 339, 169, 0, 515, 46, 1, 0, 0, 0, 516, 517, 3, 337, 
+	168, 0, 517, 518, 3, 333, 166, 0, 518, 519, 3, 339, 169, 0, 519, 520, 3, 
+	339, 169, 0, 520, 48, 1, 0, 0, 0, 521, 522, 3, 345, 172, 0, 522, 523, 3, 
+	343, 171, 0, 523, 50, 1, 0, 0, 0, 524, 525, 3, 353, 176, 0, 525, 526, 3, 
+	331, 165, 0, 526, 527, 3, 345, 172, 0, 527, 528, 3, 361, 180, 0, 528, 52, 
+	1, 0, 0, 0, 529, 530, 3, 357, 178, 0, 530, 531, 3, 353, 176, 0, 531, 532, 
+	3, 325, 162, 0, 532, 54, 1, 0, 0, 0, 533, 534, 3, 353, 176, 0, 534, 535, 
+	3, 355, 177, 0, 535, 536, 3, 317, 158, 0, 536, 537, 3, 355, 177, 0, 537, 
+	538, 3, 325, 162, 0, 538, 539, 3, 303, 151, 0, 539, 540, 3, 351, 175, 0, 
+	540, 541, 3, 325, 162, 0, 541, 542, 3, 347, 173, 0, 542, 543, 3, 345, 172, 
+	0, 543, 56, 1, 0, 0, 0, 544, 545, 3, 353, 176, 0, 545, 546, 3, 355, 177, 
+	0, 546, 547, 3, 317, 158, 0, 547, 548, 3, 355, 177, 0, 548, 549, 3, 325, 
+	162, 0, 549, 550, 3, 303, 151, 0, 550, 551, 3, 341, 170, 0, 551, 552, 3, 
+	317, 158, 0, 552, 553, 3, 321, 160, 0, 553, 554, 3, 331, 165, 0, 554, 555, 
+	3, 333, 166, 0, 555, 556, 3, 343, 171, 0, 556, 557, 3, 325, 162, 0, 557, 
+	58, 1, 0, 0, 0, 558, 559, 3, 341, 170, 0, 559, 560, 3, 317, 158, 0, 560, 
+	561, 3, 353, 176, 0, 561, 562, 3, 355, 177, 0, 562, 563, 3, 325, 162, 0, 
+	563, 564, 3, 351, 175, 0, 564, 60, 1, 0, 0, 0, 565, 566, 3, 341, 170, 0, 
+	566, 567, 3, 325, 162, 0, 567, 568, 3, 355, 177, 0, 568, 569, 3, 317, 158, 
+	0, 569, 570, 3, 323, 161, 0, 570, 571, 3, 317, 158, 0, 571, 572, 3, 355, 
+	177, 0, 572, 573, 3, 317, 158, 0, 573, 62, 1, 0, 0, 0, 574, 575, 3, 355, 
+	177, 0, 575, 576, 3, 365, 182, 0, 576, 577, 3, 347, 173, 0, 577, 578, 3, 
+	325, 162, 0, 578, 579, 3, 353, 176, 0, 579, 64, 1, 0, 0, 0, 580, 581, 3, 
+	355, 177, 0, 581, 582, 3, 365, 182, 0, 582, 583, 3, 347, 173, 0, 583, 584, 
+	3, 325, 162, 0, 584, 66, 1, 0, 0, 0, 585, 586, 3, 353, 176, 0, 586, 587, 
+	3, 355, 177, 0, 587, 588, 3, 345, 172, 0, 588, 589, 3, 351, 175, 0, 589, 
+	590, 3, 317, 158, 0, 590, 591, 3, 329, 164, 0, 591, 592, 3, 325, 162, 0, 
+	592, 593, 3, 353, 176, 0, 593, 68, 1, 0, 0, 0, 594, 595, 3, 353, 176, 0, 
+	595, 596, 3, 355, 177, 0, 596, 597, 3, 345, 172, 0, 597, 598, 3, 351, 175, 
+	0, 598, 599, 3, 317, 158, 0, 599, 600, 3, 329, 164, 0, 600, 601, 3, 325, 
+	162, 0, 601, 70, 1, 0, 0, 0, 602, 603, 3, 319, 159, 0, 603, 604, 3, 351, 
+	175, 0, 604, 605, 3, 345, 172, 0, 605, 606, 3, 337, 168, 0, 606, 607, 3, 
+	325, 162, 0, 607, 608, 3, 351, 175, 0, 608, 72, 1, 0, 0, 0, 609, 610, 3, 
+	317, 158, 0, 610, 611, 3, 339, 169, 0, 611, 612, 3, 333, 166, 0, 612, 613, 
+	3, 359, 179, 0, 613, 614, 3, 325, 162, 0, 614, 74, 1, 0, 0, 0, 615, 616, 
+	3, 353, 176, 0, 616, 617, 3, 321, 160, 0, 617, 618, 3, 331, 165, 0, 618, 
+	619, 3, 325, 162, 0, 619, 620, 3, 341, 170, 0, 620, 621, 3, 317, 158, 0, 
+	621, 622, 3, 353, 176, 0, 622, 76, 1, 0, 0, 0, 623, 624, 3, 323, 161, 0, 
+	624, 625, 3, 317, 158, 0, 625, 626, 3, 355, 177, 0, 626, 627, 3, 317, 158, 
+	0, 627, 628, 3, 319, 159, 0, 628, 629, 3, 317, 158, 0, 629, 630, 3, 353, 
+	176, 0, 630, 631, 3, 325, 162, 0, 631, 78, 1, 0, 0, 0, 632, 633, 3, 323, 
+	161, 0, 633, 634, 3, 317, 158, 0, 634, 635, 3, 355, 177, 0, 635, 636, 3, 
+	317, 158, 0, 636, 637, 3, 319, 159, 0, 637, 638, 3, 317, 158, 0, 638, 639, 
+	3, 353, 176, 0, 639, 640, 3, 325, 162, 0, 640, 641, 3, 353, 176, 0, 641, 
+	80, 1, 0, 0, 0, 642, 643, 3, 343, 171, 0, 643, 644, 3, 317, 158, 0, 644, 
+	645, 3, 341, 170, 0, 645, 646, 3, 325, 162, 0, 646, 647, 3, 353, 176, 0, 
+	647, 648, 3, 347, 173, 0, 648, 649, 3, 317, 158, 0, 649, 650, 3, 321, 160, 
+	0, 650, 651, 3, 325, 162, 0, 651, 82, 1, 0, 0, 0, 652, 653, 3, 343, 171, 
+	0, 653, 654, 3, 317, 158, 0, 654, 655, 3, 341, 170, 0, 655, 656, 3, 325, 
+	162, 0, 656, 657, 3, 353, 176, 0, 657, 658, 3, 347, 173, 0, 658, 659, 3, 
+	317, 158, 0, 659, 660, 3, 321, 160, 0, 660, 661, 3, 325, 162, 0, 661, 662, 
+	3, 353, 176, 0, 662, 84, 1, 0, 0, 0, 663, 664, 3, 343, 171, 0, 664, 665, 
+	3, 345, 172, 0, 665, 666, 3, 323, 161, 0, 666, 667, 3, 325, 162, 0, 667, 
+	86, 1, 0, 0, 0, 668, 669, 3, 341, 170, 0, 669, 670, 3, 325, 162, 0, 670, 
+	671, 3, 355, 177, 0, 671, 672, 3, 351, 175, 0, 672, 673, 3, 333, 166, 0, 
+	673, 674, 3, 321, 160, 0, 674, 675, 3, 353, 176, 0, 675, 88, 1, 0, 0, 0, 
+	676, 677, 3, 341, 170, 0, 677, 678, 3, 325, 162, 0, 678, 679, 3, 355, 177, 
+	0, 679, 680, 3, 351, 175, 0, 680, 681, 3, 333, 166, 0, 681, 682, 3, 321, 
+	160, 0, 682, 90, 1, 0, 0, 0, 683, 684, 3, 327, 163, 0, 684, 685, 3, 333, 
+	166, 0, 685, 686, 3, 325, 162, 0, 686, 687, 3, 339, 169, 0, 687, 688, 3, 
+	323, 161, 0, 688, 92, 1, 0, 0, 0, 689, 690, 3, 327, 163, 0, 690, 691, 3, 
+	333, 166, 0, 691, 692, 3, 325, 162, 0, 692, 693, 3, 339, 169, 0, 693, 694, 
+	3, 323, 161, 0, 694, 695, 3, 353, 176, 0, 695, 94, 1, 0, 0, 0, 696, 697, 
+	3, 355, 177, 0, 697, 698, 3, 317, 158, 0, 698, 699, 3, 329, 164, 0, 699, 
+	96, 1, 0, 0, 0, 700, 701, 3, 333, 166, 0, 701, 702, 3, 343, 171, 0, 702, 
+	703, 3, 327, 163, 0, 703, 704, 3, 345, 172, 0, 704, 98, 1, 0, 0, 0, 705, 
+	706, 3, 337, 168, 0, 706, 707, 3, 325, 162, 0, 707, 708, 3, 365, 182, 0, 
+	708, 709, 3, 353, 176, 0, 709, 100, 1, 0, 0, 0, 710, 711, 3, 337, 168, 
+	0, 711, 712, 3, 325, 162, 0, 712, 713, 3, 365, 182, 0, 713, 102, 1, 0, 
+	0, 0, 714, 715, 3, 361, 180, 0, 715, 716, 3, 333, 166, 0, 716, 717, 3, 
+	355, 177, 0, 717, 718, 3, 331, 165, 0, 718, 104, 1, 0, 0, 0, 719, 720, 
+	3, 359, 179, 0, 720, 721, 3, 317, 158, 0, 721, 722, 3, 339, 169, 0, 722, 
+	723, 3, 357, 178, 0, 723, 724, 3, 325, 162, 0, 724, 725, 3, 353, 176, 0, 
+	725, 106, 1, 0, 0, 0, 726, 727, 3, 359, 179, 0, 727, 728, 3, 317, 158, 
+	0, 728, 729, 3, 339, 169, 0, 729, 730, 3, 357, 178, 0, 730, 731, 3, 325, 
+	162, 0, 731, 108, 1, 0, 0, 0, 732, 733, 3, 327, 163, 0, 733, 734, 3, 351, 
+	175, 0, 734, 735, 3, 345, 172, 0, 735, 736, 3, 341, 170, 0, 736, 110, 1, 
+	0, 0, 0, 737, 738, 3, 361, 180, 0, 738, 739, 3, 331, 165, 0, 739, 740, 
+	3, 325, 162, 0, 740, 741, 3, 351, 175, 0, 741, 742, 3, 325, 162, 0, 742, 
+	112, 1, 0, 0, 0, 743, 744, 3, 339, 169, 0, 744, 745, 3, 333, 166, 0, 745, 
+	746, 3, 341, 170, 0, 746, 747, 3, 333, 166, 0, 747, 748, 3, 355, 177, 0, 
+	748, 114, 1, 0, 0, 0, 749, 750, 3, 345, 172, 0, 750, 751, 3, 327, 163, 
+	0, 751, 752, 3, 327, 163, 0, 752, 753, 3, 353, 176, 0, 753, 754, 3, 325, 
+	162, 0, 754, 755, 3, 355, 177, 0, 755, 116, 1, 0, 0, 0, 756, 757, 3, 349, 
+	174, 0, 757, 758, 3, 357, 178, 0, 758, 759, 3, 325, 162, 0, 759, 760, 3, 
+	351, 175, 0, 760, 761, 3, 333, 166, 0, 761, 762, 3, 325, 162, 0, 762, 763, 
+	3, 353, 176, 0, 763, 118, 1, 0, 0, 0, 764, 765, 3, 349, 174, 0, 765, 766, 
+	3, 357, 178, 0, 766, 767, 3, 325, 162, 0, 767, 768, 3, 351, 175, 0, 768, 
+	769, 3, 365, 182, 0, 769, 120, 1, 0, 0, 0, 770, 771, 3, 325, 162, 0, 771, 
+	772, 3, 363, 181, 0, 772, 773, 3, 347, 173, 0, 773, 774, 3, 339, 169, 0, 
+	774, 775, 3, 317, 158, 0, 775, 776, 3, 333, 166, 0, 776, 777, 3, 343, 171, 
+	0, 777, 122, 1, 0, 0, 0, 778, 779, 3, 361, 180, 0, 779, 780, 3, 333, 166, 
+	0, 780, 781, 3, 355, 177, 0, 781, 782, 3, 331, 165, 0, 782, 783, 3, 359, 
+	179, 0, 783, 784, 3, 317, 158, 0, 784, 785, 3, 339, 169, 0, 785, 786, 3, 
+	357, 178, 0, 786, 787, 3, 325, 162, 0, 787, 124, 1, 0, 0, 0, 788, 789, 
+	3, 353, 176, 0, 789, 790, 3, 325, 162, 0, 790, 791, 3, 339, 169, 0, 791, 
+	792, 3, 325, 162, 0, 792, 793, 3, 321, 160, 0, 793, 794, 3, 355, 177, 0, 
+	794, 126, 1, 0, 0, 0, 795, 796, 3, 317, 158, 0, 796, 797, 3, 353, 176, 
+	0, 797, 128, 1, 0, 0, 0, 798, 799, 3, 317, 158, 0, 799, 800, 3, 343, 171, 
+	0, 800, 801, 3, 323, 161, 0, 801, 130, 1, 0, 0, 0, 802, 803, 3, 345, 172, 
+	0, 803, 804, 3, 351, 175, 0, 804, 132, 1, 0, 0, 0, 805, 806, 3, 327, 163, 
+	0, 806, 807, 3, 333, 166, 0, 807, 808, 3, 339, 169, 0, 808, 809, 3, 339, 
+	169, 0, 809, 134, 1, 0, 0, 0, 810, 811, 3, 343, 171, 0, 811, 812, 3, 357, 
+	178, 0, 812, 813, 3, 339, 169, 0, 813, 814, 3, 339, 169, 0, 814, 136, 1, 
+	0, 0, 0, 815, 816, 3, 347, 173, 0, 816, 817, 3, 351, 175, 0, 817, 818, 
+	3, 325, 162, 0, 818, 819, 3, 359, 179, 0, 819, 820, 3, 333, 166, 0, 820, 
+	821, 3, 345, 172, 0, 821, 822, 3, 357, 178, 0, 822, 823, 3, 353, 176, 0, 
+	823, 138, 1, 0, 0, 0, 824, 825, 3, 345, 172, 0, 825, 826, 3, 351, 175, 
+	0, 826, 827, 3, 323, 161, 0, 827, 828, 3, 325, 162, 0, 828, 829, 3, 351, 
+	175, 0, 829, 140, 1, 0, 0, 0, 830, 831, 3, 317, 158, 0, 831, 832, 3, 353, 
+	176, 0, 832, 833, 3, 321, 160, 0, 833, 142, 1, 0, 0, 0, 834, 835, 3, 323, 
+	161, 0, 835, 836, 3, 325, 162, 0, 836, 837, 3, 353, 176, 0, 837, 838, 3, 
+	321, 160, 0, 838, 144, 1, 0, 0, 0, 839, 840, 3, 339, 169, 0, 840, 841, 
+	3, 333, 166, 0, 841, 842, 3, 337, 168, 0, 842, 843, 3, 325, 162, 0, 843, 
+	146, 1, 0, 0, 0, 844, 845, 3, 343, 171, 0, 845, 846, 3, 345, 172, 0, 846, 
+	847, 3, 355, 177, 0, 847, 148, 1, 0, 0, 0, 848, 849, 3, 319, 159, 0, 849, 
+	850, 3, 325, 162, 0, 850, 851, 3, 355, 177, 0, 851, 852, 3, 361, 180, 0, 
+	852, 853, 3, 325, 162, 0, 853, 854, 3, 325, 162, 0, 854, 855, 3, 343, 171, 
+	0, 855, 150, 1, 0, 0, 0, 856, 857, 3, 333, 166, 0, 857, 858, 3, 353, 176, 
+	0, 858, 152, 1, 0, 0, 0, 859, 860, 3, 329, 164, 0, 860, 861, 3, 351, 175, 
+	0, 861, 862, 3, 345, 172, 0, 862, 863, 3, 357, 178, 0, 863, 864, 3, 347, 
+	173, 0, 864, 154, 1, 0, 0, 0, 865, 866, 3, 331, 165, 0, 866, 867, 3, 317, 
+	158, 0, 867, 868, 3, 359, 179, 0, 868, 869, 3, 333, 166, 0, 869, 870, 3, 
+	343, 171, 0, 870, 871, 3, 329, 164, 0, 871, 156, 1, 0, 0, 0, 872, 873, 
+	3, 319, 159, 0, 873, 874, 3, 365, 182, 0, 874, 158, 1, 0, 0, 0, 875, 876, 
+	3, 327, 163, 0, 876, 877, 3, 345, 172, 0, 877, 878, 3, 351, 175, 0, 878, 
+	160, 1, 0, 0, 0, 879, 880, 3, 353, 176, 0, 880, 881, 3, 355, 177, 0, 881, 
+	882, 3, 317, 158, 0, 882, 883, 3, 355, 177, 0, 883, 884, 3, 353, 176, 0, 
+	884, 162, 1, 0, 0, 0, 885, 886, 3, 355, 177, 0, 886, 887, 3, 333, 166, 
+	0, 887, 888, 3, 341, 170, 0, 888, 889, 3, 325, 162, 0, 889, 164, 1, 0, 
+	0, 0, 890, 891, 3, 343, 171, 0, 891, 892, 3, 345, 172, 0, 892, 893, 3, 
+	361, 180, 0, 893, 166, 1, 0, 0, 0, 894, 895, 3, 333, 166, 0, 895, 896, 
+	3, 343, 171, 0, 896, 168, 1, 0, 0, 0, 897, 898, 3, 339, 169, 0, 898, 899, 
+	3, 345, 172, 0, 899, 900, 3, 329, 164, 0, 900, 170, 1, 0, 0, 0, 901, 902, 
+	3, 347, 173, 0, 902, 903, 3, 351, 175, 0, 903, 904, 3, 345, 172, 0, 904, 
+	905, 3, 327, 163, 0, 905, 906, 3, 333, 166, 0, 906, 907, 3, 339, 169, 0, 
+	907, 908, 3, 325, 162, 0, 908, 172, 1, 0, 0, 0, 909, 910, 3, 351, 175, 
+	0, 910, 911, 3, 325, 162, 0, 911, 912, 3, 349, 174, 0, 912, 913, 3, 357, 
+	178, 0, 913, 914, 3, 325, 162, 0, 914, 915, 3, 353, 176, 0, 915, 916, 3, 
+	355, 177, 0, 916, 917, 3, 353, 176, 0, 917, 174, 1, 0, 0, 0, 918, 919, 
+	3, 351, 175, 0, 919, 920, 3, 325, 162, 0, 920, 921, 3, 349, 174, 0, 921, 
+	922, 3, 357, 178, 0, 922, 923, 3, 325, 162, 0, 923, 924, 3, 353, 176, 0, 
+	924, 925, 3, 355, 177, 0, 925, 176, 1, 0, 0, 0, 926, 927, 3, 353, 176, 
+	0, 927, 928, 3, 325, 162, 0, 928, 929, 3, 351, 175, 0, 929, 930, 3, 333, 
+	166, 0, 930, 931, 3, 325, 162, 0, 931, 932, 3, 353, 176, 0, 932, 178, 1, 
+	0, 0, 0, 933, 934, 3, 349, 174, 0, 934, 935, 3, 357, 178, 0, 935, 936, 
+	3, 345, 172, 0, 936, 937, 3, 355, 177, 0, 937, 938, 3, 317, 158, 0, 938, 
+	180, 1, 0, 0, 0, 939, 940, 3, 321, 160, 0, 940, 941, 3, 317, 158, 0, 941, 
+	942, 3, 351, 175, 0, 942, 943, 3, 323, 161, 0, 943, 944, 3, 333, 166, 0, 
+	944, 945, 3, 343, 171, 0, 945, 946, 3, 317, 158, 0, 946, 947, 3, 339, 169, 
+	0, 947, 948, 3, 333, 166, 0, 948, 949, 3, 355, 177, 0, 949, 950, 3, 365, 
+	182, 0, 950, 182, 1, 0, 0, 0, 951, 952, 3, 333, 166, 0, 952, 953, 3, 323, 
+	161, 0, 953, 184, 1, 0, 0, 0, 954, 955, 3, 357, 178, 0, 955, 956, 3, 353, 
+	176, 0, 956, 957, 3, 325, 162, 0, 957, 958, 3, 351, 175, 0, 958, 186, 1, 
+	0, 0, 0, 959, 960, 3, 357, 178, 0, 960, 961, 3, 353, 176, 0, 961, 962, 
+	3, 325, 162, 0, 962, 963, 3, 351, 175, 0, 963, 964, 3, 353, 176, 0, 964, 
+	188, 1, 0, 0, 0, 965, 966, 3, 347, 173, 0, 966, 967, 3, 317, 158, 0, 967, 
+	968, 3, 353, 176, 0, 968, 969, 3, 353, 176, 0, 969, 970, 3, 361, 180, 0, 
+	970, 971, 3, 345, 172, 0, 971, 972, 3, 351, 175, 0, 972, 973, 3, 323, 161, 
+	0, 973, 190, 1, 0, 0, 0, 974, 975, 3, 355, 177, 0, 975, 976, 3, 345, 172, 
+	0, 976, 977, 3, 337, 168, 0, 977, 978, 3, 325, 162, 0, 978, 979, 3, 343, 
+	171, 0, 979, 192, 1, 0, 0, 0, 980, 981, 3, 329, 164, 0, 981, 982, 3, 351, 
+	175, 0, 982, 983, 3, 317, 158, 0, 983, 984, 3, 343, 171, 0, 984, 985, 3, 
+	355, 177, 0, 985, 194, 1, 0, 0, 0, 986, 987, 3, 351, 175, 0, 987, 988, 
+	3, 325, 162, 0, 988, 989, 3, 359, 179, 0, 989, 990, 3, 345, 172, 0, 990, 
+	991, 3, 337, 168, 0, 991, 992, 3, 325, 162, 0, 992, 196, 1, 0, 0, 0, 993, 
+	994, 3, 355, 177, 0, 994, 995, 3, 345, 172, 0, 995, 198, 1, 0, 0, 0, 996, 
+	997, 3, 351, 175, 0, 997, 998, 3, 325, 162, 0, 998, 999, 3, 317, 158, 0, 
+	999, 1000, 3, 323, 161, 0, 1000, 200, 1, 0, 0, 0, 1001, 1002, 3, 361, 180, 
+	0, 1002, 1003, 3, 351, 175, 0, 1003, 1004, 3, 333, 166, 0, 1004, 1005, 
+	3, 355, 177, 0, 1005, 1006, 3, 325, 162, 0, 1006, 202, 1, 0, 0, 0, 1007, 
+	1008, 3, 317, 158, 0, 1008, 1009, 3, 323, 161, 0, 1009, 1010, 3, 341, 170, 
+	0, 1010, 1011, 3, 333, 166, 0, 1011, 1012, 3, 343, 171, 0, 1012, 204, 1, 
+	0, 0, 0, 1013, 1014, 3, 353, 176, 0, 1014, 1015, 3, 357, 178, 0, 1015, 
+	1016, 3, 341, 170, 0, 1016, 206, 1, 0, 0, 0, 1017, 1018, 3, 341, 170, 0, 
+	1018, 1019, 3, 333, 166, 0, 1019, 1020, 3, 343, 171, 0, 1020, 208, 1, 0, 
+	0, 0, 1021, 1022, 3, 341, 170, 0, 1022, 1023, 3, 317, 158, 0, 1023, 1024, 
+	3, 363, 181, 0, 1024, 210, 1, 0, 0, 0, 1025, 1026, 3, 321, 160, 0, 1026, 
+	1027, 3, 345, 172, 0, 1027, 1028, 3, 357, 178, 0, 1028, 1029, 3, 343, 171, 
+	0, 1029, 1030, 3, 355, 177, 0, 1030, 212, 1, 0, 0, 0, 1031, 1032, 3, 339, 
+	169, 0, 1032, 1033, 3, 317, 158, 0, 1033, 1034, 3, 353, 176, 0, 1034, 1035, 
+	3, 355, 177, 0, 1035, 214, 1, 0, 0, 0, 1036, 1037, 3, 327, 163, 0, 1037, 
+	1038, 3, 333, 166, 0, 1038, 1039, 3, 351, 175, 0, 1039, 1040, 3, 353, 176, 
+	0, 1040, 1041, 3, 355, 177, 0, 1041, 216, 1, 0, 0, 0, 1042, 1043, 3, 317, 
+	158, 0, 1043, 1044, 3, 359, 179, 0, 1044, 1045, 3, 329, 164, 0, 1045, 218, 
+	1, 0, 0, 0, 1046, 1047, 3, 353, 176, 0, 1047, 1048, 3, 355, 177, 0, 1048, 
+	1049, 3, 323, 161, 0, 1049, 1050, 3, 323, 161, 0, 1050, 1051, 3, 325, 162, 
+	0, 1051, 1052, 3, 359, 179, 0, 1052, 220, 1, 0, 0, 0, 1053, 1054, 3, 349, 
+	174, 0, 1054, 1055, 3, 357, 178, 0, 1055, 1056, 3, 317, 158, 0, 1056, 1057, 
+	3, 343, 171, 0, 1057, 1058, 3, 355, 177, 0, 1058, 1059, 3, 333, 166, 0, 
+	1059, 1060, 3, 339, 169, 0, 1060, 1061, 3, 325, 162, 0, 1061, 222, 1, 0, 
+	0, 0, 1062, 1063, 3, 351, 175, 0, 1063, 1064, 3, 317, 158, 0, 1064, 1065, 
+	3, 355, 177, 0, 1065, 1066, 3, 325, 162, 0, 1066, 224, 1, 0, 0, 0, 1067, 
+	1068, 3, 347, 173, 0, 1068, 1069, 3, 325, 162, 0, 1069, 1070, 3, 351, 175, 
+	0, 1070, 1071, 3, 321, 160, 0, 1071, 1072, 3, 325, 162, 0, 1072, 1073, 
+	3, 343, 171, 0, 1073, 1074, 3, 355, 177, 0, 1074, 1075, 3, 333, 166, 0, 
+	1075, 1076, 3, 339, 169, 0, 1076, 1077, 3, 325, 162, 0, 1077, 226, 1, 0, 
+	0, 0, 1078, 1079, 3, 333, 166, 0, 1079, 1080, 3, 343, 171, 0, 1080, 1081, 
+	3, 321, 160, 0, 1081, 1082, 3, 351, 175, 0, 1082, 1083, 3, 325, 162, 0, 
+	1083, 1084, 3, 317, 158, 0, 1084, 1085, 3, 353, 176, 0, 1085, 1086, 3, 
+	325, 162, 0, 1086, 228, 1, 0, 0, 0, 1087, 1088, 3, 323, 161, 0, 1088, 1089, 
+	3, 325, 162, 0, 1089, 1090, 3, 351, 175, 0, 1090, 1091, 3, 333, 166, 0, 
+	1091, 1092, 3, 359, 179, 0, 1092, 1093, 3, 317, 158, 0, 1093, 1094, 3, 
+	355, 177, 0, 1094, 1095, 3, 333, 166, 0, 1095, 1096, 3, 359, 179, 0, 1096, 
+	1097, 3, 325, 162, 0, 1097, 230, 1, 0, 0, 0, 1098, 1099, 3, 323, 161, 0, 
+	1099, 1100, 3, 325, 162, 0, 1100, 1101, 3, 339, 169, 0, 1101, 1102, 3, 
+	355, 177, 0, 1102, 1103, 3, 317, 158, 0, 1103, 232, 1, 0, 0, 0, 1104, 1105, 
+	3, 341, 170, 0, 1105, 1106, 3, 345, 172, 0, 1106, 1107, 3, 359, 179, 0, 
+	1107, 1108, 3, 333, 166, 0, 1108, 1109, 3, 343, 171, 0, 1109, 1110, 3, 
+	329, 164, 0, 1110, 1111, 5, 95, 0, 0, 1111, 1112, 3, 317, 158, 0, 1112, 
+	1113, 3, 359, 179, 0, 1113, 1114, 3, 325, 162, 0, 1114, 1115, 3, 351, 175, 
+	0, 1115, 1116, 3, 317, 158, 0, 1116, 1117, 3, 329, 164, 0, 1117, 1118, 
+	3, 325, 162, 0, 1118, 234, 1, 0, 0, 0, 1119, 1120, 3, 317, 158, 0, 1120, 
+	1121, 3, 319, 159, 0, 1121, 1122, 3, 353, 176, 0, 1122, 236, 1, 0, 0, 0, 
+	1123, 1124, 3, 321, 160, 0, 1124, 1125, 3, 339, 169, 0, 1125, 1126, 3, 
+	317, 158, 0, 1126, 1127, 3, 341, 170, 0, 1127, 1128, 3, 347, 173, 0, 1128, 
+	1129, 5, 95, 0, 0, 1129, 1130, 3, 341, 170, 0, 1130, 1131, 3, 333, 166, 
+	0, 1131, 1132, 3, 343, 171, 0, 1132, 238, 1, 0, 0, 0, 1133, 1134, 3, 321, 
+	160, 0, 1134, 1135, 3, 339, 169, 0, 1135, 1136, 3, 317, 158, 0, 1136, 1137, 
+	3, 341, 170, 0, 1137, 1138, 3, 347, 173, 0, 1138, 1139, 5, 95, 0, 0, 1139, 
+	1140, 3, 341, 170, 0, 1140, 1141, 3, 317, 158, 0, 1141, 1142, 3, 363, 181, 
+	0, 1142, 240, 1, 0, 0, 0, 1143, 1144, 3, 355, 177, 0, 1144, 1145, 3, 333, 
+	166, 0, 1145, 1146, 3, 341, 170, 0, 1146, 1147, 3, 325, 162, 0, 1147, 1148, 
+	3, 353, 176, 0, 1148, 1149, 3, 331, 165, 0, 1149, 1150, 3, 333, 166, 0, 
+	1150, 1151, 3, 327, 163, 0, 1151, 1152, 3, 355, 177, 0, 1152, 242, 1, 0, 
+	0, 0, 1153, 1154, 3, 353, 176, 0, 1154, 244, 1, 0, 0, 0, 1155, 1156, 5, 
+	109, 0, 0, 1156, 246, 1, 0, 0, 0, 1157, 1158, 3, 331, 165, 0, 1158, 248, 
+	1, 0, 0, 0, 1159, 1160, 3, 323, 161, 0, 1160, 250, 1, 0, 0, 0, 1161, 1162, 
+	3, 361, 180, 0, 1162, 252, 1, 0, 0, 0, 1163, 1164, 5, 77, 0, 0, 1164, 254, 
+	1, 0, 0, 0, 1165, 1166, 3, 365, 182, 0, 1166, 256, 1, 0, 0, 0, 1167, 1168, 
+	5, 46, 0, 0, 1168, 258, 1, 0, 0, 0, 1169, 1170, 5, 58, 0, 0, 1170, 260, 
+	1, 0, 0, 0, 1171, 1172, 5, 61, 0, 0, 1172, 262, 1, 0, 0, 0, 1173, 1174, 
+	5, 60, 0, 0, 1174, 1175, 5, 62, 0, 0, 1175, 264, 1, 0, 0, 0, 1176, 1177, 
+	5, 33, 0, 0, 1177, 1178, 5, 61, 0, 0, 1178, 266, 1, 0, 0, 0, 1179, 1180, 
+	5, 62, 0, 0, 1180, 268, 1, 0, 0, 0, 1181, 1182, 5, 62, 0, 0, 1182, 1183, 
+	5, 61, 0, 0, 1183, 270, 1, 0, 0, 0, 1184, 1185, 5, 60, 0, 0, 1185, 272, 
+	1, 0, 0, 0, 1186, 1187, 5, 60, 0, 0, 1187, 1188, 5, 61, 0, 0, 1188, 274, 
+	1, 0, 0, 0, 1189, 1190, 5, 61, 0, 0, 1190, 1191, 5, 126, 0, 0, 1191, 276, 
+	1, 0, 0, 0, 1192, 1193, 5, 33, 0, 0, 1193, 1194, 5, 126, 0, 0, 1194, 278, 
+	1, 0, 0, 0, 1195, 1196, 5, 44, 0, 0, 1196, 280, 1, 0, 0, 0, 1197, 1198, 
+	5, 123, 0, 0, 1198, 282, 1, 0, 0, 0, 1199, 1200, 5, 125, 0, 0, 1200, 284, 
+	1, 0, 0, 0, 1201, 1202, 5, 91, 0, 0, 1202, 286, 1, 0, 0, 0, 1203, 1204, 
+	5, 93, 0, 0, 1204, 288, 1, 0, 0, 0, 1205, 1206, 5, 40, 0, 0, 1206, 290, 
+	1, 0, 0, 0, 1207, 1208, 5, 41, 0, 0, 1208, 292, 1, 0, 0, 0, 1209, 1210, 
+	5, 43, 0, 0, 1210, 294, 1, 0, 0, 0, 1211, 1212, 5, 45, 0, 0, 1212, 296, 
+	1, 0, 0, 0, 1213, 1214, 5, 47, 0, 0, 1214, 298, 1, 0, 0, 0, 1215, 1216, 
+	5, 42, 0, 0, 1216, 300, 1, 0, 0, 0, 1217, 1218, 5, 37, 0, 0, 1218, 302, 
+	1, 0, 0, 0, 1219, 1220, 5, 95, 0, 0, 1220, 304, 1, 0, 0, 0, 1221, 1222, 
+	3, 315, 157, 0, 1222, 306, 1, 0, 0, 0, 1223, 1225, 3, 313, 156, 0, 1224, 
+	1223, 1, 0, 0, 0, 1225, 1226, 1, 0, 0, 0, 1226, 1224, 1, 0, 0, 0, 1226, 
+	1227, 1, 0, 0, 0, 1227, 308, 1, 0, 0, 0, 1228, 1230, 3, 313, 156, 0, 1229, 
+	1228, 1, 0, 0, 0, 1230, 1231, 1, 0, 0, 0, 1231, 1229, 1, 0, 0, 0, 1231, 
+	1232, 1, 0, 0, 0, 1232, 1233, 1, 0, 0, 0, 1233, 1234, 5, 46, 0, 0, 1234, 
+	1238, 8, 6, 0, 0, 1235, 1237, 3, 313, 156, 0, 1236, 1235, 1, 0, 0, 0, 1237, 
+	1240, 1, 0, 0, 0, 1238, 1236, 1, 0, 0, 0, 1238, 1239, 1, 0, 0, 0, 1239, 
+	1248, 1, 0, 0, 0, 1240, 1238, 1, 0, 0, 0, 1241, 1243, 5, 46, 0, 0, 1242, 
+	1244, 3, 313, 156, 0, 1243, 1242, 1, 0, 0, 0, 1244, 1245, 1, 0, 0, 0, 1245, 
+	1243, 1, 0, 0, 0, 1245, 1246, 1, 0, 0, 0, 1246, 1248, 1, 0, 0, 0, 1247, 
+	1229, 1, 0, 0, 0, 1247, 1241, 1, 0, 0, 0, 1248, 310, 1, 0, 0, 0, 1249, 
+	1250, 7, 5, 0, 0, 1250, 312, 1, 0, 0, 0, 1251, 1252, 7, 7, 0, 0, 1252, 
+	314, 1, 0, 0, 0, 1253, 1259, 7, 8, 0, 0, 1254, 1258, 7, 8, 0, 0, 1255, 
+	1258, 3, 313, 156, 0, 1256, 1258, 7, 9, 0, 0, 1257, 1254, 1, 0, 0, 0, 1257, 
+	1255, 1, 0, 0, 0, 1257, 1256, 1, 0, 0, 0, 1258, 1261, 1, 0, 0, 0, 1259, 
+	1257, 1, 0, 0, 0, 1259, 1260, 1, 0, 0, 0, 1260, 1304, 1, 0, 0, 0, 1261, 
+	1259, 1, 0, 0, 0, 1262, 1263, 5, 36, 0, 0, 1263, 1267, 5, 123, 0, 0, 1264, 
+	1266, 9, 0, 0, 0, 1265, 1264, 1, 0, 0, 0, 1266, 1269, 1, 0, 0, 0, 1267, 
+	1268, 1, 0, 0, 0, 1267, 1265, 1, 0, 0, 0, 1268, 1270, 1, 0, 0, 0, 1269, 
+	1267, 1, 0, 0, 0, 1270, 1304, 5, 125, 0, 0, 1271, 1275, 7, 10, 0, 0, 1272, 
+	1276, 7, 8, 0, 0, 1273, 1276, 3, 313, 156, 0, 1274, 1276, 7, 11, 0, 0, 
+	1275, 1272, 1, 0, 0, 0, 1275, 1273, 1, 0, 0, 0, 1275, 1274, 1, 0, 0, 0, 
+	1276, 1277, 1, 0, 0, 0, 1277, 1275, 1, 0, 0, 0, 1277, 1278, 1, 0, 0, 0, 
+	1278, 1304, 1, 0, 0, 0, 1279, 1283, 5, 34, 0, 0, 1280, 1282, 9, 0, 0, 0, 
+	1281, 1280, 1, 0, 0, 0, 1282, 1285, 1, 0, 0, 0, 1283, 1284, 1, 0, 0, 0, 
+	1283, 1281, 1, 0, 0, 0, 1284, 1286, 1, 0, 0, 0, 1285, 1283, 1, 0, 0, 0, 
+	1286, 1304, 5, 34, 0, 0, 1287, 1291, 5, 96, 0, 0, 1288, 1290, 9, 0, 0, 
+	0, 1289, 1288, 1, 0, 0, 0, 1290, 1293, 1, 0, 0, 0, 1291, 1292, 1, 0, 0, 
+	0, 1291, 1289, 1, 0, 0, 0, 1292, 1294, 1, 0, 0, 0, 1293, 1291, 1, 0, 0, 
+	0, 1294, 1304, 5, 96, 0, 0, 1295, 1299, 5, 39, 0, 0, 1296, 1298, 9, 0, 
+	0, 0, 1297, 1296, 1, 0, 0, 0, 1298, 1301, 1, 0, 0, 0, 1299, 1300, 1, 0, 
+	0, 0, 1299, 1297, 1, 0, 0, 0, 1300, 1302, 1, 0, 0, 0, 1301, 1299, 1, 0, 
+	0, 0, 1302, 1304, 5, 39, 0, 0, 1303, 1253, 1, 0, 0, 0, 1303, 1262, 1, 0, 
+	0, 0, 1303, 1271, 1, 0, 0, 0, 1303, 1279, 1, 0, 0, 0, 1303, 1287, 1, 0, 
+	0, 0, 1303, 1295, 1, 0, 0, 0, 1304, 316, 1, 0, 0, 0, 1305, 1306, 7, 12, 
+	0, 0, 1306, 318, 1, 0, 0, 0, 1307, 1308, 7, 13, 0, 0, 1308, 320, 1, 0, 
+	0, 0, 1309, 1310, 7, 14, 0, 0, 1310, 322, 1, 0, 0, 0, 1311, 1312, 7, 15, 
+	0, 0, 1312, 324, 1, 0, 0, 0, 1313, 1314, 7, 3, 0, 0, 1314, 326, 1, 0, 0, 
+	0, 1315, 1316, 7, 16, 0, 0, 1316, 328, 1, 0, 0, 0, 1317, 1318, 7, 17, 0, 
+	0, 1318, 330, 1, 0, 0, 0, 1319, 1320, 7, 18, 0, 0, 1320, 332, 1, 0, 0, 
+	0, 1321, 1322, 7, 19, 0, 0, 1322, 334, 1, 0, 0, 0, 1323, 1324, 7, 20, 0, 
+	0, 1324, 336, 1, 0, 0, 0, 1325, 1326, 7, 21, 0, 0, 1326, 338, 1, 0, 0, 
+	0, 1327, 1328, 7, 22, 0, 0, 1328, 340, 1, 0, 0, 0, 1329, 1330, 7, 23, 0, 
+	0, 1330, 342, 1, 0, 0, 0, 1331, 1332, 7, 24, 0, 0, 1332, 344, 1, 0, 0, 
+	0, 1333, 1334, 7, 25, 0, 0, 1334, 346, 1, 0, 0, 0, 1335, 1336, 7, 26, 0, 
+	0, 1336, 348, 1, 0, 0, 0, 1337, 1338, 7, 27, 0, 0, 1338, 350, 1, 0, 0, 
+	0, 1339, 1340, 7, 28, 0, 0, 1340, 352, 1, 0, 0, 0, 1341, 1342, 7, 29, 0, 
+	0, 1342, 354, 1, 0, 0, 0, 1343, 1344, 7, 30, 0, 0, 1344, 356, 1, 0, 0, 
+	0, 1345, 1346, 7, 31, 0, 0, 1346, 358, 1, 0, 0, 0, 1347, 1348, 7, 32, 0, 
+	0, 1348, 360, 1, 0, 0, 0, 1349, 1350, 7, 33, 0, 0, 1350, 362, 1, 0, 0, 
+	0, 1351, 1352, 7, 34, 0, 0, 1352, 364, 1, 0, 0, 0, 1353, 1354, 7, 35, 0, 
+	0, 1354, 366, 1, 0, 0, 0, 1355, 1356, 7, 36, 0, 0, 1356, 368, 1, 0, 0, 
+	0, 20, 0, 383, 385, 393, 407, 414, 1226, 1231, 1238, 1245, 1247, 1257, 
+	1259, 1267, 1275, 1277, 1283, 1291, 1299, 1303, 1, 6, 0, 0,
 }
   deserializer := antlr.NewATNDeserializer(nil)
   staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -672,129 +794,153 @@ func NewSQLLexer(input antlr.CharStream) *SQLLexer {
 const (
 	SQLLexerT__0 = 1
 	SQLLexerT__1 = 2
-	SQLLexerT__2 = 3
-	SQLLexerSTRING = 4
-	SQLLexerWS = 5
-	SQLLexerT_CREATE = 6
-	SQLLexerT_UPDATE = 7
-	SQLLexerT_SET = 8
-	SQLLexerT_DROP = 9
-	SQLLexerT_INTERVAL = 10
-	SQLLexerT_INTERVAL_NAME = 11
-	SQLLexerT_SHARD = 12
-	SQLLexerT_REPLICATION = 13
-	SQLLexerT_TTL = 14
-	SQLLexerT_META_TTL = 15
-	SQLLexerT_PAST_TTL = 16
-	SQLLexerT_FUTURE_TTL = 17
-	SQLLexerT_KILL = 18
-	SQLLexerT_ON = 19
-	SQLLexerT_SHOW = 20
-	SQLLexerT_USE = 21
-	SQLLexerT_STATE_REPO = 22
-	SQLLexerT_STATE_MACHINE = 23
-	SQLLexerT_MASTER = 24
-	SQLLexerT_METADATA = 25
-	SQLLexerT_TYPES = 26
-	SQLLexerT_TYPE = 27
-	SQLLexerT_STORAGES = 28
-	SQLLexerT_STORAGE = 29
-	SQLLexerT_BROKER = 30
-	SQLLexerT_ALIVE = 31
-	SQLLexerT_SCHEMAS = 32
-	SQLLexerT_DATASBAE = 33
-	SQLLexerT_DATASBAES = 34
-	SQLLexerT_NAMESPACE = 35
-	SQLLexerT_NAMESPACES = 36
-	SQLLexerT_NODE = 37
-	SQLLexerT_METRICS = 38
-	SQLLexerT_METRIC = 39
-	SQLLexerT_FIELD = 40
-	SQLLexerT_FIELDS = 41
-	SQLLexerT_TAG = 42
-	SQLLexerT_INFO = 43
-	SQLLexerT_KEYS = 44
-	SQLLexerT_KEY = 45
-	SQLLexerT_WITH = 46
-	SQLLexerT_VALUES = 47
-	SQLLexerT_VALUE = 48
-	SQLLexerT_FROM = 49
-	SQLLexerT_WHERE = 50
-	SQLLexerT_LIMIT = 51
-	SQLLexerT_QUERIES = 52
-	SQLLexerT_QUERY = 53
-	SQLLexerT_EXPLAIN = 54
-	SQLLexerT_WITH_VALUE = 55
-	SQLLexerT_SELECT = 56
-	SQLLexerT_AS = 57
-	SQLLexerT_AND = 58
-	SQLLexerT_OR = 59
-	SQLLexerT_FILL = 60
-	SQLLexerT_NULL = 61
-	SQLLexerT_PREVIOUS = 62
-	SQLLexerT_ORDER = 63
-	SQLLexerT_ASC = 64
-	SQLLexerT_DESC = 65
-	SQLLexerT_LIKE = 66
-	SQLLexerT_NOT = 67
-	SQLLexerT_BETWEEN = 68
-	SQLLexerT_IS = 69
-	SQLLexerT_GROUP = 70
-	SQLLexerT_HAVING = 71
-	SQLLexerT_BY = 72
-	SQLLexerT_FOR = 73
-	SQLLexerT_STATS = 74
-	SQLLexerT_TIME = 75
-	SQLLexerT_NOW = 76
-	SQLLexerT_IN = 77
-	SQLLexerT_LOG = 78
-	SQLLexerT_PROFILE = 79
-	SQLLexerT_REQUESTS = 80
-	SQLLexerT_REQUEST = 81
-	SQLLexerT_ID = 82
-	SQLLexerT_SUM = 83
-	SQLLexerT_MIN = 84
-	SQLLexerT_MAX = 85
-	SQLLexerT_COUNT = 86
-	SQLLexerT_LAST = 87
-	SQLLexerT_FIRST = 88
-	SQLLexerT_AVG = 89
-	SQLLexerT_STDDEV = 90
-	SQLLexerT_QUANTILE = 91
-	SQLLexerT_RATE = 92
-	SQLLexerT_SECOND = 93
-	SQLLexerT_MINUTE = 94
-	SQLLexerT_HOUR = 95
-	SQLLexerT_DAY = 96
-	SQLLexerT_WEEK = 97
-	SQLLexerT_MONTH = 98
-	SQLLexerT_YEAR = 99
-	SQLLexerT_DOT = 100
-	SQLLexerT_COLON = 101
-	SQLLexerT_EQUAL = 102
-	SQLLexerT_NOTEQUAL = 103
-	SQLLexerT_NOTEQUAL2 = 104
-	SQLLexerT_GREATER = 105
-	SQLLexerT_GREATEREQUAL = 106
-	SQLLexerT_LESS = 107
-	SQLLexerT_LESSEQUAL = 108
-	SQLLexerT_REGEXP = 109
-	SQLLexerT_NEQREGEXP = 110
-	SQLLexerT_COMMA = 111
-	SQLLexerT_OPEN_B = 112
-	SQLLexerT_CLOSE_B = 113
-	SQLLexerT_OPEN_SB = 114
-	SQLLexerT_CLOSE_SB = 115
-	SQLLexerT_OPEN_P = 116
-	SQLLexerT_CLOSE_P = 117
-	SQLLexerT_ADD = 118
-	SQLLexerT_SUB = 119
-	SQLLexerT_DIV = 120
-	SQLLexerT_MUL = 121
-	SQLLexerT_MOD = 122
-	SQLLexerT_UNDERLINE = 123
-	SQLLexerL_ID = 124
-	SQLLexerL_INT = 125
-	SQLLexerL_DEC = 126
+	SQLLexerSTRING = 3
+	SQLLexerWS = 4
+	SQLLexerT_CREATE = 5
+	SQLLexerT_UPDATE = 6
+	SQLLexerT_SET = 7
+	SQLLexerT_DROP = 8
+	SQLLexerT_ALTER = 9
+	SQLLexerT_DELETE = 10
+	SQLLexerT_INTERVAL = 11
+	SQLLexerT_INTERVAL_NAME = 12
+	SQLLexerT_SHARD = 13
+	SQLLexerT_REPLICATION = 14
+	SQLLexerT_TTL = 15
+	SQLLexerT_META_TTL = 16
+	SQLLexerT_PAST_TTL = 17
+	SQLLexerT_FUTURE_TTL = 18
+	SQLLexerT_KILL = 19
+	SQLLexerT_ON = 20
+	SQLLexerT_SHOW = 21
+	SQLLexerT_USE = 22
+	SQLLexerT_STATE_REPO = 23
+	SQLLexerT_STATE_MACHINE = 24
+	SQLLexerT_MASTER = 25
+	SQLLexerT_METADATA = 26
+	SQLLexerT_TYPES = 27
+	SQLLexerT_TYPE = 28
+	SQLLexerT_STORAGES = 29
+	SQLLexerT_STORAGE = 30
+	SQLLexerT_BROKER = 31
+	SQLLexerT_ALIVE = 32
+	SQLLexerT_SCHEMAS = 33
+	SQLLexerT_DATASBAE = 34
+	SQLLexerT_DATASBAES = 35
+	SQLLexerT_NAMESPACE = 36
+	SQLLexerT_NAMESPACES = 37
+	SQLLexerT_NODE = 38
+	SQLLexerT_METRICS = 39
+	SQLLexerT_METRIC = 40
+	SQLLexerT_FIELD = 41
+	SQLLexerT_FIELDS = 42
+	SQLLexerT_TAG = 43
+	SQLLexerT_INFO = 44
+	SQLLexerT_KEYS = 45
+	SQLLexerT_KEY = 46
+	SQLLexerT_WITH = 47
+	SQLLexerT_VALUES = 48
+	SQLLexerT_VALUE = 49
+	SQLLexerT_FROM = 50
+	SQLLexerT_WHERE = 51
+	SQLLexerT_LIMIT = 52
+	SQLLexerT_OFFSET = 53
+	SQLLexerT_QUERIES = 54
+	SQLLexerT_QUERY = 55
+	SQLLexerT_EXPLAIN = 56
+	SQLLexerT_WITH_VALUE = 57
+	SQLLexerT_SELECT = 58
+	SQLLexerT_AS = 59
+	SQLLexerT_AND = 60
+	SQLLexerT_OR = 61
+	SQLLexerT_FILL = 62
+	SQLLexerT_NULL = 63
+	SQLLexerT_PREVIOUS = 64
+	SQLLexerT_ORDER = 65
+	SQLLexerT_ASC = 66
+	SQLLexerT_DESC = 67
+	SQLLexerT_LIKE = 68
+	SQLLexerT_NOT = 69
+	SQLLexerT_BETWEEN = 70
+	SQLLexerT_IS = 71
+	SQLLexerT_GROUP = 72
+	SQLLexerT_HAVING = 73
+	SQLLexerT_BY = 74
+	SQLLexerT_FOR = 75
+	SQLLexerT_STATS = 76
+	SQLLexerT_TIME = 77
+	SQLLexerT_NOW = 78
+	SQLLexerT_IN = 79
+	SQLLexerT_LOG = 80
+	SQLLexerT_PROFILE = 81
+	SQLLexerT_REQUESTS = 82
+	SQLLexerT_REQUEST = 83
+	SQLLexerT_SERIES = 84
+	SQLLexerT_QUOTA = 85
+	SQLLexerT_CARDINALITY = 86
+	SQLLexerT_ID = 87
+	SQLLexerT_USER = 88
+	SQLLexerT_USERS = 89
+	SQLLexerT_PASSWORD = 90
+	SQLLexerT_TOKEN = 91
+	SQLLexerT_GRANT = 92
+	SQLLexerT_REVOKE = 93
+	SQLLexerT_TO = 94
+	SQLLexerT_READ = 95
+	SQLLexerT_WRITE = 96
+	SQLLexerT_ADMIN = 97
+	SQLLexerT_SUM = 98
+	SQLLexerT_MIN = 99
+	SQLLexerT_MAX = 100
+	SQLLexerT_COUNT = 101
+	SQLLexerT_LAST = 102
+	SQLLexerT_FIRST = 103
+	SQLLexerT_AVG = 104
+	SQLLexerT_STDDEV = 105
+	SQLLexerT_QUANTILE = 106
+	SQLLexerT_RATE = 107
+	SQLLexerT_PERCENTILE = 108
+	SQLLexerT_INCREASE = 109
+	SQLLexerT_DERIVATIVE = 110
+	SQLLexerT_DELTA = 111
+	SQLLexerT_MOVING_AVERAGE = 112
+	SQLLexerT_ABS = 113
+	SQLLexerT_CLAMP_MIN = 114
+	SQLLexerT_CLAMP_MAX = 115
+	SQLLexerT_TIMESHIFT = 116
+	SQLLexerT_SECOND = 117
+	SQLLexerT_MINUTE = 118
+	SQLLexerT_HOUR = 119
+	SQLLexerT_DAY = 120
+	SQLLexerT_WEEK = 121
+	SQLLexerT_MONTH = 122
+	SQLLexerT_YEAR = 123
+	SQLLexerT_DOT = 124
+	SQLLexerT_COLON = 125
+	SQLLexerT_EQUAL = 126
+	SQLLexerT_NOTEQUAL = 127
+	SQLLexerT_NOTEQUAL2 = 128
+	SQLLexerT_GREATER = 129
+	SQLLexerT_GREATEREQUAL = 130
+	SQLLexerT_LESS = 131
+	SQLLexerT_LESSEQUAL = 132
+	SQLLexerT_REGEXP = 133
+	SQLLexerT_NEQREGEXP = 134
+	SQLLexerT_COMMA = 135
+	SQLLexerT_OPEN_B = 136
+	SQLLexerT_CLOSE_B = 137
+	SQLLexerT_OPEN_SB = 138
+	SQLLexerT_CLOSE_SB = 139
+	SQLLexerT_OPEN_P = 140
+	SQLLexerT_CLOSE_P = 141
+	SQLLexerT_ADD = 142
+	SQLLexerT_SUB = 143
+	SQLLexerT_DIV = 144
+	SQLLexerT_MUL = 145
+	SQLLexerT_MOD = 146
+	SQLLexerT_UNDERLINE = 147
+	SQLLexerL_ID = 148
+	SQLLexerL_INT = 149
+	SQLLexerL_DEC = 150
 )
 
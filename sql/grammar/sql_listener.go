@@ -42,6 +42,9 @@ type SQLListener interface {
 	// EnterShowRequestStmt is called when entering the showRequestStmt production.
 	EnterShowRequestStmt(c *ShowRequestStmtContext)
 
+	// EnterKillQueryStmt is called when entering the killQueryStmt production.
+	EnterKillQueryStmt(c *KillQueryStmtContext)
+
 	// EnterShowStoragesStmt is called when entering the showStoragesStmt production.
 	EnterShowStoragesStmt(c *ShowStoragesStmtContext)
 
@@ -69,6 +72,9 @@ type SQLListener interface {
 	// EnterShowStorageMetricStmt is called when entering the showStorageMetricStmt production.
 	EnterShowStorageMetricStmt(c *ShowStorageMetricStmtContext)
 
+	// EnterShowSeriesQuotaStmt is called when entering the showSeriesQuotaStmt production.
+	EnterShowSeriesQuotaStmt(c *ShowSeriesQuotaStmtContext)
+
 	// EnterCreateStorageStmt is called when entering the createStorageStmt production.
 	EnterCreateStorageStmt(c *CreateStorageStmtContext)
 
@@ -81,6 +87,12 @@ type SQLListener interface {
 	// EnterDropDatabaseStmt is called when entering the dropDatabaseStmt production.
 	EnterDropDatabaseStmt(c *DropDatabaseStmtContext)
 
+	// EnterAlterDatabaseStmt is called when entering the alterDatabaseStmt production.
+	EnterAlterDatabaseStmt(c *AlterDatabaseStmtContext)
+
+	// EnterDeleteStmt is called when entering the deleteStmt production.
+	EnterDeleteStmt(c *DeleteStmtContext)
+
 	// EnterShowDatabaseStmt is called when entering the showDatabaseStmt production.
 	EnterShowDatabaseStmt(c *ShowDatabaseStmtContext)
 
@@ -99,6 +111,42 @@ type SQLListener interface {
 	// EnterShowTagValuesStmt is called when entering the showTagValuesStmt production.
 	EnterShowTagValuesStmt(c *ShowTagValuesStmtContext)
 
+	// EnterShowCardinalityStmt is called when entering the showCardinalityStmt production.
+	EnterShowCardinalityStmt(c *ShowCardinalityStmtContext)
+
+	// EnterShowTagCardinalityStmt is called when entering the showTagCardinalityStmt production.
+	EnterShowTagCardinalityStmt(c *ShowTagCardinalityStmtContext)
+
+	// EnterShowUsersStmt is called when entering the showUsersStmt production.
+	EnterShowUsersStmt(c *ShowUsersStmtContext)
+
+	// EnterCreateUserStmt is called when entering the createUserStmt production.
+	EnterCreateUserStmt(c *CreateUserStmtContext)
+
+	// EnterDropUserStmt is called when entering the dropUserStmt production.
+	EnterDropUserStmt(c *DropUserStmtContext)
+
+	// EnterCreateTokenStmt is called when entering the createTokenStmt production.
+	EnterCreateTokenStmt(c *CreateTokenStmtContext)
+
+	// EnterGrantStmt is called when entering the grantStmt production.
+	EnterGrantStmt(c *GrantStmtContext)
+
+	// EnterRevokeStmt is called when entering the revokeStmt production.
+	EnterRevokeStmt(c *RevokeStmtContext)
+
+	// EnterUserName is called when entering the userName production.
+	EnterUserName(c *UserNameContext)
+
+	// EnterPassword is called when entering the password production.
+	EnterPassword(c *PasswordContext)
+
+	// EnterRoleName is called when entering the roleName production.
+	EnterRoleName(c *RoleNameContext)
+
+	// EnterPrivilegeDatabase is called when entering the privilegeDatabase production.
+	EnterPrivilegeDatabase(c *PrivilegeDatabaseContext)
+
 	// EnterPrefix is called when entering the prefix production.
 	EnterPrefix(c *PrefixContext)
 
@@ -147,6 +195,12 @@ type SQLListener interface {
 	// EnterFromClause is called when entering the fromClause production.
 	EnterFromClause(c *FromClauseContext)
 
+	// EnterMetricAlias is called when entering the metricAlias production.
+	EnterMetricAlias(c *MetricAliasContext)
+
+	// EnterMetricOffset is called when entering the metricOffset production.
+	EnterMetricOffset(c *MetricOffsetContext)
+
 	// EnterWhereClause is called when entering the whereClause production.
 	EnterWhereClause(c *WhereClauseContext)
 
@@ -267,12 +321,18 @@ type SQLListener interface {
 	// EnterLimitClause is called when entering the limitClause production.
 	EnterLimitClause(c *LimitClauseContext)
 
+	// EnterOffsetClause is called when entering the offsetClause production.
+	EnterOffsetClause(c *OffsetClauseContext)
+
 	// EnterMetricName is called when entering the metricName production.
 	EnterMetricName(c *MetricNameContext)
 
 	// EnterTagKey is called when entering the tagKey production.
 	EnterTagKey(c *TagKeyContext)
 
+	// EnterTagRangeKey is called when entering the tagRangeKey production.
+	EnterTagRangeKey(c *TagRangeKeyContext)
+
 	// EnterTagValue is called when entering the tagValue production.
 	EnterTagValue(c *TagValueContext)
 
@@ -300,6 +360,9 @@ type SQLListener interface {
 	// ExitShowRequestStmt is called when exiting the showRequestStmt production.
 	ExitShowRequestStmt(c *ShowRequestStmtContext)
 
+	// ExitKillQueryStmt is called when exiting the killQueryStmt production.
+	ExitKillQueryStmt(c *KillQueryStmtContext)
+
 	// ExitShowStoragesStmt is called when exiting the showStoragesStmt production.
 	ExitShowStoragesStmt(c *ShowStoragesStmtContext)
 
@@ -327,6 +390,9 @@ type SQLListener interface {
 	// ExitShowStorageMetricStmt is called when exiting the showStorageMetricStmt production.
 	ExitShowStorageMetricStmt(c *ShowStorageMetricStmtContext)
 
+	// ExitShowSeriesQuotaStmt is called when exiting the showSeriesQuotaStmt production.
+	ExitShowSeriesQuotaStmt(c *ShowSeriesQuotaStmtContext)
+
 	// ExitCreateStorageStmt is called when exiting the createStorageStmt production.
 	ExitCreateStorageStmt(c *CreateStorageStmtContext)
 
@@ -339,6 +405,12 @@ type SQLListener interface {
 	// ExitDropDatabaseStmt is called when exiting the dropDatabaseStmt production.
 	ExitDropDatabaseStmt(c *DropDatabaseStmtContext)
 
+	// ExitAlterDatabaseStmt is called when exiting the alterDatabaseStmt production.
+	ExitAlterDatabaseStmt(c *AlterDatabaseStmtContext)
+
+	// ExitDeleteStmt is called when exiting the deleteStmt production.
+	ExitDeleteStmt(c *DeleteStmtContext)
+
 	// ExitShowDatabaseStmt is called when exiting the showDatabaseStmt production.
 	ExitShowDatabaseStmt(c *ShowDatabaseStmtContext)
 
@@ -357,6 +429,42 @@ type SQLListener interface {
 	// ExitShowTagValuesStmt is called when exiting the showTagValuesStmt production.
 	ExitShowTagValuesStmt(c *ShowTagValuesStmtContext)
 
+	// ExitShowCardinalityStmt is called when exiting the showCardinalityStmt production.
+	ExitShowCardinalityStmt(c *ShowCardinalityStmtContext)
+
+	// ExitShowTagCardinalityStmt is called when exiting the showTagCardinalityStmt production.
+	ExitShowTagCardinalityStmt(c *ShowTagCardinalityStmtContext)
+
+	// ExitShowUsersStmt is called when exiting the showUsersStmt production.
+	ExitShowUsersStmt(c *ShowUsersStmtContext)
+
+	// ExitCreateUserStmt is called when exiting the createUserStmt production.
+	ExitCreateUserStmt(c *CreateUserStmtContext)
+
+	// ExitDropUserStmt is called when exiting the dropUserStmt production.
+	ExitDropUserStmt(c *DropUserStmtContext)
+
+	// ExitCreateTokenStmt is called when exiting the createTokenStmt production.
+	ExitCreateTokenStmt(c *CreateTokenStmtContext)
+
+	// ExitGrantStmt is called when exiting the grantStmt production.
+	ExitGrantStmt(c *GrantStmtContext)
+
+	// ExitRevokeStmt is called when exiting the revokeStmt production.
+	ExitRevokeStmt(c *RevokeStmtContext)
+
+	// ExitUserName is called when exiting the userName production.
+	ExitUserName(c *UserNameContext)
+
+	// ExitPassword is called when exiting the password production.
+	ExitPassword(c *PasswordContext)
+
+	// ExitRoleName is called when exiting the roleName production.
+	ExitRoleName(c *RoleNameContext)
+
+	// ExitPrivilegeDatabase is called when exiting the privilegeDatabase production.
+	ExitPrivilegeDatabase(c *PrivilegeDatabaseContext)
+
 	// ExitPrefix is called when exiting the prefix production.
 	ExitPrefix(c *PrefixContext)
 
@@ -405,6 +513,12 @@ type SQLListener interface {
 	// ExitFromClause is called when exiting the fromClause production.
 	ExitFromClause(c *FromClauseContext)
 
+	// ExitMetricAlias is called when exiting the metricAlias production.
+	ExitMetricAlias(c *MetricAliasContext)
+
+	// ExitMetricOffset is called when exiting the metricOffset production.
+	ExitMetricOffset(c *MetricOffsetContext)
+
 	// ExitWhereClause is called when exiting the whereClause production.
 	ExitWhereClause(c *WhereClauseContext)
 
@@ -525,12 +639,18 @@ type SQLListener interface {
 	// ExitLimitClause is called when exiting the limitClause production.
 	ExitLimitClause(c *LimitClauseContext)
 
+	// ExitOffsetClause is called when exiting the offsetClause production.
+	ExitOffsetClause(c *OffsetClauseContext)
+
 	// ExitMetricName is called when exiting the metricName production.
 	ExitMetricName(c *MetricNameContext)
 
 	// ExitTagKey is called when exiting the tagKey production.
 	ExitTagKey(c *TagKeyContext)
 
+	// ExitTagRangeKey is called when exiting the tagRangeKey production.
+	ExitTagRangeKey(c *TagRangeKeyContext)
+
 	// ExitTagValue is called when exiting the tagValue production.
 	ExitTagValue(c *TagValueContext)
 
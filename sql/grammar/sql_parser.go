@@ -50,61 +50,70 @@ var sqlParserStaticData struct {
 func sqlParserInit() {
   staticData := &sqlParserStaticData
   staticData.literalNames = []string{
-    "", "'true'", "'false'", "'null'", "", "", "", "", "", "", "", "", "", 
+    "", "'true'", "'false'", "", "", "", "", "", "", "", "", "", "", "", 
     "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
     "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
     "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
     "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
-    "", "", "", "", "", "", "", "", "", "", "", "", "", "'m'", "", "", "", 
-    "'M'", "", "'.'", "':'", "'='", "'<>'", "'!='", "'>'", "'>='", "'<'", 
-    "'<='", "'=~'", "'!~'", "','", "'{'", "'}'", "'['", "']'", "'('", "')'", 
-    "'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
+    "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
+    "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", 
+    "", "", "'m'", "", "", "", "'M'", "", "'.'", "':'", "'='", "'<>'", "'!='", 
+    "'>'", "'>='", "'<'", "'<='", "'=~'", "'!~'", "','", "'{'", "'}'", "'['", 
+    "']'", "'('", "')'", "'+'", "'-'", "'/'", "'*'", "'%'", "'_'",
   }
   staticData.symbolicNames = []string{
-    "", "", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP", 
-    "T_INTERVAL", "T_INTERVAL_NAME", "T_SHARD", "T_REPLICATION", "T_TTL", 
-    "T_META_TTL", "T_PAST_TTL", "T_FUTURE_TTL", "T_KILL", "T_ON", "T_SHOW", 
-    "T_USE", "T_STATE_REPO", "T_STATE_MACHINE", "T_MASTER", "T_METADATA", 
+    "", "", "", "STRING", "WS", "T_CREATE", "T_UPDATE", "T_SET", "T_DROP", 
+    "T_ALTER", "T_DELETE", "T_INTERVAL", "T_INTERVAL_NAME", "T_SHARD", "T_REPLICATION", 
+    "T_TTL", "T_META_TTL", "T_PAST_TTL", "T_FUTURE_TTL", "T_KILL", "T_ON", 
+    "T_SHOW", "T_USE", "T_STATE_REPO", "T_STATE_MACHINE", "T_MASTER", "T_METADATA", 
     "T_TYPES", "T_TYPE", "T_STORAGES", "T_STORAGE", "T_BROKER", "T_ALIVE", 
     "T_SCHEMAS", "T_DATASBAE", "T_DATASBAES", "T_NAMESPACE", "T_NAMESPACES", 
     "T_NODE", "T_METRICS", "T_METRIC", "T_FIELD", "T_FIELDS", "T_TAG", "T_INFO", 
     "T_KEYS", "T_KEY", "T_WITH", "T_VALUES", "T_VALUE", "T_FROM", "T_WHERE", 
-    "T_LIMIT", "T_QUERIES", "T_QUERY", "T_EXPLAIN", "T_WITH_VALUE", "T_SELECT", 
-    "T_AS", "T_AND", "T_OR", "T_FILL", "T_NULL", "T_PREVIOUS", "T_ORDER", 
-    "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN", "T_IS", "T_GROUP", 
-    "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME", "T_NOW", "T_IN", "T_LOG", 
-    "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_ID", "T_SUM", "T_MIN", "T_MAX", 
-    "T_COUNT", "T_LAST", "T_FIRST", "T_AVG", "T_STDDEV", "T_QUANTILE", "T_RATE", 
-    "T_SECOND", "T_MINUTE", "T_HOUR", "T_DAY", "T_WEEK", "T_MONTH", "T_YEAR", 
-    "T_DOT", "T_COLON", "T_EQUAL", "T_NOTEQUAL", "T_NOTEQUAL2", "T_GREATER", 
-    "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL", "T_REGEXP", "T_NEQREGEXP", 
-    "T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB", "T_CLOSE_SB", "T_OPEN_P", 
-    "T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL", "T_MOD", "T_UNDERLINE", 
-    "L_ID", "L_INT", "L_DEC",
+    "T_LIMIT", "T_OFFSET", "T_QUERIES", "T_QUERY", "T_EXPLAIN", "T_WITH_VALUE", 
+    "T_SELECT", "T_AS", "T_AND", "T_OR", "T_FILL", "T_NULL", "T_PREVIOUS", 
+    "T_ORDER", "T_ASC", "T_DESC", "T_LIKE", "T_NOT", "T_BETWEEN", "T_IS", 
+    "T_GROUP", "T_HAVING", "T_BY", "T_FOR", "T_STATS", "T_TIME", "T_NOW", 
+    "T_IN", "T_LOG", "T_PROFILE", "T_REQUESTS", "T_REQUEST", "T_SERIES", 
+    "T_QUOTA", "T_CARDINALITY", "T_ID", "T_USER", "T_USERS", "T_PASSWORD", 
+    "T_TOKEN", "T_GRANT", "T_REVOKE", "T_TO", "T_READ", "T_WRITE", "T_ADMIN", 
+    "T_SUM", "T_MIN", "T_MAX", "T_COUNT", "T_LAST", "T_FIRST", "T_AVG", 
+    "T_STDDEV", "T_QUANTILE", "T_RATE", "T_PERCENTILE", "T_INCREASE", "T_DERIVATIVE", 
+    "T_DELTA", "T_MOVING_AVERAGE", "T_ABS", "T_CLAMP_MIN", "T_CLAMP_MAX", 
+    "T_TIMESHIFT", "T_SECOND", "T_MINUTE", "T_HOUR", "T_DAY", "T_WEEK", 
+    "T_MONTH", "T_YEAR", "T_DOT", "T_COLON", "T_EQUAL", "T_NOTEQUAL", "T_NOTEQUAL2", 
+    "T_GREATER", "T_GREATEREQUAL", "T_LESS", "T_LESSEQUAL", "T_REGEXP", 
+    "T_NEQREGEXP", "T_COMMA", "T_OPEN_B", "T_CLOSE_B", "T_OPEN_SB", "T_CLOSE_SB", 
+    "T_OPEN_P", "T_CLOSE_P", "T_ADD", "T_SUB", "T_DIV", "T_MUL", "T_MOD", 
+    "T_UNDERLINE", "L_ID", "L_INT", "L_DEC",
   }
   staticData.ruleNames = []string{
     "statement", "useStmt", "showStmt", "showMasterStmt", "showRequestsStmt", 
-    "showRequestStmt", "showStoragesStmt", "showMetadataTypesStmt", "showBrokerMetaStmt", 
-    "showMasterMetaStmt", "showStorageMetaStmt", "showAliveStmt", "showReplicationStmt", 
-    "showBrokerMetricStmt", "showStorageMetricStmt", "createStorageStmt", 
-    "showSchemasStmt", "createDatabaseStmt", "dropDatabaseStmt", "showDatabaseStmt", 
+    "showRequestStmt", "killQueryStmt", "showStoragesStmt", "showMetadataTypesStmt", 
+    "showBrokerMetaStmt", "showMasterMetaStmt", "showStorageMetaStmt", "showAliveStmt", 
+    "showReplicationStmt", "showBrokerMetricStmt", "showStorageMetricStmt", 
+    "showSeriesQuotaStmt", "createStorageStmt", "showSchemasStmt", "createDatabaseStmt", 
+    "dropDatabaseStmt", "alterDatabaseStmt", "deleteStmt", "showDatabaseStmt", 
     "showNameSpacesStmt", "showMetricsStmt", "showFieldsStmt", "showTagKeysStmt", 
-    "showTagValuesStmt", "prefix", "withTagKey", "namespace", "databaseName", 
-    "requestID", "source", "queryStmt", "sourceAndSelect", "selectExpr", 
-    "fields", "field", "alias", "storageFilter", "databaseFilter", "typeFilter", 
-    "fromClause", "whereClause", "conditionExpr", "tagFilterExpr", "tagValueList", 
+    "showTagValuesStmt", "showCardinalityStmt", "showTagCardinalityStmt", 
+    "showUsersStmt", "createUserStmt", "dropUserStmt", "createTokenStmt", 
+    "grantStmt", "revokeStmt", "userName", "password", "roleName", "privilegeDatabase", 
+    "prefix", "withTagKey", "namespace", "databaseName", "requestID", "source", 
+    "queryStmt", "sourceAndSelect", "selectExpr", "fields", "field", "alias", 
+    "storageFilter", "databaseFilter", "typeFilter", "fromClause", "metricAlias", 
+    "metricOffset", "whereClause", "conditionExpr", "tagFilterExpr", "tagValueList", 
     "metricListFilter", "metricList", "timeRangeExpr", "timeExpr", "nowExpr", 
     "nowFunc", "groupByClause", "groupByKeys", "groupByKey", "fillOption", 
     "orderByClause", "sortField", "sortFields", "havingClause", "boolExpr", 
     "boolExprLogicalOp", "boolExprAtom", "binaryExpr", "binaryOperator", 
     "fieldExpr", "durationLit", "intervalItem", "exprFunc", "funcName", 
     "exprFuncParams", "funcParam", "exprAtom", "identFilter", "json", "obj", 
-    "pair", "arr", "value", "intNumber", "decNumber", "limitClause", "metricName", 
-    "tagKey", "tagValue", "ident", "nonReservedWords",
+    "pair", "arr", "value", "intNumber", "decNumber", "limitClause", "offsetClause", 
+    "metricName", "tagKey", "tagRangeKey", "tagValue", "ident", "nonReservedWords",
   }
   staticData.predictionContextCache = antlr.NewPredictionContextCache()
   staticData.serializedATN = []int32{
-	4, 1, 126, 767, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7, 
+	4, 1, 150, 960, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7, 
 	4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7, 
 	10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15, 
 	2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2, 
@@ -120,329 +129,418 @@ func sqlParserInit() {
 	7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2, 73, 7, 
 	73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78, 7, 78, 
 	2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83, 2, 
-	84, 7, 84, 2, 85, 7, 85, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 
-	1, 0, 3, 0, 182, 8, 0, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 
+	84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2, 89, 
+	7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94, 7, 
+	94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99, 7, 99, 
+	2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103, 2, 104, 
+	7, 104, 2, 105, 7, 105, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 
+	1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 3, 0, 230, 8, 0, 
+	1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 
 	1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 
-	1, 2, 1, 2, 3, 2, 206, 8, 2, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 5, 
-	1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 
-	1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 
-	1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 
-	1, 10, 1, 10, 3, 10, 252, 8, 10, 1, 10, 1, 10, 1, 10, 3, 10, 257, 8, 10, 
-	1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 12, 3, 12, 268, 
-	8, 12, 1, 12, 1, 12, 1, 12, 3, 12, 273, 8, 12, 1, 13, 1, 13, 1, 13, 1, 
-	13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 3, 14, 287, 
-	8, 14, 1, 14, 1, 14, 1, 14, 3, 14, 292, 8, 14, 1, 15, 1, 15, 1, 15, 1, 
-	15, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 
-	1, 18, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 3, 
-	20, 318, 8, 20, 1, 20, 3, 20, 321, 8, 20, 1, 21, 1, 21, 1, 21, 1, 21, 3, 
-	21, 327, 8, 21, 1, 21, 1, 21, 1, 21, 1, 21, 3, 21, 333, 8, 21, 1, 21, 3, 
-	21, 336, 8, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 
-	1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 3, 
-	24, 356, 8, 24, 1, 24, 3, 24, 359, 8, 24, 1, 25, 1, 25, 1, 26, 1, 26, 1, 
-	27, 1, 27, 1, 28, 1, 28, 1, 29, 1, 29, 1, 30, 1, 30, 1, 31, 3, 31, 374, 
-	8, 31, 1, 31, 1, 31, 3, 31, 378, 8, 31, 1, 31, 3, 31, 381, 8, 31, 1, 31, 
-	3, 31, 384, 8, 31, 1, 31, 3, 31, 387, 8, 31, 1, 31, 3, 31, 390, 8, 31, 
-	1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 32, 3, 32, 398, 8, 32, 1, 33, 1, 
-	33, 1, 33, 1, 34, 1, 34, 1, 34, 5, 34, 406, 8, 34, 10, 34, 12, 34, 409, 
-	9, 34, 1, 35, 1, 35, 3, 35, 413, 8, 35, 1, 36, 1, 36, 1, 36, 1, 37, 1, 
-	37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 
-	1, 40, 1, 40, 1, 40, 1, 40, 3, 40, 434, 8, 40, 1, 41, 1, 41, 1, 41, 1, 
-	42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 1, 42, 3, 42, 447, 8, 42, 
-	3, 42, 449, 8, 42, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 
-	43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 3, 43, 465, 8, 43, 1, 43, 
-	1, 43, 1, 43, 1, 43, 1, 43, 1, 43, 3, 43, 473, 8, 43, 1, 43, 1, 43, 1, 
-	43, 1, 43, 3, 43, 479, 8, 43, 1, 43, 1, 43, 1, 43, 5, 43, 484, 8, 43, 10, 
-	43, 12, 43, 487, 9, 43, 1, 44, 1, 44, 1, 44, 5, 44, 492, 8, 44, 10, 44, 
-	12, 44, 495, 9, 44, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 46, 1, 
-	46, 1, 46, 5, 46, 506, 8, 46, 10, 46, 12, 46, 509, 9, 46, 1, 47, 1, 47, 
-	1, 47, 3, 47, 514, 8, 47, 1, 48, 1, 48, 1, 48, 1, 48, 3, 48, 520, 8, 48, 
-	1, 49, 1, 49, 3, 49, 524, 8, 49, 1, 50, 1, 50, 1, 50, 3, 50, 529, 8, 50, 
-	1, 50, 1, 50, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 3, 
-	51, 541, 8, 51, 1, 51, 3, 51, 544, 8, 51, 1, 52, 1, 52, 1, 52, 5, 52, 549, 
-	8, 52, 10, 52, 12, 52, 552, 9, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 
-	53, 3, 53, 560, 8, 53, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 55, 1, 56, 
-	1, 56, 5, 56, 570, 8, 56, 10, 56, 12, 56, 573, 9, 56, 1, 57, 1, 57, 1, 
-	57, 5, 57, 578, 8, 57, 10, 57, 12, 57, 581, 9, 57, 1, 58, 1, 58, 1, 58, 
-	1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 1, 59, 3, 59, 592, 8, 59, 1, 59, 1, 
-	59, 1, 59, 1, 59, 5, 59, 598, 8, 59, 10, 59, 12, 59, 601, 9, 59, 1, 60, 
-	1, 60, 1, 61, 1, 61, 1, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 
-	63, 1, 63, 1, 63, 1, 63, 1, 63, 3, 63, 619, 8, 63, 1, 64, 1, 64, 1, 64, 
-	1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 3, 64, 629, 8, 64, 1, 64, 1, 64, 1, 
-	64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 5, 64, 
-	643, 8, 64, 10, 64, 12, 64, 646, 9, 64, 1, 65, 1, 65, 1, 65, 1, 66, 1, 
-	66, 1, 67, 1, 67, 1, 67, 3, 67, 656, 8, 67, 1, 67, 1, 67, 1, 68, 1, 68, 
-	1, 69, 1, 69, 1, 69, 5, 69, 665, 8, 69, 10, 69, 12, 69, 668, 9, 69, 1, 
-	70, 1, 70, 3, 70, 672, 8, 70, 1, 71, 1, 71, 3, 71, 676, 8, 71, 1, 71, 1, 
-	71, 3, 71, 680, 8, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 74, 
-	1, 74, 1, 74, 1, 74, 5, 74, 692, 8, 74, 10, 74, 12, 74, 695, 9, 74, 1, 
-	74, 1, 74, 1, 74, 1, 74, 3, 74, 701, 8, 74, 1, 75, 1, 75, 1, 75, 1, 75, 
-	1, 76, 1, 76, 1, 76, 1, 76, 5, 76, 711, 8, 76, 10, 76, 12, 76, 714, 9, 
-	76, 1, 76, 1, 76, 1, 76, 1, 76, 3, 76, 720, 8, 76, 1, 77, 1, 77, 1, 77, 
-	1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 3, 77, 730, 8, 77, 1, 78, 3, 78, 733, 
-	8, 78, 1, 78, 1, 78, 1, 79, 3, 79, 738, 8, 79, 1, 79, 1, 79, 1, 80, 1, 
-	80, 1, 80, 1, 81, 1, 81, 1, 82, 1, 82, 1, 83, 1, 83, 1, 84, 1, 84, 3, 84, 
-	753, 8, 84, 1, 84, 1, 84, 1, 84, 3, 84, 758, 8, 84, 5, 84, 760, 8, 84, 
-	10, 84, 12, 84, 763, 9, 84, 1, 85, 1, 85, 1, 85, 0, 3, 86, 118, 128, 86, 
-	0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 
-	38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 
-	74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 
-	108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134, 136, 
-	138, 140, 142, 144, 146, 148, 150, 152, 154, 156, 158, 160, 162, 164, 166, 
-	168, 170, 0, 10, 1, 0, 29, 30, 1, 0, 22, 23, 1, 0, 58, 59, 2, 0, 61, 62, 
-	125, 126, 1, 0, 64, 65, 2, 0, 66, 66, 109, 109, 1, 0, 93, 99, 1, 0, 83, 
-	92, 1, 0, 118, 119, 1, 0, 6, 99, 790, 0, 181, 1, 0, 0, 0, 2, 183, 1, 0, 
-	0, 0, 4, 205, 1, 0, 0, 0, 6, 207, 1, 0, 0, 0, 8, 210, 1, 0, 0, 0, 10, 213, 
-	1, 0, 0, 0, 12, 220, 1, 0, 0, 0, 14, 223, 1, 0, 0, 0, 16, 227, 1, 0, 0, 
-	0, 18, 235, 1, 0, 0, 0, 20, 243, 1, 0, 0, 0, 22, 258, 1, 0, 0, 0, 24, 262, 
-	1, 0, 0, 0, 26, 274, 1, 0, 0, 0, 28, 280, 1, 0, 0, 0, 30, 293, 1, 0, 0, 
-	0, 32, 297, 1, 0, 0, 0, 34, 300, 1, 0, 0, 0, 36, 304, 1, 0, 0, 0, 38, 308, 
-	1, 0, 0, 0, 40, 311, 1, 0, 0, 0, 42, 322, 1, 0, 0, 0, 44, 337, 1, 0, 0, 
-	0, 46, 341, 1, 0, 0, 0, 48, 346, 1, 0, 0, 0, 50, 360, 1, 0, 0, 0, 52, 362, 
-	1, 0, 0, 0, 54, 364, 1, 0, 0, 0, 56, 366, 1, 0, 0, 0, 58, 368, 1, 0, 0, 
-	0, 60, 370, 1, 0, 0, 0, 62, 373, 1, 0, 0, 0, 64, 397, 1, 0, 0, 0, 66, 399, 
-	1, 0, 0, 0, 68, 402, 1, 0, 0, 0, 70, 410, 1, 0, 0, 0, 72, 414, 1, 0, 0, 
-	0, 74, 417, 1, 0, 0, 0, 76, 421, 1, 0, 0, 0, 78, 425, 1, 0, 0, 0, 80, 429, 
-	1, 0, 0, 0, 82, 435, 1, 0, 0, 0, 84, 448, 1, 0, 0, 0, 86, 478, 1, 0, 0, 
-	0, 88, 488, 1, 0, 0, 0, 90, 496, 1, 0, 0, 0, 92, 502, 1, 0, 0, 0, 94, 510, 
-	1, 0, 0, 0, 96, 515, 1, 0, 0, 0, 98, 521, 1, 0, 0, 0, 100, 525, 1, 0, 0, 
-	0, 102, 532, 1, 0, 0, 0, 104, 545, 1, 0, 0, 0, 106, 559, 1, 0, 0, 0, 108, 
-	561, 1, 0, 0, 0, 110, 563, 1, 0, 0, 0, 112, 567, 1, 0, 0, 0, 114, 574, 
-	1, 0, 0, 0, 116, 582, 1, 0, 0, 0, 118, 591, 1, 0, 0, 0, 120, 602, 1, 0, 
-	0, 0, 122, 604, 1, 0, 0, 0, 124, 606, 1, 0, 0, 0, 126, 618, 1, 0, 0, 0, 
-	128, 628, 1, 0, 0, 0, 130, 647, 1, 0, 0, 0, 132, 650, 1, 0, 0, 0, 134, 
-	652, 1, 0, 0, 0, 136, 659, 1, 0, 0, 0, 138, 661, 1, 0, 0, 0, 140, 671, 
-	1, 0, 0, 0, 142, 679, 1, 0, 0, 0, 144, 681, 1, 0, 0, 0, 146, 685, 1, 0, 
-	0, 0, 148, 700, 1, 0, 0, 0, 150, 702, 1, 0, 0, 0, 152, 719, 1, 0, 0, 0, 
-	154, 729, 1, 0, 0, 0, 156, 732, 1, 0, 0, 0, 158, 737, 1, 0, 0, 0, 160, 
-	741, 1, 0, 0, 0, 162, 744, 1, 0, 0, 0, 164, 746, 1, 0, 0, 0, 166, 748, 
-	1, 0, 0, 0, 168, 752, 1, 0, 0, 0, 170, 764, 1, 0, 0, 0, 172, 182, 3, 4, 
-	2, 0, 173, 182, 3, 30, 15, 0, 174, 182, 3, 2, 1, 0, 175, 182, 3, 62, 31, 
-	0, 176, 182, 3, 34, 17, 0, 177, 182, 3, 36, 18, 0, 178, 179, 3, 168, 84, 
-	0, 179, 180, 5, 0, 0, 1, 180, 182, 1, 0, 0, 0, 181, 172, 1, 0, 0, 0, 181, 
-	173, 1, 0, 0, 0, 181, 174, 1, 0, 0, 0, 181, 175, 1, 0, 0, 0, 181, 176, 
-	1, 0, 0, 0, 181, 177, 1, 0, 0, 0, 181, 178, 1, 0, 0, 0, 182, 1, 1, 0, 0, 
-	0, 183, 184, 5, 21, 0, 0, 184, 185, 3, 168, 84, 0, 185, 3, 1, 0, 0, 0, 
-	186, 206, 3, 6, 3, 0, 187, 206, 3, 14, 7, 0, 188, 206, 3, 16, 8, 0, 189, 
-	206, 3, 18, 9, 0, 190, 206, 3, 20, 10, 0, 191, 206, 3, 12, 6, 0, 192, 206, 
-	3, 22, 11, 0, 193, 206, 3, 26, 13, 0, 194, 206, 3, 28, 14, 0, 195, 206, 
-	3, 24, 12, 0, 196, 206, 3, 32, 16, 0, 197, 206, 3, 38, 19, 0, 198, 206, 
-	3, 40, 20, 0, 199, 206, 3, 42, 21, 0, 200, 206, 3, 44, 22, 0, 201, 206, 
-	3, 46, 23, 0, 202, 206, 3, 48, 24, 0, 203, 206, 3, 8, 4, 0, 204, 206, 3, 
-	10, 5, 0, 205, 186, 1, 0, 0, 0, 205, 187, 1, 0, 0, 0, 205, 188, 1, 0, 0, 
-	0, 205, 189, 1, 0, 0, 0, 205, 190, 1, 0, 0, 0, 205, 191, 1, 0, 0, 0, 205, 
-	192, 1, 0, 0, 0, 205, 193, 1, 0, 0, 0, 205, 194, 1, 0, 0, 0, 205, 195, 
-	1, 0, 0, 0, 205, 196, 1, 0, 0, 0, 205, 197, 1, 0, 0, 0, 205, 198, 1, 0, 
-	0, 0, 205, 199, 1, 0, 0, 0, 205, 200, 1, 0, 0, 0, 205, 201, 1, 0, 0, 0, 
-	205, 202, 1, 0, 0, 0, 205, 203, 1, 0, 0, 0, 205, 204, 1, 0, 0, 0, 206, 
-	5, 1, 0, 0, 0, 207, 208, 5, 20, 0, 0, 208, 209, 5, 24, 0, 0, 209, 7, 1, 
-	0, 0, 0, 210, 211, 5, 20, 0, 0, 211, 212, 5, 80, 0, 0, 212, 9, 1, 0, 0, 
-	0, 213, 214, 5, 20, 0, 0, 214, 215, 5, 81, 0, 0, 215, 216, 5, 50, 0, 0, 
-	216, 217, 5, 82, 0, 0, 217, 218, 5, 102, 0, 0, 218, 219, 3, 58, 29, 0, 
-	219, 11, 1, 0, 0, 0, 220, 221, 5, 20, 0, 0, 221, 222, 5, 28, 0, 0, 222, 
-	13, 1, 0, 0, 0, 223, 224, 5, 20, 0, 0, 224, 225, 5, 25, 0, 0, 225, 226, 
-	5, 26, 0, 0, 226, 15, 1, 0, 0, 0, 227, 228, 5, 20, 0, 0, 228, 229, 5, 30, 
-	0, 0, 229, 230, 5, 25, 0, 0, 230, 231, 5, 49, 0, 0, 231, 232, 3, 60, 30, 
-	0, 232, 233, 5, 50, 0, 0, 233, 234, 3, 78, 39, 0, 234, 17, 1, 0, 0, 0, 
-	235, 236, 5, 20, 0, 0, 236, 237, 5, 24, 0, 0, 237, 238, 5, 25, 0, 0, 238, 
-	239, 5, 49, 0, 0, 239, 240, 3, 60, 30, 0, 240, 241, 5, 50, 0, 0, 241, 242, 
-	3, 78, 39, 0, 242, 19, 1, 0, 0, 0, 243, 244, 5, 20, 0, 0, 244, 245, 5, 
-	29, 0, 0, 245, 246, 5, 25, 0, 0, 246, 247, 5, 49, 0, 0, 247, 248, 3, 60, 
-	30, 0, 248, 251, 5, 50, 0, 0, 249, 252, 3, 74, 37, 0, 250, 252, 3, 78, 
-	39, 0, 251, 249, 1, 0, 0, 0, 251, 250, 1, 0, 0, 0, 252, 253, 1, 0, 0, 0, 
-	253, 256, 5, 58, 0, 0, 254, 257, 3, 74, 37, 0, 255, 257, 3, 78, 39, 0, 
-	256, 254, 1, 0, 0, 0, 256, 255, 1, 0, 0, 0, 257, 21, 1, 0, 0, 0, 258, 259, 
-	5, 20, 0, 0, 259, 260, 7, 0, 0, 0, 260, 261, 5, 31, 0, 0, 261, 23, 1, 0, 
-	0, 0, 262, 263, 5, 20, 0, 0, 263, 264, 5, 13, 0, 0, 264, 267, 5, 50, 0, 
-	0, 265, 268, 3, 74, 37, 0, 266, 268, 3, 76, 38, 0, 267, 265, 1, 0, 0, 0, 
-	267, 266, 1, 0, 0, 0, 268, 269, 1, 0, 0, 0, 269, 272, 5, 58, 0, 0, 270, 
-	273, 3, 74, 37, 0, 271, 273, 3, 76, 38, 0, 272, 270, 1, 0, 0, 0, 272, 271, 
-	1, 0, 0, 0, 273, 25, 1, 0, 0, 0, 274, 275, 5, 20, 0, 0, 275, 276, 5, 30, 
-	0, 0, 276, 277, 5, 39, 0, 0, 277, 278, 5, 50, 0, 0, 278, 279, 3, 90, 45, 
-	0, 279, 27, 1, 0, 0, 0, 280, 281, 5, 20, 0, 0, 281, 282, 5, 29, 0, 0, 282, 
-	283, 5, 39, 0, 0, 283, 286, 5, 50, 0, 0, 284, 287, 3, 74, 37, 0, 285, 287, 
-	3, 90, 45, 0, 286, 284, 1, 0, 0, 0, 286, 285, 1, 0, 0, 0, 287, 288, 1, 
-	0, 0, 0, 288, 291, 5, 58, 0, 0, 289, 292, 3, 74, 37, 0, 290, 292, 3, 90, 
-	45, 0, 291, 289, 1, 0, 0, 0, 291, 290, 1, 0, 0, 0, 292, 29, 1, 0, 0, 0, 
-	293, 294, 5, 6, 0, 0, 294, 295, 5, 29, 0, 0, 295, 296, 3, 146, 73, 0, 296, 
-	31, 1, 0, 0, 0, 297, 298, 5, 20, 0, 0, 298, 299, 5, 32, 0, 0, 299, 33, 
-	1, 0, 0, 0, 300, 301, 5, 6, 0, 0, 301, 302, 5, 33, 0, 0, 302, 303, 3, 146, 
-	73, 0, 303, 35, 1, 0, 0, 0, 304, 305, 5, 9, 0, 0, 305, 306, 5, 33, 0, 0, 
-	306, 307, 3, 56, 28, 0, 307, 37, 1, 0, 0, 0, 308, 309, 5, 20, 0, 0, 309, 
-	310, 5, 34, 0, 0, 310, 39, 1, 0, 0, 0, 311, 312, 5, 20, 0, 0, 312, 317, 
-	5, 36, 0, 0, 313, 314, 5, 50, 0, 0, 314, 315, 5, 35, 0, 0, 315, 316, 5, 
-	102, 0, 0, 316, 318, 3, 50, 25, 0, 317, 313, 1, 0, 0, 0, 317, 318, 1, 0, 
-	0, 0, 318, 320, 1, 0, 0, 0, 319, 321, 3, 160, 80, 0, 320, 319, 1, 0, 0, 
-	0, 320, 321, 1, 0, 0, 0, 321, 41, 1, 0, 0, 0, 322, 323, 5, 20, 0, 0, 323, 
-	326, 5, 38, 0, 0, 324, 325, 5, 19, 0, 0, 325, 327, 3, 54, 27, 0, 326, 324, 
-	1, 0, 0, 0, 326, 327, 1, 0, 0, 0, 327, 332, 1, 0, 0, 0, 328, 329, 5, 50, 
-	0, 0, 329, 330, 5, 39, 0, 0, 330, 331, 5, 102, 0, 0, 331, 333, 3, 50, 25, 
-	0, 332, 328, 1, 0, 0, 0, 332, 333, 1, 0, 0, 0, 333, 335, 1, 0, 0, 0, 334, 
-	336, 3, 160, 80, 0, 335, 334, 1, 0, 0, 0, 335, 336, 1, 0, 0, 0, 336, 43, 
-	1, 0, 0, 0, 337, 338, 5, 20, 0, 0, 338, 339, 5, 41, 0, 0, 339, 340, 3, 
-	80, 40, 0, 340, 45, 1, 0, 0, 0, 341, 342, 5, 20, 0, 0, 342, 343, 5, 42, 
-	0, 0, 343, 344, 5, 44, 0, 0, 344, 345, 3, 80, 40, 0, 345, 47, 1, 0, 0, 
-	0, 346, 347, 5, 20, 0, 0, 347, 348, 5, 42, 0, 0, 348, 349, 5, 47, 0, 0, 
-	349, 350, 3, 80, 40, 0, 350, 351, 5, 46, 0, 0, 351, 352, 5, 45, 0, 0, 352, 
-	353, 5, 102, 0, 0, 353, 355, 3, 52, 26, 0, 354, 356, 3, 82, 41, 0, 355, 
-	354, 1, 0, 0, 0, 355, 356, 1, 0, 0, 0, 356, 358, 1, 0, 0, 0, 357, 359, 
-	3, 160, 80, 0, 358, 357, 1, 0, 0, 0, 358, 359, 1, 0, 0, 0, 359, 49, 1, 
-	0, 0, 0, 360, 361, 3, 168, 84, 0, 361, 51, 1, 0, 0, 0, 362, 363, 3, 168, 
-	84, 0, 363, 53, 1, 0, 0, 0, 364, 365, 3, 168, 84, 0, 365, 55, 1, 0, 0, 
-	0, 366, 367, 3, 168, 84, 0, 367, 57, 1, 0, 0, 0, 368, 369, 3, 168, 84, 
-	0, 369, 59, 1, 0, 0, 0, 370, 371, 7, 1, 0, 0, 371, 61, 1, 0, 0, 0, 372, 
-	374, 5, 54, 0, 0, 373, 372, 1, 0, 0, 0, 373, 374, 1, 0, 0, 0, 374, 375, 
-	1, 0, 0, 0, 375, 377, 3, 64, 32, 0, 376, 378, 3, 82, 41, 0, 377, 376, 1, 
-	0, 0, 0, 377, 378, 1, 0, 0, 0, 378, 380, 1, 0, 0, 0, 379, 381, 3, 102, 
-	51, 0, 380, 379, 1, 0, 0, 0, 380, 381, 1, 0, 0, 0, 381, 383, 1, 0, 0, 0, 
-	382, 384, 3, 110, 55, 0, 383, 382, 1, 0, 0, 0, 383, 384, 1, 0, 0, 0, 384, 
-	386, 1, 0, 0, 0, 385, 387, 3, 160, 80, 0, 386, 385, 1, 0, 0, 0, 386, 387, 
-	1, 0, 0, 0, 387, 389, 1, 0, 0, 0, 388, 390, 5, 55, 0, 0, 389, 388, 1, 0, 
-	0, 0, 389, 390, 1, 0, 0, 0, 390, 63, 1, 0, 0, 0, 391, 392, 3, 66, 33, 0, 
-	392, 393, 3, 80, 40, 0, 393, 398, 1, 0, 0, 0, 394, 395, 3, 80, 40, 0, 395, 
-	396, 3, 66, 33, 0, 396, 398, 1, 0, 0, 0, 397, 391, 1, 0, 0, 0, 397, 394, 
-	1, 0, 0, 0, 398, 65, 1, 0, 0, 0, 399, 400, 5, 56, 0, 0, 400, 401, 3, 68, 
-	34, 0, 401, 67, 1, 0, 0, 0, 402, 407, 3, 70, 35, 0, 403, 404, 5, 111, 0, 
-	0, 404, 406, 3, 70, 35, 0, 405, 403, 1, 0, 0, 0, 406, 409, 1, 0, 0, 0, 
-	407, 405, 1, 0, 0, 0, 407, 408, 1, 0, 0, 0, 408, 69, 1, 0, 0, 0, 409, 407, 
-	1, 0, 0, 0, 410, 412, 3, 128, 64, 0, 411, 413, 3, 72, 36, 0, 412, 411, 
-	1, 0, 0, 0, 412, 413, 1, 0, 0, 0, 413, 71, 1, 0, 0, 0, 414, 415, 5, 57, 
-	0, 0, 415, 416, 3, 168, 84, 0, 416, 73, 1, 0, 0, 0, 417, 418, 5, 29, 0, 
-	0, 418, 419, 5, 102, 0, 0, 419, 420, 3, 168, 84, 0, 420, 75, 1, 0, 0, 0, 
-	421, 422, 5, 33, 0, 0, 422, 423, 5, 102, 0, 0, 423, 424, 3, 168, 84, 0, 
-	424, 77, 1, 0, 0, 0, 425, 426, 5, 27, 0, 0, 426, 427, 5, 102, 0, 0, 427, 
-	428, 3, 168, 84, 0, 428, 79, 1, 0, 0, 0, 429, 430, 5, 49, 0, 0, 430, 433, 
-	3, 162, 81, 0, 431, 432, 5, 19, 0, 0, 432, 434, 3, 54, 27, 0, 433, 431, 
-	1, 0, 0, 0, 433, 434, 1, 0, 0, 0, 434, 81, 1, 0, 0, 0, 435, 436, 5, 50, 
-	0, 0, 436, 437, 3, 84, 42, 0, 437, 83, 1, 0, 0, 0, 438, 449, 3, 86, 43, 
-	0, 439, 440, 3, 86, 43, 0, 440, 441, 5, 58, 0, 0, 441, 442, 3, 94, 47, 
-	0, 442, 449, 1, 0, 0, 0, 443, 446, 3, 94, 47, 0, 444, 445, 5, 58, 0, 0, 
-	445, 447, 3, 86, 43, 0, 446, 444, 1, 0, 0, 0, 446, 447, 1, 0, 0, 0, 447, 
-	449, 1, 0, 0, 0, 448, 438, 1, 0, 0, 0, 448, 439, 1, 0, 0, 0, 448, 443, 
-	1, 0, 0, 0, 449, 85, 1, 0, 0, 0, 450, 451, 6, 43, -1, 0, 451, 452, 5, 116, 
-	0, 0, 452, 453, 3, 86, 43, 0, 453, 454, 5, 117, 0, 0, 454, 479, 1, 0, 0, 
-	0, 455, 464, 3, 164, 82, 0, 456, 465, 5, 102, 0, 0, 457, 465, 5, 66, 0, 
-	0, 458, 459, 5, 67, 0, 0, 459, 465, 5, 66, 0, 0, 460, 465, 5, 109, 0, 0, 
-	461, 465, 5, 110, 0, 0, 462, 465, 5, 103, 0, 0, 463, 465, 5, 104, 0, 0, 
-	464, 456, 1, 0, 0, 0, 464, 457, 1, 0, 0, 0, 464, 458, 1, 0, 0, 0, 464, 
-	460, 1, 0, 0, 0, 464, 461, 1, 0, 0, 0, 464, 462, 1, 0, 0, 0, 464, 463, 
-	1, 0, 0, 0, 465, 466, 1, 0, 0, 0, 466, 467, 3, 166, 83, 0, 467, 479, 1, 
-	0, 0, 0, 468, 472, 3, 164, 82, 0, 469, 473, 5, 77, 0, 0, 470, 471, 5, 67, 
-	0, 0, 471, 473, 5, 77, 0, 0, 472, 469, 1, 0, 0, 0, 472, 470, 1, 0, 0, 0, 
-	473, 474, 1, 0, 0, 0, 474, 475, 5, 116, 0, 0, 475, 476, 3, 88, 44, 0, 476, 
-	477, 5, 117, 0, 0, 477, 479, 1, 0, 0, 0, 478, 450, 1, 0, 0, 0, 478, 455, 
-	1, 0, 0, 0, 478, 468, 1, 0, 0, 0, 479, 485, 1, 0, 0, 0, 480, 481, 10, 1, 
-	0, 0, 481, 482, 7, 2, 0, 0, 482, 484, 3, 86, 43, 2, 483, 480, 1, 0, 0, 
-	0, 484, 487, 1, 0, 0, 0, 485, 483, 1, 0, 0, 0, 485, 486, 1, 0, 0, 0, 486, 
-	87, 1, 0, 0, 0, 487, 485, 1, 0, 0, 0, 488, 493, 3, 166, 83, 0, 489, 490, 
-	5, 111, 0, 0, 490, 492, 3, 166, 83, 0, 491, 489, 1, 0, 0, 0, 492, 495, 
-	1, 0, 0, 0, 493, 491, 1, 0, 0, 0, 493, 494, 1, 0, 0, 0, 494, 89, 1, 0, 
-	0, 0, 495, 493, 1, 0, 0, 0, 496, 497, 5, 39, 0, 0, 497, 498, 5, 77, 0, 
-	0, 498, 499, 5, 116, 0, 0, 499, 500, 3, 92, 46, 0, 500, 501, 5, 117, 0, 
-	0, 501, 91, 1, 0, 0, 0, 502, 507, 3, 168, 84, 0, 503, 504, 5, 111, 0, 0, 
-	504, 506, 3, 168, 84, 0, 505, 503, 1, 0, 0, 0, 506, 509, 1, 0, 0, 0, 507, 
-	505, 1, 0, 0, 0, 507, 508, 1, 0, 0, 0, 508, 93, 1, 0, 0, 0, 509, 507, 1, 
-	0, 0, 0, 510, 513, 3, 96, 48, 0, 511, 512, 5, 58, 0, 0, 512, 514, 3, 96, 
-	48, 0, 513, 511, 1, 0, 0, 0, 513, 514, 1, 0, 0, 0, 514, 95, 1, 0, 0, 0, 
-	515, 516, 5, 75, 0, 0, 516, 519, 3, 126, 63, 0, 517, 520, 3, 98, 49, 0, 
-	518, 520, 3, 168, 84, 0, 519, 517, 1, 0, 0, 0, 519, 518, 1, 0, 0, 0, 520, 
-	97, 1, 0, 0, 0, 521, 523, 3, 100, 50, 0, 522, 524, 3, 130, 65, 0, 523, 
-	522, 1, 0, 0, 0, 523, 524, 1, 0, 0, 0, 524, 99, 1, 0, 0, 0, 525, 526, 5, 
-	76, 0, 0, 526, 528, 5, 116, 0, 0, 527, 529, 3, 138, 69, 0, 528, 527, 1, 
-	0, 0, 0, 528, 529, 1, 0, 0, 0, 529, 530, 1, 0, 0, 0, 530, 531, 5, 117, 
-	0, 0, 531, 101, 1, 0, 0, 0, 532, 533, 5, 70, 0, 0, 533, 534, 5, 72, 0, 
-	0, 534, 540, 3, 104, 52, 0, 535, 536, 5, 60, 0, 0, 536, 537, 5, 116, 0, 
-	0, 537, 538, 3, 108, 54, 0, 538, 539, 5, 117, 0, 0, 539, 541, 1, 0, 0, 
-	0, 540, 535, 1, 0, 0, 0, 540, 541, 1, 0, 0, 0, 541, 543, 1, 0, 0, 0, 542, 
-	544, 3, 116, 58, 0, 543, 542, 1, 0, 0, 0, 543, 544, 1, 0, 0, 0, 544, 103, 
-	1, 0, 0, 0, 545, 550, 3, 106, 53, 0, 546, 547, 5, 111, 0, 0, 547, 549, 
-	3, 106, 53, 0, 548, 546, 1, 0, 0, 0, 549, 552, 1, 0, 0, 0, 550, 548, 1, 
-	0, 0, 0, 550, 551, 1, 0, 0, 0, 551, 105, 1, 0, 0, 0, 552, 550, 1, 0, 0, 
-	0, 553, 560, 3, 168, 84, 0, 554, 555, 5, 75, 0, 0, 555, 556, 5, 116, 0, 
-	0, 556, 557, 3, 130, 65, 0, 557, 558, 5, 117, 0, 0, 558, 560, 1, 0, 0, 
-	0, 559, 553, 1, 0, 0, 0, 559, 554, 1, 0, 0, 0, 560, 107, 1, 0, 0, 0, 561, 
-	562, 7, 3, 0, 0, 562, 109, 1, 0, 0, 0, 563, 564, 5, 63, 0, 0, 564, 565, 
-	5, 72, 0, 0, 565, 566, 3, 114, 57, 0, 566, 111, 1, 0, 0, 0, 567, 571, 3, 
-	128, 64, 0, 568, 570, 7, 4, 0, 0, 569, 568, 1, 0, 0, 0, 570, 573, 1, 0, 
-	0, 0, 571, 569, 1, 0, 0, 0, 571, 572, 1, 0, 0, 0, 572, 113, 1, 0, 0, 0, 
-	573, 571, 1, 0, 0, 0, 574, 579, 3, 112, 56, 0, 575, 576, 5, 111, 0, 0, 
-	576, 578, 3, 112, 56, 0, 577, 575, 1, 0, 0, 0, 578, 581, 1, 0, 0, 0, 579, 
-	577, 1, 0, 0, 0, 579, 580, 1, 0, 0, 0, 580, 115, 1, 0, 0, 0, 581, 579, 
-	1, 0, 0, 0, 582, 583, 5, 71, 0, 0, 583, 584, 3, 118, 59, 0, 584, 117, 1, 
-	0, 0, 0, 585, 586, 6, 59, -1, 0, 586, 587, 5, 116, 0, 0, 587, 588, 3, 118, 
-	59, 0, 588, 589, 5, 117, 0, 0, 589, 592, 1, 0, 0, 0, 590, 592, 3, 122, 
-	61, 0, 591, 585, 1, 0, 0, 0, 591, 590, 1, 0, 0, 0, 592, 599, 1, 0, 0, 0, 
-	593, 594, 10, 2, 0, 0, 594, 595, 3, 120, 60, 0, 595, 596, 3, 118, 59, 3, 
-	596, 598, 1, 0, 0, 0, 597, 593, 1, 0, 0, 0, 598, 601, 1, 0, 0, 0, 599, 
-	597, 1, 0, 0, 0, 599, 600, 1, 0, 0, 0, 600, 119, 1, 0, 0, 0, 601, 599, 
-	1, 0, 0, 0, 602, 603, 7, 2, 0, 0, 603, 121, 1, 0, 0, 0, 604, 605, 3, 124, 
-	62, 0, 605, 123, 1, 0, 0, 0, 606, 607, 3, 128, 64, 0, 607, 608, 3, 126, 
-	63, 0, 608, 609, 3, 128, 64, 0, 609, 125, 1, 0, 0, 0, 610, 619, 5, 102, 
-	0, 0, 611, 619, 5, 103, 0, 0, 612, 619, 5, 104, 0, 0, 613, 619, 5, 107, 
-	0, 0, 614, 619, 5, 108, 0, 0, 615, 619, 5, 105, 0, 0, 616, 619, 5, 106, 
-	0, 0, 617, 619, 7, 5, 0, 0, 618, 610, 1, 0, 0, 0, 618, 611, 1, 0, 0, 0, 
-	618, 612, 1, 0, 0, 0, 618, 613, 1, 0, 0, 0, 618, 614, 1, 0, 0, 0, 618, 
-	615, 1, 0, 0, 0, 618, 616, 1, 0, 0, 0, 618, 617, 1, 0, 0, 0, 619, 127, 
-	1, 0, 0, 0, 620, 621, 6, 64, -1, 0, 621, 622, 5, 116, 0, 0, 622, 623, 3, 
-	128, 64, 0, 623, 624, 5, 117, 0, 0, 624, 629, 1, 0, 0, 0, 625, 629, 3, 
-	134, 67, 0, 626, 629, 3, 142, 71, 0, 627, 629, 3, 130, 65, 0, 628, 620, 
-	1, 0, 0, 0, 628, 625, 1, 0, 0, 0, 628, 626, 1, 0, 0, 0, 628, 627, 1, 0, 
-	0, 0, 629, 644, 1, 0, 0, 0, 630, 631, 10, 8, 0, 0, 631, 632, 5, 121, 0, 
-	0, 632, 643, 3, 128, 64, 9, 633, 634, 10, 7, 0, 0, 634, 635, 5, 120, 0, 
-	0, 635, 643, 3, 128, 64, 8, 636, 637, 10, 6, 0, 0, 637, 638, 5, 118, 0, 
-	0, 638, 643, 3, 128, 64, 7, 639, 640, 10, 5, 0, 0, 640, 641, 5, 119, 0, 
-	0, 641, 643, 3, 128, 64, 6, 642, 630, 1, 0, 0, 0, 642, 633, 1, 0, 0, 0, 
-	642, 636, 1, 0, 0, 0, 642, 639, 1, 0, 0, 0, 643, 646, 1, 0, 0, 0, 644, 
-	642, 1, 0, 0, 0, 644, 645, 1, 0, 0, 0, 645, 129, 1, 0, 0, 0, 646, 644, 
-	1, 0, 0, 0, 647, 648, 3, 156, 78, 0, 648, 649, 3, 132, 66, 0, 649, 131, 
-	1, 0, 0, 0, 650, 651, 7, 6, 0, 0, 651, 133, 1, 0, 0, 0, 652, 653, 3, 136, 
-	68, 0, 653, 655, 5, 116, 0, 0, 654, 656, 3, 138, 69, 0, 655, 654, 1, 0, 
-	0, 0, 655, 656, 1, 0, 0, 0, 656, 657, 1, 0, 0, 0, 657, 658, 5, 117, 0, 
-	0, 658, 135, 1, 0, 0, 0, 659, 660, 7, 7, 0, 0, 660, 137, 1, 0, 0, 0, 661, 
-	666, 3, 140, 70, 0, 662, 663, 5, 111, 0, 0, 663, 665, 3, 140, 70, 0, 664, 
-	662, 1, 0, 0, 0, 665, 668, 1, 0, 0, 0, 666, 664, 1, 0, 0, 0, 666, 667, 
-	1, 0, 0, 0, 667, 139, 1, 0, 0, 0, 668, 666, 1, 0, 0, 0, 669, 672, 3, 128, 
-	64, 0, 670, 672, 3, 86, 43, 0, 671, 669, 1, 0, 0, 0, 671, 670, 1, 0, 0, 
-	0, 672, 141, 1, 0, 0, 0, 673, 675, 3, 168, 84, 0, 674, 676, 3, 144, 72, 
-	0, 675, 674, 1, 0, 0, 0, 675, 676, 1, 0, 0, 0, 676, 680, 1, 0, 0, 0, 677, 
-	680, 3, 158, 79, 0, 678, 680, 3, 156, 78, 0, 679, 673, 1, 0, 0, 0, 679, 
-	677, 1, 0, 0, 0, 679, 678, 1, 0, 0, 0, 680, 143, 1, 0, 0, 0, 681, 682, 
-	5, 114, 0, 0, 682, 683, 3, 86, 43, 0, 683, 684, 5, 115, 0, 0, 684, 145, 
-	1, 0, 0, 0, 685, 686, 3, 154, 77, 0, 686, 147, 1, 0, 0, 0, 687, 688, 5, 
-	112, 0, 0, 688, 693, 3, 150, 75, 0, 689, 690, 5, 111, 0, 0, 690, 692, 3, 
-	150, 75, 0, 691, 689, 1, 0, 0, 0, 692, 695, 1, 0, 0, 0, 693, 691, 1, 0, 
-	0, 0, 693, 694, 1, 0, 0, 0, 694, 696, 1, 0, 0, 0, 695, 693, 1, 0, 0, 0, 
-	696, 697, 5, 113, 0, 0, 697, 701, 1, 0, 0, 0, 698, 699, 5, 112, 0, 0, 699, 
-	701, 5, 113, 0, 0, 700, 687, 1, 0, 0, 0, 700, 698, 1, 0, 0, 0, 701, 149, 
-	1, 0, 0, 0, 702, 703, 5, 4, 0, 0, 703, 704, 5, 101, 0, 0, 704, 705, 3, 
-	154, 77, 0, 705, 151, 1, 0, 0, 0, 706, 707, 5, 114, 0, 0, 707, 712, 3, 
-	154, 77, 0, 708, 709, 5, 111, 0, 0, 709, 711, 3, 154, 77, 0, 710, 708, 
-	1, 0, 0, 0, 711, 714, 1, 0, 0, 0, 712, 710, 1, 0, 0, 0, 712, 713, 1, 0, 
-	0, 0, 713, 715, 1, 0, 0, 0, 714, 712, 1, 0, 0, 0, 715, 716, 5, 115, 0, 
-	0, 716, 720, 1, 0, 0, 0, 717, 718, 5, 114, 0, 0, 718, 720, 5, 115, 0, 0, 
-	719, 706, 1, 0, 0, 0, 719, 717, 1, 0, 0, 0, 720, 153, 1, 0, 0, 0, 721, 
-	730, 5, 4, 0, 0, 722, 730, 3, 156, 78, 0, 723, 730, 3, 158, 79, 0, 724, 
-	730, 3, 148, 74, 0, 725, 730, 3, 152, 76, 0, 726, 730, 5, 1, 0, 0, 727, 
-	730, 5, 2, 0, 0, 728, 730, 5, 3, 0, 0, 729, 721, 1, 0, 0, 0, 729, 722, 
-	1, 0, 0, 0, 729, 723, 1, 0, 0, 0, 729, 724, 1, 0, 0, 0, 729, 725, 1, 0, 
-	0, 0, 729, 726, 1, 0, 0, 0, 729, 727, 1, 0, 0, 0, 729, 728, 1, 0, 0, 0, 
-	730, 155, 1, 0, 0, 0, 731, 733, 7, 8, 0, 0, 732, 731, 1, 0, 0, 0, 732, 
-	733, 1, 0, 0, 0, 733, 734, 1, 0, 0, 0, 734, 735, 5, 125, 0, 0, 735, 157, 
-	1, 0, 0, 0, 736, 738, 7, 8, 0, 0, 737, 736, 1, 0, 0, 0, 737, 738, 1, 0, 
-	0, 0, 738, 739, 1, 0, 0, 0, 739, 740, 5, 126, 0, 0, 740, 159, 1, 0, 0, 
-	0, 741, 742, 5, 51, 0, 0, 742, 743, 5, 125, 0, 0, 743, 161, 1, 0, 0, 0, 
-	744, 745, 3, 168, 84, 0, 745, 163, 1, 0, 0, 0, 746, 747, 3, 168, 84, 0, 
-	747, 165, 1, 0, 0, 0, 748, 749, 3, 168, 84, 0, 749, 167, 1, 0, 0, 0, 750, 
-	753, 5, 124, 0, 0, 751, 753, 3, 170, 85, 0, 752, 750, 1, 0, 0, 0, 752, 
-	751, 1, 0, 0, 0, 753, 761, 1, 0, 0, 0, 754, 757, 5, 100, 0, 0, 755, 758, 
-	5, 124, 0, 0, 756, 758, 3, 170, 85, 0, 757, 755, 1, 0, 0, 0, 757, 756, 
-	1, 0, 0, 0, 758, 760, 1, 0, 0, 0, 759, 754, 1, 0, 0, 0, 760, 763, 1, 0, 
-	0, 0, 761, 759, 1, 0, 0, 0, 761, 762, 1, 0, 0, 0, 762, 169, 1, 0, 0, 0, 
-	763, 761, 1, 0, 0, 0, 764, 765, 7, 9, 0, 0, 765, 171, 1, 0, 0, 0, 64, 181, 
-	205, 251, 256, 267, 272, 286, 291, 317, 320, 326, 332, 335, 355, 358, 373, 
-	377, 380, 383, 386, 389, 397, 407, 412, 433, 446, 448, 464, 472, 478, 485, 
-	493, 507, 513, 519, 523, 528, 540, 543, 550, 559, 571, 579, 591, 599, 618, 
-	628, 642, 644, 655, 666, 671, 675, 679, 693, 700, 712, 719, 729, 732, 737, 
-	752, 757, 761,
+	1, 2, 1, 2, 3, 2, 258, 8, 2, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 5, 
+	1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 
+	1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 
+	1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 
+	11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 3, 11, 308, 8, 11, 1, 11, 
+	1, 11, 1, 11, 3, 11, 313, 8, 11, 1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 
+	13, 1, 13, 1, 13, 1, 13, 3, 13, 324, 8, 13, 1, 13, 1, 13, 1, 13, 3, 13, 
+	329, 8, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 
+	15, 1, 15, 1, 15, 1, 15, 3, 15, 343, 8, 15, 1, 15, 1, 15, 1, 15, 3, 15, 
+	348, 8, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 355, 8, 16, 1, 16, 
+	3, 16, 358, 8, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 
+	19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 
+	1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 3, 22, 386, 8, 
+	22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 24, 
+	1, 24, 3, 24, 399, 8, 24, 1, 24, 3, 24, 402, 8, 24, 1, 25, 1, 25, 1, 25, 
+	1, 25, 3, 25, 408, 8, 25, 1, 25, 1, 25, 1, 25, 1, 25, 3, 25, 414, 8, 25, 
+	1, 25, 3, 25, 417, 8, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 
+	27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 1, 28, 
+	1, 28, 3, 28, 437, 8, 28, 1, 28, 3, 28, 440, 8, 28, 1, 29, 1, 29, 1, 29, 
+	1, 29, 3, 29, 446, 8, 29, 1, 29, 3, 29, 449, 8, 29, 1, 30, 1, 30, 1, 30, 
+	1, 30, 1, 30, 3, 30, 456, 8, 30, 1, 31, 1, 31, 1, 31, 1, 32, 1, 32, 1, 
+	32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 34, 1, 34, 
+	1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 36, 1, 
+	36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 37, 1, 37, 1, 38, 1, 38, 1, 39, 
+	1, 39, 1, 40, 1, 40, 3, 40, 498, 8, 40, 1, 41, 1, 41, 1, 42, 1, 42, 1, 
+	43, 1, 43, 1, 44, 1, 44, 1, 45, 1, 45, 1, 46, 1, 46, 1, 47, 3, 47, 513, 
+	8, 47, 1, 47, 1, 47, 3, 47, 517, 8, 47, 1, 47, 3, 47, 520, 8, 47, 1, 47, 
+	3, 47, 523, 8, 47, 1, 47, 3, 47, 526, 8, 47, 1, 47, 3, 47, 529, 8, 47, 
+	1, 47, 3, 47, 532, 8, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 3, 
+	48, 540, 8, 48, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 50, 5, 50, 548, 8, 
+	50, 10, 50, 12, 50, 551, 9, 50, 1, 51, 1, 51, 3, 51, 555, 8, 51, 1, 52, 
+	1, 52, 1, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 54, 1, 
+	55, 1, 55, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 3, 56, 575, 8, 56, 1, 56, 
+	3, 56, 578, 8, 56, 1, 56, 1, 56, 1, 56, 3, 56, 583, 8, 56, 1, 56, 3, 56, 
+	586, 8, 56, 5, 56, 588, 8, 56, 10, 56, 12, 56, 591, 9, 56, 1, 56, 1, 56, 
+	3, 56, 595, 8, 56, 1, 57, 1, 57, 1, 57, 1, 58, 1, 58, 1, 58, 1, 59, 1, 
+	59, 1, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 3, 60, 
+	614, 8, 60, 3, 60, 616, 8, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 
+	1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 3, 61, 632, 8, 
+	61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 
+	1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 1, 61, 3, 61, 650, 8, 61, 1, 61, 1, 
+	61, 1, 61, 1, 61, 3, 61, 656, 8, 61, 1, 61, 1, 61, 1, 61, 5, 61, 661, 8, 
+	61, 10, 61, 12, 61, 664, 9, 61, 1, 62, 1, 62, 1, 62, 5, 62, 669, 8, 62, 
+	10, 62, 12, 62, 672, 9, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 1, 
+	64, 1, 64, 1, 64, 5, 64, 683, 8, 64, 10, 64, 12, 64, 686, 9, 64, 1, 65, 
+	1, 65, 1, 65, 3, 65, 691, 8, 65, 1, 66, 1, 66, 1, 66, 1, 66, 3, 66, 697, 
+	8, 66, 1, 67, 1, 67, 3, 67, 701, 8, 67, 1, 68, 1, 68, 1, 68, 3, 68, 706, 
+	8, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 
+	69, 3, 69, 718, 8, 69, 1, 69, 3, 69, 721, 8, 69, 1, 70, 1, 70, 1, 70, 5, 
+	70, 726, 8, 70, 10, 70, 12, 70, 729, 9, 70, 1, 71, 1, 71, 1, 71, 1, 71, 
+	1, 71, 1, 71, 3, 71, 737, 8, 71, 1, 72, 1, 72, 1, 72, 3, 72, 742, 8, 72, 
+	1, 72, 3, 72, 745, 8, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 74, 1, 74, 5, 
+	74, 753, 8, 74, 10, 74, 12, 74, 756, 9, 74, 1, 75, 1, 75, 1, 75, 5, 75, 
+	761, 8, 75, 10, 75, 12, 75, 764, 9, 75, 1, 76, 1, 76, 1, 76, 1, 77, 1, 
+	77, 1, 77, 1, 77, 1, 77, 1, 77, 3, 77, 775, 8, 77, 1, 77, 1, 77, 1, 77, 
+	1, 77, 5, 77, 781, 8, 77, 10, 77, 12, 77, 784, 9, 77, 1, 78, 1, 78, 1, 
+	79, 1, 79, 1, 80, 1, 80, 1, 80, 1, 80, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 
+	1, 81, 1, 81, 1, 81, 3, 81, 802, 8, 81, 1, 82, 1, 82, 1, 82, 1, 82, 1, 
+	82, 1, 82, 1, 82, 1, 82, 3, 82, 812, 8, 82, 1, 82, 1, 82, 1, 82, 1, 82, 
+	1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 82, 5, 82, 826, 8, 
+	82, 10, 82, 12, 82, 829, 9, 82, 1, 83, 1, 83, 1, 83, 1, 84, 1, 84, 1, 85, 
+	1, 85, 1, 85, 3, 85, 839, 8, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1, 87, 1, 
+	87, 1, 87, 5, 87, 848, 8, 87, 10, 87, 12, 87, 851, 9, 87, 1, 88, 1, 88, 
+	3, 88, 855, 8, 88, 1, 89, 1, 89, 3, 89, 859, 8, 89, 1, 89, 1, 89, 3, 89, 
+	863, 8, 89, 1, 90, 1, 90, 1, 90, 1, 90, 1, 91, 1, 91, 1, 92, 1, 92, 1, 
+	92, 1, 92, 5, 92, 875, 8, 92, 10, 92, 12, 92, 878, 9, 92, 1, 92, 1, 92, 
+	1, 92, 1, 92, 3, 92, 884, 8, 92, 1, 93, 1, 93, 1, 93, 1, 93, 1, 94, 1, 
+	94, 1, 94, 1, 94, 5, 94, 894, 8, 94, 10, 94, 12, 94, 897, 9, 94, 1, 94, 
+	1, 94, 1, 94, 1, 94, 3, 94, 903, 8, 94, 1, 95, 1, 95, 1, 95, 1, 95, 1, 
+	95, 1, 95, 1, 95, 1, 95, 3, 95, 913, 8, 95, 1, 96, 3, 96, 916, 8, 96, 1, 
+	96, 1, 96, 1, 97, 3, 97, 921, 8, 97, 1, 97, 1, 97, 1, 98, 1, 98, 1, 98, 
+	1, 99, 1, 99, 1, 99, 1, 100, 1, 100, 1, 101, 1, 101, 1, 102, 1, 102, 1, 
+	103, 1, 103, 3, 103, 939, 8, 103, 1, 103, 3, 103, 942, 8, 103, 1, 104, 
+	1, 104, 3, 104, 946, 8, 104, 1, 104, 1, 104, 1, 104, 3, 104, 951, 8, 104, 
+	5, 104, 953, 8, 104, 10, 104, 12, 104, 956, 9, 104, 1, 105, 1, 105, 1, 
+	105, 0, 3, 122, 154, 164, 106, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 
+	24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 
+	60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 
+	96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 
+	126, 128, 130, 132, 134, 136, 138, 140, 142, 144, 146, 148, 150, 152, 154, 
+	156, 158, 160, 162, 164, 166, 168, 170, 172, 174, 176, 178, 180, 182, 184, 
+	186, 188, 190, 192, 194, 196, 198, 200, 202, 204, 206, 208, 210, 0, 12, 
+	1, 0, 30, 31, 1, 0, 95, 97, 1, 0, 23, 24, 1, 0, 129, 132, 1, 0, 60, 61, 
+	1, 0, 149, 150, 1, 0, 66, 67, 2, 0, 68, 68, 133, 133, 1, 0, 117, 123, 1, 
+	0, 98, 116, 1, 0, 142, 143, 1, 0, 5, 123, 995, 0, 229, 1, 0, 0, 0, 2, 231, 
+	1, 0, 0, 0, 4, 257, 1, 0, 0, 0, 6, 259, 1, 0, 0, 0, 8, 262, 1, 0, 0, 0, 
+	10, 265, 1, 0, 0, 0, 12, 272, 1, 0, 0, 0, 14, 276, 1, 0, 0, 0, 16, 279, 
+	1, 0, 0, 0, 18, 283, 1, 0, 0, 0, 20, 291, 1, 0, 0, 0, 22, 299, 1, 0, 0, 
+	0, 24, 314, 1, 0, 0, 0, 26, 318, 1, 0, 0, 0, 28, 330, 1, 0, 0, 0, 30, 336, 
+	1, 0, 0, 0, 32, 349, 1, 0, 0, 0, 34, 359, 1, 0, 0, 0, 36, 363, 1, 0, 0, 
+	0, 38, 366, 1, 0, 0, 0, 40, 370, 1, 0, 0, 0, 42, 374, 1, 0, 0, 0, 44, 380, 
+	1, 0, 0, 0, 46, 389, 1, 0, 0, 0, 48, 392, 1, 0, 0, 0, 50, 403, 1, 0, 0, 
+	0, 52, 418, 1, 0, 0, 0, 54, 422, 1, 0, 0, 0, 56, 427, 1, 0, 0, 0, 58, 441, 
+	1, 0, 0, 0, 60, 450, 1, 0, 0, 0, 62, 457, 1, 0, 0, 0, 64, 460, 1, 0, 0, 
+	0, 66, 467, 1, 0, 0, 0, 68, 471, 1, 0, 0, 0, 70, 475, 1, 0, 0, 0, 72, 482, 
+	1, 0, 0, 0, 74, 489, 1, 0, 0, 0, 76, 491, 1, 0, 0, 0, 78, 493, 1, 0, 0, 
+	0, 80, 497, 1, 0, 0, 0, 82, 499, 1, 0, 0, 0, 84, 501, 1, 0, 0, 0, 86, 503, 
+	1, 0, 0, 0, 88, 505, 1, 0, 0, 0, 90, 507, 1, 0, 0, 0, 92, 509, 1, 0, 0, 
+	0, 94, 512, 1, 0, 0, 0, 96, 539, 1, 0, 0, 0, 98, 541, 1, 0, 0, 0, 100, 
+	544, 1, 0, 0, 0, 102, 552, 1, 0, 0, 0, 104, 556, 1, 0, 0, 0, 106, 559, 
+	1, 0, 0, 0, 108, 563, 1, 0, 0, 0, 110, 567, 1, 0, 0, 0, 112, 571, 1, 0, 
+	0, 0, 114, 596, 1, 0, 0, 0, 116, 599, 1, 0, 0, 0, 118, 602, 1, 0, 0, 0, 
+	120, 615, 1, 0, 0, 0, 122, 655, 1, 0, 0, 0, 124, 665, 1, 0, 0, 0, 126, 
+	673, 1, 0, 0, 0, 128, 679, 1, 0, 0, 0, 130, 687, 1, 0, 0, 0, 132, 692, 
+	1, 0, 0, 0, 134, 698, 1, 0, 0, 0, 136, 702, 1, 0, 0, 0, 138, 709, 1, 0, 
+	0, 0, 140, 722, 1, 0, 0, 0, 142, 736, 1, 0, 0, 0, 144, 744, 1, 0, 0, 0, 
+	146, 746, 1, 0, 0, 0, 148, 750, 1, 0, 0, 0, 150, 757, 1, 0, 0, 0, 152, 
+	765, 1, 0, 0, 0, 154, 774, 1, 0, 0, 0, 156, 785, 1, 0, 0, 0, 158, 787, 
+	1, 0, 0, 0, 160, 789, 1, 0, 0, 0, 162, 801, 1, 0, 0, 0, 164, 811, 1, 0, 
+	0, 0, 166, 830, 1, 0, 0, 0, 168, 833, 1, 0, 0, 0, 170, 835, 1, 0, 0, 0, 
+	172, 842, 1, 0, 0, 0, 174, 844, 1, 0, 0, 0, 176, 854, 1, 0, 0, 0, 178, 
+	862, 1, 0, 0, 0, 180, 864, 1, 0, 0, 0, 182, 868, 1, 0, 0, 0, 184, 883, 
+	1, 0, 0, 0, 186, 885, 1, 0, 0, 0, 188, 902, 1, 0, 0, 0, 190, 912, 1, 0, 
+	0, 0, 192, 915, 1, 0, 0, 0, 194, 920, 1, 0, 0, 0, 196, 924, 1, 0, 0, 0, 
+	198, 927, 1, 0, 0, 0, 200, 930, 1, 0, 0, 0, 202, 932, 1, 0, 0, 0, 204, 
+	934, 1, 0, 0, 0, 206, 941, 1, 0, 0, 0, 208, 945, 1, 0, 0, 0, 210, 957, 
+	1, 0, 0, 0, 212, 230, 3, 4, 2, 0, 213, 230, 3, 34, 17, 0, 214, 230, 3, 
+	2, 1, 0, 215, 230, 3, 94, 47, 0, 216, 230, 3, 38, 19, 0, 217, 230, 3, 40, 
+	20, 0, 218, 230, 3, 42, 21, 0, 219, 230, 3, 44, 22, 0, 220, 230, 3, 64, 
+	32, 0, 221, 230, 3, 66, 33, 0, 222, 230, 3, 68, 34, 0, 223, 230, 3, 70, 
+	35, 0, 224, 230, 3, 72, 36, 0, 225, 230, 3, 12, 6, 0, 226, 227, 3, 208, 
+	104, 0, 227, 228, 5, 0, 0, 1, 228, 230, 1, 0, 0, 0, 229, 212, 1, 0, 0, 
+	0, 229, 213, 1, 0, 0, 0, 229, 214, 1, 0, 0, 0, 229, 215, 1, 0, 0, 0, 229, 
+	216, 1, 0, 0, 0, 229, 217, 1, 0, 0, 0, 229, 218, 1, 0, 0, 0, 229, 219, 
+	1, 0, 0, 0, 229, 220, 1, 0, 0, 0, 229, 221, 1, 0, 0, 0, 229, 222, 1, 0, 
+	0, 0, 229, 223, 1, 0, 0, 0, 229, 224, 1, 0, 0, 0, 229, 225, 1, 0, 0, 0, 
+	229, 226, 1, 0, 0, 0, 230, 1, 1, 0, 0, 0, 231, 232, 5, 22, 0, 0, 232, 233, 
+	3, 208, 104, 0, 233, 3, 1, 0, 0, 0, 234, 258, 3, 6, 3, 0, 235, 258, 3, 
+	16, 8, 0, 236, 258, 3, 18, 9, 0, 237, 258, 3, 20, 10, 0, 238, 258, 3, 22, 
+	11, 0, 239, 258, 3, 14, 7, 0, 240, 258, 3, 24, 12, 0, 241, 258, 3, 28, 
+	14, 0, 242, 258, 3, 30, 15, 0, 243, 258, 3, 26, 13, 0, 244, 258, 3, 36, 
+	18, 0, 245, 258, 3, 46, 23, 0, 246, 258, 3, 48, 24, 0, 247, 258, 3, 50, 
+	25, 0, 248, 258, 3, 52, 26, 0, 249, 258, 3, 54, 27, 0, 250, 258, 3, 56, 
+	28, 0, 251, 258, 3, 8, 4, 0, 252, 258, 3, 10, 5, 0, 253, 258, 3, 32, 16, 
+	0, 254, 258, 3, 58, 29, 0, 255, 258, 3, 60, 30, 0, 256, 258, 3, 62, 31, 
+	0, 257, 234, 1, 0, 0, 0, 257, 235, 1, 0, 0, 0, 257, 236, 1, 0, 0, 0, 257, 
+	237, 1, 0, 0, 0, 257, 238, 1, 0, 0, 0, 257, 239, 1, 0, 0, 0, 257, 240, 
+	1, 0, 0, 0, 257, 241, 1, 0, 0, 0, 257, 242, 1, 0, 0, 0, 257, 243, 1, 0, 
+	0, 0, 257, 244, 1, 0, 0, 0, 257, 245, 1, 0, 0, 0, 257, 246, 1, 0, 0, 0, 
+	257, 247, 1, 0, 0, 0, 257, 248, 1, 0, 0, 0, 257, 249, 1, 0, 0, 0, 257, 
+	250, 1, 0, 0, 0, 257, 251, 1, 0, 0, 0, 257, 252, 1, 0, 0, 0, 257, 253, 
+	1, 0, 0, 0, 257, 254, 1, 0, 0, 0, 257, 255, 1, 0, 0, 0, 257, 256, 1, 0, 
+	0, 0, 258, 5, 1, 0, 0, 0, 259, 260, 5, 21, 0, 0, 260, 261, 5, 25, 0, 0, 
+	261, 7, 1, 0, 0, 0, 262, 263, 5, 21, 0, 0, 263, 264, 5, 82, 0, 0, 264, 
+	9, 1, 0, 0, 0, 265, 266, 5, 21, 0, 0, 266, 267, 5, 83, 0, 0, 267, 268, 
+	5, 51, 0, 0, 268, 269, 5, 87, 0, 0, 269, 270, 5, 126, 0, 0, 270, 271, 3, 
+	90, 45, 0, 271, 11, 1, 0, 0, 0, 272, 273, 5, 19, 0, 0, 273, 274, 5, 55, 
+	0, 0, 274, 275, 3, 90, 45, 0, 275, 13, 1, 0, 0, 0, 276, 277, 5, 21, 0, 
+	0, 277, 278, 5, 29, 0, 0, 278, 15, 1, 0, 0, 0, 279, 280, 5, 21, 0, 0, 280, 
+	281, 5, 26, 0, 0, 281, 282, 5, 27, 0, 0, 282, 17, 1, 0, 0, 0, 283, 284, 
+	5, 21, 0, 0, 284, 285, 5, 31, 0, 0, 285, 286, 5, 26, 0, 0, 286, 287, 5, 
+	50, 0, 0, 287, 288, 3, 92, 46, 0, 288, 289, 5, 51, 0, 0, 289, 290, 3, 110, 
+	55, 0, 290, 19, 1, 0, 0, 0, 291, 292, 5, 21, 0, 0, 292, 293, 5, 25, 0, 
+	0, 293, 294, 5, 26, 0, 0, 294, 295, 5, 50, 0, 0, 295, 296, 3, 92, 46, 0, 
+	296, 297, 5, 51, 0, 0, 297, 298, 3, 110, 55, 0, 298, 21, 1, 0, 0, 0, 299, 
+	300, 5, 21, 0, 0, 300, 301, 5, 30, 0, 0, 301, 302, 5, 26, 0, 0, 302, 303, 
+	5, 50, 0, 0, 303, 304, 3, 92, 46, 0, 304, 307, 5, 51, 0, 0, 305, 308, 3, 
+	106, 53, 0, 306, 308, 3, 110, 55, 0, 307, 305, 1, 0, 0, 0, 307, 306, 1, 
+	0, 0, 0, 308, 309, 1, 0, 0, 0, 309, 312, 5, 60, 0, 0, 310, 313, 3, 106, 
+	53, 0, 311, 313, 3, 110, 55, 0, 312, 310, 1, 0, 0, 0, 312, 311, 1, 0, 0, 
+	0, 313, 23, 1, 0, 0, 0, 314, 315, 5, 21, 0, 0, 315, 316, 7, 0, 0, 0, 316, 
+	317, 5, 32, 0, 0, 317, 25, 1, 0, 0, 0, 318, 319, 5, 21, 0, 0, 319, 320, 
+	5, 14, 0, 0, 320, 323, 5, 51, 0, 0, 321, 324, 3, 106, 53, 0, 322, 324, 
+	3, 108, 54, 0, 323, 321, 1, 0, 0, 0, 323, 322, 1, 0, 0, 0, 324, 325, 1, 
+	0, 0, 0, 325, 328, 5, 60, 0, 0, 326, 329, 3, 106, 53, 0, 327, 329, 3, 108, 
+	54, 0, 328, 326, 1, 0, 0, 0, 328, 327, 1, 0, 0, 0, 329, 27, 1, 0, 0, 0, 
+	330, 331, 5, 21, 0, 0, 331, 332, 5, 31, 0, 0, 332, 333, 5, 40, 0, 0, 333, 
+	334, 5, 51, 0, 0, 334, 335, 3, 126, 63, 0, 335, 29, 1, 0, 0, 0, 336, 337, 
+	5, 21, 0, 0, 337, 338, 5, 30, 0, 0, 338, 339, 5, 40, 0, 0, 339, 342, 5, 
+	51, 0, 0, 340, 343, 3, 106, 53, 0, 341, 343, 3, 126, 63, 0, 342, 340, 1, 
+	0, 0, 0, 342, 341, 1, 0, 0, 0, 343, 344, 1, 0, 0, 0, 344, 347, 5, 60, 0, 
+	0, 345, 348, 3, 106, 53, 0, 346, 348, 3, 126, 63, 0, 347, 345, 1, 0, 0, 
+	0, 347, 346, 1, 0, 0, 0, 348, 31, 1, 0, 0, 0, 349, 350, 5, 21, 0, 0, 350, 
+	351, 5, 84, 0, 0, 351, 354, 5, 85, 0, 0, 352, 353, 5, 51, 0, 0, 353, 355, 
+	3, 108, 54, 0, 354, 352, 1, 0, 0, 0, 354, 355, 1, 0, 0, 0, 355, 357, 1, 
+	0, 0, 0, 356, 358, 3, 196, 98, 0, 357, 356, 1, 0, 0, 0, 357, 358, 1, 0, 
+	0, 0, 358, 33, 1, 0, 0, 0, 359, 360, 5, 5, 0, 0, 360, 361, 5, 30, 0, 0, 
+	361, 362, 3, 182, 91, 0, 362, 35, 1, 0, 0, 0, 363, 364, 5, 21, 0, 0, 364, 
+	365, 5, 33, 0, 0, 365, 37, 1, 0, 0, 0, 366, 367, 5, 5, 0, 0, 367, 368, 
+	5, 34, 0, 0, 368, 369, 3, 182, 91, 0, 369, 39, 1, 0, 0, 0, 370, 371, 5, 
+	8, 0, 0, 371, 372, 5, 34, 0, 0, 372, 373, 3, 88, 44, 0, 373, 41, 1, 0, 
+	0, 0, 374, 375, 5, 9, 0, 0, 375, 376, 5, 34, 0, 0, 376, 377, 3, 88, 44, 
+	0, 377, 378, 5, 47, 0, 0, 378, 379, 3, 182, 91, 0, 379, 43, 1, 0, 0, 0, 
+	380, 381, 5, 10, 0, 0, 381, 382, 5, 50, 0, 0, 382, 385, 3, 200, 100, 0, 
+	383, 384, 5, 20, 0, 0, 384, 386, 3, 86, 43, 0, 385, 383, 1, 0, 0, 0, 385, 
+	386, 1, 0, 0, 0, 386, 387, 1, 0, 0, 0, 387, 388, 3, 118, 59, 0, 388, 45, 
+	1, 0, 0, 0, 389, 390, 5, 21, 0, 0, 390, 391, 5, 35, 0, 0, 391, 47, 1, 0, 
+	0, 0, 392, 393, 5, 21, 0, 0, 393, 398, 5, 37, 0, 0, 394, 395, 5, 51, 0, 
+	0, 395, 396, 5, 36, 0, 0, 396, 397, 5, 126, 0, 0, 397, 399, 3, 82, 41, 
+	0, 398, 394, 1, 0, 0, 0, 398, 399, 1, 0, 0, 0, 399, 401, 1, 0, 0, 0, 400, 
+	402, 3, 196, 98, 0, 401, 400, 1, 0, 0, 0, 401, 402, 1, 0, 0, 0, 402, 49, 
+	1, 0, 0, 0, 403, 404, 5, 21, 0, 0, 404, 407, 5, 39, 0, 0, 405, 406, 5, 
+	20, 0, 0, 406, 408, 3, 86, 43, 0, 407, 405, 1, 0, 0, 0, 407, 408, 1, 0, 
+	0, 0, 408, 413, 1, 0, 0, 0, 409, 410, 5, 51, 0, 0, 410, 411, 5, 40, 0, 
+	0, 411, 412, 5, 126, 0, 0, 412, 414, 3, 82, 41, 0, 413, 409, 1, 0, 0, 0, 
+	413, 414, 1, 0, 0, 0, 414, 416, 1, 0, 0, 0, 415, 417, 3, 196, 98, 0, 416, 
+	415, 1, 0, 0, 0, 416, 417, 1, 0, 0, 0, 417, 51, 1, 0, 0, 0, 418, 419, 5, 
+	21, 0, 0, 419, 420, 5, 42, 0, 0, 420, 421, 3, 112, 56, 0, 421, 53, 1, 0, 
+	0, 0, 422, 423, 5, 21, 0, 0, 423, 424, 5, 43, 0, 0, 424, 425, 5, 45, 0, 
+	0, 425, 426, 3, 112, 56, 0, 426, 55, 1, 0, 0, 0, 427, 428, 5, 21, 0, 0, 
+	428, 429, 5, 43, 0, 0, 429, 430, 5, 48, 0, 0, 430, 431, 3, 112, 56, 0, 
+	431, 432, 5, 47, 0, 0, 432, 433, 5, 46, 0, 0, 433, 434, 5, 126, 0, 0, 434, 
+	436, 3, 84, 42, 0, 435, 437, 3, 118, 59, 0, 436, 435, 1, 0, 0, 0, 436, 
+	437, 1, 0, 0, 0, 437, 439, 1, 0, 0, 0, 438, 440, 3, 196, 98, 0, 439, 438, 
+	1, 0, 0, 0, 439, 440, 1, 0, 0, 0, 440, 57, 1, 0, 0, 0, 441, 442, 5, 21, 
+	0, 0, 442, 445, 5, 86, 0, 0, 443, 444, 5, 20, 0, 0, 444, 446, 3, 86, 43, 
+	0, 445, 443, 1, 0, 0, 0, 445, 446, 1, 0, 0, 0, 446, 448, 1, 0, 0, 0, 447, 
+	449, 3, 196, 98, 0, 448, 447, 1, 0, 0, 0, 448, 449, 1, 0, 0, 0, 449, 59, 
+	1, 0, 0, 0, 450, 451, 5, 21, 0, 0, 451, 452, 5, 43, 0, 0, 452, 453, 5, 
+	86, 0, 0, 453, 455, 3, 112, 56, 0, 454, 456, 3, 196, 98, 0, 455, 454, 1, 
+	0, 0, 0, 455, 456, 1, 0, 0, 0, 456, 61, 1, 0, 0, 0, 457, 458, 5, 21, 0, 
+	0, 458, 459, 5, 89, 0, 0, 459, 63, 1, 0, 0, 0, 460, 461, 5, 5, 0, 0, 461, 
+	462, 5, 88, 0, 0, 462, 463, 3, 74, 37, 0, 463, 464, 5, 47, 0, 0, 464, 465, 
+	5, 90, 0, 0, 465, 466, 3, 76, 38, 0, 466, 65, 1, 0, 0, 0, 467, 468, 5, 
+	8, 0, 0, 468, 469, 5, 88, 0, 0, 469, 470, 3, 74, 37, 0, 470, 67, 1, 0, 
+	0, 0, 471, 472, 5, 5, 0, 0, 472, 473, 5, 91, 0, 0, 473, 474, 3, 74, 37, 
+	0, 474, 69, 1, 0, 0, 0, 475, 476, 5, 92, 0, 0, 476, 477, 3, 78, 39, 0, 
+	477, 478, 5, 20, 0, 0, 478, 479, 3, 80, 40, 0, 479, 480, 5, 94, 0, 0, 480, 
+	481, 3, 74, 37, 0, 481, 71, 1, 0, 0, 0, 482, 483, 5, 93, 0, 0, 483, 484, 
+	3, 78, 39, 0, 484, 485, 5, 20, 0, 0, 485, 486, 3, 80, 40, 0, 486, 487, 
+	5, 50, 0, 0, 487, 488, 3, 74, 37, 0, 488, 73, 1, 0, 0, 0, 489, 490, 3, 
+	208, 104, 0, 490, 75, 1, 0, 0, 0, 491, 492, 3, 208, 104, 0, 492, 77, 1, 
+	0, 0, 0, 493, 494, 7, 1, 0, 0, 494, 79, 1, 0, 0, 0, 495, 498, 5, 145, 0, 
+	0, 496, 498, 3, 208, 104, 0, 497, 495, 1, 0, 0, 0, 497, 496, 1, 0, 0, 0, 
+	498, 81, 1, 0, 0, 0, 499, 500, 3, 208, 104, 0, 500, 83, 1, 0, 0, 0, 501, 
+	502, 3, 208, 104, 0, 502, 85, 1, 0, 0, 0, 503, 504, 3, 208, 104, 0, 504, 
+	87, 1, 0, 0, 0, 505, 506, 3, 208, 104, 0, 506, 89, 1, 0, 0, 0, 507, 508, 
+	3, 208, 104, 0, 508, 91, 1, 0, 0, 0, 509, 510, 7, 2, 0, 0, 510, 93, 1, 
+	0, 0, 0, 511, 513, 5, 56, 0, 0, 512, 511, 1, 0, 0, 0, 512, 513, 1, 0, 0, 
+	0, 513, 514, 1, 0, 0, 0, 514, 516, 3, 96, 48, 0, 515, 517, 3, 118, 59, 
+	0, 516, 515, 1, 0, 0, 0, 516, 517, 1, 0, 0, 0, 517, 519, 1, 0, 0, 0, 518, 
+	520, 3, 198, 99, 0, 519, 518, 1, 0, 0, 0, 519, 520, 1, 0, 0, 0, 520, 522, 
+	1, 0, 0, 0, 521, 523, 3, 138, 69, 0, 522, 521, 1, 0, 0, 0, 522, 523, 1, 
+	0, 0, 0, 523, 525, 1, 0, 0, 0, 524, 526, 3, 146, 73, 0, 525, 524, 1, 0, 
+	0, 0, 525, 526, 1, 0, 0, 0, 526, 528, 1, 0, 0, 0, 527, 529, 3, 196, 98, 
+	0, 528, 527, 1, 0, 0, 0, 528, 529, 1, 0, 0, 0, 529, 531, 1, 0, 0, 0, 530, 
+	532, 5, 57, 0, 0, 531, 530, 1, 0, 0, 0, 531, 532, 1, 0, 0, 0, 532, 95, 
+	1, 0, 0, 0, 533, 534, 3, 98, 49, 0, 534, 535, 3, 112, 56, 0, 535, 540, 
+	1, 0, 0, 0, 536, 537, 3, 112, 56, 0, 537, 538, 3, 98, 49, 0, 538, 540, 
+	1, 0, 0, 0, 539, 533, 1, 0, 0, 0, 539, 536, 1, 0, 0, 0, 540, 97, 1, 0, 
+	0, 0, 541, 542, 5, 58, 0, 0, 542, 543, 3, 100, 50, 0, 543, 99, 1, 0, 0, 
+	0, 544, 549, 3, 102, 51, 0, 545, 546, 5, 135, 0, 0, 546, 548, 3, 102, 51, 
+	0, 547, 545, 1, 0, 0, 0, 548, 551, 1, 0, 0, 0, 549, 547, 1, 0, 0, 0, 549, 
+	550, 1, 0, 0, 0, 550, 101, 1, 0, 0, 0, 551, 549, 1, 0, 0, 0, 552, 554, 
+	3, 164, 82, 0, 553, 555, 3, 104, 52, 0, 554, 553, 1, 0, 0, 0, 554, 555, 
+	1, 0, 0, 0, 555, 103, 1, 0, 0, 0, 556, 557, 5, 59, 0, 0, 557, 558, 3, 208, 
+	104, 0, 558, 105, 1, 0, 0, 0, 559, 560, 5, 30, 0, 0, 560, 561, 5, 126, 
+	0, 0, 561, 562, 3, 208, 104, 0, 562, 107, 1, 0, 0, 0, 563, 564, 5, 34, 
+	0, 0, 564, 565, 5, 126, 0, 0, 565, 566, 3, 208, 104, 0, 566, 109, 1, 0, 
+	0, 0, 567, 568, 5, 28, 0, 0, 568, 569, 5, 126, 0, 0, 569, 570, 3, 208, 
+	104, 0, 570, 111, 1, 0, 0, 0, 571, 572, 5, 50, 0, 0, 572, 577, 3, 200, 
+	100, 0, 573, 575, 3, 116, 58, 0, 574, 573, 1, 0, 0, 0, 574, 575, 1, 0, 
+	0, 0, 575, 576, 1, 0, 0, 0, 576, 578, 3, 114, 57, 0, 577, 574, 1, 0, 0, 
+	0, 577, 578, 1, 0, 0, 0, 578, 589, 1, 0, 0, 0, 579, 580, 5, 135, 0, 0, 
+	580, 585, 3, 200, 100, 0, 581, 583, 3, 116, 58, 0, 582, 581, 1, 0, 0, 0, 
+	582, 583, 1, 0, 0, 0, 583, 584, 1, 0, 0, 0, 584, 586, 3, 114, 57, 0, 585, 
+	582, 1, 0, 0, 0, 585, 586, 1, 0, 0, 0, 586, 588, 1, 0, 0, 0, 587, 579, 
+	1, 0, 0, 0, 588, 591, 1, 0, 0, 0, 589, 587, 1, 0, 0, 0, 589, 590, 1, 0, 
+	0, 0, 590, 594, 1, 0, 0, 0, 591, 589, 1, 0, 0, 0, 592, 593, 5, 20, 0, 0, 
+	593, 595, 3, 86, 43, 0, 594, 592, 1, 0, 0, 0, 594, 595, 1, 0, 0, 0, 595, 
+	113, 1, 0, 0, 0, 596, 597, 5, 59, 0, 0, 597, 598, 3, 208, 104, 0, 598, 
+	115, 1, 0, 0, 0, 599, 600, 5, 53, 0, 0, 600, 601, 3, 166, 83, 0, 601, 117, 
+	1, 0, 0, 0, 602, 603, 5, 51, 0, 0, 603, 604, 3, 120, 60, 0, 604, 119, 1, 
+	0, 0, 0, 605, 616, 3, 122, 61, 0, 606, 607, 3, 122, 61, 0, 607, 608, 5, 
+	60, 0, 0, 608, 609, 3, 130, 65, 0, 609, 616, 1, 0, 0, 0, 610, 613, 3, 130, 
+	65, 0, 611, 612, 5, 60, 0, 0, 612, 614, 3, 122, 61, 0, 613, 611, 1, 0, 
+	0, 0, 613, 614, 1, 0, 0, 0, 614, 616, 1, 0, 0, 0, 615, 605, 1, 0, 0, 0, 
+	615, 606, 1, 0, 0, 0, 615, 610, 1, 0, 0, 0, 616, 121, 1, 0, 0, 0, 617, 
+	618, 6, 61, -1, 0, 618, 619, 5, 140, 0, 0, 619, 620, 3, 122, 61, 0, 620, 
+	621, 5, 141, 0, 0, 621, 656, 1, 0, 0, 0, 622, 631, 3, 202, 101, 0, 623, 
+	632, 5, 126, 0, 0, 624, 632, 5, 68, 0, 0, 625, 626, 5, 69, 0, 0, 626, 632, 
+	5, 68, 0, 0, 627, 632, 5, 133, 0, 0, 628, 632, 5, 134, 0, 0, 629, 632, 
+	5, 127, 0, 0, 630, 632, 5, 128, 0, 0, 631, 623, 1, 0, 0, 0, 631, 624, 1, 
+	0, 0, 0, 631, 625, 1, 0, 0, 0, 631, 627, 1, 0, 0, 0, 631, 628, 1, 0, 0, 
+	0, 631, 629, 1, 0, 0, 0, 631, 630, 1, 0, 0, 0, 632, 633, 1, 0, 0, 0, 633, 
+	634, 3, 206, 103, 0, 634, 656, 1, 0, 0, 0, 635, 636, 3, 204, 102, 0, 636, 
+	637, 7, 3, 0, 0, 637, 638, 3, 206, 103, 0, 638, 656, 1, 0, 0, 0, 639, 640, 
+	3, 202, 101, 0, 640, 641, 5, 70, 0, 0, 641, 642, 3, 206, 103, 0, 642, 643, 
+	5, 60, 0, 0, 643, 644, 3, 206, 103, 0, 644, 656, 1, 0, 0, 0, 645, 649, 
+	3, 202, 101, 0, 646, 650, 5, 79, 0, 0, 647, 648, 5, 69, 0, 0, 648, 650, 
+	5, 79, 0, 0, 649, 646, 1, 0, 0, 0, 649, 647, 1, 0, 0, 0, 650, 651, 1, 0, 
+	0, 0, 651, 652, 5, 140, 0, 0, 652, 653, 3, 124, 62, 0, 653, 654, 5, 141, 
+	0, 0, 654, 656, 1, 0, 0, 0, 655, 617, 1, 0, 0, 0, 655, 622, 1, 0, 0, 0, 
+	655, 635, 1, 0, 0, 0, 655, 639, 1, 0, 0, 0, 655, 645, 1, 0, 0, 0, 656, 
+	662, 1, 0, 0, 0, 657, 658, 10, 1, 0, 0, 658, 659, 7, 4, 0, 0, 659, 661, 
+	3, 122, 61, 2, 660, 657, 1, 0, 0, 0, 661, 664, 1, 0, 0, 0, 662, 660, 1, 
+	0, 0, 0, 662, 663, 1, 0, 0, 0, 663, 123, 1, 0, 0, 0, 664, 662, 1, 0, 0, 
+	0, 665, 670, 3, 206, 103, 0, 666, 667, 5, 135, 0, 0, 667, 669, 3, 206, 
+	103, 0, 668, 666, 1, 0, 0, 0, 669, 672, 1, 0, 0, 0, 670, 668, 1, 0, 0, 
+	0, 670, 671, 1, 0, 0, 0, 671, 125, 1, 0, 0, 0, 672, 670, 1, 0, 0, 0, 673, 
+	674, 5, 40, 0, 0, 674, 675, 5, 79, 0, 0, 675, 676, 5, 140, 0, 0, 676, 677, 
+	3, 128, 64, 0, 677, 678, 5, 141, 0, 0, 678, 127, 1, 0, 0, 0, 679, 684, 
+	3, 208, 104, 0, 680, 681, 5, 135, 0, 0, 681, 683, 3, 208, 104, 0, 682, 
+	680, 1, 0, 0, 0, 683, 686, 1, 0, 0, 0, 684, 682, 1, 0, 0, 0, 684, 685, 
+	1, 0, 0, 0, 685, 129, 1, 0, 0, 0, 686, 684, 1, 0, 0, 0, 687, 690, 3, 132, 
+	66, 0, 688, 689, 5, 60, 0, 0, 689, 691, 3, 132, 66, 0, 690, 688, 1, 0, 
+	0, 0, 690, 691, 1, 0, 0, 0, 691, 131, 1, 0, 0, 0, 692, 693, 5, 77, 0, 0, 
+	693, 696, 3, 162, 81, 0, 694, 697, 3, 134, 67, 0, 695, 697, 3, 208, 104, 
+	0, 696, 694, 1, 0, 0, 0, 696, 695, 1, 0, 0, 0, 697, 133, 1, 0, 0, 0, 698, 
+	700, 3, 136, 68, 0, 699, 701, 3, 166, 83, 0, 700, 699, 1, 0, 0, 0, 700, 
+	701, 1, 0, 0, 0, 701, 135, 1, 0, 0, 0, 702, 703, 5, 78, 0, 0, 703, 705, 
+	5, 140, 0, 0, 704, 706, 3, 174, 87, 0, 705, 704, 1, 0, 0, 0, 705, 706, 
+	1, 0, 0, 0, 706, 707, 1, 0, 0, 0, 707, 708, 5, 141, 0, 0, 708, 137, 1, 
+	0, 0, 0, 709, 710, 5, 72, 0, 0, 710, 711, 5, 74, 0, 0, 711, 717, 3, 140, 
+	70, 0, 712, 713, 5, 62, 0, 0, 713, 714, 5, 140, 0, 0, 714, 715, 3, 144, 
+	72, 0, 715, 716, 5, 141, 0, 0, 716, 718, 1, 0, 0, 0, 717, 712, 1, 0, 0, 
+	0, 717, 718, 1, 0, 0, 0, 718, 720, 1, 0, 0, 0, 719, 721, 3, 152, 76, 0, 
+	720, 719, 1, 0, 0, 0, 720, 721, 1, 0, 0, 0, 721, 139, 1, 0, 0, 0, 722, 
+	727, 3, 142, 71, 0, 723, 724, 5, 135, 0, 0, 724, 726, 3, 142, 71, 0, 725, 
+	723, 1, 0, 0, 0, 726, 729, 1, 0, 0, 0, 727, 725, 1, 0, 0, 0, 727, 728, 
+	1, 0, 0, 0, 728, 141, 1, 0, 0, 0, 729, 727, 1, 0, 0, 0, 730, 737, 3, 208, 
+	104, 0, 731, 732, 5, 77, 0, 0, 732, 733, 5, 140, 0, 0, 733, 734, 3, 166, 
+	83, 0, 734, 735, 5, 141, 0, 0, 735, 737, 1, 0, 0, 0, 736, 730, 1, 0, 0, 
+	0, 736, 731, 1, 0, 0, 0, 737, 143, 1, 0, 0, 0, 738, 745, 5, 63, 0, 0, 739, 
+	745, 5, 64, 0, 0, 740, 742, 5, 143, 0, 0, 741, 740, 1, 0, 0, 0, 741, 742, 
+	1, 0, 0, 0, 742, 743, 1, 0, 0, 0, 743, 745, 7, 5, 0, 0, 744, 738, 1, 0, 
+	0, 0, 744, 739, 1, 0, 0, 0, 744, 741, 1, 0, 0, 0, 745, 145, 1, 0, 0, 0, 
+	746, 747, 5, 65, 0, 0, 747, 748, 5, 74, 0, 0, 748, 749, 3, 150, 75, 0, 
+	749, 147, 1, 0, 0, 0, 750, 754, 3, 164, 82, 0, 751, 753, 7, 6, 0, 0, 752, 
+	751, 1, 0, 0, 0, 753, 756, 1, 0, 0, 0, 754, 752, 1, 0, 0, 0, 754, 755, 
+	1, 0, 0, 0, 755, 149, 1, 0, 0, 0, 756, 754, 1, 0, 0, 0, 757, 762, 3, 148, 
+	74, 0, 758, 759, 5, 135, 0, 0, 759, 761, 3, 148, 74, 0, 760, 758, 1, 0, 
+	0, 0, 761, 764, 1, 0, 0, 0, 762, 760, 1, 0, 0, 0, 762, 763, 1, 0, 0, 0, 
+	763, 151, 1, 0, 0, 0, 764, 762, 1, 0, 0, 0, 765, 766, 5, 73, 0, 0, 766, 
+	767, 3, 154, 77, 0, 767, 153, 1, 0, 0, 0, 768, 769, 6, 77, -1, 0, 769, 
+	770, 5, 140, 0, 0, 770, 771, 3, 154, 77, 0, 771, 772, 5, 141, 0, 0, 772, 
+	775, 1, 0, 0, 0, 773, 775, 3, 158, 79, 0, 774, 768, 1, 0, 0, 0, 774, 773, 
+	1, 0, 0, 0, 775, 782, 1, 0, 0, 0, 776, 777, 10, 2, 0, 0, 777, 778, 3, 156, 
+	78, 0, 778, 779, 3, 154, 77, 3, 779, 781, 1, 0, 0, 0, 780, 776, 1, 0, 0, 
+	0, 781, 784, 1, 0, 0, 0, 782, 780, 1, 0, 0, 0, 782, 783, 1, 0, 0, 0, 783, 
+	155, 1, 0, 0, 0, 784, 782, 1, 0, 0, 0, 785, 786, 7, 4, 0, 0, 786, 157, 
+	1, 0, 0, 0, 787, 788, 3, 160, 80, 0, 788, 159, 1, 0, 0, 0, 789, 790, 3, 
+	164, 82, 0, 790, 791, 3, 162, 81, 0, 791, 792, 3, 164, 82, 0, 792, 161, 
+	1, 0, 0, 0, 793, 802, 5, 126, 0, 0, 794, 802, 5, 127, 0, 0, 795, 802, 5, 
+	128, 0, 0, 796, 802, 5, 131, 0, 0, 797, 802, 5, 132, 0, 0, 798, 802, 5, 
+	129, 0, 0, 799, 802, 5, 130, 0, 0, 800, 802, 7, 7, 0, 0, 801, 793, 1, 0, 
+	0, 0, 801, 794, 1, 0, 0, 0, 801, 795, 1, 0, 0, 0, 801, 796, 1, 0, 0, 0, 
+	801, 797, 1, 0, 0, 0, 801, 798, 1, 0, 0, 0, 801, 799, 1, 0, 0, 0, 801, 
+	800, 1, 0, 0, 0, 802, 163, 1, 0, 0, 0, 803, 804, 6, 82, -1, 0, 804, 805, 
+	5, 140, 0, 0, 805, 806, 3, 164, 82, 0, 806, 807, 5, 141, 0, 0, 807, 812, 
+	1, 0, 0, 0, 808, 812, 3, 170, 85, 0, 809, 812, 3, 178, 89, 0, 810, 812, 
+	3, 166, 83, 0, 811, 803, 1, 0, 0, 0, 811, 808, 1, 0, 0, 0, 811, 809, 1, 
+	0, 0, 0, 811, 810, 1, 0, 0, 0, 812, 827, 1, 0, 0, 0, 813, 814, 10, 8, 0, 
+	0, 814, 815, 5, 145, 0, 0, 815, 826, 3, 164, 82, 9, 816, 817, 10, 7, 0, 
+	0, 817, 818, 5, 144, 0, 0, 818, 826, 3, 164, 82, 8, 819, 820, 10, 6, 0, 
+	0, 820, 821, 5, 142, 0, 0, 821, 826, 3, 164, 82, 7, 822, 823, 10, 5, 0, 
+	0, 823, 824, 5, 143, 0, 0, 824, 826, 3, 164, 82, 6, 825, 813, 1, 0, 0, 
+	0, 825, 816, 1, 0, 0, 0, 825, 819, 1, 0, 0, 0, 825, 822, 1, 0, 0, 0, 826, 
+	829, 1, 0, 0, 0, 827, 825, 1, 0, 0, 0, 827, 828, 1, 0, 0, 0, 828, 165, 
+	1, 0, 0, 0, 829, 827, 1, 0, 0, 0, 830, 831, 3, 192, 96, 0, 831, 832, 3, 
+	168, 84, 0, 832, 167, 1, 0, 0, 0, 833, 834, 7, 8, 0, 0, 834, 169, 1, 0, 
+	0, 0, 835, 836, 3, 172, 86, 0, 836, 838, 5, 140, 0, 0, 837, 839, 3, 174, 
+	87, 0, 838, 837, 1, 0, 0, 0, 838, 839, 1, 0, 0, 0, 839, 840, 1, 0, 0, 0, 
+	840, 841, 5, 141, 0, 0, 841, 171, 1, 0, 0, 0, 842, 843, 7, 9, 0, 0, 843, 
+	173, 1, 0, 0, 0, 844, 849, 3, 176, 88, 0, 845, 846, 5, 135, 0, 0, 846, 
+	848, 3, 176, 88, 0, 847, 845, 1, 0, 0, 0, 848, 851, 1, 0, 0, 0, 849, 847, 
+	1, 0, 0, 0, 849, 850, 1, 0, 0, 0, 850, 175, 1, 0, 0, 0, 851, 849, 1, 0, 
+	0, 0, 852, 855, 3, 164, 82, 0, 853, 855, 3, 122, 61, 0, 854, 852, 1, 0, 
+	0, 0, 854, 853, 1, 0, 0, 0, 855, 177, 1, 0, 0, 0, 856, 858, 3, 208, 104, 
+	0, 857, 859, 3, 180, 90, 0, 858, 857, 1, 0, 0, 0, 858, 859, 1, 0, 0, 0, 
+	859, 863, 1, 0, 0, 0, 860, 863, 3, 194, 97, 0, 861, 863, 3, 192, 96, 0, 
+	862, 856, 1, 0, 0, 0, 862, 860, 1, 0, 0, 0, 862, 861, 1, 0, 0, 0, 863, 
+	179, 1, 0, 0, 0, 864, 865, 5, 138, 0, 0, 865, 866, 3, 122, 61, 0, 866, 
+	867, 5, 139, 0, 0, 867, 181, 1, 0, 0, 0, 868, 869, 3, 190, 95, 0, 869, 
+	183, 1, 0, 0, 0, 870, 871, 5, 136, 0, 0, 871, 876, 3, 186, 93, 0, 872, 
+	873, 5, 135, 0, 0, 873, 875, 3, 186, 93, 0, 874, 872, 1, 0, 0, 0, 875, 
+	878, 1, 0, 0, 0, 876, 874, 1, 0, 0, 0, 876, 877, 1, 0, 0, 0, 877, 879, 
+	1, 0, 0, 0, 878, 876, 1, 0, 0, 0, 879, 880, 5, 137, 0, 0, 880, 884, 1, 
+	0, 0, 0, 881, 882, 5, 136, 0, 0, 882, 884, 5, 137, 0, 0, 883, 870, 1, 0, 
+	0, 0, 883, 881, 1, 0, 0, 0, 884, 185, 1, 0, 0, 0, 885, 886, 5, 3, 0, 0, 
+	886, 887, 5, 125, 0, 0, 887, 888, 3, 190, 95, 0, 888, 187, 1, 0, 0, 0, 
+	889, 890, 5, 138, 0, 0, 890, 895, 3, 190, 95, 0, 891, 892, 5, 135, 0, 0, 
+	892, 894, 3, 190, 95, 0, 893, 891, 1, 0, 0, 0, 894, 897, 1, 0, 0, 0, 895, 
+	893, 1, 0, 0, 0, 895, 896, 1, 0, 0, 0, 896, 898, 1, 0, 0, 0, 897, 895, 
+	1, 0, 0, 0, 898, 899, 5, 139, 0, 0, 899, 903, 1, 0, 0, 0, 900, 901, 5, 
+	138, 0, 0, 901, 903, 5, 139, 0, 0, 902, 889, 1, 0, 0, 0, 902, 900, 1, 0, 
+	0, 0, 903, 189, 1, 0, 0, 0, 904, 913, 5, 3, 0, 0, 905, 913, 3, 192, 96, 
+	0, 906, 913, 3, 194, 97, 0, 907, 913, 3, 184, 92, 0, 908, 913, 3, 188, 
+	94, 0, 909, 913, 5, 1, 0, 0, 910, 913, 5, 2, 0, 0, 911, 913, 5, 63, 0, 
+	0, 912, 904, 1, 0, 0, 0, 912, 905, 1, 0, 0, 0, 912, 906, 1, 0, 0, 0, 912, 
+	907, 1, 0, 0, 0, 912, 908, 1, 0, 0, 0, 912, 909, 1, 0, 0, 0, 912, 910, 
+	1, 0, 0, 0, 912, 911, 1, 0, 0, 0, 913, 191, 1, 0, 0, 0, 914, 916, 7, 10, 
+	0, 0, 915, 914, 1, 0, 0, 0, 915, 916, 1, 0, 0, 0, 916, 917, 1, 0, 0, 0, 
+	917, 918, 5, 149, 0, 0, 918, 193, 1, 0, 0, 0, 919, 921, 7, 10, 0, 0, 920, 
+	919, 1, 0, 0, 0, 920, 921, 1, 0, 0, 0, 921, 922, 1, 0, 0, 0, 922, 923, 
+	5, 150, 0, 0, 923, 195, 1, 0, 0, 0, 924, 925, 5, 52, 0, 0, 925, 926, 5, 
+	149, 0, 0, 926, 197, 1, 0, 0, 0, 927, 928, 5, 53, 0, 0, 928, 929, 3, 166, 
+	83, 0, 929, 199, 1, 0, 0, 0, 930, 931, 3, 208, 104, 0, 931, 201, 1, 0, 
+	0, 0, 932, 933, 3, 208, 104, 0, 933, 203, 1, 0, 0, 0, 934, 935, 5, 148, 
+	0, 0, 935, 205, 1, 0, 0, 0, 936, 942, 3, 208, 104, 0, 937, 939, 5, 143, 
+	0, 0, 938, 937, 1, 0, 0, 0, 938, 939, 1, 0, 0, 0, 939, 940, 1, 0, 0, 0, 
+	940, 942, 7, 5, 0, 0, 941, 936, 1, 0, 0, 0, 941, 938, 1, 0, 0, 0, 942, 
+	207, 1, 0, 0, 0, 943, 946, 5, 148, 0, 0, 944, 946, 3, 210, 105, 0, 945, 
+	943, 1, 0, 0, 0, 945, 944, 1, 0, 0, 0, 946, 954, 1, 0, 0, 0, 947, 950, 
+	5, 124, 0, 0, 948, 951, 5, 148, 0, 0, 949, 951, 3, 210, 105, 0, 950, 948, 
+	1, 0, 0, 0, 950, 949, 1, 0, 0, 0, 951, 953, 1, 0, 0, 0, 952, 947, 1, 0, 
+	0, 0, 953, 956, 1, 0, 0, 0, 954, 952, 1, 0, 0, 0, 954, 955, 1, 0, 0, 0, 
+	955, 209, 1, 0, 0, 0, 956, 954, 1, 0, 0, 0, 957, 958, 7, 11, 0, 0, 958, 
+	211, 1, 0, 0, 0, 81, 229, 257, 307, 312, 323, 328, 342, 347, 354, 357, 
+	385, 398, 401, 407, 413, 416, 436, 439, 445, 448, 455, 497, 512, 516, 519, 
+	522, 525, 528, 531, 539, 549, 554, 574, 577, 582, 585, 589, 594, 613, 615, 
+	631, 649, 655, 662, 670, 684, 690, 696, 700, 705, 717, 720, 727, 736, 741, 
+	744, 754, 762, 774, 782, 801, 811, 825, 827, 838, 849, 854, 858, 862, 876, 
+	883, 895, 902, 912, 915, 920, 938, 941, 945, 950, 954,
 }
   deserializer := antlr.NewATNDeserializer(nil)
   staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -484,130 +582,154 @@ const (
 	SQLParserEOF = antlr.TokenEOF
 	SQLParserT__0 = 1
 	SQLParserT__1 = 2
-	SQLParserT__2 = 3
-	SQLParserSTRING = 4
-	SQLParserWS = 5
-	SQLParserT_CREATE = 6
-	SQLParserT_UPDATE = 7
-	SQLParserT_SET = 8
-	SQLParserT_DROP = 9
-	SQLParserT_INTERVAL = 10
-	SQLParserT_INTERVAL_NAME = 11
-	SQLParserT_SHARD = 12
-	SQLParserT_REPLICATION = 13
-	SQLParserT_TTL = 14
-	SQLParserT_META_TTL = 15
-	SQLParserT_PAST_TTL = 16
-	SQLParserT_FUTURE_TTL = 17
-	SQLParserT_KILL = 18
-	SQLParserT_ON = 19
-	SQLParserT_SHOW = 20
-	SQLParserT_USE = 21
-	SQLParserT_STATE_REPO = 22
-	SQLParserT_STATE_MACHINE = 23
-	SQLParserT_MASTER = 24
-	SQLParserT_METADATA = 25
-	SQLParserT_TYPES = 26
-	SQLParserT_TYPE = 27
-	SQLParserT_STORAGES = 28
-	SQLParserT_STORAGE = 29
-	SQLParserT_BROKER = 30
-	SQLParserT_ALIVE = 31
-	SQLParserT_SCHEMAS = 32
-	SQLParserT_DATASBAE = 33
-	SQLParserT_DATASBAES = 34
-	SQLParserT_NAMESPACE = 35
-	SQLParserT_NAMESPACES = 36
-	SQLParserT_NODE = 37
-	SQLParserT_METRICS = 38
-	SQLParserT_METRIC = 39
-	SQLParserT_FIELD = 40
-	SQLParserT_FIELDS = 41
-	SQLParserT_TAG = 42
-	SQLParserT_INFO = 43
-	SQLParserT_KEYS = 44
-	SQLParserT_KEY = 45
-	SQLParserT_WITH = 46
-	SQLParserT_VALUES = 47
-	SQLParserT_VALUE = 48
-	SQLParserT_FROM = 49
-	SQLParserT_WHERE = 50
-	SQLParserT_LIMIT = 51
-	SQLParserT_QUERIES = 52
-	SQLParserT_QUERY = 53
-	SQLParserT_EXPLAIN = 54
-	SQLParserT_WITH_VALUE = 55
-	SQLParserT_SELECT = 56
-	SQLParserT_AS = 57
-	SQLParserT_AND = 58
-	SQLParserT_OR = 59
-	SQLParserT_FILL = 60
-	SQLParserT_NULL = 61
-	SQLParserT_PREVIOUS = 62
-	SQLParserT_ORDER = 63
-	SQLParserT_ASC = 64
-	SQLParserT_DESC = 65
-	SQLParserT_LIKE = 66
-	SQLParserT_NOT = 67
-	SQLParserT_BETWEEN = 68
-	SQLParserT_IS = 69
-	SQLParserT_GROUP = 70
-	SQLParserT_HAVING = 71
-	SQLParserT_BY = 72
-	SQLParserT_FOR = 73
-	SQLParserT_STATS = 74
-	SQLParserT_TIME = 75
-	SQLParserT_NOW = 76
-	SQLParserT_IN = 77
-	SQLParserT_LOG = 78
-	SQLParserT_PROFILE = 79
-	SQLParserT_REQUESTS = 80
-	SQLParserT_REQUEST = 81
-	SQLParserT_ID = 82
-	SQLParserT_SUM = 83
-	SQLParserT_MIN = 84
-	SQLParserT_MAX = 85
-	SQLParserT_COUNT = 86
-	SQLParserT_LAST = 87
-	SQLParserT_FIRST = 88
-	SQLParserT_AVG = 89
-	SQLParserT_STDDEV = 90
-	SQLParserT_QUANTILE = 91
-	SQLParserT_RATE = 92
-	SQLParserT_SECOND = 93
-	SQLParserT_MINUTE = 94
-	SQLParserT_HOUR = 95
-	SQLParserT_DAY = 96
-	SQLParserT_WEEK = 97
-	SQLParserT_MONTH = 98
-	SQLParserT_YEAR = 99
-	SQLParserT_DOT = 100
-	SQLParserT_COLON = 101
-	SQLParserT_EQUAL = 102
-	SQLParserT_NOTEQUAL = 103
-	SQLParserT_NOTEQUAL2 = 104
-	SQLParserT_GREATER = 105
-	SQLParserT_GREATEREQUAL = 106
-	SQLParserT_LESS = 107
-	SQLParserT_LESSEQUAL = 108
-	SQLParserT_REGEXP = 109
-	SQLParserT_NEQREGEXP = 110
-	SQLParserT_COMMA = 111
-	SQLParserT_OPEN_B = 112
-	SQLParserT_CLOSE_B = 113
-	SQLParserT_OPEN_SB = 114
-	SQLParserT_CLOSE_SB = 115
-	SQLParserT_OPEN_P = 116
-	SQLParserT_CLOSE_P = 117
-	SQLParserT_ADD = 118
-	SQLParserT_SUB = 119
-	SQLParserT_DIV = 120
-	SQLParserT_MUL = 121
-	SQLParserT_MOD = 122
-	SQLParserT_UNDERLINE = 123
-	SQLParserL_ID = 124
-	SQLParserL_INT = 125
-	SQLParserL_DEC = 126
+	SQLParserSTRING = 3
+	SQLParserWS = 4
+	SQLParserT_CREATE = 5
+	SQLParserT_UPDATE = 6
+	SQLParserT_SET = 7
+	SQLParserT_DROP = 8
+	SQLParserT_ALTER = 9
+	SQLParserT_DELETE = 10
+	SQLParserT_INTERVAL = 11
+	SQLParserT_INTERVAL_NAME = 12
+	SQLParserT_SHARD = 13
+	SQLParserT_REPLICATION = 14
+	SQLParserT_TTL = 15
+	SQLParserT_META_TTL = 16
+	SQLParserT_PAST_TTL = 17
+	SQLParserT_FUTURE_TTL = 18
+	SQLParserT_KILL = 19
+	SQLParserT_ON = 20
+	SQLParserT_SHOW = 21
+	SQLParserT_USE = 22
+	SQLParserT_STATE_REPO = 23
+	SQLParserT_STATE_MACHINE = 24
+	SQLParserT_MASTER = 25
+	SQLParserT_METADATA = 26
+	SQLParserT_TYPES = 27
+	SQLParserT_TYPE = 28
+	SQLParserT_STORAGES = 29
+	SQLParserT_STORAGE = 30
+	SQLParserT_BROKER = 31
+	SQLParserT_ALIVE = 32
+	SQLParserT_SCHEMAS = 33
+	SQLParserT_DATASBAE = 34
+	SQLParserT_DATASBAES = 35
+	SQLParserT_NAMESPACE = 36
+	SQLParserT_NAMESPACES = 37
+	SQLParserT_NODE = 38
+	SQLParserT_METRICS = 39
+	SQLParserT_METRIC = 40
+	SQLParserT_FIELD = 41
+	SQLParserT_FIELDS = 42
+	SQLParserT_TAG = 43
+	SQLParserT_INFO = 44
+	SQLParserT_KEYS = 45
+	SQLParserT_KEY = 46
+	SQLParserT_WITH = 47
+	SQLParserT_VALUES = 48
+	SQLParserT_VALUE = 49
+	SQLParserT_FROM = 50
+	SQLParserT_WHERE = 51
+	SQLParserT_LIMIT = 52
+	SQLParserT_OFFSET = 53
+	SQLParserT_QUERIES = 54
+	SQLParserT_QUERY = 55
+	SQLParserT_EXPLAIN = 56
+	SQLParserT_WITH_VALUE = 57
+	SQLParserT_SELECT = 58
+	SQLParserT_AS = 59
+	SQLParserT_AND = 60
+	SQLParserT_OR = 61
+	SQLParserT_FILL = 62
+	SQLParserT_NULL = 63
+	SQLParserT_PREVIOUS = 64
+	SQLParserT_ORDER = 65
+	SQLParserT_ASC = 66
+	SQLParserT_DESC = 67
+	SQLParserT_LIKE = 68
+	SQLParserT_NOT = 69
+	SQLParserT_BETWEEN = 70
+	SQLParserT_IS = 71
+	SQLParserT_GROUP = 72
+	SQLParserT_HAVING = 73
+	SQLParserT_BY = 74
+	SQLParserT_FOR = 75
+	SQLParserT_STATS = 76
+	SQLParserT_TIME = 77
+	SQLParserT_NOW = 78
+	SQLParserT_IN = 79
+	SQLParserT_LOG = 80
+	SQLParserT_PROFILE = 81
+	SQLParserT_REQUESTS = 82
+	SQLParserT_REQUEST = 83
+	SQLParserT_SERIES = 84
+	SQLParserT_QUOTA = 85
+	SQLParserT_CARDINALITY = 86
+	SQLParserT_ID = 87
+	SQLParserT_USER = 88
+	SQLParserT_USERS = 89
+	SQLParserT_PASSWORD = 90
+	SQLParserT_TOKEN = 91
+	SQLParserT_GRANT = 92
+	SQLParserT_REVOKE = 93
+	SQLParserT_TO = 94
+	SQLParserT_READ = 95
+	SQLParserT_WRITE = 96
+	SQLParserT_ADMIN = 97
+	SQLParserT_SUM = 98
+	SQLParserT_MIN = 99
+	SQLParserT_MAX = 100
+	SQLParserT_COUNT = 101
+	SQLParserT_LAST = 102
+	SQLParserT_FIRST = 103
+	SQLParserT_AVG = 104
+	SQLParserT_STDDEV = 105
+	SQLParserT_QUANTILE = 106
+	SQLParserT_RATE = 107
+	SQLParserT_PERCENTILE = 108
+	SQLParserT_INCREASE = 109
+	SQLParserT_DERIVATIVE = 110
+	SQLParserT_DELTA = 111
+	SQLParserT_MOVING_AVERAGE = 112
+	SQLParserT_ABS = 113
+	SQLParserT_CLAMP_MIN = 114
+	SQLParserT_CLAMP_MAX = 115
+	SQLParserT_TIMESHIFT = 116
+	SQLParserT_SECOND = 117
+	SQLParserT_MINUTE = 118
+	SQLParserT_HOUR = 119
+	SQLParserT_DAY = 120
+	SQLParserT_WEEK = 121
+	SQLParserT_MONTH = 122
+	SQLParserT_YEAR = 123
+	SQLParserT_DOT = 124
+	SQLParserT_COLON = 125
+	SQLParserT_EQUAL = 126
+	SQLParserT_NOTEQUAL = 127
+	SQLParserT_NOTEQUAL2 = 128
+	SQLParserT_GREATER = 129
+	SQLParserT_GREATEREQUAL = 130
+	SQLParserT_LESS = 131
+	SQLParserT_LESSEQUAL = 132
+	SQLParserT_REGEXP = 133
+	SQLParserT_NEQREGEXP = 134
+	SQLParserT_COMMA = 135
+	SQLParserT_OPEN_B = 136
+	SQLParserT_CLOSE_B = 137
+	SQLParserT_OPEN_SB = 138
+	SQLParserT_CLOSE_SB = 139
+	SQLParserT_OPEN_P = 140
+	SQLParserT_CLOSE_P = 141
+	SQLParserT_ADD = 142
+	SQLParserT_SUB = 143
+	SQLParserT_DIV = 144
+	SQLParserT_MUL = 145
+	SQLParserT_MOD = 146
+	SQLParserT_UNDERLINE = 147
+	SQLParserL_ID = 148
+	SQLParserL_INT = 149
+	SQLParserL_DEC = 150
 )
 
 // SQLParser rules.
@@ -618,86 +740,106 @@ const (
 	SQLParserRULE_showMasterStmt = 3
 	SQLParserRULE_showRequestsStmt = 4
 	SQLParserRULE_showRequestStmt = 5
-	SQLParserRULE_showStoragesStmt = 6
-	SQLParserRULE_showMetadataTypesStmt = 7
-	SQLParserRULE_showBrokerMetaStmt = 8
-	SQLParserRULE_showMasterMetaStmt = 9
-	SQLParserRULE_showStorageMetaStmt = 10
-	SQLParserRULE_showAliveStmt = 11
-	SQLParserRULE_showReplicationStmt = 12
-	SQLParserRULE_showBrokerMetricStmt = 13
-	SQLParserRULE_showStorageMetricStmt = 14
-	SQLParserRULE_createStorageStmt = 15
-	SQLParserRULE_showSchemasStmt = 16
-	SQLParserRULE_createDatabaseStmt = 17
-	SQLParserRULE_dropDatabaseStmt = 18
-	SQLParserRULE_showDatabaseStmt = 19
-	SQLParserRULE_showNameSpacesStmt = 20
-	SQLParserRULE_showMetricsStmt = 21
-	SQLParserRULE_showFieldsStmt = 22
-	SQLParserRULE_showTagKeysStmt = 23
-	SQLParserRULE_showTagValuesStmt = 24
-	SQLParserRULE_prefix = 25
-	SQLParserRULE_withTagKey = 26
-	SQLParserRULE_namespace = 27
-	SQLParserRULE_databaseName = 28
-	SQLParserRULE_requestID = 29
-	SQLParserRULE_source = 30
-	SQLParserRULE_queryStmt = 31
-	SQLParserRULE_sourceAndSelect = 32
-	SQLParserRULE_selectExpr = 33
-	SQLParserRULE_fields = 34
-	SQLParserRULE_field = 35
-	SQLParserRULE_alias = 36
-	SQLParserRULE_storageFilter = 37
-	SQLParserRULE_databaseFilter = 38
-	SQLParserRULE_typeFilter = 39
-	SQLParserRULE_fromClause = 40
-	SQLParserRULE_whereClause = 41
-	SQLParserRULE_conditionExpr = 42
-	SQLParserRULE_tagFilterExpr = 43
-	SQLParserRULE_tagValueList = 44
-	SQLParserRULE_metricListFilter = 45
-	SQLParserRULE_metricList = 46
-	SQLParserRULE_timeRangeExpr = 47
-	SQLParserRULE_timeExpr = 48
-	SQLParserRULE_nowExpr = 49
-	SQLParserRULE_nowFunc = 50
-	SQLParserRULE_groupByClause = 51
-	SQLParserRULE_groupByKeys = 52
-	SQLParserRULE_groupByKey = 53
-	SQLParserRULE_fillOption = 54
-	SQLParserRULE_orderByClause = 55
-	SQLParserRULE_sortField = 56
-	SQLParserRULE_sortFields = 57
-	SQLParserRULE_havingClause = 58
-	SQLParserRULE_boolExpr = 59
-	SQLParserRULE_boolExprLogicalOp = 60
-	SQLParserRULE_boolExprAtom = 61
-	SQLParserRULE_binaryExpr = 62
-	SQLParserRULE_binaryOperator = 63
-	SQLParserRULE_fieldExpr = 64
-	SQLParserRULE_durationLit = 65
-	SQLParserRULE_intervalItem = 66
-	SQLParserRULE_exprFunc = 67
-	SQLParserRULE_funcName = 68
-	SQLParserRULE_exprFuncParams = 69
-	SQLParserRULE_funcParam = 70
-	SQLParserRULE_exprAtom = 71
-	SQLParserRULE_identFilter = 72
-	SQLParserRULE_json = 73
-	SQLParserRULE_obj = 74
-	SQLParserRULE_pair = 75
-	SQLParserRULE_arr = 76
-	SQLParserRULE_value = 77
-	SQLParserRULE_intNumber = 78
-	SQLParserRULE_decNumber = 79
-	SQLParserRULE_limitClause = 80
-	SQLParserRULE_metricName = 81
-	SQLParserRULE_tagKey = 82
-	SQLParserRULE_tagValue = 83
-	SQLParserRULE_ident = 84
-	SQLParserRULE_nonReservedWords = 85
+	SQLParserRULE_killQueryStmt = 6
+	SQLParserRULE_showStoragesStmt = 7
+	SQLParserRULE_showMetadataTypesStmt = 8
+	SQLParserRULE_showBrokerMetaStmt = 9
+	SQLParserRULE_showMasterMetaStmt = 10
+	SQLParserRULE_showStorageMetaStmt = 11
+	SQLParserRULE_showAliveStmt = 12
+	SQLParserRULE_showReplicationStmt = 13
+	SQLParserRULE_showBrokerMetricStmt = 14
+	SQLParserRULE_showStorageMetricStmt = 15
+	SQLParserRULE_showSeriesQuotaStmt = 16
+	SQLParserRULE_createStorageStmt = 17
+	SQLParserRULE_showSchemasStmt = 18
+	SQLParserRULE_createDatabaseStmt = 19
+	SQLParserRULE_dropDatabaseStmt = 20
+	SQLParserRULE_alterDatabaseStmt = 21
+	SQLParserRULE_deleteStmt = 22
+	SQLParserRULE_showDatabaseStmt = 23
+	SQLParserRULE_showNameSpacesStmt = 24
+	SQLParserRULE_showMetricsStmt = 25
+	SQLParserRULE_showFieldsStmt = 26
+	SQLParserRULE_showTagKeysStmt = 27
+	SQLParserRULE_showTagValuesStmt = 28
+	SQLParserRULE_showCardinalityStmt = 29
+	SQLParserRULE_showTagCardinalityStmt = 30
+	SQLParserRULE_showUsersStmt = 31
+	SQLParserRULE_createUserStmt = 32
+	SQLParserRULE_dropUserStmt = 33
+	SQLParserRULE_createTokenStmt = 34
+	SQLParserRULE_grantStmt = 35
+	SQLParserRULE_revokeStmt = 36
+	SQLParserRULE_userName = 37
+	SQLParserRULE_password = 38
+	SQLParserRULE_roleName = 39
+	SQLParserRULE_privilegeDatabase = 40
+	SQLParserRULE_prefix = 41
+	SQLParserRULE_withTagKey = 42
+	SQLParserRULE_namespace = 43
+	SQLParserRULE_databaseName = 44
+	SQLParserRULE_requestID = 45
+	SQLParserRULE_source = 46
+	SQLParserRULE_queryStmt = 47
+	SQLParserRULE_sourceAndSelect = 48
+	SQLParserRULE_selectExpr = 49
+	SQLParserRULE_fields = 50
+	SQLParserRULE_field = 51
+	SQLParserRULE_alias = 52
+	SQLParserRULE_storageFilter = 53
+	SQLParserRULE_databaseFilter = 54
+	SQLParserRULE_typeFilter = 55
+	SQLParserRULE_fromClause = 56
+	SQLParserRULE_metricAlias = 57
+	SQLParserRULE_metricOffset = 58
+	SQLParserRULE_whereClause = 59
+	SQLParserRULE_conditionExpr = 60
+	SQLParserRULE_tagFilterExpr = 61
+	SQLParserRULE_tagValueList = 62
+	SQLParserRULE_metricListFilter = 63
+	SQLParserRULE_metricList = 64
+	SQLParserRULE_timeRangeExpr = 65
+	SQLParserRULE_timeExpr = 66
+	SQLParserRULE_nowExpr = 67
+	SQLParserRULE_nowFunc = 68
+	SQLParserRULE_groupByClause = 69
+	SQLParserRULE_groupByKeys = 70
+	SQLParserRULE_groupByKey = 71
+	SQLParserRULE_fillOption = 72
+	SQLParserRULE_orderByClause = 73
+	SQLParserRULE_sortField = 74
+	SQLParserRULE_sortFields = 75
+	SQLParserRULE_havingClause = 76
+	SQLParserRULE_boolExpr = 77
+	SQLParserRULE_boolExprLogicalOp = 78
+	SQLParserRULE_boolExprAtom = 79
+	SQLParserRULE_binaryExpr = 80
+	SQLParserRULE_binaryOperator = 81
+	SQLParserRULE_fieldExpr = 82
+	SQLParserRULE_durationLit = 83
+	SQLParserRULE_intervalItem = 84
+	SQLParserRULE_exprFunc = 85
+	SQLParserRULE_funcName = 86
+	SQLParserRULE_exprFuncParams = 87
+	SQLParserRULE_funcParam = 88
+	SQLParserRULE_exprAtom = 89
+	SQLParserRULE_identFilter = 90
+	SQLParserRULE_json = 91
+	SQLParserRULE_obj = 92
+	SQLParserRULE_pair = 93
+	SQLParserRULE_arr = 94
+	SQLParserRULE_value = 95
+	SQLParserRULE_intNumber = 96
+	SQLParserRULE_decNumber = 97
+	SQLParserRULE_limitClause = 98
+	SQLParserRULE_offsetClause = 99
+	SQLParserRULE_metricName = 100
+	SQLParserRULE_tagKey = 101
+	SQLParserRULE_tagRangeKey = 102
+	SQLParserRULE_tagValue = 103
+	SQLParserRULE_ident = 104
+	SQLParserRULE_nonReservedWords = 105
 )
 
 // IStatementContext is an interface to support dynamic dispatch.
@@ -834,6 +976,134 @@ func (s *StatementContext) DropDatabaseStmt() IDropDatabaseStmtContext {
 	return t.(IDropDatabaseStmtContext)
 }
 
+func (s *StatementContext) AlterDatabaseStmt() IAlterDatabaseStmtContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAlterDatabaseStmtContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAlterDatabaseStmtContext)
+}
+
+func (s *StatementContext) DeleteStmt() IDeleteStmtContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDeleteStmtContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDeleteStmtContext)
+}
+
+func (s *StatementContext) CreateUserStmt() ICreateUserStmtContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreateUserStmtContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICreateUserStmtContext)
+}
+
+func (s *StatementContext) DropUserStmt() IDropUserStmtContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDropUserStmtContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDropUserStmtContext)
+}
+
+func (s *StatementContext) CreateTokenStmt() ICreateTokenStmtContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICreateTokenStmtContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICreateTokenStmtContext)
+}
+
+func (s *StatementContext) GrantStmt() IGrantStmtContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGrantStmtContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGrantStmtContext)
+}
+
+func (s *StatementContext) RevokeStmt() IRevokeStmtContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRevokeStmtContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRevokeStmtContext)
+}
+
+func (s *StatementContext) KillQueryStmt() IKillQueryStmtContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IKillQueryStmtContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IKillQueryStmtContext)
+}
+
 func (s *StatementContext) Ident() IIdentContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
@@ -911,13 +1181,13 @@ func (p *SQLParser) Statement() (localctx IStatementContext) {
 		}
 	}()
 
-	p.SetState(181)
+	p.SetState(229)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(172)
+			p.SetState(212)
 			p.ShowStmt()
 		}
 
@@ -925,7 +1195,7 @@ func (p *SQLParser) Statement() (localctx IStatementContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(173)
+			p.SetState(213)
 			p.CreateStorageStmt()
 		}
 
@@ -933,7 +1203,7 @@ func (p *SQLParser) Statement() (localctx IStatementContext) {
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(174)
+			p.SetState(214)
 			p.UseStmt()
 		}
 
@@ -941,7 +1211,7 @@ func (p *SQLParser) Statement() (localctx IStatementContext) {
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(175)
+			p.SetState(215)
 			p.QueryStmt()
 		}
 
@@ -949,7 +1219,7 @@ func (p *SQLParser) Statement() (localctx IStatementContext) {
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(176)
+			p.SetState(216)
 			p.CreateDatabaseStmt()
 		}
 
@@ -957,7 +1227,7 @@ func (p *SQLParser) Statement() (localctx IStatementContext) {
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(177)
+			p.SetState(217)
 			p.DropDatabaseStmt()
 		}
 
@@ -965,11 +1235,75 @@ func (p *SQLParser) Statement() (localctx IStatementContext) {
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(178)
+			p.SetState(218)
+			p.AlterDatabaseStmt()
+		}
+
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(219)
+			p.DeleteStmt()
+		}
+
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(220)
+			p.CreateUserStmt()
+		}
+
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(221)
+			p.DropUserStmt()
+		}
+
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(222)
+			p.CreateTokenStmt()
+		}
+
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(223)
+			p.GrantStmt()
+		}
+
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(224)
+			p.RevokeStmt()
+		}
+
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(225)
+			p.KillQueryStmt()
+		}
+
+
+	case 15:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(226)
 			p.Ident()
 		}
 		{
-			p.SetState(179)
+			p.SetState(227)
 			p.Match(SQLParserEOF)
 		}
 
@@ -1097,11 +1431,11 @@ func (p *SQLParser) UseStmt() (localctx IUseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(183)
+		p.SetState(231)
 		p.Match(SQLParserT_USE)
 	}
 	{
-		p.SetState(184)
+		p.SetState(232)
 		p.Ident()
 	}
 
@@ -1453,6 +1787,70 @@ func (s *ShowStmtContext) ShowRequestStmt() IShowRequestStmtContext {
 	return t.(IShowRequestStmtContext)
 }
 
+func (s *ShowStmtContext) ShowSeriesQuotaStmt() IShowSeriesQuotaStmtContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowSeriesQuotaStmtContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowSeriesQuotaStmtContext)
+}
+
+func (s *ShowStmtContext) ShowCardinalityStmt() IShowCardinalityStmtContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowCardinalityStmtContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowCardinalityStmtContext)
+}
+
+func (s *ShowStmtContext) ShowTagCardinalityStmt() IShowTagCardinalityStmtContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowTagCardinalityStmtContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowTagCardinalityStmtContext)
+}
+
+func (s *ShowStmtContext) ShowUsersStmt() IShowUsersStmtContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IShowUsersStmtContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShowUsersStmtContext)
+}
+
 func (s *ShowStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -1510,13 +1908,13 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 		}
 	}()
 
-	p.SetState(205)
+	p.SetState(257)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(186)
+			p.SetState(234)
 			p.ShowMasterStmt()
 		}
 
@@ -1524,7 +1922,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(187)
+			p.SetState(235)
 			p.ShowMetadataTypesStmt()
 		}
 
@@ -1532,7 +1930,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(188)
+			p.SetState(236)
 			p.ShowBrokerMetaStmt()
 		}
 
@@ -1540,7 +1938,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(189)
+			p.SetState(237)
 			p.ShowMasterMetaStmt()
 		}
 
@@ -1548,7 +1946,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(190)
+			p.SetState(238)
 			p.ShowStorageMetaStmt()
 		}
 
@@ -1556,7 +1954,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(191)
+			p.SetState(239)
 			p.ShowStoragesStmt()
 		}
 
@@ -1564,7 +1962,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(192)
+			p.SetState(240)
 			p.ShowAliveStmt()
 		}
 
@@ -1572,7 +1970,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(193)
+			p.SetState(241)
 			p.ShowBrokerMetricStmt()
 		}
 
@@ -1580,7 +1978,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(194)
+			p.SetState(242)
 			p.ShowStorageMetricStmt()
 		}
 
@@ -1588,7 +1986,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(195)
+			p.SetState(243)
 			p.ShowReplicationStmt()
 		}
 
@@ -1596,7 +1994,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(196)
+			p.SetState(244)
 			p.ShowSchemasStmt()
 		}
 
@@ -1604,7 +2002,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 12:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(197)
+			p.SetState(245)
 			p.ShowDatabaseStmt()
 		}
 
@@ -1612,7 +2010,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 13:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(198)
+			p.SetState(246)
 			p.ShowNameSpacesStmt()
 		}
 
@@ -1620,7 +2018,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 14:
 		p.EnterOuterAlt(localctx, 14)
 		{
-			p.SetState(199)
+			p.SetState(247)
 			p.ShowMetricsStmt()
 		}
 
@@ -1628,7 +2026,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 15:
 		p.EnterOuterAlt(localctx, 15)
 		{
-			p.SetState(200)
+			p.SetState(248)
 			p.ShowFieldsStmt()
 		}
 
@@ -1636,7 +2034,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 16:
 		p.EnterOuterAlt(localctx, 16)
 		{
-			p.SetState(201)
+			p.SetState(249)
 			p.ShowTagKeysStmt()
 		}
 
@@ -1644,7 +2042,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 17:
 		p.EnterOuterAlt(localctx, 17)
 		{
-			p.SetState(202)
+			p.SetState(250)
 			p.ShowTagValuesStmt()
 		}
 
@@ -1652,7 +2050,7 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 18:
 		p.EnterOuterAlt(localctx, 18)
 		{
-			p.SetState(203)
+			p.SetState(251)
 			p.ShowRequestsStmt()
 		}
 
@@ -1660,10 +2058,42 @@ func (p *SQLParser) ShowStmt() (localctx IShowStmtContext) {
 	case 19:
 		p.EnterOuterAlt(localctx, 19)
 		{
-			p.SetState(204)
+			p.SetState(252)
 			p.ShowRequestStmt()
 		}
 
+
+	case 20:
+		p.EnterOuterAlt(localctx, 20)
+		{
+			p.SetState(253)
+			p.ShowSeriesQuotaStmt()
+		}
+
+
+	case 21:
+		p.EnterOuterAlt(localctx, 21)
+		{
+			p.SetState(254)
+			p.ShowCardinalityStmt()
+		}
+
+
+	case 22:
+		p.EnterOuterAlt(localctx, 22)
+		{
+			p.SetState(255)
+			p.ShowTagCardinalityStmt()
+		}
+
+
+	case 23:
+		p.EnterOuterAlt(localctx, 23)
+		{
+			p.SetState(256)
+			p.ShowUsersStmt()
+		}
+
 	}
 
 
@@ -1776,11 +2206,11 @@ func (p *SQLParser) ShowMasterStmt() (localctx IShowMasterStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(207)
+		p.SetState(259)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(208)
+		p.SetState(260)
 		p.Match(SQLParserT_MASTER)
 	}
 
@@ -1895,11 +2325,11 @@ func (p *SQLParser) ShowRequestsStmt() (localctx IShowRequestsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(210)
+		p.SetState(262)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(211)
+		p.SetState(263)
 		p.Match(SQLParserT_REQUESTS)
 	}
 
@@ -2042,27 +2472,27 @@ func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(213)
+		p.SetState(265)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(214)
+		p.SetState(266)
 		p.Match(SQLParserT_REQUEST)
 	}
 	{
-		p.SetState(215)
+		p.SetState(267)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(216)
+		p.SetState(268)
 		p.Match(SQLParserT_ID)
 	}
 	{
-		p.SetState(217)
+		p.SetState(269)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(218)
+		p.SetState(270)
 		p.RequestID()
 	}
 
@@ -2072,54 +2502,193 @@ func (p *SQLParser) ShowRequestStmt() (localctx IShowRequestStmtContext) {
 }
 
 
-// IShowStoragesStmtContext is an interface to support dynamic dispatch.
-type IShowStoragesStmtContext interface {
+// IKillQueryStmtContext is an interface to support dynamic dispatch.
+type IKillQueryStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowStoragesStmtContext differentiates from other interfaces.
-	IsShowStoragesStmtContext()
+	// IsKillQueryStmtContext differentiates from other interfaces.
+	IsKillQueryStmtContext()
 }
 
-type ShowStoragesStmtContext struct {
+type KillQueryStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowStoragesStmtContext() *ShowStoragesStmtContext {
-	var p = new(ShowStoragesStmtContext)
+func NewEmptyKillQueryStmtContext() *KillQueryStmtContext {
+	var p = new(KillQueryStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showStoragesStmt
+	p.RuleIndex = SQLParserRULE_killQueryStmt
 	return p
 }
 
-func (*ShowStoragesStmtContext) IsShowStoragesStmtContext() {}
+func (*KillQueryStmtContext) IsKillQueryStmtContext() {}
 
-func NewShowStoragesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStoragesStmtContext {
-	var p = new(ShowStoragesStmtContext)
+func NewKillQueryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *KillQueryStmtContext {
+	var p = new(KillQueryStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showStoragesStmt
+	p.RuleIndex = SQLParserRULE_killQueryStmt
 
 	return p
 }
 
-func (s *ShowStoragesStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowStoragesStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
+func (s *KillQueryStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowStoragesStmtContext) T_STORAGES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGES, 0)
+func (s *KillQueryStmtContext) T_KILL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_KILL, 0)
 }
 
-func (s *ShowStoragesStmtContext) GetRuleContext() antlr.RuleContext {
-	return s
+func (s *KillQueryStmtContext) T_QUERY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_QUERY, 0)
+}
+
+func (s *KillQueryStmtContext) RequestID() IRequestIDContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IRequestIDContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IRequestIDContext)
+}
+
+func (s *KillQueryStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *KillQueryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *KillQueryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterKillQueryStmt(s)
+	}
+}
+
+func (s *KillQueryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitKillQueryStmt(s)
+	}
+}
+
+func (s *KillQueryStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitKillQueryStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) KillQueryStmt() (localctx IKillQueryStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewKillQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, SQLParserRULE_killQueryStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(272)
+		p.Match(SQLParserT_KILL)
+	}
+	{
+		p.SetState(273)
+		p.Match(SQLParserT_QUERY)
+	}
+	{
+		p.SetState(274)
+		p.RequestID()
+	}
+
+
+
+	return localctx
+}
+
+
+// IShowStoragesStmtContext is an interface to support dynamic dispatch.
+type IShowStoragesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowStoragesStmtContext differentiates from other interfaces.
+	IsShowStoragesStmtContext()
+}
+
+type ShowStoragesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowStoragesStmtContext() *ShowStoragesStmtContext {
+	var p = new(ShowStoragesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showStoragesStmt
+	return p
+}
+
+func (*ShowStoragesStmtContext) IsShowStoragesStmtContext() {}
+
+func NewShowStoragesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowStoragesStmtContext {
+	var p = new(ShowStoragesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showStoragesStmt
+
+	return p
+}
+
+func (s *ShowStoragesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowStoragesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowStoragesStmtContext) T_STORAGES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGES, 0)
+}
+
+func (s *ShowStoragesStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
 func (s *ShowStoragesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
@@ -2157,7 +2726,7 @@ func (p *SQLParser) ShowStoragesStmt() (localctx IShowStoragesStmtContext) {
 	_ = this
 
 	localctx = NewShowStoragesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, SQLParserRULE_showStoragesStmt)
+	p.EnterRule(localctx, 14, SQLParserRULE_showStoragesStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2177,11 +2746,11 @@ func (p *SQLParser) ShowStoragesStmt() (localctx IShowStoragesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(220)
+		p.SetState(276)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(221)
+		p.SetState(277)
 		p.Match(SQLParserT_STORAGES)
 	}
 
@@ -2280,7 +2849,7 @@ func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtCont
 	_ = this
 
 	localctx = NewShowMetadataTypesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, SQLParserRULE_showMetadataTypesStmt)
+	p.EnterRule(localctx, 16, SQLParserRULE_showMetadataTypesStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2300,15 +2869,15 @@ func (p *SQLParser) ShowMetadataTypesStmt() (localctx IShowMetadataTypesStmtCont
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(223)
+		p.SetState(279)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(224)
+		p.SetState(280)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(225)
+		p.SetState(281)
 		p.Match(SQLParserT_TYPES)
 	}
 
@@ -2447,7 +3016,7 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 	_ = this
 
 	localctx = NewShowBrokerMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, SQLParserRULE_showBrokerMetaStmt)
+	p.EnterRule(localctx, 18, SQLParserRULE_showBrokerMetaStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2467,31 +3036,31 @@ func (p *SQLParser) ShowBrokerMetaStmt() (localctx IShowBrokerMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(227)
+		p.SetState(283)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(228)
+		p.SetState(284)
 		p.Match(SQLParserT_BROKER)
 	}
 	{
-		p.SetState(229)
+		p.SetState(285)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(230)
+		p.SetState(286)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(231)
+		p.SetState(287)
 		p.Source()
 	}
 	{
-		p.SetState(232)
+		p.SetState(288)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(233)
+		p.SetState(289)
 		p.TypeFilter()
 	}
 
@@ -2630,7 +3199,7 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 	_ = this
 
 	localctx = NewShowMasterMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, SQLParserRULE_showMasterMetaStmt)
+	p.EnterRule(localctx, 20, SQLParserRULE_showMasterMetaStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2650,31 +3219,31 @@ func (p *SQLParser) ShowMasterMetaStmt() (localctx IShowMasterMetaStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(235)
+		p.SetState(291)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(236)
+		p.SetState(292)
 		p.Match(SQLParserT_MASTER)
 	}
 	{
-		p.SetState(237)
+		p.SetState(293)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(238)
+		p.SetState(294)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(239)
+		p.SetState(295)
 		p.Source()
 	}
 	{
-		p.SetState(240)
+		p.SetState(296)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(241)
+		p.SetState(297)
 		p.TypeFilter()
 	}
 
@@ -2883,7 +3452,7 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 	_ = this
 
 	localctx = NewShowStorageMetaStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, SQLParserRULE_showStorageMetaStmt)
+	p.EnterRule(localctx, 22, SQLParserRULE_showStorageMetaStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -2903,43 +3472,43 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(243)
+		p.SetState(299)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(244)
+		p.SetState(300)
 		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(245)
+		p.SetState(301)
 		p.Match(SQLParserT_METADATA)
 	}
 	{
-		p.SetState(246)
+		p.SetState(302)
 		p.Match(SQLParserT_FROM)
 	}
 	{
-		p.SetState(247)
+		p.SetState(303)
 		p.Source()
 	}
 	{
-		p.SetState(248)
+		p.SetState(304)
 		p.Match(SQLParserT_WHERE)
 	}
-	p.SetState(251)
+	p.SetState(307)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(249)
+			p.SetState(305)
 			p.StorageFilter()
 		}
 
 
 	case SQLParserT_TYPE:
 		{
-			p.SetState(250)
+			p.SetState(306)
 			p.TypeFilter()
 		}
 
@@ -2949,23 +3518,23 @@ func (p *SQLParser) ShowStorageMetaStmt() (localctx IShowStorageMetaStmtContext)
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(253)
+		p.SetState(309)
 		p.Match(SQLParserT_AND)
 	}
-	p.SetState(256)
+	p.SetState(312)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(254)
+			p.SetState(310)
 			p.StorageFilter()
 		}
 
 
 	case SQLParserT_TYPE:
 		{
-			p.SetState(255)
+			p.SetState(311)
 			p.TypeFilter()
 		}
 
@@ -3074,7 +3643,7 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 	_ = this
 
 	localctx = NewShowAliveStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, SQLParserRULE_showAliveStmt)
+	p.EnterRule(localctx, 24, SQLParserRULE_showAliveStmt)
 	var _la int
 
 
@@ -3096,11 +3665,11 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(258)
+		p.SetState(314)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(259)
+		p.SetState(315)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_STORAGE || _la == SQLParserT_BROKER) {
@@ -3111,7 +3680,7 @@ func (p *SQLParser) ShowAliveStmt() (localctx IShowAliveStmtContext) {
 		}
 	}
 	{
-		p.SetState(260)
+		p.SetState(316)
 		p.Match(SQLParserT_ALIVE)
 	}
 
@@ -3296,7 +3865,7 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 	_ = this
 
 	localctx = NewShowReplicationStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, SQLParserRULE_showReplicationStmt)
+	p.EnterRule(localctx, 26, SQLParserRULE_showReplicationStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3316,31 +3885,31 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(262)
+		p.SetState(318)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(263)
+		p.SetState(319)
 		p.Match(SQLParserT_REPLICATION)
 	}
 	{
-		p.SetState(264)
+		p.SetState(320)
 		p.Match(SQLParserT_WHERE)
 	}
-	p.SetState(267)
+	p.SetState(323)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(265)
+			p.SetState(321)
 			p.StorageFilter()
 		}
 
 
 	case SQLParserT_DATASBAE:
 		{
-			p.SetState(266)
+			p.SetState(322)
 			p.DatabaseFilter()
 		}
 
@@ -3350,23 +3919,23 @@ func (p *SQLParser) ShowReplicationStmt() (localctx IShowReplicationStmtContext)
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(269)
+		p.SetState(325)
 		p.Match(SQLParserT_AND)
 	}
-	p.SetState(272)
+	p.SetState(328)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(270)
+			p.SetState(326)
 			p.StorageFilter()
 		}
 
 
 	case SQLParserT_DATASBAE:
 		{
-			p.SetState(271)
+			p.SetState(327)
 			p.DatabaseFilter()
 		}
 
@@ -3491,7 +4060,7 @@ func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContex
 	_ = this
 
 	localctx = NewShowBrokerMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, SQLParserRULE_showBrokerMetricStmt)
+	p.EnterRule(localctx, 28, SQLParserRULE_showBrokerMetricStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3511,23 +4080,23 @@ func (p *SQLParser) ShowBrokerMetricStmt() (localctx IShowBrokerMetricStmtContex
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(274)
+		p.SetState(330)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(275)
+		p.SetState(331)
 		p.Match(SQLParserT_BROKER)
 	}
 	{
-		p.SetState(276)
+		p.SetState(332)
 		p.Match(SQLParserT_METRIC)
 	}
 	{
-		p.SetState(277)
+		p.SetState(333)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(278)
+		p.SetState(334)
 		p.MetricListFilter()
 	}
 
@@ -3716,7 +4285,7 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 	_ = this
 
 	localctx = NewShowStorageMetricStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, SQLParserRULE_showStorageMetricStmt)
+	p.EnterRule(localctx, 30, SQLParserRULE_showStorageMetricStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -3736,35 +4305,35 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(280)
+		p.SetState(336)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(281)
+		p.SetState(337)
 		p.Match(SQLParserT_STORAGE)
 	}
 	{
-		p.SetState(282)
+		p.SetState(338)
 		p.Match(SQLParserT_METRIC)
 	}
 	{
-		p.SetState(283)
+		p.SetState(339)
 		p.Match(SQLParserT_WHERE)
 	}
-	p.SetState(286)
+	p.SetState(342)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(284)
+			p.SetState(340)
 			p.StorageFilter()
 		}
 
 
 	case SQLParserT_METRIC:
 		{
-			p.SetState(285)
+			p.SetState(341)
 			p.MetricListFilter()
 		}
 
@@ -3774,23 +4343,23 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 	{
-		p.SetState(288)
+		p.SetState(344)
 		p.Match(SQLParserT_AND)
 	}
-	p.SetState(291)
+	p.SetState(347)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_STORAGE:
 		{
-			p.SetState(289)
+			p.SetState(345)
 			p.StorageFilter()
 		}
 
 
 	case SQLParserT_METRIC:
 		{
-			p.SetState(290)
+			p.SetState(346)
 			p.MetricListFilter()
 		}
 
@@ -3806,56 +4375,64 @@ func (p *SQLParser) ShowStorageMetricStmt() (localctx IShowStorageMetricStmtCont
 }
 
 
-// ICreateStorageStmtContext is an interface to support dynamic dispatch.
-type ICreateStorageStmtContext interface {
+// IShowSeriesQuotaStmtContext is an interface to support dynamic dispatch.
+type IShowSeriesQuotaStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCreateStorageStmtContext differentiates from other interfaces.
-	IsCreateStorageStmtContext()
+	// IsShowSeriesQuotaStmtContext differentiates from other interfaces.
+	IsShowSeriesQuotaStmtContext()
 }
 
-type CreateStorageStmtContext struct {
+type ShowSeriesQuotaStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCreateStorageStmtContext() *CreateStorageStmtContext {
-	var p = new(CreateStorageStmtContext)
+func NewEmptyShowSeriesQuotaStmtContext() *ShowSeriesQuotaStmtContext {
+	var p = new(ShowSeriesQuotaStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_createStorageStmt
+	p.RuleIndex = SQLParserRULE_showSeriesQuotaStmt
 	return p
 }
 
-func (*CreateStorageStmtContext) IsCreateStorageStmtContext() {}
+func (*ShowSeriesQuotaStmtContext) IsShowSeriesQuotaStmtContext() {}
 
-func NewCreateStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateStorageStmtContext {
-	var p = new(CreateStorageStmtContext)
+func NewShowSeriesQuotaStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowSeriesQuotaStmtContext {
+	var p = new(ShowSeriesQuotaStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_createStorageStmt
+	p.RuleIndex = SQLParserRULE_showSeriesQuotaStmt
 
 	return p
 }
 
-func (s *CreateStorageStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowSeriesQuotaStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CreateStorageStmtContext) T_CREATE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_CREATE, 0)
+func (s *ShowSeriesQuotaStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *CreateStorageStmtContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
+func (s *ShowSeriesQuotaStmtContext) T_SERIES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SERIES, 0)
 }
 
-func (s *CreateStorageStmtContext) Json() IJsonContext {
+func (s *ShowSeriesQuotaStmtContext) T_QUOTA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_QUOTA, 0)
+}
+
+func (s *ShowSeriesQuotaStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowSeriesQuotaStmtContext) DatabaseFilter() IDatabaseFilterContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IJsonContext); ok {
+		if _, ok := ctx.(IDatabaseFilterContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -3865,34 +4442,50 @@ func (s *CreateStorageStmtContext) Json() IJsonContext {
 		return nil
 	}
 
-	return t.(IJsonContext)
+	return t.(IDatabaseFilterContext)
 }
 
-func (s *CreateStorageStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowSeriesQuotaStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowSeriesQuotaStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CreateStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowSeriesQuotaStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *CreateStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowSeriesQuotaStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterCreateStorageStmt(s)
+		listenerT.EnterShowSeriesQuotaStmt(s)
 	}
 }
 
-func (s *CreateStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowSeriesQuotaStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitCreateStorageStmt(s)
+		listenerT.ExitShowSeriesQuotaStmt(s)
 	}
 }
 
-func (s *CreateStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowSeriesQuotaStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitCreateStorageStmt(s)
+		return t.VisitShowSeriesQuotaStmt(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -3902,12 +4495,14 @@ func (s *CreateStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interf
 
 
 
-func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
+func (p *SQLParser) ShowSeriesQuotaStmt() (localctx IShowSeriesQuotaStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewCreateStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, SQLParserRULE_createStorageStmt)
+	localctx = NewShowSeriesQuotaStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, SQLParserRULE_showSeriesQuotaStmt)
+	var _la int
+
 
 	defer func() {
 		p.ExitRule()
@@ -3927,16 +4522,44 @@ func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(293)
-		p.Match(SQLParserT_CREATE)
+		p.SetState(349)
+		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(294)
-		p.Match(SQLParserT_STORAGE)
+		p.SetState(350)
+		p.Match(SQLParserT_SERIES)
 	}
 	{
-		p.SetState(295)
-		p.Json()
+		p.SetState(351)
+		p.Match(SQLParserT_QUOTA)
+	}
+	p.SetState(354)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(352)
+			p.Match(SQLParserT_WHERE)
+		}
+		{
+			p.SetState(353)
+			p.DatabaseFilter()
+		}
+
+	}
+	p.SetState(357)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(356)
+			p.LimitClause()
+		}
+
 	}
 
 
@@ -3945,8 +4568,147 @@ func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
 }
 
 
-// IShowSchemasStmtContext is an interface to support dynamic dispatch.
-type IShowSchemasStmtContext interface {
+// ICreateStorageStmtContext is an interface to support dynamic dispatch.
+type ICreateStorageStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCreateStorageStmtContext differentiates from other interfaces.
+	IsCreateStorageStmtContext()
+}
+
+type CreateStorageStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCreateStorageStmtContext() *CreateStorageStmtContext {
+	var p = new(CreateStorageStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_createStorageStmt
+	return p
+}
+
+func (*CreateStorageStmtContext) IsCreateStorageStmtContext() {}
+
+func NewCreateStorageStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateStorageStmtContext {
+	var p = new(CreateStorageStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_createStorageStmt
+
+	return p
+}
+
+func (s *CreateStorageStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CreateStorageStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
+}
+
+func (s *CreateStorageStmtContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
+}
+
+func (s *CreateStorageStmtContext) Json() IJsonContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJsonContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJsonContext)
+}
+
+func (s *CreateStorageStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CreateStorageStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *CreateStorageStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterCreateStorageStmt(s)
+	}
+}
+
+func (s *CreateStorageStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitCreateStorageStmt(s)
+	}
+}
+
+func (s *CreateStorageStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitCreateStorageStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) CreateStorageStmt() (localctx ICreateStorageStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewCreateStorageStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, SQLParserRULE_createStorageStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(359)
+		p.Match(SQLParserT_CREATE)
+	}
+	{
+		p.SetState(360)
+		p.Match(SQLParserT_STORAGE)
+	}
+	{
+		p.SetState(361)
+		p.Json()
+	}
+
+
+
+	return localctx
+}
+
+
+// IShowSchemasStmtContext is an interface to support dynamic dispatch.
+type IShowSchemasStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
@@ -4030,7 +4792,7 @@ func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
 	_ = this
 
 	localctx = NewShowSchemasStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, SQLParserRULE_showSchemasStmt)
+	p.EnterRule(localctx, 36, SQLParserRULE_showSchemasStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4050,11 +4812,11 @@ func (p *SQLParser) ShowSchemasStmt() (localctx IShowSchemasStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(297)
+		p.SetState(363)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(298)
+		p.SetState(364)
 		p.Match(SQLParserT_SCHEMAS)
 	}
 
@@ -4165,7 +4927,7 @@ func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
 	_ = this
 
 	localctx = NewCreateDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, SQLParserRULE_createDatabaseStmt)
+	p.EnterRule(localctx, 38, SQLParserRULE_createDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4185,15 +4947,15 @@ func (p *SQLParser) CreateDatabaseStmt() (localctx ICreateDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(300)
+		p.SetState(366)
 		p.Match(SQLParserT_CREATE)
 	}
 	{
-		p.SetState(301)
+		p.SetState(367)
 		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(302)
+		p.SetState(368)
 		p.Json()
 	}
 
@@ -4304,7 +5066,7 @@ func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
 	_ = this
 
 	localctx = NewDropDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, SQLParserRULE_dropDatabaseStmt)
+	p.EnterRule(localctx, 40, SQLParserRULE_dropDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4324,15 +5086,15 @@ func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(304)
+		p.SetState(370)
 		p.Match(SQLParserT_DROP)
 	}
 	{
-		p.SetState(305)
+		p.SetState(371)
 		p.Match(SQLParserT_DATASBAE)
 	}
 	{
-		p.SetState(306)
+		p.SetState(372)
 		p.DatabaseName()
 	}
 
@@ -4342,77 +5104,113 @@ func (p *SQLParser) DropDatabaseStmt() (localctx IDropDatabaseStmtContext) {
 }
 
 
-// IShowDatabaseStmtContext is an interface to support dynamic dispatch.
-type IShowDatabaseStmtContext interface {
+// IAlterDatabaseStmtContext is an interface to support dynamic dispatch.
+type IAlterDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowDatabaseStmtContext differentiates from other interfaces.
-	IsShowDatabaseStmtContext()
+	// IsAlterDatabaseStmtContext differentiates from other interfaces.
+	IsAlterDatabaseStmtContext()
 }
 
-type ShowDatabaseStmtContext struct {
+type AlterDatabaseStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowDatabaseStmtContext() *ShowDatabaseStmtContext {
-	var p = new(ShowDatabaseStmtContext)
+func NewEmptyAlterDatabaseStmtContext() *AlterDatabaseStmtContext {
+	var p = new(AlterDatabaseStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+	p.RuleIndex = SQLParserRULE_alterDatabaseStmt
 	return p
 }
 
-func (*ShowDatabaseStmtContext) IsShowDatabaseStmtContext() {}
+func (*AlterDatabaseStmtContext) IsAlterDatabaseStmtContext() {}
 
-func NewShowDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDatabaseStmtContext {
-	var p = new(ShowDatabaseStmtContext)
+func NewAlterDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AlterDatabaseStmtContext {
+	var p = new(AlterDatabaseStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showDatabaseStmt
+	p.RuleIndex = SQLParserRULE_alterDatabaseStmt
 
 	return p
 }
 
-func (s *ShowDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *AlterDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
+func (s *AlterDatabaseStmtContext) T_ALTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALTER, 0)
 }
 
-func (s *ShowDatabaseStmtContext) T_DATASBAES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAES, 0)
+func (s *AlterDatabaseStmtContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
 }
 
-func (s *ShowDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *AlterDatabaseStmtContext) DatabaseName() IDatabaseNameContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDatabaseNameContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDatabaseNameContext)
+}
+
+func (s *AlterDatabaseStmtContext) T_WITH() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH, 0)
+}
+
+func (s *AlterDatabaseStmtContext) Json() IJsonContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IJsonContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IJsonContext)
+}
+
+func (s *AlterDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *AlterDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *ShowDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *AlterDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowDatabaseStmt(s)
+		listenerT.EnterAlterDatabaseStmt(s)
 	}
 }
 
-func (s *ShowDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *AlterDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowDatabaseStmt(s)
+		listenerT.ExitAlterDatabaseStmt(s)
 	}
 }
 
-func (s *ShowDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *AlterDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowDatabaseStmt(s)
+		return t.VisitAlterDatabaseStmt(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -4422,12 +5220,12 @@ func (s *ShowDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interfa
 
 
 
-func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
+func (p *SQLParser) AlterDatabaseStmt() (localctx IAlterDatabaseStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, SQLParserRULE_showDatabaseStmt)
+	localctx = NewAlterDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, SQLParserRULE_alterDatabaseStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4447,12 +5245,24 @@ func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(308)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(374)
+		p.Match(SQLParserT_ALTER)
 	}
 	{
-		p.SetState(309)
-		p.Match(SQLParserT_DATASBAES)
+		p.SetState(375)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(376)
+		p.DatabaseName()
+	}
+	{
+		p.SetState(377)
+		p.Match(SQLParserT_WITH)
+	}
+	{
+		p.SetState(378)
+		p.Json()
 	}
 
 
@@ -4461,68 +5271,56 @@ func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
 }
 
 
-// IShowNameSpacesStmtContext is an interface to support dynamic dispatch.
-type IShowNameSpacesStmtContext interface {
+// IDeleteStmtContext is an interface to support dynamic dispatch.
+type IDeleteStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowNameSpacesStmtContext differentiates from other interfaces.
-	IsShowNameSpacesStmtContext()
+	// IsDeleteStmtContext differentiates from other interfaces.
+	IsDeleteStmtContext()
 }
 
-type ShowNameSpacesStmtContext struct {
+type DeleteStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowNameSpacesStmtContext() *ShowNameSpacesStmtContext {
-	var p = new(ShowNameSpacesStmtContext)
+func NewEmptyDeleteStmtContext() *DeleteStmtContext {
+	var p = new(DeleteStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	p.RuleIndex = SQLParserRULE_deleteStmt
 	return p
 }
 
-func (*ShowNameSpacesStmtContext) IsShowNameSpacesStmtContext() {}
+func (*DeleteStmtContext) IsDeleteStmtContext() {}
 
-func NewShowNameSpacesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowNameSpacesStmtContext {
-	var p = new(ShowNameSpacesStmtContext)
+func NewDeleteStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeleteStmtContext {
+	var p = new(DeleteStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	p.RuleIndex = SQLParserRULE_deleteStmt
 
 	return p
 }
 
-func (s *ShowNameSpacesStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShowNameSpacesStmtContext) T_SHOW() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SHOW, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) T_NAMESPACES() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NAMESPACES, 0)
-}
-
-func (s *ShowNameSpacesStmtContext) T_WHERE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WHERE, 0)
-}
+func (s *DeleteStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowNameSpacesStmtContext) T_NAMESPACE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_NAMESPACE, 0)
+func (s *DeleteStmtContext) T_DELETE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELETE, 0)
 }
 
-func (s *ShowNameSpacesStmtContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *DeleteStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
 }
 
-func (s *ShowNameSpacesStmtContext) Prefix() IPrefixContext {
+func (s *DeleteStmtContext) MetricName() IMetricNameContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IPrefixContext); ok {
+		if _, ok := ctx.(IMetricNameContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -4532,13 +5330,13 @@ func (s *ShowNameSpacesStmtContext) Prefix() IPrefixContext {
 		return nil
 	}
 
-	return t.(IPrefixContext)
+	return t.(IMetricNameContext)
 }
 
-func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
+func (s *DeleteStmtContext) WhereClause() IWhereClauseContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
+		if _, ok := ctx.(IWhereClauseContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -4548,34 +5346,54 @@ func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
 		return nil
 	}
 
-	return t.(ILimitClauseContext)
+	return t.(IWhereClauseContext)
 }
 
-func (s *ShowNameSpacesStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DeleteStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *DeleteStmtContext) Namespace() INamespaceContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamespaceContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamespaceContext)
+}
+
+func (s *DeleteStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowNameSpacesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DeleteStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *ShowNameSpacesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DeleteStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowNameSpacesStmt(s)
+		listenerT.EnterDeleteStmt(s)
 	}
 }
 
-func (s *ShowNameSpacesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DeleteStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowNameSpacesStmt(s)
+		listenerT.ExitDeleteStmt(s)
 	}
 }
 
-func (s *ShowNameSpacesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DeleteStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowNameSpacesStmt(s)
+		return t.VisitDeleteStmt(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -4585,12 +5403,12 @@ func (s *ShowNameSpacesStmtContext) Accept(visitor antlr.ParseTreeVisitor) inter
 
 
 
-func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
+func (p *SQLParser) DeleteStmt() (localctx IDeleteStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowNameSpacesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, SQLParserRULE_showNameSpacesStmt)
+	localctx = NewDeleteStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, SQLParserRULE_deleteStmt)
 	var _la int
 
 
@@ -4612,48 +5430,36 @@ func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(311)
-		p.Match(SQLParserT_SHOW)
+		p.SetState(380)
+		p.Match(SQLParserT_DELETE)
 	}
 	{
-		p.SetState(312)
-		p.Match(SQLParserT_NAMESPACES)
+		p.SetState(381)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(382)
+		p.MetricName()
 	}
-	p.SetState(317)
+	p.SetState(385)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(313)
-			p.Match(SQLParserT_WHERE)
-		}
-		{
-			p.SetState(314)
-			p.Match(SQLParserT_NAMESPACE)
-		}
+	if _la == SQLParserT_ON {
 		{
-			p.SetState(315)
-			p.Match(SQLParserT_EQUAL)
+			p.SetState(383)
+			p.Match(SQLParserT_ON)
 		}
 		{
-			p.SetState(316)
-			p.Prefix()
+			p.SetState(384)
+			p.Namespace()
 		}
 
 	}
-	p.SetState(320)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-
-	if _la == SQLParserT_LIMIT {
-		{
-			p.SetState(319)
-			p.LimitClause()
-		}
-
+	{
+		p.SetState(387)
+		p.WhereClause()
 	}
 
 
@@ -4662,85 +5468,184 @@ func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
 }
 
 
-// IShowMetricsStmtContext is an interface to support dynamic dispatch.
-type IShowMetricsStmtContext interface {
+// IShowDatabaseStmtContext is an interface to support dynamic dispatch.
+type IShowDatabaseStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShowMetricsStmtContext differentiates from other interfaces.
-	IsShowMetricsStmtContext()
+	// IsShowDatabaseStmtContext differentiates from other interfaces.
+	IsShowDatabaseStmtContext()
 }
 
-type ShowMetricsStmtContext struct {
+type ShowDatabaseStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShowMetricsStmtContext() *ShowMetricsStmtContext {
-	var p = new(ShowMetricsStmtContext)
+func NewEmptyShowDatabaseStmtContext() *ShowDatabaseStmtContext {
+	var p = new(ShowDatabaseStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	p.RuleIndex = SQLParserRULE_showDatabaseStmt
 	return p
 }
 
-func (*ShowMetricsStmtContext) IsShowMetricsStmtContext() {}
+func (*ShowDatabaseStmtContext) IsShowDatabaseStmtContext() {}
 
-func NewShowMetricsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetricsStmtContext {
-	var p = new(ShowMetricsStmtContext)
+func NewShowDatabaseStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowDatabaseStmtContext {
+	var p = new(ShowDatabaseStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	p.RuleIndex = SQLParserRULE_showDatabaseStmt
 
 	return p
 }
 
-func (s *ShowMetricsStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowDatabaseStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ShowMetricsStmtContext) T_SHOW() antlr.TerminalNode {
+func (s *ShowDatabaseStmtContext) T_SHOW() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SHOW, 0)
 }
 
-func (s *ShowMetricsStmtContext) T_METRICS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRICS, 0)
+func (s *ShowDatabaseStmtContext) T_DATASBAES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAES, 0)
 }
 
-func (s *ShowMetricsStmtContext) T_ON() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ON, 0)
+func (s *ShowDatabaseStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *ShowMetricsStmtContext) Namespace() INamespaceContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INamespaceContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
-		}
+func (s *ShowDatabaseStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *ShowDatabaseStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowDatabaseStmt(s)
 	}
+}
 
-	if t == nil {
-		return nil
+func (s *ShowDatabaseStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowDatabaseStmt(s)
 	}
+}
 
-	return t.(INamespaceContext)
+func (s *ShowDatabaseStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowDatabaseStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
 }
 
-func (s *ShowMetricsStmtContext) T_WHERE() antlr.TerminalNode {
+
+
+
+func (p *SQLParser) ShowDatabaseStmt() (localctx IShowDatabaseStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowDatabaseStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, SQLParserRULE_showDatabaseStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(389)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(390)
+		p.Match(SQLParserT_DATASBAES)
+	}
+
+
+
+	return localctx
+}
+
+
+// IShowNameSpacesStmtContext is an interface to support dynamic dispatch.
+type IShowNameSpacesStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowNameSpacesStmtContext differentiates from other interfaces.
+	IsShowNameSpacesStmtContext()
+}
+
+type ShowNameSpacesStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowNameSpacesStmtContext() *ShowNameSpacesStmtContext {
+	var p = new(ShowNameSpacesStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+	return p
+}
+
+func (*ShowNameSpacesStmtContext) IsShowNameSpacesStmtContext() {}
+
+func NewShowNameSpacesStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowNameSpacesStmtContext {
+	var p = new(ShowNameSpacesStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showNameSpacesStmt
+
+	return p
+}
+
+func (s *ShowNameSpacesStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowNameSpacesStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) T_NAMESPACES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACES, 0)
+}
+
+func (s *ShowNameSpacesStmtContext) T_WHERE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_WHERE, 0)
 }
 
-func (s *ShowMetricsStmtContext) T_METRIC() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_METRIC, 0)
+func (s *ShowNameSpacesStmtContext) T_NAMESPACE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NAMESPACE, 0)
 }
 
-func (s *ShowMetricsStmtContext) T_EQUAL() antlr.TerminalNode {
+func (s *ShowNameSpacesStmtContext) T_EQUAL() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_EQUAL, 0)
 }
 
-func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
+func (s *ShowNameSpacesStmtContext) Prefix() IPrefixContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IPrefixContext); ok {
@@ -4756,7 +5661,7 @@ func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
 	return t.(IPrefixContext)
 }
 
-func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
+func (s *ShowNameSpacesStmtContext) LimitClause() ILimitClauseContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(ILimitClauseContext); ok {
@@ -4772,31 +5677,31 @@ func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
 	return t.(ILimitClauseContext)
 }
 
-func (s *ShowMetricsStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowNameSpacesStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShowMetricsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowNameSpacesStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *ShowMetricsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowNameSpacesStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterShowMetricsStmt(s)
+		listenerT.EnterShowNameSpacesStmt(s)
 	}
 }
 
-func (s *ShowMetricsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowNameSpacesStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitShowMetricsStmt(s)
+		listenerT.ExitShowNameSpacesStmt(s)
 	}
 }
 
-func (s *ShowMetricsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowNameSpacesStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitShowMetricsStmt(s)
+		return t.VisitShowNameSpacesStmt(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -4806,12 +5711,12 @@ func (s *ShowMetricsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 
 
-func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
+func (p *SQLParser) ShowNameSpacesStmt() (localctx IShowNameSpacesStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewShowMetricsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, SQLParserRULE_showMetricsStmt)
+	localctx = NewShowNameSpacesStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, SQLParserRULE_showNameSpacesStmt)
 	var _la int
 
 
@@ -4833,61 +5738,282 @@ func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(322)
+		p.SetState(392)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(323)
-		p.Match(SQLParserT_METRICS)
+		p.SetState(393)
+		p.Match(SQLParserT_NAMESPACES)
 	}
-	p.SetState(326)
+	p.SetState(398)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
-	if _la == SQLParserT_ON {
+	if _la == SQLParserT_WHERE {
 		{
-			p.SetState(324)
-			p.Match(SQLParserT_ON)
+			p.SetState(394)
+			p.Match(SQLParserT_WHERE)
 		}
 		{
-			p.SetState(325)
-			p.Namespace()
+			p.SetState(395)
+			p.Match(SQLParserT_NAMESPACE)
 		}
-
-	}
-	p.SetState(332)
+		{
+			p.SetState(396)
+			p.Match(SQLParserT_EQUAL)
+		}
+		{
+			p.SetState(397)
+			p.Prefix()
+		}
+
+	}
+	p.SetState(401)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(400)
+			p.LimitClause()
+		}
+
+	}
+
+
+
+	return localctx
+}
+
+
+// IShowMetricsStmtContext is an interface to support dynamic dispatch.
+type IShowMetricsStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShowMetricsStmtContext differentiates from other interfaces.
+	IsShowMetricsStmtContext()
+}
+
+type ShowMetricsStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShowMetricsStmtContext() *ShowMetricsStmtContext {
+	var p = new(ShowMetricsStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_showMetricsStmt
+	return p
+}
+
+func (*ShowMetricsStmtContext) IsShowMetricsStmtContext() {}
+
+func NewShowMetricsStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowMetricsStmtContext {
+	var p = new(ShowMetricsStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_showMetricsStmt
+
+	return p
+}
+
+func (s *ShowMetricsStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShowMetricsStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_METRICS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRICS, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *ShowMetricsStmtContext) Namespace() INamespaceContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamespaceContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamespaceContext)
+}
+
+func (s *ShowMetricsStmtContext) T_WHERE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WHERE, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_METRIC() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_METRIC, 0)
+}
+
+func (s *ShowMetricsStmtContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *ShowMetricsStmtContext) Prefix() IPrefixContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPrefixContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPrefixContext)
+}
+
+func (s *ShowMetricsStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowMetricsStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ShowMetricsStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *ShowMetricsStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterShowMetricsStmt(s)
+	}
+}
+
+func (s *ShowMetricsStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitShowMetricsStmt(s)
+	}
+}
+
+func (s *ShowMetricsStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitShowMetricsStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) ShowMetricsStmt() (localctx IShowMetricsStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewShowMetricsStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, SQLParserRULE_showMetricsStmt)
+	var _la int
+
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(403)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(404)
+		p.Match(SQLParserT_METRICS)
+	}
+	p.SetState(407)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(405)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(406)
+			p.Namespace()
+		}
+
+	}
+	p.SetState(413)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	if _la == SQLParserT_WHERE {
 		{
-			p.SetState(328)
+			p.SetState(409)
 			p.Match(SQLParserT_WHERE)
 		}
 		{
-			p.SetState(329)
+			p.SetState(410)
 			p.Match(SQLParserT_METRIC)
 		}
 		{
-			p.SetState(330)
+			p.SetState(411)
 			p.Match(SQLParserT_EQUAL)
 		}
 		{
-			p.SetState(331)
+			p.SetState(412)
 			p.Prefix()
 		}
 
 	}
-	p.SetState(335)
+	p.SetState(416)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(334)
+			p.SetState(415)
 			p.LimitClause()
 		}
 
@@ -5000,7 +6126,7 @@ func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
 	_ = this
 
 	localctx = NewShowFieldsStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, SQLParserRULE_showFieldsStmt)
+	p.EnterRule(localctx, 52, SQLParserRULE_showFieldsStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5020,15 +6146,15 @@ func (p *SQLParser) ShowFieldsStmt() (localctx IShowFieldsStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(337)
+		p.SetState(418)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(338)
+		p.SetState(419)
 		p.Match(SQLParserT_FIELDS)
 	}
 	{
-		p.SetState(339)
+		p.SetState(420)
 		p.FromClause()
 	}
 
@@ -5143,7 +6269,7 @@ func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
 	_ = this
 
 	localctx = NewShowTagKeysStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, SQLParserRULE_showTagKeysStmt)
+	p.EnterRule(localctx, 54, SQLParserRULE_showTagKeysStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5163,19 +6289,19 @@ func (p *SQLParser) ShowTagKeysStmt() (localctx IShowTagKeysStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(341)
+		p.SetState(422)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(342)
+		p.SetState(423)
 		p.Match(SQLParserT_TAG)
 	}
 	{
-		p.SetState(343)
+		p.SetState(424)
 		p.Match(SQLParserT_KEYS)
 	}
 	{
-		p.SetState(344)
+		p.SetState(425)
 		p.FromClause()
 	}
 
@@ -5350,7 +6476,7 @@ func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
 	_ = this
 
 	localctx = NewShowTagValuesStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, SQLParserRULE_showTagValuesStmt)
+	p.EnterRule(localctx, 56, SQLParserRULE_showTagValuesStmt)
 	var _la int
 
 
@@ -5372,57 +6498,57 @@ func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(346)
+		p.SetState(427)
 		p.Match(SQLParserT_SHOW)
 	}
 	{
-		p.SetState(347)
+		p.SetState(428)
 		p.Match(SQLParserT_TAG)
 	}
 	{
-		p.SetState(348)
+		p.SetState(429)
 		p.Match(SQLParserT_VALUES)
 	}
 	{
-		p.SetState(349)
+		p.SetState(430)
 		p.FromClause()
 	}
 	{
-		p.SetState(350)
+		p.SetState(431)
 		p.Match(SQLParserT_WITH)
 	}
 	{
-		p.SetState(351)
+		p.SetState(432)
 		p.Match(SQLParserT_KEY)
 	}
 	{
-		p.SetState(352)
+		p.SetState(433)
 		p.Match(SQLParserT_EQUAL)
 	}
 	{
-		p.SetState(353)
+		p.SetState(434)
 		p.WithTagKey()
 	}
-	p.SetState(355)
+	p.SetState(436)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	if _la == SQLParserT_WHERE {
 		{
-			p.SetState(354)
+			p.SetState(435)
 			p.WhereClause()
 		}
 
 	}
-	p.SetState(358)
+	p.SetState(439)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	if _la == SQLParserT_LIMIT {
 		{
-			p.SetState(357)
+			p.SetState(438)
 			p.LimitClause()
 		}
 
@@ -5434,48 +6560,60 @@ func (p *SQLParser) ShowTagValuesStmt() (localctx IShowTagValuesStmtContext) {
 }
 
 
-// IPrefixContext is an interface to support dynamic dispatch.
-type IPrefixContext interface {
+// IShowCardinalityStmtContext is an interface to support dynamic dispatch.
+type IShowCardinalityStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsPrefixContext differentiates from other interfaces.
-	IsPrefixContext()
+	// IsShowCardinalityStmtContext differentiates from other interfaces.
+	IsShowCardinalityStmtContext()
 }
 
-type PrefixContext struct {
+type ShowCardinalityStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPrefixContext() *PrefixContext {
-	var p = new(PrefixContext)
+func NewEmptyShowCardinalityStmtContext() *ShowCardinalityStmtContext {
+	var p = new(ShowCardinalityStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_prefix
+	p.RuleIndex = SQLParserRULE_showCardinalityStmt
 	return p
 }
 
-func (*PrefixContext) IsPrefixContext() {}
+func (*ShowCardinalityStmtContext) IsShowCardinalityStmtContext() {}
 
-func NewPrefixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrefixContext {
-	var p = new(PrefixContext)
+func NewShowCardinalityStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowCardinalityStmtContext {
+	var p = new(ShowCardinalityStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_prefix
+	p.RuleIndex = SQLParserRULE_showCardinalityStmt
 
 	return p
 }
 
-func (s *PrefixContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowCardinalityStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PrefixContext) Ident() IIdentContext {
+func (s *ShowCardinalityStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowCardinalityStmtContext) T_CARDINALITY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CARDINALITY, 0)
+}
+
+func (s *ShowCardinalityStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *ShowCardinalityStmtContext) Namespace() INamespaceContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(INamespaceContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -5485,34 +6623,50 @@ func (s *PrefixContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(INamespaceContext)
 }
 
-func (s *PrefixContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowCardinalityStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowCardinalityStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PrefixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowCardinalityStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *PrefixContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowCardinalityStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterPrefix(s)
+		listenerT.EnterShowCardinalityStmt(s)
 	}
 }
 
-func (s *PrefixContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowCardinalityStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitPrefix(s)
+		listenerT.ExitShowCardinalityStmt(s)
 	}
 }
 
-func (s *PrefixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowCardinalityStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitPrefix(s)
+		return t.VisitShowCardinalityStmt(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -5522,12 +6676,14 @@ func (s *PrefixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) Prefix() (localctx IPrefixContext) {
+func (p *SQLParser) ShowCardinalityStmt() (localctx IShowCardinalityStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, SQLParserRULE_prefix)
+	localctx = NewShowCardinalityStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, SQLParserRULE_showCardinalityStmt)
+	var _la int
+
 
 	defer func() {
 		p.ExitRule()
@@ -5547,8 +6703,40 @@ func (p *SQLParser) Prefix() (localctx IPrefixContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(360)
-		p.Ident()
+		p.SetState(441)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(442)
+		p.Match(SQLParserT_CARDINALITY)
+	}
+	p.SetState(445)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(443)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(444)
+			p.Namespace()
+		}
+
+	}
+	p.SetState(448)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(447)
+			p.LimitClause()
+		}
+
 	}
 
 
@@ -5557,48 +6745,60 @@ func (p *SQLParser) Prefix() (localctx IPrefixContext) {
 }
 
 
-// IWithTagKeyContext is an interface to support dynamic dispatch.
-type IWithTagKeyContext interface {
+// IShowTagCardinalityStmtContext is an interface to support dynamic dispatch.
+type IShowTagCardinalityStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsWithTagKeyContext differentiates from other interfaces.
-	IsWithTagKeyContext()
+	// IsShowTagCardinalityStmtContext differentiates from other interfaces.
+	IsShowTagCardinalityStmtContext()
 }
 
-type WithTagKeyContext struct {
+type ShowTagCardinalityStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyWithTagKeyContext() *WithTagKeyContext {
-	var p = new(WithTagKeyContext)
+func NewEmptyShowTagCardinalityStmtContext() *ShowTagCardinalityStmtContext {
+	var p = new(ShowTagCardinalityStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_withTagKey
+	p.RuleIndex = SQLParserRULE_showTagCardinalityStmt
 	return p
 }
 
-func (*WithTagKeyContext) IsWithTagKeyContext() {}
+func (*ShowTagCardinalityStmtContext) IsShowTagCardinalityStmtContext() {}
 
-func NewWithTagKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithTagKeyContext {
-	var p = new(WithTagKeyContext)
+func NewShowTagCardinalityStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowTagCardinalityStmtContext {
+	var p = new(ShowTagCardinalityStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_withTagKey
+	p.RuleIndex = SQLParserRULE_showTagCardinalityStmt
 
 	return p
 }
 
-func (s *WithTagKeyContext) GetParser() antlr.Parser { return s.parser }
+func (s *ShowTagCardinalityStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WithTagKeyContext) Ident() IIdentContext {
+func (s *ShowTagCardinalityStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
+
+func (s *ShowTagCardinalityStmtContext) T_TAG() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TAG, 0)
+}
+
+func (s *ShowTagCardinalityStmtContext) T_CARDINALITY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CARDINALITY, 0)
+}
+
+func (s *ShowTagCardinalityStmtContext) FromClause() IFromClauseContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IFromClauseContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -5608,34 +6808,50 @@ func (s *WithTagKeyContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IFromClauseContext)
 }
 
-func (s *WithTagKeyContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowTagCardinalityStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *ShowTagCardinalityStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *WithTagKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowTagCardinalityStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *WithTagKeyContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowTagCardinalityStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterWithTagKey(s)
+		listenerT.EnterShowTagCardinalityStmt(s)
 	}
 }
 
-func (s *WithTagKeyContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowTagCardinalityStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitWithTagKey(s)
+		listenerT.ExitShowTagCardinalityStmt(s)
 	}
 }
 
-func (s *WithTagKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowTagCardinalityStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitWithTagKey(s)
+		return t.VisitShowTagCardinalityStmt(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -5645,12 +6861,14 @@ func (s *WithTagKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
+func (p *SQLParser) ShowTagCardinalityStmt() (localctx IShowTagCardinalityStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewWithTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, SQLParserRULE_withTagKey)
+	localctx = NewShowTagCardinalityStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, SQLParserRULE_showTagCardinalityStmt)
+	var _la int
+
 
 	defer func() {
 		p.ExitRule()
@@ -5670,8 +6888,32 @@ func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(362)
-		p.Ident()
+		p.SetState(450)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(451)
+		p.Match(SQLParserT_TAG)
+	}
+	{
+		p.SetState(452)
+		p.Match(SQLParserT_CARDINALITY)
+	}
+	{
+		p.SetState(453)
+		p.FromClause()
+	}
+	p.SetState(455)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(454)
+			p.LimitClause()
+		}
+
 	}
 
 
@@ -5680,85 +6922,77 @@ func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
 }
 
 
-// INamespaceContext is an interface to support dynamic dispatch.
-type INamespaceContext interface {
+// IShowUsersStmtContext is an interface to support dynamic dispatch.
+type IShowUsersStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsNamespaceContext differentiates from other interfaces.
-	IsNamespaceContext()
+	// IsShowUsersStmtContext differentiates from other interfaces.
+	IsShowUsersStmtContext()
 }
 
-type NamespaceContext struct {
+type ShowUsersStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyNamespaceContext() *NamespaceContext {
-	var p = new(NamespaceContext)
+func NewEmptyShowUsersStmtContext() *ShowUsersStmtContext {
+	var p = new(ShowUsersStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_namespace
+	p.RuleIndex = SQLParserRULE_showUsersStmt
 	return p
 }
 
-func (*NamespaceContext) IsNamespaceContext() {}
+func (*ShowUsersStmtContext) IsShowUsersStmtContext() {}
 
-func NewNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceContext {
-	var p = new(NamespaceContext)
+func NewShowUsersStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShowUsersStmtContext {
+	var p = new(ShowUsersStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_namespace
+	p.RuleIndex = SQLParserRULE_showUsersStmt
 
 	return p
 }
 
-func (s *NamespaceContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *NamespaceContext) Ident() IIdentContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
-		}
-	}
+func (s *ShowUsersStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	if t == nil {
-		return nil
-	}
+func (s *ShowUsersStmtContext) T_SHOW() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SHOW, 0)
+}
 
-	return t.(IIdentContext)
+func (s *ShowUsersStmtContext) T_USERS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USERS, 0)
 }
 
-func (s *NamespaceContext) GetRuleContext() antlr.RuleContext {
+func (s *ShowUsersStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *NamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShowUsersStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *NamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShowUsersStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterNamespace(s)
+		listenerT.EnterShowUsersStmt(s)
 	}
 }
 
-func (s *NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShowUsersStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitNamespace(s)
+		listenerT.ExitShowUsersStmt(s)
 	}
 }
 
-func (s *NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ShowUsersStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitNamespace(s)
+		return t.VisitShowUsersStmt(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -5768,12 +7002,12 @@ func (s *NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) Namespace() (localctx INamespaceContext) {
+func (p *SQLParser) ShowUsersStmt() (localctx IShowUsersStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, SQLParserRULE_namespace)
+	localctx = NewShowUsersStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, SQLParserRULE_showUsersStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5793,8 +7027,12 @@ func (p *SQLParser) Namespace() (localctx INamespaceContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(364)
-		p.Ident()
+		p.SetState(457)
+		p.Match(SQLParserT_SHOW)
+	}
+	{
+		p.SetState(458)
+		p.Match(SQLParserT_USERS)
 	}
 
 
@@ -5803,48 +7041,56 @@ func (p *SQLParser) Namespace() (localctx INamespaceContext) {
 }
 
 
-// IDatabaseNameContext is an interface to support dynamic dispatch.
-type IDatabaseNameContext interface {
+// ICreateUserStmtContext is an interface to support dynamic dispatch.
+type ICreateUserStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDatabaseNameContext differentiates from other interfaces.
-	IsDatabaseNameContext()
+	// IsCreateUserStmtContext differentiates from other interfaces.
+	IsCreateUserStmtContext()
 }
 
-type DatabaseNameContext struct {
+type CreateUserStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDatabaseNameContext() *DatabaseNameContext {
-	var p = new(DatabaseNameContext)
+func NewEmptyCreateUserStmtContext() *CreateUserStmtContext {
+	var p = new(CreateUserStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_databaseName
+	p.RuleIndex = SQLParserRULE_createUserStmt
 	return p
 }
 
-func (*DatabaseNameContext) IsDatabaseNameContext() {}
+func (*CreateUserStmtContext) IsCreateUserStmtContext() {}
 
-func NewDatabaseNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseNameContext {
-	var p = new(DatabaseNameContext)
+func NewCreateUserStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateUserStmtContext {
+	var p = new(CreateUserStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_databaseName
+	p.RuleIndex = SQLParserRULE_createUserStmt
 
 	return p
 }
 
-func (s *DatabaseNameContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateUserStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DatabaseNameContext) Ident() IIdentContext {
+func (s *CreateUserStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
+}
+
+func (s *CreateUserStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *CreateUserStmtContext) UserName() IUserNameContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
+		if _, ok := ctx.(IUserNameContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -5854,34 +7100,58 @@ func (s *DatabaseNameContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IUserNameContext)
 }
 
-func (s *DatabaseNameContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateUserStmtContext) T_WITH() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH, 0)
+}
+
+func (s *CreateUserStmtContext) T_PASSWORD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PASSWORD, 0)
+}
+
+func (s *CreateUserStmtContext) Password() IPasswordContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IPasswordContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPasswordContext)
+}
+
+func (s *CreateUserStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DatabaseNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateUserStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *DatabaseNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateUserStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDatabaseName(s)
+		listenerT.EnterCreateUserStmt(s)
 	}
 }
 
-func (s *DatabaseNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateUserStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDatabaseName(s)
+		listenerT.ExitCreateUserStmt(s)
 	}
 }
 
-func (s *DatabaseNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateUserStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitDatabaseName(s)
+		return t.VisitCreateUserStmt(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -5891,12 +7161,12 @@ func (s *DatabaseNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 
 
-func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
+func (p *SQLParser) CreateUserStmt() (localctx ICreateUserStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewDatabaseNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, SQLParserRULE_databaseName)
+	localctx = NewCreateUserStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, SQLParserRULE_createUserStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5916,8 +7186,28 @@ func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(366)
-		p.Ident()
+		p.SetState(460)
+		p.Match(SQLParserT_CREATE)
+	}
+	{
+		p.SetState(461)
+		p.Match(SQLParserT_USER)
+	}
+	{
+		p.SetState(462)
+		p.UserName()
+	}
+	{
+		p.SetState(463)
+		p.Match(SQLParserT_WITH)
+	}
+	{
+		p.SetState(464)
+		p.Match(SQLParserT_PASSWORD)
+	}
+	{
+		p.SetState(465)
+		p.Password()
 	}
 
 
@@ -5926,49 +7216,57 @@ func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
 }
 
 
-// IRequestIDContext is an interface to support dynamic dispatch.
-type IRequestIDContext interface {
+// IDropUserStmtContext is an interface to support dynamic dispatch.
+type IDropUserStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsRequestIDContext differentiates from other interfaces.
-	IsRequestIDContext()
+	// IsDropUserStmtContext differentiates from other interfaces.
+	IsDropUserStmtContext()
 }
 
-type RequestIDContext struct {
+type DropUserStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRequestIDContext() *RequestIDContext {
-	var p = new(RequestIDContext)
+func NewEmptyDropUserStmtContext() *DropUserStmtContext {
+	var p = new(DropUserStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_requestID
+	p.RuleIndex = SQLParserRULE_dropUserStmt
 	return p
 }
 
-func (*RequestIDContext) IsRequestIDContext() {}
+func (*DropUserStmtContext) IsDropUserStmtContext() {}
 
-func NewRequestIDContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RequestIDContext {
-	var p = new(RequestIDContext)
+func NewDropUserStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DropUserStmtContext {
+	var p = new(DropUserStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_requestID
+	p.RuleIndex = SQLParserRULE_dropUserStmt
 
 	return p
 }
 
-func (s *RequestIDContext) GetParser() antlr.Parser { return s.parser }
+func (s *DropUserStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RequestIDContext) Ident() IIdentContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IIdentContext); ok {
-			t = ctx.(antlr.RuleContext);
+func (s *DropUserStmtContext) T_DROP() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DROP, 0)
+}
+
+func (s *DropUserStmtContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *DropUserStmtContext) UserName() IUserNameContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUserNameContext); ok {
+			t = ctx.(antlr.RuleContext);
 			break
 		}
 	}
@@ -5977,34 +7275,34 @@ func (s *RequestIDContext) Ident() IIdentContext {
 		return nil
 	}
 
-	return t.(IIdentContext)
+	return t.(IUserNameContext)
 }
 
-func (s *RequestIDContext) GetRuleContext() antlr.RuleContext {
+func (s *DropUserStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RequestIDContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DropUserStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *RequestIDContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DropUserStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterRequestID(s)
+		listenerT.EnterDropUserStmt(s)
 	}
 }
 
-func (s *RequestIDContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DropUserStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitRequestID(s)
+		listenerT.ExitDropUserStmt(s)
 	}
 }
 
-func (s *RequestIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *DropUserStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitRequestID(s)
+		return t.VisitDropUserStmt(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -6014,12 +7312,12 @@ func (s *RequestIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
+func (p *SQLParser) DropUserStmt() (localctx IDropUserStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewRequestIDContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, SQLParserRULE_requestID)
+	localctx = NewDropUserStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, SQLParserRULE_dropUserStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6039,8 +7337,16 @@ func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(368)
-		p.Ident()
+		p.SetState(467)
+		p.Match(SQLParserT_DROP)
+	}
+	{
+		p.SetState(468)
+		p.Match(SQLParserT_USER)
+	}
+	{
+		p.SetState(469)
+		p.UserName()
 	}
 
 
@@ -6049,77 +7355,93 @@ func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
 }
 
 
-// ISourceContext is an interface to support dynamic dispatch.
-type ISourceContext interface {
+// ICreateTokenStmtContext is an interface to support dynamic dispatch.
+type ICreateTokenStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSourceContext differentiates from other interfaces.
-	IsSourceContext()
+	// IsCreateTokenStmtContext differentiates from other interfaces.
+	IsCreateTokenStmtContext()
 }
 
-type SourceContext struct {
+type CreateTokenStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySourceContext() *SourceContext {
-	var p = new(SourceContext)
+func NewEmptyCreateTokenStmtContext() *CreateTokenStmtContext {
+	var p = new(CreateTokenStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_source
+	p.RuleIndex = SQLParserRULE_createTokenStmt
 	return p
 }
 
-func (*SourceContext) IsSourceContext() {}
+func (*CreateTokenStmtContext) IsCreateTokenStmtContext() {}
 
-func NewSourceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceContext {
-	var p = new(SourceContext)
+func NewCreateTokenStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CreateTokenStmtContext {
+	var p = new(CreateTokenStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_source
+	p.RuleIndex = SQLParserRULE_createTokenStmt
 
 	return p
 }
 
-func (s *SourceContext) GetParser() antlr.Parser { return s.parser }
+func (s *CreateTokenStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SourceContext) T_STATE_MACHINE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STATE_MACHINE, 0)
+func (s *CreateTokenStmtContext) T_CREATE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CREATE, 0)
 }
 
-func (s *SourceContext) T_STATE_REPO() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STATE_REPO, 0)
+func (s *CreateTokenStmtContext) T_TOKEN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TOKEN, 0)
 }
 
-func (s *SourceContext) GetRuleContext() antlr.RuleContext {
+func (s *CreateTokenStmtContext) UserName() IUserNameContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUserNameContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUserNameContext)
+}
+
+func (s *CreateTokenStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SourceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CreateTokenStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *SourceContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CreateTokenStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterSource(s)
+		listenerT.EnterCreateTokenStmt(s)
 	}
 }
 
-func (s *SourceContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CreateTokenStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitSource(s)
+		listenerT.ExitCreateTokenStmt(s)
 	}
 }
 
-func (s *SourceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CreateTokenStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitSource(s)
+		return t.VisitCreateTokenStmt(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -6129,14 +7451,12 @@ func (s *SourceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) Source() (localctx ISourceContext) {
+func (p *SQLParser) CreateTokenStmt() (localctx ICreateTokenStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewSourceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, SQLParserRULE_source)
-	var _la int
-
+	localctx = NewCreateTokenStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, SQLParserRULE_createTokenStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6156,15 +7476,16 @@ func (p *SQLParser) Source() (localctx ISourceContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(370)
-		_la = p.GetTokenStream().LA(1)
-
-		if !(_la == SQLParserT_STATE_REPO || _la == SQLParserT_STATE_MACHINE) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
-		}
+		p.SetState(471)
+		p.Match(SQLParserT_CREATE)
+	}
+	{
+		p.SetState(472)
+		p.Match(SQLParserT_TOKEN)
+	}
+	{
+		p.SetState(473)
+		p.UserName()
 	}
 
 
@@ -6173,68 +7494,52 @@ func (p *SQLParser) Source() (localctx ISourceContext) {
 }
 
 
-// IQueryStmtContext is an interface to support dynamic dispatch.
-type IQueryStmtContext interface {
+// IGrantStmtContext is an interface to support dynamic dispatch.
+type IGrantStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsQueryStmtContext differentiates from other interfaces.
-	IsQueryStmtContext()
+	// IsGrantStmtContext differentiates from other interfaces.
+	IsGrantStmtContext()
 }
 
-type QueryStmtContext struct {
+type GrantStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyQueryStmtContext() *QueryStmtContext {
-	var p = new(QueryStmtContext)
+func NewEmptyGrantStmtContext() *GrantStmtContext {
+	var p = new(GrantStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_queryStmt
+	p.RuleIndex = SQLParserRULE_grantStmt
 	return p
 }
 
-func (*QueryStmtContext) IsQueryStmtContext() {}
+func (*GrantStmtContext) IsGrantStmtContext() {}
 
-func NewQueryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryStmtContext {
-	var p = new(QueryStmtContext)
+func NewGrantStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GrantStmtContext {
+	var p = new(GrantStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_queryStmt
+	p.RuleIndex = SQLParserRULE_grantStmt
 
 	return p
 }
 
-func (s *QueryStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *QueryStmtContext) SourceAndSelect() ISourceAndSelectContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISourceAndSelectContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISourceAndSelectContext)
-}
+func (s *GrantStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *QueryStmtContext) T_EXPLAIN() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EXPLAIN, 0)
+func (s *GrantStmtContext) T_GRANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_GRANT, 0)
 }
 
-func (s *QueryStmtContext) WhereClause() IWhereClauseContext {
+func (s *GrantStmtContext) RoleName() IRoleNameContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IWhereClauseContext); ok {
+		if _, ok := ctx.(IRoleNameContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -6244,29 +7549,17 @@ func (s *QueryStmtContext) WhereClause() IWhereClauseContext {
 		return nil
 	}
 
-	return t.(IWhereClauseContext)
+	return t.(IRoleNameContext)
 }
 
-func (s *QueryStmtContext) GroupByClause() IGroupByClauseContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IGroupByClauseContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IGroupByClauseContext)
+func (s *GrantStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
 }
 
-func (s *QueryStmtContext) OrderByClause() IOrderByClauseContext {
+func (s *GrantStmtContext) PrivilegeDatabase() IPrivilegeDatabaseContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IOrderByClauseContext); ok {
+		if _, ok := ctx.(IPrivilegeDatabaseContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -6276,13 +7569,17 @@ func (s *QueryStmtContext) OrderByClause() IOrderByClauseContext {
 		return nil
 	}
 
-	return t.(IOrderByClauseContext)
+	return t.(IPrivilegeDatabaseContext)
 }
 
-func (s *QueryStmtContext) LimitClause() ILimitClauseContext {
+func (s *GrantStmtContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
+}
+
+func (s *GrantStmtContext) UserName() IUserNameContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ILimitClauseContext); ok {
+		if _, ok := ctx.(IUserNameContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -6292,38 +7589,34 @@ func (s *QueryStmtContext) LimitClause() ILimitClauseContext {
 		return nil
 	}
 
-	return t.(ILimitClauseContext)
-}
-
-func (s *QueryStmtContext) T_WITH_VALUE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_WITH_VALUE, 0)
+	return t.(IUserNameContext)
 }
 
-func (s *QueryStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *GrantStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *QueryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GrantStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *QueryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GrantStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterQueryStmt(s)
+		listenerT.EnterGrantStmt(s)
 	}
 }
 
-func (s *QueryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GrantStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitQueryStmt(s)
+		listenerT.ExitGrantStmt(s)
 	}
 }
 
-func (s *QueryStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *GrantStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitQueryStmt(s)
+		return t.VisitGrantStmt(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -6333,14 +7626,12 @@ func (s *QueryStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
+func (p *SQLParser) GrantStmt() (localctx IGrantStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, SQLParserRULE_queryStmt)
-	var _la int
-
+	localctx = NewGrantStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, SQLParserRULE_grantStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6359,81 +7650,29 @@ func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(373)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-
-	if _la == SQLParserT_EXPLAIN {
-		{
-			p.SetState(372)
-			p.Match(SQLParserT_EXPLAIN)
-		}
-
-	}
 	{
-		p.SetState(375)
-		p.SourceAndSelect()
+		p.SetState(475)
+		p.Match(SQLParserT_GRANT)
 	}
-	p.SetState(377)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-
-	if _la == SQLParserT_WHERE {
-		{
-			p.SetState(376)
-			p.WhereClause()
-		}
-
+	{
+		p.SetState(476)
+		p.RoleName()
 	}
-	p.SetState(380)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-
-	if _la == SQLParserT_GROUP {
-		{
-			p.SetState(379)
-			p.GroupByClause()
-		}
-
+	{
+		p.SetState(477)
+		p.Match(SQLParserT_ON)
 	}
-	p.SetState(383)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-
-	if _la == SQLParserT_ORDER {
-		{
-			p.SetState(382)
-			p.OrderByClause()
-		}
-
+	{
+		p.SetState(478)
+		p.PrivilegeDatabase()
 	}
-	p.SetState(386)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-
-	if _la == SQLParserT_LIMIT {
-		{
-			p.SetState(385)
-			p.LimitClause()
-		}
-
+	{
+		p.SetState(479)
+		p.Match(SQLParserT_TO)
 	}
-	p.SetState(389)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-
-	if _la == SQLParserT_WITH_VALUE {
-		{
-			p.SetState(388)
-			p.Match(SQLParserT_WITH_VALUE)
-		}
-
+	{
+		p.SetState(480)
+		p.UserName()
 	}
 
 
@@ -6442,48 +7681,52 @@ func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
 }
 
 
-// ISourceAndSelectContext is an interface to support dynamic dispatch.
-type ISourceAndSelectContext interface {
+// IRevokeStmtContext is an interface to support dynamic dispatch.
+type IRevokeStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSourceAndSelectContext differentiates from other interfaces.
-	IsSourceAndSelectContext()
+	// IsRevokeStmtContext differentiates from other interfaces.
+	IsRevokeStmtContext()
 }
 
-type SourceAndSelectContext struct {
+type RevokeStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySourceAndSelectContext() *SourceAndSelectContext {
-	var p = new(SourceAndSelectContext)
+func NewEmptyRevokeStmtContext() *RevokeStmtContext {
+	var p = new(RevokeStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_sourceAndSelect
+	p.RuleIndex = SQLParserRULE_revokeStmt
 	return p
 }
 
-func (*SourceAndSelectContext) IsSourceAndSelectContext() {}
+func (*RevokeStmtContext) IsRevokeStmtContext() {}
 
-func NewSourceAndSelectContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceAndSelectContext {
-	var p = new(SourceAndSelectContext)
+func NewRevokeStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RevokeStmtContext {
+	var p = new(RevokeStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_sourceAndSelect
+	p.RuleIndex = SQLParserRULE_revokeStmt
 
 	return p
 }
 
-func (s *SourceAndSelectContext) GetParser() antlr.Parser { return s.parser }
+func (s *RevokeStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SourceAndSelectContext) SelectExpr() ISelectExprContext {
+func (s *RevokeStmtContext) T_REVOKE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REVOKE, 0)
+}
+
+func (s *RevokeStmtContext) RoleName() IRoleNameContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ISelectExprContext); ok {
+		if _, ok := ctx.(IRoleNameContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -6493,13 +7736,17 @@ func (s *SourceAndSelectContext) SelectExpr() ISelectExprContext {
 		return nil
 	}
 
-	return t.(ISelectExprContext)
+	return t.(IRoleNameContext)
 }
 
-func (s *SourceAndSelectContext) FromClause() IFromClauseContext {
+func (s *RevokeStmtContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *RevokeStmtContext) PrivilegeDatabase() IPrivilegeDatabaseContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFromClauseContext); ok {
+		if _, ok := ctx.(IPrivilegeDatabaseContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -6509,34 +7756,54 @@ func (s *SourceAndSelectContext) FromClause() IFromClauseContext {
 		return nil
 	}
 
-	return t.(IFromClauseContext)
-}
-
-func (s *SourceAndSelectContext) GetRuleContext() antlr.RuleContext {
-	return s
+	return t.(IPrivilegeDatabaseContext)
 }
 
-func (s *SourceAndSelectContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
+func (s *RevokeStmtContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
 }
 
+func (s *RevokeStmtContext) UserName() IUserNameContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IUserNameContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
 
-func (s *SourceAndSelectContext) EnterRule(listener antlr.ParseTreeListener) {
+	if t == nil {
+		return nil
+	}
+
+	return t.(IUserNameContext)
+}
+
+func (s *RevokeStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RevokeStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *RevokeStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterSourceAndSelect(s)
+		listenerT.EnterRevokeStmt(s)
 	}
 }
 
-func (s *SourceAndSelectContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RevokeStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitSourceAndSelect(s)
+		listenerT.ExitRevokeStmt(s)
 	}
 }
 
-func (s *SourceAndSelectContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RevokeStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitSourceAndSelect(s)
+		return t.VisitRevokeStmt(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -6546,12 +7813,12 @@ func (s *SourceAndSelectContext) Accept(visitor antlr.ParseTreeVisitor) interfac
 
 
 
-func (p *SQLParser) SourceAndSelect() (localctx ISourceAndSelectContext) {
+func (p *SQLParser) RevokeStmt() (localctx IRevokeStmtContext) {
 	this := p
 	_ = this
 
-	localctx = NewSourceAndSelectContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, SQLParserRULE_sourceAndSelect)
+	localctx = NewRevokeStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, SQLParserRULE_revokeStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6569,90 +7836,80 @@ func (p *SQLParser) SourceAndSelect() (localctx ISourceAndSelectContext) {
 		}
 	}()
 
-	p.SetState(397)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case SQLParserT_SELECT:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(391)
-			p.SelectExpr()
-		}
-		{
-			p.SetState(392)
-			p.FromClause()
-		}
-
-
-	case SQLParserT_FROM:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(394)
-			p.FromClause()
-		}
-		{
-			p.SetState(395)
-			p.SelectExpr()
-		}
-
-
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(482)
+		p.Match(SQLParserT_REVOKE)
+	}
+	{
+		p.SetState(483)
+		p.RoleName()
+	}
+	{
+		p.SetState(484)
+		p.Match(SQLParserT_ON)
+	}
+	{
+		p.SetState(485)
+		p.PrivilegeDatabase()
+	}
+	{
+		p.SetState(486)
+		p.Match(SQLParserT_FROM)
 	}
+	{
+		p.SetState(487)
+		p.UserName()
+	}
+
 
 
 	return localctx
 }
 
 
-// ISelectExprContext is an interface to support dynamic dispatch.
-type ISelectExprContext interface {
+// IUserNameContext is an interface to support dynamic dispatch.
+type IUserNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSelectExprContext differentiates from other interfaces.
-	IsSelectExprContext()
+	// IsUserNameContext differentiates from other interfaces.
+	IsUserNameContext()
 }
 
-type SelectExprContext struct {
+type UserNameContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySelectExprContext() *SelectExprContext {
-	var p = new(SelectExprContext)
+func NewEmptyUserNameContext() *UserNameContext {
+	var p = new(UserNameContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_selectExpr
+	p.RuleIndex = SQLParserRULE_userName
 	return p
 }
 
-func (*SelectExprContext) IsSelectExprContext() {}
+func (*UserNameContext) IsUserNameContext() {}
 
-func NewSelectExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectExprContext {
-	var p = new(SelectExprContext)
+func NewUserNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *UserNameContext {
+	var p = new(UserNameContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_selectExpr
+	p.RuleIndex = SQLParserRULE_userName
 
 	return p
 }
 
-func (s *SelectExprContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SelectExprContext) T_SELECT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_SELECT, 0)
-}
+func (s *UserNameContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SelectExprContext) Fields() IFieldsContext {
+func (s *UserNameContext) Ident() IIdentContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldsContext); ok {
+		if _, ok := ctx.(IIdentContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -6662,34 +7919,34 @@ func (s *SelectExprContext) Fields() IFieldsContext {
 		return nil
 	}
 
-	return t.(IFieldsContext)
+	return t.(IIdentContext)
 }
 
-func (s *SelectExprContext) GetRuleContext() antlr.RuleContext {
+func (s *UserNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SelectExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *UserNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *SelectExprContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *UserNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterSelectExpr(s)
+		listenerT.EnterUserName(s)
 	}
 }
 
-func (s *SelectExprContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *UserNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitSelectExpr(s)
+		listenerT.ExitUserName(s)
 	}
 }
 
-func (s *SelectExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *UserNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitSelectExpr(s)
+		return t.VisitUserName(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -6699,12 +7956,12 @@ func (s *SelectExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
+func (p *SQLParser) UserName() (localctx IUserNameContext) {
 	this := p
 	_ = this
 
-	localctx = NewSelectExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, SQLParserRULE_selectExpr)
+	localctx = NewUserNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, SQLParserRULE_userName)
 
 	defer func() {
 		p.ExitRule()
@@ -6724,12 +7981,8 @@ func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(399)
-		p.Match(SQLParserT_SELECT)
-	}
-	{
-		p.SetState(400)
-		p.Fields()
+		p.SetState(489)
+		p.Ident()
 	}
 
 
@@ -6738,75 +7991,50 @@ func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
 }
 
 
-// IFieldsContext is an interface to support dynamic dispatch.
-type IFieldsContext interface {
+// IPasswordContext is an interface to support dynamic dispatch.
+type IPasswordContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFieldsContext differentiates from other interfaces.
-	IsFieldsContext()
+	// IsPasswordContext differentiates from other interfaces.
+	IsPasswordContext()
 }
 
-type FieldsContext struct {
+type PasswordContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFieldsContext() *FieldsContext {
-	var p = new(FieldsContext)
+func NewEmptyPasswordContext() *PasswordContext {
+	var p = new(PasswordContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_fields
+	p.RuleIndex = SQLParserRULE_password
 	return p
 }
 
-func (*FieldsContext) IsFieldsContext() {}
+func (*PasswordContext) IsPasswordContext() {}
 
-func NewFieldsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldsContext {
-	var p = new(FieldsContext)
+func NewPasswordContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PasswordContext {
+	var p = new(PasswordContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_fields
+	p.RuleIndex = SQLParserRULE_password
 
 	return p
 }
 
-func (s *FieldsContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *FieldsContext) AllField() []IFieldContext {
-	children := s.GetChildren()
-	len := 0
-	for _, ctx := range children {
-		if _, ok := ctx.(IFieldContext); ok {
-			len++
-		}
-	}
-
-	tst := make([]IFieldContext, len)
-	i := 0
-	for _, ctx := range children {
-		if t, ok := ctx.(IFieldContext); ok {
-			tst[i] = t.(IFieldContext)
-			i++
-		}
-	}
-
-	return tst
-}
+func (s *PasswordContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FieldsContext) Field(i int) IFieldContext {
+func (s *PasswordContext) Ident() IIdentContext {
 	var t antlr.RuleContext;
-	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldContext); ok {
-			if j == i {
-				t = ctx.(antlr.RuleContext);
-				break
-			}
-			j++
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
 		}
 	}
 
@@ -6814,42 +8042,34 @@ func (s *FieldsContext) Field(i int) IFieldContext {
 		return nil
 	}
 
-	return t.(IFieldContext)
-}
-
-func (s *FieldsContext) AllT_COMMA() []antlr.TerminalNode {
-	return s.GetTokens(SQLParserT_COMMA)
-}
-
-func (s *FieldsContext) T_COMMA(i int) antlr.TerminalNode {
-	return s.GetToken(SQLParserT_COMMA, i)
+	return t.(IIdentContext)
 }
 
-func (s *FieldsContext) GetRuleContext() antlr.RuleContext {
+func (s *PasswordContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FieldsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PasswordContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *FieldsContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PasswordContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterFields(s)
+		listenerT.EnterPassword(s)
 	}
 }
 
-func (s *FieldsContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PasswordContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitFields(s)
+		listenerT.ExitPassword(s)
 	}
 }
 
-func (s *FieldsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PasswordContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitFields(s)
+		return t.VisitPassword(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -6859,14 +8079,12 @@ func (s *FieldsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) Fields() (localctx IFieldsContext) {
+func (p *SQLParser) Password() (localctx IPasswordContext) {
 	this := p
 	_ = this
 
-	localctx = NewFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, SQLParserRULE_fields)
-	var _la int
-
+	localctx = NewPasswordContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, SQLParserRULE_password)
 
 	defer func() {
 		p.ExitRule()
@@ -6886,28 +8104,8 @@ func (p *SQLParser) Fields() (localctx IFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(402)
-		p.Field()
-	}
-	p.SetState(407)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-
-	for _la == SQLParserT_COMMA {
-		{
-			p.SetState(403)
-			p.Match(SQLParserT_COMMA)
-		}
-		{
-			p.SetState(404)
-			p.Field()
-		}
-
-
-		p.SetState(409)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		p.SetState(491)
+		p.Ident()
 	}
 
 
@@ -6916,101 +8114,81 @@ func (p *SQLParser) Fields() (localctx IFieldsContext) {
 }
 
 
-// IFieldContext is an interface to support dynamic dispatch.
-type IFieldContext interface {
+// IRoleNameContext is an interface to support dynamic dispatch.
+type IRoleNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFieldContext differentiates from other interfaces.
-	IsFieldContext()
+	// IsRoleNameContext differentiates from other interfaces.
+	IsRoleNameContext()
 }
 
-type FieldContext struct {
+type RoleNameContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFieldContext() *FieldContext {
-	var p = new(FieldContext)
+func NewEmptyRoleNameContext() *RoleNameContext {
+	var p = new(RoleNameContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_field
+	p.RuleIndex = SQLParserRULE_roleName
 	return p
 }
 
-func (*FieldContext) IsFieldContext() {}
+func (*RoleNameContext) IsRoleNameContext() {}
 
-func NewFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldContext {
-	var p = new(FieldContext)
+func NewRoleNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RoleNameContext {
+	var p = new(RoleNameContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_field
+	p.RuleIndex = SQLParserRULE_roleName
 
 	return p
 }
 
-func (s *FieldContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *FieldContext) FieldExpr() IFieldExprContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IFieldExprContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *RoleNameContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IFieldExprContext)
+func (s *RoleNameContext) T_READ() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_READ, 0)
 }
 
-func (s *FieldContext) Alias() IAliasContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IAliasContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
+func (s *RoleNameContext) T_WRITE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WRITE, 0)
+}
 
-	return t.(IAliasContext)
+func (s *RoleNameContext) T_ADMIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ADMIN, 0)
 }
 
-func (s *FieldContext) GetRuleContext() antlr.RuleContext {
+func (s *RoleNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RoleNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *FieldContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RoleNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterField(s)
+		listenerT.EnterRoleName(s)
 	}
 }
 
-func (s *FieldContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RoleNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitField(s)
+		listenerT.ExitRoleName(s)
 	}
 }
 
-func (s *FieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *RoleNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitField(s)
+		return t.VisitRoleName(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -7020,12 +8198,12 @@ func (s *FieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) Field() (localctx IFieldContext) {
+func (p *SQLParser) RoleName() (localctx IRoleNameContext) {
 	this := p
 	_ = this
 
-	localctx = NewFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, SQLParserRULE_field)
+	localctx = NewRoleNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, SQLParserRULE_roleName)
 	var _la int
 
 
@@ -7047,20 +8225,15 @@ func (p *SQLParser) Field() (localctx IFieldContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(410)
-		p.fieldExpr(0)
-	}
-	p.SetState(412)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
+		p.SetState(493)
+		_la = p.GetTokenStream().LA(1)
 
-	if _la == SQLParserT_AS {
-		{
-			p.SetState(411)
-			p.Alias()
+		if !(((((_la - 95)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 95))) & ((1 << (SQLParserT_READ - 95)) | (1 << (SQLParserT_WRITE - 95)) | (1 << (SQLParserT_ADMIN - 95)))) != 0)) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-
 	}
 
 
@@ -7069,49 +8242,49 @@ func (p *SQLParser) Field() (localctx IFieldContext) {
 }
 
 
-// IAliasContext is an interface to support dynamic dispatch.
-type IAliasContext interface {
+// IPrivilegeDatabaseContext is an interface to support dynamic dispatch.
+type IPrivilegeDatabaseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsAliasContext differentiates from other interfaces.
-	IsAliasContext()
+	// IsPrivilegeDatabaseContext differentiates from other interfaces.
+	IsPrivilegeDatabaseContext()
 }
 
-type AliasContext struct {
+type PrivilegeDatabaseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAliasContext() *AliasContext {
-	var p = new(AliasContext)
+func NewEmptyPrivilegeDatabaseContext() *PrivilegeDatabaseContext {
+	var p = new(PrivilegeDatabaseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_alias
+	p.RuleIndex = SQLParserRULE_privilegeDatabase
 	return p
 }
 
-func (*AliasContext) IsAliasContext() {}
+func (*PrivilegeDatabaseContext) IsPrivilegeDatabaseContext() {}
 
-func NewAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AliasContext {
-	var p = new(AliasContext)
+func NewPrivilegeDatabaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrivilegeDatabaseContext {
+	var p = new(PrivilegeDatabaseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_alias
+	p.RuleIndex = SQLParserRULE_privilegeDatabase
 
 	return p
 }
 
-func (s *AliasContext) GetParser() antlr.Parser { return s.parser }
+func (s *PrivilegeDatabaseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *AliasContext) T_AS() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AS, 0)
+func (s *PrivilegeDatabaseContext) T_MUL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MUL, 0)
 }
 
-func (s *AliasContext) Ident() IIdentContext {
+func (s *PrivilegeDatabaseContext) Ident() IIdentContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentContext); ok {
@@ -7127,31 +8300,31 @@ func (s *AliasContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *AliasContext) GetRuleContext() antlr.RuleContext {
+func (s *PrivilegeDatabaseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrivilegeDatabaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *AliasContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrivilegeDatabaseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterAlias(s)
+		listenerT.EnterPrivilegeDatabase(s)
 	}
 }
 
-func (s *AliasContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrivilegeDatabaseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitAlias(s)
+		listenerT.ExitPrivilegeDatabase(s)
 	}
 }
 
-func (s *AliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PrivilegeDatabaseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitAlias(s)
+		return t.VisitPrivilegeDatabase(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -7161,12 +8334,12 @@ func (s *AliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) Alias() (localctx IAliasContext) {
+func (p *SQLParser) PrivilegeDatabase() (localctx IPrivilegeDatabaseContext) {
 	this := p
 	_ = this
 
-	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, SQLParserRULE_alias)
+	localctx = NewPrivilegeDatabaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, SQLParserRULE_privilegeDatabase)
 
 	defer func() {
 		p.ExitRule()
@@ -7184,69 +8357,75 @@ func (p *SQLParser) Alias() (localctx IAliasContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(414)
-		p.Match(SQLParserT_AS)
-	}
-	{
-		p.SetState(415)
-		p.Ident()
-	}
+	p.SetState(497)
+	p.GetErrorHandler().Sync(p)
 
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_MUL:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(495)
+			p.Match(SQLParserT_MUL)
+		}
+
+
+	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_ALTER, SQLParserT_DELETE, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_OFFSET, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_SERIES, SQLParserT_QUOTA, SQLParserT_CARDINALITY, SQLParserT_ID, SQLParserT_USER, SQLParserT_USERS, SQLParserT_PASSWORD, SQLParserT_TOKEN, SQLParserT_GRANT, SQLParserT_REVOKE, SQLParserT_TO, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ADMIN, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_PERCENTILE, SQLParserT_INCREASE, SQLParserT_DERIVATIVE, SQLParserT_DELTA, SQLParserT_MOVING_AVERAGE, SQLParserT_ABS, SQLParserT_CLAMP_MIN, SQLParserT_CLAMP_MAX, SQLParserT_TIMESHIFT, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR, SQLParserL_ID:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(496)
+			p.Ident()
+		}
+
+
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
 
 
 	return localctx
 }
 
 
-// IStorageFilterContext is an interface to support dynamic dispatch.
-type IStorageFilterContext interface {
+// IPrefixContext is an interface to support dynamic dispatch.
+type IPrefixContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsStorageFilterContext differentiates from other interfaces.
-	IsStorageFilterContext()
+	// IsPrefixContext differentiates from other interfaces.
+	IsPrefixContext()
 }
 
-type StorageFilterContext struct {
+type PrefixContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStorageFilterContext() *StorageFilterContext {
-	var p = new(StorageFilterContext)
+func NewEmptyPrefixContext() *PrefixContext {
+	var p = new(PrefixContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_storageFilter
+	p.RuleIndex = SQLParserRULE_prefix
 	return p
 }
 
-func (*StorageFilterContext) IsStorageFilterContext() {}
+func (*PrefixContext) IsPrefixContext() {}
 
-func NewStorageFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StorageFilterContext {
-	var p = new(StorageFilterContext)
+func NewPrefixContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PrefixContext {
+	var p = new(PrefixContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_storageFilter
+	p.RuleIndex = SQLParserRULE_prefix
 
 	return p
 }
 
-func (s *StorageFilterContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *StorageFilterContext) T_STORAGE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_STORAGE, 0)
-}
-
-func (s *StorageFilterContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
-}
+func (s *PrefixContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StorageFilterContext) Ident() IIdentContext {
+func (s *PrefixContext) Ident() IIdentContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentContext); ok {
@@ -7262,31 +8441,31 @@ func (s *StorageFilterContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *StorageFilterContext) GetRuleContext() antlr.RuleContext {
+func (s *PrefixContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StorageFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PrefixContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *StorageFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PrefixContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterStorageFilter(s)
+		listenerT.EnterPrefix(s)
 	}
 }
 
-func (s *StorageFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PrefixContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitStorageFilter(s)
+		listenerT.ExitPrefix(s)
 	}
 }
 
-func (s *StorageFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *PrefixContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitStorageFilter(s)
+		return t.VisitPrefix(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -7296,12 +8475,12 @@ func (s *StorageFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{
 
 
 
-func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
+func (p *SQLParser) Prefix() (localctx IPrefixContext) {
 	this := p
 	_ = this
 
-	localctx = NewStorageFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, SQLParserRULE_storageFilter)
+	localctx = NewPrefixContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, SQLParserRULE_prefix)
 
 	defer func() {
 		p.ExitRule()
@@ -7321,15 +8500,7 @@ func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(417)
-		p.Match(SQLParserT_STORAGE)
-	}
-	{
-		p.SetState(418)
-		p.Match(SQLParserT_EQUAL)
-	}
-	{
-		p.SetState(419)
+		p.SetState(499)
 		p.Ident()
 	}
 
@@ -7339,53 +8510,45 @@ func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
 }
 
 
-// IDatabaseFilterContext is an interface to support dynamic dispatch.
-type IDatabaseFilterContext interface {
+// IWithTagKeyContext is an interface to support dynamic dispatch.
+type IWithTagKeyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDatabaseFilterContext differentiates from other interfaces.
-	IsDatabaseFilterContext()
+	// IsWithTagKeyContext differentiates from other interfaces.
+	IsWithTagKeyContext()
 }
 
-type DatabaseFilterContext struct {
+type WithTagKeyContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDatabaseFilterContext() *DatabaseFilterContext {
-	var p = new(DatabaseFilterContext)
+func NewEmptyWithTagKeyContext() *WithTagKeyContext {
+	var p = new(WithTagKeyContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_databaseFilter
+	p.RuleIndex = SQLParserRULE_withTagKey
 	return p
 }
 
-func (*DatabaseFilterContext) IsDatabaseFilterContext() {}
+func (*WithTagKeyContext) IsWithTagKeyContext() {}
 
-func NewDatabaseFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseFilterContext {
-	var p = new(DatabaseFilterContext)
+func NewWithTagKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithTagKeyContext {
+	var p = new(WithTagKeyContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_databaseFilter
+	p.RuleIndex = SQLParserRULE_withTagKey
 
 	return p
 }
 
-func (s *DatabaseFilterContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *DatabaseFilterContext) T_DATASBAE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_DATASBAE, 0)
-}
-
-func (s *DatabaseFilterContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
-}
+func (s *WithTagKeyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DatabaseFilterContext) Ident() IIdentContext {
+func (s *WithTagKeyContext) Ident() IIdentContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentContext); ok {
@@ -7401,31 +8564,31 @@ func (s *DatabaseFilterContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *DatabaseFilterContext) GetRuleContext() antlr.RuleContext {
+func (s *WithTagKeyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DatabaseFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *WithTagKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *DatabaseFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *WithTagKeyContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterDatabaseFilter(s)
+		listenerT.EnterWithTagKey(s)
 	}
 }
 
-func (s *DatabaseFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *WithTagKeyContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitDatabaseFilter(s)
+		listenerT.ExitWithTagKey(s)
 	}
 }
 
-func (s *DatabaseFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *WithTagKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitDatabaseFilter(s)
+		return t.VisitWithTagKey(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -7435,41 +8598,2350 @@ func (s *DatabaseFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface
 
 
 
-func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
+func (p *SQLParser) WithTagKey() (localctx IWithTagKeyContext) {
 	this := p
 	_ = this
 
-	localctx = NewDatabaseFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, SQLParserRULE_databaseFilter)
+	localctx = NewWithTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, SQLParserRULE_withTagKey)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(501)
+		p.Ident()
+	}
+
+
+
+	return localctx
+}
+
+
+// INamespaceContext is an interface to support dynamic dispatch.
+type INamespaceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsNamespaceContext differentiates from other interfaces.
+	IsNamespaceContext()
+}
+
+type NamespaceContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNamespaceContext() *NamespaceContext {
+	var p = new(NamespaceContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_namespace
+	return p
+}
+
+func (*NamespaceContext) IsNamespaceContext() {}
+
+func NewNamespaceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamespaceContext {
+	var p = new(NamespaceContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_namespace
+
+	return p
+}
+
+func (s *NamespaceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NamespaceContext) Ident() IIdentContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *NamespaceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NamespaceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *NamespaceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterNamespace(s)
+	}
+}
+
+func (s *NamespaceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitNamespace(s)
+	}
+}
+
+func (s *NamespaceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitNamespace(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) Namespace() (localctx INamespaceContext) {
+	this := p
+	_ = this
+
+	localctx = NewNamespaceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, SQLParserRULE_namespace)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(503)
+		p.Ident()
+	}
+
+
+
+	return localctx
+}
+
+
+// IDatabaseNameContext is an interface to support dynamic dispatch.
+type IDatabaseNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDatabaseNameContext differentiates from other interfaces.
+	IsDatabaseNameContext()
+}
+
+type DatabaseNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDatabaseNameContext() *DatabaseNameContext {
+	var p = new(DatabaseNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_databaseName
+	return p
+}
+
+func (*DatabaseNameContext) IsDatabaseNameContext() {}
+
+func NewDatabaseNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseNameContext {
+	var p = new(DatabaseNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_databaseName
+
+	return p
+}
+
+func (s *DatabaseNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DatabaseNameContext) Ident() IIdentContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *DatabaseNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DatabaseNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *DatabaseNameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDatabaseName(s)
+	}
+}
+
+func (s *DatabaseNameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDatabaseName(s)
+	}
+}
+
+func (s *DatabaseNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitDatabaseName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) DatabaseName() (localctx IDatabaseNameContext) {
+	this := p
+	_ = this
+
+	localctx = NewDatabaseNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, SQLParserRULE_databaseName)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(505)
+		p.Ident()
+	}
+
+
+
+	return localctx
+}
+
+
+// IRequestIDContext is an interface to support dynamic dispatch.
+type IRequestIDContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsRequestIDContext differentiates from other interfaces.
+	IsRequestIDContext()
+}
+
+type RequestIDContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyRequestIDContext() *RequestIDContext {
+	var p = new(RequestIDContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_requestID
+	return p
+}
+
+func (*RequestIDContext) IsRequestIDContext() {}
+
+func NewRequestIDContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RequestIDContext {
+	var p = new(RequestIDContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_requestID
+
+	return p
+}
+
+func (s *RequestIDContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *RequestIDContext) Ident() IIdentContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *RequestIDContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RequestIDContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *RequestIDContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterRequestID(s)
+	}
+}
+
+func (s *RequestIDContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitRequestID(s)
+	}
+}
+
+func (s *RequestIDContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitRequestID(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) RequestID() (localctx IRequestIDContext) {
+	this := p
+	_ = this
+
+	localctx = NewRequestIDContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, SQLParserRULE_requestID)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(507)
+		p.Ident()
+	}
+
+
+
+	return localctx
+}
+
+
+// ISourceContext is an interface to support dynamic dispatch.
+type ISourceContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSourceContext differentiates from other interfaces.
+	IsSourceContext()
+}
+
+type SourceContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySourceContext() *SourceContext {
+	var p = new(SourceContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_source
+	return p
+}
+
+func (*SourceContext) IsSourceContext() {}
+
+func NewSourceContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceContext {
+	var p = new(SourceContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_source
+
+	return p
+}
+
+func (s *SourceContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SourceContext) T_STATE_MACHINE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STATE_MACHINE, 0)
+}
+
+func (s *SourceContext) T_STATE_REPO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STATE_REPO, 0)
+}
+
+func (s *SourceContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SourceContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *SourceContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterSource(s)
+	}
+}
+
+func (s *SourceContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitSource(s)
+	}
+}
+
+func (s *SourceContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitSource(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) Source() (localctx ISourceContext) {
+	this := p
+	_ = this
+
+	localctx = NewSourceContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, SQLParserRULE_source)
+	var _la int
+
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(509)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SQLParserT_STATE_REPO || _la == SQLParserT_STATE_MACHINE) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+
+
+	return localctx
+}
+
+
+// IQueryStmtContext is an interface to support dynamic dispatch.
+type IQueryStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsQueryStmtContext differentiates from other interfaces.
+	IsQueryStmtContext()
+}
+
+type QueryStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyQueryStmtContext() *QueryStmtContext {
+	var p = new(QueryStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_queryStmt
+	return p
+}
+
+func (*QueryStmtContext) IsQueryStmtContext() {}
+
+func NewQueryStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *QueryStmtContext {
+	var p = new(QueryStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_queryStmt
+
+	return p
+}
+
+func (s *QueryStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *QueryStmtContext) SourceAndSelect() ISourceAndSelectContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISourceAndSelectContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISourceAndSelectContext)
+}
+
+func (s *QueryStmtContext) T_EXPLAIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EXPLAIN, 0)
+}
+
+func (s *QueryStmtContext) WhereClause() IWhereClauseContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWhereClauseContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWhereClauseContext)
+}
+
+func (s *QueryStmtContext) OffsetClause() IOffsetClauseContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOffsetClauseContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOffsetClauseContext)
+}
+
+func (s *QueryStmtContext) GroupByClause() IGroupByClauseContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IGroupByClauseContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGroupByClauseContext)
+}
+
+func (s *QueryStmtContext) OrderByClause() IOrderByClauseContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOrderByClauseContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrderByClauseContext)
+}
+
+func (s *QueryStmtContext) LimitClause() ILimitClauseContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILimitClauseContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILimitClauseContext)
+}
+
+func (s *QueryStmtContext) T_WITH_VALUE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WITH_VALUE, 0)
+}
+
+func (s *QueryStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *QueryStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *QueryStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterQueryStmt(s)
+	}
+}
+
+func (s *QueryStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitQueryStmt(s)
+	}
+}
+
+func (s *QueryStmtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitQueryStmt(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) QueryStmt() (localctx IQueryStmtContext) {
+	this := p
+	_ = this
+
+	localctx = NewQueryStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, SQLParserRULE_queryStmt)
+	var _la int
+
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(512)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_EXPLAIN {
+		{
+			p.SetState(511)
+			p.Match(SQLParserT_EXPLAIN)
+		}
+
+	}
+	{
+		p.SetState(514)
+		p.SourceAndSelect()
+	}
+	p.SetState(516)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_WHERE {
+		{
+			p.SetState(515)
+			p.WhereClause()
+		}
+
+	}
+	p.SetState(519)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_OFFSET {
+		{
+			p.SetState(518)
+			p.OffsetClause()
+		}
+
+	}
+	p.SetState(522)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_GROUP {
+		{
+			p.SetState(521)
+			p.GroupByClause()
+		}
+
+	}
+	p.SetState(525)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_ORDER {
+		{
+			p.SetState(524)
+			p.OrderByClause()
+		}
+
+	}
+	p.SetState(528)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_LIMIT {
+		{
+			p.SetState(527)
+			p.LimitClause()
+		}
+
+	}
+	p.SetState(531)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_WITH_VALUE {
+		{
+			p.SetState(530)
+			p.Match(SQLParserT_WITH_VALUE)
+		}
+
+	}
+
+
+
+	return localctx
+}
+
+
+// ISourceAndSelectContext is an interface to support dynamic dispatch.
+type ISourceAndSelectContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSourceAndSelectContext differentiates from other interfaces.
+	IsSourceAndSelectContext()
+}
+
+type SourceAndSelectContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySourceAndSelectContext() *SourceAndSelectContext {
+	var p = new(SourceAndSelectContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_sourceAndSelect
+	return p
+}
+
+func (*SourceAndSelectContext) IsSourceAndSelectContext() {}
+
+func NewSourceAndSelectContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SourceAndSelectContext {
+	var p = new(SourceAndSelectContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_sourceAndSelect
+
+	return p
+}
+
+func (s *SourceAndSelectContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SourceAndSelectContext) SelectExpr() ISelectExprContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISelectExprContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectExprContext)
+}
+
+func (s *SourceAndSelectContext) FromClause() IFromClauseContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFromClauseContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFromClauseContext)
+}
+
+func (s *SourceAndSelectContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SourceAndSelectContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *SourceAndSelectContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterSourceAndSelect(s)
+	}
+}
+
+func (s *SourceAndSelectContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitSourceAndSelect(s)
+	}
+}
+
+func (s *SourceAndSelectContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitSourceAndSelect(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) SourceAndSelect() (localctx ISourceAndSelectContext) {
+	this := p
+	_ = this
+
+	localctx = NewSourceAndSelectContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, SQLParserRULE_sourceAndSelect)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(539)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_SELECT:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(533)
+			p.SelectExpr()
+		}
+		{
+			p.SetState(534)
+			p.FromClause()
+		}
+
+
+	case SQLParserT_FROM:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(536)
+			p.FromClause()
+		}
+		{
+			p.SetState(537)
+			p.SelectExpr()
+		}
+
+
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+
+	return localctx
+}
+
+
+// ISelectExprContext is an interface to support dynamic dispatch.
+type ISelectExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSelectExprContext differentiates from other interfaces.
+	IsSelectExprContext()
+}
+
+type SelectExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySelectExprContext() *SelectExprContext {
+	var p = new(SelectExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_selectExpr
+	return p
+}
+
+func (*SelectExprContext) IsSelectExprContext() {}
+
+func NewSelectExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectExprContext {
+	var p = new(SelectExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_selectExpr
+
+	return p
+}
+
+func (s *SelectExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SelectExprContext) T_SELECT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SELECT, 0)
+}
+
+func (s *SelectExprContext) Fields() IFieldsContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldsContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldsContext)
+}
+
+func (s *SelectExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SelectExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *SelectExprContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterSelectExpr(s)
+	}
+}
+
+func (s *SelectExprContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitSelectExpr(s)
+	}
+}
+
+func (s *SelectExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitSelectExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) SelectExpr() (localctx ISelectExprContext) {
+	this := p
+	_ = this
+
+	localctx = NewSelectExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, SQLParserRULE_selectExpr)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(541)
+		p.Match(SQLParserT_SELECT)
+	}
+	{
+		p.SetState(542)
+		p.Fields()
+	}
+
+
+
+	return localctx
+}
+
+
+// IFieldsContext is an interface to support dynamic dispatch.
+type IFieldsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFieldsContext differentiates from other interfaces.
+	IsFieldsContext()
+}
+
+type FieldsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFieldsContext() *FieldsContext {
+	var p = new(FieldsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_fields
+	return p
+}
+
+func (*FieldsContext) IsFieldsContext() {}
+
+func NewFieldsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldsContext {
+	var p = new(FieldsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_fields
+
+	return p
+}
+
+func (s *FieldsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FieldsContext) AllField() []IFieldContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IFieldContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IFieldContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IFieldContext); ok {
+			tst[i] = t.(IFieldContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FieldsContext) Field(i int) IFieldContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldContext)
+}
+
+func (s *FieldsContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *FieldsContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *FieldsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FieldsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *FieldsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterFields(s)
+	}
+}
+
+func (s *FieldsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitFields(s)
+	}
+}
+
+func (s *FieldsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitFields(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) Fields() (localctx IFieldsContext) {
+	this := p
+	_ = this
+
+	localctx = NewFieldsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, SQLParserRULE_fields)
+	var _la int
+
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(544)
+		p.Field()
+	}
+	p.SetState(549)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	for _la == SQLParserT_COMMA {
+		{
+			p.SetState(545)
+			p.Match(SQLParserT_COMMA)
+		}
+		{
+			p.SetState(546)
+			p.Field()
+		}
+
+
+		p.SetState(551)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+
+
+	return localctx
+}
+
+
+// IFieldContext is an interface to support dynamic dispatch.
+type IFieldContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFieldContext differentiates from other interfaces.
+	IsFieldContext()
+}
+
+type FieldContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFieldContext() *FieldContext {
+	var p = new(FieldContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_field
+	return p
+}
+
+func (*FieldContext) IsFieldContext() {}
+
+func NewFieldContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FieldContext {
+	var p = new(FieldContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_field
+
+	return p
+}
+
+func (s *FieldContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FieldContext) FieldExpr() IFieldExprContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFieldExprContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFieldExprContext)
+}
+
+func (s *FieldContext) Alias() IAliasContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAliasContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAliasContext)
+}
+
+func (s *FieldContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FieldContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *FieldContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterField(s)
+	}
+}
+
+func (s *FieldContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitField(s)
+	}
+}
+
+func (s *FieldContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitField(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) Field() (localctx IFieldContext) {
+	this := p
+	_ = this
+
+	localctx = NewFieldContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, SQLParserRULE_field)
+	var _la int
+
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(552)
+		p.fieldExpr(0)
+	}
+	p.SetState(554)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_AS {
+		{
+			p.SetState(553)
+			p.Alias()
+		}
+
+	}
+
+
+
+	return localctx
+}
+
+
+// IAliasContext is an interface to support dynamic dispatch.
+type IAliasContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAliasContext differentiates from other interfaces.
+	IsAliasContext()
+}
+
+type AliasContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAliasContext() *AliasContext {
+	var p = new(AliasContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_alias
+	return p
+}
+
+func (*AliasContext) IsAliasContext() {}
+
+func NewAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AliasContext {
+	var p = new(AliasContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_alias
+
+	return p
+}
+
+func (s *AliasContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AliasContext) T_AS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AS, 0)
+}
+
+func (s *AliasContext) Ident() IIdentContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *AliasContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *AliasContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterAlias(s)
+	}
+}
+
+func (s *AliasContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitAlias(s)
+	}
+}
+
+func (s *AliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitAlias(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) Alias() (localctx IAliasContext) {
+	this := p
+	_ = this
+
+	localctx = NewAliasContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, SQLParserRULE_alias)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(556)
+		p.Match(SQLParserT_AS)
+	}
+	{
+		p.SetState(557)
+		p.Ident()
+	}
+
+
+
+	return localctx
+}
+
+
+// IStorageFilterContext is an interface to support dynamic dispatch.
+type IStorageFilterContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsStorageFilterContext differentiates from other interfaces.
+	IsStorageFilterContext()
+}
+
+type StorageFilterContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStorageFilterContext() *StorageFilterContext {
+	var p = new(StorageFilterContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_storageFilter
+	return p
+}
+
+func (*StorageFilterContext) IsStorageFilterContext() {}
+
+func NewStorageFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StorageFilterContext {
+	var p = new(StorageFilterContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_storageFilter
+
+	return p
+}
+
+func (s *StorageFilterContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StorageFilterContext) T_STORAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_STORAGE, 0)
+}
+
+func (s *StorageFilterContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *StorageFilterContext) Ident() IIdentContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *StorageFilterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StorageFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *StorageFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterStorageFilter(s)
+	}
+}
+
+func (s *StorageFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitStorageFilter(s)
+	}
+}
+
+func (s *StorageFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitStorageFilter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) StorageFilter() (localctx IStorageFilterContext) {
+	this := p
+	_ = this
+
+	localctx = NewStorageFilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, SQLParserRULE_storageFilter)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(559)
+		p.Match(SQLParserT_STORAGE)
+	}
+	{
+		p.SetState(560)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(561)
+		p.Ident()
+	}
+
+
+
+	return localctx
+}
+
+
+// IDatabaseFilterContext is an interface to support dynamic dispatch.
+type IDatabaseFilterContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDatabaseFilterContext differentiates from other interfaces.
+	IsDatabaseFilterContext()
+}
+
+type DatabaseFilterContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDatabaseFilterContext() *DatabaseFilterContext {
+	var p = new(DatabaseFilterContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_databaseFilter
+	return p
+}
+
+func (*DatabaseFilterContext) IsDatabaseFilterContext() {}
+
+func NewDatabaseFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DatabaseFilterContext {
+	var p = new(DatabaseFilterContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_databaseFilter
+
+	return p
+}
+
+func (s *DatabaseFilterContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DatabaseFilterContext) T_DATASBAE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DATASBAE, 0)
+}
+
+func (s *DatabaseFilterContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *DatabaseFilterContext) Ident() IIdentContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *DatabaseFilterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DatabaseFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *DatabaseFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterDatabaseFilter(s)
+	}
+}
+
+func (s *DatabaseFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitDatabaseFilter(s)
+	}
+}
+
+func (s *DatabaseFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitDatabaseFilter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
+	this := p
+	_ = this
+
+	localctx = NewDatabaseFilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, SQLParserRULE_databaseFilter)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(563)
+		p.Match(SQLParserT_DATASBAE)
+	}
+	{
+		p.SetState(564)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(565)
+		p.Ident()
+	}
+
+
+
+	return localctx
+}
+
+
+// ITypeFilterContext is an interface to support dynamic dispatch.
+type ITypeFilterContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTypeFilterContext differentiates from other interfaces.
+	IsTypeFilterContext()
+}
+
+type TypeFilterContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypeFilterContext() *TypeFilterContext {
+	var p = new(TypeFilterContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_typeFilter
+	return p
+}
+
+func (*TypeFilterContext) IsTypeFilterContext() {}
+
+func NewTypeFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeFilterContext {
+	var p = new(TypeFilterContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_typeFilter
+
+	return p
+}
+
+func (s *TypeFilterContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeFilterContext) T_TYPE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TYPE, 0)
+}
+
+func (s *TypeFilterContext) T_EQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_EQUAL, 0)
+}
+
+func (s *TypeFilterContext) Ident() IIdentContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentContext)
+}
+
+func (s *TypeFilterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *TypeFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterTypeFilter(s)
+	}
+}
+
+func (s *TypeFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitTypeFilter(s)
+	}
+}
+
+func (s *TypeFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitTypeFilter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeFilterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 110, SQLParserRULE_typeFilter)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(567)
+		p.Match(SQLParserT_TYPE)
+	}
+	{
+		p.SetState(568)
+		p.Match(SQLParserT_EQUAL)
+	}
+	{
+		p.SetState(569)
+		p.Ident()
+	}
+
+
+
+	return localctx
+}
+
+
+// IFromClauseContext is an interface to support dynamic dispatch.
+type IFromClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFromClauseContext differentiates from other interfaces.
+	IsFromClauseContext()
+}
+
+type FromClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFromClauseContext() *FromClauseContext {
+	var p = new(FromClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_fromClause
+	return p
+}
+
+func (*FromClauseContext) IsFromClauseContext() {}
+
+func NewFromClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FromClauseContext {
+	var p = new(FromClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_fromClause
+
+	return p
+}
+
+func (s *FromClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FromClauseContext) T_FROM() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_FROM, 0)
+}
+
+func (s *FromClauseContext) AllMetricName() []IMetricNameContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMetricNameContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMetricNameContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMetricNameContext); ok {
+			tst[i] = t.(IMetricNameContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FromClauseContext) MetricName(i int) IMetricNameContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricNameContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricNameContext)
+}
+
+func (s *FromClauseContext) AllMetricAlias() []IMetricAliasContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMetricAliasContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMetricAliasContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMetricAliasContext); ok {
+			tst[i] = t.(IMetricAliasContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FromClauseContext) MetricAlias(i int) IMetricAliasContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricAliasContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricAliasContext)
+}
+
+func (s *FromClauseContext) AllT_COMMA() []antlr.TerminalNode {
+	return s.GetTokens(SQLParserT_COMMA)
+}
+
+func (s *FromClauseContext) T_COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(SQLParserT_COMMA, i)
+}
+
+func (s *FromClauseContext) T_ON() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ON, 0)
+}
+
+func (s *FromClauseContext) Namespace() INamespaceContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamespaceContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamespaceContext)
+}
+
+func (s *FromClauseContext) AllMetricOffset() []IMetricOffsetContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMetricOffsetContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMetricOffsetContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMetricOffsetContext); ok {
+			tst[i] = t.(IMetricOffsetContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FromClauseContext) MetricOffset(i int) IMetricOffsetContext {
+	var t antlr.RuleContext;
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMetricOffsetContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMetricOffsetContext)
+}
+
+func (s *FromClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FromClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *FromClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterFromClause(s)
+	}
+}
+
+func (s *FromClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitFromClause(s)
+	}
+}
+
+func (s *FromClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitFromClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
+	this := p
+	_ = this
+
+	localctx = NewFromClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, SQLParserRULE_fromClause)
+	var _la int
+
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(571)
+		p.Match(SQLParserT_FROM)
+	}
+	{
+		p.SetState(572)
+		p.MetricName()
+	}
+	p.SetState(577)
+	p.GetErrorHandler().Sync(p)
+
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext()) == 1 {
+		p.SetState(574)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+
+		if _la == SQLParserT_OFFSET {
+			{
+				p.SetState(573)
+				p.MetricOffset()
+			}
+
+		}
+		{
+			p.SetState(576)
+			p.MetricAlias()
+		}
+
+
+	}
+	p.SetState(589)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	for _la == SQLParserT_COMMA {
+		{
+			p.SetState(579)
+			p.Match(SQLParserT_COMMA)
+		}
+		{
+			p.SetState(580)
+			p.MetricName()
+		}
+		p.SetState(585)
+		p.GetErrorHandler().Sync(p)
+
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext()) == 1 {
+			p.SetState(582)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+
+			if _la == SQLParserT_OFFSET {
+				{
+					p.SetState(581)
+					p.MetricOffset()
+				}
+
+			}
+			{
+				p.SetState(584)
+				p.MetricAlias()
+			}
+
+
+		}
+
+
+		p.SetState(591)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(594)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	defer func() {
-		p.ExitRule()
-	}()
 
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
+	if _la == SQLParserT_ON {
+		{
+			p.SetState(592)
+			p.Match(SQLParserT_ON)
+		}
+		{
+			p.SetState(593)
+			p.Namespace()
 		}
-	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(421)
-		p.Match(SQLParserT_DATASBAE)
-	}
-	{
-		p.SetState(422)
-		p.Match(SQLParserT_EQUAL)
-	}
-	{
-		p.SetState(423)
-		p.Ident()
 	}
 
 
@@ -7478,53 +10950,49 @@ func (p *SQLParser) DatabaseFilter() (localctx IDatabaseFilterContext) {
 }
 
 
-// ITypeFilterContext is an interface to support dynamic dispatch.
-type ITypeFilterContext interface {
+// IMetricAliasContext is an interface to support dynamic dispatch.
+type IMetricAliasContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeFilterContext differentiates from other interfaces.
-	IsTypeFilterContext()
+	// IsMetricAliasContext differentiates from other interfaces.
+	IsMetricAliasContext()
 }
 
-type TypeFilterContext struct {
+type MetricAliasContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeFilterContext() *TypeFilterContext {
-	var p = new(TypeFilterContext)
+func NewEmptyMetricAliasContext() *MetricAliasContext {
+	var p = new(MetricAliasContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_typeFilter
+	p.RuleIndex = SQLParserRULE_metricAlias
 	return p
 }
 
-func (*TypeFilterContext) IsTypeFilterContext() {}
+func (*MetricAliasContext) IsMetricAliasContext() {}
 
-func NewTypeFilterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeFilterContext {
-	var p = new(TypeFilterContext)
+func NewMetricAliasContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MetricAliasContext {
+	var p = new(MetricAliasContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_typeFilter
+	p.RuleIndex = SQLParserRULE_metricAlias
 
 	return p
 }
 
-func (s *TypeFilterContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TypeFilterContext) T_TYPE() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_TYPE, 0)
-}
+func (s *MetricAliasContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeFilterContext) T_EQUAL() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_EQUAL, 0)
+func (s *MetricAliasContext) T_AS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AS, 0)
 }
 
-func (s *TypeFilterContext) Ident() IIdentContext {
+func (s *MetricAliasContext) Ident() IIdentContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IIdentContext); ok {
@@ -7540,31 +11008,31 @@ func (s *TypeFilterContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
-func (s *TypeFilterContext) GetRuleContext() antlr.RuleContext {
+func (s *MetricAliasContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeFilterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MetricAliasContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *TypeFilterContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MetricAliasContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterTypeFilter(s)
+		listenerT.EnterMetricAlias(s)
 	}
 }
 
-func (s *TypeFilterContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MetricAliasContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitTypeFilter(s)
+		listenerT.ExitMetricAlias(s)
 	}
 }
 
-func (s *TypeFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MetricAliasContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitTypeFilter(s)
+		return t.VisitMetricAlias(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -7574,12 +11042,12 @@ func (s *TypeFilterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
+func (p *SQLParser) MetricAlias() (localctx IMetricAliasContext) {
 	this := p
 	_ = this
 
-	localctx = NewTypeFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, SQLParserRULE_typeFilter)
+	localctx = NewMetricAliasContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 114, SQLParserRULE_metricAlias)
 
 	defer func() {
 		p.ExitRule()
@@ -7599,15 +11067,11 @@ func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(425)
-		p.Match(SQLParserT_TYPE)
-	}
-	{
-		p.SetState(426)
-		p.Match(SQLParserT_EQUAL)
+		p.SetState(596)
+		p.Match(SQLParserT_AS)
 	}
 	{
-		p.SetState(427)
+		p.SetState(597)
 		p.Ident()
 	}
 
@@ -7617,72 +11081,52 @@ func (p *SQLParser) TypeFilter() (localctx ITypeFilterContext) {
 }
 
 
-// IFromClauseContext is an interface to support dynamic dispatch.
-type IFromClauseContext interface {
+// IMetricOffsetContext is an interface to support dynamic dispatch.
+type IMetricOffsetContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFromClauseContext differentiates from other interfaces.
-	IsFromClauseContext()
+	// IsMetricOffsetContext differentiates from other interfaces.
+	IsMetricOffsetContext()
 }
 
-type FromClauseContext struct {
+type MetricOffsetContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFromClauseContext() *FromClauseContext {
-	var p = new(FromClauseContext)
+func NewEmptyMetricOffsetContext() *MetricOffsetContext {
+	var p = new(MetricOffsetContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_fromClause
+	p.RuleIndex = SQLParserRULE_metricOffset
 	return p
 }
 
-func (*FromClauseContext) IsFromClauseContext() {}
+func (*MetricOffsetContext) IsMetricOffsetContext() {}
 
-func NewFromClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FromClauseContext {
-	var p = new(FromClauseContext)
+func NewMetricOffsetContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MetricOffsetContext {
+	var p = new(MetricOffsetContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_fromClause
+	p.RuleIndex = SQLParserRULE_metricOffset
 
 	return p
 }
 
-func (s *FromClauseContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *FromClauseContext) T_FROM() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_FROM, 0)
-}
-
-func (s *FromClauseContext) MetricName() IMetricNameContext {
-	var t antlr.RuleContext;
-	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IMetricNameContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
-		}
-	}
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IMetricNameContext)
-}
+func (s *MetricOffsetContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FromClauseContext) T_ON() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_ON, 0)
+func (s *MetricOffsetContext) T_OFFSET() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OFFSET, 0)
 }
 
-func (s *FromClauseContext) Namespace() INamespaceContext {
+func (s *MetricOffsetContext) DurationLit() IDurationLitContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(INamespaceContext); ok {
+		if _, ok := ctx.(IDurationLitContext); ok {
 			t = ctx.(antlr.RuleContext);
 			break
 		}
@@ -7692,34 +11136,34 @@ func (s *FromClauseContext) Namespace() INamespaceContext {
 		return nil
 	}
 
-	return t.(INamespaceContext)
+	return t.(IDurationLitContext)
 }
 
-func (s *FromClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *MetricOffsetContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FromClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MetricOffsetContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *FromClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MetricOffsetContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterFromClause(s)
+		listenerT.EnterMetricOffset(s)
 	}
 }
 
-func (s *FromClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MetricOffsetContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitFromClause(s)
+		listenerT.ExitMetricOffset(s)
 	}
 }
 
-func (s *FromClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MetricOffsetContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitFromClause(s)
+		return t.VisitMetricOffset(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -7729,14 +11173,12 @@ func (s *FromClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
+func (p *SQLParser) MetricOffset() (localctx IMetricOffsetContext) {
 	this := p
 	_ = this
 
-	localctx = NewFromClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, SQLParserRULE_fromClause)
-	var _la int
-
+	localctx = NewMetricOffsetContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, SQLParserRULE_metricOffset)
 
 	defer func() {
 		p.ExitRule()
@@ -7756,28 +11198,12 @@ func (p *SQLParser) FromClause() (localctx IFromClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(429)
-		p.Match(SQLParserT_FROM)
+		p.SetState(599)
+		p.Match(SQLParserT_OFFSET)
 	}
 	{
-		p.SetState(430)
-		p.MetricName()
-	}
-	p.SetState(433)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-
-	if _la == SQLParserT_ON {
-		{
-			p.SetState(431)
-			p.Match(SQLParserT_ON)
-		}
-		{
-			p.SetState(432)
-			p.Namespace()
-		}
-
+		p.SetState(600)
+		p.DurationLit()
 	}
 
 
@@ -7883,7 +11309,7 @@ func (p *SQLParser) WhereClause() (localctx IWhereClauseContext) {
 	_ = this
 
 	localctx = NewWhereClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, SQLParserRULE_whereClause)
+	p.EnterRule(localctx, 118, SQLParserRULE_whereClause)
 
 	defer func() {
 		p.ExitRule()
@@ -7903,11 +11329,11 @@ func (p *SQLParser) WhereClause() (localctx IWhereClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(435)
+		p.SetState(602)
 		p.Match(SQLParserT_WHERE)
 	}
 	{
-		p.SetState(436)
+		p.SetState(603)
 		p.ConditionExpr()
 	}
 
@@ -8030,7 +11456,7 @@ func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
 	_ = this
 
 	localctx = NewConditionExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, SQLParserRULE_conditionExpr)
+	p.EnterRule(localctx, 120, SQLParserRULE_conditionExpr)
 	var _la int
 
 
@@ -8050,13 +11476,13 @@ func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
 		}
 	}()
 
-	p.SetState(448)
+	p.SetState(615)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 39, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(438)
+			p.SetState(605)
 			p.tagFilterExpr(0)
 		}
 
@@ -8064,15 +11490,15 @@ func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(439)
+			p.SetState(606)
 			p.tagFilterExpr(0)
 		}
 		{
-			p.SetState(440)
+			p.SetState(607)
 			p.Match(SQLParserT_AND)
 		}
 		{
-			p.SetState(441)
+			p.SetState(608)
 			p.TimeRangeExpr()
 		}
 
@@ -8080,21 +11506,21 @@ func (p *SQLParser) ConditionExpr() (localctx IConditionExprContext) {
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(443)
+			p.SetState(610)
 			p.TimeRangeExpr()
 		}
-		p.SetState(446)
+		p.SetState(613)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 
 		if _la == SQLParserT_AND {
 			{
-				p.SetState(444)
+				p.SetState(611)
 				p.Match(SQLParserT_AND)
 			}
 			{
-				p.SetState(445)
+				p.SetState(612)
 				p.tagFilterExpr(0)
 			}
 
@@ -8210,12 +11636,37 @@ func (s *TagFilterExprContext) TagKey() ITagKeyContext {
 	return t.(ITagKeyContext)
 }
 
-func (s *TagFilterExprContext) TagValue() ITagValueContext {
+func (s *TagFilterExprContext) AllTagValue() []ITagValueContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITagValueContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITagValueContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITagValueContext); ok {
+			tst[i] = t.(ITagValueContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TagFilterExprContext) TagValue(i int) ITagValueContext {
 	var t antlr.RuleContext;
+	j := 0
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(ITagValueContext); ok {
-			t = ctx.(antlr.RuleContext);
-			break
+			if j == i {
+				t = ctx.(antlr.RuleContext);
+				break
+			}
+			j++
 		}
 	}
 
@@ -8254,6 +11705,46 @@ func (s *TagFilterExprContext) T_NOTEQUAL2() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_NOTEQUAL2, 0)
 }
 
+func (s *TagFilterExprContext) TagRangeKey() ITagRangeKeyContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITagRangeKeyContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITagRangeKeyContext)
+}
+
+func (s *TagFilterExprContext) T_LESS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LESS, 0)
+}
+
+func (s *TagFilterExprContext) T_LESSEQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LESSEQUAL, 0)
+}
+
+func (s *TagFilterExprContext) T_GREATER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_GREATER, 0)
+}
+
+func (s *TagFilterExprContext) T_GREATEREQUAL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_GREATEREQUAL, 0)
+}
+
+func (s *TagFilterExprContext) T_BETWEEN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_BETWEEN, 0)
+}
+
+func (s *TagFilterExprContext) T_AND() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_AND, 0)
+}
+
 func (s *TagFilterExprContext) TagValueList() ITagValueListContext {
 	var t antlr.RuleContext;
 	for _, ctx := range s.GetChildren() {
@@ -8274,10 +11765,6 @@ func (s *TagFilterExprContext) T_IN() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_IN, 0)
 }
 
-func (s *TagFilterExprContext) T_AND() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_AND, 0)
-}
-
 func (s *TagFilterExprContext) T_OR() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_OR, 0)
 }
@@ -8330,8 +11817,8 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 	localctx = NewTagFilterExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx ITagFilterExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 86
-	p.EnterRecursionRule(localctx, 86, SQLParserRULE_tagFilterExpr, _p)
+	_startState := 122
+	p.EnterRecursionRule(localctx, 122, SQLParserRULE_tagFilterExpr, _p)
 	var _la int
 
 
@@ -8354,82 +11841,82 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(478)
+	p.SetState(655)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 29, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(451)
+			p.SetState(618)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(452)
+			p.SetState(619)
 			p.tagFilterExpr(0)
 		}
 		{
-			p.SetState(453)
+			p.SetState(620)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 
 	case 2:
 		{
-			p.SetState(455)
+			p.SetState(622)
 			p.TagKey()
 		}
-		p.SetState(464)
+		p.SetState(631)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SQLParserT_EQUAL:
 			{
-				p.SetState(456)
+				p.SetState(623)
 				p.Match(SQLParserT_EQUAL)
 			}
 
 
 		case SQLParserT_LIKE:
 			{
-				p.SetState(457)
+				p.SetState(624)
 				p.Match(SQLParserT_LIKE)
 			}
 
 
 		case SQLParserT_NOT:
 			{
-				p.SetState(458)
+				p.SetState(625)
 				p.Match(SQLParserT_NOT)
 			}
 			{
-				p.SetState(459)
+				p.SetState(626)
 				p.Match(SQLParserT_LIKE)
 			}
 
 
 		case SQLParserT_REGEXP:
 			{
-				p.SetState(460)
+				p.SetState(627)
 				p.Match(SQLParserT_REGEXP)
 			}
 
 
 		case SQLParserT_NEQREGEXP:
 			{
-				p.SetState(461)
+				p.SetState(628)
 				p.Match(SQLParserT_NEQREGEXP)
 			}
 
 
 		case SQLParserT_NOTEQUAL:
 			{
-				p.SetState(462)
+				p.SetState(629)
 				p.Match(SQLParserT_NOTEQUAL)
 			}
 
 
 		case SQLParserT_NOTEQUAL2:
 			{
-				p.SetState(463)
+				p.SetState(630)
 				p.Match(SQLParserT_NOTEQUAL2)
 			}
 
@@ -8439,34 +11926,79 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 		{
-			p.SetState(466)
+			p.SetState(633)
 			p.TagValue()
 		}
 
 
 	case 3:
 		{
-			p.SetState(468)
+			p.SetState(635)
+			p.TagRangeKey()
+		}
+		{
+			p.SetState(636)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(((((_la - 129)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 129))) & ((1 << (SQLParserT_GREATER - 129)) | (1 << (SQLParserT_GREATEREQUAL - 129)) | (1 << (SQLParserT_LESS - 129)) | (1 << (SQLParserT_LESSEQUAL - 129)))) != 0)) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(637)
+			p.TagValue()
+		}
+
+
+	case 4:
+		{
+			p.SetState(639)
 			p.TagKey()
 		}
-		p.SetState(472)
+		{
+			p.SetState(640)
+			p.Match(SQLParserT_BETWEEN)
+		}
+		{
+			p.SetState(641)
+			p.TagValue()
+		}
+		{
+			p.SetState(642)
+			p.Match(SQLParserT_AND)
+		}
+		{
+			p.SetState(643)
+			p.TagValue()
+		}
+
+
+	case 5:
+		{
+			p.SetState(645)
+			p.TagKey()
+		}
+		p.SetState(649)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case SQLParserT_IN:
 			{
-				p.SetState(469)
+				p.SetState(646)
 				p.Match(SQLParserT_IN)
 			}
 
 
 		case SQLParserT_NOT:
 			{
-				p.SetState(470)
+				p.SetState(647)
 				p.Match(SQLParserT_NOT)
 			}
 			{
-				p.SetState(471)
+				p.SetState(648)
 				p.Match(SQLParserT_IN)
 			}
 
@@ -8476,23 +12008,23 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 		{
-			p.SetState(474)
+			p.SetState(651)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(475)
+			p.SetState(652)
 			p.TagValueList()
 		}
 		{
-			p.SetState(476)
+			p.SetState(653)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(485)
+	p.SetState(662)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 30, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -8502,13 +12034,13 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 			_prevctx = localctx
 			localctx = NewTagFilterExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_tagFilterExpr)
-			p.SetState(480)
+			p.SetState(657)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 			}
 			{
-				p.SetState(481)
+				p.SetState(658)
 				_la = p.GetTokenStream().LA(1)
 
 				if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
@@ -8519,15 +12051,15 @@ func (p *SQLParser) tagFilterExpr(_p int) (localctx ITagFilterExprContext) {
 				}
 			}
 			{
-				p.SetState(482)
+				p.SetState(659)
 				p.tagFilterExpr(2)
 			}
 
 
 		}
-		p.SetState(487)
+		p.SetState(664)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 30, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext())
 	}
 
 
@@ -8662,7 +12194,7 @@ func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
 	_ = this
 
 	localctx = NewTagValueListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, SQLParserRULE_tagValueList)
+	p.EnterRule(localctx, 124, SQLParserRULE_tagValueList)
 	var _la int
 
 
@@ -8684,26 +12216,26 @@ func (p *SQLParser) TagValueList() (localctx ITagValueListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(488)
+		p.SetState(665)
 		p.TagValue()
 	}
-	p.SetState(493)
+	p.SetState(670)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(489)
+			p.SetState(666)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(490)
+			p.SetState(667)
 			p.TagValue()
 		}
 
 
-		p.SetState(495)
+		p.SetState(672)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -8823,7 +12355,7 @@ func (p *SQLParser) MetricListFilter() (localctx IMetricListFilterContext) {
 	_ = this
 
 	localctx = NewMetricListFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, SQLParserRULE_metricListFilter)
+	p.EnterRule(localctx, 126, SQLParserRULE_metricListFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -8843,24 +12375,24 @@ func (p *SQLParser) MetricListFilter() (localctx IMetricListFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(496)
+		p.SetState(673)
 		p.Match(SQLParserT_METRIC)
 	}
 	{
-		p.SetState(497)
+		p.SetState(674)
 		p.Match(SQLParserT_IN)
 	}
 
 	{
-		p.SetState(498)
+		p.SetState(675)
 		p.Match(SQLParserT_OPEN_P)
 	}
 	{
-		p.SetState(499)
+		p.SetState(676)
 		p.MetricList()
 	}
 	{
-		p.SetState(500)
+		p.SetState(677)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -8997,7 +12529,7 @@ func (p *SQLParser) MetricList() (localctx IMetricListContext) {
 	_ = this
 
 	localctx = NewMetricListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, SQLParserRULE_metricList)
+	p.EnterRule(localctx, 128, SQLParserRULE_metricList)
 	var _la int
 
 
@@ -9019,26 +12551,26 @@ func (p *SQLParser) MetricList() (localctx IMetricListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(502)
+		p.SetState(679)
 		p.Ident()
 	}
-	p.SetState(507)
+	p.SetState(684)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(503)
+			p.SetState(680)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(504)
+			p.SetState(681)
 			p.Ident()
 		}
 
 
-		p.SetState(509)
+		p.SetState(686)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -9171,7 +12703,7 @@ func (p *SQLParser) TimeRangeExpr() (localctx ITimeRangeExprContext) {
 	_ = this
 
 	localctx = NewTimeRangeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, SQLParserRULE_timeRangeExpr)
+	p.EnterRule(localctx, 130, SQLParserRULE_timeRangeExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -9191,20 +12723,20 @@ func (p *SQLParser) TimeRangeExpr() (localctx ITimeRangeExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(510)
+		p.SetState(687)
 		p.TimeExpr()
 	}
-	p.SetState(513)
+	p.SetState(690)
 	p.GetErrorHandler().Sync(p)
 
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(511)
+			p.SetState(688)
 			p.Match(SQLParserT_AND)
 		}
 		{
-			p.SetState(512)
+			p.SetState(689)
 			p.TimeExpr()
 		}
 
@@ -9346,7 +12878,7 @@ func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
 	_ = this
 
 	localctx = NewTimeExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, SQLParserRULE_timeExpr)
+	p.EnterRule(localctx, 132, SQLParserRULE_timeExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -9366,26 +12898,26 @@ func (p *SQLParser) TimeExpr() (localctx ITimeExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(515)
+		p.SetState(692)
 		p.Match(SQLParserT_TIME)
 	}
 	{
-		p.SetState(516)
+		p.SetState(693)
 		p.BinaryOperator()
 	}
-	p.SetState(519)
+	p.SetState(696)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(517)
+			p.SetState(694)
 			p.NowExpr()
 		}
 
 
 	case 2:
 		{
-			p.SetState(518)
+			p.SetState(695)
 			p.Ident()
 		}
 
@@ -9506,7 +13038,7 @@ func (p *SQLParser) NowExpr() (localctx INowExprContext) {
 	_ = this
 
 	localctx = NewNowExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, SQLParserRULE_nowExpr)
+	p.EnterRule(localctx, 134, SQLParserRULE_nowExpr)
 	var _la int
 
 
@@ -9528,17 +13060,17 @@ func (p *SQLParser) NowExpr() (localctx INowExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(521)
+		p.SetState(698)
 		p.NowFunc()
 	}
-	p.SetState(523)
+	p.SetState(700)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
-	if ((((_la - 118)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 118))) & ((1 << (SQLParserT_ADD - 118)) | (1 << (SQLParserT_SUB - 118)) | (1 << (SQLParserL_INT - 118)))) != 0) {
+	if ((((_la - 142)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 142))) & ((1 << (SQLParserT_ADD - 142)) | (1 << (SQLParserT_SUB - 142)) | (1 << (SQLParserL_INT - 142)))) != 0) {
 		{
-			p.SetState(522)
+			p.SetState(699)
 			p.DurationLit()
 		}
 
@@ -9655,7 +13187,7 @@ func (p *SQLParser) NowFunc() (localctx INowFuncContext) {
 	_ = this
 
 	localctx = NewNowFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, SQLParserRULE_nowFunc)
+	p.EnterRule(localctx, 136, SQLParserRULE_nowFunc)
 	var _la int
 
 
@@ -9677,27 +13209,27 @@ func (p *SQLParser) NowFunc() (localctx INowFuncContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(525)
+		p.SetState(702)
 		p.Match(SQLParserT_NOW)
 	}
 	{
-		p.SetState(526)
+		p.SetState(703)
 		p.Match(SQLParserT_OPEN_P)
 	}
-	p.SetState(528)
+	p.SetState(705)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
-	if (((_la) & -(0x1f+1)) == 0 && ((1 << uint(_la)) & ((1 << SQLParserT_CREATE) | (1 << SQLParserT_UPDATE) | (1 << SQLParserT_SET) | (1 << SQLParserT_DROP) | (1 << SQLParserT_INTERVAL) | (1 << SQLParserT_INTERVAL_NAME) | (1 << SQLParserT_SHARD) | (1 << SQLParserT_REPLICATION) | (1 << SQLParserT_TTL) | (1 << SQLParserT_META_TTL) | (1 << SQLParserT_PAST_TTL) | (1 << SQLParserT_FUTURE_TTL) | (1 << SQLParserT_KILL) | (1 << SQLParserT_ON) | (1 << SQLParserT_SHOW) | (1 << SQLParserT_USE) | (1 << SQLParserT_STATE_REPO) | (1 << SQLParserT_STATE_MACHINE) | (1 << SQLParserT_MASTER) | (1 << SQLParserT_METADATA) | (1 << SQLParserT_TYPES) | (1 << SQLParserT_TYPE) | (1 << SQLParserT_STORAGES) | (1 << SQLParserT_STORAGE) | (1 << SQLParserT_BROKER) | (1 << SQLParserT_ALIVE))) != 0) || ((((_la - 32)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 32))) & ((1 << (SQLParserT_SCHEMAS - 32)) | (1 << (SQLParserT_DATASBAE - 32)) | (1 << (SQLParserT_DATASBAES - 32)) | (1 << (SQLParserT_NAMESPACE - 32)) | (1 << (SQLParserT_NAMESPACES - 32)) | (1 << (SQLParserT_NODE - 32)) | (1 << (SQLParserT_METRICS - 32)) | (1 << (SQLParserT_METRIC - 32)) | (1 << (SQLParserT_FIELD - 32)) | (1 << (SQLParserT_FIELDS - 32)) | (1 << (SQLParserT_TAG - 32)) | (1 << (SQLParserT_INFO - 32)) | (1 << (SQLParserT_KEYS - 32)) | (1 << (SQLParserT_KEY - 32)) | (1 << (SQLParserT_WITH - 32)) | (1 << (SQLParserT_VALUES - 32)) | (1 << (SQLParserT_VALUE - 32)) | (1 << (SQLParserT_FROM - 32)) | (1 << (SQLParserT_WHERE - 32)) | (1 << (SQLParserT_LIMIT - 32)) | (1 << (SQLParserT_QUERIES - 32)) | (1 << (SQLParserT_QUERY - 32)) | (1 << (SQLParserT_EXPLAIN - 32)) | (1 << (SQLParserT_WITH_VALUE - 32)) | (1 << (SQLParserT_SELECT - 32)) | (1 << (SQLParserT_AS - 32)) | (1 << (SQLParserT_AND - 32)) | (1 << (SQLParserT_OR - 32)) | (1 << (SQLParserT_FILL - 32)) | (1 << (SQLParserT_NULL - 32)) | (1 << (SQLParserT_PREVIOUS - 32)) | (1 << (SQLParserT_ORDER - 32)))) != 0) || ((((_la - 64)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 64))) & ((1 << (SQLParserT_ASC - 64)) | (1 << (SQLParserT_DESC - 64)) | (1 << (SQLParserT_LIKE - 64)) | (1 << (SQLParserT_NOT - 64)) | (1 << (SQLParserT_BETWEEN - 64)) | (1 << (SQLParserT_IS - 64)) | (1 << (SQLParserT_GROUP - 64)) | (1 << (SQLParserT_HAVING - 64)) | (1 << (SQLParserT_BY - 64)) | (1 << (SQLParserT_FOR - 64)) | (1 << (SQLParserT_STATS - 64)) | (1 << (SQLParserT_TIME - 64)) | (1 << (SQLParserT_NOW - 64)) | (1 << (SQLParserT_IN - 64)) | (1 << (SQLParserT_LOG - 64)) | (1 << (SQLParserT_PROFILE - 64)) | (1 << (SQLParserT_REQUESTS - 64)) | (1 << (SQLParserT_REQUEST - 64)) | (1 << (SQLParserT_ID - 64)) | (1 << (SQLParserT_SUM - 64)) | (1 << (SQLParserT_MIN - 64)) | (1 << (SQLParserT_MAX - 64)) | (1 << (SQLParserT_COUNT - 64)) | (1 << (SQLParserT_LAST - 64)) | (1 << (SQLParserT_FIRST - 64)) | (1 << (SQLParserT_AVG - 64)) | (1 << (SQLParserT_STDDEV - 64)) | (1 << (SQLParserT_QUANTILE - 64)) | (1 << (SQLParserT_RATE - 64)) | (1 << (SQLParserT_SECOND - 64)) | (1 << (SQLParserT_MINUTE - 64)) | (1 << (SQLParserT_HOUR - 64)))) != 0) || ((((_la - 96)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 96))) & ((1 << (SQLParserT_DAY - 96)) | (1 << (SQLParserT_WEEK - 96)) | (1 << (SQLParserT_MONTH - 96)) | (1 << (SQLParserT_YEAR - 96)) | (1 << (SQLParserT_OPEN_P - 96)) | (1 << (SQLParserT_ADD - 96)) | (1 << (SQLParserT_SUB - 96)) | (1 << (SQLParserL_ID - 96)) | (1 << (SQLParserL_INT - 96)) | (1 << (SQLParserL_DEC - 96)))) != 0) {
+	if (((_la) & -(0x1f+1)) == 0 && ((1 << uint(_la)) & ((1 << SQLParserT_CREATE) | (1 << SQLParserT_UPDATE) | (1 << SQLParserT_SET) | (1 << SQLParserT_DROP) | (1 << SQLParserT_ALTER) | (1 << SQLParserT_DELETE) | (1 << SQLParserT_INTERVAL) | (1 << SQLParserT_INTERVAL_NAME) | (1 << SQLParserT_SHARD) | (1 << SQLParserT_REPLICATION) | (1 << SQLParserT_TTL) | (1 << SQLParserT_META_TTL) | (1 << SQLParserT_PAST_TTL) | (1 << SQLParserT_FUTURE_TTL) | (1 << SQLParserT_KILL) | (1 << SQLParserT_ON) | (1 << SQLParserT_SHOW) | (1 << SQLParserT_USE) | (1 << SQLParserT_STATE_REPO) | (1 << SQLParserT_STATE_MACHINE) | (1 << SQLParserT_MASTER) | (1 << SQLParserT_METADATA) | (1 << SQLParserT_TYPES) | (1 << SQLParserT_TYPE) | (1 << SQLParserT_STORAGES) | (1 << SQLParserT_STORAGE) | (1 << SQLParserT_BROKER))) != 0) || ((((_la - 32)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 32))) & ((1 << (SQLParserT_ALIVE - 32)) | (1 << (SQLParserT_SCHEMAS - 32)) | (1 << (SQLParserT_DATASBAE - 32)) | (1 << (SQLParserT_DATASBAES - 32)) | (1 << (SQLParserT_NAMESPACE - 32)) | (1 << (SQLParserT_NAMESPACES - 32)) | (1 << (SQLParserT_NODE - 32)) | (1 << (SQLParserT_METRICS - 32)) | (1 << (SQLParserT_METRIC - 32)) | (1 << (SQLParserT_FIELD - 32)) | (1 << (SQLParserT_FIELDS - 32)) | (1 << (SQLParserT_TAG - 32)) | (1 << (SQLParserT_INFO - 32)) | (1 << (SQLParserT_KEYS - 32)) | (1 << (SQLParserT_KEY - 32)) | (1 << (SQLParserT_WITH - 32)) | (1 << (SQLParserT_VALUES - 32)) | (1 << (SQLParserT_VALUE - 32)) | (1 << (SQLParserT_FROM - 32)) | (1 << (SQLParserT_WHERE - 32)) | (1 << (SQLParserT_LIMIT - 32)) | (1 << (SQLParserT_OFFSET - 32)) | (1 << (SQLParserT_QUERIES - 32)) | (1 << (SQLParserT_QUERY - 32)) | (1 << (SQLParserT_EXPLAIN - 32)) | (1 << (SQLParserT_WITH_VALUE - 32)) | (1 << (SQLParserT_SELECT - 32)) | (1 << (SQLParserT_AS - 32)) | (1 << (SQLParserT_AND - 32)) | (1 << (SQLParserT_OR - 32)) | (1 << (SQLParserT_FILL - 32)) | (1 << (SQLParserT_NULL - 32)))) != 0) || ((((_la - 64)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 64))) & ((1 << (SQLParserT_PREVIOUS - 64)) | (1 << (SQLParserT_ORDER - 64)) | (1 << (SQLParserT_ASC - 64)) | (1 << (SQLParserT_DESC - 64)) | (1 << (SQLParserT_LIKE - 64)) | (1 << (SQLParserT_NOT - 64)) | (1 << (SQLParserT_BETWEEN - 64)) | (1 << (SQLParserT_IS - 64)) | (1 << (SQLParserT_GROUP - 64)) | (1 << (SQLParserT_HAVING - 64)) | (1 << (SQLParserT_BY - 64)) | (1 << (SQLParserT_FOR - 64)) | (1 << (SQLParserT_STATS - 64)) | (1 << (SQLParserT_TIME - 64)) | (1 << (SQLParserT_NOW - 64)) | (1 << (SQLParserT_IN - 64)) | (1 << (SQLParserT_LOG - 64)) | (1 << (SQLParserT_PROFILE - 64)) | (1 << (SQLParserT_REQUESTS - 64)) | (1 << (SQLParserT_REQUEST - 64)) | (1 << (SQLParserT_SERIES - 64)) | (1 << (SQLParserT_QUOTA - 64)) | (1 << (SQLParserT_CARDINALITY - 64)) | (1 << (SQLParserT_ID - 64)) | (1 << (SQLParserT_USER - 64)) | (1 << (SQLParserT_USERS - 64)) | (1 << (SQLParserT_PASSWORD - 64)) | (1 << (SQLParserT_TOKEN - 64)) | (1 << (SQLParserT_GRANT - 64)) | (1 << (SQLParserT_REVOKE - 64)) | (1 << (SQLParserT_TO - 64)) | (1 << (SQLParserT_READ - 64)))) != 0) || ((((_la - 96)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 96))) & ((1 << (SQLParserT_WRITE - 96)) | (1 << (SQLParserT_ADMIN - 96)) | (1 << (SQLParserT_SUM - 96)) | (1 << (SQLParserT_MIN - 96)) | (1 << (SQLParserT_MAX - 96)) | (1 << (SQLParserT_COUNT - 96)) | (1 << (SQLParserT_LAST - 96)) | (1 << (SQLParserT_FIRST - 96)) | (1 << (SQLParserT_AVG - 96)) | (1 << (SQLParserT_STDDEV - 96)) | (1 << (SQLParserT_QUANTILE - 96)) | (1 << (SQLParserT_RATE - 96)) | (1 << (SQLParserT_PERCENTILE - 96)) | (1 << (SQLParserT_INCREASE - 96)) | (1 << (SQLParserT_DERIVATIVE - 96)) | (1 << (SQLParserT_DELTA - 96)) | (1 << (SQLParserT_MOVING_AVERAGE - 96)) | (1 << (SQLParserT_ABS - 96)) | (1 << (SQLParserT_CLAMP_MIN - 96)) | (1 << (SQLParserT_CLAMP_MAX - 96)) | (1 << (SQLParserT_TIMESHIFT - 96)) | (1 << (SQLParserT_SECOND - 96)) | (1 << (SQLParserT_MINUTE - 96)) | (1 << (SQLParserT_HOUR - 96)) | (1 << (SQLParserT_DAY - 96)) | (1 << (SQLParserT_WEEK - 96)) | (1 << (SQLParserT_MONTH - 96)) | (1 << (SQLParserT_YEAR - 96)))) != 0) || ((((_la - 140)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 140))) & ((1 << (SQLParserT_OPEN_P - 140)) | (1 << (SQLParserT_ADD - 140)) | (1 << (SQLParserT_SUB - 140)) | (1 << (SQLParserL_ID - 140)) | (1 << (SQLParserL_INT - 140)) | (1 << (SQLParserL_DEC - 140)))) != 0) {
 		{
-			p.SetState(527)
+			p.SetState(704)
 			p.ExprFuncParams()
 		}
 
 	}
 	{
-		p.SetState(530)
+		p.SetState(707)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -9852,7 +13384,7 @@ func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
 	_ = this
 
 	localctx = NewGroupByClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, SQLParserRULE_groupByClause)
+	p.EnterRule(localctx, 138, SQLParserRULE_groupByClause)
 	var _la int
 
 
@@ -9874,49 +13406,49 @@ func (p *SQLParser) GroupByClause() (localctx IGroupByClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(532)
+		p.SetState(709)
 		p.Match(SQLParserT_GROUP)
 	}
 	{
-		p.SetState(533)
+		p.SetState(710)
 		p.Match(SQLParserT_BY)
 	}
 	{
-		p.SetState(534)
+		p.SetState(711)
 		p.GroupByKeys()
 	}
-	p.SetState(540)
+	p.SetState(717)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	if _la == SQLParserT_FILL {
 		{
-			p.SetState(535)
+			p.SetState(712)
 			p.Match(SQLParserT_FILL)
 		}
 		{
-			p.SetState(536)
+			p.SetState(713)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(537)
+			p.SetState(714)
 			p.FillOption()
 		}
 		{
-			p.SetState(538)
+			p.SetState(715)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 	}
-	p.SetState(543)
+	p.SetState(720)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	if _la == SQLParserT_HAVING {
 		{
-			p.SetState(542)
+			p.SetState(719)
 			p.HavingClause()
 		}
 
@@ -10054,7 +13586,7 @@ func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
 	_ = this
 
 	localctx = NewGroupByKeysContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, SQLParserRULE_groupByKeys)
+	p.EnterRule(localctx, 140, SQLParserRULE_groupByKeys)
 	var _la int
 
 
@@ -10076,26 +13608,26 @@ func (p *SQLParser) GroupByKeys() (localctx IGroupByKeysContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(545)
+		p.SetState(722)
 		p.GroupByKey()
 	}
-	p.SetState(550)
+	p.SetState(727)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(546)
+			p.SetState(723)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(547)
+			p.SetState(724)
 			p.GroupByKey()
 		}
 
 
-		p.SetState(552)
+		p.SetState(729)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -10227,7 +13759,7 @@ func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
 	_ = this
 
 	localctx = NewGroupByKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, SQLParserRULE_groupByKey)
+	p.EnterRule(localctx, 142, SQLParserRULE_groupByKey)
 
 	defer func() {
 		p.ExitRule()
@@ -10245,13 +13777,13 @@ func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
 		}
 	}()
 
-	p.SetState(559)
+	p.SetState(736)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 40, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(553)
+			p.SetState(730)
 			p.Ident()
 		}
 
@@ -10259,19 +13791,19 @@ func (p *SQLParser) GroupByKey() (localctx IGroupByKeyContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(554)
+			p.SetState(731)
 			p.Match(SQLParserT_TIME)
 		}
 		{
-			p.SetState(555)
+			p.SetState(732)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(556)
+			p.SetState(733)
 			p.DurationLit()
 		}
 		{
-			p.SetState(557)
+			p.SetState(734)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
@@ -10336,6 +13868,10 @@ func (s *FillOptionContext) L_DEC() antlr.TerminalNode {
 	return s.GetToken(SQLParserL_DEC, 0)
 }
 
+func (s *FillOptionContext) T_SUB() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SUB, 0)
+}
+
 func (s *FillOptionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -10375,7 +13911,7 @@ func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
 	_ = this
 
 	localctx = NewFillOptionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, SQLParserRULE_fillOption)
+	p.EnterRule(localctx, 144, SQLParserRULE_fillOption)
 	var _la int
 
 
@@ -10395,21 +13931,59 @@ func (p *SQLParser) FillOption() (localctx IFillOptionContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(561)
+	p.SetState(744)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_NULL:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(738)
+			p.Match(SQLParserT_NULL)
+		}
+
+
+	case SQLParserT_PREVIOUS:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(739)
+			p.Match(SQLParserT_PREVIOUS)
+		}
+
+
+	case SQLParserT_SUB, SQLParserL_INT, SQLParserL_DEC:
+		p.EnterOuterAlt(localctx, 3)
+		p.SetState(741)
+		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == SQLParserT_NULL || _la == SQLParserT_PREVIOUS || _la == SQLParserL_INT || _la == SQLParserL_DEC) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+
+		if _la == SQLParserT_SUB {
+			{
+				p.SetState(740)
+				p.Match(SQLParserT_SUB)
+			}
+
+		}
+		{
+			p.SetState(743)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == SQLParserL_INT || _la == SQLParserL_DEC) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
 		}
-	}
 
 
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+
 	return localctx
 }
 
@@ -10515,7 +14089,7 @@ func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 	_ = this
 
 	localctx = NewOrderByClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, SQLParserRULE_orderByClause)
+	p.EnterRule(localctx, 146, SQLParserRULE_orderByClause)
 
 	defer func() {
 		p.ExitRule()
@@ -10535,15 +14109,15 @@ func (p *SQLParser) OrderByClause() (localctx IOrderByClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(563)
+		p.SetState(746)
 		p.Match(SQLParserT_ORDER)
 	}
 	{
-		p.SetState(564)
+		p.SetState(747)
 		p.Match(SQLParserT_BY)
 	}
 	{
-		p.SetState(565)
+		p.SetState(748)
 		p.SortFields()
 	}
 
@@ -10662,7 +14236,7 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 	_ = this
 
 	localctx = NewSortFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, SQLParserRULE_sortField)
+	p.EnterRule(localctx, 148, SQLParserRULE_sortField)
 	var _la int
 
 
@@ -10684,17 +14258,17 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(567)
+		p.SetState(750)
 		p.fieldExpr(0)
 	}
-	p.SetState(571)
+	p.SetState(754)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	for _la == SQLParserT_ASC || _la == SQLParserT_DESC {
 		{
-			p.SetState(568)
+			p.SetState(751)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ASC || _la == SQLParserT_DESC) {
@@ -10706,7 +14280,7 @@ func (p *SQLParser) SortField() (localctx ISortFieldContext) {
 		}
 
 
-		p.SetState(573)
+		p.SetState(756)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -10843,7 +14417,7 @@ func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 	_ = this
 
 	localctx = NewSortFieldsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, SQLParserRULE_sortFields)
+	p.EnterRule(localctx, 150, SQLParserRULE_sortFields)
 	var _la int
 
 
@@ -10865,26 +14439,26 @@ func (p *SQLParser) SortFields() (localctx ISortFieldsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(574)
+		p.SetState(757)
 		p.SortField()
 	}
-	p.SetState(579)
+	p.SetState(762)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(575)
+			p.SetState(758)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(576)
+			p.SetState(759)
 			p.SortField()
 		}
 
 
-		p.SetState(581)
+		p.SetState(764)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -10992,7 +14566,7 @@ func (p *SQLParser) HavingClause() (localctx IHavingClauseContext) {
 	_ = this
 
 	localctx = NewHavingClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, SQLParserRULE_havingClause)
+	p.EnterRule(localctx, 152, SQLParserRULE_havingClause)
 
 	defer func() {
 		p.ExitRule()
@@ -11012,11 +14586,11 @@ func (p *SQLParser) HavingClause() (localctx IHavingClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(582)
+		p.SetState(765)
 		p.Match(SQLParserT_HAVING)
 	}
 	{
-		p.SetState(583)
+		p.SetState(766)
 		p.boolExpr(0)
 	}
 
@@ -11193,8 +14767,8 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 	localctx = NewBoolExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IBoolExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 118
-	p.EnterRecursionRule(localctx, 118, SQLParserRULE_boolExpr, _p)
+	_startState := 154
+	p.EnterRecursionRule(localctx, 154, SQLParserRULE_boolExpr, _p)
 
 	defer func() {
 		p.UnrollRecursionContexts(_parentctx)
@@ -11215,35 +14789,35 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(591)
+	p.SetState(774)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(586)
+			p.SetState(769)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(587)
+			p.SetState(770)
 			p.boolExpr(0)
 		}
 		{
-			p.SetState(588)
+			p.SetState(771)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 
 	case 2:
 		{
-			p.SetState(590)
+			p.SetState(773)
 			p.BoolExprAtom()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(599)
+	p.SetState(782)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 44, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -11253,25 +14827,25 @@ func (p *SQLParser) boolExpr(_p int) (localctx IBoolExprContext) {
 			_prevctx = localctx
 			localctx = NewBoolExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_boolExpr)
-			p.SetState(593)
+			p.SetState(776)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 			}
 			{
-				p.SetState(594)
+				p.SetState(777)
 				p.BoolExprLogicalOp()
 			}
 			{
-				p.SetState(595)
+				p.SetState(778)
 				p.boolExpr(3)
 			}
 
 
 		}
-		p.SetState(601)
+		p.SetState(784)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 44, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
 	}
 
 
@@ -11365,7 +14939,7 @@ func (p *SQLParser) BoolExprLogicalOp() (localctx IBoolExprLogicalOpContext) {
 	_ = this
 
 	localctx = NewBoolExprLogicalOpContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, SQLParserRULE_boolExprLogicalOp)
+	p.EnterRule(localctx, 156, SQLParserRULE_boolExprLogicalOp)
 	var _la int
 
 
@@ -11387,7 +14961,7 @@ func (p *SQLParser) BoolExprLogicalOp() (localctx IBoolExprLogicalOpContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(602)
+		p.SetState(785)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == SQLParserT_AND || _la == SQLParserT_OR) {
@@ -11497,7 +15071,7 @@ func (p *SQLParser) BoolExprAtom() (localctx IBoolExprAtomContext) {
 	_ = this
 
 	localctx = NewBoolExprAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, SQLParserRULE_boolExprAtom)
+	p.EnterRule(localctx, 158, SQLParserRULE_boolExprAtom)
 
 	defer func() {
 		p.ExitRule()
@@ -11517,7 +15091,7 @@ func (p *SQLParser) BoolExprAtom() (localctx IBoolExprAtomContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(604)
+		p.SetState(787)
 		p.BinaryExpr()
 	}
 
@@ -11661,7 +15235,7 @@ func (p *SQLParser) BinaryExpr() (localctx IBinaryExprContext) {
 	_ = this
 
 	localctx = NewBinaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, SQLParserRULE_binaryExpr)
+	p.EnterRule(localctx, 160, SQLParserRULE_binaryExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -11681,15 +15255,15 @@ func (p *SQLParser) BinaryExpr() (localctx IBinaryExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(606)
+		p.SetState(789)
 		p.fieldExpr(0)
 	}
 	{
-		p.SetState(607)
+		p.SetState(790)
 		p.BinaryOperator()
 	}
 	{
-		p.SetState(608)
+		p.SetState(791)
 		p.fieldExpr(0)
 	}
 
@@ -11812,7 +15386,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	_ = this
 
 	localctx = NewBinaryOperatorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, SQLParserRULE_binaryOperator)
+	p.EnterRule(localctx, 162, SQLParserRULE_binaryOperator)
 	var _la int
 
 
@@ -11832,14 +15406,14 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 		}
 	}()
 
-	p.SetState(618)
+	p.SetState(801)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserT_EQUAL:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(610)
+			p.SetState(793)
 			p.Match(SQLParserT_EQUAL)
 		}
 
@@ -11847,7 +15421,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_NOTEQUAL:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(611)
+			p.SetState(794)
 			p.Match(SQLParserT_NOTEQUAL)
 		}
 
@@ -11855,7 +15429,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_NOTEQUAL2:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(612)
+			p.SetState(795)
 			p.Match(SQLParserT_NOTEQUAL2)
 		}
 
@@ -11863,7 +15437,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_LESS:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(613)
+			p.SetState(796)
 			p.Match(SQLParserT_LESS)
 		}
 
@@ -11871,7 +15445,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_LESSEQUAL:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(614)
+			p.SetState(797)
 			p.Match(SQLParserT_LESSEQUAL)
 		}
 
@@ -11879,7 +15453,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_GREATER:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(615)
+			p.SetState(798)
 			p.Match(SQLParserT_GREATER)
 		}
 
@@ -11887,7 +15461,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_GREATEREQUAL:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(616)
+			p.SetState(799)
 			p.Match(SQLParserT_GREATEREQUAL)
 		}
 
@@ -11895,7 +15469,7 @@ func (p *SQLParser) BinaryOperator() (localctx IBinaryOperatorContext) {
 	case SQLParserT_LIKE, SQLParserT_REGEXP:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(617)
+			p.SetState(800)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_LIKE || _la == SQLParserT_REGEXP) {
@@ -12116,8 +15690,8 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 	localctx = NewFieldExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IFieldExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 128
-	p.EnterRecursionRule(localctx, 128, SQLParserRULE_fieldExpr, _p)
+	_startState := 164
+	p.EnterRecursionRule(localctx, 164, SQLParserRULE_fieldExpr, _p)
 
 	defer func() {
 		p.UnrollRecursionContexts(_parentctx)
@@ -12138,49 +15712,49 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(628)
+	p.SetState(811)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(621)
+			p.SetState(804)
 			p.Match(SQLParserT_OPEN_P)
 		}
 		{
-			p.SetState(622)
+			p.SetState(805)
 			p.fieldExpr(0)
 		}
 		{
-			p.SetState(623)
+			p.SetState(806)
 			p.Match(SQLParserT_CLOSE_P)
 		}
 
 
 	case 2:
 		{
-			p.SetState(625)
+			p.SetState(808)
 			p.ExprFunc()
 		}
 
 
 	case 3:
 		{
-			p.SetState(626)
+			p.SetState(809)
 			p.ExprAtom()
 		}
 
 
 	case 4:
 		{
-			p.SetState(627)
+			p.SetState(810)
 			p.DurationLit()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(644)
+	p.SetState(827)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -12188,23 +15762,23 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(642)
+			p.SetState(825)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(630)
+				p.SetState(813)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
 				}
 				{
-					p.SetState(631)
+					p.SetState(814)
 					p.Match(SQLParserT_MUL)
 				}
 				{
-					p.SetState(632)
+					p.SetState(815)
 					p.fieldExpr(9)
 				}
 
@@ -12212,17 +15786,17 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 			case 2:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(633)
+				p.SetState(816)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 7)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 7)", ""))
 				}
 				{
-					p.SetState(634)
+					p.SetState(817)
 					p.Match(SQLParserT_DIV)
 				}
 				{
-					p.SetState(635)
+					p.SetState(818)
 					p.fieldExpr(8)
 				}
 
@@ -12230,17 +15804,17 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 			case 3:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(636)
+				p.SetState(819)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
 				}
 				{
-					p.SetState(637)
+					p.SetState(820)
 					p.Match(SQLParserT_ADD)
 				}
 				{
-					p.SetState(638)
+					p.SetState(821)
 					p.fieldExpr(7)
 				}
 
@@ -12248,26 +15822,26 @@ func (p *SQLParser) fieldExpr(_p int) (localctx IFieldExprContext) {
 			case 4:
 				localctx = NewFieldExprContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, SQLParserRULE_fieldExpr)
-				p.SetState(639)
+				p.SetState(822)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(640)
+					p.SetState(823)
 					p.Match(SQLParserT_SUB)
 				}
 				{
-					p.SetState(641)
+					p.SetState(824)
 					p.fieldExpr(6)
 				}
 
 			}
 
 		}
-		p.SetState(646)
+		p.SetState(829)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext())
 	}
 
 
@@ -12385,7 +15959,7 @@ func (p *SQLParser) DurationLit() (localctx IDurationLitContext) {
 	_ = this
 
 	localctx = NewDurationLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, SQLParserRULE_durationLit)
+	p.EnterRule(localctx, 166, SQLParserRULE_durationLit)
 
 	defer func() {
 		p.ExitRule()
@@ -12405,11 +15979,11 @@ func (p *SQLParser) DurationLit() (localctx IDurationLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(647)
+		p.SetState(830)
 		p.IntNumber()
 	}
 	{
-		p.SetState(648)
+		p.SetState(831)
 		p.IntervalItem()
 	}
 
@@ -12524,7 +16098,7 @@ func (p *SQLParser) IntervalItem() (localctx IIntervalItemContext) {
 	_ = this
 
 	localctx = NewIntervalItemContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, SQLParserRULE_intervalItem)
+	p.EnterRule(localctx, 168, SQLParserRULE_intervalItem)
 	var _la int
 
 
@@ -12546,10 +16120,10 @@ func (p *SQLParser) IntervalItem() (localctx IIntervalItemContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(650)
+		p.SetState(833)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((((_la - 93)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 93))) & ((1 << (SQLParserT_SECOND - 93)) | (1 << (SQLParserT_MINUTE - 93)) | (1 << (SQLParserT_HOUR - 93)) | (1 << (SQLParserT_DAY - 93)) | (1 << (SQLParserT_WEEK - 93)) | (1 << (SQLParserT_MONTH - 93)) | (1 << (SQLParserT_YEAR - 93)))) != 0)) {
+		if !(((((_la - 117)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 117))) & ((1 << (SQLParserT_SECOND - 117)) | (1 << (SQLParserT_MINUTE - 117)) | (1 << (SQLParserT_HOUR - 117)) | (1 << (SQLParserT_DAY - 117)) | (1 << (SQLParserT_WEEK - 117)) | (1 << (SQLParserT_MONTH - 117)) | (1 << (SQLParserT_YEAR - 117)))) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -12680,7 +16254,7 @@ func (p *SQLParser) ExprFunc() (localctx IExprFuncContext) {
 	_ = this
 
 	localctx = NewExprFuncContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, SQLParserRULE_exprFunc)
+	p.EnterRule(localctx, 170, SQLParserRULE_exprFunc)
 	var _la int
 
 
@@ -12702,27 +16276,27 @@ func (p *SQLParser) ExprFunc() (localctx IExprFuncContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(652)
+		p.SetState(835)
 		p.FuncName()
 	}
 	{
-		p.SetState(653)
+		p.SetState(836)
 		p.Match(SQLParserT_OPEN_P)
 	}
-	p.SetState(655)
+	p.SetState(838)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
-	if (((_la) & -(0x1f+1)) == 0 && ((1 << uint(_la)) & ((1 << SQLParserT_CREATE) | (1 << SQLParserT_UPDATE) | (1 << SQLParserT_SET) | (1 << SQLParserT_DROP) | (1 << SQLParserT_INTERVAL) | (1 << SQLParserT_INTERVAL_NAME) | (1 << SQLParserT_SHARD) | (1 << SQLParserT_REPLICATION) | (1 << SQLParserT_TTL) | (1 << SQLParserT_META_TTL) | (1 << SQLParserT_PAST_TTL) | (1 << SQLParserT_FUTURE_TTL) | (1 << SQLParserT_KILL) | (1 << SQLParserT_ON) | (1 << SQLParserT_SHOW) | (1 << SQLParserT_USE) | (1 << SQLParserT_STATE_REPO) | (1 << SQLParserT_STATE_MACHINE) | (1 << SQLParserT_MASTER) | (1 << SQLParserT_METADATA) | (1 << SQLParserT_TYPES) | (1 << SQLParserT_TYPE) | (1 << SQLParserT_STORAGES) | (1 << SQLParserT_STORAGE) | (1 << SQLParserT_BROKER) | (1 << SQLParserT_ALIVE))) != 0) || ((((_la - 32)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 32))) & ((1 << (SQLParserT_SCHEMAS - 32)) | (1 << (SQLParserT_DATASBAE - 32)) | (1 << (SQLParserT_DATASBAES - 32)) | (1 << (SQLParserT_NAMESPACE - 32)) | (1 << (SQLParserT_NAMESPACES - 32)) | (1 << (SQLParserT_NODE - 32)) | (1 << (SQLParserT_METRICS - 32)) | (1 << (SQLParserT_METRIC - 32)) | (1 << (SQLParserT_FIELD - 32)) | (1 << (SQLParserT_FIELDS - 32)) | (1 << (SQLParserT_TAG - 32)) | (1 << (SQLParserT_INFO - 32)) | (1 << (SQLParserT_KEYS - 32)) | (1 << (SQLParserT_KEY - 32)) | (1 << (SQLParserT_WITH - 32)) | (1 << (SQLParserT_VALUES - 32)) | (1 << (SQLParserT_VALUE - 32)) | (1 << (SQLParserT_FROM - 32)) | (1 << (SQLParserT_WHERE - 32)) | (1 << (SQLParserT_LIMIT - 32)) | (1 << (SQLParserT_QUERIES - 32)) | (1 << (SQLParserT_QUERY - 32)) | (1 << (SQLParserT_EXPLAIN - 32)) | (1 << (SQLParserT_WITH_VALUE - 32)) | (1 << (SQLParserT_SELECT - 32)) | (1 << (SQLParserT_AS - 32)) | (1 << (SQLParserT_AND - 32)) | (1 << (SQLParserT_OR - 32)) | (1 << (SQLParserT_FILL - 32)) | (1 << (SQLParserT_NULL - 32)) | (1 << (SQLParserT_PREVIOUS - 32)) | (1 << (SQLParserT_ORDER - 32)))) != 0) || ((((_la - 64)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 64))) & ((1 << (SQLParserT_ASC - 64)) | (1 << (SQLParserT_DESC - 64)) | (1 << (SQLParserT_LIKE - 64)) | (1 << (SQLParserT_NOT - 64)) | (1 << (SQLParserT_BETWEEN - 64)) | (1 << (SQLParserT_IS - 64)) | (1 << (SQLParserT_GROUP - 64)) | (1 << (SQLParserT_HAVING - 64)) | (1 << (SQLParserT_BY - 64)) | (1 << (SQLParserT_FOR - 64)) | (1 << (SQLParserT_STATS - 64)) | (1 << (SQLParserT_TIME - 64)) | (1 << (SQLParserT_NOW - 64)) | (1 << (SQLParserT_IN - 64)) | (1 << (SQLParserT_LOG - 64)) | (1 << (SQLParserT_PROFILE - 64)) | (1 << (SQLParserT_REQUESTS - 64)) | (1 << (SQLParserT_REQUEST - 64)) | (1 << (SQLParserT_ID - 64)) | (1 << (SQLParserT_SUM - 64)) | (1 << (SQLParserT_MIN - 64)) | (1 << (SQLParserT_MAX - 64)) | (1 << (SQLParserT_COUNT - 64)) | (1 << (SQLParserT_LAST - 64)) | (1 << (SQLParserT_FIRST - 64)) | (1 << (SQLParserT_AVG - 64)) | (1 << (SQLParserT_STDDEV - 64)) | (1 << (SQLParserT_QUANTILE - 64)) | (1 << (SQLParserT_RATE - 64)) | (1 << (SQLParserT_SECOND - 64)) | (1 << (SQLParserT_MINUTE - 64)) | (1 << (SQLParserT_HOUR - 64)))) != 0) || ((((_la - 96)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 96))) & ((1 << (SQLParserT_DAY - 96)) | (1 << (SQLParserT_WEEK - 96)) | (1 << (SQLParserT_MONTH - 96)) | (1 << (SQLParserT_YEAR - 96)) | (1 << (SQLParserT_OPEN_P - 96)) | (1 << (SQLParserT_ADD - 96)) | (1 << (SQLParserT_SUB - 96)) | (1 << (SQLParserL_ID - 96)) | (1 << (SQLParserL_INT - 96)) | (1 << (SQLParserL_DEC - 96)))) != 0) {
+	if (((_la) & -(0x1f+1)) == 0 && ((1 << uint(_la)) & ((1 << SQLParserT_CREATE) | (1 << SQLParserT_UPDATE) | (1 << SQLParserT_SET) | (1 << SQLParserT_DROP) | (1 << SQLParserT_ALTER) | (1 << SQLParserT_DELETE) | (1 << SQLParserT_INTERVAL) | (1 << SQLParserT_INTERVAL_NAME) | (1 << SQLParserT_SHARD) | (1 << SQLParserT_REPLICATION) | (1 << SQLParserT_TTL) | (1 << SQLParserT_META_TTL) | (1 << SQLParserT_PAST_TTL) | (1 << SQLParserT_FUTURE_TTL) | (1 << SQLParserT_KILL) | (1 << SQLParserT_ON) | (1 << SQLParserT_SHOW) | (1 << SQLParserT_USE) | (1 << SQLParserT_STATE_REPO) | (1 << SQLParserT_STATE_MACHINE) | (1 << SQLParserT_MASTER) | (1 << SQLParserT_METADATA) | (1 << SQLParserT_TYPES) | (1 << SQLParserT_TYPE) | (1 << SQLParserT_STORAGES) | (1 << SQLParserT_STORAGE) | (1 << SQLParserT_BROKER))) != 0) || ((((_la - 32)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 32))) & ((1 << (SQLParserT_ALIVE - 32)) | (1 << (SQLParserT_SCHEMAS - 32)) | (1 << (SQLParserT_DATASBAE - 32)) | (1 << (SQLParserT_DATASBAES - 32)) | (1 << (SQLParserT_NAMESPACE - 32)) | (1 << (SQLParserT_NAMESPACES - 32)) | (1 << (SQLParserT_NODE - 32)) | (1 << (SQLParserT_METRICS - 32)) | (1 << (SQLParserT_METRIC - 32)) | (1 << (SQLParserT_FIELD - 32)) | (1 << (SQLParserT_FIELDS - 32)) | (1 << (SQLParserT_TAG - 32)) | (1 << (SQLParserT_INFO - 32)) | (1 << (SQLParserT_KEYS - 32)) | (1 << (SQLParserT_KEY - 32)) | (1 << (SQLParserT_WITH - 32)) | (1 << (SQLParserT_VALUES - 32)) | (1 << (SQLParserT_VALUE - 32)) | (1 << (SQLParserT_FROM - 32)) | (1 << (SQLParserT_WHERE - 32)) | (1 << (SQLParserT_LIMIT - 32)) | (1 << (SQLParserT_OFFSET - 32)) | (1 << (SQLParserT_QUERIES - 32)) | (1 << (SQLParserT_QUERY - 32)) | (1 << (SQLParserT_EXPLAIN - 32)) | (1 << (SQLParserT_WITH_VALUE - 32)) | (1 << (SQLParserT_SELECT - 32)) | (1 << (SQLParserT_AS - 32)) | (1 << (SQLParserT_AND - 32)) | (1 << (SQLParserT_OR - 32)) | (1 << (SQLParserT_FILL - 32)) | (1 << (SQLParserT_NULL - 32)))) != 0) || ((((_la - 64)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 64))) & ((1 << (SQLParserT_PREVIOUS - 64)) | (1 << (SQLParserT_ORDER - 64)) | (1 << (SQLParserT_ASC - 64)) | (1 << (SQLParserT_DESC - 64)) | (1 << (SQLParserT_LIKE - 64)) | (1 << (SQLParserT_NOT - 64)) | (1 << (SQLParserT_BETWEEN - 64)) | (1 << (SQLParserT_IS - 64)) | (1 << (SQLParserT_GROUP - 64)) | (1 << (SQLParserT_HAVING - 64)) | (1 << (SQLParserT_BY - 64)) | (1 << (SQLParserT_FOR - 64)) | (1 << (SQLParserT_STATS - 64)) | (1 << (SQLParserT_TIME - 64)) | (1 << (SQLParserT_NOW - 64)) | (1 << (SQLParserT_IN - 64)) | (1 << (SQLParserT_LOG - 64)) | (1 << (SQLParserT_PROFILE - 64)) | (1 << (SQLParserT_REQUESTS - 64)) | (1 << (SQLParserT_REQUEST - 64)) | (1 << (SQLParserT_SERIES - 64)) | (1 << (SQLParserT_QUOTA - 64)) | (1 << (SQLParserT_CARDINALITY - 64)) | (1 << (SQLParserT_ID - 64)) | (1 << (SQLParserT_USER - 64)) | (1 << (SQLParserT_USERS - 64)) | (1 << (SQLParserT_PASSWORD - 64)) | (1 << (SQLParserT_TOKEN - 64)) | (1 << (SQLParserT_GRANT - 64)) | (1 << (SQLParserT_REVOKE - 64)) | (1 << (SQLParserT_TO - 64)) | (1 << (SQLParserT_READ - 64)))) != 0) || ((((_la - 96)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 96))) & ((1 << (SQLParserT_WRITE - 96)) | (1 << (SQLParserT_ADMIN - 96)) | (1 << (SQLParserT_SUM - 96)) | (1 << (SQLParserT_MIN - 96)) | (1 << (SQLParserT_MAX - 96)) | (1 << (SQLParserT_COUNT - 96)) | (1 << (SQLParserT_LAST - 96)) | (1 << (SQLParserT_FIRST - 96)) | (1 << (SQLParserT_AVG - 96)) | (1 << (SQLParserT_STDDEV - 96)) | (1 << (SQLParserT_QUANTILE - 96)) | (1 << (SQLParserT_RATE - 96)) | (1 << (SQLParserT_PERCENTILE - 96)) | (1 << (SQLParserT_INCREASE - 96)) | (1 << (SQLParserT_DERIVATIVE - 96)) | (1 << (SQLParserT_DELTA - 96)) | (1 << (SQLParserT_MOVING_AVERAGE - 96)) | (1 << (SQLParserT_ABS - 96)) | (1 << (SQLParserT_CLAMP_MIN - 96)) | (1 << (SQLParserT_CLAMP_MAX - 96)) | (1 << (SQLParserT_TIMESHIFT - 96)) | (1 << (SQLParserT_SECOND - 96)) | (1 << (SQLParserT_MINUTE - 96)) | (1 << (SQLParserT_HOUR - 96)) | (1 << (SQLParserT_DAY - 96)) | (1 << (SQLParserT_WEEK - 96)) | (1 << (SQLParserT_MONTH - 96)) | (1 << (SQLParserT_YEAR - 96)))) != 0) || ((((_la - 140)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 140))) & ((1 << (SQLParserT_OPEN_P - 140)) | (1 << (SQLParserT_ADD - 140)) | (1 << (SQLParserT_SUB - 140)) | (1 << (SQLParserL_ID - 140)) | (1 << (SQLParserL_INT - 140)) | (1 << (SQLParserL_DEC - 140)))) != 0) {
 		{
-			p.SetState(654)
+			p.SetState(837)
 			p.ExprFuncParams()
 		}
 
 	}
 	{
-		p.SetState(657)
+		p.SetState(840)
 		p.Match(SQLParserT_CLOSE_P)
 	}
 
@@ -12810,6 +16384,42 @@ func (s *FuncNameContext) T_RATE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_RATE, 0)
 }
 
+func (s *FuncNameContext) T_PERCENTILE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PERCENTILE, 0)
+}
+
+func (s *FuncNameContext) T_INCREASE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INCREASE, 0)
+}
+
+func (s *FuncNameContext) T_DERIVATIVE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DERIVATIVE, 0)
+}
+
+func (s *FuncNameContext) T_DELTA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELTA, 0)
+}
+
+func (s *FuncNameContext) T_MOVING_AVERAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MOVING_AVERAGE, 0)
+}
+
+func (s *FuncNameContext) T_ABS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ABS, 0)
+}
+
+func (s *FuncNameContext) T_CLAMP_MIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP_MIN, 0)
+}
+
+func (s *FuncNameContext) T_CLAMP_MAX() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP_MAX, 0)
+}
+
+func (s *FuncNameContext) T_TIMESHIFT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TIMESHIFT, 0)
+}
+
 func (s *FuncNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -12849,7 +16459,7 @@ func (p *SQLParser) FuncName() (localctx IFuncNameContext) {
 	_ = this
 
 	localctx = NewFuncNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, SQLParserRULE_funcName)
+	p.EnterRule(localctx, 172, SQLParserRULE_funcName)
 	var _la int
 
 
@@ -12871,10 +16481,10 @@ func (p *SQLParser) FuncName() (localctx IFuncNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(659)
+		p.SetState(842)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((((_la - 83)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 83))) & ((1 << (SQLParserT_SUM - 83)) | (1 << (SQLParserT_MIN - 83)) | (1 << (SQLParserT_MAX - 83)) | (1 << (SQLParserT_COUNT - 83)) | (1 << (SQLParserT_LAST - 83)) | (1 << (SQLParserT_FIRST - 83)) | (1 << (SQLParserT_AVG - 83)) | (1 << (SQLParserT_STDDEV - 83)) | (1 << (SQLParserT_QUANTILE - 83)) | (1 << (SQLParserT_RATE - 83)))) != 0)) {
+		if !(((((_la - 98)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 98))) & ((1 << (SQLParserT_SUM - 98)) | (1 << (SQLParserT_MIN - 98)) | (1 << (SQLParserT_MAX - 98)) | (1 << (SQLParserT_COUNT - 98)) | (1 << (SQLParserT_LAST - 98)) | (1 << (SQLParserT_FIRST - 98)) | (1 << (SQLParserT_AVG - 98)) | (1 << (SQLParserT_STDDEV - 98)) | (1 << (SQLParserT_QUANTILE - 98)) | (1 << (SQLParserT_RATE - 98)) | (1 << (SQLParserT_PERCENTILE - 98)) | (1 << (SQLParserT_INCREASE - 98)) | (1 << (SQLParserT_DERIVATIVE - 98)) | (1 << (SQLParserT_DELTA - 98)) | (1 << (SQLParserT_MOVING_AVERAGE - 98)) | (1 << (SQLParserT_ABS - 98)) | (1 << (SQLParserT_CLAMP_MIN - 98)) | (1 << (SQLParserT_CLAMP_MAX - 98)) | (1 << (SQLParserT_TIMESHIFT - 98)))) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -13014,7 +16624,7 @@ func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 	_ = this
 
 	localctx = NewExprFuncParamsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 138, SQLParserRULE_exprFuncParams)
+	p.EnterRule(localctx, 174, SQLParserRULE_exprFuncParams)
 	var _la int
 
 
@@ -13036,26 +16646,26 @@ func (p *SQLParser) ExprFuncParams() (localctx IExprFuncParamsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(661)
+		p.SetState(844)
 		p.FuncParam()
 	}
-	p.SetState(666)
+	p.SetState(849)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	for _la == SQLParserT_COMMA {
 		{
-			p.SetState(662)
+			p.SetState(845)
 			p.Match(SQLParserT_COMMA)
 		}
 		{
-			p.SetState(663)
+			p.SetState(846)
 			p.FuncParam()
 		}
 
 
-		p.SetState(668)
+		p.SetState(851)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -13175,7 +16785,7 @@ func (p *SQLParser) FuncParam() (localctx IFuncParamContext) {
 	_ = this
 
 	localctx = NewFuncParamContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, SQLParserRULE_funcParam)
+	p.EnterRule(localctx, 176, SQLParserRULE_funcParam)
 
 	defer func() {
 		p.ExitRule()
@@ -13193,13 +16803,13 @@ func (p *SQLParser) FuncParam() (localctx IFuncParamContext) {
 		}
 	}()
 
-	p.SetState(671)
+	p.SetState(854)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(669)
+			p.SetState(852)
 			p.fieldExpr(0)
 		}
 
@@ -13207,7 +16817,7 @@ func (p *SQLParser) FuncParam() (localctx IFuncParamContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(670)
+			p.SetState(853)
 			p.tagFilterExpr(0)
 		}
 
@@ -13359,7 +16969,7 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	_ = this
 
 	localctx = NewExprAtomContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, SQLParserRULE_exprAtom)
+	p.EnterRule(localctx, 178, SQLParserRULE_exprAtom)
 
 	defer func() {
 		p.ExitRule()
@@ -13377,22 +16987,22 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 		}
 	}()
 
-	p.SetState(679)
+	p.SetState(862)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(673)
+			p.SetState(856)
 			p.Ident()
 		}
-		p.SetState(675)
+		p.SetState(858)
 		p.GetErrorHandler().Sync(p)
 
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 52, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(674)
+				p.SetState(857)
 				p.IdentFilter()
 			}
 
@@ -13403,7 +17013,7 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(677)
+			p.SetState(860)
 			p.DecNumber()
 		}
 
@@ -13411,7 +17021,7 @@ func (p *SQLParser) ExprAtom() (localctx IExprAtomContext) {
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(678)
+			p.SetState(861)
 			p.IntNumber()
 		}
 
@@ -13523,7 +17133,7 @@ func (p *SQLParser) IdentFilter() (localctx IIdentFilterContext) {
 	_ = this
 
 	localctx = NewIdentFilterContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, SQLParserRULE_identFilter)
+	p.EnterRule(localctx, 180, SQLParserRULE_identFilter)
 
 	defer func() {
 		p.ExitRule()
@@ -13543,15 +17153,15 @@ func (p *SQLParser) IdentFilter() (localctx IIdentFilterContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(681)
+		p.SetState(864)
 		p.Match(SQLParserT_OPEN_SB)
 	}
 	{
-		p.SetState(682)
+		p.SetState(865)
 		p.tagFilterExpr(0)
 	}
 	{
-		p.SetState(683)
+		p.SetState(866)
 		p.Match(SQLParserT_CLOSE_SB)
 	}
 
@@ -13654,7 +17264,7 @@ func (p *SQLParser) Json() (localctx IJsonContext) {
 	_ = this
 
 	localctx = NewJsonContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, SQLParserRULE_json)
+	p.EnterRule(localctx, 182, SQLParserRULE_json)
 
 	defer func() {
 		p.ExitRule()
@@ -13674,7 +17284,7 @@ func (p *SQLParser) Json() (localctx IJsonContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(685)
+		p.SetState(868)
 		p.Value()
 	}
 
@@ -13818,7 +17428,7 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 	_ = this
 
 	localctx = NewObjContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, SQLParserRULE_obj)
+	p.EnterRule(localctx, 184, SQLParserRULE_obj)
 	var _la int
 
 
@@ -13838,41 +17448,41 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 		}
 	}()
 
-	p.SetState(700)
+	p.SetState(883)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(687)
+			p.SetState(870)
 			p.Match(SQLParserT_OPEN_B)
 		}
 		{
-			p.SetState(688)
+			p.SetState(871)
 			p.Pair()
 		}
-		p.SetState(693)
+		p.SetState(876)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 
 		for _la == SQLParserT_COMMA {
 			{
-				p.SetState(689)
+				p.SetState(872)
 				p.Match(SQLParserT_COMMA)
 			}
 			{
-				p.SetState(690)
+				p.SetState(873)
 				p.Pair()
 			}
 
 
-			p.SetState(695)
+			p.SetState(878)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(696)
+			p.SetState(879)
 			p.Match(SQLParserT_CLOSE_B)
 		}
 
@@ -13880,11 +17490,11 @@ func (p *SQLParser) Obj() (localctx IObjContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(698)
+			p.SetState(881)
 			p.Match(SQLParserT_OPEN_B)
 		}
 		{
-			p.SetState(699)
+			p.SetState(882)
 			p.Match(SQLParserT_CLOSE_B)
 		}
 
@@ -13996,7 +17606,7 @@ func (p *SQLParser) Pair() (localctx IPairContext) {
 	_ = this
 
 	localctx = NewPairContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, SQLParserRULE_pair)
+	p.EnterRule(localctx, 186, SQLParserRULE_pair)
 
 	defer func() {
 		p.ExitRule()
@@ -14016,15 +17626,15 @@ func (p *SQLParser) Pair() (localctx IPairContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(702)
+		p.SetState(885)
 		p.Match(SQLParserSTRING)
 	}
 	{
-		p.SetState(703)
+		p.SetState(886)
 		p.Match(SQLParserT_COLON)
 	}
 	{
-		p.SetState(704)
+		p.SetState(887)
 		p.Value()
 	}
 
@@ -14168,7 +17778,7 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 	_ = this
 
 	localctx = NewArrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, SQLParserRULE_arr)
+	p.EnterRule(localctx, 188, SQLParserRULE_arr)
 	var _la int
 
 
@@ -14188,41 +17798,41 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 		}
 	}()
 
-	p.SetState(719)
+	p.SetState(902)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(706)
+			p.SetState(889)
 			p.Match(SQLParserT_OPEN_SB)
 		}
 		{
-			p.SetState(707)
+			p.SetState(890)
 			p.Value()
 		}
-		p.SetState(712)
+		p.SetState(895)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 
 		for _la == SQLParserT_COMMA {
 			{
-				p.SetState(708)
+				p.SetState(891)
 				p.Match(SQLParserT_COMMA)
 			}
 			{
-				p.SetState(709)
+				p.SetState(892)
 				p.Value()
 			}
 
 
-			p.SetState(714)
+			p.SetState(897)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(715)
+			p.SetState(898)
 			p.Match(SQLParserT_CLOSE_SB)
 		}
 
@@ -14230,11 +17840,11 @@ func (p *SQLParser) Arr() (localctx IArrContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(717)
+			p.SetState(900)
 			p.Match(SQLParserT_OPEN_SB)
 		}
 		{
-			p.SetState(718)
+			p.SetState(901)
 			p.Match(SQLParserT_CLOSE_SB)
 		}
 
@@ -14351,6 +17961,10 @@ func (s *ValueContext) Arr() IArrContext {
 	return t.(IArrContext)
 }
 
+func (s *ValueContext) T_NULL() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_NULL, 0)
+}
+
 func (s *ValueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -14390,7 +18004,7 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	_ = this
 
 	localctx = NewValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, SQLParserRULE_value)
+	p.EnterRule(localctx, 190, SQLParserRULE_value)
 
 	defer func() {
 		p.ExitRule()
@@ -14408,13 +18022,13 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 		}
 	}()
 
-	p.SetState(729)
+	p.SetState(912)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(721)
+			p.SetState(904)
 			p.Match(SQLParserSTRING)
 		}
 
@@ -14422,7 +18036,7 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(722)
+			p.SetState(905)
 			p.IntNumber()
 		}
 
@@ -14430,7 +18044,7 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(723)
+			p.SetState(906)
 			p.DecNumber()
 		}
 
@@ -14438,7 +18052,7 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(724)
+			p.SetState(907)
 			p.Obj()
 		}
 
@@ -14446,7 +18060,7 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(725)
+			p.SetState(908)
 			p.Arr()
 		}
 
@@ -14454,7 +18068,7 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(726)
+			p.SetState(909)
 			p.Match(SQLParserT__0)
 		}
 
@@ -14462,7 +18076,7 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(727)
+			p.SetState(910)
 			p.Match(SQLParserT__1)
 		}
 
@@ -14470,8 +18084,8 @@ func (p *SQLParser) Value() (localctx IValueContext) {
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(728)
-			p.Match(SQLParserT__2)
+			p.SetState(911)
+			p.Match(SQLParserT_NULL)
 		}
 
 	}
@@ -14570,7 +18184,7 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 	_ = this
 
 	localctx = NewIntNumberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, SQLParserRULE_intNumber)
+	p.EnterRule(localctx, 192, SQLParserRULE_intNumber)
 	var _la int
 
 
@@ -14591,14 +18205,14 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(732)
+	p.SetState(915)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 
 	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
 		{
-			p.SetState(731)
+			p.SetState(914)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
@@ -14611,7 +18225,7 @@ func (p *SQLParser) IntNumber() (localctx IIntNumberContext) {
 
 	}
 	{
-		p.SetState(734)
+		p.SetState(917)
 		p.Match(SQLParserL_INT)
 	}
 
@@ -14705,14 +18319,148 @@ func (s *DecNumberContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 
 
-func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
+func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
+	this := p
+	_ = this
+
+	localctx = NewDecNumberContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 194, SQLParserRULE_decNumber)
+	var _la int
+
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(920)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+
+	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
+		{
+			p.SetState(919)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
+	}
+	{
+		p.SetState(922)
+		p.Match(SQLParserL_DEC)
+	}
+
+
+
+	return localctx
+}
+
+
+// ILimitClauseContext is an interface to support dynamic dispatch.
+type ILimitClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsLimitClauseContext differentiates from other interfaces.
+	IsLimitClauseContext()
+}
+
+type LimitClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLimitClauseContext() *LimitClauseContext {
+	var p = new(LimitClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_limitClause
+	return p
+}
+
+func (*LimitClauseContext) IsLimitClauseContext() {}
+
+func NewLimitClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LimitClauseContext {
+	var p = new(LimitClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_limitClause
+
+	return p
+}
+
+func (s *LimitClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LimitClauseContext) T_LIMIT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_LIMIT, 0)
+}
+
+func (s *LimitClauseContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
+}
+
+func (s *LimitClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LimitClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *LimitClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterLimitClause(s)
+	}
+}
+
+func (s *LimitClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitLimitClause(s)
+	}
+}
+
+func (s *LimitClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitLimitClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
 	this := p
 	_ = this
 
-	localctx = NewDecNumberContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, SQLParserRULE_decNumber)
-	var _la int
-
+	localctx = NewLimitClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 196, SQLParserRULE_limitClause)
 
 	defer func() {
 		p.ExitRule()
@@ -14731,28 +18479,13 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(737)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-
-	if _la == SQLParserT_ADD || _la == SQLParserT_SUB {
-		{
-			p.SetState(736)
-			_la = p.GetTokenStream().LA(1)
-
-			if !(_la == SQLParserT_ADD || _la == SQLParserT_SUB) {
-				p.GetErrorHandler().RecoverInline(p)
-			} else {
-				p.GetErrorHandler().ReportMatch(p)
-				p.Consume()
-			}
-		}
-
+	{
+		p.SetState(924)
+		p.Match(SQLParserT_LIMIT)
 	}
 	{
-		p.SetState(739)
-		p.Match(SQLParserL_DEC)
+		p.SetState(925)
+		p.Match(SQLParserL_INT)
 	}
 
 
@@ -14761,77 +18494,89 @@ func (p *SQLParser) DecNumber() (localctx IDecNumberContext) {
 }
 
 
-// ILimitClauseContext is an interface to support dynamic dispatch.
-type ILimitClauseContext interface {
+// IOffsetClauseContext is an interface to support dynamic dispatch.
+type IOffsetClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsLimitClauseContext differentiates from other interfaces.
-	IsLimitClauseContext()
+	// IsOffsetClauseContext differentiates from other interfaces.
+	IsOffsetClauseContext()
 }
 
-type LimitClauseContext struct {
+type OffsetClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyLimitClauseContext() *LimitClauseContext {
-	var p = new(LimitClauseContext)
+func NewEmptyOffsetClauseContext() *OffsetClauseContext {
+	var p = new(OffsetClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SQLParserRULE_limitClause
+	p.RuleIndex = SQLParserRULE_offsetClause
 	return p
 }
 
-func (*LimitClauseContext) IsLimitClauseContext() {}
+func (*OffsetClauseContext) IsOffsetClauseContext() {}
 
-func NewLimitClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LimitClauseContext {
-	var p = new(LimitClauseContext)
+func NewOffsetClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OffsetClauseContext {
+	var p = new(OffsetClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SQLParserRULE_limitClause
+	p.RuleIndex = SQLParserRULE_offsetClause
 
 	return p
 }
 
-func (s *LimitClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *OffsetClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LimitClauseContext) T_LIMIT() antlr.TerminalNode {
-	return s.GetToken(SQLParserT_LIMIT, 0)
+func (s *OffsetClauseContext) T_OFFSET() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OFFSET, 0)
 }
 
-func (s *LimitClauseContext) L_INT() antlr.TerminalNode {
-	return s.GetToken(SQLParserL_INT, 0)
+func (s *OffsetClauseContext) DurationLit() IDurationLitContext {
+	var t antlr.RuleContext;
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDurationLitContext); ok {
+			t = ctx.(antlr.RuleContext);
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDurationLitContext)
 }
 
-func (s *LimitClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *OffsetClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LimitClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *OffsetClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
 
-func (s *LimitClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *OffsetClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.EnterLimitClause(s)
+		listenerT.EnterOffsetClause(s)
 	}
 }
 
-func (s *LimitClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *OffsetClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SQLListener); ok {
-		listenerT.ExitLimitClause(s)
+		listenerT.ExitOffsetClause(s)
 	}
 }
 
-func (s *LimitClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *OffsetClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SQLVisitor:
-		return t.VisitLimitClause(s)
+		return t.VisitOffsetClause(s)
 
 	default:
 		return t.VisitChildren(s)
@@ -14841,12 +18586,12 @@ func (s *LimitClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{}
 
 
 
-func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
+func (p *SQLParser) OffsetClause() (localctx IOffsetClauseContext) {
 	this := p
 	_ = this
 
-	localctx = NewLimitClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, SQLParserRULE_limitClause)
+	localctx = NewOffsetClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 198, SQLParserRULE_offsetClause)
 
 	defer func() {
 		p.ExitRule()
@@ -14866,12 +18611,12 @@ func (p *SQLParser) LimitClause() (localctx ILimitClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(741)
-		p.Match(SQLParserT_LIMIT)
+		p.SetState(927)
+		p.Match(SQLParserT_OFFSET)
 	}
 	{
-		p.SetState(742)
-		p.Match(SQLParserL_INT)
+		p.SetState(928)
+		p.DurationLit()
 	}
 
 
@@ -14973,7 +18718,7 @@ func (p *SQLParser) MetricName() (localctx IMetricNameContext) {
 	_ = this
 
 	localctx = NewMetricNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, SQLParserRULE_metricName)
+	p.EnterRule(localctx, 200, SQLParserRULE_metricName)
 
 	defer func() {
 		p.ExitRule()
@@ -14993,7 +18738,7 @@ func (p *SQLParser) MetricName() (localctx IMetricNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(744)
+		p.SetState(930)
 		p.Ident()
 	}
 
@@ -15096,7 +18841,7 @@ func (p *SQLParser) TagKey() (localctx ITagKeyContext) {
 	_ = this
 
 	localctx = NewTagKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, SQLParserRULE_tagKey)
+	p.EnterRule(localctx, 202, SQLParserRULE_tagKey)
 
 	defer func() {
 		p.ExitRule()
@@ -15116,7 +18861,7 @@ func (p *SQLParser) TagKey() (localctx ITagKeyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(746)
+		p.SetState(932)
 		p.Ident()
 	}
 
@@ -15126,6 +18871,117 @@ func (p *SQLParser) TagKey() (localctx ITagKeyContext) {
 }
 
 
+// ITagRangeKeyContext is an interface to support dynamic dispatch.
+type ITagRangeKeyContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTagRangeKeyContext differentiates from other interfaces.
+	IsTagRangeKeyContext()
+}
+
+type TagRangeKeyContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTagRangeKeyContext() *TagRangeKeyContext {
+	var p = new(TagRangeKeyContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SQLParserRULE_tagRangeKey
+	return p
+}
+
+func (*TagRangeKeyContext) IsTagRangeKeyContext() {}
+
+func NewTagRangeKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TagRangeKeyContext {
+	var p = new(TagRangeKeyContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SQLParserRULE_tagRangeKey
+
+	return p
+}
+
+func (s *TagRangeKeyContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TagRangeKeyContext) L_ID() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_ID, 0)
+}
+
+func (s *TagRangeKeyContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TagRangeKeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+
+func (s *TagRangeKeyContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.EnterTagRangeKey(s)
+	}
+}
+
+func (s *TagRangeKeyContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SQLListener); ok {
+		listenerT.ExitTagRangeKey(s)
+	}
+}
+
+func (s *TagRangeKeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SQLVisitor:
+		return t.VisitTagRangeKey(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+
+
+
+func (p *SQLParser) TagRangeKey() (localctx ITagRangeKeyContext) {
+	this := p
+	_ = this
+
+	localctx = NewTagRangeKeyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 204, SQLParserRULE_tagRangeKey)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(934)
+		p.Match(SQLParserL_ID)
+	}
+
+
+
+	return localctx
+}
+
+
 // ITagValueContext is an interface to support dynamic dispatch.
 type ITagValueContext interface {
 	antlr.ParserRuleContext
@@ -15180,6 +19036,18 @@ func (s *TagValueContext) Ident() IIdentContext {
 	return t.(IIdentContext)
 }
 
+func (s *TagValueContext) L_INT() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_INT, 0)
+}
+
+func (s *TagValueContext) L_DEC() antlr.TerminalNode {
+	return s.GetToken(SQLParserL_DEC, 0)
+}
+
+func (s *TagValueContext) T_SUB() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SUB, 0)
+}
+
 func (s *TagValueContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -15219,7 +19087,9 @@ func (p *SQLParser) TagValue() (localctx ITagValueContext) {
 	_ = this
 
 	localctx = NewTagValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, SQLParserRULE_tagValue)
+	p.EnterRule(localctx, 206, SQLParserRULE_tagValue)
+	var _la int
+
 
 	defer func() {
 		p.ExitRule()
@@ -15237,13 +19107,50 @@ func (p *SQLParser) TagValue() (localctx ITagValueContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(748)
-		p.Ident()
-	}
+	p.SetState(941)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_ALTER, SQLParserT_DELETE, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_OFFSET, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_SERIES, SQLParserT_QUOTA, SQLParserT_CARDINALITY, SQLParserT_ID, SQLParserT_USER, SQLParserT_USERS, SQLParserT_PASSWORD, SQLParserT_TOKEN, SQLParserT_GRANT, SQLParserT_REVOKE, SQLParserT_TO, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ADMIN, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_PERCENTILE, SQLParserT_INCREASE, SQLParserT_DERIVATIVE, SQLParserT_DELTA, SQLParserT_MOVING_AVERAGE, SQLParserT_ABS, SQLParserT_CLAMP_MIN, SQLParserT_CLAMP_MAX, SQLParserT_TIMESHIFT, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR, SQLParserL_ID:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(936)
+			p.Ident()
+		}
+
+
+	case SQLParserT_SUB, SQLParserL_INT, SQLParserL_DEC:
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(938)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+
+		if _la == SQLParserT_SUB {
+			{
+				p.SetState(937)
+				p.Match(SQLParserT_SUB)
+			}
+
+		}
+		{
+			p.SetState(940)
+			_la = p.GetTokenStream().LA(1)
+
+			if !(_la == SQLParserL_INT || _la == SQLParserL_DEC) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+
 
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
 
 	return localctx
 }
@@ -15383,7 +19290,7 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 	_ = this
 
 	localctx = NewIdentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, SQLParserRULE_ident)
+	p.EnterRule(localctx, 208, SQLParserRULE_ident)
 
 	defer func() {
 		p.ExitRule()
@@ -15404,20 +19311,20 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(752)
+	p.SetState(945)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SQLParserL_ID:
 		{
-			p.SetState(750)
+			p.SetState(943)
 			p.Match(SQLParserL_ID)
 		}
 
 
-	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
+	case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_ALTER, SQLParserT_DELETE, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_OFFSET, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_SERIES, SQLParserT_QUOTA, SQLParserT_CARDINALITY, SQLParserT_ID, SQLParserT_USER, SQLParserT_USERS, SQLParserT_PASSWORD, SQLParserT_TOKEN, SQLParserT_GRANT, SQLParserT_REVOKE, SQLParserT_TO, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ADMIN, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_PERCENTILE, SQLParserT_INCREASE, SQLParserT_DERIVATIVE, SQLParserT_DELTA, SQLParserT_MOVING_AVERAGE, SQLParserT_ABS, SQLParserT_CLAMP_MIN, SQLParserT_CLAMP_MAX, SQLParserT_TIMESHIFT, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
 		{
-			p.SetState(751)
+			p.SetState(944)
 			p.NonReservedWords()
 		}
 
@@ -15426,30 +19333,30 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(761)
+	p.SetState(954)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(754)
+				p.SetState(947)
 				p.Match(SQLParserT_DOT)
 			}
-			p.SetState(757)
+			p.SetState(950)
 			p.GetErrorHandler().Sync(p)
 
 			switch p.GetTokenStream().LA(1) {
 			case SQLParserL_ID:
 				{
-					p.SetState(755)
+					p.SetState(948)
 					p.Match(SQLParserL_ID)
 				}
 
 
-			case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_ID, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
+			case SQLParserT_CREATE, SQLParserT_UPDATE, SQLParserT_SET, SQLParserT_DROP, SQLParserT_ALTER, SQLParserT_DELETE, SQLParserT_INTERVAL, SQLParserT_INTERVAL_NAME, SQLParserT_SHARD, SQLParserT_REPLICATION, SQLParserT_TTL, SQLParserT_META_TTL, SQLParserT_PAST_TTL, SQLParserT_FUTURE_TTL, SQLParserT_KILL, SQLParserT_ON, SQLParserT_SHOW, SQLParserT_USE, SQLParserT_STATE_REPO, SQLParserT_STATE_MACHINE, SQLParserT_MASTER, SQLParserT_METADATA, SQLParserT_TYPES, SQLParserT_TYPE, SQLParserT_STORAGES, SQLParserT_STORAGE, SQLParserT_BROKER, SQLParserT_ALIVE, SQLParserT_SCHEMAS, SQLParserT_DATASBAE, SQLParserT_DATASBAES, SQLParserT_NAMESPACE, SQLParserT_NAMESPACES, SQLParserT_NODE, SQLParserT_METRICS, SQLParserT_METRIC, SQLParserT_FIELD, SQLParserT_FIELDS, SQLParserT_TAG, SQLParserT_INFO, SQLParserT_KEYS, SQLParserT_KEY, SQLParserT_WITH, SQLParserT_VALUES, SQLParserT_VALUE, SQLParserT_FROM, SQLParserT_WHERE, SQLParserT_LIMIT, SQLParserT_OFFSET, SQLParserT_QUERIES, SQLParserT_QUERY, SQLParserT_EXPLAIN, SQLParserT_WITH_VALUE, SQLParserT_SELECT, SQLParserT_AS, SQLParserT_AND, SQLParserT_OR, SQLParserT_FILL, SQLParserT_NULL, SQLParserT_PREVIOUS, SQLParserT_ORDER, SQLParserT_ASC, SQLParserT_DESC, SQLParserT_LIKE, SQLParserT_NOT, SQLParserT_BETWEEN, SQLParserT_IS, SQLParserT_GROUP, SQLParserT_HAVING, SQLParserT_BY, SQLParserT_FOR, SQLParserT_STATS, SQLParserT_TIME, SQLParserT_NOW, SQLParserT_IN, SQLParserT_LOG, SQLParserT_PROFILE, SQLParserT_REQUESTS, SQLParserT_REQUEST, SQLParserT_SERIES, SQLParserT_QUOTA, SQLParserT_CARDINALITY, SQLParserT_ID, SQLParserT_USER, SQLParserT_USERS, SQLParserT_PASSWORD, SQLParserT_TOKEN, SQLParserT_GRANT, SQLParserT_REVOKE, SQLParserT_TO, SQLParserT_READ, SQLParserT_WRITE, SQLParserT_ADMIN, SQLParserT_SUM, SQLParserT_MIN, SQLParserT_MAX, SQLParserT_COUNT, SQLParserT_LAST, SQLParserT_FIRST, SQLParserT_AVG, SQLParserT_STDDEV, SQLParserT_QUANTILE, SQLParserT_RATE, SQLParserT_PERCENTILE, SQLParserT_INCREASE, SQLParserT_DERIVATIVE, SQLParserT_DELTA, SQLParserT_MOVING_AVERAGE, SQLParserT_ABS, SQLParserT_CLAMP_MIN, SQLParserT_CLAMP_MAX, SQLParserT_TIMESHIFT, SQLParserT_SECOND, SQLParserT_MINUTE, SQLParserT_HOUR, SQLParserT_DAY, SQLParserT_WEEK, SQLParserT_MONTH, SQLParserT_YEAR:
 				{
-					p.SetState(756)
+					p.SetState(949)
 					p.NonReservedWords()
 				}
 
@@ -15461,9 +19368,9 @@ func (p *SQLParser) Ident() (localctx IIdentContext) {
 
 
 		}
-		p.SetState(763)
+		p.SetState(956)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext())
 	}
 
 
@@ -15526,6 +19433,14 @@ func (s *NonReservedWordsContext) T_DROP() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_DROP, 0)
 }
 
+func (s *NonReservedWordsContext) T_ALTER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ALTER, 0)
+}
+
+func (s *NonReservedWordsContext) T_DELETE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELETE, 0)
+}
+
 func (s *NonReservedWordsContext) T_INTERVAL() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_INTERVAL, 0)
 }
@@ -15646,6 +19561,10 @@ func (s *NonReservedWordsContext) T_LIMIT() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_LIMIT, 0)
 }
 
+func (s *NonReservedWordsContext) T_OFFSET() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_OFFSET, 0)
+}
+
 func (s *NonReservedWordsContext) T_QUERIES() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_QUERIES, 0)
 }
@@ -15798,6 +19717,42 @@ func (s *NonReservedWordsContext) T_RATE() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_RATE, 0)
 }
 
+func (s *NonReservedWordsContext) T_PERCENTILE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PERCENTILE, 0)
+}
+
+func (s *NonReservedWordsContext) T_INCREASE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_INCREASE, 0)
+}
+
+func (s *NonReservedWordsContext) T_DERIVATIVE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DERIVATIVE, 0)
+}
+
+func (s *NonReservedWordsContext) T_DELTA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_DELTA, 0)
+}
+
+func (s *NonReservedWordsContext) T_MOVING_AVERAGE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_MOVING_AVERAGE, 0)
+}
+
+func (s *NonReservedWordsContext) T_ABS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ABS, 0)
+}
+
+func (s *NonReservedWordsContext) T_CLAMP_MIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP_MIN, 0)
+}
+
+func (s *NonReservedWordsContext) T_CLAMP_MAX() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CLAMP_MAX, 0)
+}
+
+func (s *NonReservedWordsContext) T_TIMESHIFT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TIMESHIFT, 0)
+}
+
 func (s *NonReservedWordsContext) T_SECOND() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_SECOND, 0)
 }
@@ -15886,6 +19841,58 @@ func (s *NonReservedWordsContext) T_ID() antlr.TerminalNode {
 	return s.GetToken(SQLParserT_ID, 0)
 }
 
+func (s *NonReservedWordsContext) T_SERIES() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_SERIES, 0)
+}
+
+func (s *NonReservedWordsContext) T_QUOTA() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_QUOTA, 0)
+}
+
+func (s *NonReservedWordsContext) T_CARDINALITY() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_CARDINALITY, 0)
+}
+
+func (s *NonReservedWordsContext) T_USER() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USER, 0)
+}
+
+func (s *NonReservedWordsContext) T_USERS() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_USERS, 0)
+}
+
+func (s *NonReservedWordsContext) T_PASSWORD() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_PASSWORD, 0)
+}
+
+func (s *NonReservedWordsContext) T_TOKEN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TOKEN, 0)
+}
+
+func (s *NonReservedWordsContext) T_GRANT() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_GRANT, 0)
+}
+
+func (s *NonReservedWordsContext) T_REVOKE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_REVOKE, 0)
+}
+
+func (s *NonReservedWordsContext) T_TO() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_TO, 0)
+}
+
+func (s *NonReservedWordsContext) T_READ() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_READ, 0)
+}
+
+func (s *NonReservedWordsContext) T_WRITE() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_WRITE, 0)
+}
+
+func (s *NonReservedWordsContext) T_ADMIN() antlr.TerminalNode {
+	return s.GetToken(SQLParserT_ADMIN, 0)
+}
+
 func (s *NonReservedWordsContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -15925,7 +19932,7 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 	_ = this
 
 	localctx = NewNonReservedWordsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, SQLParserRULE_nonReservedWords)
+	p.EnterRule(localctx, 210, SQLParserRULE_nonReservedWords)
 	var _la int
 
 
@@ -15947,10 +19954,10 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(764)
+		p.SetState(957)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((((_la - 6)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 6))) & ((1 << (SQLParserT_CREATE - 6)) | (1 << (SQLParserT_UPDATE - 6)) | (1 << (SQLParserT_SET - 6)) | (1 << (SQLParserT_DROP - 6)) | (1 << (SQLParserT_INTERVAL - 6)) | (1 << (SQLParserT_INTERVAL_NAME - 6)) | (1 << (SQLParserT_SHARD - 6)) | (1 << (SQLParserT_REPLICATION - 6)) | (1 << (SQLParserT_TTL - 6)) | (1 << (SQLParserT_META_TTL - 6)) | (1 << (SQLParserT_PAST_TTL - 6)) | (1 << (SQLParserT_FUTURE_TTL - 6)) | (1 << (SQLParserT_KILL - 6)) | (1 << (SQLParserT_ON - 6)) | (1 << (SQLParserT_SHOW - 6)) | (1 << (SQLParserT_USE - 6)) | (1 << (SQLParserT_STATE_REPO - 6)) | (1 << (SQLParserT_STATE_MACHINE - 6)) | (1 << (SQLParserT_MASTER - 6)) | (1 << (SQLParserT_METADATA - 6)) | (1 << (SQLParserT_TYPES - 6)) | (1 << (SQLParserT_TYPE - 6)) | (1 << (SQLParserT_STORAGES - 6)) | (1 << (SQLParserT_STORAGE - 6)) | (1 << (SQLParserT_BROKER - 6)) | (1 << (SQLParserT_ALIVE - 6)) | (1 << (SQLParserT_SCHEMAS - 6)) | (1 << (SQLParserT_DATASBAE - 6)) | (1 << (SQLParserT_DATASBAES - 6)) | (1 << (SQLParserT_NAMESPACE - 6)) | (1 << (SQLParserT_NAMESPACES - 6)) | (1 << (SQLParserT_NODE - 6)))) != 0) || ((((_la - 38)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 38))) & ((1 << (SQLParserT_METRICS - 38)) | (1 << (SQLParserT_METRIC - 38)) | (1 << (SQLParserT_FIELD - 38)) | (1 << (SQLParserT_FIELDS - 38)) | (1 << (SQLParserT_TAG - 38)) | (1 << (SQLParserT_INFO - 38)) | (1 << (SQLParserT_KEYS - 38)) | (1 << (SQLParserT_KEY - 38)) | (1 << (SQLParserT_WITH - 38)) | (1 << (SQLParserT_VALUES - 38)) | (1 << (SQLParserT_VALUE - 38)) | (1 << (SQLParserT_FROM - 38)) | (1 << (SQLParserT_WHERE - 38)) | (1 << (SQLParserT_LIMIT - 38)) | (1 << (SQLParserT_QUERIES - 38)) | (1 << (SQLParserT_QUERY - 38)) | (1 << (SQLParserT_EXPLAIN - 38)) | (1 << (SQLParserT_WITH_VALUE - 38)) | (1 << (SQLParserT_SELECT - 38)) | (1 << (SQLParserT_AS - 38)) | (1 << (SQLParserT_AND - 38)) | (1 << (SQLParserT_OR - 38)) | (1 << (SQLParserT_FILL - 38)) | (1 << (SQLParserT_NULL - 38)) | (1 << (SQLParserT_PREVIOUS - 38)) | (1 << (SQLParserT_ORDER - 38)) | (1 << (SQLParserT_ASC - 38)) | (1 << (SQLParserT_DESC - 38)) | (1 << (SQLParserT_LIKE - 38)) | (1 << (SQLParserT_NOT - 38)) | (1 << (SQLParserT_BETWEEN - 38)) | (1 << (SQLParserT_IS - 38)))) != 0) || ((((_la - 70)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 70))) & ((1 << (SQLParserT_GROUP - 70)) | (1 << (SQLParserT_HAVING - 70)) | (1 << (SQLParserT_BY - 70)) | (1 << (SQLParserT_FOR - 70)) | (1 << (SQLParserT_STATS - 70)) | (1 << (SQLParserT_TIME - 70)) | (1 << (SQLParserT_NOW - 70)) | (1 << (SQLParserT_IN - 70)) | (1 << (SQLParserT_LOG - 70)) | (1 << (SQLParserT_PROFILE - 70)) | (1 << (SQLParserT_REQUESTS - 70)) | (1 << (SQLParserT_REQUEST - 70)) | (1 << (SQLParserT_ID - 70)) | (1 << (SQLParserT_SUM - 70)) | (1 << (SQLParserT_MIN - 70)) | (1 << (SQLParserT_MAX - 70)) | (1 << (SQLParserT_COUNT - 70)) | (1 << (SQLParserT_LAST - 70)) | (1 << (SQLParserT_FIRST - 70)) | (1 << (SQLParserT_AVG - 70)) | (1 << (SQLParserT_STDDEV - 70)) | (1 << (SQLParserT_QUANTILE - 70)) | (1 << (SQLParserT_RATE - 70)) | (1 << (SQLParserT_SECOND - 70)) | (1 << (SQLParserT_MINUTE - 70)) | (1 << (SQLParserT_HOUR - 70)) | (1 << (SQLParserT_DAY - 70)) | (1 << (SQLParserT_WEEK - 70)) | (1 << (SQLParserT_MONTH - 70)) | (1 << (SQLParserT_YEAR - 70)))) != 0)) {
+		if !((((_la) & -(0x1f+1)) == 0 && ((1 << uint(_la)) & ((1 << SQLParserT_CREATE) | (1 << SQLParserT_UPDATE) | (1 << SQLParserT_SET) | (1 << SQLParserT_DROP) | (1 << SQLParserT_ALTER) | (1 << SQLParserT_DELETE) | (1 << SQLParserT_INTERVAL) | (1 << SQLParserT_INTERVAL_NAME) | (1 << SQLParserT_SHARD) | (1 << SQLParserT_REPLICATION) | (1 << SQLParserT_TTL) | (1 << SQLParserT_META_TTL) | (1 << SQLParserT_PAST_TTL) | (1 << SQLParserT_FUTURE_TTL) | (1 << SQLParserT_KILL) | (1 << SQLParserT_ON) | (1 << SQLParserT_SHOW) | (1 << SQLParserT_USE) | (1 << SQLParserT_STATE_REPO) | (1 << SQLParserT_STATE_MACHINE) | (1 << SQLParserT_MASTER) | (1 << SQLParserT_METADATA) | (1 << SQLParserT_TYPES) | (1 << SQLParserT_TYPE) | (1 << SQLParserT_STORAGES) | (1 << SQLParserT_STORAGE) | (1 << SQLParserT_BROKER))) != 0) || ((((_la - 32)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 32))) & ((1 << (SQLParserT_ALIVE - 32)) | (1 << (SQLParserT_SCHEMAS - 32)) | (1 << (SQLParserT_DATASBAE - 32)) | (1 << (SQLParserT_DATASBAES - 32)) | (1 << (SQLParserT_NAMESPACE - 32)) | (1 << (SQLParserT_NAMESPACES - 32)) | (1 << (SQLParserT_NODE - 32)) | (1 << (SQLParserT_METRICS - 32)) | (1 << (SQLParserT_METRIC - 32)) | (1 << (SQLParserT_FIELD - 32)) | (1 << (SQLParserT_FIELDS - 32)) | (1 << (SQLParserT_TAG - 32)) | (1 << (SQLParserT_INFO - 32)) | (1 << (SQLParserT_KEYS - 32)) | (1 << (SQLParserT_KEY - 32)) | (1 << (SQLParserT_WITH - 32)) | (1 << (SQLParserT_VALUES - 32)) | (1 << (SQLParserT_VALUE - 32)) | (1 << (SQLParserT_FROM - 32)) | (1 << (SQLParserT_WHERE - 32)) | (1 << (SQLParserT_LIMIT - 32)) | (1 << (SQLParserT_OFFSET - 32)) | (1 << (SQLParserT_QUERIES - 32)) | (1 << (SQLParserT_QUERY - 32)) | (1 << (SQLParserT_EXPLAIN - 32)) | (1 << (SQLParserT_WITH_VALUE - 32)) | (1 << (SQLParserT_SELECT - 32)) | (1 << (SQLParserT_AS - 32)) | (1 << (SQLParserT_AND - 32)) | (1 << (SQLParserT_OR - 32)) | (1 << (SQLParserT_FILL - 32)) | (1 << (SQLParserT_NULL - 32)))) != 0) || ((((_la - 64)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 64))) & ((1 << (SQLParserT_PREVIOUS - 64)) | (1 << (SQLParserT_ORDER - 64)) | (1 << (SQLParserT_ASC - 64)) | (1 << (SQLParserT_DESC - 64)) | (1 << (SQLParserT_LIKE - 64)) | (1 << (SQLParserT_NOT - 64)) | (1 << (SQLParserT_BETWEEN - 64)) | (1 << (SQLParserT_IS - 64)) | (1 << (SQLParserT_GROUP - 64)) | (1 << (SQLParserT_HAVING - 64)) | (1 << (SQLParserT_BY - 64)) | (1 << (SQLParserT_FOR - 64)) | (1 << (SQLParserT_STATS - 64)) | (1 << (SQLParserT_TIME - 64)) | (1 << (SQLParserT_NOW - 64)) | (1 << (SQLParserT_IN - 64)) | (1 << (SQLParserT_LOG - 64)) | (1 << (SQLParserT_PROFILE - 64)) | (1 << (SQLParserT_REQUESTS - 64)) | (1 << (SQLParserT_REQUEST - 64)) | (1 << (SQLParserT_SERIES - 64)) | (1 << (SQLParserT_QUOTA - 64)) | (1 << (SQLParserT_CARDINALITY - 64)) | (1 << (SQLParserT_ID - 64)) | (1 << (SQLParserT_USER - 64)) | (1 << (SQLParserT_USERS - 64)) | (1 << (SQLParserT_PASSWORD - 64)) | (1 << (SQLParserT_TOKEN - 64)) | (1 << (SQLParserT_GRANT - 64)) | (1 << (SQLParserT_REVOKE - 64)) | (1 << (SQLParserT_TO - 64)) | (1 << (SQLParserT_READ - 64)))) != 0) || ((((_la - 96)) & -(0x1f+1)) == 0 && ((1 << uint((_la - 96))) & ((1 << (SQLParserT_WRITE - 96)) | (1 << (SQLParserT_ADMIN - 96)) | (1 << (SQLParserT_SUM - 96)) | (1 << (SQLParserT_MIN - 96)) | (1 << (SQLParserT_MAX - 96)) | (1 << (SQLParserT_COUNT - 96)) | (1 << (SQLParserT_LAST - 96)) | (1 << (SQLParserT_FIRST - 96)) | (1 << (SQLParserT_AVG - 96)) | (1 << (SQLParserT_STDDEV - 96)) | (1 << (SQLParserT_QUANTILE - 96)) | (1 << (SQLParserT_RATE - 96)) | (1 << (SQLParserT_PERCENTILE - 96)) | (1 << (SQLParserT_INCREASE - 96)) | (1 << (SQLParserT_DERIVATIVE - 96)) | (1 << (SQLParserT_DELTA - 96)) | (1 << (SQLParserT_MOVING_AVERAGE - 96)) | (1 << (SQLParserT_ABS - 96)) | (1 << (SQLParserT_CLAMP_MIN - 96)) | (1 << (SQLParserT_CLAMP_MAX - 96)) | (1 << (SQLParserT_TIMESHIFT - 96)) | (1 << (SQLParserT_SECOND - 96)) | (1 << (SQLParserT_MINUTE - 96)) | (1 << (SQLParserT_HOUR - 96)) | (1 << (SQLParserT_DAY - 96)) | (1 << (SQLParserT_WEEK - 96)) | (1 << (SQLParserT_MONTH - 96)) | (1 << (SQLParserT_YEAR - 96)))) != 0)) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -15966,17 +19973,17 @@ func (p *SQLParser) NonReservedWords() (localctx INonReservedWordsContext) {
 
 func (p *SQLParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 43:
+	case 61:
 			var t *TagFilterExprContext = nil
 			if localctx != nil { t = localctx.(*TagFilterExprContext) }
 			return p.TagFilterExpr_Sempred(t, predIndex)
 
-	case 59:
+	case 77:
 			var t *BoolExprContext = nil
 			if localctx != nil { t = localctx.(*BoolExprContext) }
 			return p.BoolExpr_Sempred(t, predIndex)
 
-	case 64:
+	case 82:
 			var t *FieldExprContext = nil
 			if localctx != nil { t = localctx.(*FieldExprContext) }
 			return p.FieldExpr_Sempred(t, predIndex)
@@ -41,6 +41,9 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#showRequestStmt.
 	VisitShowRequestStmt(ctx *ShowRequestStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#killQueryStmt.
+	VisitKillQueryStmt(ctx *KillQueryStmtContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showStoragesStmt.
 	VisitShowStoragesStmt(ctx *ShowStoragesStmtContext) interface{}
 
@@ -68,6 +71,9 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#showStorageMetricStmt.
 	VisitShowStorageMetricStmt(ctx *ShowStorageMetricStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#showSeriesQuotaStmt.
+	VisitShowSeriesQuotaStmt(ctx *ShowSeriesQuotaStmtContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#createStorageStmt.
 	VisitCreateStorageStmt(ctx *CreateStorageStmtContext) interface{}
 
@@ -80,6 +86,12 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#dropDatabaseStmt.
 	VisitDropDatabaseStmt(ctx *DropDatabaseStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#alterDatabaseStmt.
+	VisitAlterDatabaseStmt(ctx *AlterDatabaseStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#deleteStmt.
+	VisitDeleteStmt(ctx *DeleteStmtContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#showDatabaseStmt.
 	VisitShowDatabaseStmt(ctx *ShowDatabaseStmtContext) interface{}
 
@@ -98,6 +110,42 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#showTagValuesStmt.
 	VisitShowTagValuesStmt(ctx *ShowTagValuesStmtContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#showCardinalityStmt.
+	VisitShowCardinalityStmt(ctx *ShowCardinalityStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showTagCardinalityStmt.
+	VisitShowTagCardinalityStmt(ctx *ShowTagCardinalityStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#showUsersStmt.
+	VisitShowUsersStmt(ctx *ShowUsersStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#createUserStmt.
+	VisitCreateUserStmt(ctx *CreateUserStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#dropUserStmt.
+	VisitDropUserStmt(ctx *DropUserStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#createTokenStmt.
+	VisitCreateTokenStmt(ctx *CreateTokenStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#grantStmt.
+	VisitGrantStmt(ctx *GrantStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#revokeStmt.
+	VisitRevokeStmt(ctx *RevokeStmtContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#userName.
+	VisitUserName(ctx *UserNameContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#password.
+	VisitPassword(ctx *PasswordContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#roleName.
+	VisitRoleName(ctx *RoleNameContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#privilegeDatabase.
+	VisitPrivilegeDatabase(ctx *PrivilegeDatabaseContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#prefix.
 	VisitPrefix(ctx *PrefixContext) interface{}
 
@@ -146,6 +194,12 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#fromClause.
 	VisitFromClause(ctx *FromClauseContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#metricAlias.
+	VisitMetricAlias(ctx *MetricAliasContext) interface{}
+
+	// Visit a parse tree produced by SQLParser#metricOffset.
+	VisitMetricOffset(ctx *MetricOffsetContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#whereClause.
 	VisitWhereClause(ctx *WhereClauseContext) interface{}
 
@@ -266,12 +320,18 @@ type SQLVisitor interface {
 	// Visit a parse tree produced by SQLParser#limitClause.
 	VisitLimitClause(ctx *LimitClauseContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#offsetClause.
+	VisitOffsetClause(ctx *OffsetClauseContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#metricName.
 	VisitMetricName(ctx *MetricNameContext) interface{}
 
 	// Visit a parse tree produced by SQLParser#tagKey.
 	VisitTagKey(ctx *TagKeyContext) interface{}
 
+	// Visit a parse tree produced by SQLParser#tagRangeKey.
+	VisitTagRangeKey(ctx *TagRangeKeyContext) interface{}
+
 	// Visit a parse tree produced by SQLParser#tagValue.
 	VisitTagValue(ctx *TagValueContext) interface{}
 
@@ -33,6 +33,8 @@ type listener struct {
 	schemasStmt        *schemasStmtParser
 	storageStmt        *storageStmtParser
 	requestStmt        *requestStmtParser
+	deleteStmt         *deleteStmtParser
+	userStmt           *userStmtParser
 }
 
 // EnterQueryStmt is called when production queryStmt is entered.
@@ -55,6 +57,12 @@ func (l *listener) EnterShowRequestStmt(_ *grammar.ShowRequestStmtContext) {
 	l.requestStmt = newRequestStmtParse()
 }
 
+// EnterKillQueryStmt is called when production killQueryStmt is entered.
+func (l *listener) EnterKillQueryStmt(_ *grammar.KillQueryStmtContext) {
+	l.requestStmt = newRequestStmtParse()
+	l.requestStmt.visitKillQuery()
+}
+
 // EnterRequestID is called when production requestID is entered.
 func (l *listener) EnterRequestID(ctx *grammar.RequestIDContext) {
 	l.requestStmt.visitRequestID(ctx)
@@ -120,6 +128,11 @@ func (l *listener) EnterShowReplicationStmt(_ *grammar.ShowReplicationStmtContex
 	l.stateStmt = newStateStmtParse(stmt.Replication)
 }
 
+// EnterShowSeriesQuotaStmt is called when production showSeriesQuotaStmt is entered.
+func (l *listener) EnterShowSeriesQuotaStmt(_ *grammar.ShowSeriesQuotaStmtContext) {
+	l.stateStmt = newStateStmtParse(stmt.SeriesQuota)
+}
+
 // EnterStorageFilter is called when production storageFilter is entered.
 func (l *listener) EnterStorageFilter(ctx *grammar.StorageFilterContext) {
 	switch {
@@ -170,6 +183,66 @@ func (l *listener) EnterDropDatabaseStmt(_ *grammar.DropDatabaseStmtContext) {
 	l.schemasStmt = newSchemasStmtParse(stmt.DropDatabaseSchemaType)
 }
 
+// EnterAlterDatabaseStmt is called when production alterDatabaseStmt is entered.
+func (l *listener) EnterAlterDatabaseStmt(_ *grammar.AlterDatabaseStmtContext) {
+	l.schemasStmt = newSchemasStmtParse(stmt.AlterDatabaseSchemaType)
+}
+
+// EnterDeleteStmt is called when production deleteStmt is entered.
+func (l *listener) EnterDeleteStmt(_ *grammar.DeleteStmtContext) {
+	l.deleteStmt = newDeleteStmtParser()
+}
+
+// EnterShowUsersStmt is called when production showUsersStmt is entered.
+func (l *listener) EnterShowUsersStmt(_ *grammar.ShowUsersStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpShow)
+}
+
+// EnterCreateUserStmt is called when production createUserStmt is entered.
+func (l *listener) EnterCreateUserStmt(_ *grammar.CreateUserStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpCreate)
+}
+
+// EnterDropUserStmt is called when production dropUserStmt is entered.
+func (l *listener) EnterDropUserStmt(_ *grammar.DropUserStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpDrop)
+}
+
+// EnterCreateTokenStmt is called when production createTokenStmt is entered.
+func (l *listener) EnterCreateTokenStmt(_ *grammar.CreateTokenStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpCreateToken)
+}
+
+// EnterGrantStmt is called when production grantStmt is entered.
+func (l *listener) EnterGrantStmt(_ *grammar.GrantStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpGrant)
+}
+
+// EnterRevokeStmt is called when production revokeStmt is entered.
+func (l *listener) EnterRevokeStmt(_ *grammar.RevokeStmtContext) {
+	l.userStmt = newUserStmtParse(stmt.UserOpRevoke)
+}
+
+// EnterUserName is called when production userName is entered.
+func (l *listener) EnterUserName(ctx *grammar.UserNameContext) {
+	l.userStmt.visitUserName(ctx)
+}
+
+// EnterPassword is called when production password is entered.
+func (l *listener) EnterPassword(ctx *grammar.PasswordContext) {
+	l.userStmt.visitPassword(ctx)
+}
+
+// EnterRoleName is called when production roleName is entered.
+func (l *listener) EnterRoleName(ctx *grammar.RoleNameContext) {
+	l.userStmt.visitRoleName(ctx)
+}
+
+// EnterPrivilegeDatabase is called when production privilegeDatabase is entered.
+func (l *listener) EnterPrivilegeDatabase(ctx *grammar.PrivilegeDatabaseContext) {
+	l.userStmt.visitPrivilegeDatabase(ctx)
+}
+
 // EnterDatabaseName is called when production databaseName is entered.
 func (l *listener) EnterDatabaseName(ctx *grammar.DatabaseNameContext) {
 	l.schemasStmt.visitDatabaseName(ctx)
@@ -211,6 +284,16 @@ func (l *listener) EnterShowTagValuesStmt(_ *grammar.ShowTagValuesStmtContext) {
 	l.metricMetadataStmt = newMetricMetadataStmtParser(stmt.TagValue)
 }
 
+// EnterShowCardinalityStmt is called when production showCardinalityStmt is entered.
+func (l *listener) EnterShowCardinalityStmt(_ *grammar.ShowCardinalityStmtContext) {
+	l.metricMetadataStmt = newMetricMetadataStmtParser(stmt.MetricCardinality)
+}
+
+// EnterShowTagCardinalityStmt is called when production showTagCardinalityStmt is entered.
+func (l *listener) EnterShowTagCardinalityStmt(_ *grammar.ShowTagCardinalityStmtContext) {
+	l.metricMetadataStmt = newMetricMetadataStmtParser(stmt.TagCardinality)
+}
+
 // EnterNamespace is called when production namespace is entered.
 func (l *listener) EnterNamespace(ctx *grammar.NamespaceContext) {
 	switch {
@@ -218,6 +301,8 @@ func (l *listener) EnterNamespace(ctx *grammar.NamespaceContext) {
 		l.queryStmt.visitNamespace(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitNamespace(ctx)
+	case l.deleteStmt != nil:
+		l.deleteStmt.visitNamespace(ctx)
 	}
 }
 
@@ -242,6 +327,32 @@ func (l *listener) EnterMetricName(ctx *grammar.MetricNameContext) {
 		l.queryStmt.visitMetricName(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitMetricName(ctx)
+	case l.deleteStmt != nil:
+		l.deleteStmt.visitMetricName(ctx)
+	}
+}
+
+// EnterMetricAlias is called when production metricAlias is entered.
+func (l *listener) EnterMetricAlias(ctx *grammar.MetricAliasContext) {
+	switch {
+	case l.queryStmt != nil:
+		l.queryStmt.visitMetricAlias(ctx)
+	case l.metricMetadataStmt != nil:
+		l.metricMetadataStmt.visitMetricAlias(ctx)
+	}
+}
+
+// EnterMetricOffset is called when production metricOffset is entered.
+func (l *listener) EnterMetricOffset(ctx *grammar.MetricOffsetContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitMetricOffset(ctx)
+	}
+}
+
+// EnterOffsetClause is called when production offsetClause is entered.
+func (l *listener) EnterOffsetClause(ctx *grammar.OffsetClauseContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitOffsetClause(ctx)
 	}
 }
 
@@ -308,6 +419,8 @@ func (l *listener) EnterLimitClause(ctx *grammar.LimitClauseContext) {
 		l.queryStmt.visitLimit(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitLimit(ctx)
+	case l.stateStmt != nil:
+		l.stateStmt.visitLimit(ctx)
 	}
 }
 
@@ -318,6 +431,8 @@ func (l *listener) EnterTagFilterExpr(ctx *grammar.TagFilterExprContext) {
 		l.queryStmt.visitTagFilterExpr(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitTagFilterExpr(ctx)
+	case l.deleteStmt != nil:
+		l.deleteStmt.visitTagFilterExpr(ctx)
 	}
 }
 
@@ -328,6 +443,8 @@ func (l *listener) ExitTagFilterExpr(_ *grammar.TagFilterExprContext) {
 		l.queryStmt.completeTagFilterExpr()
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.completeTagFilterExpr()
+	case l.deleteStmt != nil:
+		l.deleteStmt.completeTagFilterExpr()
 	}
 }
 
@@ -338,13 +455,18 @@ func (l *listener) EnterTagValue(ctx *grammar.TagValueContext) {
 		l.queryStmt.visitTagValue(ctx)
 	case l.metricMetadataStmt != nil:
 		l.metricMetadataStmt.visitTagValue(ctx)
+	case l.deleteStmt != nil:
+		l.deleteStmt.visitTagValue(ctx)
 	}
 }
 
 // EnterTimeRangeExpr is called when production timeRangeExpr is entered.
 func (l *listener) EnterTimeRangeExpr(ctx *grammar.TimeRangeExprContext) {
-	if l.queryStmt != nil {
+	switch {
+	case l.queryStmt != nil:
 		l.queryStmt.visitTimeRangeExpr(ctx)
+	case l.deleteStmt != nil:
+		l.deleteStmt.visitTimeRangeExpr(ctx)
 	}
 }
 
@@ -355,6 +477,55 @@ func (l *listener) EnterGroupByKey(ctx *grammar.GroupByKeyContext) {
 	}
 }
 
+// EnterFillOption is called when production fillOption is entered.
+func (l *listener) EnterFillOption(ctx *grammar.FillOptionContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitFillOption(ctx)
+	}
+}
+
+// EnterHavingClause is called when production havingClause is entered.
+func (l *listener) EnterHavingClause(ctx *grammar.HavingClauseContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitHavingClause(ctx)
+	}
+}
+
+// ExitHavingClause is called when production havingClause is exited.
+func (l *listener) ExitHavingClause(ctx *grammar.HavingClauseContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.completeHavingClause(ctx)
+	}
+}
+
+// EnterBoolExpr is called when production boolExpr is entered.
+func (l *listener) EnterBoolExpr(ctx *grammar.BoolExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitBoolExpr(ctx)
+	}
+}
+
+// ExitBoolExpr is called when production boolExpr is exited.
+func (l *listener) ExitBoolExpr(ctx *grammar.BoolExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.completeBoolExpr(ctx)
+	}
+}
+
+// EnterBinaryExpr is called when production binaryExpr is entered.
+func (l *listener) EnterBinaryExpr(ctx *grammar.BinaryExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.visitBinaryExpr(ctx)
+	}
+}
+
+// ExitBinaryExpr is called when production binaryExpr is exited.
+func (l *listener) ExitBinaryExpr(ctx *grammar.BinaryExprContext) {
+	if l.queryStmt != nil {
+		l.queryStmt.completeBinaryExpr(ctx)
+	}
+}
+
 // EnterSortField is called when production sortField is entered.
 func (l *listener) EnterSortField(ctx *grammar.SortFieldContext) {
 	if l.queryStmt != nil {
@@ -388,6 +559,10 @@ func (l *listener) statement() (stmt.Statement, error) {
 		return l.stateStmt.build()
 	case l.requestStmt != nil:
 		return l.requestStmt.build()
+	case l.deleteStmt != nil:
+		return l.deleteStmt.build()
+	case l.userStmt != nil:
+		return l.userStmt.build()
 	default:
 		return nil, nil
 	}
@@ -18,6 +18,8 @@
 package sql
 
 import (
+	"fmt"
+
 	commonconstants "github.com/lindb/common/constants"
 
 	"github.com/lindb/lindb/pkg/collections"
@@ -50,6 +52,9 @@ func (s *metricMetadataStmtParser) build() (stmt.Statement, error) {
 	if s.err != nil {
 		return nil, s.err
 	}
+	if len(s.sources) > 1 {
+		return nil, fmt.Errorf("metadata query only supports one metric")
+	}
 	if s.limit <= 0 {
 		s.limit = 100
 	}
@@ -33,6 +33,10 @@ func TestMetaStmt_validation(t *testing.T) {
 	s, err := queryStmt.build()
 	assert.Error(t, err)
 	assert.Nil(t, s)
+	// case 2: multiple metrics
+	s, err = Parse("show tag keys from cpu, memory")
+	assert.Error(t, err)
+	assert.Nil(t, s)
 }
 
 func TestMetaStmt_ShowNamespace(t *testing.T) {
@@ -88,6 +92,31 @@ func TestMetaStmt_ShowTagKeys(t *testing.T) {
 	assert.Equal(t, "ns", query.Namespace)
 }
 
+func TestMetaStmt_ShowCardinality(t *testing.T) {
+	q, err := Parse("show cardinality")
+	assert.NoError(t, err)
+	query := q.(*stmt.MetricMetadata)
+	assert.Equal(t, stmt.MetricCardinality, query.Type)
+	assert.Equal(t, "default-ns", query.Namespace)
+	assert.Equal(t, 100, query.Limit)
+
+	q, err = Parse("show cardinality on 'ns' limit 10")
+	assert.NoError(t, err)
+	query = q.(*stmt.MetricMetadata)
+	assert.Equal(t, stmt.MetricCardinality, query.Type)
+	assert.Equal(t, "ns", query.Namespace)
+	assert.Equal(t, 10, query.Limit)
+}
+
+func TestMetaStmt_ShowTagCardinality(t *testing.T) {
+	q, err := Parse("show tag cardinality from 'cpu' on 'ns'")
+	assert.NoError(t, err)
+	query := q.(*stmt.MetricMetadata)
+	assert.Equal(t, stmt.TagCardinality, query.Type)
+	assert.Equal(t, "cpu", query.MetricName)
+	assert.Equal(t, "ns", query.Namespace)
+}
+
 func TestMetaStmt_ShowTagValues(t *testing.T) {
 	sql := "show tag values from 'cpu' on 'ns' with key = 'key1' where key1='value1' and key2='value2' limit 10"
 	q, err := Parse(sql)
@@ -113,6 +113,17 @@ func TestShowReplication(t *testing.T) {
 	assert.Equal(t, &stmt.State{Type: stmt.Replication, StorageName: "s", Database: "d"}, query)
 }
 
+func TestShowSeriesQuota(t *testing.T) {
+	query, err := Parse("show series quota")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.SeriesQuota}, query)
+	query, err = Parse("show series quota where database=d limit 10")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.State{Type: stmt.SeriesQuota, Database: "d", Limit: 10}, query)
+	_, err = Parse("show series quota limit 10000000000")
+	assert.Error(t, err)
+}
+
 func TestShowBrokerMetric(t *testing.T) {
 	query, err := Parse("show broker metric where metric in (a,b)")
 	assert.NoError(t, err)
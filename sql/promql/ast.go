@@ -0,0 +1,145 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Expr represents the expression of PromQL.
+type Expr interface {
+	// String returns the PromQL string of expression.
+	String() string
+}
+
+// MatchType represents the type of label matcher.
+type MatchType int
+
+const (
+	// MatchEqual represents label value equals(=).
+	MatchEqual MatchType = iota
+	// MatchNotEqual represents label value not equals(!=).
+	MatchNotEqual
+	// MatchRegexp represents label value matches regexp(=~).
+	MatchRegexp
+	// MatchNotRegexp represents label value not matches regexp(!~).
+	MatchNotRegexp
+)
+
+// String returns the operator of match type.
+func (t MatchType) String() string {
+	switch t {
+	case MatchEqual:
+		return "="
+	case MatchNotEqual:
+		return "!="
+	case MatchRegexp:
+		return "=~"
+	case MatchNotRegexp:
+		return "!~"
+	default:
+		return "unknown"
+	}
+}
+
+// LabelMatcher represents the label filter of vector selector.
+type LabelMatcher struct {
+	Type  MatchType
+	Name  string
+	Value string
+}
+
+// VectorSelector represents the instant/range vector selector, such as: cpu{host="h1"}[5m].
+type VectorSelector struct {
+	Name     string
+	Matchers []*LabelMatcher
+	Range    time.Duration // range vector if > 0
+}
+
+// Call represents the function call, such as: rate(cpu[5m]).
+type Call struct {
+	Func string
+	Args []Expr
+}
+
+// Aggregate represents the aggregation operator, such as: sum by (host) (cpu).
+type Aggregate struct {
+	Op       string
+	Grouping []string
+	Param    Expr // parameter of topk
+	Expr     Expr
+}
+
+// NumberLiteral represents the number literal, such as: 0.99.
+type NumberLiteral struct {
+	Val float64
+}
+
+// String returns the PromQL string of label matcher.
+func (m *LabelMatcher) String() string {
+	return fmt.Sprintf("%s%s%q", m.Name, m.Type, m.Value)
+}
+
+// String returns the PromQL string of vector selector.
+func (e *VectorSelector) String() string {
+	var sb strings.Builder
+	sb.WriteString(e.Name)
+	if len(e.Matchers) > 0 {
+		matchers := make([]string, len(e.Matchers))
+		for idx, m := range e.Matchers {
+			matchers[idx] = m.String()
+		}
+		sb.WriteString("{" + strings.Join(matchers, ",") + "}")
+	}
+	if e.Range > 0 {
+		sb.WriteString("[" + e.Range.String() + "]")
+	}
+	return sb.String()
+}
+
+// String returns the PromQL string of function call.
+func (e *Call) String() string {
+	args := make([]string, len(e.Args))
+	for idx, arg := range e.Args {
+		args[idx] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", e.Func, strings.Join(args, ", "))
+}
+
+// String returns the PromQL string of aggregation.
+func (e *Aggregate) String() string {
+	var sb strings.Builder
+	sb.WriteString(e.Op)
+	if len(e.Grouping) > 0 {
+		sb.WriteString(" by (" + strings.Join(e.Grouping, ", ") + ") ")
+	}
+	sb.WriteString("(")
+	if e.Param != nil {
+		sb.WriteString(e.Param.String() + ", ")
+	}
+	sb.WriteString(e.Expr.String() + ")")
+	return sb.String()
+}
+
+// String returns the PromQL string of number literal.
+func (e *NumberLiteral) String() string {
+	return strconv.FormatFloat(e.Val, 'f', -1, 64)
+}
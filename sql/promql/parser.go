@@ -0,0 +1,469 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// tokenType represents the type of lexical token.
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenIdentifier
+	tokenNumber
+	tokenString
+	tokenDuration
+	tokenLeftBrace
+	tokenRightBrace
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+	tokenEqual
+	tokenNotEqual
+	tokenRegexp
+	tokenNotRegexp
+)
+
+// token represents the lexical token of PromQL.
+type token struct {
+	typ tokenType
+	val string
+	pos int
+}
+
+// metricNameLabel represents the label name of metric name.
+const metricNameLabel = "__name__"
+
+// aggregateOps represents the supported aggregation operators.
+var aggregateOps = map[string]struct{}{
+	"sum":  {},
+	"avg":  {},
+	"min":  {},
+	"max":  {},
+	"topk": {},
+}
+
+// functions represents the supported functions.
+var functions = map[string]struct{}{
+	"rate":               {},
+	"histogram_quantile": {},
+}
+
+// Parse parses the practical subset of PromQL, supports:
+// 1. vector selector with =, !=, =~, !~ label matchers;
+// 2. rate/histogram_quantile function;
+// 3. sum/avg/min/max/topk aggregation with by clause.
+func Parse(input string) (Expr, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.typ != tokenEOF {
+		return nil, p.unexpected(tok)
+	}
+	return expr, nil
+}
+
+// lex splits the input into tokens.
+func lex(input string) ([]token, error) {
+	var tokens []token
+	pos := 0
+	for pos < len(input) {
+		ch := input[pos]
+		switch {
+		case unicode.IsSpace(rune(ch)):
+			pos++
+		case isIdentifierStart(ch):
+			start := pos
+			for pos < len(input) && isIdentifierChar(input[pos]) {
+				pos++
+			}
+			tokens = append(tokens, token{typ: tokenIdentifier, val: input[start:pos], pos: start})
+		case isDigit(ch) || ch == '.':
+			start := pos
+			for pos < len(input) && (isDigit(input[pos]) || input[pos] == '.' ||
+				input[pos] == 'e' || input[pos] == 'E' ||
+				((input[pos] == '+' || input[pos] == '-') && (input[pos-1] == 'e' || input[pos-1] == 'E'))) {
+				pos++
+			}
+			tokens = append(tokens, token{typ: tokenNumber, val: input[start:pos], pos: start})
+		case ch == '"' || ch == '\'' || ch == '`':
+			start := pos
+			pos++
+			for pos < len(input) && input[pos] != ch {
+				if input[pos] == '\\' && ch != '`' {
+					pos++
+				}
+				pos++
+			}
+			if pos >= len(input) {
+				return nil, fmt.Errorf("unterminated quoted string at position %d", start)
+			}
+			pos++
+			val, err := unquote(input[start:pos])
+			if err != nil {
+				return nil, fmt.Errorf("invalid quoted string at position %d: %w", start, err)
+			}
+			tokens = append(tokens, token{typ: tokenString, val: val, pos: start})
+		case ch == '[':
+			start := pos
+			end := strings.IndexByte(input[pos:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated range at position %d", start)
+			}
+			tokens = append(tokens, token{typ: tokenDuration, val: strings.TrimSpace(input[pos+1 : pos+end]), pos: start})
+			pos += end + 1
+		case ch == '{':
+			tokens = append(tokens, token{typ: tokenLeftBrace, val: "{", pos: pos})
+			pos++
+		case ch == '}':
+			tokens = append(tokens, token{typ: tokenRightBrace, val: "}", pos: pos})
+			pos++
+		case ch == '(':
+			tokens = append(tokens, token{typ: tokenLeftParen, val: "(", pos: pos})
+			pos++
+		case ch == ')':
+			tokens = append(tokens, token{typ: tokenRightParen, val: ")", pos: pos})
+			pos++
+		case ch == ',':
+			tokens = append(tokens, token{typ: tokenComma, val: ",", pos: pos})
+			pos++
+		case strings.HasPrefix(input[pos:], "=~"):
+			tokens = append(tokens, token{typ: tokenRegexp, val: "=~", pos: pos})
+			pos += 2
+		case strings.HasPrefix(input[pos:], "!~"):
+			tokens = append(tokens, token{typ: tokenNotRegexp, val: "!~", pos: pos})
+			pos += 2
+		case strings.HasPrefix(input[pos:], "!="):
+			tokens = append(tokens, token{typ: tokenNotEqual, val: "!=", pos: pos})
+			pos += 2
+		case ch == '=':
+			tokens = append(tokens, token{typ: tokenEqual, val: "=", pos: pos})
+			pos++
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", ch, pos)
+		}
+	}
+	tokens = append(tokens, token{typ: tokenEOF, pos: len(input)})
+	return tokens, nil
+}
+
+// unquote unquotes the quoted string, supports double/single quote and backtick.
+func unquote(s string) (string, error) {
+	if s[0] == '\'' {
+		// convert to double quoted string
+		inner := s[1 : len(s)-1]
+		inner = strings.ReplaceAll(inner, `\'`, `'`)
+		inner = strings.ReplaceAll(inner, `"`, `\"`)
+		s = `"` + inner + `"`
+	}
+	return strconv.Unquote(s)
+}
+
+func isDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
+
+func isIdentifierStart(ch byte) bool {
+	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || ch == '_' || ch == ':'
+}
+
+func isIdentifierChar(ch byte) bool {
+	return isIdentifierStart(ch) || isDigit(ch)
+}
+
+// parser represents the recursive descent parser of PromQL.
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// peek returns the current token without consuming it.
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// next consumes the current token.
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.typ != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// expect consumes the current token which must be the given type.
+func (p *parser) expect(typ tokenType) (token, error) {
+	tok := p.next()
+	if tok.typ != typ {
+		return tok, p.unexpected(tok)
+	}
+	return tok, nil
+}
+
+// unexpected returns the error of unexpected token.
+func (p *parser) unexpected(tok token) error {
+	if tok.typ == tokenEOF {
+		return fmt.Errorf("unexpected end of input")
+	}
+	return fmt.Errorf("unexpected %q at position %d", tok.val, tok.pos)
+}
+
+// parseExpr parses the expression.
+func (p *parser) parseExpr() (Expr, error) {
+	tok := p.peek()
+	switch tok.typ {
+	case tokenNumber:
+		p.next()
+		val, err := strconv.ParseFloat(tok.val, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", tok.val, tok.pos)
+		}
+		return &NumberLiteral{Val: val}, nil
+	case tokenLeftParen:
+		p.next()
+		expr, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	case tokenLeftBrace:
+		return p.parseVectorSelector("")
+	case tokenIdentifier:
+		p.next()
+		if _, ok := aggregateOps[tok.val]; ok {
+			if next := p.peek(); next.typ == tokenLeftParen || next.typ == tokenIdentifier {
+				return p.parseAggregate(tok.val)
+			}
+		}
+		if p.peek().typ == tokenLeftParen {
+			if _, ok := functions[tok.val]; !ok {
+				return nil, fmt.Errorf("not support function: %s", tok.val)
+			}
+			return p.parseCall(tok.val)
+		}
+		return p.parseVectorSelector(tok.val)
+	default:
+		return nil, p.unexpected(tok)
+	}
+}
+
+// parseAggregate parses the aggregation, such as: sum by (host) (cpu) or sum(cpu) by (host).
+func (p *parser) parseAggregate(op string) (Expr, error) {
+	agg := &Aggregate{Op: op}
+	var err error
+	if p.peek().typ == tokenIdentifier {
+		if agg.Grouping, err = p.parseGrouping(); err != nil {
+			return nil, err
+		}
+	}
+	args, err := p.parseArgs()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case op == "topk" && len(args) == 2:
+		agg.Param, agg.Expr = args[0], args[1]
+	case op != "topk" && len(args) == 1:
+		agg.Expr = args[0]
+	default:
+		return nil, fmt.Errorf("wrong number of arguments for aggregation: %s", op)
+	}
+	if agg.Grouping == nil && p.peek().typ == tokenIdentifier {
+		if agg.Grouping, err = p.parseGrouping(); err != nil {
+			return nil, err
+		}
+	}
+	return agg, nil
+}
+
+// parseGrouping parses by clause, only supports by.
+func (p *parser) parseGrouping() ([]string, error) {
+	tok := p.next()
+	if tok.val != "by" {
+		return nil, fmt.Errorf("not support grouping modifier: %s", tok.val)
+	}
+	if _, err := p.expect(tokenLeftParen); err != nil {
+		return nil, err
+	}
+	labels := []string{}
+	for p.peek().typ != tokenRightParen {
+		label, err := p.expect(tokenIdentifier)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, label.val)
+		if p.peek().typ == tokenComma {
+			p.next()
+		}
+	}
+	p.next()
+	return labels, nil
+}
+
+// parseCall parses the function call.
+func (p *parser) parseCall(name string) (Expr, error) {
+	args, err := p.parseArgs()
+	if err != nil {
+		return nil, err
+	}
+	return &Call{Func: name, Args: args}, nil
+}
+
+// parseArgs parses the arguments in parentheses.
+func (p *parser) parseArgs() ([]Expr, error) {
+	if _, err := p.expect(tokenLeftParen); err != nil {
+		return nil, err
+	}
+	var args []Expr
+	for p.peek().typ != tokenRightParen {
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.peek().typ == tokenComma {
+			p.next()
+		} else if p.peek().typ != tokenRightParen {
+			return nil, p.unexpected(p.peek())
+		}
+	}
+	p.next()
+	return args, nil
+}
+
+// parseVectorSelector parses the vector selector, such as: cpu{host="h1"}[5m].
+func (p *parser) parseVectorSelector(name string) (Expr, error) {
+	selector := &VectorSelector{Name: name}
+	if p.peek().typ == tokenLeftBrace {
+		p.next()
+		for p.peek().typ != tokenRightBrace {
+			matcher, err := p.parseLabelMatcher()
+			if err != nil {
+				return nil, err
+			}
+			if matcher.Name == metricNameLabel && matcher.Type == MatchEqual && selector.Name == "" {
+				selector.Name = matcher.Value
+			} else {
+				selector.Matchers = append(selector.Matchers, matcher)
+			}
+			if p.peek().typ == tokenComma {
+				p.next()
+			} else if p.peek().typ != tokenRightBrace {
+				return nil, p.unexpected(p.peek())
+			}
+		}
+		p.next()
+	}
+	if selector.Name == "" {
+		return nil, fmt.Errorf("vector selector must contain metric name")
+	}
+	if tok := p.peek(); tok.typ == tokenDuration {
+		p.next()
+		duration, err := ParseDuration(tok.val)
+		if err != nil {
+			return nil, err
+		}
+		selector.Range = duration
+	}
+	return selector, nil
+}
+
+// parseLabelMatcher parses the label matcher, such as: host="h1".
+func (p *parser) parseLabelMatcher() (*LabelMatcher, error) {
+	name, err := p.expect(tokenIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	matcher := &LabelMatcher{Name: name.val}
+	switch op := p.next(); op.typ {
+	case tokenEqual:
+		matcher.Type = MatchEqual
+	case tokenNotEqual:
+		matcher.Type = MatchNotEqual
+	case tokenRegexp:
+		matcher.Type = MatchRegexp
+	case tokenNotRegexp:
+		matcher.Type = MatchNotRegexp
+	default:
+		return nil, p.unexpected(op)
+	}
+	value, err := p.expect(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	matcher.Value = value.val
+	return matcher, nil
+}
+
+// durationUnits represents the supported units of PromQL duration.
+var durationUnits = map[string]time.Duration{
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+	"y":  365 * 24 * time.Hour,
+}
+
+// ParseDuration parses PromQL duration, such as: 1h30m, 5m, 10s.
+func ParseDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+	var result time.Duration
+	pos := 0
+	for pos < len(s) {
+		start := pos
+		for pos < len(s) && isDigit(s[pos]) {
+			pos++
+		}
+		if start == pos {
+			return 0, fmt.Errorf("invalid duration: %s", s)
+		}
+		num, _ := strconv.ParseInt(s[start:pos], 10, 64)
+		start = pos
+		for pos < len(s) && !isDigit(s[pos]) {
+			pos++
+		}
+		unit, ok := durationUnits[s[start:pos]]
+		if !ok {
+			return 0, fmt.Errorf("invalid duration: %s", s)
+		}
+		result += time.Duration(num) * unit
+	}
+	if result <= 0 {
+		return 0, fmt.Errorf("duration must be greater than 0: %s", s)
+	}
+	return result, nil
+}
@@ -0,0 +1,143 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		input string
+		expr  Expr
+	}{
+		{
+			input: `cpu`,
+			expr:  &VectorSelector{Name: "cpu"},
+		},
+		{
+			input: `cpu{host="h1", ip!='1.1.1.1', zone=~"sh.*",app!~` + "`test.*`" + `}`,
+			expr: &VectorSelector{Name: "cpu", Matchers: []*LabelMatcher{
+				{Type: MatchEqual, Name: "host", Value: "h1"},
+				{Type: MatchNotEqual, Name: "ip", Value: "1.1.1.1"},
+				{Type: MatchRegexp, Name: "zone", Value: "sh.*"},
+				{Type: MatchNotRegexp, Name: "app", Value: "test.*"},
+			}},
+		},
+		{
+			input: `{__name__="cpu",host="h1"}[1h30m]`,
+			expr: &VectorSelector{
+				Name:     "cpu",
+				Matchers: []*LabelMatcher{{Type: MatchEqual, Name: "host", Value: "h1"}},
+				Range:    time.Hour + 30*time.Minute,
+			},
+		},
+		{
+			input: `rate(http_requests_total[5m])`,
+			expr:  &Call{Func: "rate", Args: []Expr{&VectorSelector{Name: "http_requests_total", Range: 5 * time.Minute}}},
+		},
+		{
+			input: `sum by (host, zone) (cpu)`,
+			expr:  &Aggregate{Op: "sum", Grouping: []string{"host", "zone"}, Expr: &VectorSelector{Name: "cpu"}},
+		},
+		{
+			input: `avg(cpu) by (host)`,
+			expr:  &Aggregate{Op: "avg", Grouping: []string{"host"}, Expr: &VectorSelector{Name: "cpu"}},
+		},
+		{
+			input: `topk(5, (max(cpu)))`,
+			expr: &Aggregate{Op: "topk", Param: &NumberLiteral{Val: 5},
+				Expr: &Aggregate{Op: "max", Expr: &VectorSelector{Name: "cpu"}}},
+		},
+		{
+			input: `histogram_quantile(0.99, sum by (le) (rate(latency_bucket[1m])))`,
+			expr: &Call{Func: "histogram_quantile", Args: []Expr{
+				&NumberLiteral{Val: 0.99},
+				&Aggregate{Op: "sum", Grouping: []string{"le"}, Expr: &Call{Func: "rate", Args: []Expr{
+					&VectorSelector{Name: "latency_bucket", Range: time.Minute},
+				}}},
+			}},
+		},
+		{
+			// metric name same as aggregation operator
+			input: `max`,
+			expr:  &VectorSelector{Name: "max"},
+		},
+	}
+	for _, tt := range cases {
+		expr, err := Parse(tt.input)
+		assert.NoError(t, err, tt.input)
+		assert.Equal(t, tt.expr, expr, tt.input)
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	for _, input := range []string{
+		``,
+		`cpu + 1`,
+		`{host="h1"}`,
+		`cpu{host="h1"`,
+		`cpu{host=h1}`,
+		`cpu{host>"h1"}`,
+		`cpu{host="h1" zone="sh"}`,
+		`cpu{host="h1}`,
+		`cpu{host="\x"}`,
+		`cpu[5m`,
+		`cpu[5x]`,
+		`cpu[m]`,
+		`cpu[0s]`,
+		`cpu)`,
+		`(cpu`,
+		`1e`,
+		`irate(cpu[5m])`,
+		`sum without (host) (cpu)`,
+		`sum by host (cpu)`,
+		`sum by (1) (cpu)`,
+		`sum(cpu, cpu)`,
+		`topk(cpu)`,
+		`sum(cpu cpu)`,
+		`sum(cpu`,
+	} {
+		_, err := Parse(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	d, err := ParseDuration("1d2h3m4s5ms")
+	assert.NoError(t, err)
+	assert.Equal(t, 26*time.Hour+3*time.Minute+4*time.Second+5*time.Millisecond, d)
+	d, err = ParseDuration("1w1y")
+	assert.NoError(t, err)
+	assert.Equal(t, 372*24*time.Hour, d)
+	_, err = ParseDuration("")
+	assert.Error(t, err)
+}
+
+func TestExpr_String(t *testing.T) {
+	expr, err := Parse(`histogram_quantile(0.99, sum by (le) (rate(latency_bucket{host!="h1"}[1m])))`)
+	assert.NoError(t, err)
+	assert.Equal(t, `histogram_quantile(0.99, sum by (le) (rate(latency_bucket{host!="h1"}[1m0s])))`, expr.String())
+	expr, err = Parse(`topk(3, cpu)`)
+	assert.NoError(t, err)
+	assert.Equal(t, `topk(3, cpu)`, expr.String())
+	assert.Equal(t, "unknown", MatchType(10).String())
+}
@@ -0,0 +1,261 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+const bucketSuffix = "_bucket"
+
+// aggregateFuncs represents the mapping of aggregation operator => LinDB function.
+var aggregateFuncs = map[string]function.FuncType{
+	"sum": function.Sum,
+	"avg": function.Avg,
+	"min": function.Min,
+	"max": function.Max,
+}
+
+// Query represents the LinDB query translated from PromQL expression.
+type Query struct {
+	Stmt *stmt.Query
+	// GroupByAllTags represents returning each series without aggregation,
+	// caller needs to group by all tag keys of metric.
+	GroupByAllTags bool
+}
+
+// translated represents the intermediate result of translating.
+type translated struct {
+	query      *Query
+	expr       stmt.Expr
+	rangeValue bool
+}
+
+// Translate translates PromQL expression into LinDB query, the field represents the value of prometheus sample,
+// all select items use field as alias. Caller needs to set the time range/interval of query.
+func Translate(expr Expr, field string) (*Query, error) {
+	result, err := translate(expr, field)
+	if err != nil {
+		return nil, err
+	}
+	if result.rangeValue {
+		return nil, fmt.Errorf("range vector is only supported in rate function: %s", expr)
+	}
+	result.query.Stmt.SelectItems = []stmt.Expr{&stmt.SelectItem{Expr: result.expr, Alias: field}}
+	return result.query, nil
+}
+
+// ConditionOf builds tag filter condition based on label matchers, combines all matchers with and.
+func ConditionOf(matchers []*LabelMatcher) (stmt.Expr, error) {
+	var condition stmt.Expr
+	for _, matcher := range matchers {
+		if matcher.Value == "" {
+			// empty label value means label not exist in prometheus, cannot filter by tag index
+			continue
+		}
+		var expr stmt.Expr
+		switch matcher.Type {
+		case MatchEqual:
+			expr = &stmt.EqualsExpr{Key: matcher.Name, Value: matcher.Value}
+		case MatchNotEqual:
+			expr = &stmt.NotExpr{Expr: &stmt.EqualsExpr{Key: matcher.Name, Value: matcher.Value}}
+		case MatchRegexp:
+			expr = &stmt.RegexExpr{Key: matcher.Name, Regexp: matcher.Value}
+		case MatchNotRegexp:
+			expr = &stmt.NotExpr{Expr: &stmt.RegexExpr{Key: matcher.Name, Regexp: matcher.Value}}
+		default:
+			return nil, fmt.Errorf("not support label matcher type: %s", matcher.Type)
+		}
+		if condition == nil {
+			condition = expr
+		} else {
+			condition = &stmt.BinaryExpr{Left: condition, Operator: stmt.AND, Right: expr}
+		}
+	}
+	return condition, nil
+}
+
+// translate translates the expression recursively.
+func translate(expr Expr, field string) (*translated, error) {
+	switch e := expr.(type) {
+	case *VectorSelector:
+		return translateSelector(e, e.Name, field)
+	case *Call:
+		switch e.Func {
+		case "rate":
+			return translateRate(e, field)
+		case "histogram_quantile":
+			return translateHistogramQuantile(e, field)
+		}
+		return nil, fmt.Errorf("not support function: %s", e.Func)
+	case *Aggregate:
+		if e.Op == "topk" {
+			return translateTopK(e, field)
+		}
+		return translateAggregate(e, field)
+	default:
+		return nil, fmt.Errorf("not support expression: %s", expr)
+	}
+}
+
+// translateSelector translates vector selector into query, each series is returned without aggregation.
+func translateSelector(selector *VectorSelector, metricName, field string) (*translated, error) {
+	condition, err := ConditionOf(selector.Matchers)
+	if err != nil {
+		return nil, err
+	}
+	return &translated{
+		query: &Query{
+			Stmt:           &stmt.Query{MetricName: metricName, Condition: condition},
+			GroupByAllTags: true,
+		},
+		expr:       &stmt.FieldExpr{Name: field},
+		rangeValue: selector.Range > 0,
+	}, nil
+}
+
+// translateRate translates rate(selector[range]) into rate(increase(field)),
+// counter is stored as cumulative value, increase handles counter reset,
+// the rate is calculated by query interval(step), not the range of selector.
+func translateRate(call *Call, field string) (*translated, error) {
+	if len(call.Args) != 1 {
+		return nil, fmt.Errorf("wrong number of arguments for function: %s", call.Func)
+	}
+	selector, ok := call.Args[0].(*VectorSelector)
+	if !ok || selector.Range <= 0 {
+		return nil, fmt.Errorf("function rate requires range vector selector: %s", call)
+	}
+	result, err := translateSelector(selector, selector.Name, field)
+	if err != nil {
+		return nil, err
+	}
+	result.expr = &stmt.CallExpr{FuncType: function.Rate, Params: []stmt.Expr{
+		&stmt.CallExpr{FuncType: function.Increase, Params: []stmt.Expr{result.expr}},
+	}}
+	result.rangeValue = false
+	return result, nil
+}
+
+// translateAggregate translates sum/avg/min/max by (labels) (expr) into group by query.
+func translateAggregate(agg *Aggregate, field string) (*translated, error) {
+	funcType, ok := aggregateFuncs[agg.Op]
+	if !ok {
+		return nil, fmt.Errorf("not support aggregation: %s", agg.Op)
+	}
+	result, err := translate(agg.Expr, field)
+	if err != nil {
+		return nil, err
+	}
+	if result.rangeValue {
+		return nil, fmt.Errorf("aggregation %s requires instant vector: %s", agg.Op, agg.Expr)
+	}
+	if !result.query.GroupByAllTags || len(result.query.Stmt.OrderByItems) > 0 {
+		return nil, fmt.Errorf("not support nested aggregation: %s", agg)
+	}
+	switch e := result.expr.(type) {
+	case *stmt.FieldExpr:
+		result.expr = &stmt.CallExpr{FuncType: funcType, Params: []stmt.Expr{e}}
+	case *stmt.CallExpr:
+		// rate of grouped sum equals sum of each rate(sum of last value of each series),
+		// rewrite rate(increase(field)) => rate(increase(sum(field))).
+		if e.FuncType != function.Rate || funcType != function.Sum {
+			return nil, fmt.Errorf("not support aggregation %s of expression: %s", agg.Op, agg.Expr)
+		}
+		increase := e.Params[0].(*stmt.CallExpr)
+		increase.Params = []stmt.Expr{&stmt.CallExpr{FuncType: funcType, Params: increase.Params}}
+	}
+	result.query.Stmt.GroupBy = agg.Grouping
+	result.query.GroupByAllTags = false
+	return result, nil
+}
+
+// translateTopK translates topk(k, expr) into order by max value desc with limit k.
+func translateTopK(agg *Aggregate, field string) (*translated, error) {
+	param, ok := agg.Param.(*NumberLiteral)
+	if !ok || param.Val < 1 || param.Val != math.Trunc(param.Val) {
+		return nil, fmt.Errorf("topk requires positive integer parameter: %s", agg)
+	}
+	if len(agg.Grouping) > 0 {
+		return nil, fmt.Errorf("not support topk with grouping: %s", agg)
+	}
+	result, err := translate(agg.Expr, field)
+	if err != nil {
+		return nil, err
+	}
+	if result.rangeValue || len(result.query.Stmt.OrderByItems) > 0 {
+		return nil, fmt.Errorf("not support topk of expression: %s", agg.Expr)
+	}
+	result.query.Stmt.OrderByItems = []stmt.Expr{&stmt.OrderByExpr{
+		Expr: &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{&stmt.FieldExpr{Name: field}}},
+		Desc: true,
+	}}
+	result.query.Stmt.Limit = int(param.Val)
+	return result, nil
+}
+
+// translateHistogramQuantile translates histogram_quantile(φ, xxx_bucket) into quantile function of histogram xxx,
+// supports: histogram_quantile(φ, sum by (le, ...) (rate(xxx_bucket[range]))) and without sum/rate.
+func translateHistogramQuantile(call *Call, field string) (*translated, error) {
+	if len(call.Args) != 2 {
+		return nil, fmt.Errorf("wrong number of arguments for function: %s", call.Func)
+	}
+	quantile, ok := call.Args[0].(*NumberLiteral)
+	if !ok || quantile.Val < 0 || quantile.Val > 1 {
+		return nil, fmt.Errorf("histogram_quantile requires quantile between 0 and 1: %s", call)
+	}
+	var (
+		inner    = call.Args[1]
+		grouping []string
+		grouped  bool
+	)
+	if agg, ok := inner.(*Aggregate); ok {
+		if agg.Op != "sum" {
+			return nil, fmt.Errorf("histogram_quantile only supports sum aggregation: %s", call)
+		}
+		for _, label := range agg.Grouping {
+			if label != "le" {
+				grouping = append(grouping, label)
+			}
+		}
+		grouped = true
+		inner = agg.Expr
+	}
+	if rate, ok := inner.(*Call); ok && rate.Func == "rate" && len(rate.Args) == 1 {
+		inner = rate.Args[0]
+	}
+	selector, ok := inner.(*VectorSelector)
+	if !ok || !strings.HasSuffix(selector.Name, bucketSuffix) {
+		return nil, fmt.Errorf("histogram_quantile requires histogram bucket selector: %s", call)
+	}
+	result, err := translateSelector(selector, strings.TrimSuffix(selector.Name, bucketSuffix), field)
+	if err != nil {
+		return nil, err
+	}
+	result.expr = &stmt.CallExpr{FuncType: function.Quantile, Params: []stmt.Expr{&stmt.NumberLiteral{Val: quantile.Val}}}
+	result.rangeValue = false
+	if grouped {
+		result.query.Stmt.GroupBy = grouping
+		result.query.GroupByAllTags = false
+	}
+	return result, nil
+}
@@ -0,0 +1,182 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package promql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestTranslate(t *testing.T) {
+	value := &stmt.FieldExpr{Name: "value"}
+	cases := []struct {
+		input string
+		query *Query
+	}{
+		{
+			input: `cpu{host="h1"}`,
+			query: &Query{
+				Stmt: &stmt.Query{
+					MetricName:  "cpu",
+					Condition:   &stmt.EqualsExpr{Key: "host", Value: "h1"},
+					SelectItems: []stmt.Expr{&stmt.SelectItem{Expr: value, Alias: "value"}},
+				},
+				GroupByAllTags: true,
+			},
+		},
+		{
+			input: `rate(http_requests_total[5m])`,
+			query: &Query{
+				Stmt: &stmt.Query{
+					MetricName: "http_requests_total",
+					SelectItems: []stmt.Expr{&stmt.SelectItem{
+						Expr: &stmt.CallExpr{FuncType: function.Rate, Params: []stmt.Expr{
+							&stmt.CallExpr{FuncType: function.Increase, Params: []stmt.Expr{value}},
+						}},
+						Alias: "value",
+					}},
+				},
+				GroupByAllTags: true,
+			},
+		},
+		{
+			input: `sum by (path) (rate(http_requests_total[5m]))`,
+			query: &Query{
+				Stmt: &stmt.Query{
+					MetricName: "http_requests_total",
+					SelectItems: []stmt.Expr{&stmt.SelectItem{
+						Expr: &stmt.CallExpr{FuncType: function.Rate, Params: []stmt.Expr{
+							&stmt.CallExpr{FuncType: function.Increase, Params: []stmt.Expr{
+								&stmt.CallExpr{FuncType: function.Sum, Params: []stmt.Expr{value}},
+							}},
+						}},
+						Alias: "value",
+					}},
+					GroupBy: []string{"path"},
+				},
+			},
+		},
+		{
+			input: `max(cpu)`,
+			query: &Query{
+				Stmt: &stmt.Query{
+					MetricName: "cpu",
+					SelectItems: []stmt.Expr{&stmt.SelectItem{
+						Expr:  &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{value}},
+						Alias: "value",
+					}},
+				},
+			},
+		},
+		{
+			input: `topk(3, avg by (host) (cpu))`,
+			query: &Query{
+				Stmt: &stmt.Query{
+					MetricName: "cpu",
+					SelectItems: []stmt.Expr{&stmt.SelectItem{
+						Expr:  &stmt.CallExpr{FuncType: function.Avg, Params: []stmt.Expr{value}},
+						Alias: "value",
+					}},
+					GroupBy: []string{"host"},
+					OrderByItems: []stmt.Expr{&stmt.OrderByExpr{
+						Expr: &stmt.CallExpr{FuncType: function.Max, Params: []stmt.Expr{value}},
+						Desc: true,
+					}},
+					Limit: 3,
+				},
+			},
+		},
+		{
+			input: `histogram_quantile(0.99, sum by (le, host) (rate(latency_bucket{zone="sh"}[1m])))`,
+			query: &Query{
+				Stmt: &stmt.Query{
+					MetricName: "latency",
+					Condition:  &stmt.EqualsExpr{Key: "zone", Value: "sh"},
+					SelectItems: []stmt.Expr{&stmt.SelectItem{
+						Expr:  &stmt.CallExpr{FuncType: function.Quantile, Params: []stmt.Expr{&stmt.NumberLiteral{Val: 0.99}}},
+						Alias: "value",
+					}},
+					GroupBy: []string{"host"},
+				},
+			},
+		},
+		{
+			input: `histogram_quantile(0.9, latency_bucket)`,
+			query: &Query{
+				Stmt: &stmt.Query{
+					MetricName: "latency",
+					SelectItems: []stmt.Expr{&stmt.SelectItem{
+						Expr:  &stmt.CallExpr{FuncType: function.Quantile, Params: []stmt.Expr{&stmt.NumberLiteral{Val: 0.9}}},
+						Alias: "value",
+					}},
+				},
+				GroupByAllTags: true,
+			},
+		},
+	}
+	for _, tt := range cases {
+		expr, err := Parse(tt.input)
+		assert.NoError(t, err, tt.input)
+		query, err := Translate(expr, "value")
+		assert.NoError(t, err, tt.input)
+		assert.Equal(t, tt.query, query, tt.input)
+	}
+}
+
+func TestTranslate_Error(t *testing.T) {
+	for _, input := range []string{
+		`cpu[5m]`,
+		`1`,
+		`rate(cpu)`,
+		`rate(cpu[5m], cpu[5m])`,
+		`sum(cpu[5m])`,
+		`sum(sum(cpu))`,
+		`sum(topk(1, cpu))`,
+		`avg(rate(cpu[5m]))`,
+		`max(histogram_quantile(0.9, latency_bucket))`,
+		`sum(irate(cpu[5m]))`,
+		`topk(0, cpu)`,
+		`topk(1.5, cpu)`,
+		`topk(cpu, cpu)`,
+		`topk by (host) (1, cpu)`,
+		`topk(1, cpu[5m])`,
+		`topk(1, topk(1, cpu))`,
+		`histogram_quantile(0.9)`,
+		`histogram_quantile(2, latency_bucket)`,
+		`histogram_quantile(0.9, max by (le) (latency_bucket))`,
+		`histogram_quantile(0.9, latency)`,
+	} {
+		expr, err := Parse(input)
+		if err != nil {
+			continue
+		}
+		_, err = Translate(expr, "value")
+		assert.Error(t, err, input)
+	}
+	// invalid matcher type
+	_, err := ConditionOf([]*LabelMatcher{{Type: MatchType(10), Name: "host", Value: "h1"}})
+	assert.Error(t, err)
+	_, err = translate(&Call{Func: "unknown"}, "value")
+	assert.Error(t, err)
+	_, err = translate(&Aggregate{Op: "count", Expr: &VectorSelector{Name: "cpu"}}, "value")
+	assert.Error(t, err)
+}
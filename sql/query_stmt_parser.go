@@ -40,13 +40,17 @@ type queryStmtParser struct {
 	selectItems []stmt.Expr
 	fieldNames  map[string]struct{} // cache field name include alias
 
-	startTime int64
-	endTime   int64
-
 	groupBy  []string
 	interval int64
+	offset   int64
 	orderBy  []stmt.Expr
 
+	fill      stmt.FillOption
+	fillValue float64
+
+	having    stmt.Expr
+	hasHaving bool
+
 	curOrderByExpr *stmt.OrderByExpr
 	hasOrderBy     bool
 }
@@ -74,6 +78,13 @@ func (q *queryStmtParser) build() (stmt.Statement, error) {
 	query.Explain = q.explain
 	query.Namespace = q.namespace
 	query.MetricName = q.metricName
+	query.Offset = q.offset
+	if len(q.sources) > 1 {
+		query.Sources = q.sources
+	} else if len(q.sources) == 1 {
+		// single metric, offset of metric is same as offset of query
+		query.Offset += q.sources[0].Offset
+	}
 	query.SelectItems = q.selectItems
 	query.Condition = q.condition
 
@@ -91,8 +102,12 @@ func (q *queryStmtParser) build() (stmt.Statement, error) {
 
 	query.Interval = timeutil.Interval(q.interval)
 	query.GroupBy = q.groupBy
+	query.Fill = q.fill
+	query.FillValue = q.fillValue
+	query.Having = q.having
 	query.OrderByItems = q.orderBy
 	query.Limit = q.limit
+	query.ExplicitLimit = q.hasLimit
 	return query, nil
 }
 
@@ -107,9 +122,45 @@ func (q *queryStmtParser) validation() error {
 	if len(q.selectItems) == 0 {
 		return fmt.Errorf("select fields cannbe be empty")
 	}
+	if q.having != nil && len(q.groupBy) == 0 {
+		return fmt.Errorf("having clause requires group by tag keys")
+	}
+	if len(q.sources) > 1 {
+		return q.validateCrossMetric()
+	}
 	return nil
 }
 
+// validateCrossMetric validates the query which selects fields from multiple metrics,
+// all fields must be qualified by metric alias(or name).
+func (q *queryStmtParser) validateCrossMetric() error {
+	names := make(map[string]struct{})
+	for _, source := range q.sources {
+		if _, ok := names[source.Name()]; ok {
+			return fmt.Errorf("duplicate metric source: %s", source.Name())
+		}
+		names[source.Name()] = struct{}{}
+	}
+	if q.having != nil || len(q.orderBy) > 0 {
+		return fmt.Errorf("having/order by are not supported for cross metric query")
+	}
+	var err error
+	for _, item := range q.selectItems {
+		stmt.WalkFieldExpr(item, func(fieldExpr *stmt.FieldExpr) {
+			if err != nil {
+				return
+			}
+			for _, source := range q.sources {
+				if _, ok := source.FieldName(fieldExpr.Name); ok {
+					return
+				}
+			}
+			err = fmt.Errorf("field: %s must be qualified by metric alias in cross metric query", fieldExpr.Name)
+		})
+	}
+	return err
+}
+
 // resetExprStack resets expr stack for next parse fragment
 func (q *queryStmtParser) resetExprStack() {
 	q.exprStack = collections.NewStack()
@@ -126,6 +177,108 @@ func (q *queryStmtParser) visitGroupByKey(ctx *grammar.GroupByKeyContext) {
 	}
 }
 
+// visitFillOption visits when production fill option expression is entered.
+func (q *queryStmtParser) visitFillOption(ctx *grammar.FillOptionContext) {
+	switch {
+	case ctx.T_NULL() != nil:
+		q.fill = stmt.NullFill
+	case ctx.T_PREVIOUS() != nil:
+		q.fill = stmt.PreviousFill
+	case ctx.L_INT() != nil || ctx.L_DEC() != nil:
+		value, err := strconv.ParseFloat(ctx.GetText(), 64)
+		if err != nil {
+			q.err = err
+			return
+		}
+		q.fill = stmt.ValueFill
+		q.fillValue = value
+	}
+}
+
+// visitHavingClause visits when production having clause expression is entered.
+func (q *queryStmtParser) visitHavingClause(_ *grammar.HavingClauseContext) {
+	q.hasHaving = true
+	q.resetExprStack()
+}
+
+// completeHavingClause completes having clause parse.
+func (q *queryStmtParser) completeHavingClause(_ *grammar.HavingClauseContext) {
+	q.hasHaving = false
+}
+
+// visitBoolExpr visits when production bool expression is entered.
+func (q *queryStmtParser) visitBoolExpr(ctx *grammar.BoolExprContext) {
+	switch {
+	case ctx.T_OPEN_P() != nil:
+		q.exprStack.Push(&stmt.ParenExpr{})
+	case ctx.BoolExprLogicalOp() != nil:
+		logicalOp, ok := ctx.BoolExprLogicalOp().(*grammar.BoolExprLogicalOpContext)
+		if !ok {
+			return
+		}
+		if logicalOp.T_OR() != nil {
+			q.exprStack.Push(&stmt.BinaryExpr{Operator: stmt.OR})
+		} else {
+			q.exprStack.Push(&stmt.BinaryExpr{Operator: stmt.AND})
+		}
+	}
+}
+
+// completeBoolExpr completes a bool expression for having condition.
+func (q *queryStmtParser) completeBoolExpr(ctx *grammar.BoolExprContext) {
+	if ctx.T_OPEN_P() == nil && ctx.BoolExprLogicalOp() == nil {
+		// bool expr atom completed by binary expr
+		return
+	}
+	q.completeHavingExpr()
+}
+
+// visitBinaryExpr visits when production binary(compare) expression is entered.
+func (q *queryStmtParser) visitBinaryExpr(ctx *grammar.BinaryExprContext) {
+	binaryOp, ok := ctx.BinaryOperator().(*grammar.BinaryOperatorContext)
+	if !ok {
+		return
+	}
+	var op stmt.BinaryOP
+	switch {
+	case binaryOp.T_EQUAL() != nil:
+		op = stmt.EQUAL
+	case binaryOp.T_NOTEQUAL() != nil || binaryOp.T_NOTEQUAL2() != nil:
+		op = stmt.NOTEQUAL
+	case binaryOp.T_LESS() != nil:
+		op = stmt.LESS
+	case binaryOp.T_LESSEQUAL() != nil:
+		op = stmt.LESSEQUAL
+	case binaryOp.T_GREATER() != nil:
+		op = stmt.GREATER
+	case binaryOp.T_GREATEREQUAL() != nil:
+		op = stmt.GREATEREQUAL
+	default:
+		q.err = fmt.Errorf("having clause not support operator: %s", binaryOp.GetText())
+		op = stmt.UNKNOWN
+	}
+	q.exprStack.Push(&stmt.BinaryExpr{Operator: op})
+}
+
+// completeBinaryExpr completes a binary(compare) expression for having condition.
+func (q *queryStmtParser) completeBinaryExpr(_ *grammar.BinaryExprContext) {
+	q.completeHavingExpr()
+}
+
+// completeHavingExpr pops the completed expr, sets it as parent's param or having condition.
+func (q *queryStmtParser) completeHavingExpr() {
+	cur := q.exprStack.Pop()
+	expr, ok := cur.(stmt.Expr)
+	if !ok {
+		return
+	}
+	if q.exprStack.Empty() {
+		q.having = expr
+		return
+	}
+	q.setExprParam(expr)
+}
+
 // visitSortField visits when production sort field expression is entered.
 func (q *queryStmtParser) visitSortField(ctx *grammar.SortFieldContext) {
 	q.hasOrderBy = true
@@ -167,87 +320,17 @@ func (q *queryStmtParser) check() error {
 	return nil
 }
 
-// visitTimeRangeExpr visits when production timeRange expression is entered.
-func (q *queryStmtParser) visitTimeRangeExpr(ctx *grammar.TimeRangeExprContext) {
-	timeExprCtxList := ctx.AllTimeExpr()
-	for _, timeExpr := range timeExprCtxList {
-		timeExprCtx, ok := timeExpr.(*grammar.TimeExprContext)
-		if !ok {
-			continue
-		}
-		var timestamp int64
-		var err error
-		switch {
-		case timeExprCtx.Ident() != nil:
-			timestamp, err = timeutil.ParseTimestamp(strutil.GetStringValue(timeExprCtx.Ident().GetText()))
-		case timeExprCtx.NowExpr() != nil:
-			timestamp = timeutil.Now()
-			durationExpr, durationExist := timeExprCtx.NowExpr().(*grammar.NowExprContext)
-			if durationExist {
-				timestamp += q.parseDuration(durationExpr.DurationLit())
-			}
-		}
-		if err != nil {
-			q.err = err
-			continue
-		}
-		binaryOp := timeExprCtx.BinaryOperator()
-		if binaryOp == nil {
-			continue
-		}
-		binaryOpCtx, ok := binaryOp.(*grammar.BinaryOperatorContext)
-		if !ok {
-			continue
-		}
-		if binaryOpCtx.T_GREATER() != nil || binaryOpCtx.T_GREATEREQUAL() != nil {
-			q.startTime = timestamp
-		}
-		if binaryOpCtx.T_LESS() != nil || binaryOpCtx.T_LESSEQUAL() != nil {
-			q.endTime = timestamp
-		}
-	}
+// visitOffsetClause visits when production offset clause is entered
+func (q *queryStmtParser) visitOffsetClause(ctx *grammar.OffsetClauseContext) {
+	q.offset = q.parseDuration(ctx.DurationLit())
 }
 
-// parseDuration parses time duration from duration string
-func (q *queryStmtParser) parseDuration(ctx grammar.IDurationLitContext) int64 {
-	if ctx == nil {
-		return 0
-	}
-	durationCtx, ok := ctx.(*grammar.DurationLitContext)
-	if !ok {
-		return 0
-	}
-
-	duration, err := strconv.ParseInt(durationCtx.IntNumber().GetText(), 10, 64)
-	if err != nil {
-		q.err = err
-		return 0
-	}
-	var result int64
-	if durationCtx.IntervalItem() == nil {
-		return result
-	}
-	unit, ok := durationCtx.IntervalItem().(*grammar.IntervalItemContext)
-	if !ok {
-		return result
+// visitMetricOffset visits when production metric offset is entered
+func (q *queryStmtParser) visitMetricOffset(ctx *grammar.MetricOffsetContext) {
+	if len(q.sources) == 0 {
+		return
 	}
-	switch {
-	case unit.T_SECOND() != nil:
-		result = duration * timeutil.OneSecond
-	case unit.T_MINUTE() != nil:
-		result = duration * timeutil.OneMinute
-	case unit.T_HOUR() != nil:
-		result = duration * timeutil.OneHour
-	case unit.T_DAY() != nil:
-		result = duration * timeutil.OneDay
-	case unit.T_WEEK() != nil:
-		result = duration * timeutil.OneWeek
-	case unit.T_MONTH() != nil:
-		result = duration * timeutil.OneMonth
-	case unit.T_YEAR() != nil:
-		result = duration * timeutil.OneYear
-	}
-	return result
+	q.sources[len(q.sources)-1].Offset = q.parseDuration(ctx.DurationLit())
 }
 
 // visitFieldExpr visits when production field expression is entered
@@ -265,6 +348,11 @@ func (q *queryStmtParser) visitFieldExpr(ctx *grammar.FieldExprContext) {
 		q.exprStack.Push(&stmt.BinaryExpr{Operator: stmt.ADD})
 	case ctx.T_SUB() != nil:
 		q.exprStack.Push(&stmt.BinaryExpr{Operator: stmt.SUB})
+	case ctx.DurationLit() != nil:
+		// duration as function param, e.g. timeshift(f, 1d)
+		if !q.exprStack.Empty() {
+			q.setExprParam(&stmt.DurationLiteral{Val: q.parseDuration(ctx.DurationLit())})
+		}
 	}
 }
 
@@ -310,6 +398,24 @@ func (q *queryStmtParser) visitFuncName(ctx *grammar.FuncNameContext) {
 		callExpr.FuncType = function.Quantile
 	case ctx.T_RATE() != nil:
 		callExpr.FuncType = function.Rate
+	case ctx.T_PERCENTILE() != nil:
+		callExpr.FuncType = function.Percentile
+	case ctx.T_INCREASE() != nil:
+		callExpr.FuncType = function.Increase
+	case ctx.T_DERIVATIVE() != nil:
+		callExpr.FuncType = function.Derivative
+	case ctx.T_DELTA() != nil:
+		callExpr.FuncType = function.Delta
+	case ctx.T_MOVING_AVERAGE() != nil:
+		callExpr.FuncType = function.MovingAverage
+	case ctx.T_ABS() != nil:
+		callExpr.FuncType = function.Abs
+	case ctx.T_CLAMP_MIN() != nil:
+		callExpr.FuncType = function.ClampMin
+	case ctx.T_CLAMP_MAX() != nil:
+		callExpr.FuncType = function.ClampMax
+	case ctx.T_TIMESHIFT() != nil:
+		callExpr.FuncType = function.TimeShift
 	}
 }
 
@@ -367,6 +473,8 @@ func (q *queryStmtParser) parseFieldName(fieldName string) {
 		} else {
 			q.setExprParam(fieldExpr)
 		}
+	case q.hasHaving: // handle having condition item
+		q.setExprParam(fieldExpr)
 	default: // handle select item
 		if q.exprStack.Empty() {
 			q.selectItems = append(q.selectItems, &stmt.SelectItem{Expr: fieldExpr})
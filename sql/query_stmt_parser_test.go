@@ -153,6 +153,48 @@ func TestSelectFuncItem(t *testing.T) {
 		Expr: &stmt.CallExpr{FuncType: function.Rate, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
 	}, *selectItem)
 
+	sql = "select percentile(f, 99) as p99 from memory"
+	q, err = Parse(sql)
+	query = q.(*stmt.Query)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(query.SelectItems))
+	selectItem = (query.SelectItems[0]).(*stmt.SelectItem)
+	assert.Equal(t, stmt.SelectItem{
+		Expr: &stmt.CallExpr{FuncType: function.Percentile, Params: []stmt.Expr{
+			&stmt.FieldExpr{Name: "f"}, &stmt.NumberLiteral{Val: 99},
+		}},
+		Alias: "p99",
+	}, *selectItem)
+
+	sql = "select timeshift(derivative(f), 1d), moving_average(f, 5), clamp_max(abs(f), 10) from memory"
+	q, err = Parse(sql)
+	query = q.(*stmt.Query)
+	assert.Nil(t, err)
+	assert.Equal(t, []stmt.Expr{
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.TimeShift, Params: []stmt.Expr{
+			&stmt.CallExpr{FuncType: function.Derivative, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+			&stmt.DurationLiteral{Val: timeutil.OneDay},
+		}}},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.MovingAverage, Params: []stmt.Expr{
+			&stmt.FieldExpr{Name: "f"}, &stmt.NumberLiteral{Val: 5},
+		}}},
+		&stmt.SelectItem{Expr: &stmt.CallExpr{FuncType: function.ClampMax, Params: []stmt.Expr{
+			&stmt.CallExpr{FuncType: function.Abs, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}},
+			&stmt.NumberLiteral{Val: 10},
+		}}},
+	}, query.SelectItems)
+
+	for sql, funcType := range map[string]function.FuncType{
+		"select increase(f) from memory":    function.Increase,
+		"select delta(f) from memory":       function.Delta,
+		"select clamp_min(f,0) from memory": function.ClampMin,
+	} {
+		q, err = Parse(sql)
+		assert.NoError(t, err)
+		query = q.(*stmt.Query)
+		assert.Equal(t, funcType, query.SelectItems[0].(*stmt.SelectItem).Expr.(*stmt.CallExpr).FuncType)
+	}
+
 	sql = "select last(f) from memory"
 	q, err = Parse(sql)
 	query = q.(*stmt.Query)
@@ -339,6 +381,7 @@ func TestLimit(t *testing.T) {
 	query := q.(*stmt.Query)
 	assert.Nil(t, err)
 	assert.Equal(t, 10, query.Limit)
+	assert.True(t, query.ExplicitLimit)
 
 	sql = "select f from cpu limit abc"
 	_, err = Parse(sql)
@@ -350,6 +393,16 @@ func TestLimit(t *testing.T) {
 	query = q.(*stmt.Query)
 	assert.Nil(t, err)
 	assert.Equal(t, 20, query.Limit)
+	assert.False(t, query.ExplicitLimit)
+
+	// identifier named limit isn't limit clause
+	sql = "select sum(limit) as limit from limit group by limit order by limit desc"
+	q, err = Parse(sql)
+	assert.Nil(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, "limit", query.MetricName)
+	assert.Equal(t, 20, query.Limit)
+	assert.False(t, query.ExplicitLimit)
 }
 
 func TestTimeRange(t *testing.T) {
@@ -369,6 +422,37 @@ func TestTimeRange(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestOffset(t *testing.T) {
+	q, err := Parse("select count from http where time > now()-1h offset 7d")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, 7*timeutil.OneDay, query.Offset)
+
+	q, err = Parse("select count from http offset 1h group by host")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, timeutil.OneHour, query.Offset)
+	assert.Equal(t, []string{"host"}, query.GroupBy)
+
+	// offset of single metric
+	q, err = Parse("select count from http offset 1d as yesterday offset 1h")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, timeutil.OneDay+timeutil.OneHour, query.Offset)
+	assert.Empty(t, query.Sources)
+
+	// offset of metric for cross metric query
+	q, err = Parse("select today.count - last_week.count from http as today, http offset 7d as last_week " +
+		"where time > now()-1h group by host")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, int64(0), query.Offset)
+	assert.Equal(t, []*stmt.MetricSource{
+		{MetricName: "http", Alias: "today"},
+		{MetricName: "http", Alias: "last_week", Offset: 7 * timeutil.OneDay},
+	}, query.Sources)
+}
+
 func TestInterval(t *testing.T) {
 	sql := "select f from cpu where region='sh'"
 	q, err := Parse(sql)
@@ -422,6 +506,29 @@ func TestGroupBy(t *testing.T) {
 	assert.Equal(t, "/data", query.GroupBy[1])
 }
 
+func TestFill(t *testing.T) {
+	cases := []struct {
+		sql       string
+		fill      stmt.FillOption
+		fillValue float64
+	}{
+		{sql: "select f from cpu group by host", fill: stmt.NoFill},
+		{sql: "select f from cpu group by host,time(1m) fill(null)", fill: stmt.NullFill},
+		{sql: "select f from cpu group by time(1m) fill(previous)", fill: stmt.PreviousFill},
+		{sql: "select f from cpu group by time(1m) fill(0)", fill: stmt.ValueFill, fillValue: 0},
+		{sql: "select f from cpu group by host fill(10.5) having f > 1", fill: stmt.ValueFill, fillValue: 10.5},
+		{sql: "select f from cpu group by time(1m) fill(-1)", fill: stmt.ValueFill, fillValue: -1},
+		{sql: "select f from cpu group by host fill(-0.5)", fill: stmt.ValueFill, fillValue: -0.5},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		query := q.(*stmt.Query)
+		assert.Equal(t, tt.fill, query.Fill, tt.sql)
+		assert.Equal(t, tt.fillValue, query.FillValue, tt.sql)
+	}
+}
+
 func TestEmptyCondition(t *testing.T) {
 	sql := "select f from cpu"
 	q, err := Parse(sql)
@@ -469,6 +576,61 @@ func TestLikeExpr(t *testing.T) {
 	assert.Equal(t, stmt.NotExpr{Expr: &stmt.LikeExpr{Key: "ip", Value: "1.1.%.1"}}, *notExpr)
 }
 
+func TestCompareExpr(t *testing.T) {
+	cases := []struct {
+		sql string
+		op  stmt.BinaryOP
+	}{
+		{sql: "select f from cpu where version<'1.2.0'", op: stmt.LESS},
+		{sql: "select f from cpu where version<='1.2.0'", op: stmt.LESSEQUAL},
+		{sql: "select f from cpu where version>'1.2.0'", op: stmt.GREATER},
+		{sql: "select f from cpu where version>='1.2.0'", op: stmt.GREATEREQUAL},
+	}
+	for _, c := range cases {
+		q, err := Parse(c.sql)
+		assert.NoError(t, err)
+		query := q.(*stmt.Query)
+		expr := query.Condition.(*stmt.CompareExpr)
+		assert.Equal(t, stmt.CompareExpr{Key: "version", Operator: c.op, Value: "1.2.0"}, *expr)
+	}
+
+	// with time range
+	q, err := Parse("select f from cpu where build>'10' and time>now()-1h and time<now()")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, stmt.CompareExpr{Key: "build", Operator: stmt.GREATER, Value: "10"}, *query.Condition.(*stmt.CompareExpr))
+	assert.True(t, query.TimeRange.End > query.TimeRange.Start)
+
+	// time is not a range tag key
+	_, err = Parse("select f from cpu where time>'10' and host='a'")
+	assert.Error(t, err)
+
+	// number without quote
+	numberCases := []struct {
+		sql  string
+		expr stmt.Expr
+	}{
+		{sql: "select f from cpu where status_code>=500", expr: &stmt.CompareExpr{Key: "status_code", Operator: stmt.GREATEREQUAL, Value: "500"}},
+		{sql: "select f from cpu where temp<-1.5", expr: &stmt.CompareExpr{Key: "temp", Operator: stmt.LESS, Value: "-1.5"}},
+		{sql: "select f from cpu where status_code between 500 and 599", expr: &stmt.BetweenExpr{Key: "status_code", Start: "500", End: "599"}},
+		{sql: "select f from cpu where status_code=500", expr: &stmt.EqualsExpr{Key: "status_code", Value: "500"}},
+	}
+	for _, c := range numberCases {
+		q, err = Parse(c.sql)
+		assert.NoError(t, err, c.sql)
+		assert.Equal(t, c.expr, q.(*stmt.Query).Condition, c.sql)
+	}
+}
+
+func TestBetweenExpr(t *testing.T) {
+	q, err := Parse("select f from cpu where version between 'v1.2.0' and 'v1.10.0' and host='a'")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	expr := query.Condition.(*stmt.BinaryExpr)
+	assert.Equal(t, &stmt.BetweenExpr{Key: "version", Start: "v1.2.0", End: "v1.10.0"}, expr.Left)
+	assert.Equal(t, &stmt.EqualsExpr{Key: "host", Value: "a"}, expr.Right)
+}
+
 func TestRegexExpr(t *testing.T) {
 	sql := "select f from cpu where ip=~'/1.1.*.1/'"
 	q, _ := Parse(sql)
@@ -667,3 +829,124 @@ func TestOrderBy(t *testing.T) {
 		})
 	}
 }
+
+func TestHaving(t *testing.T) {
+	avgF := &stmt.CallExpr{FuncType: function.Avg, Params: []stmt.Expr{&stmt.FieldExpr{Name: "f"}}}
+	cases := []struct {
+		name    string
+		sql     string
+		having  stmt.Expr
+		wantErr bool
+	}{
+		{
+			name: "no having",
+			sql:  "select f from cpu group by host",
+		},
+		{
+			name:   "compare function with number",
+			sql:    "select avg(f) from cpu group by host having avg(f) > 100",
+			having: &stmt.BinaryExpr{Left: avgF, Operator: stmt.GREATER, Right: &stmt.NumberLiteral{Val: 100}},
+		},
+		{
+			name: "compare field with math expr",
+			sql:  "select f from cpu group by host having f <= 2*10",
+			having: &stmt.BinaryExpr{
+				Left:     &stmt.FieldExpr{Name: "f"},
+				Operator: stmt.LESSEQUAL,
+				Right: &stmt.BinaryExpr{
+					Left:     &stmt.NumberLiteral{Val: 2},
+					Operator: stmt.MUL,
+					Right:    &stmt.NumberLiteral{Val: 10},
+				},
+			},
+		},
+		{
+			name: "logical and/or with paren",
+			sql:  "select f from cpu group by host having (avg(f) >= 1 or f != 2) and f = 3 order by f limit 10",
+			having: &stmt.BinaryExpr{
+				Left: &stmt.ParenExpr{Expr: &stmt.BinaryExpr{
+					Left:     &stmt.BinaryExpr{Left: avgF, Operator: stmt.GREATEREQUAL, Right: &stmt.NumberLiteral{Val: 1}},
+					Operator: stmt.OR,
+					Right:    &stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "f"}, Operator: stmt.NOTEQUAL, Right: &stmt.NumberLiteral{Val: 2}},
+				}},
+				Operator: stmt.AND,
+				Right:    &stmt.BinaryExpr{Left: &stmt.FieldExpr{Name: "f"}, Operator: stmt.EQUAL, Right: &stmt.NumberLiteral{Val: 3}},
+			},
+		},
+		{
+			name:    "having field not in select list",
+			sql:     "select f from cpu group by host having b < 1 order by b",
+			wantErr: true,
+		},
+		{
+			name:    "operator not support",
+			sql:     "select f from cpu group by host having f like 1",
+			wantErr: true,
+		},
+		{
+			name:    "having without group by tag keys",
+			sql:     "select f from cpu group by time(1m) having f < 1",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(tt.sql)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				query := q.(*stmt.Query)
+				assert.Equal(t, tt.having, query.Having)
+			}
+		})
+	}
+}
+
+func TestQuery_CrossMetric(t *testing.T) {
+	q, err := Parse("select e.count/r.count as error_rate from errors as e, 'http.requests' as r " +
+		"where app='a' group by host")
+	assert.NoError(t, err)
+	query := q.(*stmt.Query)
+	assert.Equal(t, "errors", query.MetricName)
+	assert.Equal(t, []*stmt.MetricSource{
+		{MetricName: "errors", Alias: "e"},
+		{MetricName: "http.requests", Alias: "r"},
+	}, query.Sources)
+	assert.True(t, query.IsCrossMetric())
+	assert.Equal(t, &stmt.SelectItem{
+		Expr: &stmt.BinaryExpr{
+			Left:     &stmt.FieldExpr{Name: "e.count"},
+			Operator: stmt.DIV,
+			Right:    &stmt.FieldExpr{Name: "r.count"},
+		},
+		Alias: "error_rate",
+	}, query.SelectItems[0])
+	assert.Equal(t, []string{"host"}, query.GroupBy)
+
+	// qualified by metric name
+	q, err = Parse("select sum(errors.count)/sum(requests.count) from errors, requests")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, []*stmt.MetricSource{{MetricName: "errors"}, {MetricName: "requests"}}, query.Sources)
+
+	// single metric with alias
+	q, err = Parse("select f from cpu as c")
+	assert.NoError(t, err)
+	query = q.(*stmt.Query)
+	assert.Equal(t, "cpu", query.MetricName)
+	assert.False(t, query.IsCrossMetric())
+
+	cases := []string{
+		"select e.count/count from errors as e, requests as r",
+		"select e.count from errors as e, requests as e",
+		"select e.count from errors as e, requests as r group by host having e.count > 1",
+		"select e.count from errors as e, requests as r order by e.count",
+	}
+	for _, sql := range cases {
+		_, err = Parse(sql)
+		assert.Error(t, err, sql)
+	}
+}
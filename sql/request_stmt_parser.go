@@ -35,6 +35,11 @@ func newRequestStmtParse() *requestStmtParser {
 	}
 }
 
+// visitKillQuery visits when production kill query expression is entered.
+func (s *requestStmtParser) visitKillQuery() {
+	s.request.Kill = true
+}
+
 // visitRequestID visits when production request id expression is entered.
 func (s *requestStmtParser) visitRequestID(ctx *grammar.RequestIDContext) {
 	s.request.RequestID = strutil.GetStringValue(ctx.GetText())
@@ -33,4 +33,11 @@ func TestRequestStmt(t *testing.T) {
 	q, err = Parse("show request where id='xxx'")
 	assert.NoError(t, err)
 	assert.Equal(t, &stmt.Request{RequestID: "xxx"}, q)
+
+	q, err = Parse("kill query 'xxx'")
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Request{RequestID: "xxx", Kill: true}, q)
+
+	_, err = Parse("kill query")
+	assert.Error(t, err)
 }
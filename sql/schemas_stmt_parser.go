@@ -40,7 +40,12 @@ func (s *schemasStmtParser) visitCfg(ctx *grammar.JsonContext) {
 
 // visitDropDatabase visits when production database name expression is entered.
 func (s *schemasStmtParser) visitDatabaseName(ctx *grammar.DatabaseNameContext) {
-	s.schema.Value = strutil.GetStringValue(ctx.GetText())
+	name := strutil.GetStringValue(ctx.GetText())
+	if s.schema.Type == stmt.AlterDatabaseSchemaType {
+		s.schema.Name = name
+		return
+	}
+	s.schema.Value = name
 }
 
 // build returns the state statement.
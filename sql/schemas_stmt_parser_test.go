@@ -47,3 +47,20 @@ func TestCreateDatabase(t *testing.T) {
 		Value: `{"name":"test"}`,
 	}, q)
 }
+
+func TestAlterDatabase(t *testing.T) {
+	q, err := Parse(`alter database 'test' with {\"intervals\":[{\"interval\":\"10s\",\"retention\":\"7d\"}]}`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Schema{
+		Type:  stmt.AlterDatabaseSchemaType,
+		Name:  "test",
+		Value: `{"intervals":[{"interval":"10s","retention":"7d"}]}`,
+	}, q)
+	q, err = Parse(`alter database test with {\"ahead\":\"2h\"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, &stmt.Schema{
+		Type:  stmt.AlterDatabaseSchemaType,
+		Name:  "test",
+		Value: `{"ahead":"2h"}`,
+	}, q)
+}
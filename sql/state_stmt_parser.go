@@ -18,6 +18,8 @@
 package sql
 
 import (
+	"strconv"
+
 	"github.com/lindb/lindb/pkg/strutil"
 	"github.com/lindb/lindb/sql/grammar"
 	"github.com/lindb/lindb/sql/stmt"
@@ -26,6 +28,7 @@ import (
 // stateStmtParser represents show state statement parser.
 type stateStmtParser struct {
 	state *stmt.State
+	err   error
 }
 
 // newStateStmtParse creates a show state statement parser.
@@ -53,7 +56,23 @@ func (s *stateStmtParser) visitMetricList(ctx *grammar.MetricListContext) {
 	}
 }
 
+// visitLimit visits limit clause.
+func (s *stateStmtParser) visitLimit(ctx *grammar.LimitClauseContext) {
+	if ctx.L_INT() == nil {
+		return
+	}
+	limit, err := strconv.ParseInt(ctx.L_INT().GetText(), 10, 32)
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.state.Limit = int(limit)
+}
+
 // build returns the state statement.
 func (s *stateStmtParser) build() (stmt.Statement, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
 	return s.state, nil
 }
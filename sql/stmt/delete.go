@@ -0,0 +1,81 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+import (
+	"encoding/json"
+
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// Delete represents delete series statement, deletes the data of series which match tag filter in time range.
+type Delete struct {
+	Namespace  string             // namespace
+	MetricName string             // like table name
+	Condition  Expr               // tag filter condition expression, deletes all series of metric if nil
+	TimeRange  timeutil.TimeRange // delete time range, start is 0 if without lower bound
+}
+
+// StatementType returns delete statement type.
+func (q *Delete) StatementType() StatementType {
+	return DeleteStatement
+}
+
+// IsWholeHistory returns if it deletes the whole history of series(without lower bound of time range).
+func (q *Delete) IsWholeHistory() bool {
+	return q.TimeRange.Start <= 0
+}
+
+// innerDelete represents a wrapper of delete for json encoding
+type innerDelete struct {
+	Namespace  string             `json:"namespace,omitempty"`
+	MetricName string             `json:"metricName,omitempty"`
+	Condition  json.RawMessage    `json:"condition,omitempty"`
+	TimeRange  timeutil.TimeRange `json:"timeRange,omitempty"`
+}
+
+// MarshalJSON returns json data of delete
+func (q *Delete) MarshalJSON() ([]byte, error) {
+	inner := innerDelete{
+		Namespace:  q.Namespace,
+		MetricName: q.MetricName,
+		Condition:  Marshal(q.Condition),
+		TimeRange:  q.TimeRange,
+	}
+	return encoding.JSONMarshal(&inner), nil
+}
+
+// UnmarshalJSON parses json data to delete
+func (q *Delete) UnmarshalJSON(value []byte) error {
+	inner := innerDelete{}
+	if err := encoding.JSONUnmarshal(value, &inner); err != nil {
+		return err
+	}
+	if inner.Condition != nil {
+		condition, err := Unmarshal(inner.Condition)
+		if err != nil {
+			return err
+		}
+		q.Condition = condition
+	}
+	q.Namespace = inner.Namespace
+	q.MetricName = inner.MetricName
+	q.TimeRange = inner.TimeRange
+	return nil
+}
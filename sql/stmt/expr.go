@@ -39,6 +39,7 @@ import (
 
 	"github.com/lindb/lindb/aggregation/function"
 	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 //go:generate mockgen -source ./expr.go -destination=./expr_mock.go -package=stmt
@@ -83,6 +84,14 @@ type TagFilter interface {
 	TagKey() string
 }
 
+// RangeTagFilter represents tag filter which matches tag values in a range,
+// tag values are compared as number or semantic version
+type RangeTagFilter interface {
+	TagFilter
+	// ValueRange returns the tag value range, nil if bound cannot be ordered
+	ValueRange() *TagValueRange
+}
+
 // SelectItem represents a select item from select statement
 type SelectItem struct {
 	Expr  Expr
@@ -105,6 +114,11 @@ type NumberLiteral struct {
 	Val float64 `json:"val"`
 }
 
+// DurationLiteral represents a time duration(milliseconds), e.g. 1h.
+type DurationLiteral struct {
+	Val int64 `json:"val"`
+}
+
 // CallExpr represents a function call expression
 type CallExpr struct {
 	FuncType function.FuncType
@@ -161,6 +175,20 @@ type RegexExpr struct {
 	Regexp string `json:"regexp"`
 }
 
+// CompareExpr represents a numeric/semantic version comparison expression(<,<=,>,>=)
+type CompareExpr struct {
+	Key      string   `json:"key"`
+	Operator BinaryOP `json:"operator"`
+	Value    string   `json:"value"`
+}
+
+// BetweenExpr represents a numeric/semantic version between expression(both bounds are inclusive)
+type BetweenExpr struct {
+	Key   string `json:"key"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
 // NotExpr represents a not expression
 type NotExpr struct {
 	Expr Expr
@@ -198,6 +226,24 @@ func (e *NumberLiteral) Rewrite() string {
 	return fmt.Sprintf("%.2f", e.Val)
 }
 
+// Rewrite rewrites the duration literal after parse
+func (e *DurationLiteral) Rewrite() string {
+	switch {
+	case e.Val == 0:
+		return "0s"
+	case e.Val%timeutil.OneDay == 0:
+		return fmt.Sprintf("%dd", e.Val/timeutil.OneDay)
+	case e.Val%timeutil.OneHour == 0:
+		return fmt.Sprintf("%dh", e.Val/timeutil.OneHour)
+	case e.Val%timeutil.OneMinute == 0:
+		return fmt.Sprintf("%dm", e.Val/timeutil.OneMinute)
+	case e.Val%timeutil.OneSecond == 0:
+		return fmt.Sprintf("%ds", e.Val/timeutil.OneSecond)
+	default:
+		return fmt.Sprintf("%dms", e.Val)
+	}
+}
+
 // Rewrite rewrites the binary expr after parse
 func (e *BinaryExpr) Rewrite() string {
 	return fmt.Sprintf("%s%s%s", e.Left.Rewrite(), BinaryOPString(e.Operator), e.Right.Rewrite())
@@ -228,6 +274,35 @@ func (e *RegexExpr) Rewrite() string {
 	return fmt.Sprintf("%s=~%s", e.Key, e.Regexp)
 }
 
+// Rewrite rewrites the compare expr after parse
+func (e *CompareExpr) Rewrite() string {
+	return fmt.Sprintf("%s%s%s", e.Key, BinaryOPString(e.Operator), e.Value)
+}
+
+// Rewrite rewrites the between expr after parse
+func (e *BetweenExpr) Rewrite() string {
+	return fmt.Sprintf("%s between %s and %s", e.Key, e.Start, e.End)
+}
+
+// WalkFieldExpr walks all field exprs of select item/call/paren/binary expr via fn
+func WalkFieldExpr(expr Expr, fn func(fieldExpr *FieldExpr)) {
+	switch e := expr.(type) {
+	case *FieldExpr:
+		fn(e)
+	case *SelectItem:
+		WalkFieldExpr(e.Expr, fn)
+	case *CallExpr:
+		for _, param := range e.Params {
+			WalkFieldExpr(param, fn)
+		}
+	case *ParenExpr:
+		WalkFieldExpr(e.Expr, fn)
+	case *BinaryExpr:
+		WalkFieldExpr(e.Left, fn)
+		WalkFieldExpr(e.Right, fn)
+	}
+}
+
 // Marshal returns json of expr using custom json marshal
 func Marshal(expr Expr) []byte {
 	switch e := expr.(type) {
@@ -235,12 +310,18 @@ func Marshal(expr Expr) []byte {
 		return encoding.JSONMarshal(&exprData{Type: "regex", Expr: encoding.JSONMarshal(expr)})
 	case *LikeExpr:
 		return encoding.JSONMarshal(&exprData{Type: "like", Expr: encoding.JSONMarshal(expr)})
+	case *CompareExpr:
+		return encoding.JSONMarshal(&exprData{Type: "compare", Expr: encoding.JSONMarshal(expr)})
+	case *BetweenExpr:
+		return encoding.JSONMarshal(&exprData{Type: "between", Expr: encoding.JSONMarshal(expr)})
 	case *InExpr:
 		return encoding.JSONMarshal(&exprData{Type: "in", Expr: encoding.JSONMarshal(expr)})
 	case *EqualsExpr:
 		return encoding.JSONMarshal(&exprData{Type: "equals", Expr: encoding.JSONMarshal(expr)})
 	case *NumberLiteral:
 		return encoding.JSONMarshal(&exprData{Type: "number", Expr: encoding.JSONMarshal(expr)})
+	case *DurationLiteral:
+		return encoding.JSONMarshal(&exprData{Type: "duration", Expr: encoding.JSONMarshal(expr)})
 	case *FieldExpr:
 		return encoding.JSONMarshal(&exprData{Type: "field", Expr: encoding.JSONMarshal(expr)})
 	case *NotExpr:
@@ -299,12 +380,18 @@ func Unmarshal(value []byte) (Expr, error) {
 		return unmarshal(&expr, &RegexExpr{})
 	case "like":
 		return unmarshal(&expr, &LikeExpr{})
+	case "compare":
+		return unmarshal(&expr, &CompareExpr{})
+	case "between":
+		return unmarshal(&expr, &BetweenExpr{})
 	case "in":
 		return unmarshal(&expr, &InExpr{})
 	case "equals":
 		return unmarshal(&expr, &EqualsExpr{})
 	case "number":
 		return unmarshal(&expr, &NumberLiteral{})
+	case "duration":
+		return unmarshal(&expr, &DurationLiteral{})
 	case field:
 		return unmarshal(&expr, &FieldExpr{})
 	case "paren":
@@ -420,3 +507,26 @@ func (e *LikeExpr) TagKey() string { return e.Key }
 
 // TagKey returns the regex filter's tag key
 func (e *RegexExpr) TagKey() string { return e.Key }
+
+// TagKey returns the compare filter's tag key
+func (e *CompareExpr) TagKey() string { return e.Key }
+
+// TagKey returns the between filter's tag key
+func (e *BetweenExpr) TagKey() string { return e.Key }
+
+// ValueRange returns the tag value range of compare filter, nil if value cannot be ordered
+func (e *CompareExpr) ValueRange() *TagValueRange {
+	switch e.Operator {
+	case LESS, LESSEQUAL:
+		return newTagValueRange("", e.Value, false, true, false, e.Operator == LESSEQUAL)
+	case GREATER, GREATEREQUAL:
+		return newTagValueRange(e.Value, "", true, false, e.Operator == GREATEREQUAL, false)
+	default:
+		return nil
+	}
+}
+
+// ValueRange returns the tag value range of between filter, nil if value cannot be ordered
+func (e *BetweenExpr) ValueRange() *TagValueRange {
+	return newTagValueRange(e.Start, e.End, true, true, true, true)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/lindb/lindb/aggregation/function"
+	"github.com/lindb/lindb/pkg/timeutil"
 )
 
 func TestExpr_Rewrite(t *testing.T) {
@@ -63,6 +64,9 @@ func TestExpr_Rewrite(t *testing.T) {
 
 	assert.Equal(t, "tagKey=~Regexp", (&RegexExpr{Key: "tagKey", Regexp: "Regexp"}).Rewrite())
 
+	assert.Equal(t, "tagKey>=1.2", (&CompareExpr{Key: "tagKey", Operator: GREATEREQUAL, Value: "1.2"}).Rewrite())
+	assert.Equal(t, "tagKey between 1 and 2", (&BetweenExpr{Key: "tagKey", Start: "1", End: "2"}).Rewrite())
+
 	assert.Equal(t, "f desc", (&OrderByExpr{Expr: &FieldExpr{Name: "f"}, Desc: true}).Rewrite())
 	assert.Equal(t, "max(f) asc", (&OrderByExpr{Expr: &CallExpr{FuncType: function.Max, Params: []Expr{&FieldExpr{Name: "f"}}}}).Rewrite())
 }
@@ -72,6 +76,8 @@ func TestTagFilter(t *testing.T) {
 	assert.Equal(t, "tagKey", (&LikeExpr{Key: "tagKey", Value: "tagValue"}).TagKey())
 	assert.Equal(t, "tagKey", (&InExpr{Key: "tagKey", Values: []string{"a", "b", "c"}}).TagKey())
 	assert.Equal(t, "tagKey", (&RegexExpr{Key: "tagKey", Regexp: "Regexp"}).TagKey())
+	assert.Equal(t, "tagKey", (&CompareExpr{Key: "tagKey", Operator: LESS, Value: "1"}).TagKey())
+	assert.Equal(t, "tagKey", (&BetweenExpr{Key: "tagKey", Start: "1", End: "2"}).TagKey())
 }
 
 func TestExpr_Marshal_Fail(t *testing.T) {
@@ -129,6 +135,22 @@ func TestLikeExpr_Marshal(t *testing.T) {
 	assert.Equal(t, *expr, *e)
 }
 
+func TestCompareExpr_Marshal(t *testing.T) {
+	expr := &CompareExpr{Key: "tagKey", Operator: LESSEQUAL, Value: "v1.2.3"}
+	data := Marshal(expr)
+	exprData, _ := Unmarshal(data)
+	e := exprData.(*CompareExpr)
+	assert.Equal(t, *expr, *e)
+}
+
+func TestBetweenExpr_Marshal(t *testing.T) {
+	expr := &BetweenExpr{Key: "tagKey", Start: "1", End: "10"}
+	data := Marshal(expr)
+	exprData, _ := Unmarshal(data)
+	e := exprData.(*BetweenExpr)
+	assert.Equal(t, *expr, *e)
+}
+
 func TestInExpr_Marshal(t *testing.T) {
 	expr := &InExpr{Key: "tagKey"}
 	data := Marshal(expr)
@@ -170,6 +192,24 @@ func TestNumberLiteral_Marshal(t *testing.T) {
 	assert.Equal(t, *expr, *e)
 }
 
+func TestDurationLiteral_Marshal(t *testing.T) {
+	expr := &CallExpr{FuncType: function.TimeShift, Params: []Expr{&FieldExpr{Name: "f"}, &DurationLiteral{Val: timeutil.OneHour}}}
+	data := Marshal(expr)
+	exprData, err := Unmarshal(data)
+	assert.NoError(t, err)
+	e := exprData.(*CallExpr)
+	assert.Equal(t, *expr, *e)
+}
+
+func TestDurationLiteral_Rewrite(t *testing.T) {
+	assert.Equal(t, "0s", (&DurationLiteral{}).Rewrite())
+	assert.Equal(t, "2d", (&DurationLiteral{Val: 2 * timeutil.OneDay}).Rewrite())
+	assert.Equal(t, "-3h", (&DurationLiteral{Val: -3 * timeutil.OneHour}).Rewrite())
+	assert.Equal(t, "5m", (&DurationLiteral{Val: 5 * timeutil.OneMinute}).Rewrite())
+	assert.Equal(t, "10s", (&DurationLiteral{Val: 10 * timeutil.OneSecond}).Rewrite())
+	assert.Equal(t, "10ms", (&DurationLiteral{Val: 10}).Rewrite())
+}
+
 func TestSelectItem_Marshal(t *testing.T) {
 	expr := &SelectItem{Expr: &FieldExpr{Name: "f"}, Alias: "f1"}
 	data := Marshal(expr)
@@ -232,3 +272,15 @@ func TestBinaryExpr_Marshal(t *testing.T) {
 	e := exprData.(*BinaryExpr)
 	assert.Equal(t, *expr, *e)
 }
+
+func TestWalkFieldExpr(t *testing.T) {
+	var names []string
+	WalkFieldExpr(&SelectItem{Expr: &BinaryExpr{
+		Left:     &CallExpr{FuncType: function.Sum, Params: []Expr{&FieldExpr{Name: "a"}}},
+		Operator: DIV,
+		Right:    &ParenExpr{Expr: &BinaryExpr{Left: &FieldExpr{Name: "b"}, Operator: ADD, Right: &NumberLiteral{Val: 1}}},
+	}}, func(fieldExpr *FieldExpr) {
+		names = append(names, fieldExpr.Name)
+	})
+	assert.Equal(t, []string{"a", "b"}, names)
+}
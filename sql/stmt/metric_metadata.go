@@ -33,6 +33,8 @@ const (
 	TagKey
 	TagValue
 	Field
+	MetricCardinality
+	TagCardinality
 )
 
 // String returns string value of metadata type
@@ -48,6 +50,10 @@ func (m MetricMetadataType) String() string {
 		return "tagKey"
 	case TagValue:
 		return "tagValue"
+	case MetricCardinality:
+		return "metricCardinality"
+	case TagCardinality:
+		return "tagCardinality"
 	default:
 		return unknown
 	}
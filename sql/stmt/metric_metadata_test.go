@@ -31,6 +31,8 @@ func TestMetadataType_String(t *testing.T) {
 	assert.Equal(t, "field", Field.String())
 	assert.Equal(t, "tagKey", TagKey.String())
 	assert.Equal(t, "tagValue", TagValue.String())
+	assert.Equal(t, "metricCardinality", MetricCardinality.String())
+	assert.Equal(t, "tagCardinality", TagCardinality.String())
 	assert.Equal(t, "unknown", MetricMetadataType(0).String())
 }
 
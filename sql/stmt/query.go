@@ -19,28 +19,76 @@ package stmt
 
 import (
 	"encoding/json"
+	"math"
+	"strings"
 
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/timeutil"
 )
 
+// FillOption represents the fill policy for down sampling interval without data.
+type FillOption int8
+
+const (
+	// NoFill does not fill down sampling interval without data(default).
+	NoFill FillOption = iota
+	// NullFill fills down sampling interval without data with null.
+	NullFill
+	// PreviousFill fills down sampling interval without data with previous value.
+	PreviousFill
+	// ValueFill fills down sampling interval without data with given value.
+	ValueFill
+)
+
+// MetricSource represents a metric of from clause in cross metric query,
+// fields of metric are referenced in select list as alias.field(metric name if alias is empty).
+type MetricSource struct {
+	MetricName string `json:"metricName"`
+	Alias      string `json:"alias,omitempty"`
+	Offset     int64  `json:"offset,omitempty"` // shifts query time range backward(milliseconds) for this metric
+}
+
+// Name returns the name which qualifies the field of metric.
+func (s *MetricSource) Name() string {
+	if s.Alias != "" {
+		return s.Alias
+	}
+	return s.MetricName
+}
+
+// FieldName returns the field name of metric if qualified field name references this metric.
+func (s *MetricSource) FieldName(qualifiedName string) (string, bool) {
+	prefix := s.Name() + "."
+	if !strings.HasPrefix(qualifiedName, prefix) || len(qualifiedName) == len(prefix) {
+		return "", false
+	}
+	return qualifiedName[len(prefix):], true
+}
+
 // Query represents search statement
 type Query struct {
-	Explain     bool   // need explain query execute stat
-	Namespace   string // namespace
-	MetricName  string // like table name
-	SelectItems []Expr // select list, such as field, function call, math expression etc.
-	Condition   Expr   // tag filter condition expression
+	Explain     bool            // need explain query execute stat
+	Namespace   string          // namespace
+	MetricName  string          // like table name
+	Sources     []*MetricSource // metric sources of cross metric query, empty if single metric query
+	SelectItems []Expr          // select list, such as field, function call, math expression etc.
+	Condition   Expr            // tag filter condition expression
 
 	// broker plan maybe reset
 	TimeRange       timeutil.TimeRange // query time range
+	ResultTimeRange timeutil.TimeRange // time range of result if query time range is widened for timeshift function
+	Offset          int64              // shifts time range backward(milliseconds) when query data, result is re-aligned
 	Interval        timeutil.Interval  // down sampling interval
 	IntervalRatio   int                // down sampling interval ratio
 	StorageInterval timeutil.Interval  // down sampling storage interval, data find
 
-	GroupBy      []string // group by tag keys
-	OrderByItems []Expr   // order by field expr list
-	Limit        int      // num. of time series list for result
+	GroupBy       []string   // group by tag keys
+	Fill          FillOption // fill option for down sampling interval without data
+	FillValue     float64    // fill value if fill option is value fill
+	Having        Expr       // having condition expression, filters grouped series after aggregation
+	OrderByItems  []Expr     // order by field expr list
+	Limit         int        // num. of time series list for result
+	ExplicitLimit bool       // limit set by limit clause explicitly, else default limit of parser used
 }
 
 // StatementType returns metric query type.
@@ -48,27 +96,56 @@ func (q *Query) StatementType() StatementType {
 	return QueryStatement
 }
 
+// IsCrossMetric returns whether query selects fields from multiple metrics
+func (q *Query) IsCrossMetric() bool {
+	return len(q.Sources) > 1
+}
+
 // HasGroupBy returns whether query has grouping tag keys
 func (q *Query) HasGroupBy() bool {
 	return len(q.GroupBy) > 0
 }
 
+// GetLimit returns the max num. of time series for result, unlimited if limit not set(<=0).
+func (q *Query) GetLimit() int {
+	if q.Limit <= 0 {
+		return math.MaxInt32
+	}
+	return q.Limit
+}
+
+// GetResultTimeRange returns the time range of result,
+// query time range maybe widened for reading the points shifted into result by timeshift function.
+func (q *Query) GetResultTimeRange() timeutil.TimeRange {
+	if q.ResultTimeRange == (timeutil.TimeRange{}) {
+		return q.TimeRange
+	}
+	return q.ResultTimeRange
+}
+
 // innerQuery represents a wrapper of query for json encoding
 type innerQuery struct {
 	Explain     bool              `json:"Explain,omitempty"`
 	Namespace   string            `json:"namespace,omitempty"`
 	MetricName  string            `json:"metricName,omitempty"`
+	Sources     []*MetricSource   `json:"sources,omitempty"`
 	SelectItems []json.RawMessage `json:"selectItems,omitempty"`
 	Condition   json.RawMessage   `json:"condition,omitempty"`
 
 	TimeRange       timeutil.TimeRange `json:"timeRange,omitempty"`
+	ResultTimeRange timeutil.TimeRange `json:"resultTimeRange,omitempty"`
+	Offset          int64              `json:"offset,omitempty"`
 	Interval        timeutil.Interval  `json:"interval,omitempty"`
 	IntervalRatio   int                `json:"intervalRatio,omitempty"`
 	StorageInterval timeutil.Interval  `json:"storageInterval,omitempty"`
 
-	GroupBy      []string          `json:"groupBy,omitempty"`
-	OrderByItems []json.RawMessage `json:"orderByItems,omitempty"`
-	Limit        int               `json:"limit,omitempty"`
+	GroupBy       []string          `json:"groupBy,omitempty"`
+	Fill          FillOption        `json:"fill,omitempty"`
+	FillValue     float64           `json:"fillValue,omitempty"`
+	Having        json.RawMessage   `json:"having,omitempty"`
+	OrderByItems  []json.RawMessage `json:"orderByItems,omitempty"`
+	Limit         int               `json:"limit,omitempty"`
+	ExplicitLimit bool              `json:"explicitLimit,omitempty"`
 }
 
 // MarshalJSON returns json data of query
@@ -76,14 +153,21 @@ func (q *Query) MarshalJSON() ([]byte, error) {
 	inner := innerQuery{
 		Explain:         q.Explain,
 		MetricName:      q.MetricName,
+		Sources:         q.Sources,
 		Namespace:       q.Namespace,
 		Condition:       Marshal(q.Condition),
 		TimeRange:       q.TimeRange,
+		ResultTimeRange: q.ResultTimeRange,
+		Offset:          q.Offset,
 		Interval:        q.Interval,
 		IntervalRatio:   q.IntervalRatio,
 		StorageInterval: q.StorageInterval,
 		GroupBy:         q.GroupBy,
+		Fill:            q.Fill,
+		FillValue:       q.FillValue,
+		Having:          Marshal(q.Having),
 		Limit:           q.Limit,
+		ExplicitLimit:   q.ExplicitLimit,
 	}
 	for _, item := range q.SelectItems {
 		inner.SelectItems = append(inner.SelectItems, Marshal(item))
@@ -107,6 +191,13 @@ func (q *Query) UnmarshalJSON(value []byte) error {
 		}
 		q.Condition = condition
 	}
+	if inner.Having != nil {
+		having, err := Unmarshal(inner.Having)
+		if err != nil {
+			return err
+		}
+		q.Having = having
+	}
 	// select list
 	var selectItems []Expr
 	for _, item := range inner.SelectItems {
@@ -128,14 +219,20 @@ func (q *Query) UnmarshalJSON(value []byte) error {
 
 	q.Explain = inner.Explain
 	q.MetricName = inner.MetricName
+	q.Sources = inner.Sources
 	q.Namespace = inner.Namespace
 	q.SelectItems = selectItems
 	q.TimeRange = inner.TimeRange
+	q.ResultTimeRange = inner.ResultTimeRange
+	q.Offset = inner.Offset
 	q.Interval = inner.Interval
 	q.IntervalRatio = inner.IntervalRatio
 	q.StorageInterval = inner.StorageInterval
 	q.GroupBy = inner.GroupBy
+	q.Fill = inner.Fill
+	q.FillValue = inner.FillValue
 	q.OrderByItems = orderByItems
 	q.Limit = inner.Limit
+	q.ExplicitLimit = inner.ExplicitLimit
 	return nil
 }
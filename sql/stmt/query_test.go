@@ -18,6 +18,7 @@
 package stmt
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -62,9 +63,21 @@ func TestQuery_Marshal(t *testing.T) {
 				Right:    &EqualsExpr{Key: "path", Value: "/home"},
 			}},
 		},
-		TimeRange: timeutil.TimeRange{Start: 10, End: 30},
-		Interval:  1000,
-		GroupBy:   []string{"a", "b", "c"},
+		TimeRange:       timeutil.TimeRange{Start: 10, End: 30},
+		ResultTimeRange: timeutil.TimeRange{Start: 20, End: 30},
+		Offset:          timeutil.OneDay,
+		Interval:        1000,
+		GroupBy:         []string{"a", "b", "c"},
+		Fill:            ValueFill,
+		FillValue:       10.5,
+		Having: &BinaryExpr{
+			Left: &CallExpr{
+				FuncType: function.Avg,
+				Params:   []Expr{&FieldExpr{Name: "b"}},
+			},
+			Operator: GREATER,
+			Right:    &NumberLiteral{Val: 100},
+		},
 		OrderByItems: []Expr{
 			&FieldExpr{Name: "b"},
 			&CallExpr{
@@ -72,7 +85,8 @@ func TestQuery_Marshal(t *testing.T) {
 				Params:   []Expr{&FieldExpr{Name: "c"}},
 			},
 		},
-		Limit: 100,
+		Limit:         100,
+		ExplicitLimit: true,
 	}
 
 	data := encoding.JSONMarshal(&query)
@@ -83,6 +97,19 @@ func TestQuery_Marshal(t *testing.T) {
 	assert.True(t, query.HasGroupBy())
 }
 
+func TestQuery_GetLimit(t *testing.T) {
+	assert.Equal(t, 10, (&Query{Limit: 10}).GetLimit())
+	assert.Equal(t, math.MaxInt32, (&Query{}).GetLimit())
+	assert.Equal(t, math.MaxInt32, (&Query{Limit: -1}).GetLimit())
+}
+
+func TestQuery_GetResultTimeRange(t *testing.T) {
+	q := &Query{TimeRange: timeutil.TimeRange{Start: 10, End: 20}}
+	assert.Equal(t, q.TimeRange, q.GetResultTimeRange())
+	q.ResultTimeRange = timeutil.TimeRange{Start: 15, End: 20}
+	assert.Equal(t, q.ResultTimeRange, q.GetResultTimeRange())
+}
+
 func TestQuery_Marshal_Fail(t *testing.T) {
 	query := &Query{}
 	err := query.UnmarshalJSON([]byte{1, 2, 3})
@@ -93,8 +120,51 @@ func TestQuery_Marshal_Fail(t *testing.T) {
 	assert.Error(t, err)
 	err = query.UnmarshalJSON([]byte("{\"orderByItems\":[\"123\"]}"))
 	assert.Error(t, err)
+	err = query.UnmarshalJSON([]byte("{\"having\":\"123\"}"))
+	assert.Error(t, err)
 }
 
 func TestQuery_StatementType(t *testing.T) {
 	assert.Equal(t, QueryStatement, (&Query{}).StatementType())
 }
+
+func TestQuery_CrossMetric(t *testing.T) {
+	query := Query{
+		MetricName: "errors",
+		Sources:    []*MetricSource{{MetricName: "errors", Alias: "e"}, {MetricName: "requests"}},
+		SelectItems: []Expr{
+			&SelectItem{Expr: &BinaryExpr{
+				Left:     &FieldExpr{Name: "e.count"},
+				Operator: DIV,
+				Right:    &FieldExpr{Name: "requests.count"},
+			}},
+		},
+		Limit: 10,
+	}
+	assert.True(t, query.IsCrossMetric())
+	assert.False(t, (&Query{}).IsCrossMetric())
+
+	data := encoding.JSONMarshal(&query)
+	query1 := Query{}
+	err := encoding.JSONUnmarshal(data, &query1)
+	assert.NoError(t, err)
+	assert.Equal(t, query, query1)
+}
+
+func TestMetricSource_FieldName(t *testing.T) {
+	source := &MetricSource{MetricName: "system.cpu", Alias: "c"}
+	assert.Equal(t, "c", source.Name())
+	fieldName, ok := source.FieldName("c.usage")
+	assert.True(t, ok)
+	assert.Equal(t, "usage", fieldName)
+	_, ok = source.FieldName("c.")
+	assert.False(t, ok)
+	_, ok = source.FieldName("system.cpu.usage")
+	assert.False(t, ok)
+
+	source = &MetricSource{MetricName: "system.cpu"}
+	assert.Equal(t, "system.cpu", source.Name())
+	fieldName, ok = source.FieldName("system.cpu.usage")
+	assert.True(t, ok)
+	assert.Equal(t, "usage", fieldName)
+}
@@ -17,9 +17,10 @@
 
 package stmt
 
-// Request represents show request statement.
+// Request represents show request/kill query statement.
 type Request struct {
 	RequestID string
+	Kill      bool // kill query by request id
 }
 
 // StatementType returns request statement type.
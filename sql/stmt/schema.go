@@ -24,6 +24,7 @@ const (
 	DatabaseSchemaType
 	CreateDatabaseSchemaType
 	DropDatabaseSchemaType
+	AlterDatabaseSchemaType
 )
 
 // Schema represents show all database schemas statement.
@@ -31,7 +32,10 @@ type Schema struct {
 	Type SchemaType
 	// create stmt: value is database json config.
 	// drop stmt: value is database name.
+	// alter stmt: value is database option json, which overrides the current option.
 	Value string
+	// alter stmt: name is database name.
+	Name string
 }
 
 // StatementType returns schema query type.
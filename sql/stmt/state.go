@@ -33,6 +33,8 @@ const (
 	BrokerMetric
 	// StorageMetric represents show current storage's metric statement
 	StorageMetric
+	// SeriesQuota represents show series quota usage of database statement.
+	SeriesQuota
 )
 
 // State represents show state statement.
@@ -42,6 +44,7 @@ type State struct {
 	Database    string
 
 	MetricNames []string
+	Limit       int // limit of metrics for series quota usage
 }
 
 // StatementType returns state query type.
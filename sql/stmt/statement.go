@@ -28,6 +28,8 @@ const (
 	MetricMetadataStatement
 	QueryStatement
 	RequestStatement
+	DeleteStatement
+	UserStatement
 )
 
 // Statement represents LinDB query language statement
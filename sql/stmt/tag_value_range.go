@@ -0,0 +1,263 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ComparableValue represents a tag value which can be ordered,
+// as a number(e.g. 10, 1.5) or a semantic version(e.g. v1.2.3, 1.2.0-rc.1).
+type ComparableValue struct {
+	Number     float64
+	Version    []uint64
+	PreRelease string
+
+	numeric bool
+}
+
+// ParseComparableValue parses tag value as number or semantic version,
+// returns false if tag value cannot be ordered.
+func ParseComparableValue(value string) (ComparableValue, bool) {
+	var v ComparableValue
+	if num, err := strconv.ParseFloat(value, 64); err == nil && !math.IsNaN(num) {
+		v.Number = num
+		v.numeric = true
+	}
+	v.Version, v.PreRelease = parseVersion(value)
+	return v, v.numeric || v.Version != nil
+}
+
+// IsNumeric returns if value is a number.
+func (v ComparableValue) IsNumeric() bool {
+	return v.numeric
+}
+
+// IsVersion returns if value is a semantic version.
+func (v ComparableValue) IsVersion() bool {
+	return v.Version != nil
+}
+
+// Ordering represents how comparable values are ordered.
+type Ordering int8
+
+const (
+	// NumericOrdering orders values as number.
+	NumericOrdering Ordering = iota
+	// VersionOrdering orders values as semantic version.
+	VersionOrdering
+)
+
+// OrderingOf picks one ordering from the shapes of all values,
+// numeric if all values are numbers, else semantic version(e.g. 1.3 vs 1.3.0 are compared as version).
+func OrderingOf(values ...ComparableValue) Ordering {
+	for _, v := range values {
+		if !v.numeric {
+			return VersionOrdering
+		}
+	}
+	return NumericOrdering
+}
+
+// Compare compares two values by the ordering picked from both values, returns -1/0/1 and true if comparable.
+func (v ComparableValue) Compare(o ComparableValue) (int, bool) {
+	return v.CompareBy(o, OrderingOf(v, o))
+}
+
+// CompareBy compares two values by given ordering, returns -1/0/1 and true if both values can be ordered by it.
+func (v ComparableValue) CompareBy(o ComparableValue, ordering Ordering) (int, bool) {
+	switch {
+	case ordering == NumericOrdering && v.numeric && o.numeric:
+		return compareNumber(v.Number, o.Number), true
+	case ordering == VersionOrdering && v.IsVersion() && o.IsVersion():
+		return v.CompareVersion(o), true
+	default:
+		return 0, false
+	}
+}
+
+// CompareVersion compares two values as semantic version,
+// missing version parts are regarded as 0, pre-release version is less than release version.
+func (v ComparableValue) CompareVersion(o ComparableValue) int {
+	n := len(v.Version)
+	if len(o.Version) > n {
+		n = len(o.Version)
+	}
+	for i := 0; i < n; i++ {
+		var a, b uint64
+		if i < len(v.Version) {
+			a = v.Version[i]
+		}
+		if i < len(o.Version) {
+			b = o.Version[i]
+		}
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+	}
+	return comparePreRelease(v.PreRelease, o.PreRelease)
+}
+
+// TagValueRange represents the range of tag value, unbounded if bound is nil.
+// All tag values are compared with bounds by one ordering picked from bounds,
+// so that the result is consistent, e.g. > 1.25 only matches numbers, > 1.25.0 matches versions(includes 1.3).
+type TagValueRange struct {
+	Low, High                   *ComparableValue
+	LowInclusive, HighInclusive bool
+	Ordering                    Ordering
+}
+
+// Match checks if tag value is in range, false if tag value cannot be ordered by the ordering of range.
+func (r *TagValueRange) Match(tagValue string) bool {
+	value, ok := ParseComparableValue(tagValue)
+	if !ok {
+		return false
+	}
+	return r.MatchValue(value)
+}
+
+// MatchValue checks if comparable value is in range, false if value cannot be ordered by the ordering of range.
+func (r *TagValueRange) MatchValue(value ComparableValue) bool {
+	if r.Low != nil {
+		c, ok := value.CompareBy(*r.Low, r.Ordering)
+		if !ok || c < 0 || (c == 0 && !r.LowInclusive) {
+			return false
+		}
+	}
+	if r.High != nil {
+		c, ok := value.CompareBy(*r.High, r.Ordering)
+		if !ok || c > 0 || (c == 0 && !r.HighInclusive) {
+			return false
+		}
+	}
+	return true
+}
+
+// newTagValueRange creates tag value range by bounds, returns nil if bound cannot be ordered.
+func newTagValueRange(low, high string, hasLow, hasHigh, lowInclusive, highInclusive bool) *TagValueRange {
+	r := &TagValueRange{LowInclusive: lowInclusive, HighInclusive: highInclusive}
+	var bounds []ComparableValue
+	if hasLow {
+		v, ok := ParseComparableValue(low)
+		if !ok {
+			return nil
+		}
+		r.Low = &v
+		bounds = append(bounds, v)
+	}
+	if hasHigh {
+		v, ok := ParseComparableValue(high)
+		if !ok {
+			return nil
+		}
+		r.High = &v
+		bounds = append(bounds, v)
+	}
+	r.Ordering = OrderingOf(bounds...)
+	if r.Ordering == VersionOrdering {
+		for _, bound := range bounds {
+			if !bound.IsVersion() {
+				// bounds are mixed with number(not version) and version, e.g. between -1 and 1.0.0
+				return nil
+			}
+		}
+	}
+	return r
+}
+
+// parseVersion parses semantic version like v1.2.3-rc.1+build, returns nil if not a version.
+func parseVersion(value string) (version []uint64, preRelease string) {
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "v"), "V")
+	if idx := strings.IndexByte(value, '+'); idx >= 0 {
+		// ignore build metadata
+		value = value[:idx]
+	}
+	if idx := strings.IndexByte(value, '-'); idx >= 0 {
+		preRelease = value[idx+1:]
+		value = value[:idx]
+		if preRelease == "" {
+			return nil, ""
+		}
+	}
+	if value == "" {
+		return nil, ""
+	}
+	parts := strings.Split(value, ".")
+	version = make([]uint64, len(parts))
+	for i, part := range parts {
+		num, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, ""
+		}
+		version[i] = num
+	}
+	return version, preRelease
+}
+
+// comparePreRelease compares pre-release identifiers, empty means release version which has higher precedence.
+func comparePreRelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if c := compareIdentifier(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+	return compareNumber(float64(len(as)), float64(len(bs)))
+}
+
+// compareIdentifier compares pre-release identifier, numeric identifier is less than alphanumeric identifier.
+func compareIdentifier(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return compareNumber(float64(an), float64(bn))
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// compareNumber compares two numbers.
+func compareNumber(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
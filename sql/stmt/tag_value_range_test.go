@@ -0,0 +1,191 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseComparableValue(t *testing.T) {
+	v, ok := ParseComparableValue("10.5")
+	assert.True(t, ok)
+	assert.True(t, v.IsNumeric())
+	assert.True(t, v.IsVersion())
+	assert.Equal(t, 10.5, v.Number)
+
+	v, ok = ParseComparableValue("v1.2.3-rc.1+build.5")
+	assert.True(t, ok)
+	assert.False(t, v.IsNumeric())
+	assert.Equal(t, []uint64{1, 2, 3}, v.Version)
+	assert.Equal(t, "rc.1", v.PreRelease)
+
+	v, ok = ParseComparableValue("-1")
+	assert.True(t, ok)
+	assert.False(t, v.IsVersion())
+
+	for _, value := range []string{"", "abc", "NaN", "v", "1.x", "1.2-", "1..2"} {
+		_, ok = ParseComparableValue(value)
+		assert.False(t, ok, value)
+	}
+}
+
+func TestOrderingOf(t *testing.T) {
+	cases := []struct {
+		values   []string
+		ordering Ordering
+	}{
+		{values: []string{"1.3", "1.25"}, ordering: NumericOrdering},
+		{values: []string{"-1", "10"}, ordering: NumericOrdering},
+		{values: []string{"1.3", "1.3.0"}, ordering: VersionOrdering},
+		{values: []string{"1.3.0", "1.25"}, ordering: VersionOrdering},
+		{values: []string{"v2"}, ordering: VersionOrdering},
+		{values: nil, ordering: NumericOrdering},
+	}
+	for _, c := range cases {
+		var values []ComparableValue
+		for _, value := range c.values {
+			v, _ := ParseComparableValue(value)
+			values = append(values, v)
+		}
+		assert.Equal(t, c.ordering, OrderingOf(values...), c.values)
+	}
+}
+
+func TestComparableValue_Compare(t *testing.T) {
+	cases := []struct {
+		a, b       string
+		result     int
+		comparable bool
+	}{
+		{a: "1.9", b: "1.10", result: 1, comparable: true},
+		{a: "v1.9.0", b: "v1.10.0", result: -1, comparable: true},
+		{a: "1.9", b: "1.10.0", result: -1, comparable: true},
+		{a: "1.3", b: "1.3.0", result: 0, comparable: true},
+		{a: "1.3.0", b: "1.3", result: 0, comparable: true},
+		{a: "2", b: "v2.0.0", result: 0, comparable: true},
+		{a: "1.0.0-rc.1", b: "1.0.0", result: -1, comparable: true},
+		{a: "1.0.0", b: "1.0.0-rc.1", result: 1, comparable: true},
+		{a: "1.0.0-rc.2", b: "1.0.0-rc.10", result: -1, comparable: true},
+		{a: "1.0.0-1", b: "1.0.0-alpha", result: -1, comparable: true},
+		{a: "1.0.0-alpha", b: "1.0.0-1", result: 1, comparable: true},
+		{a: "1.0.0-alpha", b: "1.0.0-beta", result: -1, comparable: true},
+		{a: "1.0.0-alpha", b: "1.0.0-alpha.1", result: -1, comparable: true},
+		{a: "-1", b: "1", result: -1, comparable: true},
+		{a: "5", b: "5", result: 0, comparable: true},
+		{a: "-1", b: "1.0.0", result: 0, comparable: false},
+	}
+	for _, c := range cases {
+		a, _ := ParseComparableValue(c.a)
+		b, _ := ParseComparableValue(c.b)
+		result, ok := a.Compare(b)
+		assert.Equal(t, c.comparable, ok, c.a+" vs "+c.b)
+		assert.Equal(t, c.result, result, c.a+" vs "+c.b)
+	}
+}
+
+func TestComparableValue_CompareBy(t *testing.T) {
+	cases := []struct {
+		a, b       string
+		ordering   Ordering
+		result     int
+		comparable bool
+	}{
+		{a: "1.3", b: "1.25", ordering: NumericOrdering, result: 1, comparable: true},
+		{a: "1.3", b: "1.25", ordering: VersionOrdering, result: -1, comparable: true},
+		{a: "1.3.0", b: "1.25", ordering: NumericOrdering, comparable: false},
+		{a: "1.3.0", b: "1.25", ordering: VersionOrdering, result: -1, comparable: true},
+		{a: "-1", b: "1", ordering: VersionOrdering, comparable: false},
+		{a: "10", b: "9", ordering: NumericOrdering, result: 1, comparable: true},
+	}
+	for _, c := range cases {
+		a, _ := ParseComparableValue(c.a)
+		b, _ := ParseComparableValue(c.b)
+		result, ok := a.CompareBy(b, c.ordering)
+		assert.Equal(t, c.comparable, ok, c.a+" vs "+c.b)
+		assert.Equal(t, c.result, result, c.a+" vs "+c.b)
+	}
+}
+
+func TestTagValueRange_Match(t *testing.T) {
+	cases := []struct {
+		name      string
+		expr      RangeTagFilter
+		ordering  Ordering
+		matched   []string
+		unmatched []string
+	}{
+		{
+			name:      "greater than number",
+			expr:      &CompareExpr{Operator: GREATER, Value: "10"},
+			ordering:  NumericOrdering,
+			matched:   []string{"11", "10.5"},
+			unmatched: []string{"10", "9", "abc", "11.0.0"},
+		},
+		{
+			name:     "greater equal number",
+			expr:     &CompareExpr{Operator: GREATEREQUAL, Value: "10"},
+			ordering: NumericOrdering,
+			matched:  []string{"10"},
+		},
+		{
+			name:      "less than version",
+			expr:      &CompareExpr{Operator: LESS, Value: "v1.10.0"},
+			ordering:  VersionOrdering,
+			matched:   []string{"v1.9.9", "1.9"},
+			unmatched: []string{"v1.10.0", "-1"},
+		},
+		{
+			name:     "less equal version",
+			expr:     &CompareExpr{Operator: LESSEQUAL, Value: "v1.10.0"},
+			ordering: VersionOrdering,
+			matched:  []string{"1.10"},
+		},
+		{
+			name:      "between numbers",
+			expr:      &BetweenExpr{Start: "1", End: "2"},
+			ordering:  NumericOrdering,
+			matched:   []string{"1", "2", "1.5"},
+			unmatched: []string{"2.1", "1.5.0"},
+		},
+		{
+			name:      "between number and version",
+			expr:      &BetweenExpr{Start: "1.3", End: "1.3.5"},
+			ordering:  VersionOrdering,
+			matched:   []string{"1.3", "1.3.0", "1.3.5", "v1.3.2"},
+			unmatched: []string{"1.25", "1.30"},
+		},
+	}
+	for _, c := range cases {
+		r := c.expr.ValueRange()
+		assert.Equal(t, c.ordering, r.Ordering, c.name)
+		for _, value := range c.matched {
+			assert.True(t, r.Match(value), c.name+": "+value)
+		}
+		for _, value := range c.unmatched {
+			assert.False(t, r.Match(value), c.name+": "+value)
+		}
+	}
+
+	assert.Nil(t, (&CompareExpr{Operator: EQUAL, Value: "1"}).ValueRange())
+	assert.Nil(t, (&CompareExpr{Operator: LESS, Value: "abc"}).ValueRange())
+	assert.Nil(t, (&BetweenExpr{Start: "1", End: "abc"}).ValueRange())
+	assert.Nil(t, (&BetweenExpr{Start: "abc", End: "1"}).ValueRange())
+	assert.Nil(t, (&BetweenExpr{Start: "-1", End: "1.0.0"}).ValueRange())
+}
@@ -0,0 +1,53 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package stmt
+
+// UserOpType represents user/privilege related operation.
+type UserOpType int
+
+const (
+	// UserOpUnknown represents unknown operation.
+	UserOpUnknown UserOpType = iota
+	// UserOpShow represents show users.
+	UserOpShow
+	// UserOpCreate represents create user with password.
+	UserOpCreate
+	// UserOpDrop represents drop user/api token.
+	UserOpDrop
+	// UserOpCreateToken represents create(or rotate) api token.
+	UserOpCreateToken
+	// UserOpGrant represents grant role on database to user.
+	UserOpGrant
+	// UserOpRevoke represents revoke role on database from user.
+	UserOpRevoke
+)
+
+// User represents user/privilege management statement.
+type User struct {
+	Type     UserOpType
+	Name     string
+	Password string
+	// grant/revoke stmt: role name and database("*" means all databases).
+	Role     string
+	Database string
+}
+
+// StatementType returns user statement type.
+func (q *User) StatementType() StatementType {
+	return UserStatement
+}
@@ -0,0 +1,75 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func TestUserStmt(t *testing.T) {
+	cases := []struct {
+		sql  string
+		want *stmt.User
+	}{
+		{
+			sql:  "show users",
+			want: &stmt.User{Type: stmt.UserOpShow},
+		},
+		{
+			sql:  "create user 'bob' with password 'p@ss word'",
+			want: &stmt.User{Type: stmt.UserOpCreate, Name: "bob", Password: "p@ss word"},
+		},
+		{
+			sql:  "drop user bob",
+			want: &stmt.User{Type: stmt.UserOpDrop, Name: "bob"},
+		},
+		{
+			sql:  "create token 'agent'",
+			want: &stmt.User{Type: stmt.UserOpCreateToken, Name: "agent"},
+		},
+		{
+			sql:  "grant write on 'db' to 'agent'",
+			want: &stmt.User{Type: stmt.UserOpGrant, Name: "agent", Role: "write", Database: "db"},
+		},
+		{
+			sql:  "GRANT ADMIN ON * TO bob",
+			want: &stmt.User{Type: stmt.UserOpGrant, Name: "bob", Role: "ADMIN", Database: "*"},
+		},
+		{
+			sql:  "revoke read on db from bob",
+			want: &stmt.User{Type: stmt.UserOpRevoke, Name: "bob", Role: "read", Database: "db"},
+		},
+	}
+	for _, tt := range cases {
+		q, err := Parse(tt.sql)
+		assert.NoError(t, err, tt.sql)
+		assert.Equal(t, tt.want, q, tt.sql)
+	}
+
+	// user related keywords can be used as identifier
+	q, err := Parse("select read from user where token='a'")
+	assert.NoError(t, err)
+	assert.Equal(t, "user", q.(*stmt.Query).MetricName)
+
+	_, err = Parse("grant owner on db to bob")
+	assert.Error(t, err)
+}
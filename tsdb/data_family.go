@@ -74,6 +74,11 @@ type DataFamily interface {
 	Flush() error
 	// MemDBSize returns memory database heap size.
 	MemDBSize() int64
+	// WithFlushBarrier flushes memory database, then invokes fn with persisted sequences,
+	// flush is paused when fn running, so that data of files is consistent with persisted sequences.
+	WithFlushBarrier(fn func(sequences map[int32]int64) error) error
+	// FileFilter filters data of files under data family based on query condition, excludes memory database.
+	FileFilter(executeCtx *flow.ShardExecuteContext) ([]flow.FilterResultSet, error)
 
 	// GetState returns the current state include memory database state.
 	GetState() models.DataFamilyState
@@ -86,6 +91,10 @@ type DataFamily interface {
 	// Release decrements write ref count,
 	// if ref==0, no data will write this family.
 	Release()
+	// refreshTombstones refreshes the deleted data of family from shard's tombstones.
+	refreshTombstones()
+	// purgeTombstones refreshes the deleted data of family, then purges them from all files of family.
+	purgeTombstones() error
 
 	// DataFilter filters data under data family based on query condition
 	flow.DataFilter
@@ -119,6 +128,7 @@ type dataFamily struct {
 
 	ref          atomic.Int32 // ref count for writing
 	lastReadTime *atomic.Int64
+	tombstones   atomic.Value // deleted data of family(metricsdata.Tombstones)
 	mutex        sync.Mutex
 
 	statistics *metrics.FamilyStatistics
@@ -166,6 +176,7 @@ func newDataFamily(
 
 	f.indicator = fmt.Sprintf("%s/%s/%s", dbName, shardIDStr,
 		timeutil.FormatTimestamp(familyTime, timeutil.DataTimeFormat4))
+	f.refreshTombstones()
 
 	// add data family into global family manager
 	GetFamilyManager().AddFamily(f)
@@ -324,6 +335,38 @@ func (f *dataFamily) Compact() {
 	}
 }
 
+// refreshTombstones refreshes the deleted data of family from shard's tombstones,
+// sets them into kv family for purging deleted data when does compact job.
+func (f *dataFamily) refreshTombstones() {
+	store := f.shard.Tombstones()
+	if store == nil {
+		return
+	}
+	tombstones := familyTombstones(store.GetTombstones(f.timeRange), f.interval, f.familyTime)
+	f.tombstones.Store(tombstones)
+	if len(tombstones) == 0 {
+		// clear the deleted data context, so that compact job can move file
+		f.family.SetTombstone(nil)
+		return
+	}
+	f.family.SetTombstone(tombstones)
+}
+
+// purgeTombstones refreshes the deleted data of family, then purges them from all files of family.
+func (f *dataFamily) purgeTombstones() error {
+	f.refreshTombstones()
+	return f.family.Purge()
+}
+
+// getTombstones returns the tombstones of metric.
+func (f *dataFamily) getTombstones(metricKey uint32) []metricsdata.Tombstone {
+	tombstones, ok := f.tombstones.Load().(metricsdata.Tombstones)
+	if !ok {
+		return nil
+	}
+	return tombstones[metricKey]
+}
+
 // Retain increments write ref count
 func (f *dataFamily) Retain() {
 	f.ref.Inc()
@@ -383,6 +426,37 @@ func (f *dataFamily) MemDBSize() int64 {
 	return 0
 }
 
+// WithFlushBarrier flushes memory database, then invokes fn with persisted sequences,
+// flush is paused when fn running, so that data of files is consistent with persisted sequences.
+func (f *dataFamily) WithFlushBarrier(fn func(sequences map[int32]int64) error) error {
+	if err := f.Flush(); err != nil {
+		return err
+	}
+	// wait another flush job completed, then pause flush job
+	for !f.isFlushing.CAS(false, true) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	f.flushCondition.Add(1)
+	defer func() {
+		f.flushCondition.Done()
+		f.isFlushing.Store(false)
+	}()
+
+	f.mutex.Lock()
+	sequences := make(map[int32]int64)
+	for leader, seq := range f.persistSeq {
+		sequences[leader] = seq.Load()
+	}
+	f.mutex.Unlock()
+	return fn(sequences)
+}
+
+// FileFilter filters data of files under data family based on query condition, excludes memory database.
+func (f *dataFamily) FileFilter(executeCtx *flow.ShardExecuteContext) ([]flow.FilterResultSet, error) {
+	f.lastReadTime.Store(fasttime.UnixMilliseconds())
+	return f.fileFilter(executeCtx)
+}
+
 // Filter filters the data based on metric/version/seriesIDs,
 // if it finds data then returns the FilterResultSet, else returns nil
 func (f *dataFamily) Filter(executeCtx *flow.ShardExecuteContext) (resultSet []flow.FilterResultSet, err error) {
@@ -504,7 +578,7 @@ func (f *dataFamily) fileFilter(shardExecuteContext *flow.ShardExecuteContext) (
 	if len(metricReaders) == 0 {
 		return
 	}
-	filter := newFilterFunc(f.timeRange.Start, snapShot, metricReaders)
+	filter := newFilterFunc(f.timeRange.Start, snapShot, metricReaders, f.getTombstones(metricKey))
 	return filter.Filter(shardExecuteContext.SeriesIDsAfterFiltering, shardExecuteContext.StorageExecuteCtx.Fields)
 }
 
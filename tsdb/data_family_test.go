@@ -28,6 +28,7 @@ import (
 
 	"github.com/lindb/common/pkg/fasttime"
 	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/flow"
@@ -64,6 +65,7 @@ func TestDataFamily_BaseTime(t *testing.T) {
 	snapshot.EXPECT().Close()
 	family.EXPECT().GetSnapshot().Return(snapshot)
 	shard := NewMockShard(ctrl)
+	shard.EXPECT().Tombstones().Return(nil).AnyTimes()
 	shard.EXPECT().Database().Return(database)
 	shard.EXPECT().ShardID().Return(models.ShardID(1))
 	dataFamily := newDataFamily(shard, nil, timeutil.Interval(timeutil.OneSecond*10), timeRange, 10, family)
@@ -176,7 +178,7 @@ func TestDataFamily_Filter(t *testing.T) {
 				mReader.EXPECT().GetTimeRange().Return(timeutil.SlotRange{Start: 0, End: 1000})
 				filter := metricsdata.NewMockFilter(ctrl)
 				newFilterFunc = func(familyTime int64, snapshot version.Snapshot,
-					readers []metricsdata.MetricReader) metricsdata.Filter {
+					readers []metricsdata.MetricReader, tombstones []metricsdata.Tombstone) metricsdata.Filter {
 					return filter
 				}
 				filter.EXPECT().Filter(gomock.Any(), gomock.Any()).Return([]flow.FilterResultSet{nil}, nil)
@@ -221,6 +223,37 @@ func TestDataFamily_Filter(t *testing.T) {
 	}
 }
 
+func TestDataFamily_FileFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	family := kv.NewMockFamily(ctrl)
+	snapshot := version.NewMockSnapshot(ctrl)
+	snapshot.EXPECT().Close().AnyTimes()
+	family.EXPECT().GetSnapshot().Return(snapshot).AnyTimes()
+	snapshot.EXPECT().FindReaders(gomock.Any()).Return(nil, nil)
+	now := timeutil.Now()
+	f := &dataFamily{
+		familyTime:   now,
+		family:       family,
+		lastReadTime: atomic.NewInt64(0),
+		// memory database is excluded
+		mutableMemDB: memdb.NewMockMemoryDatabase(ctrl),
+	}
+	rs, err := f.FileFilter(&flow.ShardExecuteContext{
+		StorageExecuteCtx: &flow.StorageExecuteContext{
+			MetricID: 1,
+			Query: &stmtpkg.Query{
+				StorageInterval: timeutil.Interval(timeutil.OneMinute),
+				TimeRange:       timeutil.TimeRange{Start: now, End: now + 60000},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, rs)
+	assert.True(t, f.lastReadTime.Load() > 0)
+}
+
 func TestDataFamily_NeedFlush(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -583,6 +616,35 @@ func TestDataFamily_Sequence(t *testing.T) {
 	assert.Equal(t, 1, c)
 }
 
+func TestDataFamily_WithFlushBarrier(t *testing.T) {
+	f := &dataFamily{
+		persistSeq: map[int32]atomic.Int64{
+			1: *atomic.NewInt64(10),
+		},
+		logger: logger.GetLogger("TSDB", "Test"),
+	}
+	// case 1: flush paused when fn running
+	assert.NoError(t, f.WithFlushBarrier(func(sequences map[int32]int64) error {
+		assert.Equal(t, map[int32]int64{1: 10}, sequences)
+		assert.True(t, f.IsFlushing())
+		return nil
+	}))
+	assert.False(t, f.IsFlushing())
+	// case 2: fn failure
+	assert.Error(t, f.WithFlushBarrier(func(_ map[int32]int64) error {
+		return fmt.Errorf("err")
+	}))
+	assert.False(t, f.IsFlushing())
+	// case 3: wait another flush job completed
+	f.isFlushing.Store(true)
+	time.AfterFunc(20*time.Millisecond, func() {
+		f.isFlushing.Store(false)
+	})
+	assert.NoError(t, f.WithFlushBarrier(func(_ map[int32]int64) error {
+		return nil
+	}))
+}
+
 func TestDataFamily_WriteRows(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -841,3 +903,52 @@ func TestDataFamily_Evict(t *testing.T) {
 		})
 	}
 }
+
+func TestDataFamily_Tombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := NewMockShard(ctrl)
+	kvFamily := kv.NewMockFamily(ctrl)
+	store := NewMockTombstoneStore(ctrl)
+	f := &dataFamily{
+		shard:      shard,
+		family:     kvFamily,
+		interval:   timeutil.Interval(10 * timeutil.OneSecond),
+		familyTime: 0,
+		timeRange:  timeutil.TimeRange{Start: 0, End: timeutil.OneHour - 1},
+	}
+	// case 1: tombstone store not exist
+	shard.EXPECT().Tombstones().Return(nil)
+	f.refreshTombstones()
+	assert.Nil(t, f.getTombstones(1))
+	// case 2: tombstones not found
+	shard.EXPECT().Tombstones().Return(store).AnyTimes()
+	store.EXPECT().GetTombstones(f.timeRange).Return(nil)
+	kvFamily.EXPECT().SetTombstone(nil)
+	f.refreshTombstones()
+	assert.Nil(t, f.getTombstones(1))
+	// case 3: set tombstones
+	store.EXPECT().GetTombstones(f.timeRange).Return([]*Tombstone{{
+		MetricID:  1,
+		SeriesIDs: roaring.BitmapOf(1),
+		TimeRange: timeutil.TimeRange{Start: 0, End: 20 * timeutil.OneSecond},
+	}})
+	tombstones := metricsdata.Tombstones{
+		1: {{SeriesIDs: roaring.BitmapOf(1), SlotRange: timeutil.SlotRange{Start: 0, End: 2}}},
+	}
+	kvFamily.EXPECT().SetTombstone(tombstones)
+	f.refreshTombstones()
+	assert.Equal(t, tombstones[1], f.getTombstones(1))
+	assert.Nil(t, f.getTombstones(2))
+	// case 4: tombstones removed, clear tombstones
+	store.EXPECT().GetTombstones(f.timeRange).Return(nil)
+	kvFamily.EXPECT().SetTombstone(nil)
+	f.refreshTombstones()
+	assert.Nil(t, f.getTombstones(1))
+	// case 5: purge tombstones
+	store.EXPECT().GetTombstones(f.timeRange).Return(nil)
+	kvFamily.EXPECT().SetTombstone(nil)
+	kvFamily.EXPECT().Purge().Return(fmt.Errorf("err"))
+	assert.Error(t, f.purgeTombstones())
+}
@@ -18,24 +18,31 @@
 package tsdb
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
+	"path/filepath"
 	"runtime"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"go.uber.org/atomic"
 
+	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/internal/concurrent"
 	"github.com/lindb/lindb/internal/linmetric"
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
 	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/tsdb/metadb"
 	"github.com/lindb/lindb/tsdb/tblstore/tagkeymeta"
+	"github.com/lindb/lindb/tsdb/tiering"
 )
 
 //go:generate mockgen -source=./database.go -destination=./database_mock.go -package=tsdb
@@ -48,10 +55,17 @@ type Database interface {
 	NumOfShards() int
 	// GetOption returns the database options
 	GetOption() *option.DatabaseOption
+	// UpdateOption updates the database options online after database altered,
+	// includes retention, rollup intervals, writable time range and flusher thresholds.
+	UpdateOption(dbOption *option.DatabaseOption) error
 	// CreateShards creates families for data partition
 	CreateShards(shardIDs []models.ShardID) error
 	// GetShard returns shard by given shard id
 	GetShard(shardID models.ShardID) (Shard, bool)
+	// GetSeriesQuotaUsage returns the series quota usages of all shards, top n metrics if limit > 0.
+	GetSeriesQuotaUsage(limit int) ([]models.ShardSeriesQuota, error)
+	// DropShards drops shards include all data, keep active shards.
+	DropShards(activeShards map[models.ShardID]struct{})
 	// ExecutorPool returns the pool for querying tasks
 	ExecutorPool() *ExecutorPool
 	// Closer closes database's underlying resource
@@ -66,6 +80,9 @@ type Database interface {
 	Flush() error
 	// Drop drops current database include all data.
 	Drop() error
+	// Checkpoint flushes memory data and writes a consistent snapshot of database
+	// into dir, the snapshot keeps the same directory tree as storage under dir.
+	Checkpoint(dir string) error
 	// TTL expires the data of each shard base on time to live.
 	TTL()
 	// EvictSegment evicts segment which long term no read operation.
@@ -84,6 +101,7 @@ type database struct {
 	name           string // database-name
 	dir            string
 	config         *databaseConfig // meta configuration
+	configMutex    sync.RWMutex    // mutex for accessing meta configuration
 	executorPool   *ExecutorPool   // executor pool for querying task
 	mutex          sync.Mutex      // mutex for creating families
 	shardSet       shardSet        // atomic value
@@ -188,9 +206,42 @@ func (db *database) NumOfShards() int {
 
 // GetOption returns the database options
 func (db *database) GetOption() *option.DatabaseOption {
+	db.configMutex.RLock()
+	defer db.configMutex.RUnlock()
+
 	return db.config.Option
 }
 
+// UpdateOption updates the database options online after database altered,
+// includes retention, rollup intervals, writable time range and flusher thresholds.
+func (db *database) UpdateOption(dbOption *option.DatabaseOption) error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	newOption := dbOption.Clone()
+	sort.Sort(newOption.Intervals)
+	currentOption := db.GetOption()
+	if bytes.Equal(encoding.JSONMarshal(currentOption), encoding.JSONMarshal(newOption)) {
+		// option not changed
+		return nil
+	}
+	if err := currentOption.ValidateUpdate(newOption); err != nil {
+		return err
+	}
+	// persist and publish new option first, new segment created with new option
+	if err := db.dumpDatabaseConfig(&databaseConfig{Option: newOption, ShardIDs: db.config.ShardIDs}); err != nil {
+		return err
+	}
+	for _, entry := range db.shardSet.Entries() {
+		if err := entry.shard.UpdateOption(newOption); err != nil {
+			return fmt.Errorf("update option for shard[%d] of database[%s] error: %s", entry.shardID, db.name, err)
+		}
+	}
+	engineLogger.Info("update database option successfully",
+		logger.String("database", db.name), logger.Any("option", newOption))
+	return nil
+}
+
 // CreateShards creates families for data partition
 func (db *database) CreateShards(
 	shardIDs []models.ShardID,
@@ -243,6 +294,70 @@ func (db *database) GetShard(shardID models.ShardID) (Shard, bool) {
 	return db.shardSet.GetShard(shardID)
 }
 
+// GetSeriesQuotaUsage returns the series quota usages of all shards, top n metrics if limit > 0.
+func (db *database) GetSeriesQuotaUsage(limit int) ([]models.ShardSeriesQuota, error) {
+	var rs []models.ShardSeriesQuota
+	for _, entry := range db.shardSet.Entries() {
+		usages, err := entry.shard.IndexDatabase().GetSeriesQuotaUsage(limit)
+		if err != nil {
+			return nil, err
+		}
+		rs = append(rs, models.ShardSeriesQuota{ShardID: entry.shardID, Usages: usages})
+	}
+	sort.Slice(rs, func(i, j int) bool { return rs[i].ShardID < rs[j].ShardID })
+	return rs, nil
+}
+
+// DropShards drops shards include all data, keep active shards.
+func (db *database) DropShards(activeShards map[models.ShardID]struct{}) {
+	for _, entry := range db.shardSet.Entries() {
+		if _, ok := activeShards[entry.shardID]; ok {
+			continue
+		}
+		if err := db.dropShard(entry.shardID); err != nil {
+			engineLogger.Warn("drop shard failure", logger.String("database", db.name),
+				logger.Any("shardID", entry.shardID), logger.Error(err))
+			continue
+		}
+		engineLogger.Info("drop shard successfully", logger.String("database", db.name),
+			logger.Any("shardID", entry.shardID))
+	}
+}
+
+// dropShard closes the shard and removes all data of it.
+func (db *database) dropShard(shardID models.ShardID) error {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	s, ok := db.GetShard(shardID)
+	if !ok {
+		return nil
+	}
+	// using new engine option
+	newCfg := &databaseConfig{Option: db.config.Option}
+	for _, id := range db.config.ShardIDs {
+		if id != shardID {
+			newCfg.ShardIDs = append(newCfg.ShardIDs, id)
+		}
+	}
+	if err := db.dumpDatabaseConfig(newCfg); err != nil {
+		return err
+	}
+	db.shardSet.DeleteShard(shardID)
+	if err := s.Close(); err != nil {
+		engineLogger.Warn("close shard failure when drop shard",
+			logger.String("database", db.name), logger.Any("shardID", shardID), logger.Error(err))
+	}
+	if err := removeDir(shardPath(db.name, shardID)); err != nil {
+		return err
+	}
+	// remove the segments moved into cold storage
+	if backend := tiering.GetBackend(); backend != nil {
+		return backend.Delete(shardIndicator(db.name, shardID))
+	}
+	return nil
+}
+
 // ExecutorPool returns the query task execute pool
 func (db *database) ExecutorPool() *ExecutorPool {
 	return db.executorPool
@@ -292,7 +407,9 @@ func (db *database) dumpDatabaseConfig(newConfig *databaseConfig) error {
 	if err := encodeToml(cfgPath, newConfig); err != nil {
 		return fmt.Errorf("write engine options to file[%s] error:%s", cfgPath, err)
 	}
+	db.configMutex.Lock()
 	db.config = newConfig
+	db.configMutex.Unlock()
 	return nil
 }
 
@@ -307,7 +424,8 @@ func (db *database) initMetadata() error {
 		tagValueDir,
 		kv.FamilyOption{
 			CompactThreshold: 0,
-			Merger:           string(tagkeymeta.MergerName)})
+			Merger:           string(tagkeymeta.MergerName),
+			ValueCompression: db.GetOption().ValueCompression})
 	if err != nil {
 		return err
 	}
@@ -376,5 +494,95 @@ func (db *database) Drop() error {
 	if err := removeDir(db.dir); err != nil {
 		return err
 	}
+	// remove the segments moved into cold storage
+	if backend := tiering.GetBackend(); backend != nil {
+		return backend.Delete(db.name)
+	}
+	return nil
+}
+
+// Checkpoint flushes memory data and writes a consistent snapshot of database into dir.
+// Stores are snapshot from data to metadata, so that all ids referenced by data are
+// included in the snapshot even if writes are in progress.
+func (db *database) Checkpoint(dir string) error {
+	// 1. flush metadata/index/data to disk
+	if err := db.flushMetaAndWait(); err != nil {
+		return err
+	}
+	shards := db.shardSet.Entries()
+	for _, shardEntry := range shards {
+		if err := flushShardAndWait(shardEntry.shard); err != nil {
+			return err
+		}
+	}
+	// 2. snapshot shard level data/index stores and series id mapping
+	for _, shardEntry := range shards {
+		if err := checkpointShard(shardEntry.shard, dir); err != nil {
+			return err
+		}
+	}
+	// 3. snapshot database level metadata, flush tag meta again for new tags referenced by shard index
+	if err := db.flushMetaAndWait(); err != nil {
+		return err
+	}
+	if err := db.metaStore.Checkpoint(filepath.Join(dir, tagMetaIndicator(db.name))); err != nil {
+		return err
+	}
+	if err := db.metadata.MetadataDatabase().Checkpoint(filepath.Join(dir, db.name, metaDir)); err != nil {
+		return err
+	}
+	// 4. copy options file
+	if err := copyFile(optionsPath(db.name), filepath.Join(dir, db.name, options)); err != nil {
+		return err
+	}
+	engineLogger.Info("checkpoint database successfully",
+		logger.String("database", db.name), logger.String("dir", dir))
 	return nil
 }
+
+// flushMetaAndWait flushes metadata, waits if other flush job is doing.
+func (db *database) flushMetaAndWait() error {
+	db.WaitFlushMetaCompleted()
+	if err := db.FlushMeta(); err != nil {
+		return err
+	}
+	db.WaitFlushMetaCompleted()
+	return nil
+}
+
+// flushShardAndWait flushes index and all data families of shard.
+func flushShardAndWait(shard Shard) error {
+	shard.WaitFlushIndexCompleted()
+	if err := shard.FlushIndex(); err != nil {
+		return err
+	}
+	shard.WaitFlushIndexCompleted()
+	for _, family := range GetFamilyManager().GetFamiliesByShard(shard) {
+		if err := family.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkpointShard writes the snapshot of shard's kv stores and series id mapping into dir.
+func checkpointShard(shard Shard, dir string) error {
+	prefix := shard.Indicator() + string(filepath.Separator)
+	for _, store := range kv.GetStoreManager().GetStores() {
+		if !strings.HasPrefix(store.Name(), prefix) {
+			continue
+		}
+		if err := store.Checkpoint(filepath.Join(dir, store.Name())); err != nil {
+			return err
+		}
+	}
+	if err := shard.IndexDatabase().Checkpoint(filepath.Join(dir, shard.Indicator(), metaDir)); err != nil {
+		return err
+	}
+	// copy tombstone file if shard has deleted series
+	tombstone := filepath.Join(config.GlobalStorageConfig().TSDB.Dir, shard.Indicator(), tombstoneFile)
+	if !fileExist(tombstone) {
+		return nil
+	}
+	return copyFile(tombstone, filepath.Join(dir, shard.Indicator(), tombstoneFile))
+}
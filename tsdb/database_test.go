@@ -35,7 +35,9 @@ import (
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/tsdb/indexdb"
 	"github.com/lindb/lindb/tsdb/metadb"
+	"github.com/lindb/lindb/tsdb/tiering"
 )
 
 func TestDatabase_New(t *testing.T) {
@@ -532,6 +534,166 @@ func TestDatabase_Drop(t *testing.T) {
 	}
 	metadata.EXPECT().Close().Return(nil)
 	assert.NoError(t, db.Drop())
+
+	// remove segments in cold storage
+	backend := tiering.NewMockBackend(ctrl)
+	tiering.InitBackend(backend)
+	defer tiering.InitBackend(nil)
+	backend.EXPECT().Delete("test-db").Return(fmt.Errorf("err"))
+	db.name = "test-db"
+	metadata.EXPECT().Close().Return(nil)
+	assert.Error(t, db.Drop())
+}
+
+func TestDatabase_DropShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		removeDir = fileutil.RemoveDir
+		encodeToml = ltoml.EncodeToml
+		ctrl.Finish()
+	}()
+	removeDir = func(path string) error {
+		return nil
+	}
+	encodeToml = func(fileName string, v interface{}) error {
+		return nil
+	}
+	shard1 := NewMockShard(ctrl)
+	shard2 := NewMockShard(ctrl)
+	shard3 := NewMockShard(ctrl)
+	set := newShardSet()
+	set.InsertShard(1, shard1)
+	set.InsertShard(2, shard2)
+	set.InsertShard(3, shard3)
+	db := &database{
+		config:   &databaseConfig{ShardIDs: []models.ShardID{1, 2, 3}},
+		shardSet: *set,
+	}
+	shard2.EXPECT().Close().Return(fmt.Errorf("err"))
+	shard3.EXPECT().Close().Return(nil)
+	db.DropShards(map[models.ShardID]struct{}{1: {}})
+	assert.Equal(t, 1, db.NumOfShards())
+	assert.Equal(t, []models.ShardID{1}, db.config.ShardIDs)
+	_, ok := db.GetShard(2)
+	assert.False(t, ok)
+
+	// dump config failure
+	encodeToml = func(fileName string, v interface{}) error {
+		return fmt.Errorf("err")
+	}
+	db.DropShards(map[models.ShardID]struct{}{})
+	assert.Equal(t, 1, db.NumOfShards())
+	// shard not exist
+	assert.NoError(t, db.dropShard(10))
+
+	// remove segments in cold storage
+	encodeToml = func(fileName string, v interface{}) error {
+		return nil
+	}
+	backend := tiering.NewMockBackend(ctrl)
+	tiering.InitBackend(backend)
+	defer tiering.InitBackend(nil)
+	db.name = "test-db"
+	backend.EXPECT().Delete(shardIndicator("test-db", 1)).Return(nil)
+	shard1.EXPECT().Close().Return(nil)
+	db.DropShards(map[models.ShardID]struct{}{})
+	assert.Equal(t, 0, db.NumOfShards())
+}
+
+func TestDatabase_UpdateOption(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		encodeToml = ltoml.EncodeToml
+		ctrl.Finish()
+	}()
+	shard1 := NewMockShard(ctrl)
+	set := newShardSet()
+	set.InsertShard(1, shard1)
+	newDB := func() *database {
+		return &database{
+			config: &databaseConfig{
+				ShardIDs: []models.ShardID{1},
+				Option: &option.DatabaseOption{
+					Intervals: option.Intervals{{Interval: timeutil.Interval(10 * timeutil.OneSecond), Retention: timeutil.Interval(timeutil.OneDay)}},
+				},
+			},
+			shardSet: *set,
+		}
+	}
+	newOpt := &option.DatabaseOption{
+		Intervals: option.Intervals{
+			{Interval: timeutil.Interval(timeutil.OneHour), Retention: timeutil.Interval(30 * timeutil.OneDay)},
+			{Interval: timeutil.Interval(10 * timeutil.OneSecond), Retention: timeutil.Interval(7 * timeutil.OneDay)},
+		},
+		Ahead: "2h",
+	}
+	cases := []struct {
+		name    string
+		opt     *option.DatabaseOption
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "option not changed",
+			opt: &option.DatabaseOption{
+				Intervals: option.Intervals{{Interval: timeutil.Interval(10 * timeutil.OneSecond), Retention: timeutil.Interval(timeutil.OneDay)}},
+			},
+		},
+		{
+			name: "writable interval changed",
+			opt: &option.DatabaseOption{
+				Intervals: option.Intervals{{Interval: timeutil.Interval(5 * timeutil.OneMinute)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dump config failure",
+			opt:  newOpt,
+			prepare: func() {
+				encodeToml = func(fileName string, v interface{}) error {
+					return fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "update shard option failure",
+			opt:  newOpt,
+			prepare: func() {
+				shard1.EXPECT().UpdateOption(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "update option successfully",
+			opt:  newOpt,
+			prepare: func() {
+				shard1.EXPECT().UpdateOption(gomock.Any()).Return(nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			encodeToml = func(fileName string, v interface{}) error {
+				return nil
+			}
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			db := newDB()
+			err := db.UpdateOption(tt.opt)
+			if (err != nil) != tt.wantErr {
+				t.Fatal(tt.name)
+			}
+			if err == nil && tt.opt == newOpt {
+				opt := db.GetOption()
+				// intervals sorted
+				assert.Equal(t, timeutil.Interval(10*timeutil.OneSecond), opt.Intervals[0].Interval)
+				assert.Equal(t, "2h", opt.Ahead)
+			}
+		})
+	}
 }
 
 func TestDatabase_TTL(t *testing.T) {
@@ -658,3 +820,201 @@ func Benchmark_ShardSet_binarySearch(b *testing.B) {
 		}
 	})
 }
+
+func TestDatabase_Checkpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		kv.InitStoreManager(nil)
+		copyFile = fileutil.CopyFile
+		ctrl.Finish()
+	}()
+
+	storeMgr := kv.NewMockStoreManager(ctrl)
+	kv.InitStoreManager(storeMgr)
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	metaStore := kv.NewMockStore(ctrl)
+	shardStore := kv.NewMockStore(ctrl)
+	shardStore.EXPECT().Name().Return("test/shard/1/index").AnyTimes()
+	otherStore := kv.NewMockStore(ctrl)
+	otherStore.EXPECT().Name().Return("test/shard/10/index").AnyTimes()
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().Indicator().Return("test/shard/1").AnyTimes()
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	shard.EXPECT().WaitFlushIndexCompleted().AnyTimes()
+	db := &database{
+		name:           "test",
+		metadata:       metadata,
+		metaStore:      metaStore,
+		shardSet:       *newShardSet(),
+		flushCondition: sync.NewCond(&sync.Mutex{}),
+		statistics:     metrics.NewDatabaseStatistics("test"),
+	}
+	db.shardSet.InsertShard(1, shard)
+
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "flush metadata failure",
+			prepare: func() {
+				metadata.EXPECT().Flush().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "flush shard index failure",
+			prepare: func() {
+				metadata.EXPECT().Flush().Return(nil)
+				shard.EXPECT().FlushIndex().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "checkpoint shard store failure",
+			prepare: func() {
+				metadata.EXPECT().Flush().Return(nil)
+				shard.EXPECT().FlushIndex().Return(nil)
+				storeMgr.EXPECT().GetStores().Return([]kv.Store{otherStore, shardStore})
+				shardStore.EXPECT().Checkpoint(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "checkpoint series id mapping failure",
+			prepare: func() {
+				metadata.EXPECT().Flush().Return(nil)
+				shard.EXPECT().FlushIndex().Return(nil)
+				storeMgr.EXPECT().GetStores().Return([]kv.Store{shardStore})
+				shardStore.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				indexDB.EXPECT().Checkpoint(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "flush metadata again failure",
+			prepare: func() {
+				metadata.EXPECT().Flush().Return(nil)
+				shard.EXPECT().FlushIndex().Return(nil)
+				storeMgr.EXPECT().GetStores().Return(nil)
+				indexDB.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				metadata.EXPECT().Flush().Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "checkpoint tag meta store failure",
+			prepare: func() {
+				metadata.EXPECT().Flush().Return(nil).Times(2)
+				shard.EXPECT().FlushIndex().Return(nil)
+				storeMgr.EXPECT().GetStores().Return(nil)
+				indexDB.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				metaStore.EXPECT().Checkpoint(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "checkpoint metadata failure",
+			prepare: func() {
+				metadata.EXPECT().Flush().Return(nil).Times(2)
+				shard.EXPECT().FlushIndex().Return(nil)
+				storeMgr.EXPECT().GetStores().Return(nil)
+				indexDB.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				metaStore.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				metadataDB.EXPECT().Checkpoint(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "copy options failure",
+			prepare: func() {
+				metadata.EXPECT().Flush().Return(nil).Times(2)
+				shard.EXPECT().FlushIndex().Return(nil)
+				storeMgr.EXPECT().GetStores().Return(nil)
+				indexDB.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				metaStore.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				metadataDB.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				copyFile = func(src, dst string) error {
+					return fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "copy tombstone failure",
+			prepare: func() {
+				metadata.EXPECT().Flush().Return(nil)
+				shard.EXPECT().FlushIndex().Return(nil)
+				storeMgr.EXPECT().GetStores().Return(nil)
+				indexDB.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				fileExist = func(file string) bool {
+					return true
+				}
+				copyFile = func(src, dst string) error {
+					return fmt.Errorf("err")
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name: "checkpoint successfully",
+			prepare: func() {
+				metadata.EXPECT().Flush().Return(nil).Times(2)
+				shard.EXPECT().FlushIndex().Return(nil)
+				storeMgr.EXPECT().GetStores().Return(nil)
+				indexDB.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				metaStore.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				metadataDB.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+				copyFile = func(src, dst string) error {
+					return nil
+				}
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				copyFile = fileutil.CopyFile
+				fileExist = fileutil.Exist
+			}()
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			if err := db.Checkpoint(t.TempDir()); (err != nil) != tt.wantErr {
+				t.Errorf("Checkpoint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDatabase_GetSeriesQuotaUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard1 := NewMockShard(ctrl)
+	shard2 := NewMockShard(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	shard1.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	shard2.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	set := newShardSet()
+	set.InsertShard(2, shard2)
+	set.InsertShard(1, shard1)
+	db := &database{shardSet: *set}
+
+	indexDB.EXPECT().GetSeriesQuotaUsage(10).Return(nil, fmt.Errorf("err"))
+	rs, err := db.GetSeriesQuotaUsage(10)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+
+	usages := []models.SeriesQuotaUsage{{Scope: models.ShardQuotaScope, Name: "db", Usage: 10}}
+	indexDB.EXPECT().GetSeriesQuotaUsage(10).Return(usages, nil).Times(2)
+	rs, err = db.GetSeriesQuotaUsage(10)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ShardSeriesQuota{{ShardID: 1, Usages: usages}, {ShardID: 2, Usages: usages}}, rs)
+}
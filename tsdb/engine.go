@@ -55,6 +55,8 @@ type Engine interface {
 	FlushDatabase(ctx context.Context, databaseName string) bool
 	// DropDatabases drops databases, keep active database.
 	DropDatabases(activeDatabases map[string]struct{})
+	// DropShards drops the shards of database, keep active shards.
+	DropShards(databaseName string, activeShards map[models.ShardID]struct{})
 	// TTL expires the data of each database base on time to live.
 	TTL()
 	// EvictSegment evicts segment which long term no read operation.
@@ -205,6 +207,15 @@ func (e *engine) DropDatabases(activeDatabases map[string]struct{}) {
 	}
 }
 
+// DropShards drops the shards of database, keep active shards.
+func (e *engine) DropShards(databaseName string, activeShards map[models.ShardID]struct{}) {
+	db, ok := e.dbSet.GetDatabase(databaseName)
+	if !ok {
+		return
+	}
+	db.DropShards(activeShards)
+}
+
 // TTL expires the data of each database base on time to live.
 func (e *engine) TTL() {
 	for _, db := range e.dbSet.Entries() {
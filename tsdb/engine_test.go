@@ -282,6 +282,20 @@ func TestEngine_DropDatabases(t *testing.T) {
 	assert.Len(t, engineImpl.dbSet.Entries(), 1)
 }
 
+func TestEngine_DropShards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, _ := NewEngine()
+	engineImpl := e.(*engine)
+	mockDatabase1 := NewMockDatabase(ctrl)
+	engineImpl.dbSet.PutDatabase("test_db_1", mockDatabase1)
+	mockDatabase1.EXPECT().DropShards(map[models.ShardID]struct{}{1: {}})
+	e.DropShards("test_db_1", map[models.ShardID]struct{}{1: {}})
+	// database not exist
+	e.DropShards("test_db_2", map[models.ShardID]struct{}{1: {}})
+}
+
 func TestEngine_TTL(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
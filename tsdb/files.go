@@ -39,6 +39,7 @@ import (
 //	xx/shard/1/(path)
 //	xx/shard/1/buffer/123213123131 // time of ns
 //	xx/shard/1/meta/
+//	xx/shard/1/TOMBSTONE => deleted series
 //	xx/shard/1/index/inverted/
 //	xx/shard/1/segment/day/20191012/
 //	xx/shard/1/segment/month/201910/
@@ -53,6 +54,7 @@ const (
 	forwardIndexDir  = "forward"
 	invertedIndexDir = "inverted"
 	bufferDir        = "buffer"
+	tombstoneFile    = "TOMBSTONE"
 )
 
 // createDatabasePath creates database's root path if existed.
@@ -104,9 +106,19 @@ func shardMetaPath(database string, shardID models.ShardID) string {
 	return filepath.Join(shardPath(database, shardID), metaDir)
 }
 
+// shardTombstonePath returns shard level tombstone file path.
+func shardTombstonePath(database string, shardID models.ShardID) string {
+	return filepath.Join(shardPath(database, shardID), tombstoneFile)
+}
+
 // ShardSegmentIndicator returns the segment name indicator information.
 func ShardSegmentIndicator(database string, shardID models.ShardID, interval timeutil.Interval, name string) string {
-	return filepath.Join(shardIndicator(database, shardID), segmentDir, interval.Type().String(), name)
+	return filepath.Join(shardIntervalIndicator(database, shardID, interval), name)
+}
+
+// shardIntervalIndicator returns the interval segment indicator information.
+func shardIntervalIndicator(database string, shardID models.ShardID, interval timeutil.Interval) string {
+	return filepath.Join(shardIndicator(database, shardID), segmentDir, interval.Type().String())
 }
 
 // ShardSegmentPath returns segment path in shard dir.
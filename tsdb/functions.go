@@ -18,6 +18,8 @@
 package tsdb
 
 import (
+	"os"
+
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/ltoml"
 	"github.com/lindb/lindb/tsdb/indexdb"
@@ -31,10 +33,16 @@ var (
 	mkDirIfNotExist        = fileutil.MkDirIfNotExist
 	listDir                = fileutil.ListDir
 	removeDir              = fileutil.RemoveDir
+	copyFile               = fileutil.CopyFile
 	fileExist              = fileutil.Exist
+	readFileFunc           = os.ReadFile
+	writeFileFunc          = os.WriteFile
+	renameFunc             = os.Rename
+	openFileFunc           = os.OpenFile
 	decodeToml             = ltoml.DecodeToml
 	newDatabaseFunc        = newDatabase
 	newSegmentFunc         = newSegment
+	newColdSegmentFunc     = newColdSegment
 	newMetadataFunc        = metadb.NewMetadata
 	newShardFunc           = newShard
 	encodeToml             = ltoml.EncodeToml
@@ -46,4 +54,5 @@ var (
 	newDataFamilyFunc      = newDataFamily
 	newMetricDataFlusher   = metricsdata.NewFlusher
 	closeFamilyFunc        = closeFamily
+	newTombstoneStoreFunc  = newTombstoneStore
 )
@@ -23,6 +23,8 @@ import (
 	"io"
 	"path"
 
+	"github.com/lindb/roaring"
+
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/pkg/fileutil"
@@ -41,6 +43,9 @@ var (
 
 const SeriesDB = "series"
 
+// droppedTagKeysKey is the key of dropped tag key ids, its length is different from metric/series key.
+var droppedTagKeysKey = []byte("__$$dropped_tag_keys$$__")
+
 // IDMappingBackend represents the id mapping backend storage,
 // save series data(tags hash => series id) under metric
 type IDMappingBackend interface {
@@ -55,6 +60,16 @@ type IDMappingBackend interface {
 	genSeriesID(metricID metric.ID, tagsHash uint64, seriesID uint32) error
 	// sync the backend memory data into persist storage.
 	sync() error
+	// checkpoint writes a consistent snapshot of backend storage into dir.
+	checkpoint(dir string) error
+	// countSeries returns the number of series for each metric.
+	countSeries() (metrics map[metric.ID]uint32, err error)
+	// deleteMetric deletes the series sequence and all series ids of metric.
+	deleteMetric(metricID metric.ID) error
+	// loadDroppedTagKeys loads the tag key ids of dropped metrics which not purged from index, returns nil if not exist.
+	loadDroppedTagKeys() (*roaring.Bitmap, error)
+	// saveDroppedTagKeys persists the tag key ids of dropped metrics which not purged from index.
+	saveDroppedTagKeys(tagKeyIDs *roaring.Bitmap) error
 }
 
 // idMappingBackend implements IDMappingBackend interface
@@ -143,3 +158,69 @@ func (imb *idMappingBackend) Close() error {
 func (imb *idMappingBackend) sync() error {
 	return imb.db.Flush()
 }
+
+// checkpoint writes a consistent snapshot of backend storage into dir.
+func (imb *idMappingBackend) checkpoint(dir string) error {
+	return imb.db.Checkpoint(path.Join(dir, SeriesDB))
+}
+
+// countSeries returns the number of series for each metric.
+func (imb *idMappingBackend) countSeries() (metrics map[metric.ID]uint32, err error) {
+	metrics = make(map[metric.ID]uint32)
+	err = imb.db.WalkKeys(nil, func(key []byte) bool {
+		// key: metric id(4 bytes) => series sequence, metric id + tags hash(8 bytes) => series id
+		if len(key) == 12 {
+			metrics[metric.ID(binary.LittleEndian.Uint32(key))]++
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
+// deleteMetric deletes the series sequence and all series ids of metric.
+func (imb *idMappingBackend) deleteMetric(metricID metric.ID) error {
+	var keys [][]byte
+	// key: metric id(4 bytes) => series sequence, metric id + tags hash(8 bytes) => series id
+	if err := imb.db.WalkKeys(metricID.MarshalBinary(), func(key []byte) bool {
+		if len(key) == 4 || len(key) == 12 {
+			keys = append(keys, append([]byte{}, key...))
+		}
+		return true
+	}); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := imb.db.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadDroppedTagKeys loads the tag key ids of dropped metrics which not purged from index, returns nil if not exist.
+func (imb *idMappingBackend) loadDroppedTagKeys() (*roaring.Bitmap, error) {
+	val, exist, err := imb.db.Get(droppedTagKeysKey)
+	if err != nil || !exist {
+		return nil, err
+	}
+	tagKeyIDs := roaring.New()
+	if err := tagKeyIDs.UnmarshalBinary(val); err != nil {
+		return nil, err
+	}
+	return tagKeyIDs, nil
+}
+
+// saveDroppedTagKeys persists the tag key ids of dropped metrics which not purged from index.
+func (imb *idMappingBackend) saveDroppedTagKeys(tagKeyIDs *roaring.Bitmap) error {
+	if tagKeyIDs == nil || tagKeyIDs.IsEmpty() {
+		return imb.db.Delete(droppedTagKeysKey)
+	}
+	val, err := tagKeyIDs.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return imb.db.Put(droppedTagKeysKey, val)
+}
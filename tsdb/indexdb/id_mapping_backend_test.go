@@ -24,6 +24,8 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/roaring"
+
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/unique"
@@ -104,6 +106,8 @@ func TestIDMappingBackend_Close_sync(t *testing.T) {
 
 	idStore.EXPECT().Flush().Return(fmt.Errorf("err"))
 	assert.Error(t, backend.sync())
+	idStore.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+	assert.NoError(t, backend.checkpoint(t.TempDir()))
 	idStore.EXPECT().Close().Return(nil)
 	assert.NoError(t, backend.Close())
 }
@@ -258,3 +262,100 @@ func TestIdMappingBackend_saveSeriesSequence(t *testing.T) {
 	store.EXPECT().Put([]byte{1, 0, 0, 0}, []byte{10, 0, 0, 0}).Return(nil)
 	assert.NoError(t, backend.saveSeriesSequence(metric.ID(1), uint32(10)))
 }
+
+func TestIDMappingBackend_countSeries(t *testing.T) {
+	backend, err := newIDMappingBackend(t.TempDir())
+	assert.NoError(t, err)
+	defer func() {
+		_ = backend.Close()
+	}()
+	assert.NoError(t, backend.saveSeriesSequence(metric.ID(1), 100))
+	assert.NoError(t, backend.genSeriesID(metric.ID(1), 1, 1))
+	assert.NoError(t, backend.genSeriesID(metric.ID(1), 2, 2))
+	assert.NoError(t, backend.genSeriesID(metric.ID(2), 1, 1))
+	metrics, err := backend.countSeries()
+	assert.NoError(t, err)
+	assert.Equal(t, map[metric.ID]uint32{1: 2, 2: 1}, metrics)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	idStore := unique.NewMockIDStore(ctrl)
+	idStore.EXPECT().WalkKeys(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	metrics, err = (&idMappingBackend{db: idStore}).countSeries()
+	assert.Error(t, err)
+	assert.Nil(t, metrics)
+}
+
+func TestIDMappingBackend_deleteMetric(t *testing.T) {
+	backend, err := newIDMappingBackend(t.TempDir())
+	assert.NoError(t, err)
+	defer func() {
+		_ = backend.Close()
+	}()
+	assert.NoError(t, backend.saveSeriesSequence(metric.ID(1), 100))
+	assert.NoError(t, backend.genSeriesID(metric.ID(1), 1, 1))
+	assert.NoError(t, backend.genSeriesID(metric.ID(1), 2, 2))
+	assert.NoError(t, backend.saveSeriesSequence(metric.ID(2), 100))
+	assert.NoError(t, backend.genSeriesID(metric.ID(2), 1, 1))
+	assert.NoError(t, backend.saveDroppedTagKeys(roaring.BitmapOf(1)))
+	assert.NoError(t, backend.deleteMetric(metric.ID(1)))
+	metrics, err := backend.countSeries()
+	assert.NoError(t, err)
+	assert.Equal(t, map[metric.ID]uint32{2: 1}, metrics)
+	_, err = backend.getSeriesID(metric.ID(1), 1)
+	assert.ErrorIs(t, err, constants.ErrNotFound)
+	seriesID, err := backend.getSeriesID(metric.ID(2), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), seriesID)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	idStore := unique.NewMockIDStore(ctrl)
+	// case 1: walk keys failure
+	idStore.EXPECT().WalkKeys(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, (&idMappingBackend{db: idStore}).deleteMetric(1))
+	// case 2: delete key failure
+	idStore.EXPECT().WalkKeys(gomock.Any(), gomock.Any()).DoAndReturn(func(prefix []byte, fn func(key []byte) bool) error {
+		fn(prefix)
+		return nil
+	})
+	idStore.EXPECT().Delete(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, (&idMappingBackend{db: idStore}).deleteMetric(1))
+}
+
+func TestIDMappingBackend_droppedTagKeys(t *testing.T) {
+	backend, err := newIDMappingBackend(t.TempDir())
+	assert.NoError(t, err)
+	defer func() {
+		_ = backend.Close()
+	}()
+	tagKeyIDs, err := backend.loadDroppedTagKeys()
+	assert.NoError(t, err)
+	assert.Nil(t, tagKeyIDs)
+	assert.NoError(t, backend.saveDroppedTagKeys(roaring.BitmapOf(1, 100)))
+	assert.NoError(t, backend.genSeriesID(metric.ID(1), 1, 1))
+	metrics, err := backend.countSeries()
+	assert.NoError(t, err)
+	assert.Equal(t, map[metric.ID]uint32{1: 1}, metrics)
+	tagKeyIDs, err = backend.loadDroppedTagKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 100}, tagKeyIDs.ToArray())
+	assert.NoError(t, backend.saveDroppedTagKeys(roaring.New()))
+	tagKeyIDs, err = backend.loadDroppedTagKeys()
+	assert.NoError(t, err)
+	assert.Nil(t, tagKeyIDs)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	idStore := unique.NewMockIDStore(ctrl)
+	// case 1: get failure
+	idStore.EXPECT().Get(droppedTagKeysKey).Return(nil, false, fmt.Errorf("err"))
+	tagKeyIDs, err = (&idMappingBackend{db: idStore}).loadDroppedTagKeys()
+	assert.Error(t, err)
+	assert.Nil(t, tagKeyIDs)
+	// case 2: unmarshal failure
+	idStore.EXPECT().Get(droppedTagKeysKey).Return([]byte{1, 2, 3}, true, nil)
+	tagKeyIDs, err = (&idMappingBackend{db: idStore}).loadDroppedTagKeys()
+	assert.Error(t, err)
+	assert.Nil(t, tagKeyIDs)
+}
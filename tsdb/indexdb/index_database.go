@@ -29,6 +29,8 @@ import (
 	"github.com/lindb/lindb/flow"
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/metric"
 	"github.com/lindb/lindb/series/tag"
@@ -49,6 +51,7 @@ type indexDatabase struct {
 	metricID2Mapping map[metric.ID]MetricIDMapping // key: metric id, value: metric id mapping
 	metadata         metadb.Metadata               // the metadata for generating ID of metric, field
 	index            InvertedIndex
+	quota            *seriesQuota // series quota of shard/namespace/metric
 
 	statistics *metrics.IndexDBStatistics
 
@@ -73,9 +76,16 @@ func NewIndexDatabase(ctx context.Context, parent string, metadata metadb.Metada
 		metadata:         metadata,
 		metricID2Mapping: make(map[metric.ID]MetricIDMapping),
 		index:            newInvertedIndex(metadata, forwardFamily, invertedFamily),
+		quota:            newSeriesQuota(metadata.DatabaseName(), backend, metadata),
 		statistics:       metrics.NewIndexDBStatistics(metadata.DatabaseName()),
 	}
-
+	// load the tag keys of dropped metrics which not purged from index files
+	droppedTagKeys, err := backend.loadDroppedTagKeys()
+	if err != nil {
+		_ = backend.Close()
+		return nil, err
+	}
+	db.index.setDroppedTagKeys(droppedTagKeys)
 	return db, nil
 }
 
@@ -91,8 +101,8 @@ func (db *indexDatabase) GetGroupingContext(ctx *flow.ShardExecuteContext) error
 
 // GetOrCreateSeriesID gets series by tags hash, if not exist generate new series id in memory,
 // if generate a new series id returns isCreate is true
-// if generate fail return err
-func (db *indexDatabase) GetOrCreateSeriesID(metricID metric.ID, tagsHash uint64,
+// if generate fail return err, returns *models.SeriesQuotaError if series quota exceeded.
+func (db *indexDatabase) GetOrCreateSeriesID(namespace, metricName string, metricID metric.ID, tagsHash uint64,
 ) (seriesID uint32, isCreated bool, err error) {
 	db.rwMutex.Lock()
 	defer db.rwMutex.Unlock()
@@ -124,6 +134,11 @@ func (db *indexDatabase) GetOrCreateSeriesID(metricID metric.ID, tagsHash uint64
 	if err != nil && !errors.Is(err, constants.ErrNotFound) {
 		return series.EmptySeriesID, false, err
 	}
+	// check series quota before creating new series
+	if err := db.quota.check(namespace, metricName, metricID); err != nil {
+		db.incQuotaExceeded(err)
+		return series.EmptySeriesID, false, err
+	}
 	// check if sequence need store
 	seq := metricIDMapping.SeriesSequence()
 	if !seq.HasNext() {
@@ -140,10 +155,44 @@ func (db *indexDatabase) GetOrCreateSeriesID(metricID metric.ID, tagsHash uint64
 	if err := db.backend.genSeriesID(metricID, tagsHash, seriesID); err != nil {
 		return series.EmptySeriesID, false, err
 	}
+	db.quota.incr(namespace, metricID)
 
 	return seriesID, true, nil
 }
 
+// SetSeriesQuota sets the series quota for creating new series.
+func (db *indexDatabase) SetSeriesQuota(quota option.ShardSeriesQuotaOption) {
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	db.quota.option = quota
+}
+
+// GetSeriesQuotaUsage returns the series usages of shard/namespace/metric,
+// returns top n metrics by series if limit > 0.
+func (db *indexDatabase) GetSeriesQuotaUsage(limit int) ([]models.SeriesQuotaUsage, error) {
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	return db.quota.usages(limit)
+}
+
+// incQuotaExceeded increases the statistics of series quota exceeded by scope.
+func (db *indexDatabase) incQuotaExceeded(err error) {
+	var quotaErr *models.SeriesQuotaError
+	if !errors.As(err, &quotaErr) {
+		return
+	}
+	switch quotaErr.Scope {
+	case models.ShardQuotaScope:
+		db.statistics.ShardSeriesQuotaExceeded.Incr()
+	case models.NamespaceQuotaScope:
+		db.statistics.NamespaceSeriesQuotaExceeded.Incr()
+	case models.MetricQuotaScope:
+		db.statistics.MetricSeriesQuotaExceeded.Incr()
+	}
+}
+
 // GetSeriesIDsByTagValueIDs gets series ids by tag value ids for spec tag key of metric
 func (db *indexDatabase) GetSeriesIDsByTagValueIDs(tagKeyID tag.KeyID, tagValueIDs *roaring.Bitmap) (*roaring.Bitmap, error) {
 	return db.index.GetSeriesIDsByTagValueIDs(tagKeyID, tagValueIDs)
@@ -199,6 +248,53 @@ func (db *indexDatabase) Flush() error {
 	return db.index.Flush()
 }
 
+// Checkpoint writes a consistent snapshot of series id mapping storage into dir.
+func (db *indexDatabase) Checkpoint(dir string) error {
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	return db.backend.checkpoint(dir)
+}
+
+// DropMetric drops the series id mapping and inverted index of metric,
+// must be invoked before dropping the metadata of metric, because it needs tag keys of metric.
+func (db *indexDatabase) DropMetric(namespace, metricName string, metricID metric.ID) error {
+	tags, err := db.metadata.MetadataDatabase().GetAllTagKeys(namespace, metricName)
+	if err != nil && !errors.Is(err, constants.ErrNotFound) {
+		return err
+	}
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	if err := db.backend.deleteMetric(metricID); err != nil {
+		return err
+	}
+	delete(db.metricID2Mapping, metricID)
+	db.quota.drop(namespace, metricID)
+
+	if len(tags) == 0 {
+		return nil
+	}
+	tagKeyIDs := roaring.New()
+	for _, tagMeta := range tags {
+		tagKeyIDs.Add(uint32(tagMeta.ID))
+	}
+	droppedTagKeys := db.index.dropTagKeys(tagKeyIDs)
+	return db.backend.saveDroppedTagKeys(droppedTagKeys)
+}
+
+// PurgeDroppedTagKeys purges the inverted index of dropped tag keys from index files.
+func (db *indexDatabase) PurgeDroppedTagKeys() error {
+	purged, err := db.index.purgeDroppedTagKeys()
+	if err != nil || purged == nil {
+		return err
+	}
+	db.rwMutex.Lock()
+	defer db.rwMutex.Unlock()
+
+	return db.backend.saveDroppedTagKeys(db.index.removeDroppedTagKeys(purged))
+}
+
 // Close closes the database, releases the resources
 func (db *indexDatabase) Close() error {
 	db.cancel()
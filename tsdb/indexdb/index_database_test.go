@@ -32,6 +32,9 @@ import (
 	"github.com/lindb/lindb/config"
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/unique"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/metric"
@@ -138,6 +141,7 @@ func TestIndexDatabase_GetOrCreateSeriesID(t *testing.T) {
 		metricID2Mapping: map[metric.ID]MetricIDMapping{
 			2: mapping,
 		},
+		quota: newSeriesQuota("test", backend, nil),
 	}
 
 	cases := []struct {
@@ -341,7 +345,7 @@ func TestIndexDatabase_GetOrCreateSeriesID(t *testing.T) {
 				tt.prepare()
 			}
 
-			seriesID, isCreate, err := db.GetOrCreateSeriesID(tt.metricID, tt.tagsHash)
+			seriesID, isCreate, err := db.GetOrCreateSeriesID("ns", "cpu", tt.metricID, tt.tagsHash)
 			assert.Equal(t, tt.out.seriesID, seriesID)
 			assert.Equal(t, tt.out.isCreate, isCreate)
 			assert.Equal(t, tt.out.err, err)
@@ -438,6 +442,7 @@ func TestIndexDatabase_Close(t *testing.T) {
 	createBackendFn = func(parent string) (IDMappingBackend, error) {
 		return backend, nil
 	}
+	backend.EXPECT().loadDroppedTagKeys().Return(nil, nil)
 	backend.EXPECT().sync().Return(nil)
 
 	meta := metadb.NewMockMetadata(ctrl)
@@ -465,6 +470,7 @@ func TestIndexDatabase_Flush(t *testing.T) {
 	createBackendFn = func(parent string) (IDMappingBackend, error) {
 		return backend, nil
 	}
+	backend.EXPECT().loadDroppedTagKeys().Return(nil, nil)
 
 	meta := metadb.NewMockMetadata(ctrl)
 	meta.EXPECT().DatabaseName().Return("test").AnyTimes()
@@ -475,4 +481,156 @@ func TestIndexDatabase_Flush(t *testing.T) {
 
 	backend.EXPECT().sync().Return(fmt.Errorf("err"))
 	assert.Error(t, db.Flush())
+	backend.EXPECT().checkpoint(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, db.Checkpoint(t.TempDir()))
+}
+
+func TestIndexDatabase_SeriesQuota(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sequence := unique.NewMockSequence(ctrl)
+	sequence.EXPECT().HasNext().Return(true).AnyTimes()
+	backend := NewMockIDMappingBackend(ctrl)
+	mapping := NewMockMetricIDMapping(ctrl)
+	mapping.EXPECT().GetSeriesID(gomock.Any()).Return(series.EmptySeriesID, false).AnyTimes()
+	mapping.EXPECT().SeriesSequence().Return(sequence).AnyTimes()
+	backend.EXPECT().getSeriesID(gomock.Any(), gomock.Any()).Return(series.EmptySeriesID, constants.ErrNotFound).AnyTimes()
+	db := &indexDatabase{
+		backend: backend,
+		metricID2Mapping: map[metric.ID]MetricIDMapping{
+			2: mapping,
+		},
+		quota:      newSeriesQuota("test", backend, nil),
+		statistics: metrics.NewIndexDBStatistics("test"),
+	}
+	db.SetSeriesQuota(option.ShardSeriesQuotaOption{MaxMetricSeries: 1})
+
+	backend.EXPECT().countSeries().Return(map[metric.ID]uint32{}, nil)
+	mapping.EXPECT().GenSeriesID(gomock.Any()).Return(uint32(1))
+	backend.EXPECT().genSeriesID(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	seriesID, isCreated, err := db.GetOrCreateSeriesID("ns", "cpu", 2, 10)
+	assert.NoError(t, err)
+	assert.True(t, isCreated)
+	assert.Equal(t, uint32(1), seriesID)
+	// metric quota exceeded
+	_, _, err = db.GetOrCreateSeriesID("ns", "cpu", 2, 11)
+	assert.ErrorIs(t, err, constants.ErrSeriesQuotaExceeded)
+	assert.Equal(t, float64(1), db.statistics.MetricSeriesQuotaExceeded.Get())
+
+	usages, err := db.GetSeriesQuotaUsage(0)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.SeriesQuotaUsage{
+		{Scope: models.ShardQuotaScope, Name: "test", Usage: 1},
+		{Scope: models.MetricQuotaScope, Name: "cpu", Quota: 1, Usage: 1},
+	}, usages)
+}
+
+func TestIndexDatabase_DropMetric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createBackendFn = newIDMappingBackend
+		ctrl.Finish()
+	}()
+	backend := NewMockIDMappingBackend(ctrl)
+	createBackendFn = func(parent string) (IDMappingBackend, error) {
+		return backend, nil
+	}
+	meta := metadb.NewMockMetadata(ctrl)
+	meta.EXPECT().DatabaseName().Return("test").AnyTimes()
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	meta.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+	// case 1: load dropped tag keys failure
+	backend.EXPECT().loadDroppedTagKeys().Return(nil, fmt.Errorf("err"))
+	backend.EXPECT().Close().Return(nil)
+	db, err := NewIndexDatabase(context.TODO(), t.TempDir(), meta, nil, nil)
+	assert.Error(t, err)
+	assert.Nil(t, db)
+
+	backend.EXPECT().loadDroppedTagKeys().Return(nil, nil)
+	db, err = NewIndexDatabase(context.TODO(), t.TempDir(), meta, nil, nil)
+	assert.NoError(t, err)
+	index := NewMockInvertedIndex(ctrl)
+	db1 := db.(*indexDatabase)
+	db1.index = index
+	db1.metricID2Mapping[10] = newMetricIDMapping(10, 0)
+	tags := tag.Metas{{Key: "host", ID: 1}, {Key: "ip", ID: 2}}
+
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "get tag keys failure",
+			prepare: func() {
+				metadataDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete metric failure",
+			prepare: func() {
+				metadataDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(tags, nil)
+				backend.EXPECT().deleteMetric(metric.ID(10)).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "metric without tags",
+			prepare: func() {
+				metadataDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(nil, constants.ErrNotFound)
+				backend.EXPECT().deleteMetric(metric.ID(10)).Return(nil)
+			},
+		},
+		{
+			name: "save dropped tag keys failure",
+			prepare: func() {
+				metadataDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(tags, nil)
+				backend.EXPECT().deleteMetric(metric.ID(10)).Return(nil)
+				index.EXPECT().dropTagKeys(roaring.BitmapOf(1, 2)).Return(roaring.BitmapOf(1, 2))
+				backend.EXPECT().saveDroppedTagKeys(roaring.BitmapOf(1, 2)).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "drop metric successfully",
+			prepare: func() {
+				metadataDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(tags, nil)
+				backend.EXPECT().deleteMetric(metric.ID(10)).Return(nil)
+				index.EXPECT().dropTagKeys(roaring.BitmapOf(1, 2)).Return(roaring.BitmapOf(1, 2, 3))
+				backend.EXPECT().saveDroppedTagKeys(roaring.BitmapOf(1, 2, 3)).Return(nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tt.prepare()
+			err := db.DropMetric("ns", "cpu", 10)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DropMetric() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+	assert.Empty(t, db1.metricID2Mapping)
+}
+
+func TestIndexDatabase_PurgeDroppedTagKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	backend := NewMockIDMappingBackend(ctrl)
+	index := NewMockInvertedIndex(ctrl)
+	db := &indexDatabase{backend: backend, index: index}
+	// case 1: purge failure
+	index.EXPECT().purgeDroppedTagKeys().Return(nil, fmt.Errorf("err"))
+	assert.Error(t, db.PurgeDroppedTagKeys())
+	// case 2: no dropped tag keys
+	index.EXPECT().purgeDroppedTagKeys().Return(nil, nil)
+	assert.NoError(t, db.PurgeDroppedTagKeys())
+	// case 3: purge successfully
+	index.EXPECT().purgeDroppedTagKeys().Return(roaring.BitmapOf(1, 2), nil)
+	index.EXPECT().removeDroppedTagKeys(roaring.BitmapOf(1, 2)).Return(roaring.BitmapOf(3))
+	backend.EXPECT().saveDroppedTagKeys(roaring.BitmapOf(3)).Return(nil)
+	assert.NoError(t, db.PurgeDroppedTagKeys())
 }
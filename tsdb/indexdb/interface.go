@@ -21,7 +21,9 @@ import (
 	"io"
 
 	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/series"
 	"github.com/lindb/lindb/series/metric"
 )
@@ -48,11 +50,24 @@ type IndexDatabase interface {
 	series.Filter
 	// GetOrCreateSeriesID gets series by tags hash, if not exist generate new series id in memory,
 	// if generate a new series id returns isCreate is true
-	// if generate fail return err
-	GetOrCreateSeriesID(metricID metric.ID, tagsHash uint64) (seriesID uint32, isCreated bool, err error)
+	// if generate fail return err, returns *models.SeriesQuotaError if series quota exceeded.
+	GetOrCreateSeriesID(namespace, metricName string, metricID metric.ID,
+		tagsHash uint64) (seriesID uint32, isCreated bool, err error)
+	// SetSeriesQuota sets the series quota for creating new series.
+	SetSeriesQuota(quota option.ShardSeriesQuotaOption)
+	// GetSeriesQuotaUsage returns the series usages of shard/namespace/metric,
+	// returns top n metrics by series if limit > 0.
+	GetSeriesQuotaUsage(limit int) ([]models.SeriesQuotaUsage, error)
 	// BuildInvertIndex builds the inverted index for tag value => series ids,
 	// the tags is considered as an empty key-value pair while tags is nil.
 	BuildInvertIndex(namespace, metricName string, tagIterator *metric.KeyValueIterator, seriesID uint32)
 	// Flush flushes index data to disk
 	Flush() error
+	// Checkpoint writes a consistent snapshot of series id mapping storage into dir.
+	Checkpoint(dir string) error
+	// DropMetric drops the series id mapping and inverted index of metric,
+	// must be invoked before dropping the metadata of metric, because it needs tag keys of metric.
+	DropMetric(namespace, metricName string, metricID metric.ID) error
+	// PurgeDroppedTagKeys purges the inverted index of dropped tag keys from index files.
+	PurgeDroppedTagKeys() error
 }
@@ -58,6 +58,15 @@ type InvertedIndex interface {
 	buildInvertIndex(namespace, metricName string, tagIterator *metric.KeyValueIterator, seriesID uint32)
 	// Flush flushes the inverted-index of tag value id=>series ids under tag key
 	Flush() error
+	// setDroppedTagKeys sets the tag keys of dropped metrics which not purged from index files.
+	setDroppedTagKeys(tagKeyIDs *roaring.Bitmap)
+	// dropTagKeys drops the index of tag keys, returns all dropped tag keys which not purged.
+	dropTagKeys(tagKeyIDs *roaring.Bitmap) *roaring.Bitmap
+	// purgeDroppedTagKeys purges the index of dropped tag keys from index files,
+	// returns the purged tag keys, returns nil if no tag keys dropped.
+	purgeDroppedTagKeys() (*roaring.Bitmap, error)
+	// removeDroppedTagKeys removes the purged tag keys from dropped tag keys, returns the remaining tag keys.
+	removeDroppedTagKeys(purged *roaring.Bitmap) *roaring.Bitmap
 }
 
 type invertedIndex struct {
@@ -67,6 +76,7 @@ type invertedIndex struct {
 
 	mutable   *TagIndexStore
 	immutable *TagIndexStore
+	dropped   *roaring.Bitmap // tag keys of dropped metrics which not purged from index files
 
 	rwMutex sync.RWMutex
 }
@@ -77,6 +87,7 @@ func newInvertedIndex(metadata metadb.Metadata, forwardFamily, invertedFamily kv
 		forwardFamily:  forwardFamily,
 		metadata:       metadata,
 		mutable:        NewTagIndexStore(),
+		dropped:        roaring.New(),
 	}
 }
 
@@ -293,7 +304,14 @@ func (index *invertedIndex) Flush() error {
 	if err != nil {
 		return err
 	}
+	index.rwMutex.RLock()
+	dropped := index.dropped.Clone()
+	index.rwMutex.RUnlock()
 	if err := index.immutable.WalkEntry(func(key uint32, value TagIndex) error {
+		if dropped.Contains(key) {
+			// metric of tag key dropped, ignore it
+			return nil
+		}
 		if err := value.flush(key, forward, inverted); err != nil {
 			return err
 		}
@@ -315,6 +333,69 @@ func (index *invertedIndex) Flush() error {
 	return nil
 }
 
+// setDroppedTagKeys sets the tag keys of dropped metrics which not purged from index files.
+func (index *invertedIndex) setDroppedTagKeys(tagKeyIDs *roaring.Bitmap) {
+	if tagKeyIDs == nil {
+		return
+	}
+	index.rwMutex.Lock()
+	defer index.rwMutex.Unlock()
+
+	index.dropped = tagKeyIDs.Clone()
+	index.setTombstone()
+}
+
+// dropTagKeys drops the index of tag keys, returns all dropped tag keys which not purged.
+func (index *invertedIndex) dropTagKeys(tagKeyIDs *roaring.Bitmap) *roaring.Bitmap {
+	index.rwMutex.Lock()
+	defer index.rwMutex.Unlock()
+
+	index.dropped.Or(tagKeyIDs)
+	index.setTombstone()
+	return index.dropped.Clone()
+}
+
+// purgeDroppedTagKeys purges the index of dropped tag keys from index files,
+// returns the purged tag keys, returns nil if no tag keys dropped.
+func (index *invertedIndex) purgeDroppedTagKeys() (*roaring.Bitmap, error) {
+	index.rwMutex.RLock()
+	dropped := index.dropped.Clone()
+	index.rwMutex.RUnlock()
+
+	if dropped.IsEmpty() {
+		return nil, nil
+	}
+	if err := index.forwardFamily.Purge(); err != nil {
+		return nil, err
+	}
+	if err := index.invertedFamily.Purge(); err != nil {
+		return nil, err
+	}
+	return dropped, nil
+}
+
+// removeDroppedTagKeys removes the purged tag keys from dropped tag keys, returns the remaining tag keys.
+func (index *invertedIndex) removeDroppedTagKeys(purged *roaring.Bitmap) *roaring.Bitmap {
+	index.rwMutex.Lock()
+	defer index.rwMutex.Unlock()
+
+	index.dropped.AndNot(purged)
+	index.setTombstone()
+	return index.dropped.Clone()
+}
+
+// setTombstone sets the dropped tag keys into kv families for purging index data when does compact job,
+// clears it if no tag keys dropped, so that compact job can move file.
+func (index *invertedIndex) setTombstone() {
+	if index.dropped.IsEmpty() {
+		index.forwardFamily.SetTombstone(nil)
+		index.invertedFamily.SetTombstone(nil)
+		return
+	}
+	index.forwardFamily.SetTombstone(index.dropped.Clone())
+	index.invertedFamily.SetTombstone(index.dropped.Clone())
+}
+
 // checkFlush checks if it needs to do flush job, if it needs, do switch mutable/immutable
 func (index *invertedIndex) checkFlush() bool {
 	index.rwMutex.Lock()
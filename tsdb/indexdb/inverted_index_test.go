@@ -372,3 +372,78 @@ func prepareInvertedIndex(ctrl *gomock.Controller) InvertedIndex {
 	}), 3)
 	return index
 }
+
+func TestInvertedIndex_droppedTagKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newInvertedFlusherFunc = tagindex.NewInvertedFlusher
+		newForwardFlusherFunc = tagindex.NewForwardFlusher
+		ctrl.Finish()
+	}()
+	forwardFamily := kv.NewMockFamily(ctrl)
+	invertedFamily := kv.NewMockFamily(ctrl)
+	index := newInvertedIndex(nil, forwardFamily, invertedFamily)
+	// case 1: no dropped tag keys
+	index.setDroppedTagKeys(nil)
+	purged, err := index.purgeDroppedTagKeys()
+	assert.NoError(t, err)
+	assert.Nil(t, purged)
+	// case 2: load dropped tag keys
+	forwardFamily.EXPECT().SetTombstone(roaring.BitmapOf(1))
+	invertedFamily.EXPECT().SetTombstone(roaring.BitmapOf(1))
+	index.setDroppedTagKeys(roaring.BitmapOf(1))
+	// case 3: drop tag keys
+	forwardFamily.EXPECT().SetTombstone(roaring.BitmapOf(1, 2, 3))
+	invertedFamily.EXPECT().SetTombstone(roaring.BitmapOf(1, 2, 3))
+	assert.Equal(t, roaring.BitmapOf(1, 2, 3), index.dropTagKeys(roaring.BitmapOf(2, 3)))
+	// case 4: purge forward failure
+	forwardFamily.EXPECT().Purge().Return(fmt.Errorf("err"))
+	purged, err = index.purgeDroppedTagKeys()
+	assert.Error(t, err)
+	assert.Nil(t, purged)
+	// case 5: purge inverted failure
+	forwardFamily.EXPECT().Purge().Return(nil)
+	invertedFamily.EXPECT().Purge().Return(fmt.Errorf("err"))
+	purged, err = index.purgeDroppedTagKeys()
+	assert.Error(t, err)
+	assert.Nil(t, purged)
+	// case 6: purge successfully
+	forwardFamily.EXPECT().Purge().Return(nil)
+	invertedFamily.EXPECT().Purge().Return(nil)
+	purged, err = index.purgeDroppedTagKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, roaring.BitmapOf(1, 2, 3), purged)
+	// case 7: tag key dropped when purging
+	forwardFamily.EXPECT().SetTombstone(roaring.BitmapOf(1, 2, 3, 4))
+	invertedFamily.EXPECT().SetTombstone(roaring.BitmapOf(1, 2, 3, 4))
+	index.dropTagKeys(roaring.BitmapOf(4))
+	forwardFamily.EXPECT().SetTombstone(roaring.BitmapOf(4))
+	invertedFamily.EXPECT().SetTombstone(roaring.BitmapOf(4))
+	assert.Equal(t, roaring.BitmapOf(4), index.removeDroppedTagKeys(purged))
+	// case 8: flush ignores dropped tag keys
+	f := kv.NewMockFlusher(ctrl)
+	f.EXPECT().Release().AnyTimes()
+	forward := tagindex.NewMockForwardFlusher(ctrl)
+	inverted := tagindex.NewMockInvertedFlusher(ctrl)
+	newForwardFlusherFunc = func(kvFlusher kv.Flusher) (tagindex.ForwardFlusher, error) {
+		return forward, nil
+	}
+	newInvertedFlusherFunc = func(kvFlusher kv.Flusher) (tagindex.InvertedFlusher, error) {
+		return inverted, nil
+	}
+	idx := index.(*invertedIndex)
+	tagIndex := NewMockTagIndex(ctrl)
+	droppedTagIndex := NewMockTagIndex(ctrl)
+	idx.mutable.Put(4, droppedTagIndex)
+	idx.mutable.Put(5, tagIndex)
+	forwardFamily.EXPECT().NewFlusher().Return(f)
+	invertedFamily.EXPECT().NewFlusher().Return(f)
+	tagIndex.EXPECT().flush(uint32(5), gomock.Any(), gomock.Any()).Return(nil)
+	forward.EXPECT().Close().Return(nil)
+	inverted.EXPECT().Close().Return(nil)
+	assert.NoError(t, index.Flush())
+	// case 9: all dropped tag keys purged
+	forwardFamily.EXPECT().SetTombstone(nil)
+	invertedFamily.EXPECT().SetTombstone(nil)
+	assert.True(t, index.removeDroppedTagKeys(roaring.BitmapOf(4)).IsEmpty())
+}
@@ -0,0 +1,227 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexdb
+
+import (
+	"math"
+	"sort"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+// metricSeries represents the number of series of metric.
+type metricSeries struct {
+	namespace string
+	name      string // metric name, empty if metric has no new series since loaded
+	series    uint32
+}
+
+// seriesQuota tracks the number of series for shard/namespace/metric,
+// checks series quota before creating new series.
+// The counters are loaded from id mapping backend lazily when series quota enabled.
+// NOTICE: seriesQuota is not thread safe, need protect by lock of index database.
+type seriesQuota struct {
+	database string
+	option   option.ShardSeriesQuotaOption
+	backend  IDMappingBackend
+	metadata metadb.Metadata
+
+	loaded     bool
+	total      uint32
+	metrics    map[metric.ID]*metricSeries
+	namespaces map[string]uint32 // only track the namespace which need check quota
+}
+
+// newSeriesQuota creates the series quota tracker.
+func newSeriesQuota(database string, backend IDMappingBackend, metadata metadb.Metadata) *seriesQuota {
+	return &seriesQuota{
+		database: database,
+		backend:  backend,
+		metadata: metadata,
+	}
+}
+
+// check checks if the new series of metric can be created, returns *models.SeriesQuotaError if quota exceeded.
+func (q *seriesQuota) check(namespace, metricName string, metricID metric.ID) error {
+	if !q.option.Enabled() && !q.loaded {
+		return nil
+	}
+	if err := q.load(); err != nil {
+		return err
+	}
+	ms := q.getMetricSeries(namespace, metricName, metricID)
+	if quota := q.option.MetricQuota(metricName); quota > 0 && ms.series >= quota {
+		return &models.SeriesQuotaError{
+			Scope: models.MetricQuotaScope, Namespace: namespace, Name: metricName, Quota: quota, Usage: ms.series,
+		}
+	}
+	if quota := q.option.MaxNamespaceSeries; quota > 0 {
+		nsSeries, err := q.getNamespaceSeries(namespace)
+		if err != nil {
+			return err
+		}
+		if nsSeries >= quota {
+			return &models.SeriesQuotaError{Scope: models.NamespaceQuotaScope, Name: namespace, Quota: quota, Usage: nsSeries}
+		}
+	}
+	if quota := q.option.MaxSeries; quota > 0 && q.total >= quota {
+		return &models.SeriesQuotaError{Scope: models.ShardQuotaScope, Name: q.database, Quota: quota, Usage: q.total}
+	}
+	return nil
+}
+
+// incr increases the counters after new series created.
+func (q *seriesQuota) incr(namespace string, metricID metric.ID) {
+	if !q.loaded {
+		return
+	}
+	q.total++
+	if ms, ok := q.metrics[metricID]; ok {
+		ms.series++
+	}
+	if _, ok := q.namespaces[namespace]; ok {
+		q.namespaces[namespace]++
+	}
+}
+
+// drop decreases the counters after the series of metric dropped.
+func (q *seriesQuota) drop(namespace string, metricID metric.ID) {
+	if !q.loaded {
+		return
+	}
+	ms, ok := q.metrics[metricID]
+	if !ok {
+		return
+	}
+	q.total -= ms.series
+	if series, ok := q.namespaces[namespace]; ok && series >= ms.series {
+		q.namespaces[namespace] = series - ms.series
+	}
+	delete(q.metrics, metricID)
+}
+
+// usages returns the series usages of shard/namespace/metric, metrics are sorted by series desc.
+func (q *seriesQuota) usages(limit int) ([]models.SeriesQuotaUsage, error) {
+	if err := q.load(); err != nil {
+		return nil, err
+	}
+	rs := []models.SeriesQuotaUsage{{
+		Scope: models.ShardQuotaScope,
+		Name:  q.database,
+		Quota: q.option.MaxSeries,
+		Usage: q.total,
+	}}
+	var namespaces []string
+	for namespace := range q.namespaces {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	for _, namespace := range namespaces {
+		rs = append(rs, models.SeriesQuotaUsage{
+			Scope: models.NamespaceQuotaScope,
+			Name:  namespace,
+			Quota: q.option.MaxNamespaceSeries,
+			Usage: q.namespaces[namespace],
+		})
+	}
+	var metrics []*metricSeries
+	for _, ms := range q.metrics {
+		// metric name unknown if metric has no new series since loaded
+		if ms.name != "" {
+			metrics = append(metrics, ms)
+		}
+	}
+	sort.Slice(metrics, func(i, j int) bool {
+		if metrics[i].series == metrics[j].series {
+			return metrics[i].name < metrics[j].name
+		}
+		return metrics[i].series > metrics[j].series
+	})
+	if limit > 0 && len(metrics) > limit {
+		metrics = metrics[:limit]
+	}
+	for _, ms := range metrics {
+		rs = append(rs, models.SeriesQuotaUsage{
+			Scope: models.MetricQuotaScope,
+			Name:  ms.name,
+			Quota: q.option.MetricQuota(ms.name),
+			Usage: ms.series,
+		})
+	}
+	return rs, nil
+}
+
+// load loads the number of series for each metric from backend if not loaded.
+func (q *seriesQuota) load() error {
+	if q.loaded {
+		return nil
+	}
+	metrics, err := q.backend.countSeries()
+	if err != nil {
+		return err
+	}
+	q.metrics = make(map[metric.ID]*metricSeries, len(metrics))
+	q.namespaces = make(map[string]uint32)
+	q.total = 0
+	for metricID, series := range metrics {
+		q.metrics[metricID] = &metricSeries{series: series}
+		q.total += series
+	}
+	q.loaded = true
+	return nil
+}
+
+// getMetricSeries returns the series counter of metric, creates it if not exist.
+func (q *seriesQuota) getMetricSeries(namespace, metricName string, metricID metric.ID) *metricSeries {
+	ms, ok := q.metrics[metricID]
+	if !ok {
+		ms = &metricSeries{}
+		q.metrics[metricID] = ms
+	}
+	ms.namespace = namespace
+	ms.name = metricName
+	return ms
+}
+
+// getNamespaceSeries returns the number of series under namespace,
+// sums the series of all metrics under namespace when first checking.
+func (q *seriesQuota) getNamespaceSeries(namespace string) (uint32, error) {
+	if series, ok := q.namespaces[namespace]; ok {
+		return series, nil
+	}
+	metricNames, err := q.metadata.MetadataDatabase().SuggestMetrics(namespace, "", math.MaxInt32)
+	if err != nil {
+		return 0, err
+	}
+	series := uint32(0)
+	for _, metricName := range metricNames {
+		metricID, err := q.metadata.MetadataDatabase().GetMetricID(namespace, metricName)
+		if err != nil {
+			// metric maybe dropped
+			continue
+		}
+		if ms, ok := q.metrics[metricID]; ok {
+			series += ms.series
+		}
+	}
+	q.namespaces[namespace] = series
+	return series, nil
+}
@@ -0,0 +1,191 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package indexdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+func TestSeriesQuota_check(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	backend := NewMockIDMappingBackend(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+
+	cases := []struct {
+		name    string
+		option  option.ShardSeriesQuotaOption
+		prepare func()
+		scope   models.SeriesQuotaScope
+		wantErr bool
+	}{
+		{
+			name: "quota disabled",
+		},
+		{
+			name:   "load series failure",
+			option: option.ShardSeriesQuotaOption{MaxSeries: 10},
+			prepare: func() {
+				backend.EXPECT().countSeries().Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:   "metric quota exceeded",
+			option: option.ShardSeriesQuotaOption{MaxMetricSeries: 10, Metrics: map[string]uint32{"cpu": 3}},
+			prepare: func() {
+				backend.EXPECT().countSeries().Return(map[metric.ID]uint32{1: 3, 2: 5}, nil)
+			},
+			scope:   models.MetricQuotaScope,
+			wantErr: true,
+		},
+		{
+			name:   "suggest metrics failure",
+			option: option.ShardSeriesQuotaOption{MaxNamespaceSeries: 8},
+			prepare: func() {
+				backend.EXPECT().countSeries().Return(map[metric.ID]uint32{1: 3, 2: 5}, nil)
+				metadataDB.EXPECT().SuggestMetrics("ns", "", gomock.Any()).Return(nil, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name:   "namespace quota exceeded",
+			option: option.ShardSeriesQuotaOption{MaxNamespaceSeries: 8},
+			prepare: func() {
+				backend.EXPECT().countSeries().Return(map[metric.ID]uint32{1: 3, 2: 5}, nil)
+				metadataDB.EXPECT().SuggestMetrics("ns", "", gomock.Any()).Return([]string{"cpu", "mem", "disk"}, nil)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(1), nil)
+				metadataDB.EXPECT().GetMetricID("ns", "mem").Return(metric.ID(2), nil)
+				metadataDB.EXPECT().GetMetricID("ns", "disk").Return(metric.ID(0), fmt.Errorf("err"))
+			},
+			scope:   models.NamespaceQuotaScope,
+			wantErr: true,
+		},
+		{
+			name:   "shard quota exceeded",
+			option: option.ShardSeriesQuotaOption{MaxSeries: 8},
+			prepare: func() {
+				backend.EXPECT().countSeries().Return(map[metric.ID]uint32{1: 3, 2: 5}, nil)
+			},
+			scope:   models.ShardQuotaScope,
+			wantErr: true,
+		},
+		{
+			name:   "quota not exceeded",
+			option: option.ShardSeriesQuotaOption{MaxSeries: 9, MaxNamespaceSeries: 9, MaxMetricSeries: 4},
+			prepare: func() {
+				backend.EXPECT().countSeries().Return(map[metric.ID]uint32{1: 3, 2: 5}, nil)
+				metadataDB.EXPECT().SuggestMetrics("ns", "", gomock.Any()).Return([]string{"cpu", "mem"}, nil)
+				metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(1), nil)
+				metadataDB.EXPECT().GetMetricID("ns", "mem").Return(metric.ID(2), nil)
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			q := newSeriesQuota("db", backend, metadata)
+			q.option = tt.option
+			err := q.check("ns", "cpu", 1)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("check() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.scope != "" {
+				var quotaErr *models.SeriesQuotaError
+				assert.ErrorAs(t, err, &quotaErr)
+				assert.Equal(t, tt.scope, quotaErr.Scope)
+			}
+		})
+	}
+}
+
+func TestSeriesQuota_usages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	backend := NewMockIDMappingBackend(ctrl)
+	metadata := metadb.NewMockMetadata(ctrl)
+	metadataDB := metadb.NewMockMetadataDatabase(ctrl)
+	metadata.EXPECT().MetadataDatabase().Return(metadataDB).AnyTimes()
+
+	q := newSeriesQuota("db", backend, metadata)
+	// load failure
+	backend.EXPECT().countSeries().Return(nil, fmt.Errorf("err"))
+	rs, err := q.usages(0)
+	assert.Error(t, err)
+	assert.Nil(t, rs)
+	// incr before loaded
+	q.incr("ns", 1)
+	assert.Zero(t, q.total)
+
+	backend.EXPECT().countSeries().Return(map[metric.ID]uint32{1: 3, 2: 5, 3: 1}, nil)
+	metadataDB.EXPECT().SuggestMetrics("ns", "", gomock.Any()).Return([]string{"cpu", "mem"}, nil)
+	metadataDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(1), nil)
+	metadataDB.EXPECT().GetMetricID("ns", "mem").Return(metric.ID(2), nil)
+	q.option = option.ShardSeriesQuotaOption{MaxSeries: 100, MaxNamespaceSeries: 50, Metrics: map[string]uint32{"cpu": 10}}
+	assert.NoError(t, q.check("ns", "cpu", 1))
+	q.incr("ns", 1)
+	assert.NoError(t, q.check("ns", "mem", 2))
+	q.incr("ns", 2)
+	// tracking continue after quota disabled
+	q.option = option.ShardSeriesQuotaOption{}
+	assert.NoError(t, q.check("ns", "mem", 2))
+	q.incr("ns", 2)
+
+	rs, err = q.usages(0)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.SeriesQuotaUsage{
+		{Scope: models.ShardQuotaScope, Name: "db", Usage: 12},
+		{Scope: models.NamespaceQuotaScope, Name: "ns", Usage: 11},
+		{Scope: models.MetricQuotaScope, Name: "mem", Usage: 7},
+		{Scope: models.MetricQuotaScope, Name: "cpu", Usage: 4},
+	}, rs)
+	rs, err = q.usages(1)
+	assert.NoError(t, err)
+	assert.Len(t, rs, 3)
+
+	// drop metric
+	q.drop("ns", 100)
+	q.drop("ns", 2)
+	rs, err = q.usages(0)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.SeriesQuotaUsage{
+		{Scope: models.ShardQuotaScope, Name: "db", Usage: 5},
+		{Scope: models.NamespaceQuotaScope, Name: "ns", Usage: 4},
+		{Scope: models.MetricQuotaScope, Name: "cpu", Usage: 4},
+	}, rs)
+	// drop before loaded
+	q = newSeriesQuota("db", backend, metadata)
+	q.drop("ns", 1)
+	assert.Zero(t, q.total)
+}
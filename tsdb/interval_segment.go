@@ -19,12 +19,15 @@ package tsdb
 
 import (
 	"fmt"
+	"math"
 	"path"
+	"path/filepath"
 	"sync"
 
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/tsdb/tiering"
 )
 
 //go:generate mockgen -source=./interval_segment.go -destination=./interval_segment_mock.go -package=tsdb
@@ -41,20 +44,41 @@ type IntervalSegment interface {
 	TTL() error
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
+	// UpdateOption updates the interval option(retention) after database option altered,
+	// and refreshes the rollup intervals of loaded segments.
+	UpdateOption(interval option.Interval)
+	// PurgeTombstones purges the deleted data of tombstones from the data families of local segments,
+	// returns the tombstones which cannot be purged, includes purge failure or overlap cold segments(read only).
+	PurgeTombstones(tombstones []*Tombstone) (retained []*Tombstone)
+	// RefreshTombstones refreshes the deleted data of loaded data families which overlap the time range.
+	RefreshTombstones(timeRange timeutil.TimeRange)
 }
 
 // intervalSegment implements IntervalSegment interface
 type intervalSegment struct {
-	dir      string
-	shard    Shard
-	interval option.Interval
-	segments map[string]Segment
+	dir       string
+	indicator string // relative path of interval segment, also is the key prefix in cold storage
+	shard     Shard
+	interval  option.Interval
+	segments  map[string]Segment
+
+	// cached segment names in cold storage, nil means not loaded, refreshed when ttl/move segment into cold storage.
+	coldSegments []string
+	// restoring cold segments, make sure only one goroutine downloads the same segment.
+	restoring map[string]*restoreCall
 
 	mutex sync.Mutex
 
 	logger *logger.Logger
 }
 
+// restoreCall represents an in-flight restoring of cold segment.
+type restoreCall struct {
+	done    chan struct{}
+	segment Segment
+	err     error
+}
+
 // newIntervalSegment create interval segment based on interval/type/path etc.
 func newIntervalSegment(shard Shard, interval option.Interval) (segment IntervalSegment, err error) {
 	dir := ShardSegmentPath(shard.Database().Name(), shard.ShardID(), interval.Interval)
@@ -63,11 +87,12 @@ func newIntervalSegment(shard Shard, interval option.Interval) (segment Interval
 		return nil, err
 	}
 	return &intervalSegment{
-		dir:      dir,
-		shard:    shard,
-		interval: interval,
-		segments: make(map[string]Segment),
-		logger:   logger.GetLogger("TSDB", "IntervalSegment"),
+		dir:       dir,
+		indicator: shardIntervalIndicator(shard.Database().Name(), shard.ShardID(), interval.Interval),
+		shard:     shard,
+		interval:  interval,
+		segments:  make(map[string]Segment),
+		logger:    logger.GetLogger("TSDB", "IntervalSegment"),
 	}, nil
 }
 
@@ -79,6 +104,16 @@ func (s *intervalSegment) GetOrCreateSegment(segmentName string) (Segment, error
 	if segment, ok := s.segments[segmentName]; ok {
 		return segment, nil
 	}
+	if backend := tiering.GetBackend(); backend != nil && !fileExist(filepath.Join(s.dir, segmentName)) {
+		// cold segment is read only, cannot write data into it
+		cold, err := backend.Exist(filepath.Join(s.indicator, segmentName))
+		if err != nil {
+			return nil, fmt.Errorf("check segment if in cold storage error: %s", err)
+		}
+		if cold {
+			return nil, fmt.Errorf("segment[%s] moved into cold storage, cannot write", segmentName)
+		}
+	}
 	seg, err := newSegmentFunc(s.shard, segmentName, s.interval.Interval)
 	if err != nil {
 		return nil, fmt.Errorf("create segmenet error: %s", err)
@@ -98,7 +133,7 @@ func (s *intervalSegment) GetDataFamilies(timeRange timeutil.TimeRange) []DataFa
 		End:   timeRange.End,
 	}
 
-	expireInterval := s.interval.Retention.Int64()
+	expireInterval := s.getRetention()
 	if err := s.walkSegment(func(segmentName string, segmentTime int64) {
 		if now-segmentTime >= expireInterval {
 			// segment is expired, need to ignore
@@ -132,21 +167,59 @@ func (s *intervalSegment) GetDataFamilies(timeRange timeutil.TimeRange) []DataFa
 
 // getOrLoadSegment returns segment for current interval.
 // 1. return segment if it's exist in memory cache;
-// 2. return segment if it's exist in storage.
+// 2. return segment if it's exist in storage;
+// 3. return segment if it's moved into cold storage.
 func (s *intervalSegment) getOrLoadSegment(segmentName string) (Segment, error) {
 	s.mutex.Lock()
-	defer s.mutex.Unlock()
-
 	if segment, ok := s.segments[segmentName]; ok {
+		s.mutex.Unlock()
+		return segment, nil
+	}
+	backend := tiering.GetBackend()
+	if backend == nil || fileExist(filepath.Join(s.dir, segmentName)) {
+		defer s.mutex.Unlock()
+
+		segment, err := newSegmentFunc(s.shard, segmentName, s.interval.Interval)
+		if err != nil {
+			return nil, err
+		}
+		s.segments[segmentName] = segment
 		return segment, nil
 	}
-	var err error
-	segment, err := newSegmentFunc(s.shard, segmentName, s.interval.Interval)
+	// restore cold segment without lock, because downloading files from cold storage maybe slow,
+	// the goroutines which load the same segment wait for the in-flight restoring.
+	if s.restoring == nil {
+		s.restoring = make(map[string]*restoreCall)
+	}
+	call, ok := s.restoring[segmentName]
+	if ok {
+		s.mutex.Unlock()
+		<-call.done
+		return call.segment, call.err
+	}
+	call = &restoreCall{done: make(chan struct{})}
+	s.restoring[segmentName] = call
+	s.mutex.Unlock()
+
+	call.segment, call.err = s.restoreSegment(backend, segmentName)
+
+	s.mutex.Lock()
+	delete(s.restoring, segmentName)
+	if call.err == nil {
+		s.segments[segmentName] = call.segment
+	}
+	s.mutex.Unlock()
+	close(call.done)
+	return call.segment, call.err
+}
+
+// restoreSegment restores the files of segment from cold storage, then opens it as cold segment.
+func (s *intervalSegment) restoreSegment(backend tiering.Backend, segmentName string) (Segment, error) {
+	dir, err := backend.Restore(filepath.Join(s.indicator, segmentName))
 	if err != nil {
 		return nil, err
 	}
-	s.segments[segmentName] = segment
-	return segment, nil
+	return newColdSegmentFunc(s.shard, segmentName, s.interval.Interval, dir)
 }
 
 // Close closes interval segment, release resource
@@ -160,15 +233,29 @@ func (s *intervalSegment) Close() {
 	}
 }
 
-// TTL expires segment base on time to live.
+// TTL expires segment base on time to live, and moves old segment into cold storage based on tiering policy.
 func (s *intervalSegment) TTL() error {
 	now := timeutil.Now()
-	expireInterval := s.interval.Retention.Int64()
+	expireInterval := s.getRetention()
+	backend := tiering.GetBackend()
+	coldAfter := int64(0)
+	if backend != nil {
+		coldAfter = s.shard.Database().GetOption().GetColdAfter()
+		// refresh the cached cold segments periodically, they maybe changed by other node(e.g. shard migration)
+		s.refreshColdSegments(backend)
+	}
+	calc := s.interval.Interval.Calculator()
 
 	return s.walkSegment(func(segmentName string, segmentTime int64) {
 		// add 2 hours buffer, for some cases stop write.
 		if now-segmentTime > expireInterval+2*timeutil.OneHour {
 			s.dropSegment(segmentName)
+			return
+		}
+		// the whole segment is older than cold after, move it into cold storage
+		if coldAfter > 0 && calc.CalcSegmentTime(now-coldAfter) > segmentTime &&
+			fileExist(filepath.Join(s.dir, segmentName)) {
+			s.moveToCold(backend, segmentName)
 		}
 	})
 }
@@ -178,12 +265,21 @@ func (s *intervalSegment) EvictSegment() {
 	s.mutex.Lock()
 	defer s.mutex.Unlock()
 
+	backend := tiering.GetBackend()
 	for segmentName := range s.segments {
 		segment := s.segments[segmentName]
 		// add 2 hours buffer, for some cases stop write.
 		if segment.NeedEvict() {
 			segment.Close()
 			delete(s.segments, segmentName)
+			if backend != nil && !fileExist(filepath.Join(s.dir, segmentName)) {
+				// release the cache files of cold segment
+				if err := backend.Release(filepath.Join(s.indicator, segmentName)); err != nil {
+					s.logger.Warn("release cold segment failure",
+						logger.String("path", s.dir), logger.String("segment", segmentName),
+						logger.Error(err))
+				}
+			}
 
 			s.logger.Info("evict segment complete",
 				logger.String("path", s.dir), logger.String("segment", segmentName))
@@ -191,12 +287,142 @@ func (s *intervalSegment) EvictSegment() {
 	}
 }
 
-// walkSegment lists all segment under current interval segment dir.
+// UpdateOption updates the interval option(retention) after database option altered,
+// and refreshes the rollup intervals of loaded segments.
+func (s *intervalSegment) UpdateOption(interval option.Interval) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.interval.Retention = interval.Retention
+	for _, segment := range s.segments {
+		segment.RefreshRollup()
+	}
+}
+
+// PurgeTombstones purges the deleted data of tombstones from the data families of local segments,
+// returns the tombstones which cannot be purged, includes purge failure or overlap cold segments(read only).
+// The tombstones only overlap expired segments are purged, because expired data cannot be read.
+func (s *intervalSegment) PurgeTombstones(tombstones []*Tombstone) (retained []*Tombstone) {
+	now := timeutil.Now()
+	expireInterval := s.getRetention()
+	calc := s.interval.Interval.Calculator()
+	backend := tiering.GetBackend()
+	retainedSet := make(map[*Tombstone]struct{})
+	retain := func(tombstones []*Tombstone) {
+		for _, tombstone := range tombstones {
+			retainedSet[tombstone] = struct{}{}
+		}
+	}
+	if err := s.walkSegment(func(segmentName string, segmentTime int64) {
+		if now-segmentTime >= expireInterval {
+			// segment is expired, need to ignore
+			return
+		}
+		var overlaps []*Tombstone
+		timeRange := timeutil.TimeRange{Start: math.MaxInt64, End: math.MinInt64}
+		for _, tombstone := range tombstones {
+			segmentTimeRange := timeutil.TimeRange{
+				Start: calc.CalcSegmentTime(tombstone.TimeRange.Start),
+				End:   tombstone.TimeRange.End,
+			}
+			if segmentTimeRange.Contains(segmentTime) {
+				overlaps = append(overlaps, tombstone)
+				if tombstone.TimeRange.Start < timeRange.Start {
+					timeRange.Start = tombstone.TimeRange.Start
+				}
+				if tombstone.TimeRange.End > timeRange.End {
+					timeRange.End = tombstone.TimeRange.End
+				}
+			}
+		}
+		if len(overlaps) == 0 {
+			return
+		}
+		if backend != nil && !fileExist(filepath.Join(s.dir, segmentName)) {
+			// cold segment is read only, keep tombstones for filtering deleted data when query
+			retain(overlaps)
+			return
+		}
+		segment, err := s.getOrLoadSegment(segmentName)
+		if err != nil {
+			s.logger.Warn("load segment failure when purge tombstones",
+				logger.String("path", s.dir), logger.String("segment", segmentName), logger.Error(err))
+			retain(overlaps)
+			return
+		}
+		if timeRange.Start < segmentTime {
+			timeRange.Start = segmentTime
+		}
+		for _, family := range segment.GetDataFamilies(timeRange) {
+			familyTimeRange := family.TimeRange()
+			var familyTombstones []*Tombstone
+			for _, tombstone := range overlaps {
+				if tombstone.TimeRange.Overlap(familyTimeRange) {
+					familyTombstones = append(familyTombstones, tombstone)
+				}
+			}
+			if len(familyTombstones) == 0 {
+				continue
+			}
+			if err := family.purgeTombstones(); err != nil {
+				s.logger.Warn("purge tombstones of family failure",
+					logger.String("family", family.Indicator()), logger.Error(err))
+				retain(familyTombstones)
+			}
+		}
+	}); err != nil {
+		s.logger.Warn("list segment failure when purge tombstones",
+			logger.String("path", s.dir), logger.Error(err))
+		return tombstones
+	}
+	for _, tombstone := range tombstones {
+		if _, ok := retainedSet[tombstone]; ok {
+			retained = append(retained, tombstone)
+		}
+	}
+	return retained
+}
+
+// RefreshTombstones refreshes the deleted data of loaded data families which overlap the time range.
+func (s *intervalSegment) RefreshTombstones(timeRange timeutil.TimeRange) {
+	s.mutex.Lock()
+	segments := make([]Segment, 0, len(s.segments))
+	for _, segment := range s.segments {
+		segments = append(segments, segment)
+	}
+	s.mutex.Unlock()
+
+	for _, segment := range segments {
+		familyTimeRange := timeRange
+		if familyTimeRange.Start < segment.BaseTime() {
+			familyTimeRange.Start = segment.BaseTime()
+		}
+		if familyTimeRange.Start > familyTimeRange.End {
+			continue
+		}
+		for _, family := range segment.GetDataFamilies(familyTimeRange) {
+			family.refreshTombstones()
+		}
+	}
+}
+
+// getRetention returns the retention of current interval segment.
+func (s *intervalSegment) getRetention() int64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.interval.Retention.Int64()
+}
+
+// walkSegment lists all segment under current interval segment dir and cold storage.
 func (s *intervalSegment) walkSegment(fn func(segmentName string, segmentTime int64)) error {
 	segmentNames, err := listDir(s.dir)
 	if err != nil {
 		return err
 	}
+	if backend := tiering.GetBackend(); backend != nil {
+		segmentNames = mergeSegmentNames(segmentNames, s.listColdSegments(backend))
+	}
 	for _, segmentName := range segmentNames {
 		calc := s.interval.Interval.Calculator()
 		baseTime, err := calc.ParseSegmentTime(segmentName)
@@ -211,6 +437,54 @@ func (s *intervalSegment) walkSegment(fn func(segmentName string, segmentTime in
 	return nil
 }
 
+// listColdSegments returns the cached segment names in cold storage, loads them if not cached.
+func (s *intervalSegment) listColdSegments(backend tiering.Backend) []string {
+	s.mutex.Lock()
+	coldSegmentNames := s.coldSegments
+	s.mutex.Unlock()
+	if coldSegmentNames != nil {
+		return coldSegmentNames
+	}
+	return s.refreshColdSegments(backend)
+}
+
+// refreshColdSegments lists the segment names from cold storage, then caches them.
+func (s *intervalSegment) refreshColdSegments(backend tiering.Backend) []string {
+	coldSegmentNames, err := backend.List(s.indicator)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err != nil {
+		// ignore cold segments, make sure the segments in local storage can be read
+		s.logger.Warn("list segment from cold storage failure",
+			logger.String("path", s.indicator), logger.Error(err))
+		return s.coldSegments
+	}
+	if coldSegmentNames == nil {
+		coldSegmentNames = []string{}
+	}
+	s.coldSegments = coldSegmentNames
+	return coldSegmentNames
+}
+
+// updateColdSegments updates the cached cold segments if loaded, need hold lock.
+// the cached slice is copied on write, because it maybe walked without lock.
+func (s *intervalSegment) updateColdSegments(segmentName string, add bool) {
+	if s.coldSegments == nil {
+		return
+	}
+	coldSegmentNames := make([]string, 0, len(s.coldSegments)+1)
+	for _, name := range s.coldSegments {
+		if name != segmentName {
+			coldSegmentNames = append(coldSegmentNames, name)
+		}
+	}
+	if add {
+		coldSegmentNames = append(coldSegmentNames, segmentName)
+	}
+	s.coldSegments = coldSegmentNames
+}
+
 // dropSegment drops segment's data.
 func (s *intervalSegment) dropSegment(segmentName string) {
 	s.mutex.Lock()
@@ -227,6 +501,60 @@ func (s *intervalSegment) dropSegment(segmentName string) {
 			logger.Error(err))
 		return
 	}
+	if backend := tiering.GetBackend(); backend != nil {
+		if err := backend.Delete(filepath.Join(s.indicator, segmentName)); err != nil {
+			s.logger.Warn("remove segment from cold storage failure",
+				logger.String("path", s.dir), logger.String("segment", segmentName),
+				logger.Error(err))
+			return
+		}
+		s.updateColdSegments(segmentName, false)
+	}
 	s.logger.Info("do segment ttl successfully",
 		logger.String("path", s.dir), logger.String("segment", segmentName))
 }
+
+// moveToCold closes the segment, then moves its files into cold storage,
+// skips the segment which is in use(same as evict segment), try moving it in next ttl.
+func (s *intervalSegment) moveToCold(backend tiering.Backend, segmentName string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if segment, ok := s.segments[segmentName]; ok {
+		if !segment.NeedEvict() {
+			s.logger.Info("segment is in use, move it into cold storage later",
+				logger.String("path", s.dir), logger.String("segment", segmentName))
+			return
+		}
+		segment.Close()
+	}
+	delete(s.segments, segmentName)
+
+	if err := backend.Archive(filepath.Join(s.indicator, segmentName), filepath.Join(s.dir, segmentName)); err != nil {
+		s.logger.Warn("move segment into cold storage failure",
+			logger.String("path", s.dir), logger.String("segment", segmentName),
+			logger.Error(err))
+		return
+	}
+	s.updateColdSegments(segmentName, true)
+	s.logger.Info("move segment into cold storage successfully",
+		logger.String("path", s.dir), logger.String("segment", segmentName))
+}
+
+// mergeSegmentNames merges the segment names of local/cold storage, removes the duplicate names.
+func mergeSegmentNames(names, coldNames []string) []string {
+	if len(coldNames) == 0 {
+		return names
+	}
+	exist := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		exist[name] = struct{}{}
+	}
+	for _, name := range coldNames {
+		if _, ok := exist[name]; !ok {
+			exist[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -19,6 +19,8 @@ package tsdb
 
 import (
 	"fmt"
+	"path/filepath"
+	"sync"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -29,6 +31,7 @@ import (
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/tsdb/tiering"
 )
 
 func TestIntervalSegment_New(t *testing.T) {
@@ -408,3 +411,297 @@ func TestIntervalSegment_EvictSegment(t *testing.T) {
 	s.EvictSegment()
 	assert.Len(t, s.segments, 0)
 }
+
+func TestIntervalSegment_UpdateOption(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	segment := NewMockSegment(ctrl)
+	s := &intervalSegment{
+		interval: option.Interval{
+			Interval:  timeutil.Interval(10 * timeutil.OneSecond),
+			Retention: timeutil.Interval(30 * timeutil.OneDay),
+		},
+		segments: map[string]Segment{"20190904": segment},
+	}
+	segment.EXPECT().RefreshRollup()
+	s.UpdateOption(option.Interval{
+		Interval:  timeutil.Interval(10 * timeutil.OneSecond),
+		Retention: timeutil.Interval(60 * timeutil.OneDay),
+	})
+	assert.Equal(t, 60*timeutil.OneDay, s.getRetention())
+}
+
+func TestIntervalSegment_Tiering(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		tiering.InitBackend(nil)
+		fileExist = fileutil.Exist
+		listDir = fileutil.ListDir
+		removeDir = fileutil.RemoveDir
+		newSegmentFunc = newSegment
+		newColdSegmentFunc = newColdSegment
+		ctrl.Finish()
+	}()
+	backend := tiering.NewMockBackend(ctrl)
+	tiering.InitBackend(backend)
+	segment := NewMockSegment(ctrl)
+	db := NewMockDatabase(ctrl)
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	newSegmentFunc = func(_ Shard, _ string, _ timeutil.Interval) (Segment, error) {
+		return segment, nil
+	}
+	newIS := func() *intervalSegment {
+		return &intervalSegment{
+			dir:       "/data/db/shard/1/segment/day",
+			indicator: "db/shard/1/segment/day",
+			shard:     shard,
+			interval: option.Interval{
+				Interval:  timeutil.Interval(10 * timeutil.OneSecond),
+				Retention: timeutil.Interval(30 * timeutil.OneDay),
+			},
+			segments: make(map[string]Segment),
+			logger:   logger.GetLogger("TSDB", "Segment"),
+		}
+	}
+	hot := map[string]bool{}
+	fileExist = func(file string) bool {
+		return hot[file]
+	}
+
+	t.Run("cannot write cold segment", func(t *testing.T) {
+		s := newIS()
+		backend.EXPECT().Exist("db/shard/1/segment/day/20221001").Return(false, fmt.Errorf("err"))
+		_, err := s.GetOrCreateSegment("20221001")
+		assert.Error(t, err)
+		backend.EXPECT().Exist("db/shard/1/segment/day/20221001").Return(true, nil)
+		_, err = s.GetOrCreateSegment("20221001")
+		assert.Error(t, err)
+		backend.EXPECT().Exist("db/shard/1/segment/day/20221001").Return(false, nil)
+		seg, err := s.GetOrCreateSegment("20221001")
+		assert.NoError(t, err)
+		assert.Equal(t, segment, seg)
+	})
+	t.Run("load cold segment", func(t *testing.T) {
+		s := newIS()
+		backend.EXPECT().Restore("db/shard/1/segment/day/20221001").Return("", fmt.Errorf("err"))
+		_, err := s.getOrLoadSegment("20221001")
+		assert.Error(t, err)
+		backend.EXPECT().Restore("db/shard/1/segment/day/20221001").Return("/cold/20221001", nil)
+		newColdSegmentFunc = func(_ Shard, _ string, _ timeutil.Interval, dir string) (Segment, error) {
+			assert.Equal(t, "/cold/20221001", dir)
+			return segment, nil
+		}
+		seg, err := s.getOrLoadSegment("20221001")
+		assert.NoError(t, err)
+		assert.Equal(t, segment, seg)
+		// evict cold segment, release cache files
+		segment.EXPECT().NeedEvict().Return(true)
+		segment.EXPECT().Close()
+		backend.EXPECT().Release("db/shard/1/segment/day/20221001").Return(fmt.Errorf("err"))
+		s.EvictSegment()
+		assert.Empty(t, s.segments)
+	})
+	t.Run("restore cold segment only once when concurrent loading", func(t *testing.T) {
+		s := newIS()
+		restoring := make(chan struct{})
+		backend.EXPECT().Restore("db/shard/1/segment/day/20221001").DoAndReturn(func(_ string) (string, error) {
+			<-restoring
+			return "/cold/20221001", nil
+		})
+		newColdSegmentFunc = func(_ Shard, _ string, _ timeutil.Interval, _ string) (Segment, error) {
+			return segment, nil
+		}
+		var wait sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+				seg, err := s.getOrLoadSegment("20221001")
+				assert.NoError(t, err)
+				assert.Equal(t, segment, seg)
+			}()
+		}
+		// other segment can be loaded when restoring
+		hot["/data/db/shard/1/segment/day/20221002"] = true
+		seg, err := s.getOrLoadSegment("20221002")
+		assert.NoError(t, err)
+		assert.Equal(t, segment, seg)
+		close(restoring)
+		wait.Wait()
+		assert.Len(t, s.segments, 2)
+		assert.Empty(t, s.restoring)
+	})
+	t.Run("walk hot and cold segments", func(t *testing.T) {
+		s := newIS()
+		listDir = func(_ string) ([]string, error) {
+			return []string{"20221001"}, nil
+		}
+		backend.EXPECT().List("db/shard/1/segment/day").Return([]string{"20221001", "20220930"}, nil)
+		var names []string
+		assert.NoError(t, s.walkSegment(func(segmentName string, _ int64) {
+			names = append(names, segmentName)
+		}))
+		assert.Equal(t, []string{"20221001", "20220930"}, names)
+		// walk cached cold segments
+		names = nil
+		assert.NoError(t, s.walkSegment(func(segmentName string, _ int64) {
+			names = append(names, segmentName)
+		}))
+		assert.Equal(t, []string{"20221001", "20220930"}, names)
+		// keep cached cold segments if refresh failure
+		backend.EXPECT().List("db/shard/1/segment/day").Return(nil, fmt.Errorf("err"))
+		assert.Equal(t, []string{"20221001", "20220930"}, s.refreshColdSegments(backend))
+		// ignore cold segments if list failure
+		s = newIS()
+		names = nil
+		backend.EXPECT().List("db/shard/1/segment/day").Return(nil, fmt.Errorf("err"))
+		assert.NoError(t, s.walkSegment(func(segmentName string, _ int64) {
+			names = append(names, segmentName)
+		}))
+		assert.Equal(t, []string{"20221001"}, names)
+		// no cold segments
+		backend.EXPECT().List("db/shard/1/segment/day").Return(nil, nil)
+		assert.Equal(t, []string{}, s.refreshColdSegments(backend))
+	})
+	t.Run("move old segment into cold storage", func(t *testing.T) {
+		s := newIS()
+		now := timeutil.Now()
+		oldSegment := timeutil.FormatTimestamp(now-10*timeutil.OneDay, "20060102")
+		newSegment := timeutil.FormatTimestamp(now-timeutil.OneDay, "20060102")
+		expiredSegment := timeutil.FormatTimestamp(now-40*timeutil.OneDay, "20060102")
+		hot["/data/db/shard/1/segment/day/"+oldSegment] = true
+		hot["/data/db/shard/1/segment/day/"+newSegment] = true
+		s.segments[oldSegment] = segment
+		listDir = func(_ string) ([]string, error) {
+			return []string{oldSegment, newSegment}, nil
+		}
+		removeDir = func(_ string) error {
+			return nil
+		}
+		db.EXPECT().GetOption().Return(&option.DatabaseOption{Tiering: option.TieringOption{ColdAfter: "7d"}}).AnyTimes()
+		backend.EXPECT().List(gomock.Any()).Return([]string{expiredSegment}, nil).AnyTimes()
+
+		// segment in use, cannot move it into cold storage
+		segment.EXPECT().NeedEvict().Return(false)
+		backend.EXPECT().Delete("db/shard/1/segment/day/" + expiredSegment).Return(fmt.Errorf("err"))
+		assert.NoError(t, s.TTL())
+		assert.Len(t, s.segments, 1)
+		// archive failure, drop expired cold segment failure
+		segment.EXPECT().NeedEvict().Return(true)
+		segment.EXPECT().Close()
+		backend.EXPECT().Archive("db/shard/1/segment/day/"+oldSegment, "/data/db/shard/1/segment/day/"+oldSegment).
+			Return(fmt.Errorf("err"))
+		backend.EXPECT().Delete("db/shard/1/segment/day/" + expiredSegment).Return(fmt.Errorf("err"))
+		assert.NoError(t, s.TTL())
+		assert.Empty(t, s.segments)
+		// archive successfully
+		backend.EXPECT().Archive("db/shard/1/segment/day/"+oldSegment, "/data/db/shard/1/segment/day/"+oldSegment).
+			Return(nil)
+		backend.EXPECT().Delete("db/shard/1/segment/day/" + expiredSegment).Return(nil)
+		assert.NoError(t, s.TTL())
+		// cached cold segments updated after moving/dropping segment
+		assert.Equal(t, []string{oldSegment}, s.coldSegments)
+	})
+}
+
+func TestIntervalSegment_PurgeTombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		tiering.InitBackend(nil)
+		fileExist = fileutil.Exist
+		listDir = fileutil.ListDir
+		newSegmentFunc = newSegment
+		ctrl.Finish()
+	}()
+	backend := tiering.NewMockBackend(ctrl)
+	tiering.InitBackend(backend)
+	db := NewMockDatabase(ctrl)
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	newSegmentFunc = func(_ Shard, _ string, _ timeutil.Interval) (Segment, error) {
+		return nil, fmt.Errorf("err")
+	}
+	calc := timeutil.Interval(10 * timeutil.OneSecond).Calculator()
+	now := timeutil.Now()
+	segmentTime := func(days int64) (string, int64) {
+		base := calc.CalcSegmentTime(now - days*timeutil.OneDay)
+		return calc.GetSegment(base), base
+	}
+	local, localTime := segmentTime(1)
+	cold, coldTime := segmentTime(2)
+	expired, expiredTime := segmentTime(40)
+	notLoad, notLoadTime := segmentTime(3)
+	tombstone := func(base int64, startHour, endHour int64) *Tombstone {
+		return &Tombstone{TimeRange: timeutil.TimeRange{
+			Start: base + startHour*timeutil.OneHour,
+			End:   base + endHour*timeutil.OneHour,
+		}}
+	}
+	purged := tombstone(localTime, 1, 2)
+	purgeFailure := tombstone(localTime, 5, 6)
+	inCold := tombstone(coldTime, 1, 2)
+	inExpired := tombstone(expiredTime, 1, 2)
+	loadFailure := tombstone(notLoadTime, 1, 2)
+	tombstones := []*Tombstone{purged, purgeFailure, inCold, inExpired, loadFailure}
+
+	segment := NewMockSegment(ctrl)
+	s := &intervalSegment{
+		dir:       "/data/db/shard/1/segment/day",
+		indicator: "db/shard/1/segment/day",
+		shard:     shard,
+		interval: option.Interval{
+			Interval:  timeutil.Interval(10 * timeutil.OneSecond),
+			Retention: timeutil.Interval(30 * timeutil.OneDay),
+		},
+		segments: map[string]Segment{local: segment},
+		logger:   logger.GetLogger("TSDB", "Segment"),
+	}
+	fileExist = func(file string) bool {
+		return file != filepath.Join(s.dir, cold)
+	}
+	// case 1: list segment failure
+	listDir = func(path string) ([]string, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Equal(t, tombstones, s.PurgeTombstones(tombstones))
+	// case 2: purge tombstones
+	listDir = func(path string) ([]string, error) {
+		return []string{local, expired, notLoad}, nil
+	}
+	backend.EXPECT().List(s.indicator).Return([]string{cold}, nil)
+	family1 := NewMockDataFamily(ctrl)
+	family2 := NewMockDataFamily(ctrl)
+	family3 := NewMockDataFamily(ctrl)
+	family2.EXPECT().Indicator().Return("family2").AnyTimes()
+	family1.EXPECT().TimeRange().Return(timeutil.TimeRange{
+		Start: localTime + timeutil.OneHour, End: localTime + 2*timeutil.OneHour - 1}).AnyTimes()
+	family2.EXPECT().TimeRange().Return(timeutil.TimeRange{
+		Start: localTime + 5*timeutil.OneHour, End: localTime + 6*timeutil.OneHour - 1}).AnyTimes()
+	family3.EXPECT().TimeRange().Return(timeutil.TimeRange{
+		Start: localTime + 3*timeutil.OneHour, End: localTime + 4*timeutil.OneHour - 1}).AnyTimes()
+	segment.EXPECT().GetDataFamilies(timeutil.TimeRange{
+		Start: localTime + timeutil.OneHour, End: localTime + 6*timeutil.OneHour,
+	}).Return([]DataFamily{family1, family2, family3})
+	family1.EXPECT().purgeTombstones().Return(nil)
+	family2.EXPECT().purgeTombstones().Return(fmt.Errorf("err"))
+	assert.Equal(t, []*Tombstone{purgeFailure, inCold, loadFailure}, s.PurgeTombstones(tombstones))
+}
+
+func TestIntervalSegment_RefreshTombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	segment1 := NewMockSegment(ctrl)
+	segment2 := NewMockSegment(ctrl)
+	family := NewMockDataFamily(ctrl)
+	s := &intervalSegment{
+		segments: map[string]Segment{"20190903": segment1, "20190904": segment2},
+	}
+	segment1.EXPECT().BaseTime().Return(int64(10)).AnyTimes()
+	segment2.EXPECT().BaseTime().Return(int64(100)).AnyTimes()
+	segment1.EXPECT().GetDataFamilies(timeutil.TimeRange{Start: 10, End: 50}).Return([]DataFamily{family})
+	family.EXPECT().refreshTombstones()
+	s.RefreshTombstones(timeutil.TimeRange{Start: 0, End: 50})
+}
@@ -87,4 +87,9 @@ type MetadataDatabase interface {
 	SuggestNamespace(prefix string, limit int) (namespaces []string, err error)
 	// Sync syncs the pending metadata update event
 	Sync() error
+	// Checkpoint writes a consistent snapshot of metadata storage into dir.
+	Checkpoint(dir string) error
+	// DropMetric drops the metadata(metric id/tag keys/fields) of metric,
+	// after dropped, new metric id will be generated when writes same metric.
+	DropMetric(namespace, metricName string) error
 }
@@ -94,8 +94,13 @@ type MetadataBackend interface {
 	// if not exist constants.ErrMetricBucketNotFound
 	getMetricMetadata(metricID metric.ID) (metadata MetricMetadata, err error)
 
+	// deleteMetric deletes the metric metadata include metric id/fields/tags.
+	deleteMetric(namespace, metricName string) error
+
 	// sync the backend memory data into persist storage.
 	sync() error
+	// checkpoint writes a consistent snapshot of backend storage into dir.
+	checkpoint(dir string) error
 }
 
 // metadataBackend implements the MetadataBackend interface.
@@ -423,6 +428,29 @@ func (mb *metadataBackend) getMetricMetadata(metricID metric.ID) (metadata Metri
 	return
 }
 
+// deleteMetric deletes the metric metadata include metric id/fields/tags.
+func (mb *metadataBackend) deleteMetric(namespace, metricName string) error {
+	namespaceVal, exist, err := mb.namespace.Get([]byte(namespace))
+	if err != nil || !exist {
+		return err
+	}
+	var key []byte
+	key = append(key, namespaceVal...)
+	key = append(key, metricName...)
+	metricVal, exist, err := mb.metric.Get(key)
+	if err != nil || !exist {
+		return err
+	}
+	metricID := metric.ID(binary.LittleEndian.Uint32(metricVal))
+	if err := mb.tagKey.Delete(metricID.MarshalBinary()); err != nil {
+		return err
+	}
+	if err := mb.field.Delete(metricID.MarshalBinary()); err != nil {
+		return err
+	}
+	return mb.metric.Delete(key)
+}
+
 // sync the backend memory data into persist storage.
 func (mb *metadataBackend) sync() error {
 	var result error
@@ -438,6 +466,19 @@ func (mb *metadataBackend) sync() error {
 	return result
 }
 
+// checkpoint writes a consistent snapshot of all backend id stores into dir.
+func (mb *metadataBackend) checkpoint(dir string) error {
+	if err := mb.saveSequences(); err != nil {
+		return err
+	}
+	for name, db := range mb.dbs {
+		if err := db.Checkpoint(path.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Close closes the backend storage.
 func (mb *metadataBackend) Close() error {
 	var result error
@@ -421,6 +421,90 @@ func TestMetadataBackend_getMetricID(t *testing.T) {
 	}
 }
 
+func TestMetadataBackend_deleteMetric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nsStore := unique.NewMockIDStore(ctrl)
+	metricStore := unique.NewMockIDStore(ctrl)
+	tagStore := unique.NewMockIDStore(ctrl)
+	fieldStore := unique.NewMockIDStore(ctrl)
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "get ns id failure",
+			prepare: func() {
+				nsStore.EXPECT().Get(gomock.Any()).Return(nil, false, fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "ns id not found",
+			prepare: func() {
+				nsStore.EXPECT().Get(gomock.Any()).Return(nil, false, nil)
+			},
+		},
+		{
+			name: "metric id not found",
+			prepare: func() {
+				nsStore.EXPECT().Get(gomock.Any()).Return([]byte{1, 0, 0, 0}, true, nil)
+				metricStore.EXPECT().Get(gomock.Any()).Return(nil, false, nil)
+			},
+		},
+		{
+			name: "delete tag keys failure",
+			prepare: func() {
+				nsStore.EXPECT().Get(gomock.Any()).Return([]byte{1, 0, 0, 0}, true, nil)
+				metricStore.EXPECT().Get(gomock.Any()).Return([]byte{2, 0, 0, 0}, true, nil)
+				tagStore.EXPECT().Delete(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete fields failure",
+			prepare: func() {
+				nsStore.EXPECT().Get(gomock.Any()).Return([]byte{1, 0, 0, 0}, true, nil)
+				metricStore.EXPECT().Get(gomock.Any()).Return([]byte{2, 0, 0, 0}, true, nil)
+				tagStore.EXPECT().Delete(gomock.Any()).Return(nil)
+				fieldStore.EXPECT().Delete(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "delete metric successfully",
+			prepare: func() {
+				nsStore.EXPECT().Get(gomock.Any()).Return([]byte{1, 0, 0, 0}, true, nil)
+				metricStore.EXPECT().Get(gomock.Any()).Return([]byte{2, 0, 0, 0}, true, nil)
+				tagStore.EXPECT().Delete(metric.ID(2).MarshalBinary()).Return(nil)
+				fieldStore.EXPECT().Delete(metric.ID(2).MarshalBinary()).Return(nil)
+				metricStore.EXPECT().Delete([]byte{1, 0, 0, 0, 'n', 'a', 'm', 'e'}).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			backend := &metadataBackend{
+				namespace: nsStore,
+				metric:    metricStore,
+				tagKey:    tagStore,
+				field:     fieldStore,
+			}
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			err := backend.deleteMetric("ns", "name")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("deleteMetric() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestMetadataBackend_saveTagKey(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1000,3 +1084,65 @@ func TestMetadataBackend_Close(t *testing.T) {
 		})
 	}
 }
+
+func TestMetadataBackend_checkpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sequence := unique.NewMockSequence(ctrl)
+	store := unique.NewMockIDStore(ctrl)
+	cases := []struct {
+		name    string
+		prepare func()
+		wantErr bool
+	}{
+		{
+			name: "save sequence failure",
+			prepare: func() {
+				sequence.EXPECT().Current().Return(uint32(10))
+				store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "checkpoint id store failure",
+			prepare: func() {
+				sequence.EXPECT().Current().Return(uint32(10))
+				store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
+				store.EXPECT().Checkpoint(gomock.Any()).Return(fmt.Errorf("err"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "checkpoint successfully",
+			prepare: func() {
+				sequence.EXPECT().Current().Return(uint32(10))
+				store.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
+				store.EXPECT().Checkpoint(gomock.Any()).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			backend := &metadataBackend{
+				sequences: []sequenceItem{{
+					sequence: sequence,
+					store:    store,
+					key:      tagKeyIDSequenceKey,
+				}},
+				dbs: map[string]unique.IDStore{
+					TagKeyDB: store,
+				},
+			}
+			if tt.prepare != nil {
+				tt.prepare()
+			}
+			err := backend.checkpoint(t.TempDir())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkpoint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -206,7 +206,11 @@ func (mdb *metadataDatabase) GenFieldID(
 	if fieldType == field.Unknown {
 		return field.EmptyFieldID, series.ErrFieldTypeUnspecified
 	}
-	metricMetadata, _ := mdb.getMetricMetadataFromCache(namespace, metricName)
+	metricMetadata, ok := mdb.getMetricMetadataFromCache(namespace, metricName)
+	if !ok {
+		// metric maybe dropped after gen metric id
+		return field.EmptyFieldID, fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+	}
 
 	mdb.rwMux.Lock()
 	defer mdb.rwMux.Unlock()
@@ -238,7 +242,11 @@ func (mdb *metadataDatabase) GenFieldID(
 // GenTagKeyID generates the tag key id in the memory
 // !!!!! NOTICE: metric metadata must be existed in memory, because gen metric has been saved
 func (mdb *metadataDatabase) GenTagKeyID(namespace, metricName, tagKey string) (tagKeyID tag.KeyID, err error) {
-	metricMetadata, _ := mdb.getMetricMetadataFromCache(namespace, metricName)
+	metricMetadata, ok := mdb.getMetricMetadataFromCache(namespace, metricName)
+	if !ok {
+		// metric maybe dropped after gen metric id
+		return tag.EmptyTagKeyID, fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, metricName)
+	}
 
 	mdb.rwMux.Lock()
 	defer mdb.rwMux.Unlock()
@@ -271,6 +279,27 @@ func (mdb *metadataDatabase) Sync() error {
 	return mdb.backend.sync()
 }
 
+// Checkpoint writes a consistent snapshot of metadata storage into dir.
+func (mdb *metadataDatabase) Checkpoint(dir string) error {
+	mdb.rwMux.Lock()
+	defer mdb.rwMux.Unlock()
+
+	return mdb.backend.checkpoint(dir)
+}
+
+// DropMetric drops the metadata(metric id/tag keys/fields) of metric,
+// after dropped, new metric id will be generated when writes same metric.
+func (mdb *metadataDatabase) DropMetric(namespace, metricName string) error {
+	mdb.rwMux.Lock()
+	defer mdb.rwMux.Unlock()
+
+	if err := mdb.backend.deleteMetric(namespace, metricName); err != nil {
+		return err
+	}
+	delete(mdb.metrics, commonseries.JoinNamespaceMetric(namespace, metricName))
+	return nil
+}
+
 // Close closes the resources
 func (mdb *metadataDatabase) Close() error {
 	mdb.rwMux.Lock()
@@ -19,6 +19,7 @@ package metadb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -614,6 +615,15 @@ func TestMetadataDatabase_GenFieldID(t *testing.T) {
 				err error
 			}{id: field.EmptyFieldID, err: series.ErrFieldTypeUnspecified},
 		},
+		{
+			name:       "metric metadata not found",
+			metricName: "not-found",
+			f:          field.Meta{Name: "sum", Type: field.SumField},
+			out: struct {
+				id  field.ID
+				err error
+			}{id: field.EmptyFieldID, err: fmt.Errorf("%w, metric: %s", constants.ErrMetricIDNotFound, "not-found")},
+		},
 		{
 			name:       "wrong field type",
 			metricName: "cache",
@@ -791,6 +801,38 @@ func TestMetadataDatabase_GenTagKeyID(t *testing.T) {
 	}
 }
 
+func TestMetadataDatabase_DropMetric(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		createMetadataBackendFn = newMetadataBackend
+		ctrl.Finish()
+	}()
+	mockBackend := NewMockMetadataBackend(ctrl)
+	createMetadataBackendFn = func(parent string) (backend MetadataBackend, err error) {
+		return mockBackend, nil
+	}
+	meta := NewMockMetricMetadata(ctrl)
+	db := newMockMetadataDatabase(t, t.TempDir())
+	db2 := db.(*metadataDatabase)
+	key := commonseries.JoinNamespaceMetric("ns-1", "cache")
+	db2.metrics[key] = meta
+
+	// delete backend failure
+	mockBackend.EXPECT().deleteMetric("ns-1", "cache").Return(fmt.Errorf("err"))
+	assert.Error(t, db.DropMetric("ns-1", "cache"))
+	_, ok := db2.metrics[key]
+	assert.True(t, ok)
+	// drop successfully
+	mockBackend.EXPECT().deleteMetric("ns-1", "cache").Return(nil)
+	assert.NoError(t, db.DropMetric("ns-1", "cache"))
+	_, ok = db2.metrics[key]
+	assert.False(t, ok)
+	// gen tag key after metric dropped
+	tagKeyID, err := db.GenTagKeyID("ns-1", "cache", "host")
+	assert.True(t, errors.Is(err, constants.ErrMetricIDNotFound))
+	assert.Equal(t, tag.EmptyTagKeyID, tagKeyID)
+}
+
 func TestMetadataDatabase_Close_Sync(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -804,9 +846,11 @@ func TestMetadataDatabase_Close_Sync(t *testing.T) {
 	}
 	mockBackend.EXPECT().Close().Return(nil)
 	mockBackend.EXPECT().sync().Return(nil)
+	mockBackend.EXPECT().checkpoint(gomock.Any()).Return(nil)
 	db := newMockMetadataDatabase(t, t.TempDir())
 	assert.NoError(t, db.Close())
 	assert.NoError(t, db.Sync())
+	assert.NoError(t, db.Checkpoint(t.TempDir()))
 }
 
 func newMockMetadataDatabase(t *testing.T, dir string) MetadataDatabase {
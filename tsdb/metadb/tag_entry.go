@@ -20,12 +20,14 @@ package metadb
 import (
 	"regexp"
 	"strings"
+	"sync"
 
 	"go.uber.org/atomic"
 
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/sql/stmt"
+	"github.com/lindb/lindb/tsdb/tblstore/tagkeymeta"
 )
 
 // TagEntry represents the tag value=>id under tag key
@@ -52,8 +54,17 @@ type TagEntry interface {
 type tagEntry struct {
 	tagValueSeq atomic.Uint32
 	tagValues   map[string]uint32
+
+	// rangeIndex is the sorted numeric/version view of tag values for range filter, built lazily when searching,
+	// new tag values are appended to pendingRange, which are merged into rangeIndex when exceeding threshold.
+	rangeIndex   *tagkeymeta.RangeIndex
+	pendingRange []tagkeymeta.RangeEntry
+	indexLock    sync.Mutex
 }
 
+// maxPendingRangeEntries is the max number of pending tag values which are not merged into range index.
+const maxPendingRangeEntries = 1024
+
 // newTagEntry creates tag entry with tag value id auto sequence
 func newTagEntry(tagValueSeq uint32) TagEntry {
 	t := &tagEntry{
@@ -76,6 +87,16 @@ func (t *tagEntry) getTagValueIDSeq() uint32 {
 // addTagValue adds tag value=>id mapping
 func (t *tagEntry) addTagValue(tagValue string, tagValueID uint32) {
 	t.tagValues[tagValue] = tagValueID
+
+	t.indexLock.Lock()
+	defer t.indexLock.Unlock()
+	if t.rangeIndex == nil {
+		// range index not built, builds it when searching
+		return
+	}
+	if value, ok := stmt.ParseComparableValue(tagValue); ok {
+		t.pendingRange = append(t.pendingRange, tagkeymeta.RangeEntry{Value: value, TagValueID: tagValueID})
+	}
 }
 
 // getTagValueIDs returns all tag value ids under the tag key
@@ -109,6 +130,8 @@ func (t *tagEntry) findSeriesIDsByExpr(expr stmt.TagFilter) *roaring.Bitmap {
 		return t.findSeriesIDsByLike(expression)
 	case *stmt.RegexExpr:
 		return t.findSeriesIDsByRegex(expression)
+	case stmt.RangeTagFilter:
+		return t.findSeriesIDsByRange(expression)
 	}
 	metaLogger.Warn("expr type is not tag filter when find tag value ids by expr")
 	return nil
@@ -201,6 +224,39 @@ func (t *tagEntry) findSeriesIDsByRegex(expr *stmt.RegexExpr) *roaring.Bitmap {
 	return result
 }
 
+// findSeriesIDsByRange finds tag value ids by tag value - <,<=,>,>= and between
+func (t *tagEntry) findSeriesIDsByRange(expr stmt.RangeTagFilter) *roaring.Bitmap {
+	valueRange := expr.ValueRange()
+	if valueRange == nil {
+		return nil
+	}
+	t.indexLock.Lock()
+	switch {
+	case t.rangeIndex == nil:
+		var entries []tagkeymeta.RangeEntry
+		for tagValue, tagValueID := range t.tagValues {
+			if value, ok := stmt.ParseComparableValue(tagValue); ok {
+				entries = append(entries, tagkeymeta.RangeEntry{Value: value, TagValueID: tagValueID})
+			}
+		}
+		t.rangeIndex = tagkeymeta.NewRangeIndex(entries)
+	case len(t.pendingRange) > maxPendingRangeEntries:
+		t.rangeIndex = t.rangeIndex.Merge(t.pendingRange)
+		t.pendingRange = nil
+	}
+	// range index is immutable, pending entries only append, read them out of lock
+	index, pending := t.rangeIndex, t.pendingRange
+	t.indexLock.Unlock()
+
+	result := roaring.BitmapOf(index.Find(valueRange)...)
+	for _, entry := range pending {
+		if valueRange.MatchValue(entry.Value) {
+			result.Add(entry.TagValueID)
+		}
+	}
+	return result
+}
+
 // collectTagValues collects the tag values by tag value ids,
 func (t *tagEntry) collectTagValues(tagValueIDs *roaring.Bitmap, tagValues map[uint32]string) {
 	for value, tagValueID := range t.tagValues {
@@ -18,6 +18,7 @@
 package metadb
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -86,6 +87,47 @@ func TestTagEntry_findSeriesIDsByIn(t *testing.T) {
 		tagIndex.findSeriesIDsByExpr(&stmt.InExpr{Key: "host", Values: []string{"b", "bc", "bcd", "ahi"}}))
 }
 
+func TestTagEntry_findSeriesIDsByRange(t *testing.T) {
+	tagIndex := newTagEntry(0)
+	for i, value := range []string{"1", "1.5", "2", "10", "v1.9.0", "v1.10.0", "1.10.0-rc.1", "abc", "-3"} {
+		tagIndex.addTagValue(value, uint32(i+1))
+	}
+	cases := []struct {
+		expr   stmt.TagFilter
+		result *roaring.Bitmap
+	}{
+		{expr: &stmt.CompareExpr{Key: "v", Operator: stmt.GREATER, Value: "1.5"}, result: roaring.BitmapOf(3, 4)},
+		{expr: &stmt.CompareExpr{Key: "v", Operator: stmt.LESSEQUAL, Value: "1.5"}, result: roaring.BitmapOf(1, 2, 9)},
+		{expr: &stmt.CompareExpr{Key: "v", Operator: stmt.GREATEREQUAL, Value: "v1.9.0"}, result: roaring.BitmapOf(3, 4, 5, 6, 7)},
+		{expr: &stmt.CompareExpr{Key: "v", Operator: stmt.LESS, Value: "v1.10.0"}, result: roaring.BitmapOf(1, 2, 5, 7)},
+		{expr: &stmt.BetweenExpr{Key: "v", Start: "-5", End: "1.5"}, result: roaring.BitmapOf(1, 2, 9)},
+		{expr: &stmt.BetweenExpr{Key: "v", Start: "10", End: "1"}, result: roaring.BitmapOf()},
+		// bounds are mixed with number and version, compares as version
+		{expr: &stmt.BetweenExpr{Key: "v", Start: "1", End: "v1.9.0"}, result: roaring.BitmapOf(1, 2, 5)},
+		// bound cannot be ordered
+		{expr: &stmt.CompareExpr{Key: "v", Operator: stmt.LESS, Value: "abc"}, result: nil},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.result, tagIndex.findSeriesIDsByExpr(c.expr), c.expr.Rewrite())
+	}
+
+	// new tag value is searched before merged into range index
+	tagIndex.addTagValue("1.7", 10)
+	tagIndex.addTagValue("xyz", 11)
+	entry := tagIndex.(*tagEntry)
+	assert.Len(t, entry.pendingRange, 1)
+	assert.Equal(t, roaring.BitmapOf(3, 4, 10),
+		tagIndex.findSeriesIDsByExpr(&stmt.CompareExpr{Key: "v", Operator: stmt.GREATER, Value: "1.5"}))
+
+	// pending tag values exceed threshold, merge into range index
+	for i := 0; i <= maxPendingRangeEntries; i++ {
+		tagIndex.addTagValue(strconv.Itoa(100+i), uint32(100+i))
+	}
+	result := tagIndex.findSeriesIDsByExpr(&stmt.CompareExpr{Key: "v", Operator: stmt.GREATER, Value: "1.5"})
+	assert.Empty(t, entry.pendingRange)
+	assert.Equal(t, uint64(maxPendingRangeEntries+4), result.GetCardinality())
+}
+
 func TestTagEntry_findSeriesIDsByExpr_not_tagFilter(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
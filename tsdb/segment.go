@@ -26,6 +26,7 @@ import (
 	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
 	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
 )
@@ -45,6 +46,8 @@ type Segment interface {
 	NeedEvict() bool
 	// EvictFamily evicts data family.
 	EvictFamily(familyTime int64)
+	// RefreshRollup refreshes the rollup intervals of kv store based on latest database option.
+	RefreshRollup()
 	// Close closes segment, include kv store.
 	Close()
 }
@@ -56,6 +59,7 @@ type segment struct {
 	baseTime  int64
 	kvStore   kv.Store
 	interval  timeutil.Interval
+	cold      bool // segment moved into cold storage, read only
 	families  map[int]DataFamily
 
 	mutex sync.RWMutex
@@ -65,6 +69,16 @@ type segment struct {
 
 // newSegment returns segment, segment is wrapper of kv store.
 func newSegment(shard Shard, segmentName string, interval timeutil.Interval) (Segment, error) {
+	return openSegment(shard, segmentName, interval, "")
+}
+
+// newColdSegment returns segment which moved into cold storage, the files of kv store are under given dir.
+func newColdSegment(shard Shard, segmentName string, interval timeutil.Interval, dir string) (Segment, error) {
+	return openSegment(shard, segmentName, interval, dir)
+}
+
+// openSegment opens the kv store of segment, the store is under cold dir if cold dir not empty.
+func openSegment(shard Shard, segmentName string, interval timeutil.Interval, coldDir string) (Segment, error) {
 	indicator := ShardSegmentIndicator(shard.Database().Name(), shard.ShardID(), interval, segmentName)
 	// parse base time from segment name
 	calc := interval.Calculator()
@@ -73,19 +87,15 @@ func newSegment(shard Shard, segmentName string, interval timeutil.Interval) (Se
 		return nil, fmt.Errorf("parse segment[%s] base time error", indicator)
 	}
 
-	storeOption := kv.DefaultStoreOption()
-	intervals := shard.Database().GetOption().Intervals
-	if shard.CurrentInterval() == interval && len(intervals) > 1 {
-		// if interval == writeable interval and database set auto rollup intervals
-		sort.Sort(intervals) // need sort interval
-		var rollup []timeutil.Interval
-		for _, rollupInterval := range intervals {
-			rollup = append(rollup, rollupInterval.Interval)
-		}
-		storeOption.Rollup = rollup[1:]
-		storeOption.Source = interval
+	var kvStore kv.Store
+	if coldDir == "" {
+		storeOption := kv.DefaultStoreOption()
+		storeOption.Source, storeOption.Rollup = rollupOption(shard, interval)
+		kvStore, err = kv.GetStoreManager().CreateStore(indicator, storeOption)
+	} else {
+		// cold segment is read only, no rollup for its kv store
+		kvStore, err = kv.GetStoreManager().CreateStoreWithPath(indicator, coldDir, kv.DefaultStoreOption())
 	}
-	kvStore, err := kv.GetStoreManager().CreateStore(indicator, storeOption)
 	if err != nil {
 		return nil, fmt.Errorf("create kv store for segment error:%s", err)
 	}
@@ -95,11 +105,27 @@ func newSegment(shard Shard, segmentName string, interval timeutil.Interval) (Se
 		baseTime:  baseTime,
 		kvStore:   kvStore,
 		interval:  interval,
+		cold:      coldDir != "",
 		families:  make(map[int]DataFamily),
 		logger:    logger.GetLogger("TSDB", "Segment"),
 	}, nil
 }
 
+// rollupOption returns the rollup source/target intervals of segment's kv store,
+// only writeable interval segment need rollup if database set auto rollup intervals.
+func rollupOption(shard Shard, interval timeutil.Interval) (source timeutil.Interval, rollup []timeutil.Interval) {
+	// copy intervals, because database option maybe shared
+	intervals := append(option.Intervals{}, shard.Database().GetOption().Intervals...)
+	if shard.CurrentInterval() != interval || len(intervals) <= 1 {
+		return 0, nil
+	}
+	sort.Sort(intervals) // need sort interval
+	for _, rollupInterval := range intervals[1:] {
+		rollup = append(rollup, rollupInterval.Interval)
+	}
+	return interval, rollup
+}
+
 // BaseTime returns segment base time
 func (s *segment) BaseTime() int64 {
 	return s.baseTime
@@ -150,6 +176,15 @@ func (s *segment) EvictFamily(familyTime int64) {
 	delete(s.families, family)
 }
 
+// RefreshRollup refreshes the rollup intervals of kv store based on latest database option.
+func (s *segment) RefreshRollup() {
+	if s.cold {
+		return
+	}
+	source, rollup := rollupOption(s.shard, s.interval)
+	s.kvStore.SetRollup(source, rollup)
+}
+
 // GetOrCreateDataFamily returns the data family based on timestamp.
 func (s *segment) GetOrCreateDataFamily(timestamp int64) (DataFamily, error) {
 	calc := s.interval.Calculator()
@@ -168,14 +203,19 @@ func (s *segment) GetOrCreateDataFamily(timestamp int64) (DataFamily, error) {
 	if family, ok := s.families[familyTime]; ok {
 		return family, nil
 	}
-	familyOption := kv.FamilyOption{
-		CompactThreshold: 0,
-		Merger:           string(metricsdata.MetricDataMerger),
-	}
 	familyName := strconv.Itoa(familyTime)
 	family := s.kvStore.GetFamily(familyName)
 	if family == nil {
+		if s.cold {
+			return nil, fmt.Errorf("%w, segment moved into cold storage, cannot create family: %s",
+				constants.ErrDataFamilyNotFound, familyName)
+		}
 		// create kv family
+		familyOption := kv.FamilyOption{
+			CompactThreshold: 0,
+			Merger:           string(metricsdata.MetricDataMerger),
+			ValueCompression: s.shard.Database().GetOption().ValueCompression,
+		}
 		var err error
 		family, err = s.kvStore.CreateFamily(fmt.Sprintf("%d", familyTime), familyOption)
 		if err != nil {
@@ -29,6 +29,7 @@ import (
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
 	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
 )
 
 func TestSegment_New(t *testing.T) {
@@ -121,6 +122,10 @@ func TestSegment_GetOrCreateDataFamily(t *testing.T) {
 	defer ctrl.Finish()
 
 	store := kv.NewMockStore(ctrl)
+	database := NewMockDatabase(ctrl)
+	database.EXPECT().GetOption().Return(&option.DatabaseOption{ValueCompression: "lz4"}).AnyTimes()
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().Database().Return(database).AnyTimes()
 	interval := timeutil.Interval(10 * 1000)
 	baseTime, _ := timeutil.ParseTimestamp("20190904 00:00:00", "20060102 15:04:05")
 	cases := []struct {
@@ -144,7 +149,10 @@ func TestSegment_GetOrCreateDataFamily(t *testing.T) {
 					return NewMockDataFamily(ctrl)
 				}
 				store.EXPECT().GetFamily(gomock.Any()).Return(nil)
-				store.EXPECT().CreateFamily(gomock.Any(), gomock.Any()).Return(nil, nil)
+				store.EXPECT().CreateFamily(gomock.Any(), kv.FamilyOption{
+					Merger:           string(metricsdata.MetricDataMerger),
+					ValueCompression: "lz4",
+				}).Return(nil, nil)
 			},
 		},
 		{
@@ -188,6 +196,7 @@ func TestSegment_GetOrCreateDataFamily(t *testing.T) {
 				newDataFamilyFunc = newDataFamily
 			}()
 			seg := &segment{
+				shard:    shard,
 				baseTime: baseTime,
 				kvStore:  store,
 				interval: interval,
@@ -358,3 +367,75 @@ func TestSegment_NeedEvict(t *testing.T) {
 	assert.True(t, s.NeedEvict())
 	s.EvictFamily(timeutil.Now())
 }
+
+func TestSegment_NewCold(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		kv.InitStoreManager(nil)
+		ctrl.Finish()
+	}()
+
+	storeMgr := kv.NewMockStoreManager(ctrl)
+	kv.InitStoreManager(storeMgr)
+	store := kv.NewMockStore(ctrl)
+	database := NewMockDatabase(ctrl)
+	database.EXPECT().Name().Return("test").AnyTimes()
+	shard := NewMockShard(ctrl)
+	interval := timeutil.Interval(timeutil.OneSecond * 10)
+	shard.EXPECT().Database().Return(database).AnyTimes()
+	shard.EXPECT().ShardID().Return(models.ShardID(1)).AnyTimes()
+
+	// create store err
+	storeMgr.EXPECT().CreateStoreWithPath(gomock.Any(), "/cold/20190904", gomock.Any()).Return(nil, fmt.Errorf("err"))
+	s, err := newColdSegment(shard, "20190904", interval, "/cold/20190904")
+	assert.Error(t, err)
+	assert.Nil(t, s)
+	// create cold segment successfully
+	storeMgr.EXPECT().CreateStoreWithPath(gomock.Any(), "/cold/20190904", gomock.Any()).Return(store, nil)
+	s, err = newColdSegment(shard, "20190904", interval, "/cold/20190904")
+	assert.NoError(t, err)
+	assert.NotNil(t, s)
+	// cold segment is read only, no rollup
+	s.RefreshRollup()
+	// cannot create family for cold segment
+	store.EXPECT().GetFamily(gomock.Any()).Return(nil)
+	now, _ := timeutil.ParseTimestamp("20190904 10:00:00", "20060102 15:04:05")
+	f, err := s.GetOrCreateDataFamily(now)
+	assert.Error(t, err)
+	assert.Nil(t, f)
+}
+
+func TestSegment_RefreshRollup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	interval := timeutil.Interval(10 * timeutil.OneSecond)
+	database := NewMockDatabase(ctrl)
+	shard := NewMockShard(ctrl)
+	shard.EXPECT().Database().Return(database).AnyTimes()
+	shard.EXPECT().CurrentInterval().Return(interval).AnyTimes()
+	store := kv.NewMockStore(ctrl)
+	s := &segment{interval: interval, shard: shard, kvStore: store}
+
+	// writeable interval segment, rollup to new interval
+	database.EXPECT().GetOption().Return(&option.DatabaseOption{
+		Intervals: option.Intervals{
+			{Interval: timeutil.Interval(timeutil.OneHour)},
+			{Interval: interval},
+			{Interval: timeutil.Interval(5 * timeutil.OneMinute)},
+		},
+	})
+	store.EXPECT().SetRollup(interval, []timeutil.Interval{
+		timeutil.Interval(5 * timeutil.OneMinute),
+		timeutil.Interval(timeutil.OneHour),
+	})
+	s.RefreshRollup()
+
+	// rollup interval segment, no rollup
+	s.interval = timeutil.Interval(timeutil.OneHour)
+	database.EXPECT().GetOption().Return(&option.DatabaseOption{
+		Intervals: option.Intervals{{Interval: interval}, {Interval: timeutil.Interval(timeutil.OneHour)}},
+	})
+	store.EXPECT().SetRollup(timeutil.Interval(0), nil)
+	s.RefreshRollup()
+}
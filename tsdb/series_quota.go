@@ -0,0 +1,85 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"sync"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// seriesQuotaRejectionTTL is the time to keep the series quota rejection.
+const seriesQuotaRejectionTTL = timeutil.OneMinute
+
+// seriesQuotaRejection represents the latest rejection of series quota scope.
+type seriesQuotaRejection struct {
+	err      models.SeriesQuotaError
+	seq      int64
+	rejectAt int64
+}
+
+// seriesQuotaRejections keeps the recent series quota rejections of shard.
+// Series are created asynchronously after the write acked by storage,
+// so the rejections are reported to brokers over write stream, then brokers reject the write of the scopes.
+type seriesQuotaRejections struct {
+	seq        int64
+	rejections map[models.SeriesQuotaError]*seriesQuotaRejection // key: scope/namespace/name
+
+	mutex sync.Mutex
+}
+
+// add records the series quota rejection, removes the expired rejections.
+func (r *seriesQuotaRejections) add(err *models.SeriesQuotaError) {
+	now := timeutil.Now()
+	key := models.SeriesQuotaError{Scope: err.Scope, Namespace: err.Namespace, Name: err.Name}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.rejections == nil {
+		r.rejections = make(map[models.SeriesQuotaError]*seriesQuotaRejection)
+	}
+	if rejection, ok := r.rejections[key]; ok && now-rejection.rejectAt < seriesQuotaRejectionTTL/2 {
+		// same scope rejected recently, no need report again
+		return
+	}
+	r.seq++
+	r.rejections[key] = &seriesQuotaRejection{err: *err, seq: r.seq, rejectAt: now}
+	for k, v := range r.rejections {
+		if now-v.rejectAt > seriesQuotaRejectionTTL {
+			delete(r.rejections, k)
+		}
+	}
+}
+
+// since returns the rejections which recorded after given sequence, and the latest sequence.
+func (r *seriesQuotaRejections) since(seq int64) (errs []models.SeriesQuotaError, latest int64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.seq <= seq {
+		return nil, r.seq
+	}
+	for _, rejection := range r.rejections {
+		if rejection.seq > seq {
+			errs = append(errs, rejection.err)
+		}
+	}
+	return errs, r.seq
+}
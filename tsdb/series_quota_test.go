@@ -0,0 +1,60 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/models"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestSeriesQuotaRejections(t *testing.T) {
+	r := &seriesQuotaRejections{}
+	errs, seq := r.since(0)
+	assert.Empty(t, errs)
+	assert.Equal(t, int64(0), seq)
+
+	shardErr := &models.SeriesQuotaError{Scope: models.ShardQuotaScope, Name: "db", Quota: 10, Usage: 10}
+	metricErr := &models.SeriesQuotaError{Scope: models.MetricQuotaScope, Namespace: "ns", Name: "cpu", Quota: 1, Usage: 1}
+	r.add(shardErr)
+	r.add(shardErr) // same scope rejected recently, ignore
+	errs, seq = r.since(0)
+	assert.Equal(t, []models.SeriesQuotaError{*shardErr}, errs)
+	assert.Equal(t, int64(1), seq)
+
+	r.add(metricErr)
+	errs, seq = r.since(seq)
+	assert.Equal(t, []models.SeriesQuotaError{*metricErr}, errs)
+	assert.Equal(t, int64(2), seq)
+	errs, _ = r.since(seq)
+	assert.Empty(t, errs)
+
+	// expired rejection reported again, and old rejections removed
+	r.rejections[models.SeriesQuotaError{Scope: models.ShardQuotaScope, Name: "db"}].rejectAt =
+		timeutil.Now() - seriesQuotaRejectionTTL
+	r.rejections[models.SeriesQuotaError{Scope: models.MetricQuotaScope, Namespace: "ns", Name: "cpu"}].rejectAt =
+		timeutil.Now() - 2*seriesQuotaRejectionTTL
+	r.add(shardErr)
+	errs, seq = r.since(seq)
+	assert.Equal(t, []models.SeriesQuotaError{*shardErr}, errs)
+	assert.Equal(t, int64(3), seq)
+	assert.Len(t, r.rejections, 1)
+}
@@ -19,14 +19,17 @@ package tsdb
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"sort"
 	"strconv"
 	"sync"
 	"time"
 
 	commonconstants "github.com/lindb/common/constants"
+	"github.com/lindb/roaring"
 	"go.uber.org/atomic"
 
 	"github.com/lindb/lindb/kv"
@@ -58,14 +61,26 @@ type Shard interface {
 	Indicator() string
 	// GetOrCrateDataFamily returns data family, if not exist create a new data family.
 	GetOrCrateDataFamily(familyTime int64) (DataFamily, error)
+	// GetOrCreateRollupDataFamily returns data family under rollup interval, if not exist create a new data family.
+	GetOrCreateRollupDataFamily(interval timeutil.Interval, familyTime int64) (DataFamily, error)
 	// GetDataFamilies returns data family list by interval type and time range, return nil if not match
 	GetDataFamilies(intervalType timeutil.IntervalType, timeRange timeutil.TimeRange) []DataFamily
 	// IndexDatabase returns the index-database
 	IndexDatabase() indexdb.IndexDatabase
 	// BufferManager returns write temp memory manager.
 	BufferManager() memdb.BufferManager
-	// LookupRowMetricMeta lookups the metadata of metric data for each row with same family in batch.
+	// LookupRowMetricMeta lookups the metadata of metric data for each row with same family in batch,
+	// returns error wraps *models.SeriesQuotaError if some rows rejected because series quota exceeded,
+	// other writable rows can be written continue.
 	LookupRowMetricMeta(rows []metric.StorageRow) error
+	// SeriesQuotaErrors returns the series quota rejections which recorded after given sequence,
+	// and the latest sequence for next fetching.
+	SeriesQuotaErrors(afterSeq int64) (errs []models.SeriesQuotaError, seq int64)
+	// Tombstones returns the tombstones which record deleted series.
+	Tombstones() TombstoneStore
+	// Delete deletes the series of metric in time range, writes tombstone,
+	// deleted data will be filtered when query, and be purged when compact data.
+	Delete(metricID metric.ID, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) error
 	// FlushIndex flushes index data to disk.
 	FlushIndex() error
 	// WaitFlushIndexCompleted waits flush index job completed.
@@ -76,6 +91,9 @@ type Shard interface {
 	TTL()
 	// EvictSegment evicts segment which long term no read operation.
 	EvictSegment()
+	// UpdateOption updates shard's option after database option altered,
+	// updates retention of existing intervals, and creates segment for new rollup interval.
+	UpdateOption(dbOption *option.DatabaseOption) error
 	// Closer releases shard's resource, such as flush data, spawned goroutines etc.
 	io.Closer
 }
@@ -87,14 +105,18 @@ type shard struct {
 	id        models.ShardID
 	option    *option.DatabaseOption
 
-	bufferMgr memdb.BufferManager
-	indexDB   indexdb.IndexDatabase
-	metadata  metadb.Metadata
+	bufferMgr  memdb.BufferManager
+	indexDB    indexdb.IndexDatabase
+	metadata   metadb.Metadata
+	tombstones TombstoneStore
+	// recent series quota rejections, reported to brokers
+	quotaRejections seriesQuotaRejections
 	// write accept time range
 	interval timeutil.Interval
 	// segments keeps all rollup target interval segments,
 	// includes one smallest interval segment for writing data, and rollup interval segments
 	rollupTargets  map[timeutil.Interval]IntervalSegment
+	rollupMutex    sync.RWMutex    // mutex for replacing rollup targets
 	segment        IntervalSegment // smallest interval for writing data
 	isFlushing     atomic.Bool     // restrict flusher concurrency
 	flushCondition *sync.Cond      // flush condition
@@ -134,6 +156,11 @@ func newShard(
 	}
 	// try cleanup history dirty write buffer
 	createdShard.bufferMgr.Cleanup()
+	// load tombstones before creating data family, because family need tombstones for filtering deleted data
+	createdShard.tombstones, err = newTombstoneStoreFunc(shardTombstonePath(db.Name(), shardID))
+	if err != nil {
+		return nil, err
+	}
 
 	// sort intervals
 	sort.Sort(dbOption.Intervals)
@@ -199,7 +226,7 @@ func (s *shard) GetOrCrateDataFamily(familyTime int64) (DataFamily, error) {
 		return nil, err
 	}
 	// build rollup target segment if set auto rollup interval
-	for interval, rollupSegment := range s.rollupTargets {
+	for interval, rollupSegment := range s.getRollupTargets() {
 		_, err = rollupSegment.GetOrCreateSegment(interval.Calculator().GetSegment(familyTime))
 		if err != nil {
 			return nil, err
@@ -212,14 +239,27 @@ func (s *shard) GetOrCrateDataFamily(familyTime int64) (DataFamily, error) {
 	return family, nil
 }
 
+// GetOrCreateRollupDataFamily returns data family under rollup interval, if not exist create a new data family.
+func (s *shard) GetOrCreateRollupDataFamily(interval timeutil.Interval, familyTime int64) (DataFamily, error) {
+	rollupSegment, ok := s.getRollupTargets()[interval]
+	if !ok {
+		return nil, fmt.Errorf("rollup interval: %s not found for shard: %s", interval, s.indicator)
+	}
+	segment, err := rollupSegment.GetOrCreateSegment(interval.Calculator().GetSegment(familyTime))
+	if err != nil {
+		return nil, err
+	}
+	return segment.GetOrCreateDataFamily(familyTime)
+}
+
 func (s *shard) GetDataFamilies(intervalType timeutil.IntervalType, timeRange timeutil.TimeRange) []DataFamily {
 	// first check query interval is writable interval.
-	if s.interval.Type() == intervalType || len(s.rollupTargets) == 1 {
+	if s.interval.Type() == intervalType || len(s.getRollupTargets()) == 1 {
 		// if no rollup, need to use current writable interval.
 		return s.segment.GetDataFamilies(timeRange)
 	}
 	// then find family from rollup targets
-	for interval, rollupSegment := range s.rollupTargets {
+	for interval, rollupSegment := range s.getRollupTargets() {
 		if interval.Type() == intervalType {
 			return rollupSegment.GetDataFamilies(timeRange)
 		}
@@ -227,6 +267,43 @@ func (s *shard) GetDataFamilies(intervalType timeutil.IntervalType, timeRange ti
 	return nil
 }
 
+// Tombstones returns the tombstones which record deleted series.
+func (s *shard) Tombstones() TombstoneStore {
+	return s.tombstones
+}
+
+// Delete deletes the series of metric in time range, writes tombstone,
+// deleted data will be filtered when query, and be purged when compact data.
+func (s *shard) Delete(metricID metric.ID, seriesIDs *roaring.Bitmap, timeRange timeutil.TimeRange) error {
+	var families []DataFamily
+	for _, rollupSegment := range s.getRollupTargets() {
+		families = append(families, rollupSegment.GetDataFamilies(timeRange)...)
+	}
+	// flush memory data before write tombstone, because tombstone only applies on file storage
+	for _, family := range families {
+		if err := family.Flush(); err != nil {
+			return err
+		}
+	}
+	if err := s.tombstones.Add(&Tombstone{
+		MetricID:  metricID,
+		SeriesIDs: seriesIDs,
+		TimeRange: timeRange,
+	}); err != nil {
+		return err
+	}
+	for _, family := range families {
+		family.refreshTombstones()
+	}
+	s.logger.Info("delete series successfully",
+		logger.String("shard", s.indicator),
+		logger.Any("metricID", metricID),
+		logger.Any("series", seriesIDs.GetCardinality()),
+		logger.Int64("start", timeRange.Start),
+		logger.Int64("end", timeRange.End))
+	return nil
+}
+
 func (s *shard) lookupRowMeta(row *metric.StorageRow) (err error) {
 	namespace := commonconstants.DefaultNamespace
 	metricName := string(row.Name())
@@ -245,7 +322,7 @@ func (s *shard) lookupRowMeta(row *metric.StorageRow) (err error) {
 		// if metric without tags, uses default series id(0)
 		row.SeriesID = series.IDWithoutTags
 	} else {
-		row.SeriesID, isCreated, err = s.indexDB.GetOrCreateSeriesID(row.MetricID, row.TagsHash())
+		row.SeriesID, isCreated, err = s.indexDB.GetOrCreateSeriesID(namespace, metricName, row.MetricID, row.TagsHash())
 		if err != nil {
 			return err
 		}
@@ -318,10 +395,27 @@ Done:
 	return nil
 }
 
-// LookupRowMetricMeta lookups the metadata of metric data for each row with same family in batch.
+// LookupRowMetricMeta lookups the metadata of metric data for each row with same family in batch,
+// returns error wraps *models.SeriesQuotaError if some rows rejected because series quota exceeded,
+// other writable rows can be written continue.
 func (s *shard) LookupRowMetricMeta(rows []metric.StorageRow) error {
+	var (
+		quotaErr *models.SeriesQuotaError
+		rejected int
+	)
 	for idx := range rows {
 		if err := s.lookupRowMeta(&rows[idx]); err != nil {
+			var e *models.SeriesQuotaError
+			if errors.As(err, &e) {
+				// series quota exceeded, record first error, no need log each row
+				if quotaErr == nil {
+					quotaErr = e
+				}
+				s.quotaRejections.add(e)
+				rejected++
+				s.statistics.SeriesQuotaRejectedRows.Incr()
+				continue
+			}
 			s.statistics.LookupMetricMetaFailures.Incr()
 			s.logger.Error("failed to lookup meta of row",
 				logger.String("database", s.db.Name()),
@@ -329,9 +423,18 @@ func (s *shard) LookupRowMetricMeta(rows []metric.StorageRow) error {
 			continue
 		}
 	}
+	if quotaErr != nil {
+		return fmt.Errorf("%w, rejected rows: %d", quotaErr, rejected)
+	}
 	return nil
 }
 
+// SeriesQuotaErrors returns the series quota rejections which recorded after given sequence,
+// and the latest sequence for next fetching.
+func (s *shard) SeriesQuotaErrors(afterSeq int64) (errs []models.SeriesQuotaError, seq int64) {
+	return s.quotaRejections.since(afterSeq)
+}
+
 func (s *shard) Close() error {
 	// finally, cleanup temp buffer.
 	defer s.bufferMgr.Cleanup()
@@ -350,7 +453,7 @@ func (s *shard) Close() error {
 	}
 	// close segment/flush family data
 	s.segment.Close()
-	for _, rollupSegment := range s.rollupTargets {
+	for _, rollupSegment := range s.getRollupTargets() {
 		rollupSegment.Close()
 	}
 	return nil
@@ -400,7 +503,7 @@ func (s *shard) WaitFlushIndexCompleted() {
 
 // TTL expires the data of each segment base on time to live.
 func (s *shard) TTL() {
-	for interval, rollupSegment := range s.rollupTargets {
+	for interval, rollupSegment := range s.getRollupTargets() {
 		if err := rollupSegment.TTL(); err != nil {
 			s.logger.Warn("do segment ttl failure",
 				logger.String("database", s.db.Name()),
@@ -410,18 +513,118 @@ func (s *shard) TTL() {
 			)
 		}
 	}
+	s.purgeTombstones()
+	if err := s.indexDB.PurgeDroppedTagKeys(); err != nil {
+		s.logger.Warn("purge dropped tag keys of index failure",
+			logger.String("shard", s.indicator), logger.Error(err))
+	}
+}
+
+// purgeTombstones purges the deleted data of tombstones from data families of all intervals,
+// then removes the tombstones which are purged or only overlap expired segments.
+// NOTICE: after tombstone removed, the data of deleted series written after delete becomes visible.
+func (s *shard) purgeTombstones() {
+	tombstones := s.tombstones.GetTombstones(timeutil.TimeRange{Start: math.MinInt64, End: math.MaxInt64})
+	if len(tombstones) == 0 {
+		return
+	}
+	retained := make(map[*Tombstone]struct{})
+	for _, rollupSegment := range s.getRollupTargets() {
+		for _, tombstone := range rollupSegment.PurgeTombstones(tombstones) {
+			retained[tombstone] = struct{}{}
+		}
+	}
+	var removed []*Tombstone
+	timeRange := timeutil.TimeRange{Start: math.MaxInt64, End: math.MinInt64}
+	for _, tombstone := range tombstones {
+		if _, ok := retained[tombstone]; !ok {
+			removed = append(removed, tombstone)
+			if tombstone.TimeRange.Start < timeRange.Start {
+				timeRange.Start = tombstone.TimeRange.Start
+			}
+			if tombstone.TimeRange.End > timeRange.End {
+				timeRange.End = tombstone.TimeRange.End
+			}
+		}
+	}
+	if len(removed) == 0 {
+		return
+	}
+	if err := s.tombstones.Remove(removed); err != nil {
+		s.logger.Warn("remove purged tombstones failure",
+			logger.String("shard", s.indicator), logger.Error(err))
+		return
+	}
+	// clear the deleted data of families, so that compact job can move file again
+	for _, rollupSegment := range s.getRollupTargets() {
+		rollupSegment.RefreshTombstones(timeRange)
+	}
+	s.logger.Info("purge tombstones successfully",
+		logger.String("shard", s.indicator),
+		logger.Int("purged", len(removed)),
+		logger.Int("retained", len(retained)))
 }
 
 // EvictSegment evicts segment which long term no read operation.
 func (s *shard) EvictSegment() {
-	for _, rollupSegment := range s.rollupTargets {
+	for _, rollupSegment := range s.getRollupTargets() {
 		rollupSegment.EvictSegment()
 	}
 }
 
+// UpdateOption updates shard's option after database option altered,
+// updates retention of existing intervals, and creates segment for new rollup interval.
+func (s *shard) UpdateOption(dbOption *option.DatabaseOption) error {
+	s.rollupMutex.Lock()
+	defer s.rollupMutex.Unlock()
+
+	// copy on write, avoid concurrent read rollup targets
+	rollupTargets := make(map[timeutil.Interval]IntervalSegment)
+	for interval, rollupSegment := range s.rollupTargets {
+		rollupTargets[interval] = rollupSegment
+	}
+	for _, targetInterval := range dbOption.Intervals {
+		if rollupSegment, ok := rollupTargets[targetInterval.Interval]; ok {
+			rollupSegment.UpdateOption(targetInterval)
+			continue
+		}
+		// new segment for new rollup interval
+		rollupSegment, err := newIntervalSegmentFunc(s, targetInterval)
+		if err != nil {
+			return err
+		}
+		rollupTargets[targetInterval.Interval] = rollupSegment
+		s.logger.Info("add new rollup interval for shard",
+			logger.String("database", s.db.Name()),
+			logger.Any("shardID", s.id),
+			logger.String("interval", targetInterval.Interval.String()))
+	}
+	s.indexDB.SetSeriesQuota(dbOption.ShardSeriesQuota)
+	s.option = dbOption
+	s.rollupTargets = rollupTargets
+	return nil
+}
+
+// getRollupTargets returns the rollup target segments.
+func (s *shard) getRollupTargets() map[timeutil.Interval]IntervalSegment {
+	s.rollupMutex.RLock()
+	defer s.rollupMutex.RUnlock()
+
+	return s.rollupTargets
+}
+
+// getOption returns the current database option of shard.
+func (s *shard) getOption() *option.DatabaseOption {
+	s.rollupMutex.RLock()
+	defer s.rollupMutex.RUnlock()
+
+	return s.option
+}
+
 // initIndexDatabase initializes the index database
 func (s *shard) initIndexDatabase() error {
 	var err error
+	dbOption := s.getOption()
 	s.indexStore, err = kv.GetStoreManager().CreateStore(shardIndexIndicator(s.db.Name(), s.id), kv.DefaultStoreOption())
 	if err != nil {
 		return err
@@ -430,7 +633,8 @@ func (s *shard) initIndexDatabase() error {
 		forwardIndexDir,
 		kv.FamilyOption{
 			CompactThreshold: 0,
-			Merger:           string(tagindex.SeriesForwardMerger)})
+			Merger:           string(tagindex.SeriesForwardMerger),
+			ValueCompression: dbOption.ValueCompression})
 	if err != nil {
 		return err
 	}
@@ -438,7 +642,8 @@ func (s *shard) initIndexDatabase() error {
 		invertedIndexDir,
 		kv.FamilyOption{
 			CompactThreshold: 0,
-			Merger:           string(tagindex.SeriesInvertedMerger)})
+			Merger:           string(tagindex.SeriesInvertedMerger),
+			ValueCompression: dbOption.ValueCompression})
 	if err != nil {
 		return err
 	}
@@ -450,5 +655,6 @@ func (s *shard) initIndexDatabase() error {
 	if err != nil {
 		return err
 	}
+	s.indexDB.SetSeriesQuota(dbOption.ShardSeriesQuota)
 	return nil
 }
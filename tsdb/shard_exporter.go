@@ -0,0 +1,542 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"sort"
+
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/constants"
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/logger"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+//go:generate mockgen -source=./shard_exporter.go -destination=./shard_exporter_mock.go -package=tsdb
+
+// exportBatchSize represents the max size of metric rows in one export batch.
+const exportBatchSize = 4 * 1024 * 1024
+
+// ExportBatch represents a batch of metric rows which belong to same data family.
+type ExportBatch struct {
+	Interval   timeutil.Interval `json:"interval,omitempty"` // interval of data family, writable interval if not set
+	FamilyTime int64             `json:"familyTime"`
+	// Sequences are the persisted replica sequences of data family which are consistent with exported data,
+	// only set in the last batch of each data family under writable interval.
+	Sequences map[int32]int64 `json:"sequences,omitempty"`
+	Rows      []byte          `json:"rows,omitempty"` // size-prefixed metric rows
+}
+
+// ShardExporter exports all data of shard as metric rows.
+// NOTE: metric/tag/field/series ids are different between storage nodes,
+// so data must be exported with metric name/tags/field names.
+type ShardExporter interface {
+	// Export exports data of all data families under all intervals,
+	// invokes fn for each batch of metric rows.
+	Export(ctx context.Context, fn func(batch *ExportBatch) error) error
+}
+
+// shardExporter implements ShardExporter interface.
+type shardExporter struct {
+	shard     Shard
+	converter *metric.BrokerRowProtoConverter
+	buf       bytes.Buffer
+
+	logger *logger.Logger
+}
+
+// NewShardExporter creates a shard data exporter.
+func NewShardExporter(shard Shard) ShardExporter {
+	return &shardExporter{
+		shard:     shard,
+		converter: metric.NewProtoConverter(),
+		logger:    logger.GetLogger("TSDB", "ShardExporter"),
+	}
+}
+
+// Export exports data of all data families under all intervals,
+// invokes fn for each batch of metric rows.
+//
+// 1. data families under writable interval export the data of files with persisted sequences(flush barrier),
+// data in memory database will be replayed by write ahead log from persisted sequences;
+// 2. data families under rollup interval only export the data before the data families of smaller interval,
+// the data after it will be rolled up from the data of smaller interval in target node.
+func (e *shardExporter) Export(ctx context.Context, fn func(batch *ExportBatch) error) error {
+	metadataDB := e.shard.Database().Metadata().MetadataDatabase()
+	namespaces, err := metadataDB.SuggestNamespace("", math.MaxInt32)
+	if err != nil {
+		return err
+	}
+	intervals := make(option.Intervals, len(e.shard.Database().GetOption().Intervals))
+	copy(intervals, e.shard.Database().GetOption().Intervals)
+	sort.Sort(intervals)
+
+	exportedStart := int64(math.MaxInt64) // the start time of data families exported under smaller intervals
+	for idx, interval := range intervals {
+		families := e.shard.GetDataFamilies(interval.Interval.Type(), timeutil.TimeRange{
+			Start: 0,
+			End:   timeutil.Now() + timeutil.OneDay,
+		})
+		writable := idx == 0
+		exportBefore := exportedStart
+		for _, family := range families {
+			if family.Interval() != interval.Interval || family.TimeRange().Start >= exportBefore {
+				continue
+			}
+			if err := e.exportFamily(ctx, family, writable, exportBefore, namespaces, fn); err != nil {
+				return err
+			}
+			if family.TimeRange().Start < exportedStart {
+				exportedStart = family.TimeRange().Start
+			}
+		}
+	}
+	return nil
+}
+
+// exportFamily exports data of data family, only exports the data before given time.
+func (e *shardExporter) exportFamily(ctx context.Context, family DataFamily,
+	writable bool, exportBefore int64, namespaces []string, fn func(batch *ExportBatch) error,
+) error {
+	metadataDB := e.shard.Database().Metadata().MetadataDatabase()
+	export := func(sequences map[int32]int64) error {
+		for _, ns := range namespaces {
+			metricNames, err := metadataDB.SuggestMetrics(ns, "", math.MaxInt32)
+			if err != nil {
+				return err
+			}
+			for _, metricName := range metricNames {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				if err := e.exportMetric(family, exportBefore, ns, metricName); err != nil {
+					return err
+				}
+				if e.buf.Len() >= exportBatchSize {
+					if err := e.flush(family, nil, fn); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		// send the last batch with sequences even if no rows
+		return e.flush(family, sequences, fn)
+	}
+	var err error
+	if writable {
+		// pause flush when exporting, so that exported data is consistent with persisted sequences,
+		// replica will replay write ahead log after persisted sequences.
+		err = family.WithFlushBarrier(export)
+	} else {
+		err = export(nil)
+	}
+	if err != nil {
+		return err
+	}
+	e.logger.Info("export data family successfully", logger.String("family", family.Indicator()))
+	return nil
+}
+
+// flush invokes fn with exported metric rows, then resets the buffer.
+func (e *shardExporter) flush(family DataFamily, sequences map[int32]int64, fn func(batch *ExportBatch) error) error {
+	defer e.buf.Reset()
+	rows := make([]byte, e.buf.Len())
+	copy(rows, e.buf.Bytes())
+	return fn(&ExportBatch{
+		Interval:   family.Interval(),
+		FamilyTime: family.FamilyTime(),
+		Sequences:  sequences,
+		Rows:       rows,
+	})
+}
+
+// exportMetric exports the data of metric under data family, only exports the data before given time.
+func (e *shardExporter) exportMetric(family DataFamily, exportBefore int64, namespace, metricName string) error {
+	metadataDB := e.shard.Database().Metadata().MetadataDatabase()
+	metricID, err := metadataDB.GetMetricID(namespace, metricName)
+	if err != nil {
+		return nil
+	}
+	fields, err := metadataDB.GetAllFields(namespace, metricName)
+	if err != nil || len(fields) == 0 {
+		return nil
+	}
+	seriesIDs, err := e.shard.IndexDatabase().GetSeriesIDsForMetric(namespace, metricName)
+	if err != nil || seriesIDs.IsEmpty() {
+		return nil
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].ID < fields[j].ID
+	})
+	storageCtx := &flow.StorageExecuteContext{
+		Query: &stmt.Query{
+			StorageInterval: family.Interval(),
+			TimeRange:       family.TimeRange(),
+		},
+		MetricID: metricID,
+		Fields:   fields,
+	}
+	shardCtx := flow.NewShardExecuteContext(storageCtx)
+	shardCtx.SeriesIDsAfterFiltering = seriesIDs
+	resultSets, err := family.FileFilter(shardCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		for _, rs := range resultSets {
+			rs.Close()
+		}
+	}()
+	if len(resultSets) == 0 {
+		return nil
+	}
+	tagKeys, err := metadataDB.GetAllTagKeys(namespace, metricName)
+	if err != nil {
+		return err
+	}
+	highKeys := seriesIDs.GetHighKeys()
+	for idx := range highKeys {
+		dataLoadCtx := &flow.DataLoadContext{
+			ShardExecuteCtx:       shardCtx,
+			SeriesIDHighKey:       highKeys[idx],
+			LowSeriesIDsContainer: seriesIDs.GetContainerAtIndex(idx),
+		}
+		dataLoadCtx.Grouping()
+		values := e.loadSeriesData(dataLoadCtx, resultSets)
+		if len(values) == 0 {
+			continue
+		}
+		tags, err := e.collectSeriesTags(dataLoadCtx, tagKeys, values)
+		if err != nil {
+			return err
+		}
+		if err := e.buildRows(family, exportBefore, namespace, metricName, fields, tags, values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadSeriesData loads data of series under same high key, returns series id => field values(slot => value).
+func (e *shardExporter) loadSeriesData(
+	dataLoadCtx *flow.DataLoadContext,
+	resultSets []flow.FilterResultSet,
+) map[uint32][]map[uint16]float64 {
+	fields := dataLoadCtx.ShardExecuteCtx.StorageExecuteCtx.Fields
+	highKey := uint32(dataLoadCtx.SeriesIDHighKey) << 16
+	values := make(map[uint32][]map[uint16]float64)
+	dataLoadCtx.Decoder = encoding.GetTSDDecoder()
+	defer encoding.ReleaseTSDDecoder(dataLoadCtx.Decoder)
+	dataLoadCtx.DownSampling = func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter) {
+		seriesID := highKey | uint32(dataLoadCtx.MinSeriesID+seriesIdx)
+		fieldValues, ok := values[seriesID]
+		if !ok {
+			fieldValues = make([]map[uint16]float64, len(fields))
+			values[seriesID] = fieldValues
+		}
+		if fieldValues[fieldIdx] == nil {
+			fieldValues[fieldIdx] = make(map[uint16]float64)
+		}
+		aggType := fields[fieldIdx].Type.AggType()
+		for slot := slotRange.Start; slot <= slotRange.End; slot++ {
+			value, ok := getter.GetValue(slot)
+			if !ok {
+				continue
+			}
+			if oldValue, exist := fieldValues[fieldIdx][slot]; exist {
+				value = aggType.Aggregate(oldValue, value)
+			}
+			fieldValues[fieldIdx][slot] = value
+		}
+	}
+	// result sets order: memory(mutable/immutable)=>file, load the oldest data first.
+	for idx := len(resultSets) - 1; idx >= 0; idx-- {
+		loader := resultSets[idx].Load(dataLoadCtx)
+		if loader == nil {
+			continue
+		}
+		loader.Load(dataLoadCtx)
+	}
+	return values
+}
+
+// collectSeriesTags collects the tags of series which have data.
+func (e *shardExporter) collectSeriesTags(
+	dataLoadCtx *flow.DataLoadContext,
+	tagKeys tag.Metas,
+	values map[uint32][]map[uint16]float64,
+) (map[uint32][]*protoMetricsV1.KeyValue, error) {
+	seriesIDs := roaring.New()
+	for seriesID := range values {
+		seriesIDs.Add(seriesID)
+	}
+	highKey := uint32(dataLoadCtx.SeriesIDHighKey) << 16
+	tags := make(map[uint32][]*protoMetricsV1.KeyValue)
+	for _, tagKey := range tagKeys {
+		// grouping context includes the series which have all group by tag keys,
+		// so need build grouping context for each tag key.
+		shardCtx := flow.NewShardExecuteContext(&flow.StorageExecuteContext{
+			GroupByTagKeyIDs: []tag.KeyID{tagKey.ID},
+		})
+		shardCtx.SeriesIDsAfterFiltering = seriesIDs.Clone()
+		if err := e.shard.IndexDatabase().GetGroupingContext(shardCtx); err != nil {
+			if errors.Is(err, constants.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		seriesTagValueIDs := make(map[uint32]uint32)
+		tagValueIDs := roaring.New()
+		shardCtx.GroupingContext.ScanSeriesTagValueIDs(dataLoadCtx, func(seriesIdxFromQuery uint16, _ int, tagValueID uint32) {
+			seriesID := highKey | uint32(dataLoadCtx.MinSeriesID+seriesIdxFromQuery)
+			if _, ok := values[seriesID]; ok {
+				seriesTagValueIDs[seriesID] = tagValueID
+				tagValueIDs.Add(tagValueID)
+			}
+		})
+		tagValues := make(map[uint32]string)
+		if err := e.shard.Database().Metadata().TagMetadata().CollectTagValues(tagKey.ID, tagValueIDs, tagValues); err != nil {
+			return nil, err
+		}
+		for seriesID, tagValueID := range seriesTagValueIDs {
+			if tagValue, ok := tagValues[tagValueID]; ok {
+				tags[seriesID] = append(tags[seriesID], &protoMetricsV1.KeyValue{Key: tagKey.Key, Value: tagValue})
+			}
+		}
+	}
+	return tags, nil
+}
+
+// buildRows builds metric rows for each series/timestamp before given time, then marshals rows into buffer.
+func (e *shardExporter) buildRows(
+	family DataFamily,
+	exportBefore int64,
+	namespace, metricName string,
+	fields field.Metas,
+	tags map[uint32][]*protoMetricsV1.KeyValue,
+	values map[uint32][]map[uint16]float64,
+) error {
+	histogram := newHistogramFields(fields)
+	seriesIDs := make([]uint32, 0, len(values))
+	for seriesID := range values {
+		seriesIDs = append(seriesIDs, seriesID)
+	}
+	sort.Slice(seriesIDs, func(i, j int) bool { return seriesIDs[i] < seriesIDs[j] })
+	for _, seriesID := range seriesIDs {
+		fieldValues := values[seriesID]
+		slots := make(map[uint16]struct{})
+		for _, slotValues := range fieldValues {
+			for slot := range slotValues {
+				slots[slot] = struct{}{}
+			}
+		}
+		sortedSlots := make([]uint16, 0, len(slots))
+		for slot := range slots {
+			sortedSlots = append(sortedSlots, slot)
+		}
+		sort.Slice(sortedSlots, func(i, j int) bool { return sortedSlots[i] < sortedSlots[j] })
+		for _, slot := range sortedSlots {
+			timestamp := timeutil.CalcTimestamp(family.FamilyTime(), int(slot), family.Interval())
+			if timestamp >= exportBefore {
+				break
+			}
+			m := &protoMetricsV1.Metric{
+				Namespace: namespace,
+				Name:      metricName,
+				Timestamp: timestamp,
+				Tags:      tags[seriesID],
+			}
+			for fieldIdx, f := range fields {
+				value, ok := fieldValues[fieldIdx][slot]
+				if !ok || histogram.contains(fieldIdx) {
+					continue
+				}
+				m.SimpleFields = append(m.SimpleFields, &protoMetricsV1.SimpleField{
+					Name:  string(f.Name),
+					Type:  simpleFieldType(f.Type),
+					Value: value,
+				})
+			}
+			m.CompoundField = histogram.build(fieldValues, slot)
+			if len(m.SimpleFields) == 0 && m.CompoundField == nil {
+				continue
+			}
+			if _, err := e.converter.MarshalProtoMetricV1To(m, &e.buf); err != nil {
+				e.logger.Warn("marshal exported metric failure, ignore it",
+					logger.String("metric", metricName), logger.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+// simpleFieldType returns the simple field type of row by field type.
+func simpleFieldType(fieldType field.Type) protoMetricsV1.SimpleFieldType {
+	switch fieldType {
+	case field.SumField:
+		return protoMetricsV1.SimpleFieldType_DELTA_SUM
+	case field.MinField:
+		return protoMetricsV1.SimpleFieldType_Min
+	case field.MaxField:
+		return protoMetricsV1.SimpleFieldType_Max
+	case field.LastField:
+		return protoMetricsV1.SimpleFieldType_LAST
+	case field.FirstField:
+		return protoMetricsV1.SimpleFieldType_FIRST
+	default:
+		return protoMetricsV1.SimpleFieldType_SIMPLE_UNSPECIFIED
+	}
+}
+
+// histogramFields represents the field indexes of histogram, which are stored as multi-fields.
+type histogramFields struct {
+	sum, count, min, max int
+	buckets              []int // sort by upper bound
+	bounds               []float64
+}
+
+// newHistogramFields creates histogram field indexes, bucket fields are sorted by upper bound.
+func newHistogramFields(fields field.Metas) *histogramFields {
+	h := &histogramFields{sum: -1, count: -1, min: -1, max: -1}
+	for idx, f := range fields {
+		if f.Type != field.HistogramField {
+			continue
+		}
+		upperBound, err := metric.UpperBound(string(f.Name))
+		if err != nil {
+			continue
+		}
+		h.buckets = append(h.buckets, idx)
+		h.bounds = append(h.bounds, upperBound)
+	}
+	if len(h.buckets) == 0 {
+		return h
+	}
+	sort.Sort(h)
+	var itr metric.CompoundFieldIterator
+	for idx, f := range fields {
+		switch f.Name {
+		case itr.HistogramSumFieldName():
+			h.sum = idx
+		case itr.HistogramCountFieldName():
+			h.count = idx
+		case itr.HistogramMinFieldName():
+			h.min = idx
+		case itr.HistogramMaxFieldName():
+			h.max = idx
+		}
+	}
+	return h
+}
+
+func (h *histogramFields) Len() int           { return len(h.buckets) }
+func (h *histogramFields) Less(i, j int) bool { return h.bounds[i] < h.bounds[j] }
+func (h *histogramFields) Swap(i, j int) {
+	h.buckets[i], h.buckets[j] = h.buckets[j], h.buckets[i]
+	h.bounds[i], h.bounds[j] = h.bounds[j], h.bounds[i]
+}
+
+// contains checks if field is a part of histogram.
+func (h *histogramFields) contains(fieldIdx int) bool {
+	if len(h.buckets) == 0 {
+		return false
+	}
+	if fieldIdx == h.sum || fieldIdx == h.count || fieldIdx == h.min || fieldIdx == h.max {
+		return true
+	}
+	for _, idx := range h.buckets {
+		if idx == fieldIdx {
+			return true
+		}
+	}
+	return false
+}
+
+// build builds compound field by slot, returns nil if no bucket value.
+func (h *histogramFields) build(fieldValues []map[uint16]float64, slot uint16) *protoMetricsV1.CompoundField {
+	found := false
+	compoundField := &protoMetricsV1.CompoundField{
+		Values:         make([]float64, len(h.buckets)),
+		ExplicitBounds: h.bounds,
+	}
+	for idx, fieldIdx := range h.buckets {
+		if value, ok := fieldValues[fieldIdx][slot]; ok {
+			compoundField.Values[idx] = value
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+	getValue := func(fieldIdx int) float64 {
+		if fieldIdx < 0 {
+			return 0
+		}
+		return fieldValues[fieldIdx][slot]
+	}
+	compoundField.Sum = getValue(h.sum)
+	compoundField.Count = getValue(h.count)
+	compoundField.Min = getValue(h.min)
+	compoundField.Max = getValue(h.max)
+	return compoundField
+}
+
+// ImportBatch writes the metric rows of exported batch into data family of shard,
+// then commits the replica sequences of source family, which make replica skip write ahead log written before.
+func ImportBatch(shard Shard, batch *ExportBatch) error {
+	var family DataFamily
+	var err error
+	if batch.Interval <= 0 || batch.Interval == shard.CurrentInterval() {
+		family, err = shard.GetOrCrateDataFamily(batch.FamilyTime)
+	} else {
+		family, err = shard.GetOrCreateRollupDataFamily(batch.Interval, batch.FamilyTime)
+	}
+	if err != nil {
+		return err
+	}
+	family.Retain()
+	defer family.Release()
+
+	if len(batch.Rows) > 0 {
+		batchRows := metric.NewStorageBatchRows()
+		batchRows.UnmarshalRows(batch.Rows)
+		rows := batchRows.Rows()
+		if err := shard.LookupRowMetricMeta(rows); err != nil && !errors.Is(err, constants.ErrSeriesQuotaExceeded) {
+			return err
+		}
+		if err := family.WriteRows(rows); err != nil {
+			return err
+		}
+	}
+	for leader, seq := range batch.Sequences {
+		family.CommitSequence(leader, seq)
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/lindb/roaring"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/flow"
+	"github.com/lindb/lindb/pkg/option"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/field"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/series/tag"
+	"github.com/lindb/lindb/tsdb/indexdb"
+	"github.com/lindb/lindb/tsdb/metadb"
+)
+
+type mockValueGetter map[uint16]float64
+
+func (g mockValueGetter) GetValue(slot uint16) (float64, bool) {
+	v, ok := g[slot]
+	return v, ok
+}
+
+func TestShardExporter_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := NewMockShard(ctrl)
+	db := NewMockDatabase(ctrl)
+	meta := metadb.NewMockMetadata(ctrl)
+	metaDB := metadb.NewMockMetadataDatabase(ctrl)
+	tagMeta := metadb.NewMockTagMetadata(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	family := NewMockDataFamily(ctrl)
+	rs := flow.NewMockFilterResultSet(ctrl)
+	loader := flow.NewMockDataLoader(ctrl)
+	groupingCtx := flow.NewMockGroupingContext(ctrl)
+
+	interval := timeutil.Interval(10 * timeutil.OneSecond)
+	familyTime := int64(1_600_000_000_000)
+	shard.EXPECT().Database().Return(db).AnyTimes()
+	shard.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	shard.EXPECT().CurrentInterval().Return(interval).AnyTimes()
+	db.EXPECT().Metadata().Return(meta).AnyTimes()
+	meta.EXPECT().MetadataDatabase().Return(metaDB).AnyTimes()
+	meta.EXPECT().TagMetadata().Return(tagMeta).AnyTimes()
+	family.EXPECT().FamilyTime().Return(familyTime).AnyTimes()
+	family.EXPECT().Interval().Return(interval).AnyTimes()
+	family.EXPECT().TimeRange().Return(timeutil.TimeRange{Start: familyTime, End: familyTime + timeutil.OneHour}).AnyTimes()
+	family.EXPECT().Indicator().Return("family").AnyTimes()
+	family.EXPECT().WithFlushBarrier(gomock.Any()).DoAndReturn(func(fn func(sequences map[int32]int64) error) error {
+		return fn(map[int32]int64{1: 100})
+	}).AnyTimes()
+	db.EXPECT().GetOption().Return(&option.DatabaseOption{Intervals: option.Intervals{{Interval: interval}}}).AnyTimes()
+
+	fields := field.Metas{
+		{ID: 1, Name: "f1", Type: field.SumField},
+		{ID: 2, Name: "HistogramSum", Type: field.SumField},
+		{ID: 3, Name: "HistogramCount", Type: field.SumField},
+		{ID: 4, Name: "__bucket_1", Type: field.HistogramField},
+		{ID: 5, Name: "__bucket_2", Type: field.HistogramField},
+		{ID: 6, Name: "__bucket_+Inf", Type: field.HistogramField},
+	}
+	exporter := NewShardExporter(shard)
+	ctx := context.TODO()
+	noop := func(batch *ExportBatch) error { return nil }
+
+	// case 1: suggest namespace failure
+	metaDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, exporter.Export(ctx, noop))
+
+	metaDB.EXPECT().SuggestNamespace("", math.MaxInt32).Return([]string{"ns"}, nil).AnyTimes()
+	shard.EXPECT().GetDataFamilies(interval.Type(), gomock.Any()).Return([]DataFamily{family}).AnyTimes()
+	// case 2: suggest metrics failure
+	metaDB.EXPECT().SuggestMetrics("ns", "", math.MaxInt32).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, exporter.Export(ctx, noop))
+
+	metaDB.EXPECT().SuggestMetrics("ns", "", math.MaxInt32).Return([]string{"cpu"}, nil).AnyTimes()
+	metaDB.EXPECT().GetMetricID("ns", "cpu").Return(metric.ID(10), nil).AnyTimes()
+	metaDB.EXPECT().GetAllFields("ns", "cpu").Return(fields, nil).AnyTimes()
+	metaDB.EXPECT().GetAllTagKeys("ns", "cpu").Return(tag.Metas{{Key: "host", ID: 1}}, nil).AnyTimes()
+	indexDB.EXPECT().GetSeriesIDsForMetric("ns", "cpu").Return(roaring.BitmapOf(1, 2), nil).AnyTimes()
+	// case 3: filter failure
+	family.EXPECT().FileFilter(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, exporter.Export(ctx, noop))
+	// case 4: context canceled
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Error(t, exporter.Export(canceledCtx, noop))
+	// case 5: export successfully
+	family.EXPECT().FileFilter(gomock.Any()).Return([]flow.FilterResultSet{rs}, nil).AnyTimes()
+	rs.EXPECT().Load(gomock.Any()).Return(loader).AnyTimes()
+	rs.EXPECT().Close().AnyTimes()
+	loader.EXPECT().Load(gomock.Any()).DoAndReturn(func(ctx *flow.DataLoadContext) {
+		slotRange := timeutil.SlotRange{Start: 1, End: 2}
+		// series 1: simple field
+		ctx.DownSampling(slotRange, 0, 0, mockValueGetter{1: 10, 2: 20})
+		ctx.DownSampling(slotRange, 0, 0, mockValueGetter{1: 5})
+		// series 2: histogram
+		for _, fieldIdx := range []int{1, 2, 3, 4, 5} {
+			ctx.DownSampling(slotRange, 1, fieldIdx, mockValueGetter{2: float64(fieldIdx)})
+		}
+	}).AnyTimes()
+	indexDB.EXPECT().GetGroupingContext(gomock.Any()).DoAndReturn(func(ctx *flow.ShardExecuteContext) error {
+		ctx.GroupingContext = groupingCtx
+		return nil
+	}).AnyTimes()
+	groupingCtx.EXPECT().ScanSeriesTagValueIDs(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ *flow.DataLoadContext, fn func(seriesIdxFromQuery uint16, tagKeyIDIdx int, tagValueID uint32)) {
+			fn(0, 0, 10)
+			fn(1, 0, 20)
+		}).AnyTimes()
+	tagMeta.EXPECT().CollectTagValues(tag.KeyID(1), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ tag.KeyID, _ *roaring.Bitmap, tagValues map[uint32]string) error {
+			tagValues[10] = "a"
+			tagValues[20] = "b"
+			return nil
+		}).AnyTimes()
+	var batches []*ExportBatch
+	assert.NoError(t, exporter.Export(ctx, func(batch *ExportBatch) error {
+		batches = append(batches, batch)
+		return nil
+	}))
+	assert.Len(t, batches, 1)
+	assert.Equal(t, familyTime, batches[0].FamilyTime)
+	assert.Equal(t, interval, batches[0].Interval)
+	assert.Equal(t, map[int32]int64{1: 100}, batches[0].Sequences)
+
+	batchRows := metric.NewStorageBatchRows()
+	batchRows.UnmarshalRows(batches[0].Rows)
+	rows := batchRows.Rows()
+	assert.Len(t, rows, 3)
+	// series 1, slot 1: sum of values
+	assert.Equal(t, "cpu", string(rows[0].Name()))
+	assert.Equal(t, "ns", string(rows[0].NameSpace()))
+	assert.Equal(t, familyTime+10*timeutil.OneSecond, rows[0].Timestamp())
+	simpleFieldItr := rows[0].NewSimpleFieldIterator()
+	assert.True(t, simpleFieldItr.HasNext())
+	assert.Equal(t, field.Name("f1"), simpleFieldItr.NextName())
+	assert.Equal(t, 15.0, simpleFieldItr.NextValue())
+	kvItr := rows[0].NewKeyValueIterator()
+	assert.True(t, kvItr.HasNext())
+	assert.Equal(t, "a", string(kvItr.NextValue()))
+	// series 2: histogram
+	compoundFieldItr, ok := rows[2].NewCompoundFieldIterator()
+	assert.True(t, ok)
+	assert.Equal(t, 1.0, compoundFieldItr.Sum())
+	assert.Equal(t, 2.0, compoundFieldItr.Count())
+	assert.False(t, rows[2].NewSimpleFieldIterator().HasNext())
+
+	// case 6: callback failure
+	assert.Error(t, exporter.Export(ctx, func(batch *ExportBatch) error {
+		return fmt.Errorf("err")
+	}))
+	// case 7: collect tag values failure
+	tagMeta2 := metadb.NewMockTagMetadata(ctrl)
+	meta2 := metadb.NewMockMetadata(ctrl)
+	db2 := NewMockDatabase(ctrl)
+	shard2 := NewMockShard(ctrl)
+	shard2.EXPECT().Database().Return(db2).AnyTimes()
+	shard2.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	shard2.EXPECT().CurrentInterval().Return(interval).AnyTimes()
+	shard2.EXPECT().GetDataFamilies(interval.Type(), gomock.Any()).Return([]DataFamily{family}).AnyTimes()
+	db2.EXPECT().Metadata().Return(meta2).AnyTimes()
+	db2.EXPECT().GetOption().Return(&option.DatabaseOption{Intervals: option.Intervals{{Interval: interval}}}).AnyTimes()
+	meta2.EXPECT().MetadataDatabase().Return(metaDB).AnyTimes()
+	meta2.EXPECT().TagMetadata().Return(tagMeta2).AnyTimes()
+	tagMeta2.EXPECT().CollectTagValues(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, NewShardExporter(shard2).Export(ctx, noop))
+
+	// case 8: export data families under rollup interval, only data before smaller interval
+	rollupInterval := timeutil.Interval(timeutil.OneHour)
+	db3 := NewMockDatabase(ctrl)
+	shard3 := NewMockShard(ctrl)
+	shard3.EXPECT().Database().Return(db3).AnyTimes()
+	shard3.EXPECT().IndexDatabase().Return(indexDB).AnyTimes()
+	db3.EXPECT().Metadata().Return(meta).AnyTimes()
+	db3.EXPECT().GetOption().Return(&option.DatabaseOption{
+		Intervals: option.Intervals{{Interval: rollupInterval}, {Interval: interval}},
+	}).AnyTimes()
+	rollupFamily1 := NewMockDataFamily(ctrl) // before writable family
+	rollupFamily1.EXPECT().Interval().Return(rollupInterval).AnyTimes()
+	rollupFamily1.EXPECT().FamilyTime().Return(familyTime - timeutil.OneDay).AnyTimes()
+	rollupFamily1.EXPECT().TimeRange().Return(timeutil.TimeRange{Start: familyTime - timeutil.OneDay, End: familyTime - 1}).AnyTimes()
+	rollupFamily1.EXPECT().Indicator().Return("rollup-family1").AnyTimes()
+	rollupFamily1.EXPECT().FileFilter(gomock.Any()).Return(nil, nil).AnyTimes()
+	rollupFamily2 := NewMockDataFamily(ctrl) // same time range with writable family, skip it
+	rollupFamily2.EXPECT().Interval().Return(rollupInterval).AnyTimes()
+	rollupFamily2.EXPECT().TimeRange().Return(timeutil.TimeRange{Start: familyTime, End: familyTime + timeutil.OneDay}).AnyTimes()
+	shard3.EXPECT().GetDataFamilies(interval.Type(), gomock.Any()).Return([]DataFamily{family}).AnyTimes()
+	shard3.EXPECT().GetDataFamilies(rollupInterval.Type(), gomock.Any()).Return([]DataFamily{rollupFamily1, rollupFamily2}).AnyTimes()
+	batches = nil
+	assert.NoError(t, NewShardExporter(shard3).Export(ctx, func(batch *ExportBatch) error {
+		batches = append(batches, batch)
+		return nil
+	}))
+	assert.Len(t, batches, 2)
+	assert.Equal(t, interval, batches[0].Interval)
+	assert.Equal(t, map[int32]int64{1: 100}, batches[0].Sequences)
+	assert.Equal(t, rollupInterval, batches[1].Interval)
+	assert.Equal(t, familyTime-timeutil.OneDay, batches[1].FamilyTime)
+	assert.Empty(t, batches[1].Sequences)
+}
+
+func TestImportBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	shard := NewMockShard(ctrl)
+	family := NewMockDataFamily(ctrl)
+	shard.EXPECT().CurrentInterval().Return(timeutil.Interval(10 * timeutil.OneSecond)).AnyTimes()
+	// case 1: create family failure
+	shard.EXPECT().GetOrCrateDataFamily(int64(10)).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, ImportBatch(shard, &ExportBatch{FamilyTime: 10}))
+
+	shard.EXPECT().GetOrCrateDataFamily(int64(10)).Return(family, nil).AnyTimes()
+	family.EXPECT().Retain().AnyTimes()
+	family.EXPECT().Release().AnyTimes()
+	// case 2: only commit sequences
+	family.EXPECT().CommitSequence(int32(1), int64(100))
+	assert.NoError(t, ImportBatch(shard, &ExportBatch{FamilyTime: 10, Sequences: map[int32]int64{1: 100}}))
+
+	var buf bytes.Buffer
+	_, err := metric.NewProtoConverter().MarshalProtoMetricV1To(&protoMetricsV1.Metric{
+		Name:         "cpu",
+		Timestamp:    10,
+		SimpleFields: []*protoMetricsV1.SimpleField{{Name: "f1", Type: protoMetricsV1.SimpleFieldType_DELTA_SUM, Value: 1}},
+	}, &buf)
+	assert.NoError(t, err)
+	rows := buf.Bytes()
+	// case 3: lookup meta failure
+	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, ImportBatch(shard, &ExportBatch{FamilyTime: 10, Rows: rows}))
+	// case 4: write rows failure
+	shard.EXPECT().LookupRowMetricMeta(gomock.Any()).Return(nil).AnyTimes()
+	family.EXPECT().WriteRows(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, ImportBatch(shard, &ExportBatch{FamilyTime: 10, Rows: rows}))
+	// case 5: import successfully
+	family.EXPECT().WriteRows(gomock.Any()).Return(nil)
+	family.EXPECT().CommitSequence(int32(1), int64(100))
+	assert.NoError(t, ImportBatch(shard, &ExportBatch{FamilyTime: 10, Rows: rows, Sequences: map[int32]int64{1: 100}}))
+	// case 6: create rollup family failure
+	rollupInterval := timeutil.Interval(timeutil.OneHour)
+	shard.EXPECT().GetOrCreateRollupDataFamily(rollupInterval, int64(10)).Return(nil, fmt.Errorf("err"))
+	assert.Error(t, ImportBatch(shard, &ExportBatch{Interval: rollupInterval, FamilyTime: 10}))
+	// case 7: import into rollup family
+	shard.EXPECT().GetOrCreateRollupDataFamily(rollupInterval, int64(10)).Return(family, nil)
+	family.EXPECT().WriteRows(gomock.Any()).Return(nil)
+	assert.NoError(t, ImportBatch(shard, &ExportBatch{Interval: rollupInterval, FamilyTime: 10, Rows: rows}))
+}
@@ -72,6 +72,23 @@ func (ss *shardSet) InsertShard(shardID models.ShardID, shard Shard) {
 	ss.num.Inc()
 }
 
+// DeleteShard removes the shard by shardID,
+// then changes atomic.Value to the new set
+func (ss *shardSet) DeleteShard(shardID models.ShardID) {
+	oldEntries := ss.value.Load().(shardEntries)
+	newEntries := make([]shardEntry, 0, oldEntries.Len())
+	for idx := range oldEntries {
+		if oldEntries[idx].shardID != shardID {
+			newEntries = append(newEntries, oldEntries[idx])
+		}
+	}
+	if len(newEntries) == oldEntries.Len() {
+		return
+	}
+	ss.value.Store(shardEntries(newEntries))
+	ss.num.Dec()
+}
+
 // GetShard searches the shard by shardID from the shardSet
 // BinarySearch is not always faster than iterating
 func (ss *shardSet) GetShard(shardID models.ShardID) (Shard, bool) {
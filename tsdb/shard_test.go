@@ -28,10 +28,13 @@ import (
 	"github.com/golang/mock/gomock"
 	commonconstants "github.com/lindb/common/constants"
 	protoMetricsV1 "github.com/lindb/common/proto/gen/v1/linmetrics"
+	"github.com/lindb/roaring"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/lindb/lindb/constants"
 	"github.com/lindb/lindb/kv"
 	"github.com/lindb/lindb/metrics"
+	"github.com/lindb/lindb/models"
 	"github.com/lindb/lindb/pkg/fileutil"
 	"github.com/lindb/lindb/pkg/logger"
 	"github.com/lindb/lindb/pkg/option"
@@ -76,6 +79,16 @@ func TestShard_New(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "load tombstone err",
+			prepare: func() {
+				newTombstoneStoreFunc = func(path string) (TombstoneStore, error) {
+					return nil, fmt.Errorf("err")
+				}
+				db.EXPECT().GetOption().Return(&option.DatabaseOption{Intervals: option.Intervals{{}}})
+			},
+			wantErr: true,
+		},
 		{
 			name: "create interval segment err",
 			prepare: func() {
@@ -153,7 +166,9 @@ func TestShard_New(t *testing.T) {
 			prepare: func() {
 				newIndexDBFunc = func(ctx context.Context, parent string, metadata metadb.Metadata,
 					forwardFamily kv.Family, invertedFamily kv.Family) (indexdb.IndexDatabase, error) {
-					return nil, nil
+					indexDB := indexdb.NewMockIndexDatabase(ctrl)
+					indexDB.EXPECT().SetSeriesQuota(gomock.Any())
+					return indexDB, nil
 				}
 				store := kv.NewMockStore(ctrl)
 				gomock.InOrder(
@@ -176,6 +191,7 @@ func TestShard_New(t *testing.T) {
 					return nil
 				}
 				newIndexDBFunc = indexdb.NewIndexDatabase
+				newTombstoneStoreFunc = newTombstoneStore
 				seq := NewMockIntervalSegment(ctrl)
 				seq.EXPECT().Close().AnyTimes()
 
@@ -270,6 +286,37 @@ func TestShard_GetDataFamilies(t *testing.T) {
 	assert.Len(t, families, 1)
 }
 
+func TestShard_GetOrCreateRollupDataFamily(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rollupSeg := NewMockIntervalSegment(ctrl)
+	segment := NewMockSegment(ctrl)
+	rollupInterval := timeutil.Interval(10 * 60 * 1000) // 10min
+	s := &shard{
+		indicator: "db/shard/1",
+		interval:  timeutil.Interval(10 * 1000), // 10s
+		rollupTargets: map[timeutil.Interval]IntervalSegment{
+			rollupInterval: rollupSeg,
+		},
+	}
+	// case 1: rollup interval not found
+	family, err := s.GetOrCreateRollupDataFamily(timeutil.Interval(timeutil.OneHour), 10)
+	assert.Error(t, err)
+	assert.Nil(t, family)
+	// case 2: create segment failure
+	rollupSeg.EXPECT().GetOrCreateSegment(gomock.Any()).Return(nil, fmt.Errorf("err"))
+	family, err = s.GetOrCreateRollupDataFamily(rollupInterval, 10)
+	assert.Error(t, err)
+	assert.Nil(t, family)
+	// case 3: create family successfully
+	rollupSeg.EXPECT().GetOrCreateSegment(gomock.Any()).Return(segment, nil)
+	segment.EXPECT().GetOrCreateDataFamily(int64(10)).Return(NewMockDataFamily(ctrl), nil)
+	family, err = s.GetOrCreateRollupDataFamily(rollupInterval, 10)
+	assert.NoError(t, err)
+	assert.NotNil(t, family)
+}
+
 func TestShard_Close(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -430,6 +477,16 @@ func TestShard_Write(t *testing.T) {
 					Return(metric.ID(0), fmt.Errorf("err"))
 			},
 		},
+		{
+			name: "series quota exceeded",
+			prepare: func() {
+				metadataDB.EXPECT().GenMetricID(commonconstants.DefaultNamespace, "test").
+					Return(metric.ID(10), nil)
+				indexDB.EXPECT().GetOrCreateSeriesID(gomock.Any(), gomock.Any(), metric.ID(10), gomock.Any()).
+					Return(uint32(0), false, &models.SeriesQuotaError{Scope: models.MetricQuotaScope, Name: "test", Quota: 1, Usage: 1})
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range cases {
 		tt := tt
@@ -440,6 +497,7 @@ func TestShard_Write(t *testing.T) {
 			err := s.LookupRowMetricMeta(mockBatchRows(&protoMetricsV1.Metric{
 				Name:      "test",
 				Timestamp: timeutil.Now(),
+				Tags:      tag.KeyValuesFromMap(map[string]string{"ip": "1.1.1.1"}),
 				SimpleFields: []*protoMetricsV1.SimpleField{{
 					Name:  "f1",
 					Value: 1.0,
@@ -449,6 +507,15 @@ func TestShard_Write(t *testing.T) {
 			if (err != nil) != tt.wantErr {
 				t.Errorf("WriteRows() error = %v, wantErr %v", err, tt.wantErr)
 			}
+			if err != nil {
+				assert.ErrorIs(t, err, constants.ErrSeriesQuotaExceeded)
+				errs, seq := s.SeriesQuotaErrors(0)
+				assert.Equal(t, []models.SeriesQuotaError{
+					{Scope: models.MetricQuotaScope, Name: "test", Quota: 1, Usage: 1},
+				}, errs)
+				errs, _ = s.SeriesQuotaErrors(seq)
+				assert.Empty(t, errs)
+			}
 		})
 	}
 }
@@ -488,7 +555,7 @@ func TestShard_lookupRowMeta(t *testing.T) {
 			tags: tag.KeyValuesFromMap(map[string]string{"ip": "1.1.1.1"}),
 			prepare: func() {
 				metadataDB.EXPECT().GenMetricID(commonconstants.DefaultNamespace, "test").Return(metric.ID(10), nil).AnyTimes()
-				indexDB.EXPECT().GetOrCreateSeriesID(metric.ID(10), gomock.Any()).Return(uint32(0), false, fmt.Errorf("err"))
+				indexDB.EXPECT().GetOrCreateSeriesID(gomock.Any(), gomock.Any(), metric.ID(10), gomock.Any()).Return(uint32(0), false, fmt.Errorf("err"))
 			},
 			wantErr: true,
 		},
@@ -498,7 +565,7 @@ func TestShard_lookupRowMeta(t *testing.T) {
 			prepare: func() {
 				metadataDB.EXPECT().GenMetricID(commonconstants.DefaultNamespace, "test").Return(metric.ID(10), nil).AnyTimes()
 				metadataDB.EXPECT().GenFieldID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(field.ID(1), nil)
-				indexDB.EXPECT().GetOrCreateSeriesID(metric.ID(10), gomock.Any()).Return(uint32(10), false, nil)
+				indexDB.EXPECT().GetOrCreateSeriesID(gomock.Any(), gomock.Any(), metric.ID(10), gomock.Any()).Return(uint32(10), false, nil)
 			},
 		},
 	}
@@ -567,6 +634,41 @@ func TestShard_WaitFlushIndexCompleted(t *testing.T) {
 	assert.True(t, timeutil.Now()-now >= 90*time.Millisecond.Milliseconds())
 }
 
+func TestShard_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	segment := NewMockIntervalSegment(ctrl)
+	family := NewMockDataFamily(ctrl)
+	tombstones := NewMockTombstoneStore(ctrl)
+	s := &shard{
+		indicator: "db/shard/1",
+		rollupTargets: map[timeutil.Interval]IntervalSegment{
+			10: segment,
+		},
+		tombstones: tombstones,
+		logger:     logger.GetLogger("TSDB", "Test"),
+	}
+	assert.Equal(t, tombstones, s.Tombstones())
+	segment.EXPECT().GetDataFamilies(gomock.Any()).Return([]DataFamily{family}).AnyTimes()
+	// case 1: flush family failure
+	family.EXPECT().Flush().Return(fmt.Errorf("err"))
+	assert.Error(t, s.Delete(1, roaring.BitmapOf(1), timeutil.TimeRange{Start: 10, End: 20}))
+	// case 2: add tombstone failure
+	family.EXPECT().Flush().Return(nil)
+	tombstones.EXPECT().Add(gomock.Any()).Return(fmt.Errorf("err"))
+	assert.Error(t, s.Delete(1, roaring.BitmapOf(1), timeutil.TimeRange{Start: 10, End: 20}))
+	// case 3: delete successfully, refresh tombstones of family
+	family.EXPECT().Flush().Return(nil)
+	tombstones.EXPECT().Add(&Tombstone{
+		MetricID:  1,
+		SeriesIDs: roaring.BitmapOf(1),
+		TimeRange: timeutil.TimeRange{Start: 10, End: 20},
+	}).Return(nil)
+	family.EXPECT().refreshTombstones()
+	assert.NoError(t, s.Delete(1, roaring.BitmapOf(1), timeutil.TimeRange{Start: 10, End: 20}))
+}
+
 func TestShard_TTL(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -580,10 +682,82 @@ func TestShard_TTL(t *testing.T) {
 		db:     db,
 		logger: logger.GetLogger("TSDB", "Test"),
 	}
+	tombstones := NewMockTombstoneStore(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	s.tombstones = tombstones
+	s.indexDB = indexDB
 	segment.EXPECT().TTL().Return(fmt.Errorf("err"))
+	tombstones.EXPECT().GetTombstones(gomock.Any()).Return(nil)
+	indexDB.EXPECT().PurgeDroppedTagKeys().Return(fmt.Errorf("err"))
 	s.TTL()
 }
 
+func TestShard_purgeTombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	segment1 := NewMockIntervalSegment(ctrl)
+	segment2 := NewMockIntervalSegment(ctrl)
+	tombstones := NewMockTombstoneStore(ctrl)
+	s := &shard{
+		rollupTargets: map[timeutil.Interval]IntervalSegment{
+			10:  segment1,
+			300: segment2,
+		},
+		tombstones: tombstones,
+		logger:     logger.GetLogger("TSDB", "Test"),
+	}
+	t1 := &Tombstone{MetricID: 1, TimeRange: timeutil.TimeRange{Start: 10, End: 20}}
+	t2 := &Tombstone{MetricID: 2, TimeRange: timeutil.TimeRange{Start: 30, End: 40}}
+	t3 := &Tombstone{MetricID: 3, TimeRange: timeutil.TimeRange{Start: 5, End: 50}}
+	all := []*Tombstone{t1, t2, t3}
+	cases := []struct {
+		name    string
+		prepare func()
+	}{
+		{
+			name: "no tombstones",
+			prepare: func() {
+				tombstones.EXPECT().GetTombstones(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "all tombstones retained",
+			prepare: func() {
+				tombstones.EXPECT().GetTombstones(gomock.Any()).Return(all)
+				segment1.EXPECT().PurgeTombstones(all).Return([]*Tombstone{t1, t2})
+				segment2.EXPECT().PurgeTombstones(all).Return([]*Tombstone{t3})
+			},
+		},
+		{
+			name: "remove tombstones failure",
+			prepare: func() {
+				tombstones.EXPECT().GetTombstones(gomock.Any()).Return(all)
+				segment1.EXPECT().PurgeTombstones(all).Return([]*Tombstone{t2})
+				segment2.EXPECT().PurgeTombstones(all).Return(nil)
+				tombstones.EXPECT().Remove([]*Tombstone{t1, t3}).Return(fmt.Errorf("err"))
+			},
+		},
+		{
+			name: "remove tombstones, then refresh families",
+			prepare: func() {
+				tombstones.EXPECT().GetTombstones(gomock.Any()).Return(all)
+				segment1.EXPECT().PurgeTombstones(all).Return(nil)
+				segment2.EXPECT().PurgeTombstones(all).Return([]*Tombstone{t1})
+				tombstones.EXPECT().Remove([]*Tombstone{t2, t3}).Return(nil)
+				segment1.EXPECT().RefreshTombstones(timeutil.TimeRange{Start: 5, End: 50})
+				segment2.EXPECT().RefreshTombstones(timeutil.TimeRange{Start: 5, End: 50})
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(_ *testing.T) {
+			tt.prepare()
+			s.purgeTombstones()
+		})
+	}
+}
+
 func TestShard_EvictSegment(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -601,6 +775,58 @@ func TestShard_EvictSegment(t *testing.T) {
 	s.EvictSegment()
 }
 
+func TestShard_UpdateOption(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newIntervalSegmentFunc = newIntervalSegment
+		ctrl.Finish()
+	}()
+	db := NewMockDatabase(ctrl)
+	db.EXPECT().Name().Return("test").AnyTimes()
+	segment := NewMockIntervalSegment(ctrl)
+	indexDB := indexdb.NewMockIndexDatabase(ctrl)
+	indexDB.EXPECT().SetSeriesQuota(gomock.Any())
+	s := &shard{
+		rollupTargets: map[timeutil.Interval]IntervalSegment{
+			10: segment,
+		},
+		db:      db,
+		indexDB: indexDB,
+		logger:  logger.GetLogger("TSDB", "Test"),
+	}
+	opt := &option.DatabaseOption{Intervals: option.Intervals{{Interval: 10}, {Interval: 100}}}
+	// create new rollup segment failure
+	segment.EXPECT().UpdateOption(opt.Intervals[0])
+	newIntervalSegmentFunc = func(_ Shard, _ option.Interval) (IntervalSegment, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, s.UpdateOption(opt))
+	assert.Len(t, s.getRollupTargets(), 1)
+	// create new rollup segment successfully
+	segment2 := NewMockIntervalSegment(ctrl)
+	segment.EXPECT().UpdateOption(opt.Intervals[0])
+	newIntervalSegmentFunc = func(_ Shard, _ option.Interval) (IntervalSegment, error) {
+		return segment2, nil
+	}
+	assert.NoError(t, s.UpdateOption(opt))
+	assert.Equal(t, map[timeutil.Interval]IntervalSegment{10: segment, 100: segment2}, s.getRollupTargets())
+	assert.Equal(t, opt, s.getOption())
+	// read option concurrently while updating option
+	opt2 := &option.DatabaseOption{Intervals: opt.Intervals, ShardSeriesQuota: option.ShardSeriesQuotaOption{MaxSeries: 100}}
+	segment.EXPECT().UpdateOption(opt.Intervals[0])
+	segment2.EXPECT().UpdateOption(opt.Intervals[1])
+	indexDB.EXPECT().SetSeriesQuota(opt2.ShardSeriesQuota)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NotNil(t, s.getOption())
+	}()
+	assert.NoError(t, s.UpdateOption(opt2))
+	wg.Wait()
+	assert.Equal(t, opt2, s.getOption())
+}
+
 func mockBatchRows(m *protoMetricsV1.Metric) []metric.StorageRow {
 	var ml = protoMetricsV1.MetricList{Metrics: []*protoMetricsV1.Metric{m}}
 	var buf bytes.Buffer
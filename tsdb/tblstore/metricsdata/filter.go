@@ -40,14 +40,16 @@ type metricsDataFilter struct {
 	familyTime int64
 	snapshot   version.Snapshot
 	readers    []MetricReader
+	tombstones []Tombstone
 }
 
-// NewFilter creates the sst file data filter
-func NewFilter(familyTime int64, snapshot version.Snapshot, readers []MetricReader) Filter {
+// NewFilter creates the sst file data filter, the data deleted by tombstones will be filtered.
+func NewFilter(familyTime int64, snapshot version.Snapshot, readers []MetricReader, tombstones []Tombstone) Filter {
 	return &metricsDataFilter{
 		familyTime: familyTime,
 		snapshot:   snapshot,
 		readers:    readers,
+		tombstones: tombstones,
 	}
 }
 
@@ -64,10 +66,22 @@ func (f *metricsDataFilter) Filter(
 		}
 		// after and operator, query bitmap is sub of store bitmap
 		matchSeriesIDs := roaring.FastAnd(seriesIDs, reader.GetSeriesIDs())
+		var partialTombstones []Tombstone
+		if len(f.tombstones) > 0 {
+			var deletedSeriesIDs *roaring.Bitmap
+			deletedSeriesIDs, partialTombstones = splitTombstones(f.tombstones, reader.GetTimeRange())
+			if deletedSeriesIDs != nil {
+				// remove series which deleted in whole time range of sst file
+				matchSeriesIDs.AndNot(deletedSeriesIDs)
+			}
+		}
 		if matchSeriesIDs.IsEmpty() {
 			// series ids not found
 			continue
 		}
+		if len(partialTombstones) > 0 {
+			reader.setTombstones(partialTombstones)
+		}
 		rs = append(rs, newFileFilterResultSet(f.familyTime, matchSeriesIDs, reader, f.snapshot))
 	}
 	// not founds
@@ -60,7 +60,7 @@ func TestMetricsDataFilter_Filter(t *testing.T) {
 	defer ctrl.Finish()
 
 	reader := NewMockMetricReader(ctrl)
-	filter := NewFilter(10, nil, []MetricReader{reader})
+	filter := NewFilter(10, nil, []MetricReader{reader}, nil)
 
 	// case 1: field not found
 	reader.EXPECT().GetFields().Return(field.Metas{{ID: 2}, {ID: 20}})
@@ -83,3 +83,30 @@ func TestMetricsDataFilter_Filter(t *testing.T) {
 	reader.EXPECT().Path().Return("1.sst")
 	assert.Equal(t, "1.sst", rs[0].Identifier())
 }
+
+func TestMetricsDataFilter_Filter_Tombstones(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reader := NewMockMetricReader(ctrl)
+	filter := NewFilter(10, nil, []MetricReader{reader}, []Tombstone{
+		{SeriesIDs: roaring.BitmapOf(1, 2), SlotRange: timeutil.SlotRange{Start: 0, End: 100}},
+		{SeriesIDs: roaring.BitmapOf(3), SlotRange: timeutil.SlotRange{Start: 15, End: 18}},
+		{SeriesIDs: roaring.BitmapOf(3), SlotRange: timeutil.SlotRange{Start: 50, End: 60}},
+	})
+	reader.EXPECT().GetFields().Return(field.Metas{{ID: 2}}).AnyTimes()
+	reader.EXPECT().GetSeriesIDs().Return(roaring.BitmapOf(1, 2, 3)).AnyTimes()
+	reader.EXPECT().GetTimeRange().Return(timeutil.SlotRange{Start: 10, End: 20}).AnyTimes()
+	// case 1: all series deleted
+	rs, err := filter.Filter(roaring.BitmapOf(1, 2), field.Metas{{ID: 2}})
+	assert.True(t, errors.Is(err, constants.ErrNotFound))
+	assert.Nil(t, rs)
+	// case 2: series deleted partially
+	reader.EXPECT().setTombstones([]Tombstone{
+		{SeriesIDs: roaring.BitmapOf(3), SlotRange: timeutil.SlotRange{Start: 15, End: 18}},
+	})
+	rs, err = filter.Filter(roaring.BitmapOf(1, 2, 3), field.Metas{{ID: 2}})
+	assert.NoError(t, err)
+	assert.Len(t, rs, 1)
+	assert.EqualValues(t, []uint32{3}, rs[0].SeriesIDs().ToArray())
+}
@@ -43,6 +43,9 @@ type mergerContext struct {
 	targetRange, sourceRange timeutil.SlotRange
 	ratio                    uint16
 	baseSlot                 uint16
+
+	tombstones []Tombstone // tombstones which delete part of source range
+	seriesID   uint32      // current merging series id
 }
 
 // merger implements kv.Merger for merging series data for each metric
@@ -50,6 +53,7 @@ type merger struct {
 	dataFlusher  Flusher
 	seriesMerger SeriesMerger
 	rollup       kv.Rollup
+	tombstones   Tombstones
 }
 
 // NewMerger creates a metric data merger
@@ -64,11 +68,15 @@ func NewMerger(flusher kv.Flusher) (kv.Merger, error) {
 	}, nil
 }
 
-// Init initializes metric data merger, if rollup context exist do rollup job, else do compact job
+// Init initializes metric data merger, if rollup context exist do rollup job, else do compact job,
+// if tombstone context exist, purge the deleted data when do compact job.
 func (m *merger) Init(params map[string]interface{}) {
 	if rollupCtx, ok := params[kv.RollupContext]; ok {
 		m.rollup = rollupCtx.(kv.Rollup)
 	}
+	if tombstoneCtx, ok := params[kv.TombstoneContext]; ok {
+		m.tombstones, _ = tombstoneCtx.(Tombstones)
+	}
 }
 
 // Merge merges the multi metric data into one target metric data for same metric id
@@ -79,6 +87,18 @@ func (m *merger) Merge(key uint32, metricBlocks [][]byte) error {
 	if err != nil {
 		return err
 	}
+	if tombstones, ok := m.tombstones[key]; ok {
+		deletedSeriesIDs, partialTombstones := splitTombstones(tombstones, mergeCtx.sourceRange)
+		if deletedSeriesIDs != nil {
+			// purge series which deleted in whole time range
+			mergeCtx.seriesIDs.AndNot(deletedSeriesIDs)
+			if mergeCtx.seriesIDs.IsEmpty() {
+				// all series deleted, drop this metric
+				return nil
+			}
+		}
+		mergeCtx.tombstones = partialTombstones
+	}
 	// 2. Prepare metric
 	m.dataFlusher.PrepareMetric(key, mergeCtx.targetFields)
 	// 3. merge series data by roaring container
@@ -108,11 +128,12 @@ func (m *merger) Merge(key uint32, metricBlocks [][]byte) error {
 					fieldReaders[blockIdx].Reset(seriesEntry, timeRange)
 				}
 			}
+			mergeCtx.seriesID = encoding.ValueWithHighLowBits(uint32(highKey)<<16, lowSeriesID)
 			if err := m.seriesMerger.merge(mergeCtx, decodeStreams, fieldReaders); err != nil {
 				return err
 			}
 			// flush series id
-			if err := m.dataFlusher.FlushSeries(encoding.ValueWithHighLowBits(uint32(highKey)<<16, lowSeriesID)); err != nil {
+			if err := m.dataFlusher.FlushSeries(mergeCtx.seriesID); err != nil {
 				return err
 			}
 		}
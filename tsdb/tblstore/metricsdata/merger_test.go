@@ -262,3 +262,64 @@ func mockMetricMergeBlockOneField(seriesIDs []uint32, start, end uint16) []byte
 	_ = flusher.CommitMetric(timeutil.SlotRange{Start: start, End: end})
 	return nopKVFlusher.Bytes()
 }
+
+func TestMerger_Compact_Tombstone(t *testing.T) {
+	flusher := kv.NewNopFlusher()
+	merge, err := NewMerger(flusher)
+	assert.NoError(t, err)
+	merge.Init(map[string]interface{}{kv.TombstoneContext: Tombstones{
+		1: {
+			{SeriesIDs: roaring.BitmapOf(1), SlotRange: timeutil.SlotRange{Start: 0, End: 100}},
+			{SeriesIDs: roaring.BitmapOf(2), SlotRange: timeutil.SlotRange{Start: 16, End: 18}},
+		},
+		2: {
+			{SeriesIDs: roaring.BitmapOf(1, 2, 4), SlotRange: timeutil.SlotRange{Start: 0, End: 100}},
+		},
+	}})
+	// case 1: all series deleted, drop metric
+	err = merge.Merge(2, [][]byte{mockRealMetricBlock([]uint32{1, 2, 4}, 11, 15)})
+	assert.NoError(t, err)
+	assert.Empty(t, flusher.Bytes())
+	// case 2: purge deleted data
+	err = merge.Merge(1, [][]byte{
+		mockRealMetricBlock([]uint32{1, 2, 4}, 11, 15),
+		mockRealMetricBlock([]uint32{2, 20}, 16, 20),
+	})
+	assert.NoError(t, err)
+	r, err := NewReader("test", flusher.Bytes())
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{2, 4, 20}, r.GetSeriesIDs().ToArray())
+
+	slots := loadSlots(r, 2)
+	assert.Equal(t, []uint16{11, 12, 13, 14, 15, 19, 20}, slots)
+}
+
+// loadSlots returns the time slots which has value of first field for series.
+func loadSlots(r MetricReader, seriesID uint32) (slots []uint16) {
+	ctx := &flow.DataLoadContext{
+		LowSeriesIDsContainer: roaring.BitmapOf(seriesID).GetContainerAtIndex(0),
+		ShardExecuteCtx: &flow.ShardExecuteContext{
+			StorageExecuteCtx: &flow.StorageExecuteContext{
+				Fields: r.GetFields(),
+				Query:  &stmt.Query{},
+			},
+		},
+		DownSampling: func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter) {
+			if fieldIdx != 0 {
+				return
+			}
+			for slot := slotRange.Start; slot <= slotRange.End; slot++ {
+				if _, ok := getter.GetValue(slot); ok {
+					slots = append(slots, slot)
+				}
+			}
+		},
+		Decoder: encoding.GetTSDDecoder(),
+	}
+	ctx.Grouping()
+	loader := r.Load(ctx)
+	if loader != nil {
+		loader.Load(ctx)
+	}
+	return
+}
@@ -58,6 +58,8 @@ type MetricReader interface {
 	Load(ctx *flow.DataLoadContext) flow.DataLoader
 	// readSeriesData reads series data from file by seriesEntryBlock
 	readSeriesData(ctx *flow.DataLoadContext, seriesIdx uint16, seriesEntryBlock []byte)
+	// setTombstones sets the tombstones which delete part of data in this sst file.
+	setTombstones(tombstones []Tombstone)
 }
 
 // metricReader implements MetricReader interface that reads metric block
@@ -71,7 +73,8 @@ type metricReader struct {
 	crc32CheckSum  uint32
 	timeRange      timeutil.SlotRange
 
-	readFieldIndexes []int // read field indexes be used when query metric data
+	readFieldIndexes []int       // read field indexes be used when query metric data
+	tombstones       []Tombstone // tombstones which delete part of data
 }
 
 // NewReader creates a metric block metricReader
@@ -106,6 +109,11 @@ func (r *metricReader) GetTimeRange() timeutil.SlotRange {
 	return r.timeRange
 }
 
+// setTombstones sets the tombstones which delete part of data in this sst file.
+func (r *metricReader) setTombstones(tombstones []Tombstone) {
+	r.tombstones = tombstones
+}
+
 // prepare the field aggregator based on query condition.
 func (r *metricReader) prepare(fields field.Metas) (found bool) {
 	fieldMap := make(map[field.ID]int)
@@ -170,11 +178,24 @@ func (r *metricReader) Load(ctx *flow.DataLoadContext) flow.DataLoader {
 // readSeriesData reads series data from file by given position.
 func (r *metricReader) readSeriesData(ctx *flow.DataLoadContext, seriesIdx uint16, seriesEntryBlock []byte) {
 	decoder := ctx.Decoder
+	var getter encoding.TSDValueGetter = decoder
+	if len(r.tombstones) > 0 {
+		getter = &tombstoneValueGetter{
+			getter:     decoder,
+			tombstones: r.tombstones,
+			seriesID:   uint32(ctx.SeriesIDHighKey)<<16 | uint32(ctx.MinSeriesID+seriesIdx),
+		}
+	}
 	fieldCount := r.fields.Len()
 	if fieldCount == 1 {
-		decoder.ResetWithTimeRange(seriesEntryBlock, r.timeRange.Start, r.timeRange.End)
-		// metric has one field, just read the data
-		ctx.DownSampling(r.timeRange, seriesIdx, 0, decoder)
+		// metric has one field, just read the data,
+		// field index must use the index of query fields.
+		for queryIdx, readIdx := range r.readFieldIndexes {
+			if readIdx == 0 {
+				decoder.ResetWithTimeRange(seriesEntryBlock, r.timeRange.Start, r.timeRange.End)
+				ctx.DownSampling(r.timeRange, seriesIdx, queryIdx, getter)
+			}
+		}
 		return
 	}
 
@@ -196,7 +217,7 @@ func (r *metricReader) readSeriesData(ctx *flow.DataLoadContext, seriesIdx uint1
 		if err == nil {
 			decoder.ResetWithTimeRange(fieldBlock, r.timeRange.Start, r.timeRange.End)
 			// read field data
-			ctx.DownSampling(r.timeRange, seriesIdx, queryIdx, decoder)
+			ctx.DownSampling(r.timeRange, seriesIdx, queryIdx, getter)
 		}
 	}
 	encoding.ReleaseFixedOffsetDecoder(fieldOffsetsDecoder)
@@ -161,6 +161,23 @@ func TestReader_Load(t *testing.T) {
 	ctx.Grouping()
 	scanner = r.Load(ctx)
 	assert.Nil(t, scanner)
+	// case 7: metric has one field, query multi-fields
+	r, err = NewReader("1.sst", mockMetricBlockForOneField())
+	assert.NoError(t, err)
+	var fieldIndexes []int
+	ctx.SeriesIDHighKey = 0
+	ctx.ShardExecuteCtx.StorageExecuteCtx.Fields = field.Metas{{ID: 1}, {ID: 2}}
+	ctx.DownSampling = func(slotRange timeutil.SlotRange, seriesIdx uint16, fieldIdx int, getter encoding.TSDValueGetter) {
+		fieldIndexes = append(fieldIndexes, fieldIdx)
+	}
+	ctx.Grouping()
+	scanner = r.Load(ctx)
+	assert.NotNil(t, scanner)
+	scanner.Load(ctx)
+	assert.NotEmpty(t, fieldIndexes)
+	for _, fieldIdx := range fieldIndexes {
+		assert.Equal(t, 1, fieldIdx)
+	}
 }
 
 func TestReader_scan(t *testing.T) {
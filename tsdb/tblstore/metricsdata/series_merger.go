@@ -18,6 +18,8 @@
 package metricsdata
 
 import (
+	"math"
+
 	"github.com/lindb/lindb/aggregation"
 	"github.com/lindb/lindb/pkg/encoding"
 )
@@ -72,13 +74,23 @@ func (sm *seriesMerger) merge(
 				streams[idx].ResetWithTimeRange(fieldData, oldSlotRange.Start, oldSlotRange.End)
 			}
 		}
+		emitValue := encodeStream.EmitDownSamplingValue
+		if len(mergeCtx.tombstones) > 0 {
+			// compact merge with tombstones, purge the deleted data(emit empty value)
+			emitValue = func(targetPos int, value float64) {
+				if isDeleted(mergeCtx.tombstones, mergeCtx.seriesID, mergeCtx.targetRange.Start+uint16(targetPos)) {
+					value = math.Inf(1)
+				}
+				encodeStream.EmitDownSamplingValue(targetPos, value)
+			}
+		}
 		// merges field data from source time range => target time range,
 		// compact merge: source range = target range and ratio = 1
 		// rollup merge: source range[5,182]=>target range[0,6], ratio:30, source interval:10s, target interval:5min
 		aggregation.DownSamplingMultiSeriesInto(
 			mergeCtx.targetRange, mergeCtx.ratio, mergeCtx.baseSlot,
 			f.Type, streams,
-			encodeStream.EmitDownSamplingValue,
+			emitValue,
 		)
 
 		data, err := encodeStream.BytesWithoutTime()
@@ -0,0 +1,90 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricsdata
+
+import (
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/pkg/encoding"
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+// Tombstone represents the deleted series of metric in the slot range of family.
+type Tombstone struct {
+	SeriesIDs *roaring.Bitmap
+	SlotRange timeutil.SlotRange
+}
+
+// Tombstones represents the deleted data of family, metric id => tombstones.
+type Tombstones map[uint32][]Tombstone
+
+// covers returns if tombstone covers the whole slot range.
+func (t *Tombstone) covers(slotRange timeutil.SlotRange) bool {
+	return t.SlotRange.Start <= slotRange.Start && t.SlotRange.End >= slotRange.End
+}
+
+// overlaps returns if tombstone overlaps the slot range.
+func (t *Tombstone) overlaps(slotRange timeutil.SlotRange) bool {
+	return t.SlotRange.Start <= slotRange.End && t.SlotRange.End >= slotRange.Start
+}
+
+// splitTombstones splits the tombstones by slot range,
+// returns the series ids which are deleted in whole slot range and the tombstones which delete part of slot range.
+func splitTombstones(tombstones []Tombstone, slotRange timeutil.SlotRange) (deleted *roaring.Bitmap, partial []Tombstone) {
+	for idx := range tombstones {
+		tombstone := tombstones[idx]
+		switch {
+		case tombstone.covers(slotRange):
+			if deleted == nil {
+				deleted = roaring.New()
+			}
+			deleted.Or(tombstone.SeriesIDs)
+		case tombstone.overlaps(slotRange):
+			partial = append(partial, tombstone)
+		}
+	}
+	return deleted, partial
+}
+
+// isDeleted checks if the value of series in time slot is deleted.
+func isDeleted(tombstones []Tombstone, seriesID uint32, slot uint16) bool {
+	for idx := range tombstones {
+		tombstone := tombstones[idx]
+		if slot >= tombstone.SlotRange.Start && slot <= tombstone.SlotRange.End && tombstone.SeriesIDs.Contains(seriesID) {
+			return true
+		}
+	}
+	return false
+}
+
+// tombstoneValueGetter wraps the value getter, skips the values deleted by tombstones.
+type tombstoneValueGetter struct {
+	getter     encoding.TSDValueGetter
+	tombstones []Tombstone
+	seriesID   uint32
+}
+
+// GetValue returns value by time slot, if it hasn't or be deleted, return false.
+func (g *tombstoneValueGetter) GetValue(slot uint16) (float64, bool) {
+	// always read value from getter, because tsd decoder decodes value in sequence.
+	value, ok := g.getter.GetValue(slot)
+	if !ok || isDeleted(g.tombstones, g.seriesID, slot) {
+		return 0, false
+	}
+	return value, true
+}
@@ -0,0 +1,63 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metricsdata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+)
+
+func TestTombstone_split(t *testing.T) {
+	tombstones := []Tombstone{
+		{SeriesIDs: roaring.BitmapOf(1), SlotRange: timeutil.SlotRange{Start: 0, End: 100}},
+		{SeriesIDs: roaring.BitmapOf(2), SlotRange: timeutil.SlotRange{Start: 10, End: 20}},
+		{SeriesIDs: roaring.BitmapOf(3), SlotRange: timeutil.SlotRange{Start: 15, End: 30}},
+		{SeriesIDs: roaring.BitmapOf(4), SlotRange: timeutil.SlotRange{Start: 50, End: 60}},
+	}
+	deleted, partial := splitTombstones(tombstones, timeutil.SlotRange{Start: 10, End: 20})
+	assert.Equal(t, []uint32{1, 2}, deleted.ToArray())
+	assert.Equal(t, tombstones[2:3], partial)
+
+	deleted, partial = splitTombstones(tombstones, timeutil.SlotRange{Start: 200, End: 300})
+	assert.Nil(t, deleted)
+	assert.Empty(t, partial)
+
+	assert.True(t, isDeleted(tombstones, 3, 15))
+	assert.True(t, isDeleted(tombstones, 3, 30))
+	assert.False(t, isDeleted(tombstones, 3, 31))
+	assert.False(t, isDeleted(tombstones, 4, 15))
+}
+
+func TestTombstone_Reader(t *testing.T) {
+	r, err := NewReader("test", mockRealMetricBlock([]uint32{1, 2, 4}, 11, 15))
+	assert.NoError(t, err)
+	assert.Equal(t, []uint16{11, 12, 13, 14, 15}, loadSlots(r, 2))
+
+	r.setTombstones([]Tombstone{
+		{SeriesIDs: roaring.BitmapOf(2), SlotRange: timeutil.SlotRange{Start: 12, End: 13}},
+		{SeriesIDs: roaring.BitmapOf(4), SlotRange: timeutil.SlotRange{Start: 11, End: 15}},
+	})
+	assert.Equal(t, []uint16{11, 14, 15}, loadSlots(r, 2))
+	assert.Empty(t, loadSlots(r, 4))
+	assert.Equal(t, []uint16{11, 12, 13, 14, 15}, loadSlots(r, 1))
+}
@@ -34,9 +34,14 @@ func init() {
 type forwardMerger struct {
 	forwardFlusher ForwardFlusher
 	kvFlusher      kv.Flusher
+	dropped        *roaring.Bitmap // dropped tag keys which need purge
 }
 
-func (m *forwardMerger) Init(_ map[string]interface{}) {}
+func (m *forwardMerger) Init(params map[string]interface{}) {
+	if dropped, ok := params[kv.TombstoneContext].(*roaring.Bitmap); ok {
+		m.dropped = dropped
+	}
+}
 
 // NewForwardMerger creates a forward merger
 func NewForwardMerger(flusher kv.Flusher) (kv.Merger, error) {
@@ -52,6 +57,10 @@ func NewForwardMerger(flusher kv.Flusher) (kv.Merger, error) {
 
 // Merge merges the multi forward index data into a forward index for same tag key id
 func (m *forwardMerger) Merge(key uint32, values [][]byte) error {
+	if m.dropped != nil && m.dropped.Contains(key) {
+		// metric of tag key dropped, purge it
+		return nil
+	}
 	var scanners []*tagForwardScanner
 	seriesIDs := roaring.New() // target merged series ids
 	// 1. prepare tag forward scanner
@@ -65,6 +65,18 @@ func TestForwardMerger_Merge(t *testing.T) {
 	assert.Nil(t, nopFlusher2.Bytes())
 }
 
+func TestForwardMerger_Merge_dropped(t *testing.T) {
+	nopFlusher := kv.NewNopFlusher()
+	merge, _ := NewForwardMerger(nopFlusher)
+	merge.Init(map[string]interface{}{kv.TombstoneContext: roaring.BitmapOf(1)})
+	// tag key dropped, purge it
+	assert.NoError(t, merge.Merge(1, mockMergeForwardBlock()))
+	assert.Nil(t, nopFlusher.Bytes())
+	// tag key not dropped
+	assert.NoError(t, merge.Merge(2, mockMergeForwardBlock()))
+	assert.NotNil(t, nopFlusher.Bytes())
+}
+
 func mockMergeForwardBlock() (block [][]byte) {
 	nopKVFlusher1 := kv.NewNopFlusher()
 	forwardFlusher, _ := NewForwardFlusher(nopKVFlusher1)
@@ -33,7 +33,10 @@ import (
 
 func TestInvertedMerger_Merge(t *testing.T) {
 	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+	defer func() {
+		encoding.BitmapUnmarshal = bitmapUnmarshal
+		ctrl.Finish()
+	}()
 
 	nopFlusher := kv.NewNopFlusher()
 	merge, _ := NewInvertedMerger(nopFlusher)
@@ -121,6 +124,18 @@ func mockInvertedMergeData() (data [][]byte) {
 	return data
 }
 
+func TestInvertedMerger_Merge_dropped(t *testing.T) {
+	nopFlusher := kv.NewNopFlusher()
+	merge, _ := NewInvertedMerger(nopFlusher)
+	merge.Init(map[string]interface{}{kv.TombstoneContext: roaring.BitmapOf(1)})
+	// tag key dropped, purge it
+	assert.NoError(t, merge.Merge(1, mockInvertedMergeData()))
+	assert.Nil(t, nopFlusher.Bytes())
+	// tag key not dropped
+	assert.NoError(t, merge.Merge(2, mockInvertedMergeData()))
+	assert.NotNil(t, nopFlusher.Bytes())
+}
+
 func TestInvertedMerger_Merge_same_tagValues(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -30,6 +30,7 @@ import (
 	"github.com/lindb/lindb/pkg/encoding"
 	"github.com/lindb/lindb/pkg/strutil"
 	"github.com/lindb/lindb/pkg/trie"
+	"github.com/lindb/lindb/sql/stmt"
 )
 
 //go:generate mockgen -source ./meta.go -destination=./meta_mock.go -package tagkeymeta
@@ -54,6 +55,12 @@ type TagKeyMeta interface {
 	FindTagValueIDsByLike(tagValue string) (tagValueIDs []uint32)
 	// FindTagValueIDsByRegex finds tagValueIDs by regex pattern,
 	FindTagValueIDsByRegex(tagValuePattern string) (tagValueIDs []uint32)
+	// FindTagValueIDsByRange finds tagValueIDs which tag value in range(number or semantic version),
+	// searches by the sorted range index.
+	FindTagValueIDsByRange(valueRange *stmt.TagValueRange) (tagValueIDs []uint32)
+	// RangeIndex returns the sorted range index of tag values which can be ordered,
+	// built by scanning all tag values at first time.
+	RangeIndex() (*RangeIndex, error)
 }
 
 const (
@@ -91,6 +98,7 @@ type tagKeyMeta struct {
 	offsetsPos     int
 	tagValueIDSeq  uint32
 	crc32CheckSum  uint32
+	rangeIndex     *RangeIndex
 }
 
 func newTagKeyMeta(tagKeyMetaBlock []byte) (TagKeyMeta, error) {
@@ -353,3 +361,37 @@ func (meta *tagKeyMeta) FindTagValueIDsByRegex(tagValuePattern string) (tagValue
 	}
 	return tagValueIDs
 }
+
+// FindTagValueIDsByRange finds tagValueIDs which tag value in range(number or semantic version),
+// searches by the sorted range index.
+func (meta *tagKeyMeta) FindTagValueIDsByRange(valueRange *stmt.TagValueRange) (tagValueIDs []uint32) {
+	if valueRange == nil {
+		return nil
+	}
+	index, err := meta.RangeIndex()
+	if err != nil {
+		return nil
+	}
+	return index.Find(valueRange)
+}
+
+// RangeIndex returns the sorted range index of tag values which can be ordered,
+// built by scanning all tag values at first time.
+func (meta *tagKeyMeta) RangeIndex() (*RangeIndex, error) {
+	if meta.rangeIndex != nil {
+		return meta.rangeIndex, nil
+	}
+	itr, err := meta.PrefixIterator(nil)
+	if err != nil {
+		return nil, err
+	}
+	var entries []RangeEntry
+	for itr.Valid() {
+		if value, ok := stmt.ParseComparableValue(strutil.ByteSlice2String(itr.Key())); ok {
+			entries = append(entries, RangeEntry{Value: value, TagValueID: encoding.ByteSlice2Uint32(itr.Value())})
+		}
+		itr.Next()
+	}
+	meta.rangeIndex = NewRangeIndex(entries)
+	return meta.rangeIndex, nil
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/lindb/roaring"
 
 	"github.com/lindb/lindb/kv"
+	"github.com/lindb/lindb/sql/stmt"
 )
 
 func Test_newTagKeyMeta_error_cases(t *testing.T) {
@@ -176,6 +177,20 @@ func TestTagKeyMeta_FindTagValueIDsByRegex(t *testing.T) {
 	assert.Len(t, meta.FindTagValueIDsByRegex(".*"), 10000)
 }
 
+func TestTagKeyMeta_FindTagValueIDsByRange(t *testing.T) {
+	meta, _ := newTagKeyMeta(buildTestTrieData())
+
+	// case1: bound cannot be ordered
+	assert.Len(t, meta.FindTagValueIDsByRange(nil), 0)
+
+	// case2: between
+	assert.Len(t, meta.FindTagValueIDsByRange((&stmt.BetweenExpr{Start: "1.1.1.1", End: "1.1.1.10"}).ValueRange()), 10)
+
+	// case3: compare
+	assert.Len(t, meta.FindTagValueIDsByRange((&stmt.CompareExpr{Operator: stmt.GREATEREQUAL, Value: "10.10.10.10"}).ValueRange()), 1)
+	assert.Len(t, meta.FindTagValueIDsByRange((&stmt.CompareExpr{Operator: stmt.LESS, Value: "1.1.2"}).ValueRange()), 10)
+}
+
 func TestTagKeyMeta_CollectTagValues(t *testing.T) {
 	meta, _ := newTagKeyMeta(buildTestTrieData())
 	// case1: normal
@@ -236,6 +251,8 @@ func TestTagKeyMeta_Error(t *testing.T) {
 
 	// FindTagValueIDsByRegex error
 	assert.Len(t, meta.FindTagValueIDsByRegex("x"), 0)
+	// FindTagValueIDsByRange error
+	assert.Len(t, meta.FindTagValueIDsByRange(&stmt.TagValueRange{}), 0)
 	// FindTagValueIDsByLike error
 	assert.Len(t, meta.FindTagValueIDsByLike("x*"), 0)
 	assert.Len(t, meta.FindTagValueIDsByLike("*x*"), 0)
@@ -0,0 +1,184 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tagkeymeta
+
+import (
+	"container/list"
+	"sort"
+	"sync"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+// RangeEntry represents the comparable tag value with tag value id.
+type RangeEntry struct {
+	Value      stmt.ComparableValue
+	TagValueID uint32
+}
+
+// RangeIndex represents the sorted views of tag values which can be ordered, immutable after built.
+type RangeIndex struct {
+	numbers  []RangeEntry // sorted by number
+	versions []RangeEntry // sorted by semantic version, includes numbers which are versions too
+}
+
+// NewRangeIndex builds the sorted views of comparable tag values.
+func NewRangeIndex(entries []RangeEntry) *RangeIndex {
+	return (&RangeIndex{}).Merge(entries)
+}
+
+// Len returns the number of tag values in index.
+func (index *RangeIndex) Len() int {
+	return len(index.numbers) + len(index.versions)
+}
+
+// Merge returns a new range index which includes the entries of current index and given entries.
+func (index *RangeIndex) Merge(entries []RangeEntry) *RangeIndex {
+	var numbers, versions []RangeEntry
+	for _, entry := range entries {
+		if entry.Value.IsNumeric() {
+			numbers = append(numbers, entry)
+		}
+		if entry.Value.IsVersion() {
+			versions = append(versions, entry)
+		}
+	}
+	return &RangeIndex{
+		numbers:  mergeEntries(index.numbers, numbers, stmt.NumericOrdering),
+		versions: mergeEntries(index.versions, versions, stmt.VersionOrdering),
+	}
+}
+
+// Find finds tag value ids in range by binary search on the sorted view of range's ordering.
+func (index *RangeIndex) Find(valueRange *stmt.TagValueRange) (tagValueIDs []uint32) {
+	if valueRange == nil {
+		return nil
+	}
+	entries := index.numbers
+	if valueRange.Ordering == stmt.VersionOrdering {
+		entries = index.versions
+	}
+	compare := func(value, bound stmt.ComparableValue) int {
+		c, _ := value.CompareBy(bound, valueRange.Ordering)
+		return c
+	}
+	start := 0
+	end := len(entries)
+	if low := valueRange.Low; low != nil {
+		start = sort.Search(len(entries), func(i int) bool {
+			c := compare(entries[i].Value, *low)
+			return c > 0 || (c == 0 && valueRange.LowInclusive)
+		})
+	}
+	if high := valueRange.High; high != nil {
+		end = sort.Search(len(entries), func(i int) bool {
+			c := compare(entries[i].Value, *high)
+			return c > 0 || (c == 0 && !valueRange.HighInclusive)
+		})
+	}
+	for i := start; i < end; i++ {
+		tagValueIDs = append(tagValueIDs, entries[i].TagValueID)
+	}
+	return tagValueIDs
+}
+
+// mergeEntries sorts the new entries by ordering, then merges them with sorted entries into a new slice.
+func mergeEntries(sorted, entries []RangeEntry, ordering stmt.Ordering) []RangeEntry {
+	less := func(a, b RangeEntry) bool {
+		c, _ := a.Value.CompareBy(b.Value, ordering)
+		return c < 0
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return less(entries[i], entries[j])
+	})
+	if len(sorted) == 0 {
+		return entries
+	}
+	result := make([]RangeEntry, 0, len(sorted)+len(entries))
+	i, j := 0, 0
+	for i < len(sorted) && j < len(entries) {
+		if less(entries[j], sorted[i]) {
+			result = append(result, entries[j])
+			j++
+		} else {
+			result = append(result, sorted[i])
+			i++
+		}
+	}
+	result = append(result, sorted[i:]...)
+	return append(result, entries[j:]...)
+}
+
+// rangeIndexKey represents the key of range index cache, tag key meta in table file is immutable.
+type rangeIndexKey struct {
+	path     string
+	tagKeyID uint32
+}
+
+// rangeIndexCache represents the LRU cache of range index for tag key meta in table file,
+// avoids scanning all tag values of table file for each range filter.
+type rangeIndexCache struct {
+	capacity int
+	entries  map[rangeIndexKey]*list.Element
+	lru      *list.List
+
+	lock sync.Mutex
+}
+
+// rangeIndexCacheEntry represents the element of range index cache.
+type rangeIndexCacheEntry struct {
+	key   rangeIndexKey
+	index *RangeIndex
+}
+
+// newRangeIndexCache creates a range index cache with capacity.
+func newRangeIndexCache(capacity int) *rangeIndexCache {
+	return &rangeIndexCache{
+		capacity: capacity,
+		entries:  make(map[rangeIndexKey]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// get returns the range index by key.
+func (c *rangeIndexCache) get(key rangeIndexKey) (*RangeIndex, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(elem)
+		return elem.Value.(*rangeIndexCacheEntry).index, true
+	}
+	return nil, false
+}
+
+// put puts the range index into cache, evicts the least recently used one if cache is full.
+func (c *rangeIndexCache) put(key rangeIndexKey, index *RangeIndex) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*rangeIndexCacheEntry).index = index
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&rangeIndexCacheEntry{key: key, index: index})
+	for c.lru.Len() > c.capacity {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*rangeIndexCacheEntry).key)
+	}
+}
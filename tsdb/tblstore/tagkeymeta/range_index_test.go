@@ -0,0 +1,90 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tagkeymeta
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/sql/stmt"
+)
+
+func newRangeEntries(values ...string) (entries []RangeEntry) {
+	for idx, value := range values {
+		v, ok := stmt.ParseComparableValue(value)
+		if ok {
+			entries = append(entries, RangeEntry{Value: v, TagValueID: uint32(idx + 1)})
+		}
+	}
+	return entries
+}
+
+func TestRangeIndex_Find(t *testing.T) {
+	index := NewRangeIndex(newRangeEntries("10", "1.5", "v1.10.0", "abc", "-3", "1.3.0", "1.25"))
+	assert.Equal(t, 9, index.Len()) // 10, 1.5, 1.25 are both number and version
+	assert.Nil(t, index.Find(nil))
+
+	cases := []struct {
+		expr   stmt.RangeTagFilter
+		result []uint32
+	}{
+		{expr: &stmt.CompareExpr{Operator: stmt.GREATER, Value: "1.3"}, result: []uint32{2, 1}},
+		{expr: &stmt.CompareExpr{Operator: stmt.LESSEQUAL, Value: "1.5"}, result: []uint32{5, 7, 2}},
+		{expr: &stmt.CompareExpr{Operator: stmt.GREATER, Value: "1.3.0"}, result: []uint32{2, 3, 7, 1}},
+		{expr: &stmt.BetweenExpr{Start: "1.3", End: "1.5.0"}, result: []uint32{6, 2}},
+		{expr: &stmt.BetweenExpr{Start: "10", End: "1"}, result: nil},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.result, index.Find(c.expr.ValueRange()), c.expr.Rewrite())
+	}
+}
+
+func TestRangeIndex_Merge(t *testing.T) {
+	index := NewRangeIndex(newRangeEntries("1", "5", "9"))
+	merged := index.Merge([]RangeEntry{
+		{Value: newRangeEntries("7")[0].Value, TagValueID: 10},
+		{Value: newRangeEntries("3")[0].Value, TagValueID: 11},
+	})
+	// old index is immutable
+	assert.Equal(t, []uint32{2, 3}, index.Find((&stmt.CompareExpr{Operator: stmt.GREATER, Value: "2"}).ValueRange()))
+	assert.Equal(t, []uint32{11, 2, 10, 3}, merged.Find((&stmt.CompareExpr{Operator: stmt.GREATER, Value: "2"}).ValueRange()))
+}
+
+func TestRangeIndexCache(t *testing.T) {
+	cache := newRangeIndexCache(2)
+	index := NewRangeIndex(nil)
+	key1 := rangeIndexKey{path: "1.sst", tagKeyID: 1}
+	key2 := rangeIndexKey{path: "2.sst", tagKeyID: 1}
+	key3 := rangeIndexKey{path: "3.sst", tagKeyID: 1}
+	cache.put(key1, index)
+	cache.put(key2, index)
+	// key1 is recently used
+	_, ok := cache.get(key1)
+	assert.True(t, ok)
+	cache.put(key3, index)
+	_, ok = cache.get(key2)
+	assert.False(t, ok)
+	_, ok = cache.get(key1)
+	assert.True(t, ok)
+	// put exist key
+	cache.put(key1, NewRangeIndex(newRangeEntries("1")))
+	index, ok = cache.get(key1)
+	assert.True(t, ok)
+	assert.Equal(t, 2, index.Len())
+}
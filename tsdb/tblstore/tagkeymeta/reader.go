@@ -35,6 +35,8 @@ import (
 // for testing
 var (
 	newTagKeyMetaFn = newTagKeyMeta
+	// rangeIndexes caches the range index of tag key meta in table files.
+	rangeIndexes = newRangeIndexCache(1024)
 )
 
 // Reader reads tag value data from tag-index-table
@@ -123,6 +125,9 @@ func (r *tagReader) GetTagValueID(tagKeyID tag.KeyID, tagValue string) (tagValue
 
 // FindValueIDsByExprForTagKeyID finds tag values ids by tag filter expr and tag key id
 func (r *tagReader) FindValueIDsByExprForTagKeyID(tagKeyID tag.KeyID, expr stmt.TagFilter) (*roaring.Bitmap, error) {
+	if rangeExpr, ok := expr.(stmt.RangeTagFilter); ok {
+		return r.findValueIDsByRange(tagKeyID, rangeExpr.ValueRange())
+	}
 	tagKeyMetas := r.filterTagKeyMetas(tagKeyID)
 	if len(tagKeyMetas) == 0 {
 		return nil, fmt.Errorf("%w, tagKeyID: %d", constants.ErrTagKeyMetaNotFound, tagKeyID)
@@ -146,6 +151,37 @@ func (r *tagReader) FindValueIDsByExprForTagKeyID(tagKeyID tag.KeyID, expr stmt.
 	return tagValueIDs, nil
 }
 
+// findValueIDsByRange finds tag value ids in range by the cached range index of each table file.
+func (r *tagReader) findValueIDsByRange(tagKeyID tag.KeyID, valueRange *stmt.TagValueRange) (*roaring.Bitmap, error) {
+	tagValueIDs := roaring.New()
+	found := false
+	for _, reader := range r.readers {
+		key := rangeIndexKey{path: reader.Path(), tagKeyID: uint32(tagKeyID)}
+		index, ok := rangeIndexes.get(key)
+		if !ok {
+			tagKeyMetaBlock, err := reader.Get(uint32(tagKeyID))
+			if err != nil {
+				continue
+			}
+			tagKeyMeta, err := newTagKeyMetaFn(tagKeyMetaBlock)
+			if err != nil {
+				continue
+			}
+			index, err = tagKeyMeta.RangeIndex()
+			if err != nil {
+				continue
+			}
+			rangeIndexes.put(key, index)
+		}
+		found = true
+		tagValueIDs.AddMany(index.Find(valueRange))
+	}
+	if !found {
+		return nil, fmt.Errorf("%w, tagKeyID: %d", constants.ErrTagKeyMetaNotFound, tagKeyID)
+	}
+	return tagValueIDs, nil
+}
+
 // GetTagValueIDsForTagKeyID get tag value ids for spec tag key id of metric.
 func (r *tagReader) GetTagValueIDsForTagKeyID(tagKeyID tag.KeyID) (*roaring.Bitmap, error) {
 	tagKeyMetas := r.filterTagKeyMetas(tagKeyID)
@@ -272,6 +272,56 @@ func TestReader_FindSeriesIDsByExprForTagID_RegexExpr(t *testing.T) {
 	assert.True(t, idSet.IsEmpty())
 }
 
+func TestReader_FindSeriesIDsByExprForTagID_RangeExpr(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		newTagKeyMetaFn = newTagKeyMeta
+		rangeIndexes = newRangeIndexCache(1024)
+		ctrl.Finish()
+	}()
+	_, ipBlock, hostBlock := buildTrieBlock()
+	mockReader := table.NewMockReader(ctrl)
+	mockReader.EXPECT().Path().Return("/data/000001.sst").AnyTimes()
+	// range index is cached, tag key meta only reads once
+	mockReader.EXPECT().Get(uint32(21)).Return(ipBlock, nil)
+	mockReader.EXPECT().Get(uint32(22)).Return(hostBlock, nil)
+	mockReader.EXPECT().Get(uint32(19)).Return(nil, io.EOF)
+	reader := NewReader([]table.Reader{mockReader})
+
+	idSet, err := reader.FindValueIDsByExprForTagKeyID(21, &stmt.BetweenExpr{Key: "ip", Start: "192.168.2.0", End: "192.168.2.255"})
+	assert.NoError(t, err)
+	assert.Equal(t, roaring.BitmapOf(4, 5, 6), idSet)
+
+	idSet, err = reader.FindValueIDsByExprForTagKeyID(21, &stmt.CompareExpr{Key: "ip", Operator: stmt.GREATER, Value: "192.168.3.8"})
+	assert.NoError(t, err)
+	assert.Equal(t, roaring.BitmapOf(9), idSet)
+
+	// tag values cannot be ordered
+	idSet, err = reader.FindValueIDsByExprForTagKeyID(22, &stmt.CompareExpr{Key: "host", Operator: stmt.GREATER, Value: "1"})
+	assert.NoError(t, err)
+	assert.True(t, idSet.IsEmpty())
+
+	// tag key not exist
+	idSet, err = reader.FindValueIDsByExprForTagKeyID(19, &stmt.CompareExpr{Key: "host", Operator: stmt.GREATER, Value: "1"})
+	assert.Error(t, err)
+	assert.Nil(t, idSet)
+
+	// build range index failure
+	mockReader.EXPECT().Get(uint32(23)).Return(ipBlock, nil).Times(2)
+	newTagKeyMetaFn = func(_ []byte) (TagKeyMeta, error) {
+		return nil, fmt.Errorf("err")
+	}
+	_, err = reader.FindValueIDsByExprForTagKeyID(23, &stmt.CompareExpr{Key: "host", Operator: stmt.GREATER, Value: "1"})
+	assert.Error(t, err)
+	tagMeta := NewMockTagKeyMeta(ctrl)
+	newTagKeyMetaFn = func(_ []byte) (TagKeyMeta, error) {
+		return tagMeta, nil
+	}
+	tagMeta.EXPECT().RangeIndex().Return(nil, fmt.Errorf("err"))
+	_, err = reader.FindValueIDsByExprForTagKeyID(23, &stmt.CompareExpr{Key: "host", Operator: stmt.GREATER, Value: "1"})
+	assert.Error(t, err)
+}
+
 func TestReader_SuggestTagValues(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer func() {
@@ -0,0 +1,88 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tiering
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+//go:generate mockgen -source=./backend.go -destination=./backend_mock.go -package=tiering
+
+const (
+	// LocalBackend stores cold segments in local file system(like another disk).
+	LocalBackend = "local"
+	// S3Backend stores cold segments in s3 compatible object store.
+	S3Backend = "s3"
+)
+
+// for testing
+var (
+	mkDirIfNotExist = fileutil.MkDirIfNotExist
+	listDir         = fileutil.ListDir
+	removeDir       = fileutil.RemoveDir
+	copyFile        = fileutil.CopyFile
+	renameFunc      = os.Rename
+)
+
+var backend Backend
+
+// InitBackend initializes the cold storage backend singleton, nil means disable tiering.
+func InitBackend(b Backend) {
+	backend = b
+}
+
+// GetBackend returns the cold storage backend singleton, returns nil if tiering disabled.
+func GetBackend() Backend {
+	return backend
+}
+
+// Backend represents the cold storage which stores the old segments moved from local storage.
+// The key of segment is the relative path under storage dir(like db/shard/1/segment/day/20220101).
+type Backend interface {
+	// Archive moves all files under local dir into cold storage by key, then removes local dir.
+	Archive(key, dir string) error
+	// Restore returns the local dir which can read the files of key,
+	// downloads the files into cache dir if backend is remote.
+	Restore(key string) (string, error)
+	// Release releases the local resource(cache files) of key after segment closed.
+	Release(key string) error
+	// Exist checks if key exist in cold storage.
+	Exist(key string) (bool, error)
+	// List returns the names of children under prefix.
+	List(prefix string) ([]string, error)
+	// Delete deletes all files under key(prefix) from cold storage.
+	Delete(key string) error
+}
+
+// NewBackend creates the cold storage backend based on config, returns nil if tiering disabled.
+func NewBackend(cfg *config.Tiering) (Backend, error) {
+	switch cfg.Backend {
+	case "":
+		return nil, nil
+	case LocalBackend:
+		return NewLocalBackend(cfg.Dir)
+	case S3Backend:
+		return NewS3Backend(cfg)
+	default:
+		return nil, fmt.Errorf("unknown tiering backend: %s", cfg.Backend)
+	}
+}
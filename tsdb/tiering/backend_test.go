@@ -0,0 +1,97 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tiering
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/config"
+)
+
+func TestBackend_Init(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer func() {
+		InitBackend(nil)
+		ctrl.Finish()
+	}()
+	assert.Nil(t, GetBackend())
+	b := NewMockBackend(ctrl)
+	InitBackend(b)
+	assert.Equal(t, b, GetBackend())
+}
+
+func TestNewBackend(t *testing.T) {
+	dir := t.TempDir()
+	cases := []struct {
+		name    string
+		cfg     *config.Tiering
+		isNil   bool
+		wantErr bool
+	}{
+		{
+			name:  "tiering disabled",
+			cfg:   &config.Tiering{},
+			isNil: true,
+		},
+		{
+			name: "local backend",
+			cfg:  &config.Tiering{Backend: LocalBackend, Dir: filepath.Join(dir, "local")},
+		},
+		{
+			name: "s3 backend",
+			cfg: &config.Tiering{
+				Backend:    S3Backend,
+				Dir:        filepath.Join(dir, "s3"),
+				S3Endpoint: "http://127.0.0.1:9000",
+				S3Bucket:   "lindb",
+			},
+		},
+		{
+			name:    "s3 backend, invalid endpoint",
+			cfg:     &config.Tiering{Backend: S3Backend, Dir: filepath.Join(dir, "s3"), S3Bucket: "lindb"},
+			isNil:   true,
+			wantErr: true,
+		},
+		{
+			name:    "s3 backend, bucket empty",
+			cfg:     &config.Tiering{Backend: S3Backend, Dir: filepath.Join(dir, "s3"), S3Endpoint: "http://127.0.0.1:9000"},
+			isNil:   true,
+			wantErr: true,
+		},
+		{
+			name:    "unknown backend",
+			cfg:     &config.Tiering{Backend: "hdfs"},
+			isNil:   true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := NewBackend(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewBackend() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.isNil, b == nil)
+		})
+	}
+}
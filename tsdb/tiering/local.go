@@ -0,0 +1,123 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tiering
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+// localBackend implements Backend interface, stores cold segments in local file system.
+type localBackend struct {
+	dir string
+}
+
+// NewLocalBackend creates the local file system backend with root dir.
+func NewLocalBackend(dir string) (Backend, error) {
+	if err := mkDirIfNotExist(dir); err != nil {
+		return nil, fmt.Errorf("create tiering dir[%s] error: %s", dir, err)
+	}
+	return &localBackend{
+		dir: dir,
+	}, nil
+}
+
+// Archive moves all files under local dir into cold storage by key, then removes local dir.
+func (b *localBackend) Archive(key, dir string) error {
+	target := filepath.Join(b.dir, key)
+	// remove the files of previous failure archive
+	if err := removeDir(target); err != nil {
+		return err
+	}
+	if err := mkDirIfNotExist(filepath.Dir(target)); err != nil {
+		return err
+	}
+	if err := renameFunc(dir, target); err == nil {
+		return nil
+	}
+	// rename not supported(cross device), copy files into tmp dir, then rename it
+	tmp := target + ".tmp"
+	if err := removeDir(tmp); err != nil {
+		return err
+	}
+	if err := walkFiles(dir, func(name, file string) error {
+		if err := mkDirIfNotExist(filepath.Dir(filepath.Join(tmp, name))); err != nil {
+			return err
+		}
+		return copyFile(file, filepath.Join(tmp, name))
+	}); err != nil {
+		return err
+	}
+	if err := renameFunc(tmp, target); err != nil {
+		return err
+	}
+	return removeDir(dir)
+}
+
+// Restore returns the dir under cold storage, because files can be read directly.
+func (b *localBackend) Restore(key string) (string, error) {
+	target := filepath.Join(b.dir, key)
+	if !fileutil.Exist(target) {
+		return "", fmt.Errorf("segment[%s] not found in tiering storage", key)
+	}
+	return target, nil
+}
+
+// Release does nothing, because no cache files for local backend.
+func (b *localBackend) Release(_ string) error {
+	return nil
+}
+
+// Exist checks if key exist in cold storage.
+func (b *localBackend) Exist(key string) (bool, error) {
+	return fileutil.Exist(filepath.Join(b.dir, key)), nil
+}
+
+// List returns the names of children under prefix.
+func (b *localBackend) List(prefix string) ([]string, error) {
+	dir := filepath.Join(b.dir, prefix)
+	if !fileutil.Exist(dir) {
+		return nil, nil
+	}
+	return listDir(dir)
+}
+
+// Delete deletes all files under key(prefix) from cold storage.
+func (b *localBackend) Delete(key string) error {
+	return removeDir(filepath.Join(b.dir, key))
+}
+
+// walkFiles walks all regular files under dir, name is the slash separated path relative to dir.
+func walkFiles(dir string, fn func(name, file string) error) error {
+	return filepath.WalkDir(dir, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		name, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		return fn(filepath.ToSlash(name), file)
+	})
+}
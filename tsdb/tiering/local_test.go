@@ -0,0 +1,124 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tiering
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+// prepareSegment creates segment dir with some files for testing.
+func prepareSegment(t *testing.T, dir string) {
+	assert.NoError(t, fileutil.MkDirIfNotExist(filepath.Join(dir, "1")))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "OPTIONS"), []byte("options"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "1", "000001.sst"), []byte("sst-data"), 0644))
+}
+
+// assertSegment asserts the files of segment dir.
+func assertSegment(t *testing.T, dir string) {
+	data, err := os.ReadFile(filepath.Join(dir, "OPTIONS"))
+	assert.NoError(t, err)
+	assert.Equal(t, "options", string(data))
+	data, err = os.ReadFile(filepath.Join(dir, "1", "000001.sst"))
+	assert.NoError(t, err)
+	assert.Equal(t, "sst-data", string(data))
+}
+
+func TestLocalBackend(t *testing.T) {
+	hotDir := t.TempDir()
+	b, err := NewLocalBackend(t.TempDir())
+	assert.NoError(t, err)
+
+	key := filepath.Join("db", "shard", "1", "segment", "day", "20221017")
+	src := filepath.Join(hotDir, key)
+	prepareSegment(t, src)
+
+	ok, err := b.Exist(key)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	names, err := b.List(filepath.Dir(key))
+	assert.NoError(t, err)
+	assert.Empty(t, names)
+	_, err = b.Restore(key)
+	assert.Error(t, err)
+
+	assert.NoError(t, b.Archive(key, src))
+	assert.False(t, fileutil.Exist(src))
+	ok, err = b.Exist(key)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	names, err = b.List(filepath.Dir(key))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"20221017"}, names)
+	dir, err := b.Restore(key)
+	assert.NoError(t, err)
+	assertSegment(t, dir)
+	assert.NoError(t, b.Release(key))
+
+	assert.NoError(t, b.Delete(filepath.Join("db", "shard", "1")))
+	ok, err = b.Exist(key)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestLocalBackend_Archive_Copy(t *testing.T) {
+	defer func() {
+		renameFunc = os.Rename
+		copyFile = fileutil.CopyFile
+		mkDirIfNotExist = fileutil.MkDirIfNotExist
+	}()
+	hotDir := t.TempDir()
+	b, err := NewLocalBackend(t.TempDir())
+	assert.NoError(t, err)
+	key := filepath.Join("db", "20221017")
+	src := filepath.Join(hotDir, key)
+	prepareSegment(t, src)
+
+	// cross device, copy files
+	renameFunc = func(oldpath, newpath string) error {
+		if oldpath == src {
+			return fmt.Errorf("cross device")
+		}
+		return os.Rename(oldpath, newpath)
+	}
+	copyFile = func(_, _ string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, b.Archive(key, src))
+	assert.True(t, fileutil.Exist(src))
+	copyFile = fileutil.CopyFile
+
+	assert.NoError(t, b.Archive(key, src))
+	assert.False(t, fileutil.Exist(src))
+	dir, err := b.Restore(key)
+	assert.NoError(t, err)
+	assertSegment(t, dir)
+
+	mkDirIfNotExist = func(_ string) error {
+		return fmt.Errorf("err")
+	}
+	_, err = NewLocalBackend(t.TempDir())
+	assert.Error(t, err)
+	assert.Error(t, b.Archive(key, src))
+}
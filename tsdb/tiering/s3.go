@@ -0,0 +1,206 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tiering
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/lindb/lindb/config"
+	"github.com/lindb/lindb/pkg/fileutil"
+)
+
+// s3Backend implements Backend interface, stores cold segments in s3 compatible object store,
+// the segment files are downloaded into local cache dir when reading.
+type s3Backend struct {
+	cacheDir string
+	client   *s3Client
+
+	mutex sync.Mutex
+}
+
+// NewS3Backend creates the s3 compatible object store backend.
+func NewS3Backend(cfg *config.Tiering) (Backend, error) {
+	client, err := newS3Client(cfg.S3Endpoint, cfg.S3Region, cfg.S3Bucket, cfg.S3AccessKey, cfg.S3SecretKey,
+		cfg.S3Timeout.Duration())
+	if err != nil {
+		return nil, err
+	}
+	if err := mkDirIfNotExist(cfg.Dir); err != nil {
+		return nil, fmt.Errorf("create tiering cache dir[%s] error: %s", cfg.Dir, err)
+	}
+	return &s3Backend{
+		cacheDir: cfg.Dir,
+		client:   client,
+	}, nil
+}
+
+// Archive uploads all files under local dir into object store by key, then removes local dir.
+func (b *s3Backend) Archive(key, dir string) error {
+	// remove the objects of previous failure archive
+	if err := b.deleteObjects(key); err != nil {
+		return err
+	}
+	if err := walkFiles(dir, func(name, file string) error {
+		return b.putFile(path.Join(objectPrefix(key), name), file)
+	}); err != nil {
+		return err
+	}
+	return removeDir(dir)
+}
+
+// Restore downloads the files of key into cache dir if not cached, returns the cache dir.
+func (b *s3Backend) Restore(key string) (string, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	target := filepath.Join(b.cacheDir, key)
+	if fileutil.Exist(target) {
+		return target, nil
+	}
+	prefix := objectPrefix(key) + "/"
+	objects, _, err := b.client.ListObjects(prefix, "")
+	if err != nil {
+		return "", err
+	}
+	if len(objects) == 0 {
+		return "", fmt.Errorf("segment[%s] not found in tiering storage", key)
+	}
+	// download files into tmp dir, then rename it, make sure cache dir is completed
+	tmp := target + ".tmp"
+	if err := removeDir(tmp); err != nil {
+		return "", err
+	}
+	for _, object := range objects {
+		file := filepath.Join(tmp, filepath.FromSlash(strings.TrimPrefix(object.Key, prefix)))
+		if err := mkDirIfNotExist(filepath.Dir(file)); err != nil {
+			return "", err
+		}
+		if err := b.getFile(object.Key, file); err != nil {
+			return "", err
+		}
+	}
+	if err := renameFunc(tmp, target); err != nil {
+		return "", err
+	}
+	return target, nil
+}
+
+// Release removes the cache files of key.
+func (b *s3Backend) Release(key string) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return removeDir(filepath.Join(b.cacheDir, key))
+}
+
+// Exist checks if key exist in object store.
+func (b *s3Backend) Exist(key string) (bool, error) {
+	objects, prefixes, err := b.client.ListObjects(objectPrefix(key)+"/", "/")
+	if err != nil {
+		return false, err
+	}
+	return len(objects) > 0 || len(prefixes) > 0, nil
+}
+
+// List returns the names of children under prefix.
+func (b *s3Backend) List(prefix string) ([]string, error) {
+	dir := objectPrefix(prefix) + "/"
+	objects, prefixes, err := b.client.ListObjects(dir, "/")
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, p := range prefixes {
+		names = append(names, strings.TrimSuffix(strings.TrimPrefix(p, dir), "/"))
+	}
+	for _, object := range objects {
+		names = append(names, strings.TrimPrefix(object.Key, dir))
+	}
+	return names, nil
+}
+
+// Delete deletes all objects under key(prefix) from object store, and removes the cache files.
+func (b *s3Backend) Delete(key string) error {
+	if err := b.deleteObjects(key); err != nil {
+		return err
+	}
+	return b.Release(key)
+}
+
+// deleteObjects deletes all objects under key(prefix).
+func (b *s3Backend) deleteObjects(key string) error {
+	objects, _, err := b.client.ListObjects(objectPrefix(key)+"/", "")
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		if err := b.client.DeleteObject(object.Key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// putFile uploads local file as object.
+func (b *s3Backend) putFile(key, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	stat, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	return b.client.PutObject(key, f, stat.Size())
+}
+
+// getFile downloads object into local file.
+func (b *s3Backend) getFile(key, file string) (err error) {
+	body, err := b.client.GetObject(key)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = body.Close()
+	}()
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err0 := f.Close(); err == nil {
+			err = err0
+		}
+	}()
+	_, err = io.Copy(f, body)
+	return err
+}
+
+// objectPrefix returns the slash separated object key prefix.
+func objectPrefix(key string) string {
+	return strings.Trim(filepath.ToSlash(key), "/")
+}
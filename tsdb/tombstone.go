@@ -0,0 +1,306 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"sync"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/pkg/stream"
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+)
+
+//go:generate mockgen -source=./tombstone.go -destination=./tombstone_mock.go -package=tsdb
+
+const tombstoneVersion = byte(1)
+
+// tombstone record header: payload length(4 bytes) + crc32 checksum of payload(4 bytes).
+const tombstoneRecordHeaderSize = 8
+
+// Tombstone represents the deleted series of metric in time range.
+type Tombstone struct {
+	MetricID  metric.ID
+	SeriesIDs *roaring.Bitmap
+	TimeRange timeutil.TimeRange
+}
+
+// TombstoneStore represents the tombstones of shard, which records the deleted series,
+// the data of deleted series will be filtered when query, and be purged when compact data family.
+type TombstoneStore interface {
+	// Add adds a tombstone, appends it into the tombstone log file.
+	Add(tombstone *Tombstone) error
+	// Remove removes the tombstones which are purged or expired, then rewrites the tombstone log file.
+	Remove(tombstones []*Tombstone) error
+	// GetTombstones returns the tombstones which overlap the time range.
+	GetTombstones(timeRange timeutil.TimeRange) []*Tombstone
+	// DeletedSeriesIDs returns the series ids of metric which deleted in whole time range,
+	// returns nil if not found.
+	DeletedSeriesIDs(metricID metric.ID, timeRange timeutil.TimeRange) *roaring.Bitmap
+}
+
+// tombstoneStore implements TombstoneStore interface.
+// The tombstone file is an append only log, format: version(1 byte) + record1 + record2 + ...,
+// the file is rewritten only when tombstones removed.
+type tombstoneStore struct {
+	path       string
+	size       int64 // valid size of tombstone log file
+	tombstones []*Tombstone
+
+	mutex sync.RWMutex
+}
+
+// newTombstoneStore creates the tombstone store, loads the tombstones if file exist.
+func newTombstoneStore(path string) (TombstoneStore, error) {
+	s := &tombstoneStore{path: path}
+	if !fileExist(path) {
+		return s, nil
+	}
+	data, err := readFileFunc(path)
+	if err != nil {
+		return nil, err
+	}
+	var size int
+	s.tombstones, size, err = decodeTombstones(data)
+	if err != nil {
+		return nil, fmt.Errorf("load tombstone file[%s] failure: %w", path, err)
+	}
+	s.size = int64(size)
+	if size < len(data) {
+		// the last record is incomplete(crash when appending), rewrite the valid records
+		if err := s.rewrite(s.tombstones); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Add adds a tombstone, appends it into the tombstone log file.
+func (s *tombstoneStore) Add(tombstone *Tombstone) error {
+	record, err := encodeTombstone(tombstone)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var data []byte
+	if s.size == 0 {
+		data = append(data, tombstoneVersion)
+	}
+	data = append(data, record...)
+	f, err := openFileFunc(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if err != nil {
+		// discard the partial record, keep the log file valid
+		_ = f.Truncate(s.size)
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	s.size += int64(len(data))
+	s.tombstones = append(s.tombstones, tombstone)
+	return nil
+}
+
+// Remove removes the tombstones which are purged or expired, then rewrites the tombstone log file.
+func (s *tombstoneStore) Remove(tombstones []*Tombstone) error {
+	if len(tombstones) == 0 {
+		return nil
+	}
+	removed := make(map[*Tombstone]struct{}, len(tombstones))
+	for _, tombstone := range tombstones {
+		removed[tombstone] = struct{}{}
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var retained []*Tombstone
+	for _, tombstone := range s.tombstones {
+		if _, ok := removed[tombstone]; !ok {
+			retained = append(retained, tombstone)
+		}
+	}
+	if len(retained) == len(s.tombstones) {
+		return nil
+	}
+	return s.rewrite(retained)
+}
+
+// rewrite writes the tombstones into tmp file, then renames tmp file to tombstone log file.
+func (s *tombstoneStore) rewrite(tombstones []*Tombstone) error {
+	data := []byte{tombstoneVersion}
+	for _, tombstone := range tombstones {
+		record, err := encodeTombstone(tombstone)
+		if err != nil {
+			return err
+		}
+		data = append(data, record...)
+	}
+	// write data to tmp file, if success then rename tmp => target file
+	tmp := fmt.Sprintf("%s.tmp", s.path)
+	if err := writeFileFunc(tmp, data, 0644); err != nil {
+		return err
+	}
+	if err := renameFunc(tmp, s.path); err != nil {
+		return fmt.Errorf("rename tmp file[%s] name error:%s", tmp, err)
+	}
+	s.size = int64(len(data))
+	s.tombstones = tombstones
+	return nil
+}
+
+// GetTombstones returns the tombstones which overlap the time range.
+func (s *tombstoneStore) GetTombstones(timeRange timeutil.TimeRange) (rs []*Tombstone) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, tombstone := range s.tombstones {
+		if tombstone.TimeRange.Overlap(timeRange) {
+			rs = append(rs, tombstone)
+		}
+	}
+	return rs
+}
+
+// DeletedSeriesIDs returns the series ids of metric which deleted in whole time range.
+func (s *tombstoneStore) DeletedSeriesIDs(metricID metric.ID, timeRange timeutil.TimeRange) *roaring.Bitmap {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var seriesIDs *roaring.Bitmap
+	for _, tombstone := range s.tombstones {
+		if tombstone.MetricID != metricID ||
+			tombstone.TimeRange.Start > timeRange.Start || tombstone.TimeRange.End < timeRange.End {
+			continue
+		}
+		if seriesIDs == nil {
+			seriesIDs = roaring.New()
+		}
+		seriesIDs.Or(tombstone.SeriesIDs)
+	}
+	return seriesIDs
+}
+
+// encodeTombstone encodes tombstone into a log record,
+// format: length(4 bytes) + crc32 checksum(4 bytes) + metric id + time range + series ids.
+func encodeTombstone(tombstone *Tombstone) ([]byte, error) {
+	seriesIDs, err := tombstone.SeriesIDs.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	writer := stream.NewBufferWriter(nil)
+	writer.PutUint32(uint32(tombstone.MetricID))
+	writer.PutVarint64(tombstone.TimeRange.Start)
+	writer.PutVarint64(tombstone.TimeRange.End)
+	writer.PutUvarint64(uint64(len(seriesIDs)))
+	writer.PutBytes(seriesIDs)
+	payload, err := writer.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	record := make([]byte, tombstoneRecordHeaderSize, tombstoneRecordHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(record, uint32(len(payload)))
+	binary.LittleEndian.PutUint32(record[4:], crc32.ChecksumIEEE(payload))
+	return append(record, payload...), nil
+}
+
+// decodeTombstones decodes tombstones from the tombstone log,
+// returns the size of valid data, the incomplete last record is ignored.
+func decodeTombstones(data []byte) (tombstones []*Tombstone, size int, err error) {
+	if len(data) == 0 {
+		return nil, 0, nil
+	}
+	if v := data[0]; v != tombstoneVersion {
+		return nil, 0, fmt.Errorf("unknown tombstone version: %d", v)
+	}
+	size = 1
+	for size < len(data) {
+		remaining := len(data) - size
+		if remaining < tombstoneRecordHeaderSize {
+			break
+		}
+		length := int(binary.LittleEndian.Uint32(data[size:]))
+		if remaining-tombstoneRecordHeaderSize < length {
+			break
+		}
+		payload := data[size+tombstoneRecordHeaderSize : size+tombstoneRecordHeaderSize+length]
+		if crc32.ChecksumIEEE(payload) != binary.LittleEndian.Uint32(data[size+4:]) {
+			if size+tombstoneRecordHeaderSize+length == len(data) {
+				// the last record is incomplete
+				break
+			}
+			return nil, 0, fmt.Errorf("tombstone data checksum not match")
+		}
+		tombstone, err := decodeTombstone(payload)
+		if err != nil {
+			return nil, 0, err
+		}
+		tombstones = append(tombstones, tombstone)
+		size += tombstoneRecordHeaderSize + length
+	}
+	return tombstones, size, nil
+}
+
+// decodeTombstone decodes tombstone from the payload of log record.
+func decodeTombstone(payload []byte) (*Tombstone, error) {
+	reader := stream.NewReader(payload)
+	tombstone := &Tombstone{
+		MetricID:  metric.ID(reader.ReadUint32()),
+		TimeRange: timeutil.TimeRange{Start: reader.ReadVarint64(), End: reader.ReadVarint64()},
+		SeriesIDs: roaring.New(),
+	}
+	length := reader.ReadUvarint64()
+	seriesIDs := reader.ReadSlice(int(length))
+	if err := reader.Error(); err != nil {
+		return nil, err
+	}
+	if err := tombstone.SeriesIDs.UnmarshalBinary(seriesIDs); err != nil {
+		return nil, err
+	}
+	return tombstone, nil
+}
+
+// familyTombstones converts the tombstones to the view of data family, time range => slot range of family.
+func familyTombstones(tombstones []*Tombstone, interval timeutil.Interval, familyTime int64) metricsdata.Tombstones {
+	if len(tombstones) == 0 {
+		return nil
+	}
+	rs := make(metricsdata.Tombstones)
+	for _, tombstone := range tombstones {
+		metricKey := uint32(tombstone.MetricID)
+		rs[metricKey] = append(rs[metricKey], metricsdata.Tombstone{
+			SeriesIDs: tombstone.SeriesIDs,
+			SlotRange: interval.CalcSlotRange(familyTime, tombstone.TimeRange),
+		})
+	}
+	return rs
+}
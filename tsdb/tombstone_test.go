@@ -0,0 +1,268 @@
+// Licensed to LinDB under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. LinDB licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tsdb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/lindb/roaring"
+
+	"github.com/lindb/lindb/pkg/timeutil"
+	"github.com/lindb/lindb/series/metric"
+	"github.com/lindb/lindb/tsdb/tblstore/metricsdata"
+)
+
+func TestTombstoneStore_Add(t *testing.T) {
+	path := filepath.Join(t.TempDir(), tombstoneFile)
+	store, err := newTombstoneStore(path)
+	assert.NoError(t, err)
+	assert.Empty(t, store.GetTombstones(timeutil.TimeRange{Start: 0, End: 100}))
+	assert.Nil(t, store.DeletedSeriesIDs(1, timeutil.TimeRange{Start: 0, End: 100}))
+
+	assert.NoError(t, store.Add(&Tombstone{
+		MetricID:  1,
+		SeriesIDs: roaring.BitmapOf(1, 2),
+		TimeRange: timeutil.TimeRange{Start: 0, End: 100},
+	}))
+	assert.NoError(t, store.Add(&Tombstone{
+		MetricID:  1,
+		SeriesIDs: roaring.BitmapOf(3),
+		TimeRange: timeutil.TimeRange{Start: 50, End: 200},
+	}))
+	assert.NoError(t, store.Add(&Tombstone{
+		MetricID:  2,
+		SeriesIDs: roaring.BitmapOf(4),
+		TimeRange: timeutil.TimeRange{Start: 0, End: 100},
+	}))
+
+	// reload tombstones from file
+	store, err = newTombstoneStore(path)
+	assert.NoError(t, err)
+	assert.Len(t, store.GetTombstones(timeutil.TimeRange{Start: 10, End: 20}), 2)
+	assert.Len(t, store.GetTombstones(timeutil.TimeRange{Start: 150, End: 300}), 1)
+	assert.Empty(t, store.GetTombstones(timeutil.TimeRange{Start: 300, End: 400}))
+	assert.Equal(t, []uint32{1, 2}, store.DeletedSeriesIDs(1, timeutil.TimeRange{Start: 10, End: 20}).ToArray())
+	assert.Equal(t, []uint32{1, 2, 3}, store.DeletedSeriesIDs(1, timeutil.TimeRange{Start: 60, End: 80}).ToArray())
+	assert.Equal(t, []uint32{3}, store.DeletedSeriesIDs(1, timeutil.TimeRange{Start: 60, End: 120}).ToArray())
+	assert.Nil(t, store.DeletedSeriesIDs(1, timeutil.TimeRange{Start: 0, End: 300}))
+	assert.Equal(t, []uint32{4}, store.DeletedSeriesIDs(2, timeutil.TimeRange{Start: 10, End: 20}).ToArray())
+}
+
+func TestTombstoneStore_Add_Failure(t *testing.T) {
+	defer func() {
+		openFileFunc = os.OpenFile
+	}()
+	path := filepath.Join(t.TempDir(), tombstoneFile)
+	store, err := newTombstoneStore(path)
+	assert.NoError(t, err)
+	tombstone := &Tombstone{MetricID: 1, SeriesIDs: roaring.BitmapOf(1)}
+	// case 1: open file failure
+	openFileFunc = func(name string, flag int, perm os.FileMode) (*os.File, error) {
+		return nil, fmt.Errorf("err")
+	}
+	assert.Error(t, store.Add(tombstone))
+	// case 2: write file failure
+	assert.NoError(t, os.WriteFile(path, nil, 0644))
+	openFileFunc = func(name string, flag int, perm os.FileMode) (*os.File, error) {
+		return os.Open(name)
+	}
+	assert.Error(t, store.Add(tombstone))
+	assert.Empty(t, store.GetTombstones(timeutil.TimeRange{}))
+	// case 3: append after failure
+	openFileFunc = os.OpenFile
+	assert.NoError(t, store.Add(tombstone))
+	store, err = newTombstoneStore(path)
+	assert.NoError(t, err)
+	assert.Len(t, store.GetTombstones(timeutil.TimeRange{}), 1)
+}
+
+func TestTombstoneStore_Remove(t *testing.T) {
+	defer func() {
+		writeFileFunc = os.WriteFile
+		renameFunc = os.Rename
+	}()
+	path := filepath.Join(t.TempDir(), tombstoneFile)
+	store, err := newTombstoneStore(path)
+	assert.NoError(t, err)
+	// case 1: remove nothing
+	assert.NoError(t, store.Remove(nil))
+	for i := 1; i <= 3; i++ {
+		assert.NoError(t, store.Add(&Tombstone{
+			MetricID:  metric.ID(i),
+			SeriesIDs: roaring.BitmapOf(uint32(i)),
+			TimeRange: timeutil.TimeRange{Start: int64(i * 10), End: int64(i * 20)},
+		}))
+	}
+	tombstones := store.GetTombstones(timeutil.TimeRange{Start: 0, End: 100})
+	assert.Len(t, tombstones, 3)
+	// case 2: tombstone not found
+	assert.NoError(t, store.Remove([]*Tombstone{{MetricID: 1}}))
+	// case 3: write file failure
+	writeFileFunc = func(name string, data []byte, perm os.FileMode) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, store.Remove(tombstones[:1]))
+	// case 4: rename file failure
+	writeFileFunc = os.WriteFile
+	renameFunc = func(oldpath, newpath string) error {
+		return fmt.Errorf("err")
+	}
+	assert.Error(t, store.Remove(tombstones[:1]))
+	assert.Len(t, store.GetTombstones(timeutil.TimeRange{Start: 0, End: 100}), 3)
+	// case 5: remove successfully, then append new tombstone
+	renameFunc = os.Rename
+	assert.NoError(t, store.Remove(tombstones[:2]))
+	assert.NoError(t, store.Add(&Tombstone{
+		MetricID:  4,
+		SeriesIDs: roaring.BitmapOf(4),
+		TimeRange: timeutil.TimeRange{Start: 40, End: 80},
+	}))
+	store, err = newTombstoneStore(path)
+	assert.NoError(t, err)
+	rs := store.GetTombstones(timeutil.TimeRange{Start: 0, End: 100})
+	assert.Len(t, rs, 2)
+	assert.Equal(t, metric.ID(3), rs[0].MetricID)
+	assert.Equal(t, metric.ID(4), rs[1].MetricID)
+	// case 6: remove all
+	assert.NoError(t, store.Remove(rs))
+	store, err = newTombstoneStore(path)
+	assert.NoError(t, err)
+	assert.Empty(t, store.GetTombstones(timeutil.TimeRange{Start: 0, End: 100}))
+}
+
+func TestTombstoneStore_Load(t *testing.T) {
+	defer func() {
+		readFileFunc = os.ReadFile
+		writeFileFunc = os.WriteFile
+	}()
+	path := filepath.Join(t.TempDir(), tombstoneFile)
+	store, err := newTombstoneStore(path)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Add(&Tombstone{MetricID: 1, SeriesIDs: roaring.BitmapOf(1)}))
+	assert.NoError(t, store.Add(&Tombstone{MetricID: 2, SeriesIDs: roaring.BitmapOf(2)}))
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	// case 1: read file failure
+	readFileFunc = func(name string) ([]byte, error) {
+		return nil, fmt.Errorf("err")
+	}
+	store, err = newTombstoneStore(path)
+	assert.Error(t, err)
+	assert.Nil(t, store)
+	// case 2: data corrupted
+	record := (len(data) - 1) / 2
+	corruptedRecord := append([]byte{}, data...)
+	corruptedRecord[1+tombstoneRecordHeaderSize] = 100
+	cases := [][]byte{
+		append([]byte{2}, data[1:]...),
+		corruptedRecord,
+	}
+	for _, corrupted := range cases {
+		corrupted := corrupted
+		readFileFunc = func(name string) ([]byte, error) {
+			return corrupted, nil
+		}
+		store, err = newTombstoneStore(path)
+		assert.Error(t, err)
+		assert.Nil(t, store)
+	}
+	// case 3: rewrite incomplete last record failure
+	readFileFunc = func(name string) ([]byte, error) {
+		return data[:len(data)-1], nil
+	}
+	writeFileFunc = func(name string, data []byte, perm os.FileMode) error {
+		return fmt.Errorf("err")
+	}
+	store, err = newTombstoneStore(path)
+	assert.Error(t, err)
+	assert.Nil(t, store)
+	writeFileFunc = os.WriteFile
+	// case 4: ignore incomplete last record
+	for _, incomplete := range [][]byte{data[:len(data)-1], data[:len(data)-record+3], data[:len(data)-3]} {
+		incomplete := incomplete
+		readFileFunc = func(name string) ([]byte, error) {
+			return incomplete, nil
+		}
+		store, err = newTombstoneStore(path)
+		assert.NoError(t, err)
+		assert.Len(t, store.GetTombstones(timeutil.TimeRange{}), 1)
+	}
+	readFileFunc = os.ReadFile
+	store, err = newTombstoneStore(path)
+	assert.NoError(t, err)
+	assert.Len(t, store.GetTombstones(timeutil.TimeRange{}), 1)
+	// case 5: empty file
+	assert.NoError(t, os.WriteFile(path, nil, 0644))
+	store, err = newTombstoneStore(path)
+	assert.NoError(t, err)
+	assert.Empty(t, store.GetTombstones(timeutil.TimeRange{}))
+}
+
+func TestTombstone_codec(t *testing.T) {
+	tombstones := []*Tombstone{
+		{MetricID: 1, SeriesIDs: roaring.BitmapOf(1, 2, 65536), TimeRange: timeutil.TimeRange{Start: 10, End: 20}},
+		{MetricID: 2, SeriesIDs: roaring.BitmapOf(3), TimeRange: timeutil.TimeRange{Start: 0, End: 100}},
+	}
+	data := []byte{tombstoneVersion}
+	for _, tombstone := range tombstones {
+		record, err := encodeTombstone(tombstone)
+		assert.NoError(t, err)
+		data = append(data, record...)
+	}
+	rs, size, err := decodeTombstones(data)
+	assert.NoError(t, err)
+	assert.Equal(t, len(data), size)
+	assert.Len(t, rs, len(tombstones))
+	for idx, tombstone := range tombstones {
+		assert.Equal(t, tombstone.MetricID, rs[idx].MetricID)
+		assert.Equal(t, tombstone.TimeRange, rs[idx].TimeRange)
+		assert.Equal(t, tombstone.SeriesIDs.ToArray(), rs[idx].SeriesIDs.ToArray())
+	}
+	// unknown version
+	data[0] = 100
+	_, _, err = decodeTombstones(data)
+	assert.Error(t, err)
+	// bad payload
+	_, err = decodeTombstone([]byte{1, 2})
+	assert.Error(t, err)
+	_, err = decodeTombstone([]byte{1, 0, 0, 0, 0, 0, 2, 1, 2})
+	assert.Error(t, err)
+}
+
+func TestTombstone_familyTombstones(t *testing.T) {
+	assert.Nil(t, familyTombstones(nil, timeutil.Interval(10*timeutil.OneSecond), 0))
+
+	familyTime, _ := timeutil.ParseTimestamp("20190702 19:00:00", "20060102 15:04:05")
+	rs := familyTombstones([]*Tombstone{
+		{MetricID: 1, SeriesIDs: roaring.BitmapOf(1), TimeRange: timeutil.TimeRange{
+			Start: familyTime + 10*timeutil.OneSecond, End: familyTime + 30*timeutil.OneSecond}},
+		{MetricID: 1, SeriesIDs: roaring.BitmapOf(2), TimeRange: timeutil.TimeRange{
+			Start: 0, End: familyTime + 2*timeutil.OneHour}},
+	}, timeutil.Interval(10*timeutil.OneSecond), familyTime)
+	assert.Equal(t, metricsdata.Tombstones{
+		1: {
+			{SeriesIDs: roaring.BitmapOf(1), SlotRange: timeutil.SlotRange{Start: 1, End: 3}},
+			{SeriesIDs: roaring.BitmapOf(2), SlotRange: timeutil.SlotRange{Start: 0, End: 359}},
+		},
+	}, rs)
+}